@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 const releaseVersion = "v11.0.0"
@@ -17,7 +18,10 @@ func SetupHandlers(app *App) {
 		for m, mb := range app.mm.Modules {
 			vm[m] = mb.ConsensusVersion()
 		}
-		vm[crosschaintypes.ModuleName] = vm[crosschaintypes.ModuleName] - 1
+		vm[crosschaintypes.ModuleName] = vm[crosschaintypes.ModuleName] - 2
+		// the observer store of live chains is at version 3 but its crosschain flags are already in the current format,
+		// the legacy flags migration to version 4 would reset them so only the migrations from version 4 are run
+		vm[observertypes.ModuleName] = 4
 		return app.mm.RunMigrations(ctx, app.configurator, vm)
 	})
 
@@ -34,6 +34,14 @@ func CreateZetaBridge(cfg *config.Config, telemetry *zetaclient.TelemetryServer)
 	}
 
 	k := zetaclient.NewKeysWithKeybase(kb, granterAddreess, cfg.AuthzHotkey)
+	if cfg.KeyringBackend == config.KeyringBackendRemote {
+		// the hotkey is held by the remote signer, the local keyring only holds the TSS p2p key
+		remoteKb, err := zetaclient.GetRemoteSignerKeybase(cfg)
+		if err != nil {
+			return nil, err
+		}
+		k = zetaclient.NewKeysWithRemoteSigner(remoteKb, kb, granterAddreess, cfg.AuthzHotkey)
+	}
 
 	bridge, err := zetaclient.NewZetaCoreBridge(k, chainIP, hotKey, cfg.ChainID, cfg.HsmMode, telemetry)
 	if err != nil {
@@ -57,7 +65,7 @@ func CreateSignerMap(
 		}
 		mpiAddress := ethcommon.HexToAddress(evmConfig.CoreParams.ConnectorContractAddress)
 		erc20CustodyAddress := ethcommon.HexToAddress(evmConfig.CoreParams.Erc20CustodyContractAddress)
-		signer, err := zetaclient.NewEVMSigner(evmConfig.Chain, evmConfig.Endpoint, tss, config.GetConnectorABI(), config.GetERC20CustodyABI(), mpiAddress, erc20CustodyAddress, logger, ts)
+		signer, err := zetaclient.NewEVMSigner(*evmConfig, tss, config.GetConnectorABI(), config.GetERC20CustodyABI(), mpiAddress, erc20CustodyAddress, logger, ts)
 		if err != nil {
 			logger.Error().Err(err).Msgf("NewEVMSigner error for chain %s", evmConfig.Chain.String())
 			continue
@@ -3,6 +3,7 @@ package main
 import (
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/zetaclient"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 )
 
@@ -34,6 +35,14 @@ type initArguments struct {
 	KeyringBackend      string
 	HsmMode             bool
 	HsmHotKey           string
+
+	RemoteSignerAddress           string
+	RemoteSignerID                string
+	RemoteSignerClientKeyPath     string
+	RemoteSignerTssKeyringBackend string
+
+	VoteBatchWindow  uint64
+	VoteBatchMaxMsgs int
 }
 
 func init() {
@@ -55,9 +64,15 @@ func init() {
 	InitCmd.Flags().Uint64Var(&initArgs.configUpdateTicker, "config-update-ticker", 5, "config update ticker (default: 0 means no ticker)")
 	InitCmd.Flags().StringVar(&initArgs.TssPath, "tss-path", "~/.tss", "path to tss location")
 	InitCmd.Flags().BoolVar(&initArgs.TestTssKeysign, "test-tss", false, "set to to true to run a check for TSS keysign on startup")
-	InitCmd.Flags().StringVar(&initArgs.KeyringBackend, "keyring-backend", string(config.KeyringBackendTest), "keyring backend to use (test, file)")
+	InitCmd.Flags().StringVar(&initArgs.KeyringBackend, "keyring-backend", string(config.KeyringBackendTest), "keyring backend to use (test, file, remote)")
 	InitCmd.Flags().BoolVar(&initArgs.HsmMode, "hsm-mode", false, "enable hsm signer, default disabled")
 	InitCmd.Flags().StringVar(&initArgs.HsmHotKey, "hsm-hotkey", "hsm-hotkey", "name of hotkey associated with hardware security module")
+	InitCmd.Flags().StringVar(&initArgs.RemoteSignerAddress, "remote-signer-address", "", "address of the remote signer with the remote keyring backend, e.g. tcp://127.0.0.1:26659 or unix:///var/run/signer.sock")
+	InitCmd.Flags().StringVar(&initArgs.RemoteSignerID, "remote-signer-id", "", "hex ID of the connection key of the remote signer")
+	InitCmd.Flags().StringVar(&initArgs.RemoteSignerClientKeyPath, "remote-signer-client-key", "", "path of the connection key authenticating zetaclient to the remote signer (default: config/remote_signer_key.json)")
+	InitCmd.Flags().Uint64Var(&initArgs.VoteBatchWindow, "vote-batch-window", 500, "window in milliseconds over which the votes are collected to be broadcasted in one tx (0 disables the batching)")
+	InitCmd.Flags().IntVar(&initArgs.VoteBatchMaxMsgs, "vote-batch-max-msgs", zetaclient.DefaultVoteBatchMaxMsgs, "maximum number of votes broadcasted in one tx")
+	InitCmd.Flags().StringVar(&initArgs.RemoteSignerTssKeyringBackend, "remote-signer-tss-keyring-backend", string(config.KeyringBackendTest), "keyring backend of the local TSS p2p key with the remote keyring backend (test, file)")
 }
 
 func Initialize(_ *cobra.Command, _ []string) error {
@@ -95,6 +110,14 @@ func Initialize(_ *cobra.Command, _ []string) error {
 	configData.KeyringBackend = config.KeyringBackend(initArgs.KeyringBackend)
 	configData.HsmMode = initArgs.HsmMode
 	configData.HsmHotKey = initArgs.HsmHotKey
+	configData.VoteBatchWindow = initArgs.VoteBatchWindow
+	configData.VoteBatchMaxMsgs = initArgs.VoteBatchMaxMsgs
+	configData.RemoteSigner = config.RemoteSignerConfig{
+		Address:           initArgs.RemoteSignerAddress,
+		SignerID:          initArgs.RemoteSignerID,
+		ClientKeyPath:     initArgs.RemoteSignerClientKeyPath,
+		TssKeyringBackend: config.KeyringBackend(initArgs.RemoteSignerTssKeyringBackend),
+	}
 
 	//Save config file
 	return config.Save(&configData, rootArgs.zetaCoreHome)
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/zetaclient"
+)
+
+var SigningJournalCmd = &cobra.Command{
+	Use:   "signing-journal",
+	Short: "Show the outbound txs signed by the node and their broadcast attempts",
+	RunE:  ShowSigningJournal,
+}
+
+type signingJournalArguments struct {
+	dbPath  string
+	chainID int64
+}
+
+var signingJournalArgs = signingJournalArguments{}
+
+func init() {
+	RootCmd.AddCommand(SigningJournalCmd)
+
+	SigningJournalCmd.Flags().StringVar(&signingJournalArgs.dbPath, "db-path", "", "chain observer db directory (default $HOME/.zetaclient/chainobserver)")
+	SigningJournalCmd.Flags().Int64Var(&signingJournalArgs.chainID, "chain-id", 0, "only show the txs of this chain")
+}
+
+func ShowSigningJournal(_ *cobra.Command, _ []string) error {
+	dbpath := signingJournalArgs.dbPath
+	if dbpath == "" {
+		userDir, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
+		dbpath = filepath.Join(userDir, ".zetaclient/chainobserver")
+	}
+	if _, err := os.Stat(filepath.Join(dbpath, zetaclient.SigningJournalDBName)); err != nil {
+		return fmt.Errorf("no signing journal in %s: %w", dbpath, err)
+	}
+
+	journal, err := zetaclient.NewSigningJournal(dbpath)
+	if err != nil {
+		return err
+	}
+	entries, err := journal.ListSignedTxs(signingJournalArgs.chainID)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHAIN\tNONCE\tCCTX\tTX HASH\tGAS PRICE\tSIGNED AT\tBROADCASTS\tLAST BROADCAST")
+	for _, entry := range entries {
+		lastBroadcast := "-"
+		if entry.LastBroadcastTime > 0 {
+			lastBroadcast = time.Unix(entry.LastBroadcastTime, 0).UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			entry.ChainID,
+			entry.Nonce,
+			entry.CctxIndex,
+			entry.TxHash,
+			entry.GasPrice,
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			entry.BroadcastAttempts,
+			lastBroadcast,
+		)
+	}
+	return w.Flush()
+}
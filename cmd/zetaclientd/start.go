@@ -105,6 +105,12 @@ func start(_ *cobra.Command, _ []string) error {
 	CreateAuthzSigner(zetaBridge.GetKeys().GetOperatorAddress().String(), zetaBridge.GetKeys().GetAddress())
 	startLogger.Debug().Msgf("CreateAuthzSigner is ready")
 
+	// VoteAggregator: the votes are collected over a short window and broadcasted in one tx to share the fees and the account sequence
+	if cfg.VoteBatchWindow > 0 {
+		// #nosec G701 always in range
+		zetaBridge.EnableVoteAggregator(time.Duration(cfg.VoteBatchWindow)*time.Millisecond, cfg.VoteBatchMaxMsgs)
+	}
+
 	// Initialize core parameters from zetacore
 	err = zetaBridge.UpdateConfigFromCore(cfg, true)
 	if err != nil {
@@ -118,11 +124,13 @@ func start(_ *cobra.Command, _ []string) error {
 
 	// Generate TSS address . The Tss address is generated through Keygen ceremony. The TSS key is used to sign all outbound transactions .
 	// The bridgePk is private key for the Hotkey. The Hotkey is used to sign all inbound transactions
+	// With the remote keyring backend the Hotkey is held by the remote signer and the bridgePk is the local TSS p2p key
 	// Each node processes a portion of the key stored in ~/.tss by default . Custom location can be specified in config file during init.
 	// After generating the key , the address is set on the zetacore
 	bridgePk, err := zetaBridge.GetKeys().GetPrivateKey()
 	if err != nil {
 		startLogger.Error().Err(err).Msg("zetabridge getPrivateKey error")
+		return err
 	}
 	startLogger.Debug().Msgf("bridgePk %s", bridgePk.String())
 	if len(bridgePk.Bytes()) != 32 {
@@ -241,8 +249,15 @@ func start(_ *cobra.Command, _ []string) error {
 		}
 	}
 
+	// NewSigningJournal : The signing journal persists the signed outbound txs, so they are re-broadcasted instead of signed again after a restart
+	signingJournal, err := mc.NewSigningJournal(dbpath)
+	if err != nil {
+		startLogger.Err(err).Msg("NewSigningJournal")
+		return err
+	}
+
 	// CreateCoreObserver : Core observer wraps the zetacore bridge and adds the client and signer maps to it . This is the high level object used for CCTX interactions
-	mo1 := mc.NewCoreObserver(zetaBridge, signerMap, chainClientMap, metrics, masterLogger, cfg, telemetryServer)
+	mo1 := mc.NewCoreObserver(zetaBridge, signerMap, chainClientMap, metrics, masterLogger, cfg, telemetryServer, signingJournal)
 	mo1.MonitorCore()
 
 	// start zeta supply checker
@@ -259,6 +274,30 @@ func start(_ *cobra.Command, _ []string) error {
 	//	defer zetaSupplyChecker.Stop()
 	//}
 
+	// start zrc20 supply checker : it reconciles the supply of every zrc20 with the assets held on its chain and votes the inbound halt of a chain on a confirmed deficit
+	if isNodeActive {
+		zrc20SupplyChecker, err := mc.NewZRC20SupplyChecker(zetaBridge, chainClientMap, metrics, masterLogger)
+		if err != nil {
+			startLogger.Err(err).Msg("NewZRC20SupplyChecker")
+		}
+		if err == nil {
+			go zrc20SupplyChecker.Start()
+			defer zrc20SupplyChecker.Stop()
+		}
+	}
+
+	// start tss balance voter : it votes the balances of the current tss migrated to the new tss once a new tss is finalized
+	if isNodeActive {
+		tssBalanceVoter, err := mc.NewTssBalanceVoter(zetaBridge, chainClientMap, masterLogger)
+		if err != nil {
+			startLogger.Err(err).Msg("NewTssBalanceVoter")
+		}
+		if err == nil {
+			go tssBalanceVoter.Start()
+			defer tssBalanceVoter.Stop()
+		}
+	}
+
 	startLogger.Info().Msgf("awaiting the os.Interrupt, syscall.SIGTERM signals...")
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
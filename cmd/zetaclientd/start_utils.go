@@ -64,26 +64,38 @@ func maskCfg(cfg *config.Config) string {
 		RPCPassword: cfg.BitcoinConfig.RPCPassword,
 		RPCHost:     cfg.BitcoinConfig.RPCHost,
 		RPCParams:   cfg.BitcoinConfig.RPCParams,
+		RPCHosts:    cfg.BitcoinConfig.RPCHosts,
+		RBFBlocks:   cfg.BitcoinConfig.RBFBlocks,
 	}
 	maskedCfg.EVMChainConfigs = map[int64]*config.EVMConfig{}
 	for key, val := range cfg.EVMChainConfigs {
 		maskedCfg.EVMChainConfigs[key] = &config.EVMConfig{
-			CoreParams: val.CoreParams,
-			Chain:      val.Chain,
-			Endpoint:   val.Endpoint,
+			CoreParams:     val.CoreParams,
+			Chain:          val.Chain,
+			Endpoint:       val.Endpoint,
+			Endpoints:      make([]string, len(val.Endpoints)),
+			EndpointQuorum: val.EndpointQuorum,
+			ForceLegacyTx:  val.ForceLegacyTx,
 		}
+		copy(maskedCfg.EVMChainConfigs[key].Endpoints, val.Endpoints)
 	}
 
 	// Mask Sensitive data
+	maskEndpoint := func(endpoint string) string {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			return endpoint
+		}
+		return endpointURL.Hostname()
+	}
 	for _, chain := range maskedCfg.EVMChainConfigs {
-		if chain.Endpoint == "" {
-			continue
+		for i, endpoint := range chain.Endpoints {
+			chain.Endpoints[i] = maskEndpoint(endpoint)
 		}
-		endpointURL, err := url.Parse(chain.Endpoint)
-		if err != nil {
+		if chain.Endpoint == "" {
 			continue
 		}
-		chain.Endpoint = endpointURL.Hostname()
+		chain.Endpoint = maskEndpoint(chain.Endpoint)
 	}
 
 	maskedCfg.BitcoinConfig.RPCUsername = ""
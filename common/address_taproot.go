@@ -0,0 +1,95 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// taprootWitnessVersion is the segwit version of pay-to-taproot outputs (BIP 341)
+const taprootWitnessVersion = 0x01
+
+// AddressTaproot is an Address for a pay-to-taproot (P2TR) output. See BIP 341 for further details.
+// The btcutil version in use predates taproot and bech32m (BIP 350), so the type is implemented here.
+type AddressTaproot struct {
+	hrp            string
+	witnessProgram [32]byte
+}
+
+var _ btcutil.Address = &AddressTaproot{}
+
+// NewAddressTaproot returns a new AddressTaproot from a 32-byte witness program
+func NewAddressTaproot(witnessProg []byte, net *chaincfg.Params) (*AddressTaproot, error) {
+	if len(witnessProg) != 32 {
+		return nil, fmt.Errorf("witness program must be 32 bytes for p2tr, got %d", len(witnessProg))
+	}
+	addr := &AddressTaproot{hrp: strings.ToLower(net.Bech32HRPSegwit)}
+	copy(addr.witnessProgram[:], witnessProg)
+	return addr, nil
+}
+
+// DecodeTaprootAddress decodes a bech32m encoded P2TR address
+func DecodeTaprootAddress(addr string) (*AddressTaproot, error) {
+	hrp, data, version, err := bech32.DecodeGeneric(addr)
+	if err != nil {
+		return nil, err
+	}
+	if version != bech32.VersionM {
+		return nil, fmt.Errorf("invalid checksum, expected bech32m encoding for address %s", addr)
+	}
+	if len(data) < 1 || data[0] != taprootWitnessVersion {
+		return nil, fmt.Errorf("invalid witness version for taproot address %s", addr)
+	}
+	witnessProg, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(witnessProg) != 32 {
+		return nil, fmt.Errorf("invalid witness program length %d for taproot address %s", len(witnessProg), addr)
+	}
+	taproot := &AddressTaproot{hrp: hrp}
+	copy(taproot.witnessProgram[:], witnessProg)
+	return taproot, nil
+}
+
+// EncodeAddress returns the bech32m string encoding of the address
+func (a *AddressTaproot) EncodeAddress() string {
+	converted, err := bech32.ConvertBits(a.witnessProgram[:], 8, 5, true)
+	if err != nil {
+		return ""
+	}
+	data := append([]byte{taprootWitnessVersion}, converted...)
+	encoded, err := bech32.EncodeM(a.hrp, data)
+	if err != nil {
+		return ""
+	}
+	return encoded
+}
+
+// ScriptAddress returns the witness program of the address
+func (a *AddressTaproot) ScriptAddress() []byte {
+	return a.witnessProgram[:]
+}
+
+// IsForNet returns whether the address is associated with the passed bitcoin network
+func (a *AddressTaproot) IsForNet(net *chaincfg.Params) bool {
+	return a.hrp == net.Bech32HRPSegwit
+}
+
+// String returns a human-readable string for the address
+func (a *AddressTaproot) String() string {
+	return a.EncodeAddress()
+}
+
+// WitnessVersion returns the witness version of the address
+func (a *AddressTaproot) WitnessVersion() byte {
+	return taprootWitnessVersion
+}
+
+// WitnessProgram returns the witness program of the address
+func (a *AddressTaproot) WitnessProgram() []byte {
+	return a.witnessProgram[:]
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressTaproot(t *testing.T) {
+	// BIP-350 test vector
+	// https://github.com/bitcoin/bips/blob/master/bip-0350.mediawiki#test-vectors-for-v0-v16-native-segregated-witness-addresses
+	t.Run("should decode and encode mainnet P2TR address", func(t *testing.T) {
+		addrStr := "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"
+		addr, err := DecodeTaprootAddress(addrStr)
+		require.NoError(t, err)
+		require.Equal(t, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", hex.EncodeToString(addr.WitnessProgram()))
+		require.Equal(t, byte(1), addr.WitnessVersion())
+		require.True(t, addr.IsForNet(&chaincfg.MainNetParams))
+		require.False(t, addr.IsForNet(&chaincfg.TestNet3Params))
+		require.Equal(t, addrStr, addr.EncodeAddress())
+		require.Equal(t, addrStr, addr.String())
+	})
+
+	t.Run("should round trip regtest P2TR address", func(t *testing.T) {
+		program, err := hex.DecodeString("1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262")
+		require.NoError(t, err)
+		addr, err := NewAddressTaproot(program, &chaincfg.RegressionNetParams)
+		require.NoError(t, err)
+		require.Equal(t, "bcrt1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qg74mmc", addr.EncodeAddress())
+		require.Equal(t, program, addr.ScriptAddress())
+
+		decoded, err := DecodeTaprootAddress(addr.EncodeAddress())
+		require.NoError(t, err)
+		require.Equal(t, addr, decoded)
+		require.True(t, decoded.IsForNet(&chaincfg.RegressionNetParams))
+	})
+
+	t.Run("should fail to create address with invalid witness program length", func(t *testing.T) {
+		_, err := NewAddressTaproot(make([]byte, 20), &chaincfg.MainNetParams)
+		require.Error(t, err)
+	})
+
+	t.Run("should fail to decode non-taproot segwit addresses", func(t *testing.T) {
+		// P2WPKH uses bech32 rather than bech32m
+		_, err := DecodeTaprootAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+		require.Error(t, err)
+		// witness v1 with bech32 checksum
+		_, err = DecodeTaprootAddress("bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd")
+		require.Error(t, err)
+		// witness v2 address
+		_, err = DecodeTaprootAddress("bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs")
+		require.Error(t, err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
 )
 
 var (
@@ -25,3 +26,37 @@ func BitcoinNetParamsFromChainID(chainID int64) (*chaincfg.Params, error) {
 		return nil, fmt.Errorf("no Bitcoin net params for chain ID: %d", chainID)
 	}
 }
+
+// DecodeBtcAddress decodes a BTC address for the given chain ID, taproot addresses included
+func DecodeBtcAddress(inputAddress string, chainID int64) (btcutil.Address, error) {
+	chainParams, err := GetBTCChainParams(chainID)
+	if err != nil {
+		return nil, err
+	}
+	address, err := btcutil.DecodeAddress(inputAddress, chainParams)
+	if err != nil {
+		// btcutil.DecodeAddress doesn't support bech32m, fall back to taproot decoding
+		taproot, errTaproot := DecodeTaprootAddress(inputAddress)
+		if errTaproot != nil {
+			return nil, fmt.Errorf("decode address failed: %s, for input address %s", err.Error(), inputAddress)
+		}
+		address = taproot
+	}
+	if !address.IsForNet(chainParams) {
+		return nil, fmt.Errorf("address %s is not for network %s", inputAddress, chainParams.Name)
+	}
+	return address, nil
+}
+
+// IsBtcAddressSupported returns true if the given BTC address type is supported as a withdrawal receiver
+func IsBtcAddressSupported(addr btcutil.Address) bool {
+	switch addr.(type) {
+	case *AddressTaproot,
+		*btcutil.AddressWitnessScriptHash,
+		*btcutil.AddressWitnessPubKeyHash,
+		*btcutil.AddressScriptHash,
+		*btcutil.AddressPubKeyHash:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBtcAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		chainID int64
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "regtest P2TR",
+			addr:    "bcrt1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qg74mmc",
+			chainID: BtcRegtestChain().ChainId,
+			want:    &AddressTaproot{},
+		},
+		{
+			name:    "regtest P2WSH",
+			addr:    "bcrt1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qzf4jry",
+			chainID: BtcRegtestChain().ChainId,
+			want:    &btcutil.AddressWitnessScriptHash{},
+		},
+		{
+			name:    "regtest P2WPKH",
+			addr:    "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080",
+			chainID: BtcRegtestChain().ChainId,
+			want:    &btcutil.AddressWitnessPubKeyHash{},
+		},
+		{
+			name:    "regtest P2SH",
+			addr:    "2N3vVYSK5XRgVSGWy21PnsRmBUywSQNdCsf",
+			chainID: BtcRegtestChain().ChainId,
+			want:    &btcutil.AddressScriptHash{},
+		},
+		{
+			name:    "regtest P2PKH",
+			addr:    "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r",
+			chainID: BtcRegtestChain().ChainId,
+			want:    &btcutil.AddressPubKeyHash{},
+		},
+		{
+			name:    "testnet P2TR",
+			addr:    "tb1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q98lawz",
+			chainID: BtcTestNetChain().ChainId,
+			want:    &AddressTaproot{},
+		},
+		{
+			name:    "mainnet P2TR",
+			addr:    "bc1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qj0fj5d",
+			chainID: BtcMainnetChain().ChainId,
+			want:    &AddressTaproot{},
+		},
+		{
+			name:    "mainnet P2WSH",
+			addr:    "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3",
+			chainID: BtcMainnetChain().ChainId,
+			want:    &btcutil.AddressWitnessScriptHash{},
+		},
+		{
+			name:    "mainnet P2SH",
+			addr:    "3CNHUhP3uyB9EUtRLsmvFUmvGdjGdkTxJw",
+			chainID: BtcMainnetChain().ChainId,
+			want:    &btcutil.AddressScriptHash{},
+		},
+		{
+			name:    "mainnet P2PKH",
+			addr:    "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			chainID: BtcMainnetChain().ChainId,
+			want:    &btcutil.AddressPubKeyHash{},
+		},
+		{
+			name:    "should fail for mainnet P2TR on regtest",
+			addr:    "bc1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qj0fj5d",
+			chainID: BtcRegtestChain().ChainId,
+			wantErr: true,
+		},
+		{
+			name:    "should fail for mainnet P2PKH on testnet",
+			addr:    "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			chainID: BtcTestNetChain().ChainId,
+			wantErr: true,
+		},
+		{
+			name:    "should fail for invalid address",
+			addr:    "bcrt1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qg74mmd",
+			chainID: BtcRegtestChain().ChainId,
+			wantErr: true,
+		},
+		{
+			name:    "should fail for non-bitcoin chain",
+			addr:    "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080",
+			chainID: EthChain().ChainId,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := DecodeBtcAddress(tc.addr, tc.chainID)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tc.want, addr)
+			require.Equal(t, tc.addr, addr.EncodeAddress())
+			require.True(t, IsBtcAddressSupported(addr))
+		})
+	}
+}
+
+func TestIsBtcAddressSupported(t *testing.T) {
+	// P2PK addresses are not supported as withdrawal receivers
+	pubKey := []byte{
+		0x02, 0x19, 0x2d, 0x74, 0xd0, 0xcb, 0x94, 0x34, 0x4c, 0x95, 0x69, 0xc2,
+		0xe7, 0x79, 0x01, 0x57, 0x3d, 0x8d, 0x79, 0x03, 0xc3, 0xeb, 0xec, 0x3a,
+		0x95, 0x77, 0x24, 0x89, 0x5d, 0xca, 0x52, 0xc6, 0xb4,
+	}
+	addr, err := btcutil.NewAddressPubKey(pubKey, BitcoinRegnetParams)
+	require.NoError(t, err)
+	require.False(t, IsBtcAddressSupported(addr))
+}
@@ -38,7 +38,7 @@ func (chain Chain) IsExternalChain() bool {
 
 // EncodeAddress bytes representations of address
 // on EVM chain, it is 20Bytes
-// on Bitcoin chain, it is P2WPKH address, []byte(bech32 encoded string)
+// on Bitcoin chain, it is a standard address (P2TR, P2WSH, P2WPKH, P2SH or P2PKH), []byte(encoded string)
 func (chain Chain) EncodeAddress(b []byte) (string, error) {
 	if IsEVMChain(chain.ChainId) {
 		addr := ethcommon.BytesToAddress(b)
@@ -48,17 +48,10 @@ func (chain Chain) EncodeAddress(b []byte) (string, error) {
 		return addr.Hex(), nil
 	} else if IsBitcoinChain(chain.ChainId) {
 		addrStr := string(b)
-		chainParams, err := GetBTCChainParams(chain.ChainId)
+		_, err := DecodeBtcAddress(addrStr, chain.ChainId)
 		if err != nil {
 			return "", err
 		}
-		addr, err := btcutil.DecodeAddress(addrStr, chainParams)
-		if err != nil {
-			return "", err
-		}
-		if !addr.IsForNet(chainParams) {
-			return "", fmt.Errorf("address is not for network %s", chainParams.Name)
-		}
 		return addrStr, nil
 	}
 	return "", fmt.Errorf("chain (%d) not supported", chain.ChainId)
@@ -210,6 +203,15 @@ func GetBTCChainParams(chainID int64) (*chaincfg.Params, error) {
 	}
 }
 
+// GetBTCBlocksPerRetarget returns the number of blocks between two difficulty retargets of a Bitcoin chain
+func GetBTCBlocksPerRetarget(chainID int64) (int64, error) {
+	chainParams, err := GetBTCChainParams(chainID)
+	if err != nil {
+		return 0, err
+	}
+	return int64(chainParams.TargetTimespan / chainParams.TargetTimePerBlock), nil
+}
+
 // InChainList checks whether the chain is in the chain list
 func (chain Chain) InChainList(chainList []*Chain) bool {
 	return ChainIDInChainList(chain.ChainId, chainList)
@@ -5,4 +5,7 @@ package common
 const (
 	CmdWhitelistERC20  = "cmd_whitelist_erc20"
 	CmdMigrateTssFunds = "cmd_migrate_tss_funds"
+
+	// CmdUpdateConnectorTssAddress updates the TSS address of the connector contract to the new TSS
+	CmdUpdateConnectorTssAddress = "cmd_update_connector_tss_address"
 )
@@ -5,9 +5,12 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
+	"sort"
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -54,6 +57,20 @@ func (h HeaderData) ParentHash() ([]byte, error) {
 	}
 }
 
+// Work returns the proof-of-work of the header, only Bitcoin headers carry a difficulty target
+func (h HeaderData) Work() (*big.Int, error) {
+	switch data := h.Data.(type) {
+	case *HeaderData_BitcoinHeader:
+		var header wire.BlockHeader
+		if err := header.Deserialize(bytes.NewReader(data.BitcoinHeader)); err != nil {
+			return nil, err
+		}
+		return blockchain.CalcWork(header.Bits), nil
+	default:
+		return nil, errors.New("work is only defined for bitcoin headers")
+	}
+}
+
 func (h HeaderData) ValidateTimestamp(zetaTime time.Time) error {
 	switch data := h.Data.(type) {
 	case *HeaderData_EthereumHeader:
@@ -165,3 +182,144 @@ func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainID int64)
 
 	return nil
 }
+
+// bitcoinMedianTimeBlocks is the number of previous headers the median time past of a Bitcoin header is computed from
+const bitcoinMedianTimeBlocks = 11
+
+// BitcoinHeaderGetter returns a stored Bitcoin header from its hash
+type BitcoinHeaderGetter func(hash chainhash.Hash) (*wire.BlockHeader, bool)
+
+// ValidateBitcoinHeaderDifficulty checks the Bits of a Bitcoin header match the target expected from its stored ancestors
+// It follows the 2016 blocks retarget rule and the minimum difficulty exception of testnet, regtest doesn't retarget
+// It fails if an ancestor required to compute the expected target is not stored
+func ValidateBitcoinHeaderDifficulty(headerBytes []byte, height int64, chainID int64, getHeader BitcoinHeaderGetter) error {
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+		return fmt.Errorf("cannot deserialize Bitcoin header (%s)", err)
+	}
+	chainParams, err := GetBTCChainParams(chainID)
+	if err != nil {
+		return fmt.Errorf("cannot get chain params (%s) for chain id (%d)", err, chainID)
+	}
+	parent, found := getHeader(header.PrevBlock)
+	if !found {
+		return fmt.Errorf("parent header %s not found", header.PrevBlock)
+	}
+
+	if chainID == BtcRegtestChain().ChainId {
+		if header.Bits != parent.Bits {
+			return fmt.Errorf("invalid bits %08x, expected %08x", header.Bits, parent.Bits)
+		}
+		return nil
+	}
+
+	blocksPerRetarget, err := GetBTCBlocksPerRetarget(chainID)
+	if err != nil {
+		return err
+	}
+	if height%blocksPerRetarget != 0 {
+		expectedBits := parent.Bits
+		if chainParams.ReduceMinDifficulty {
+			// a block mined after the reduction time can use the minimum difficulty,
+			// otherwise it uses the difficulty of the last block without the exception
+			if header.Timestamp.After(parent.Timestamp.Add(chainParams.MinDiffReductionTime)) {
+				expectedBits = chainParams.PowLimitBits
+			} else {
+				expectedBits, found = prevTestnetBits(parent, height-1, blocksPerRetarget, chainParams.PowLimitBits, getHeader)
+				if !found {
+					return fmt.Errorf("last header without the minimum difficulty exception before height %d not found", height)
+				}
+			}
+		}
+		if header.Bits != expectedBits {
+			return fmt.Errorf("invalid bits %08x, expected %08x", header.Bits, expectedBits)
+		}
+		return nil
+	}
+
+	// the retarget adjusts the target to the time taken by the previous period
+	first := parent
+	for i := int64(1); i < blocksPerRetarget; i++ {
+		first, found = getHeader(first.PrevBlock)
+		if !found {
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("first header of the retarget period before height %d not found", height)
+	}
+	expectedBits := bitcoinRetargetBits(parent.Bits, parent.Timestamp.Unix()-first.Timestamp.Unix(), chainParams)
+	if header.Bits != expectedBits {
+		return fmt.Errorf("invalid bits %08x, expected %08x", header.Bits, expectedBits)
+	}
+	return nil
+}
+
+// ValidateBitcoinHeaderMedianTime checks the timestamp of a Bitcoin header is after the median time of the previous 11 stored headers
+func ValidateBitcoinHeaderMedianTime(headerBytes []byte, getHeader BitcoinHeaderGetter) error {
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+		return fmt.Errorf("cannot deserialize Bitcoin header (%s)", err)
+	}
+
+	timestamps := make([]int64, 0, bitcoinMedianTimeBlocks)
+	prevHash := header.PrevBlock
+	for len(timestamps) < bitcoinMedianTimeBlocks {
+		ancestor, found := getHeader(prevHash)
+		if !found {
+			break
+		}
+		timestamps = append(timestamps, ancestor.Timestamp.Unix())
+		prevHash = ancestor.PrevBlock
+	}
+	if len(timestamps) == 0 {
+		return nil
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+	medianTime := time.Unix(timestamps[len(timestamps)/2], 0)
+	if !header.Timestamp.After(medianTime) {
+		return fmt.Errorf("block timestamp of %v is not after the median time past %v", header.Timestamp, medianTime)
+	}
+	return nil
+}
+
+// bitcoinRetargetBits returns the bits of the target adjusted to the timespan of the previous retarget period
+// the timespan is clamped to the maximum adjustment factor and the target to the proof-of-work limit
+func bitcoinRetargetBits(bits uint32, timespan int64, chainParams *chaincfg.Params) uint32 {
+	targetTimespan := int64(chainParams.TargetTimespan / time.Second)
+	minTimespan := targetTimespan / chainParams.RetargetAdjustmentFactor
+	maxTimespan := targetTimespan * chainParams.RetargetAdjustmentFactor
+	if timespan < minTimespan {
+		timespan = minTimespan
+	} else if timespan > maxTimespan {
+		timespan = maxTimespan
+	}
+
+	target := new(big.Int).Mul(blockchain.CompactToBig(bits), big.NewInt(timespan))
+	target.Div(target, big.NewInt(targetTimespan))
+	if target.Cmp(chainParams.PowLimit) > 0 {
+		target.Set(chainParams.PowLimit)
+	}
+	return blockchain.BigToCompact(target)
+}
+
+// prevTestnetBits returns the bits of the last block not mined with the minimum difficulty exception of testnet
+// it returns false if the search reaches a header that is not stored
+func prevTestnetBits(
+	header *wire.BlockHeader,
+	height int64,
+	blocksPerRetarget int64,
+	powLimitBits uint32,
+	getHeader BitcoinHeaderGetter,
+) (uint32, bool) {
+	for height%blocksPerRetarget != 0 && header.Bits == powLimitBits {
+		parent, found := getHeader(header.PrevBlock)
+		if !found {
+			return 0, false
+		}
+		header = parent
+		height--
+	}
+	return header.Bits, true
+}
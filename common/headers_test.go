@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"os"
@@ -225,3 +226,226 @@ func validateFakeBitcoinHeader(t *testing.T, header *wire.BlockHeader, headerByt
 	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], 18332)
 	require.Error(t, err)
 }
+
+type BitcoinHeaderFixture struct {
+	Height       int64  `json:"height"`
+	Hash         string `json:"hash"`
+	HeaderBase64 string `json:"headerBase64"`
+}
+
+// loadMainnetHeaders loads real Bitcoin mainnet headers
+func loadMainnetHeaders(t *testing.T) []*wire.BlockHeader {
+	file, err := os.Open("./test_data/btc_mainnet_headers.json")
+	require.NoError(t, err)
+	defer file.Close()
+
+	var fixtures struct {
+		Headers []BitcoinHeaderFixture `json:"headers"`
+	}
+	require.NoError(t, json.NewDecoder(file).Decode(&fixtures))
+
+	headers := make([]*wire.BlockHeader, 0, len(fixtures.Headers))
+	for _, fixture := range fixtures.Headers {
+		headerBytes, err := base64.StdEncoding.DecodeString(fixture.HeaderBase64)
+		require.NoError(t, err)
+		header := unmarshalHeader(t, headerBytes)
+		require.Equal(t, fixture.Hash, header.BlockHash().String())
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+// bitcoinHeaderStore returns a getter of the given headers
+func bitcoinHeaderStore(headers ...*wire.BlockHeader) common.BitcoinHeaderGetter {
+	store := make(map[chainhash.Hash]*wire.BlockHeader)
+	for _, header := range headers {
+		store[header.BlockHash()] = header
+	}
+	return func(hash chainhash.Hash) (*wire.BlockHeader, bool) {
+		header, found := store[hash]
+		return header, found
+	}
+}
+
+// bitcoinHeaderChain returns a chain of headers with the given bits mined at a fixed spacing
+func bitcoinHeaderChain(length int, bits uint32, start time.Time, spacing time.Duration) []*wire.BlockHeader {
+	headers := make([]*wire.BlockHeader, 0, length)
+	prevHash := chainhash.Hash{}
+	for i := 0; i < length; i++ {
+		header := &wire.BlockHeader{
+			Version:   1,
+			PrevBlock: prevHash,
+			Timestamp: start.Add(time.Duration(i) * spacing),
+			Bits:      bits,
+			Nonce:     uint32(i),
+		}
+		headers = append(headers, header)
+		prevHash = header.BlockHash()
+	}
+	return headers
+}
+
+func TestValidateBitcoinHeaderDifficulty(t *testing.T) {
+	mainnet := common.BtcMainnetChain().ChainId
+	testnet := common.BtcTestNetChain().ChainId
+
+	t.Run("mainnet header keeps the difficulty of its parent", func(t *testing.T) {
+		headers := loadMainnetHeaders(t)
+		getHeader := bitcoinHeaderStore(headers[0])
+
+		require.NoError(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, headers[1]), 1, mainnet, getHeader))
+
+		tampered := *headers[1]
+		tampered.Bits = 0x1c00ffff
+		require.Error(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &tampered), 1, mainnet, getHeader))
+	})
+
+	t.Run("fails if the parent is not stored", func(t *testing.T) {
+		headers := loadMainnetHeaders(t)
+		require.Error(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, headers[1]), 1, mainnet, bitcoinHeaderStore()))
+	})
+
+	t.Run("testnet header can use the minimum difficulty after the reduction time", func(t *testing.T) {
+		blocks := LoadTestBlocks(t)
+		headerBytes, err := base64.StdEncoding.DecodeString(blocks.Blocks[0].HeaderBase64)
+		require.NoError(t, err)
+		header := unmarshalHeader(t, headerBytes)
+		height := int64(blocks.Blocks[0].Height)
+		require.EqualValues(t, common.BitcoinTestnetParams.PowLimitBits, header.Bits)
+
+		parent := &wire.BlockHeader{Version: 1, Bits: 0x1a01aa3d}
+		getHeader := func(hash chainhash.Hash) (*wire.BlockHeader, bool) {
+			return parent, hash == header.PrevBlock
+		}
+
+		// mined more than 20 minutes after its parent
+		parent.Timestamp = header.Timestamp.Add(-21 * time.Minute)
+		require.NoError(t, common.ValidateBitcoinHeaderDifficulty(headerBytes, height, testnet, getHeader))
+
+		// mined within 20 minutes of a parent without the exception
+		parent.Timestamp = header.Timestamp.Add(-5 * time.Minute)
+		require.Error(t, common.ValidateBitcoinHeaderDifficulty(headerBytes, height, testnet, getHeader))
+
+		// mined within 20 minutes of a parent with the exception whose ancestors are not stored
+		parent.Bits = common.BitcoinTestnetParams.PowLimitBits
+		require.Error(t, common.ValidateBitcoinHeaderDifficulty(headerBytes, height, testnet, getHeader))
+	})
+
+	t.Run("testnet header uses the difficulty of the last block without the exception", func(t *testing.T) {
+		powLimitBits := common.BitcoinTestnetParams.PowLimitBits
+		chain := bitcoinHeaderChain(3, 0x1a01aa3d, time.Unix(1695860218, 0), 5*time.Minute)
+		chain[1].PrevBlock = chain[0].BlockHash()
+		chain[1].Bits = powLimitBits
+		chain[2].PrevBlock = chain[1].BlockHash()
+		chain[2].Bits = powLimitBits
+		getHeader := bitcoinHeaderStore(chain...)
+
+		header := wire.BlockHeader{
+			Version:   1,
+			PrevBlock: chain[2].BlockHash(),
+			Timestamp: chain[2].Timestamp.Add(5 * time.Minute),
+			Bits:      0x1a01aa3d,
+		}
+		require.NoError(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), 2505493, testnet, getHeader))
+
+		header.Bits = powLimitBits
+		require.Error(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), 2505493, testnet, getHeader))
+	})
+
+	t.Run("mainnet header retargets the difficulty every 2016 blocks", func(t *testing.T) {
+		bits := uint32(0x1b04864c)
+		retargetHeight := int64(2016 * 50)
+
+		for _, tc := range []struct {
+			name     string
+			spacing  time.Duration
+			timespan int64
+		}{
+			{"on schedule", 10 * time.Minute, 2015 * 600},
+			{"slower", 15 * time.Minute, 2015 * 900},
+			{"clamped to a fourth of the target timespan", time.Minute, 1209600 / 4},
+			{"clamped to four times the target timespan", time.Hour, 1209600 * 4},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				chain := bitcoinHeaderChain(2016, bits, time.Unix(1293623863, 0), tc.spacing)
+				parent := chain[len(chain)-1]
+				target := new(big.Int).Mul(blockchain.CompactToBig(bits), big.NewInt(tc.timespan))
+				target.Div(target, big.NewInt(1209600))
+
+				header := wire.BlockHeader{
+					Version:   1,
+					PrevBlock: parent.BlockHash(),
+					Timestamp: parent.Timestamp.Add(tc.spacing),
+					Bits:      blockchain.BigToCompact(target),
+				}
+				getHeader := bitcoinHeaderStore(chain...)
+				require.NoError(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), retargetHeight, mainnet, getHeader))
+
+				header.Bits = bits
+				require.Error(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), retargetHeight, mainnet, getHeader))
+			})
+		}
+	})
+
+	t.Run("mainnet retarget fails if the first block of the period is not stored", func(t *testing.T) {
+		bits := uint32(0x1b04864c)
+		chain := bitcoinHeaderChain(2016, bits, time.Unix(1293623863, 0), 10*time.Minute)
+		parent := chain[len(chain)-1]
+		target := new(big.Int).Mul(blockchain.CompactToBig(bits), big.NewInt(2015*600))
+		target.Div(target, big.NewInt(1209600))
+		header := wire.BlockHeader{
+			Version:   1,
+			PrevBlock: parent.BlockHash(),
+			Timestamp: parent.Timestamp.Add(10 * time.Minute),
+			Bits:      blockchain.BigToCompact(target),
+		}
+
+		require.NoError(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), 2016*50, mainnet, bitcoinHeaderStore(chain...)))
+		require.Error(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), 2016*50, mainnet, bitcoinHeaderStore(chain[1:]...)))
+	})
+
+	t.Run("regtest header keeps the difficulty of its parent", func(t *testing.T) {
+		regtest := common.BtcRegtestChain().ChainId
+		powLimitBits := common.BitcoinRegnetParams.PowLimitBits
+		chain := bitcoinHeaderChain(1, powLimitBits, time.Unix(1695860218, 0), time.Minute)
+		getHeader := bitcoinHeaderStore(chain...)
+
+		header := wire.BlockHeader{Version: 1, PrevBlock: chain[0].BlockHash(), Timestamp: time.Unix(1695860278, 0), Bits: powLimitBits}
+		require.NoError(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), 2016, regtest, getHeader))
+
+		header.Bits = 0x1d00ffff
+		require.Error(t, common.ValidateBitcoinHeaderDifficulty(marshalHeader(t, &header), 2016, regtest, getHeader))
+	})
+}
+
+func TestValidateBitcoinHeaderMedianTime(t *testing.T) {
+	t.Run("mainnet header is after the median time past", func(t *testing.T) {
+		headers := loadMainnetHeaders(t)
+		getHeader := bitcoinHeaderStore(headers[0])
+		require.NoError(t, common.ValidateBitcoinHeaderMedianTime(marshalHeader(t, headers[1]), getHeader))
+
+		tampered := *headers[1]
+		tampered.Timestamp = headers[0].Timestamp
+		require.Error(t, common.ValidateBitcoinHeaderMedianTime(marshalHeader(t, &tampered), getHeader))
+	})
+
+	t.Run("timestamp must exceed the median of the previous 11 headers", func(t *testing.T) {
+		// the 11 last headers of the chain are 10 minutes apart, their median is the 6th of them
+		chain := bitcoinHeaderChain(15, 0x1d00ffff, time.Unix(1231006505, 0), 10*time.Minute)
+		parent := chain[len(chain)-1]
+		median := chain[len(chain)-6].Timestamp
+		getHeader := bitcoinHeaderStore(chain...)
+
+		header := wire.BlockHeader{Version: 1, PrevBlock: parent.BlockHash(), Timestamp: median, Bits: 0x1d00ffff}
+		require.Error(t, common.ValidateBitcoinHeaderMedianTime(marshalHeader(t, &header), getHeader))
+
+		// a header can be older than its parent as long as it's after the median time past
+		header.Timestamp = median.Add(time.Second)
+		require.NoError(t, common.ValidateBitcoinHeaderMedianTime(marshalHeader(t, &header), getHeader))
+	})
+
+	t.Run("skipped if no ancestor is stored", func(t *testing.T) {
+		headers := loadMainnetHeaders(t)
+		require.NoError(t, common.ValidateBitcoinHeaderMedianTime(marshalHeader(t, headers[0]), bitcoinHeaderStore()))
+	})
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/zeta-chain/zetacore/rpc/namespaces/ethereum/personal"
 	"github.com/zeta-chain/zetacore/rpc/namespaces/ethereum/txpool"
 	"github.com/zeta-chain/zetacore/rpc/namespaces/ethereum/web3"
+	"github.com/zeta-chain/zetacore/rpc/namespaces/zeta"
 )
 
 // RPC namespaces and API version
@@ -50,6 +51,10 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// ZetaChain namespaces
+
+	ZetaNamespace = "zeta"
+
 	apiVersion = "1.0"
 )
 
@@ -168,6 +173,22 @@ func init() {
 				},
 			}
 		},
+		ZetaNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: ZetaNamespace,
+					Version:   apiVersion,
+					Service:   zeta.NewPublicAPI(ctx.Logger, clientCtx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -0,0 +1,275 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zeta-chain/zetacore/rpc/ethereum/pubsub"
+	"github.com/zeta-chain/zetacore/rpc/types"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+const (
+	// maxCctxReplayBlocks is the maximum number of blocks replayed when a cctx subscription resumes after a height
+	maxCctxReplayBlocks = 10000
+
+	// cctxEventsBufferSize is the number of tx and block events buffered while the cctxs of previous events are queried
+	cctxEventsBufferSize = 1000
+)
+
+// CctxNotification is the result of a notification of a cctx subscription
+type CctxNotification struct {
+	Height int64           `json:"height"`
+	Event  string          `json:"event,omitempty"`
+	Status string          `json:"status,omitempty"`
+	Cctx   json.RawMessage `json:"cctx,omitempty"`
+	// Error is set if the events of the height can't be replayed, the subscription is closed after this notification
+	Error string `json:"error,omitempty"`
+}
+
+// subscribeZeta creates a subscription of the zeta_subscribe method
+func (api *pubSubAPI) subscribeZeta(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+	method, ok := params[0].(string)
+	if !ok {
+		return nil, errors.New("invalid parameters")
+	}
+
+	switch method {
+	case "cctx":
+		if len(params) > 1 {
+			return api.subscribeCctx(wsConn, subID, params[1])
+		}
+		return api.subscribeCctx(wsConn, subID, nil)
+	default:
+		return nil, errors.Errorf("unsupported method %s", method)
+	}
+}
+
+// subscribeCctx streams the lifecycle changes of the cctxs matching the criteria
+// if a height is given in the criteria, the changes after this height are replayed before the new changes are streamed
+func (api *pubSubAPI) subscribeCctx(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	filter, err := types.ParseCctxFilter(extra)
+	if err != nil {
+		api.logger.Debug("invalid criteria", "error", err.Error())
+		return nil, err
+	}
+
+	sub, txUnsubFn, err := api.events.SubscribeCctxEvents()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating cctx filter")
+	}
+	// the events of the begin and end blockers are carried by the block header events
+	headerSub, headerUnsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		txUnsubFn()
+		return nil, errors.Wrap(err, "error creating block filter")
+	}
+	unsubFn := func() {
+		txUnsubFn()
+		headerUnsubFn()
+	}
+
+	// the events up to the latest height are replayed, the streamed events until this height are skipped
+	var latestHeight int64
+	if filter.FromHeight > 0 {
+		status, err := api.clientCtx.Client.Status(context.Background())
+		if err != nil {
+			unsubFn()
+			return nil, errors.Wrap(err, "failed to get latest height")
+		}
+		latestHeight = status.SyncInfo.LatestBlockHeight
+		if latestHeight-filter.FromHeight > maxCctxReplayBlocks {
+			unsubFn()
+			return nil, fmt.Errorf("cannot replay more than %d blocks", maxCctxReplayBlocks)
+		}
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			unsubFn()
+			close(done)
+		})
+	}
+
+	// buffer the streamed events so they are not dropped while the cctxs are queried
+	eventsCh := make(chan coretypes.ResultEvent, cctxEventsBufferSize)
+	go func() {
+		defer close(eventsCh)
+		ch, headerCh := sub.Event(), headerSub.Event()
+		errCh, headerErrCh := sub.Err(), headerSub.Err()
+		for {
+			var event coretypes.ResultEvent
+			var ok bool
+			select {
+			case event, ok = <-ch:
+			case event, ok = <-headerCh:
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Cctx WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				continue
+			case err, ok := <-headerErrCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Cctx WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				continue
+			case <-done:
+				return
+			}
+			if !ok {
+				return
+			}
+			select {
+			case eventsCh <- event:
+			default:
+				api.logger.Debug("dropped event during lagging cctx subscription", "subscription-id", subID)
+			}
+		}
+	}()
+
+	go func() {
+		for height := filter.FromHeight + 1; height <= latestHeight; height++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			h := height
+			blockResults, err := api.clientCtx.Client.BlockResults(context.Background(), &h)
+			if err != nil {
+				// the events of the height would be missed, the subscriber is notified and must resubscribe
+				api.logger.Error("failed to get block results", "height", height, "error", err.Error())
+				api.sendCctxError(wsConn, subID, height, errors.Wrap(err, "failed to get block results"))
+				unsubscribe()
+				return
+			}
+			if !api.sendCctxEvents(wsConn, subID, filter, types.ParseBlockCctxEvents(blockResults)) {
+				unsubscribe()
+				return
+			}
+		}
+
+		for event := range eventsCh {
+			var cctxEvents []types.CctxEvent
+			switch data := event.Data.(type) {
+			case tmtypes.EventDataTx:
+				if data.Height <= latestHeight {
+					continue
+				}
+				cctxEvents = types.ParseCctxEvents(data.Height, data.Result.Events)
+			case tmtypes.EventDataNewBlockHeader:
+				if data.Header.Height <= latestHeight {
+					continue
+				}
+				cctxEvents = append(
+					types.ParseCctxEvents(data.Header.Height, data.ResultBeginBlock.Events),
+					types.ParseCctxEvents(data.Header.Height, data.ResultEndBlock.Events)...,
+				)
+			default:
+				api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
+				continue
+			}
+			if !api.sendCctxEvents(wsConn, subID, filter, cctxEvents) {
+				unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return unsubscribe, nil
+}
+
+// sendCctxEvents writes the events of the cctxs matching the filter to the websocket connection
+// it returns false if the connection has been dropped
+func (api *pubSubAPI) sendCctxEvents(wsConn *wsConn, subID rpc.ID, filter types.CctxFilter, events []types.CctxEvent) bool {
+	for _, event := range events {
+		cctx, err := api.queryCctx(event)
+		if err != nil {
+			api.logger.Debug("failed to query cctx", "cctx-index", event.CctxIndex, "error", err.Error())
+			continue
+		}
+		if !filter.Matches(*cctx) {
+			continue
+		}
+		cctxJSON, err := api.clientCtx.Codec.MarshalJSON(cctx)
+		if err != nil {
+			api.logger.Error("failed to marshal cctx", "cctx-index", event.CctxIndex, "error", err.Error())
+			continue
+		}
+
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "zeta_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result: &CctxNotification{
+					Height: event.Height,
+					Event:  event.Event,
+					Status: event.Status,
+					Cctx:   cctxJSON,
+				},
+			},
+		}
+
+		err = wsConn.WriteJSON(res)
+		if err != nil {
+			api.logger.Debug("error writing cctx, will drop peer", "error", err.Error())
+
+			try(func() {
+				if !errors.Is(err, websocket.ErrCloseSent) {
+					err = wsConn.Close()
+					if err != nil {
+						api.logger.Debug("error closing websocket peer", "error", err.Error())
+					}
+				}
+			}, api.logger, "closing websocket peer sub")
+			return false
+		}
+	}
+	return true
+}
+
+// sendCctxError writes the error of a cctx subscription to the websocket connection
+func (api *pubSubAPI) sendCctxError(wsConn *wsConn, subID rpc.ID, height int64, err error) {
+	res := &SubscriptionNotification{
+		Jsonrpc: "2.0",
+		Method:  "zeta_subscription",
+		Params: &SubscriptionResult{
+			Subscription: subID,
+			Result: &CctxNotification{
+				Height: height,
+				Error:  err.Error(),
+			},
+		},
+	}
+	if err := wsConn.WriteJSON(res); err != nil {
+		api.logger.Debug("error writing cctx subscription error", "error", err.Error())
+	}
+}
+
+// queryCctx queries the cctx of the event at the height of the event, or at the latest height if the state is pruned
+func (api *pubSubAPI) queryCctx(event types.CctxEvent) (*crosschaintypes.CrossChainTx, error) {
+	queryClient := crosschaintypes.NewQueryClient(api.clientCtx)
+	req := &crosschaintypes.QueryGetCctxRequest{Index: event.CctxIndex}
+
+	res, err := queryClient.Cctx(types.ContextWithHeight(event.Height), req)
+	if err != nil {
+		res, err = queryClient.Cctx(context.Background(), req)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res.CrossChainTx, nil
+}
@@ -230,6 +230,22 @@ func (es EventSystem) SubscribePendingTxs() (*Subscription, pubsub.UnsubscribeFu
 	return es.subscribe(sub)
 }
 
+// SubscribeCctxEvents subscribes to the tx events carrying the crosschain typed events.
+// The crosschain events can't be selected by the query, the subscription shares the tx events topic
+// of the pending transactions subscriptions and the events are filtered by the subscriber.
+func (es EventSystem) SubscribeCctxEvents() (*Subscription, pubsub.UnsubscribeFunc, error) {
+	sub := &Subscription{
+		id:        rpc.NewID(),
+		typ:       filters.PendingTransactionsSubscription,
+		event:     txEvents,
+		created:   time.Now().UTC(),
+		hashes:    make(chan []common.Hash),
+		installed: make(chan struct{}, 1),
+		err:       make(chan error, 1),
+	}
+	return es.subscribe(sub)
+}
+
 type filterIndex map[filters.Type]map[rpc.ID]*Subscription
 
 // eventLoop (un)installs filters and processes mux events.
@@ -0,0 +1,189 @@
+package zeta
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	zrc20 "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/zrc20.sol"
+	zetacommon "github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/rpc/backend"
+	rpctypes "github.com/zeta-chain/zetacore/rpc/types"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PublicAPI is the zeta_ prefixed set of APIs exposing the cross-chain data of ZetaChain
+type PublicAPI struct {
+	logger           log.Logger
+	backend          backend.EVMBackend
+	crosschainClient crosschaintypes.QueryClient
+	fungibleClient   fungibletypes.QueryClient
+	observerClient   observertypes.QueryClient
+	zrc20ABI         *abi.ABI
+}
+
+// NewPublicAPI creates an instance of the public Zeta API.
+func NewPublicAPI(logger log.Logger, clientCtx client.Context, backend backend.EVMBackend) *PublicAPI {
+	zrc20ABI, err := zrc20.ZRC20MetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	return &PublicAPI{
+		logger:           logger.With("client", "json-rpc"),
+		backend:          backend,
+		crosschainClient: crosschaintypes.NewQueryClient(clientCtx),
+		fungibleClient:   fungibletypes.NewQueryClient(clientCtx),
+		observerClient:   observertypes.NewQueryClient(clientCtx),
+		zrc20ABI:         zrc20ABI,
+	}
+}
+
+// GetCctxByIndex returns the cctx with the given index, or nil if the cctx doesn't exist
+func (api *PublicAPI) GetCctxByIndex(index string) (*RPCCctx, error) {
+	api.logger.Debug("zeta_getCctxByIndex", "index", index)
+	res, err := api.crosschainClient.Cctx(context.Background(), &crosschaintypes.QueryGetCctxRequest{Index: index})
+	if status.Code(err) == codes.NotFound || status.Code(err) == codes.InvalidArgument {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewRPCCctx(*res.CrossChainTx), nil
+}
+
+// GetCctxsByInboundHash returns the cctxs created by the inbound tx with the given hash on the sender chain
+func (api *PublicAPI) GetCctxsByInboundHash(hash string) ([]*RPCCctx, error) {
+	api.logger.Debug("zeta_getCctxsByInboundHash", "hash", hash)
+	return api.getCctxsByInboundHash(hash)
+}
+
+// GetCctxsByZevmTxHash returns the cctxs created by the Withdrawal and ZetaSent logs of the zEVM tx with the given hash
+func (api *PublicAPI) GetCctxsByZevmTxHash(hash common.Hash) ([]*RPCCctx, error) {
+	api.logger.Debug("zeta_getCctxsByZevmTxHash", "hash", hash.Hex())
+	// the cctxs created on zEVM are indexed by the hash of the zEVM tx, including the cctxs of a deposit and call
+	// whose inbound hash is the index of the deposit cctx
+	return api.getCctxsByInboundHash(hash.Hex())
+}
+
+func (api *PublicAPI) getCctxsByInboundHash(hash string) ([]*RPCCctx, error) {
+	res, err := api.crosschainClient.InTxHashToCctxData(
+		context.Background(),
+		&crosschaintypes.QueryInTxHashToCctxDataRequest{InTxHash: hash},
+	)
+	cctxs := make([]*RPCCctx, 0)
+	if status.Code(err) == codes.NotFound {
+		return cctxs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, cctx := range res.CrossChainTxs {
+		cctxs = append(cctxs, NewRPCCctx(cctx))
+	}
+	return cctxs, nil
+}
+
+// GetWithdrawFee returns the fee of a withdrawal of the given ZRC20, paid in the gas ZRC20 of the foreign chain
+// the fee is returned by the withdrawGasFee method of the ZRC20 contract, as in the QueryWithdrawGasFee of the fungible module
+func (api *PublicAPI) GetWithdrawFee(zrc20Address common.Address) (*RPCWithdrawFee, error) {
+	api.logger.Debug("zeta_getWithdrawFee", "zrc20", zrc20Address.Hex())
+	input, err := api.zrc20ABI.Pack("withdrawGasFee")
+	if err != nil {
+		return nil, err
+	}
+	data := hexutil.Bytes(input)
+	res, err := api.backend.DoCall(evmtypes.TransactionArgs{
+		To:   &zrc20Address,
+		Data: &data,
+	}, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if res.Failed() {
+		return nil, fmt.Errorf("failed to call withdrawGasFee on %s: %s", zrc20Address.Hex(), res.VmError)
+	}
+
+	unpacked, err := api.zrc20ABI.Unpack("withdrawGasFee", res.Ret)
+	if err != nil {
+		return nil, err
+	}
+	if len(unpacked) < 2 {
+		return nil, fmt.Errorf("expect 2 returned values, got %d", len(unpacked))
+	}
+	gasZrc20, ok := unpacked[0].(common.Address)
+	if !ok {
+		return nil, errors.New("can't read returned value as address")
+	}
+	gasFee, ok := unpacked[1].(*big.Int)
+	if !ok {
+		return nil, errors.New("can't read returned value as big.Int")
+	}
+
+	return &RPCWithdrawFee{
+		GasZrc20Address: gasZrc20,
+		GasFee:          (*hexutil.Big)(gasFee),
+	}, nil
+}
+
+// GetForeignCoins returns the foreign coins supported on ZetaChain with their ZRC20
+func (api *PublicAPI) GetForeignCoins() ([]*RPCForeignCoin, error) {
+	api.logger.Debug("zeta_getForeignCoins")
+	foreignCoins := make([]*RPCForeignCoin, 0)
+	var nextKey []byte
+	for {
+		res, err := api.fungibleClient.ForeignCoinsAll(context.Background(), &fungibletypes.QueryAllForeignCoinsRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, foreignCoin := range res.ForeignCoins {
+			foreignCoins = append(foreignCoins, NewRPCForeignCoin(foreignCoin))
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return foreignCoins, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// GetTssAddresses returns the addresses of the current TSS
+// the Bitcoin address is derived for the supported Bitcoin chain
+func (api *PublicAPI) GetTssAddresses() (*RPCTssAddresses, error) {
+	api.logger.Debug("zeta_getTssAddresses")
+	chains, err := api.observerClient.SupportedChains(context.Background(), &observertypes.QuerySupportedChains{})
+	if err != nil {
+		return nil, err
+	}
+	var bitcoinChainID int64
+	for _, chain := range chains.Chains {
+		if chain != nil && zetacommon.IsBitcoinChain(chain.ChainId) {
+			bitcoinChainID = chain.ChainId
+			break
+		}
+	}
+
+	res, err := api.observerClient.GetTssAddress(context.Background(), &observertypes.QueryGetTssAddressRequest{
+		BitcoinChainId: bitcoinChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RPCTssAddresses{
+		Eth: common.HexToAddress(res.Eth),
+		Btc: res.Btc,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package zeta
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+// RPCCctx represents a cctx that will serialize to the RPC representation of a cctx
+type RPCCctx struct {
+	Index               string         `json:"index"`
+	Creator             string         `json:"creator"`
+	Status              string         `json:"status"`
+	StatusMessage       string         `json:"statusMessage"`
+	CreatedTimestamp    hexutil.Uint64 `json:"createdTimestamp"`
+	LastUpdateTimestamp hexutil.Uint64 `json:"lastUpdateTimestamp"`
+	ZetaFees            *hexutil.Big   `json:"zetaFees"`
+	RelayedMessage      string         `json:"relayedMessage"`
+	Inbound             *RPCInbound    `json:"inbound"`
+	Outbounds           []*RPCOutbound `json:"outbounds"`
+}
+
+// RPCInbound represents the inbound of a cctx in the RPC representation of a cctx
+type RPCInbound struct {
+	Sender              string         `json:"sender"`
+	SenderChainID       hexutil.Uint64 `json:"senderChainId"`
+	TxOrigin            string         `json:"txOrigin"`
+	CoinType            string         `json:"coinType"`
+	Asset               string         `json:"asset"`
+	Amount              *hexutil.Big   `json:"amount"`
+	Hash                string         `json:"hash"`
+	BlockNumber         hexutil.Uint64 `json:"blockNumber"`
+	FinalizedZetaHeight hexutil.Uint64 `json:"finalizedZetaHeight"`
+}
+
+// RPCOutbound represents an outbound of a cctx in the RPC representation of a cctx
+type RPCOutbound struct {
+	Receiver          string         `json:"receiver"`
+	ReceiverChainID   hexutil.Uint64 `json:"receiverChainId"`
+	CoinType          string         `json:"coinType"`
+	Amount            *hexutil.Big   `json:"amount"`
+	Nonce             hexutil.Uint64 `json:"nonce"`
+	Gas               hexutil.Uint64 `json:"gas"`
+	GasPrice          string         `json:"gasPrice"`
+	Hash              string         `json:"hash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+	TssPubkey         string         `json:"tssPubkey"`
+}
+
+// RPCForeignCoin represents a foreign coin in the RPC representation of a foreign coin
+type RPCForeignCoin struct {
+	Zrc20Address   common.Address `json:"zrc20Address"`
+	Asset          string         `json:"asset"`
+	ForeignChainID hexutil.Uint64 `json:"foreignChainId"`
+	Decimals       hexutil.Uint   `json:"decimals"`
+	Name           string         `json:"name"`
+	Symbol         string         `json:"symbol"`
+	CoinType       string         `json:"coinType"`
+	GasLimit       hexutil.Uint64 `json:"gasLimit"`
+	Paused         bool           `json:"paused"`
+	LiquidityCap   *hexutil.Big   `json:"liquidityCap"`
+}
+
+// RPCWithdrawFee represents the fee of a ZRC20 withdrawal, paid in the gas ZRC20 of the foreign chain
+type RPCWithdrawFee struct {
+	GasZrc20Address common.Address `json:"gasZrc20Address"`
+	GasFee          *hexutil.Big   `json:"gasFee"`
+}
+
+// RPCTssAddresses represents the addresses of the current TSS
+type RPCTssAddresses struct {
+	Eth common.Address `json:"eth"`
+	Btc string         `json:"btc"`
+}
+
+// NewRPCCctx returns a cctx that will serialize to the RPC representation of a cctx
+func NewRPCCctx(cctx crosschaintypes.CrossChainTx) *RPCCctx {
+	rpcCctx := &RPCCctx{
+		Index:          cctx.Index,
+		Creator:        cctx.Creator,
+		ZetaFees:       uintToBig(cctx.ZetaFees),
+		RelayedMessage: cctx.RelayedMessage,
+		Outbounds:      make([]*RPCOutbound, 0, len(cctx.OutboundTxParams)),
+	}
+	if cctx.CctxStatus != nil {
+		rpcCctx.Status = cctx.CctxStatus.Status.String()
+		rpcCctx.StatusMessage = cctx.CctxStatus.StatusMessage
+		// #nosec G701 always positive
+		rpcCctx.CreatedTimestamp = hexutil.Uint64(cctx.CctxStatus.CreatedTimestamp)
+		// #nosec G701 always positive
+		rpcCctx.LastUpdateTimestamp = hexutil.Uint64(cctx.CctxStatus.LastUpdateTimestamp)
+	}
+	if in := cctx.InboundTxParams; in != nil {
+		rpcCctx.Inbound = &RPCInbound{
+			Sender: in.Sender,
+			// #nosec G701 always positive
+			SenderChainID:       hexutil.Uint64(in.SenderChainId),
+			TxOrigin:            in.TxOrigin,
+			CoinType:            in.CoinType.String(),
+			Asset:               in.Asset,
+			Amount:              uintToBig(in.Amount),
+			Hash:                in.InboundTxObservedHash,
+			BlockNumber:         hexutil.Uint64(in.InboundTxObservedExternalHeight),
+			FinalizedZetaHeight: hexutil.Uint64(in.InboundTxFinalizedZetaHeight),
+		}
+	}
+	for _, out := range cctx.OutboundTxParams {
+		if out == nil {
+			continue
+		}
+		rpcOut := &RPCOutbound{
+			Receiver: out.Receiver,
+			// #nosec G701 always positive
+			ReceiverChainID: hexutil.Uint64(out.ReceiverChainId),
+			CoinType:        out.CoinType.String(),
+			Amount:          uintToBig(out.Amount),
+			Nonce:           hexutil.Uint64(out.OutboundTxTssNonce),
+			Gas:             hexutil.Uint64(out.OutboundTxGasLimit),
+			GasPrice:        out.OutboundTxGasPrice,
+			Hash:            out.OutboundTxHash,
+			BlockNumber:     hexutil.Uint64(out.OutboundTxObservedExternalHeight),
+			GasUsed:         hexutil.Uint64(out.OutboundTxGasUsed),
+			TssPubkey:       out.TssPubkey,
+		}
+		if !out.OutboundTxEffectiveGasPrice.IsNil() {
+			rpcOut.EffectiveGasPrice = (*hexutil.Big)(out.OutboundTxEffectiveGasPrice.BigInt())
+		}
+		rpcCctx.Outbounds = append(rpcCctx.Outbounds, rpcOut)
+	}
+	return rpcCctx
+}
+
+// NewRPCForeignCoin returns a foreign coin that will serialize to the RPC representation of a foreign coin
+func NewRPCForeignCoin(foreignCoin fungibletypes.ForeignCoins) *RPCForeignCoin {
+	return &RPCForeignCoin{
+		Zrc20Address: common.HexToAddress(foreignCoin.Zrc20ContractAddress),
+		Asset:        foreignCoin.Asset,
+		// #nosec G701 always positive
+		ForeignChainID: hexutil.Uint64(foreignCoin.ForeignChainId),
+		Decimals:       hexutil.Uint(foreignCoin.Decimals),
+		Name:           foreignCoin.Name,
+		Symbol:         foreignCoin.Symbol,
+		CoinType:       foreignCoin.CoinType.String(),
+		GasLimit:       hexutil.Uint64(foreignCoin.GasLimit),
+		Paused:         foreignCoin.Paused,
+		LiquidityCap:   uintToBig(foreignCoin.LiquidityCap),
+	}
+}
+
+// uintToBig returns nil for an unset amount so it serializes to null
+func uintToBig(u sdkmath.Uint) *hexutil.Big {
+	if u.IsNil() {
+		return nil
+	}
+	return (*hexutil.Big)(u.BigInt())
+}
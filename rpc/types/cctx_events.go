@@ -0,0 +1,165 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// CctxEvent is a lifecycle change of a cctx parsed from the crosschain typed events of a tx
+type CctxEvent struct {
+	Height    int64
+	CctxIndex string
+	// Event is the name of the typed event, e.g. EventInboundFinalized
+	Event string
+	// Status is the status of the cctx after the event
+	Status string
+}
+
+// ParseCctxEvents parses the crosschain typed events marking a lifecycle change of a cctx
+// the other events of the tx are ignored
+func ParseCctxEvents(height int64, events []abci.Event) []CctxEvent {
+	var cctxEvents []CctxEvent
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, crosschainEventPrefix) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		cctxEvent := CctxEvent{
+			Height: height,
+			Event:  strings.TrimPrefix(event.Type, crosschainEventPrefix),
+		}
+		switch e := typedEvent.(type) {
+		case *crosschaintypes.EventInboundFinalized:
+			cctxEvent.CctxIndex, cctxEvent.Status = e.CctxIndex, e.NewStatus
+		case *crosschaintypes.EventZrcWithdrawCreated:
+			cctxEvent.CctxIndex, cctxEvent.Status = e.CctxIndex, e.NewStatus
+		case *crosschaintypes.EventZetaWithdrawCreated:
+			cctxEvent.CctxIndex, cctxEvent.Status = e.CctxIndex, e.NewStatus
+		case *crosschaintypes.EventOutboundSuccess:
+			cctxEvent.CctxIndex, cctxEvent.Status = e.CctxIndex, e.NewStatus
+		case *crosschaintypes.EventOutboundFailure:
+			cctxEvent.CctxIndex, cctxEvent.Status = e.CctxIndex, e.NewStatus
+		case *crosschaintypes.EventOutboundExpired:
+			// the cctx stays pending until the cancel tx of the expired outbound is observed
+			cctxEvent.CctxIndex, cctxEvent.Status = e.CctxIndex, crosschaintypes.CctxStatus_PendingOutbound.String()
+		default:
+			continue
+		}
+		cctxEvents = append(cctxEvents, cctxEvent)
+	}
+	return cctxEvents
+}
+
+// ParseBlockCctxEvents parses the cctx events of a block in execution order,
+// the events of the begin blocker, of the txs and of the end blocker
+func ParseBlockCctxEvents(blockResults *coretypes.ResultBlockResults) []CctxEvent {
+	cctxEvents := ParseCctxEvents(blockResults.Height, blockResults.BeginBlockEvents)
+	for _, txResult := range blockResults.TxsResults {
+		cctxEvents = append(cctxEvents, ParseCctxEvents(blockResults.Height, txResult.Events)...)
+	}
+	return append(cctxEvents, ParseCctxEvents(blockResults.Height, blockResults.EndBlockEvents)...)
+}
+
+// crosschainEventPrefix is the prefix of the type of the crosschain typed events, the full name of their proto message
+const crosschainEventPrefix = "zetachain.zetacore.crosschain."
+
+// CctxFilter defines the criteria of a cctx subscription, the empty fields are ignored
+type CctxFilter struct {
+	CctxIndex string
+	InTxHash  string
+	Sender    string
+	// ChainID matches the sender chain or a receiver chain of the cctx
+	ChainID int64
+	// FromHeight is the ZetaChain height after which the events are replayed before streaming the new events
+	FromHeight int64
+}
+
+// ParseCctxFilter parses the criteria of a cctx subscription from the subscription params
+func ParseCctxFilter(extra interface{}) (CctxFilter, error) {
+	var filter CctxFilter
+	if extra == nil {
+		return filter, nil
+	}
+	params, ok := extra.(map[string]interface{})
+	if !ok {
+		return filter, fmt.Errorf("invalid criteria type %T", extra)
+	}
+
+	for key, value := range params {
+		switch key {
+		case "cctxIndex", "inTxHash", "sender":
+			s, ok := value.(string)
+			if !ok {
+				return filter, fmt.Errorf("invalid %s type %T", key, value)
+			}
+			switch key {
+			case "cctxIndex":
+				filter.CctxIndex = s
+			case "inTxHash":
+				filter.InTxHash = s
+			case "sender":
+				filter.Sender = s
+			}
+		case "chainId", "fromHeight":
+			// JSON numbers are decoded as float64
+			f, ok := value.(float64)
+			if !ok || f < 0 || f != float64(int64(f)) {
+				return filter, fmt.Errorf("invalid %s %v", key, value)
+			}
+			if key == "chainId" {
+				filter.ChainID = int64(f)
+			} else {
+				filter.FromHeight = int64(f)
+			}
+		default:
+			return filter, fmt.Errorf("unknown criteria %s", key)
+		}
+	}
+	return filter, nil
+}
+
+// Matches returns true if the cctx matches all the set criteria of the filter
+func (f CctxFilter) Matches(cctx crosschaintypes.CrossChainTx) bool {
+	if f.CctxIndex != "" && !sameHexOrString(f.CctxIndex, cctx.Index) {
+		return false
+	}
+	if f.InTxHash == "" && f.Sender == "" && f.ChainID == 0 {
+		return true
+	}
+	if cctx.InboundTxParams == nil {
+		return false
+	}
+	if f.InTxHash != "" && !sameHexOrString(f.InTxHash, cctx.InboundTxParams.InboundTxObservedHash) {
+		return false
+	}
+	if f.Sender != "" && !sameHexOrString(f.Sender, cctx.InboundTxParams.Sender) {
+		return false
+	}
+	if f.ChainID != 0 && f.ChainID != cctx.InboundTxParams.SenderChainId {
+		for _, outTxParams := range cctx.OutboundTxParams {
+			if outTxParams != nil && outTxParams.ReceiverChainId == f.ChainID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// sameHexOrString compares hex values case insensitively, and other values exactly
+func sameHexOrString(a, b string) bool {
+	if common.IsHexAddress(a) || strings.HasPrefix(a, "0x") {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestParseCctxEvents(t *testing.T) {
+	inboundEvent, err := sdk.TypedEventToEvent(&crosschaintypes.EventInboundFinalized{
+		CctxIndex: "0x01",
+		NewStatus: crosschaintypes.CctxStatus_PendingOutbound.String(),
+	})
+	require.NoError(t, err)
+	outboundEvent, err := sdk.TypedEventToEvent(&crosschaintypes.EventOutboundFailure{
+		CctxIndex: "0x02",
+		NewStatus: crosschaintypes.CctxStatus_PendingRevert.String(),
+	})
+	require.NoError(t, err)
+
+	events := []abci.Event{
+		{Type: "message", Attributes: []abci.EventAttribute{
+			{Key: []byte("action"), Value: []byte("/zetachain.zetacore.crosschain.MsgVoteOnObservedInboundTx")},
+		}},
+		abci.Event(inboundEvent),
+		abci.Event(outboundEvent),
+	}
+
+	require.Equal(t, []CctxEvent{
+		{
+			Height:    10,
+			CctxIndex: "0x01",
+			Event:     "EventInboundFinalized",
+			Status:    crosschaintypes.CctxStatus_PendingOutbound.String(),
+		},
+		{
+			Height:    10,
+			CctxIndex: "0x02",
+			Event:     "EventOutboundFailure",
+			Status:    crosschaintypes.CctxStatus_PendingRevert.String(),
+		},
+	}, ParseCctxEvents(10, events))
+}
+
+func TestParseBlockCctxEvents(t *testing.T) {
+	newEvent := func(cctxIndex string) abci.Event {
+		event, err := sdk.TypedEventToEvent(&crosschaintypes.EventOutboundSuccess{
+			CctxIndex: cctxIndex,
+			NewStatus: crosschaintypes.CctxStatus_OutboundMined.String(),
+		})
+		require.NoError(t, err)
+		return abci.Event(event)
+	}
+	expiredEvent, err := sdk.TypedEventToEvent(&crosschaintypes.EventOutboundExpired{CctxIndex: "0x01"})
+	require.NoError(t, err)
+
+	events := ParseBlockCctxEvents(&coretypes.ResultBlockResults{
+		Height:           10,
+		BeginBlockEvents: []abci.Event{abci.Event(expiredEvent)},
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Events: []abci.Event{newEvent("0x02")}},
+			{Events: []abci.Event{newEvent("0x03")}},
+		},
+		EndBlockEvents: []abci.Event{newEvent("0x04")},
+	})
+
+	require.Len(t, events, 4)
+	require.Equal(t, CctxEvent{
+		Height:    10,
+		CctxIndex: "0x01",
+		Event:     "EventOutboundExpired",
+		Status:    crosschaintypes.CctxStatus_PendingOutbound.String(),
+	}, events[0])
+	for i, index := range []string{"0x02", "0x03", "0x04"} {
+		require.Equal(t, index, events[i+1].CctxIndex)
+	}
+}
+
+func TestCctxFilter(t *testing.T) {
+	cctx := crosschaintypes.CrossChainTx{
+		Index: "0xabcd",
+		InboundTxParams: &crosschaintypes.InboundTxParams{
+			Sender:                "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2",
+			SenderChainId:         5,
+			InboundTxObservedHash: "0x1234",
+		},
+		OutboundTxParams: []*crosschaintypes.OutboundTxParams{
+			{ReceiverChainId: 97},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		extra   interface{}
+		matches bool
+		err     bool
+	}{
+		{"no criteria", nil, true, false},
+		{"index", map[string]interface{}{"cctxIndex": "0xABCD"}, true, false},
+		{"other index", map[string]interface{}{"cctxIndex": "0xabce"}, false, false},
+		{"sender", map[string]interface{}{"sender": "0x57f96e6b86cdefdb3d412547816a82e3e0ebf9d2"}, true, false},
+		{"sender chain", map[string]interface{}{"chainId": float64(5)}, true, false},
+		{"receiver chain", map[string]interface{}{"chainId": float64(97)}, true, false},
+		{"other chain", map[string]interface{}{"chainId": float64(1)}, false, false},
+		{"inbound hash and chain", map[string]interface{}{"inTxHash": "0x1234", "chainId": float64(97), "fromHeight": float64(100)}, true, false},
+		{"other inbound hash", map[string]interface{}{"inTxHash": "0x1235"}, false, false},
+		{"invalid chain", map[string]interface{}{"chainId": "97"}, false, true},
+		{"invalid height", map[string]interface{}{"fromHeight": float64(-1)}, false, true},
+		{"unknown criteria", map[string]interface{}{"receiver": "0x"}, false, true},
+		{"invalid criteria", []interface{}{}, false, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := ParseCctxFilter(tc.extra)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, filter.Matches(cctx))
+		})
+	}
+}
@@ -3,55 +3,68 @@ package keeper
 import (
 	"testing"
 
-	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
-	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/require"
-	"github.com/tendermint/tendermint/libs/log"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmdb "github.com/tendermint/tm-db"
 	"github.com/zeta-chain/zetacore/x/emissions/keeper"
 	"github.com/zeta-chain/zetacore/x/emissions/types"
-	observerkeeper "github.com/zeta-chain/zetacore/x/observer/keeper"
 )
 
-func EmissionsKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+// EmissionsKeeper instantiates an emissions keeper for testing purposes
+func EmissionsKeeper(t testing.TB) (*keeper.Keeper, sdk.Context, SDKKeepers, ZetaKeepers) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
+	// Initialize local store
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
+	cdc := NewCodec()
+
+	// Create regular keepers
+	sdkKeepers := NewSDKKeepers(cdc, db, stateStore)
+
+	// Create zeta keepers
+	observerKeeper := initObserverKeeper(
+		cdc,
+		db,
+		stateStore,
+		sdkKeepers.StakingKeeper,
+		sdkKeepers.SlashingKeeper,
+		sdkKeepers.ParamsKeeper,
+	)
+	zetaKeepers := ZetaKeepers{
+		ObserverKeeper: observerKeeper,
+	}
+
+	// Create the emissions keeper
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
-	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, db)
+	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
-	registry := codectypes.NewInterfaceRegistry()
-	cdc := codec.NewProtoCodec(registry)
+	ctx := NewContext(stateStore)
+
+	// Initialize modules genesis
+	sdkKeepers.InitGenesis(ctx)
+	zetaKeepers.InitGenesis(ctx)
+
+	// Add a proposer to the context
+	ctx = sdkKeepers.InitBlockProposer(t, ctx)
 
-	paramsSubspace := typesparams.NewSubspace(cdc,
-		types.Amino,
-		storeKey,
-		memStoreKey,
-		"EmissionsParams",
-	)
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		memStoreKey,
-		paramsSubspace,
+		sdkKeepers.ParamsKeeper.Subspace(types.ModuleName),
 		authtypes.FeeCollectorName,
-		bankkeeper.BaseKeeper{},
-		stakingkeeper.Keeper{},
-		observerkeeper.Keeper{},
+		sdkKeepers.BankKeeper,
+		sdkKeepers.StakingKeeper,
+		observerKeeper,
 	)
 
-	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 	k.SetParams(ctx, types.DefaultParams())
-	return k, ctx
+
+	return k, ctx, sdkKeepers, zetaKeepers
 }
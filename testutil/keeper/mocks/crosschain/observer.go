@@ -669,6 +669,34 @@ func (_m *CrosschainObserverKeeper) GetPreviousTSS(ctx types.Context) (observert
 	return r0, r1
 }
 
+// GetProvableBlockHeader provides a mock function with given fields: ctx, hash
+func (_m *CrosschainObserverKeeper) GetProvableBlockHeader(ctx types.Context, hash []byte) (common.BlockHeader, error) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProvableBlockHeader")
+	}
+
+	var r0 common.BlockHeader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, []byte) (common.BlockHeader, error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, []byte) common.BlockHeader); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(common.BlockHeader)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTSS provides a mock function with given fields: ctx
 func (_m *CrosschainObserverKeeper) GetTSS(ctx types.Context) (observertypes.TSS, bool) {
 	ret := _m.Called(ctx)
@@ -727,6 +755,34 @@ func (_m *CrosschainObserverKeeper) GetTssAddress(goCtx context.Context, req *ob
 	return r0, r1
 }
 
+// GetTssBalance provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) GetTssBalance(ctx types.Context, chainID int64) (observertypes.TssBalance, bool) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTssBalance")
+	}
+
+	var r0 observertypes.TssBalance
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) (observertypes.TssBalance, bool)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, int64) observertypes.TssBalance); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(observertypes.TssBalance)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, int64) bool); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // IsAuthorized provides a mock function with given fields: ctx, address, chain
 func (_m *CrosschainObserverKeeper) IsAuthorized(ctx types.Context, address string, chain *common.Chain) bool {
 	ret := _m.Called(ctx, address, chain)
@@ -763,6 +819,42 @@ func (_m *CrosschainObserverKeeper) IsInboundEnabled(ctx types.Context) bool {
 	return r0
 }
 
+// IsInboundEnabledForChain provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) IsInboundEnabledForChain(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsInboundEnabledForChain")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsOutboundEnabledForChain provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) IsOutboundEnabledForChain(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsOutboundEnabledForChain")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // RemoveAllExistingMigrators provides a mock function with given fields: ctx
 func (_m *CrosschainObserverKeeper) RemoveAllExistingMigrators(ctx types.Context) {
 	_m.Called(ctx)
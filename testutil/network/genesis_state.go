@@ -121,6 +121,7 @@ func AddObserverData(t *testing.T, n int, genesisState map[string]json.RawMessag
 		IsOutboundEnabled:            true,
 		GasPriceIncreaseFlags:        &observertypes.DefaultGasPriceIncreaseFlags,
 		BlockHeaderVerificationFlags: &observertypes.DefaultBlockHeaderVerificationFlags,
+		ChainFlags:                   []observertypes.ChainCrosschainFlags{},
 	}
 	tss := observertypes.TSS{
 		TssPubkey:           "tssPubkey",
@@ -167,7 +168,7 @@ func AddCrosschainData(t *testing.T, n int, genesisState map[string]json.RawMess
 	}
 
 	for i := 0; i < n; i++ {
-		state.GasPriceList = append(state.GasPriceList, &types.GasPrice{Creator: "ANY", ChainId: int64(i), Index: strconv.Itoa(i), Prices: []uint64{}, BlockNums: []uint64{}, Signers: []string{}})
+		state.GasPriceList = append(state.GasPriceList, &types.GasPrice{Creator: "ANY", ChainId: int64(i), Index: strconv.Itoa(i), Prices: []uint64{}, BlockNums: []uint64{}, Signers: []string{}, PriorityFees: []uint64{}, Timestamps: []int64{}})
 	}
 	for i := 0; i < n; i++ {
 		state.LastBlockHeightList = append(state.LastBlockHeightList, &types.LastBlockHeight{Creator: "ANY", Index: strconv.Itoa(i)})
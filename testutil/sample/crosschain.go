@@ -116,3 +116,15 @@ func ZetaAccounting(t *testing.T, index string) types.ZetaAccounting {
 		AbortedZetaAmount: math.NewUint(uint64(r.Int63())),
 	}
 }
+
+func QueuedWithdrawal(t *testing.T, cctxIndex string) types.QueuedWithdrawal {
+	r := newRandFromStringSeed(t, cctxIndex)
+	return types.QueuedWithdrawal{
+		CctxIndex:            cctxIndex,
+		Zrc20ContractAddress: EthAddress().Hex(),
+		ChainId:              r.Int63(),
+		Amount:               math.NewUint(uint64(r.Int63())),
+		RefundAddress:        EthAddress().Hex(),
+		Height:               r.Int63(),
+	}
+}
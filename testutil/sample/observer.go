@@ -137,6 +137,15 @@ func TssFundsMigrator(chainID int64) types.TssFundMigratorInfo {
 	}
 }
 
+func TssBalance(chainID int64) types.TssBalance {
+	return types.TssBalance{
+		ChainId:             chainID,
+		TssPubkey:           Tss().TssPubkey,
+		Balance:             sdk.NewUint(uint64(chainID) * 1000),
+		FinalizedZetaHeight: 1000,
+	}
+}
+
 func BlameRecord(t *testing.T, index string) types.Blame {
 	r := newRandFromStringSeed(t, index)
 	return types.Blame{
@@ -52,6 +52,13 @@ export declare enum CctxStatus {
    * @generated from enum value: Aborted = 6;
    */
   Aborted = 6,
+
+  /**
+   * withdrawal exceeding the withdrawal limits; waiting to be released or cancelled
+   *
+   * @generated from enum value: PendingWithdrawalLimit = 7;
+   */
+  PendingWithdrawalLimit = 7,
 }
 
 /**
@@ -194,6 +201,27 @@ export declare class OutboundTxParams extends Message<OutboundTxParams> {
    */
   outboundTxGasPrice: string;
 
+  /**
+   * the priority fee (EIP-1559 tip) of the outbound tx, the gas price is used as fee cap
+   *
+   * @generated from field: string outbound_tx_gas_priority_fee = 23;
+   */
+  outboundTxGasPriorityFee: string;
+
+  /**
+   * the ZetaChain height at which the outbound tx was assigned its nonce, used for the outbound timeout
+   *
+   * @generated from field: uint64 outbound_tx_created_zeta_height = 24;
+   */
+  outboundTxCreatedZetaHeight: bigint;
+
+  /**
+   * the outbound tx expired, its nonce is used by a cancel tx and the cctx is reverted once the cancel tx is observed
+   *
+   * @generated from field: bool outbound_tx_expired = 25;
+   */
+  outboundTxExpired: boolean;
+
   /**
    * the above are commands for zetaclients
    * the following fields are used when the outbound tx is mined
@@ -266,6 +294,20 @@ export declare class Status extends Message<Status> {
    */
   lastUpdateTimestamp: bigint;
 
+  /**
+   * true if the amount of the aborted cctx has been refunded on ZetaChain
+   *
+   * @generated from field: bool is_abort_refunded = 4;
+   */
+  isAbortRefunded: boolean;
+
+  /**
+   * the timestamp of the block in which the cctx was created
+   *
+   * @generated from field: int64 created_timestamp = 5;
+   */
+  createdTimestamp: bigint;
+
   constructor(data?: PartialMessage<Status>);
 
   static readonly runtime: typeof proto3;
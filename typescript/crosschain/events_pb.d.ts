@@ -291,3 +291,47 @@ export declare class EventOutboundSuccess extends Message<EventOutboundSuccess>
   static equals(a: EventOutboundSuccess | PlainMessage<EventOutboundSuccess> | undefined, b: EventOutboundSuccess | PlainMessage<EventOutboundSuccess> | undefined): boolean;
 }
 
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.EventOutboundExpired
+ */
+export declare class EventOutboundExpired extends Message<EventOutboundExpired> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: int64 chain_id = 3;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 nonce = 4;
+   */
+  nonce: bigint;
+
+  /**
+   * @generated from field: string status_message = 5;
+   */
+  statusMessage: string;
+
+  constructor(data?: PartialMessage<EventOutboundExpired>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.EventOutboundExpired";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventOutboundExpired;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventOutboundExpired;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventOutboundExpired;
+
+  static equals(a: EventOutboundExpired | PlainMessage<EventOutboundExpired> | undefined, b: EventOutboundExpired | PlainMessage<EventOutboundExpired> | undefined): boolean;
+}
@@ -6,6 +6,37 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum zetachain.zetacore.crosschain.GasPriceVoteFreshness
+ */
+export declare enum GasPriceVoteFreshness {
+  /**
+   * @generated from enum value: Fresh = 0;
+   */
+  Fresh = 0,
+
+  /**
+   * too many external blocks behind the most recent vote
+   *
+   * @generated from enum value: StaleBlocks = 1;
+   */
+  StaleBlocks = 1,
+
+  /**
+   * cast too long ago in ZetaChain time
+   *
+   * @generated from enum value: StaleTime = 2;
+   */
+  StaleTime = 2,
+
+  /**
+   * deviates too much from the median of the fresh votes
+   *
+   * @generated from enum value: Outlier = 3;
+   */
+  Outlier = 3,
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.GasPrice
  */
@@ -45,6 +76,25 @@ export declare class GasPrice extends Message<GasPrice> {
    */
   medianIndex: bigint;
 
+  /**
+   * priority fees (EIP-1559 tips) voted along with prices, empty for non EIP-1559 chains
+   *
+   * @generated from field: repeated uint64 priority_fees = 8;
+   */
+  priorityFees: bigint[];
+
+  /**
+   * ZetaChain block time (unix seconds) of each vote, 0 for votes cast before it was recorded
+   *
+   * @generated from field: repeated int64 timestamps = 9;
+   */
+  timestamps: bigint[];
+
+  /**
+   * @generated from field: uint64 median_priority_fee_index = 10;
+   */
+  medianPriorityFeeIndex: bigint;
+
   constructor(data?: PartialMessage<GasPrice>);
 
   static readonly runtime: typeof proto3;
@@ -60,3 +110,52 @@ export declare class GasPrice extends Message<GasPrice> {
   static equals(a: GasPrice | PlainMessage<GasPrice> | undefined, b: GasPrice | PlainMessage<GasPrice> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.GasPriceVote
+ */
+export declare class GasPriceVote extends Message<GasPriceVote> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: uint64 block_num = 2;
+   */
+  blockNum: bigint;
+
+  /**
+   * @generated from field: uint64 price = 3;
+   */
+  price: bigint;
+
+  /**
+   * @generated from field: uint64 priority_fee = 4;
+   */
+  priorityFee: bigint;
+
+  /**
+   * @generated from field: int64 timestamp = 5;
+   */
+  timestamp: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.GasPriceVoteFreshness freshness = 6;
+   */
+  freshness: GasPriceVoteFreshness;
+
+  constructor(data?: PartialMessage<GasPriceVote>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.GasPriceVote";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GasPriceVote;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GasPriceVote;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GasPriceVote;
+
+  static equals(a: GasPriceVote | PlainMessage<GasPriceVote> | undefined, b: GasPriceVote | PlainMessage<GasPriceVote> | undefined): boolean;
+}
+
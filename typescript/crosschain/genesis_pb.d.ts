@@ -12,6 +12,7 @@ import type { CrossChainTx, ZetaAccounting } from "./cross_chain_tx_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
 import type { InTxHashToCctx } from "./in_tx_hash_to_cctx_pb.js";
 import type { InTxTracker } from "./in_tx_tracker_pb.js";
+import type { QueuedWithdrawal, WithdrawalLimits } from "./withdrawal_limit_pb.js";
 
 /**
  * GenesisState defines the metacore module's genesis state.
@@ -59,6 +60,16 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   zetaAccounting?: ZetaAccounting;
 
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.WithdrawalLimits withdrawal_limits = 13;
+   */
+  withdrawalLimits?: WithdrawalLimits;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.QueuedWithdrawal queued_withdrawals = 14;
+   */
+  queuedWithdrawals: QueuedWithdrawal[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -9,3 +9,4 @@ export * from "./out_tx_tracker_pb";
 export * from "./params_pb";
 export * from "./query_pb";
 export * from "./tx_pb";
+export * from "./withdrawal_limit_pb";
@@ -17,6 +17,11 @@ export declare class Params extends Message<Params> {
    */
   enabled: boolean;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.OutboundTimeout outbound_timeouts = 2;
+   */
+  outboundTimeouts: OutboundTimeout[];
+
   constructor(data?: PartialMessage<Params>);
 
   static readonly runtime: typeof proto3;
@@ -32,3 +37,35 @@ export declare class Params extends Message<Params> {
   static equals(a: Params | PlainMessage<Params> | undefined, b: Params | PlainMessage<Params> | undefined): boolean;
 }
 
+
+/**
+ * OutboundTimeout defines the number of ZetaChain blocks after which a pending outbound of an EVM chain
+ * is no longer signed by the observers and can be expired into a revert
+ *
+ * @generated from message zetachain.zetacore.crosschain.OutboundTimeout
+ */
+export declare class OutboundTimeout extends Message<OutboundTimeout> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 timeout_blocks = 2;
+   */
+  timeoutBlocks: bigint;
+
+  constructor(data?: PartialMessage<OutboundTimeout>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.OutboundTimeout";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OutboundTimeout;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OutboundTimeout;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OutboundTimeout;
+
+  static equals(a: OutboundTimeout | PlainMessage<OutboundTimeout> | undefined, b: OutboundTimeout | PlainMessage<OutboundTimeout> | undefined): boolean;
+}
@@ -5,15 +5,299 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { CctxStatus, CrossChainTx } from "./cross_chain_tx_pb.js";
+import type { ChainWithdrawalUsage, QueuedWithdrawal, WithdrawalLimits, ZRC20WithdrawalUsage } from "./withdrawal_limit_pb.js";
+import type { PageRequest, PageResponse } from "../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { Params } from "./params_pb.js";
 import type { OutTxTracker } from "./out_tx_tracker_pb.js";
-import type { PageRequest, PageResponse } from "../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { InTxTracker } from "./in_tx_tracker_pb.js";
 import type { InTxHashToCctx } from "./in_tx_hash_to_cctx_pb.js";
-import type { CrossChainTx } from "./cross_chain_tx_pb.js";
-import type { GasPrice } from "./gas_price_pb.js";
+import type { GasPrice, GasPriceVote } from "./gas_price_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryTssFundsMigrationProgressRequest
+ */
+export declare class QueryTssFundsMigrationProgressRequest extends Message<QueryTssFundsMigrationProgressRequest> {
+  constructor(data?: PartialMessage<QueryTssFundsMigrationProgressRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryTssFundsMigrationProgressRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryTssFundsMigrationProgressRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryTssFundsMigrationProgressRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryTssFundsMigrationProgressRequest;
+
+  static equals(a: QueryTssFundsMigrationProgressRequest | PlainMessage<QueryTssFundsMigrationProgressRequest> | undefined, b: QueryTssFundsMigrationProgressRequest | PlainMessage<QueryTssFundsMigrationProgressRequest> | undefined): boolean;
+}
+
+/**
+ * TssFundsMigrationCctx is a cmd cctx of the TSS funds migration of a chain
+ *
+ * @generated from message zetachain.zetacore.crosschain.TssFundsMigrationCctx
+ */
+export declare class TssFundsMigrationCctx extends Message<TssFundsMigrationCctx> {
+  /**
+   * @generated from field: string index = 1;
+   */
+  index: string;
+
+  /**
+   * the command of the cctx, e.g. cmd_migrate_tss_funds
+   *
+   * @generated from field: string command = 2;
+   */
+  command: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxStatus status = 3;
+   */
+  status: CctxStatus;
+
+  /**
+   * @generated from field: uint64 nonce = 4;
+   */
+  nonce: bigint;
+
+  /**
+   * @generated from field: string amount = 5;
+   */
+  amount: string;
+
+  /**
+   * @generated from field: string outbound_tx_hash = 6;
+   */
+  outboundTxHash: string;
+
+  constructor(data?: PartialMessage<TssFundsMigrationCctx>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.TssFundsMigrationCctx";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TssFundsMigrationCctx;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TssFundsMigrationCctx;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TssFundsMigrationCctx;
+
+  static equals(a: TssFundsMigrationCctx | PlainMessage<TssFundsMigrationCctx> | undefined, b: TssFundsMigrationCctx | PlainMessage<TssFundsMigrationCctx> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.TssFundsMigrationProgress
+ */
+export declare class TssFundsMigrationProgress extends Message<TssFundsMigrationProgress> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * the contract updates first, the funds migration last
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.TssFundsMigrationCctx cctxs = 2;
+   */
+  cctxs: TssFundsMigrationCctx[];
+
+  /**
+   * all the cctxs of the chain are mined
+   *
+   * @generated from field: bool completed = 3;
+   */
+  completed: boolean;
+
+  constructor(data?: PartialMessage<TssFundsMigrationProgress>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.TssFundsMigrationProgress";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TssFundsMigrationProgress;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TssFundsMigrationProgress;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TssFundsMigrationProgress;
+
+  static equals(a: TssFundsMigrationProgress | PlainMessage<TssFundsMigrationProgress> | undefined, b: TssFundsMigrationProgress | PlainMessage<TssFundsMigrationProgress> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryTssFundsMigrationProgressResponse
+ */
+export declare class QueryTssFundsMigrationProgressResponse extends Message<QueryTssFundsMigrationProgressResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.TssFundsMigrationProgress progress = 1;
+   */
+  progress: TssFundsMigrationProgress[];
+
+  constructor(data?: PartialMessage<QueryTssFundsMigrationProgressResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryTssFundsMigrationProgressResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryTssFundsMigrationProgressResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryTssFundsMigrationProgressResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryTssFundsMigrationProgressResponse;
+
+  static equals(a: QueryTssFundsMigrationProgressResponse | PlainMessage<QueryTssFundsMigrationProgressResponse> | undefined, b: QueryTssFundsMigrationProgressResponse | PlainMessage<QueryTssFundsMigrationProgressResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryWithdrawalLimitsRequest
+ */
+export declare class QueryWithdrawalLimitsRequest extends Message<QueryWithdrawalLimitsRequest> {
+  constructor(data?: PartialMessage<QueryWithdrawalLimitsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryWithdrawalLimitsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryWithdrawalLimitsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryWithdrawalLimitsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryWithdrawalLimitsRequest;
+
+  static equals(a: QueryWithdrawalLimitsRequest | PlainMessage<QueryWithdrawalLimitsRequest> | undefined, b: QueryWithdrawalLimitsRequest | PlainMessage<QueryWithdrawalLimitsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryWithdrawalLimitsResponse
+ */
+export declare class QueryWithdrawalLimitsResponse extends Message<QueryWithdrawalLimitsResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.WithdrawalLimits withdrawal_limits = 1;
+   */
+  withdrawalLimits?: WithdrawalLimits;
+
+  constructor(data?: PartialMessage<QueryWithdrawalLimitsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryWithdrawalLimitsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryWithdrawalLimitsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryWithdrawalLimitsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryWithdrawalLimitsResponse;
+
+  static equals(a: QueryWithdrawalLimitsResponse | PlainMessage<QueryWithdrawalLimitsResponse> | undefined, b: QueryWithdrawalLimitsResponse | PlainMessage<QueryWithdrawalLimitsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryWithdrawalUsageRequest
+ */
+export declare class QueryWithdrawalUsageRequest extends Message<QueryWithdrawalUsageRequest> {
+  constructor(data?: PartialMessage<QueryWithdrawalUsageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryWithdrawalUsageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryWithdrawalUsageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryWithdrawalUsageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryWithdrawalUsageRequest;
+
+  static equals(a: QueryWithdrawalUsageRequest | PlainMessage<QueryWithdrawalUsageRequest> | undefined, b: QueryWithdrawalUsageRequest | PlainMessage<QueryWithdrawalUsageRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryWithdrawalUsageResponse
+ */
+export declare class QueryWithdrawalUsageResponse extends Message<QueryWithdrawalUsageResponse> {
+  /**
+   * @generated from field: int64 window = 1;
+   */
+  window: bigint;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.ZRC20WithdrawalUsage zrc20_usages = 2;
+   */
+  zrc20Usages: ZRC20WithdrawalUsage[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.ChainWithdrawalUsage chain_usages = 3;
+   */
+  chainUsages: ChainWithdrawalUsage[];
+
+  constructor(data?: PartialMessage<QueryWithdrawalUsageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryWithdrawalUsageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryWithdrawalUsageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryWithdrawalUsageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryWithdrawalUsageResponse;
+
+  static equals(a: QueryWithdrawalUsageResponse | PlainMessage<QueryWithdrawalUsageResponse> | undefined, b: QueryWithdrawalUsageResponse | PlainMessage<QueryWithdrawalUsageResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryAllQueuedWithdrawalRequest
+ */
+export declare class QueryAllQueuedWithdrawalRequest extends Message<QueryAllQueuedWithdrawalRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllQueuedWithdrawalRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryAllQueuedWithdrawalRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllQueuedWithdrawalRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllQueuedWithdrawalRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllQueuedWithdrawalRequest;
+
+  static equals(a: QueryAllQueuedWithdrawalRequest | PlainMessage<QueryAllQueuedWithdrawalRequest> | undefined, b: QueryAllQueuedWithdrawalRequest | PlainMessage<QueryAllQueuedWithdrawalRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryAllQueuedWithdrawalResponse
+ */
+export declare class QueryAllQueuedWithdrawalResponse extends Message<QueryAllQueuedWithdrawalResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.QueuedWithdrawal queued_withdrawals = 1;
+   */
+  queuedWithdrawals: QueuedWithdrawal[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllQueuedWithdrawalResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryAllQueuedWithdrawalResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllQueuedWithdrawalResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllQueuedWithdrawalResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllQueuedWithdrawalResponse;
+
+  static equals(a: QueryAllQueuedWithdrawalResponse | PlainMessage<QueryAllQueuedWithdrawalResponse> | undefined, b: QueryAllQueuedWithdrawalResponse | PlainMessage<QueryAllQueuedWithdrawalResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryZetaAccountingRequest
  */
@@ -631,6 +915,64 @@ export declare class QueryAllGasPriceResponse extends Message<QueryAllGasPriceRe
   static equals(a: QueryAllGasPriceResponse | PlainMessage<QueryAllGasPriceResponse> | undefined, b: QueryAllGasPriceResponse | PlainMessage<QueryAllGasPriceResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryGasPriceVotesRequest
+ */
+export declare class QueryGasPriceVotesRequest extends Message<QueryGasPriceVotesRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryGasPriceVotesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryGasPriceVotesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGasPriceVotesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGasPriceVotesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGasPriceVotesRequest;
+
+  static equals(a: QueryGasPriceVotesRequest | PlainMessage<QueryGasPriceVotesRequest> | undefined, b: QueryGasPriceVotesRequest | PlainMessage<QueryGasPriceVotesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryGasPriceVotesResponse
+ */
+export declare class QueryGasPriceVotesResponse extends Message<QueryGasPriceVotesResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.GasPriceVote votes = 1;
+   */
+  votes: GasPriceVote[];
+
+  /**
+   * @generated from field: uint64 median_price = 2;
+   */
+  medianPrice: bigint;
+
+  /**
+   * @generated from field: uint64 median_priority_fee = 3;
+   */
+  medianPriorityFee: bigint;
+
+  constructor(data?: PartialMessage<QueryGasPriceVotesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryGasPriceVotesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGasPriceVotesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGasPriceVotesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGasPriceVotesResponse;
+
+  static equals(a: QueryGasPriceVotesResponse | PlainMessage<QueryGasPriceVotesResponse> | undefined, b: QueryGasPriceVotesResponse | PlainMessage<QueryGasPriceVotesResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryGetLastBlockHeightRequest
  */
@@ -920,6 +1262,96 @@ export declare class QueryListCctxPendingResponse extends Message<QueryListCctxP
   static equals(a: QueryListCctxPendingResponse | PlainMessage<QueryListCctxPendingResponse> | undefined, b: QueryListCctxPendingResponse | PlainMessage<QueryListCctxPendingResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxSearchRequest
+ */
+export declare class QueryCctxSearchRequest extends Message<QueryCctxSearchRequest> {
+  /**
+   * @generated from field: string sender = 1;
+   */
+  sender: string;
+
+  /**
+   * @generated from field: string receiver = 2;
+   */
+  receiver: string;
+
+  /**
+   * the name of the cctx status, e.g. Aborted
+   *
+   * @generated from field: string status = 3;
+   */
+  status: string;
+
+  /**
+   * @generated from field: int64 sender_chain_id = 4;
+   */
+  senderChainId: bigint;
+
+  /**
+   * @generated from field: int64 receiver_chain_id = 5;
+   */
+  receiverChainId: bigint;
+
+  /**
+   * @generated from field: int64 start_timestamp = 6;
+   */
+  startTimestamp: bigint;
+
+  /**
+   * @generated from field: int64 end_timestamp = 7;
+   */
+  endTimestamp: bigint;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 8;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryCctxSearchRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxSearchRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxSearchRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxSearchRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxSearchRequest;
+
+  static equals(a: QueryCctxSearchRequest | PlainMessage<QueryCctxSearchRequest> | undefined, b: QueryCctxSearchRequest | PlainMessage<QueryCctxSearchRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxSearchResponse
+ */
+export declare class QueryCctxSearchResponse extends Message<QueryCctxSearchResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx CrossChainTx = 1;
+   */
+  CrossChainTx: CrossChainTx[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryCctxSearchResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxSearchResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxSearchResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxSearchResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxSearchResponse;
+
+  static equals(a: QueryCctxSearchResponse | PlainMessage<QueryCctxSearchResponse> | undefined, b: QueryCctxSearchResponse | PlainMessage<QueryCctxSearchResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryLastZetaHeightRequest
  */
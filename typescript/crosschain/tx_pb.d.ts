@@ -5,8 +5,256 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { WithdrawalLimits } from "./withdrawal_limit_pb.js";
 import type { CoinType, Proof, ReceiveStatus } from "../common/common_pb.js";
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgAbortStuckCCTX
+ */
+export declare class MsgAbortStuckCCTX extends Message<MsgAbortStuckCCTX> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<MsgAbortStuckCCTX>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgAbortStuckCCTX";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgAbortStuckCCTX;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgAbortStuckCCTX;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgAbortStuckCCTX;
+
+  static equals(a: MsgAbortStuckCCTX | PlainMessage<MsgAbortStuckCCTX> | undefined, b: MsgAbortStuckCCTX | PlainMessage<MsgAbortStuckCCTX> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgAbortStuckCCTXResponse
+ */
+export declare class MsgAbortStuckCCTXResponse extends Message<MsgAbortStuckCCTXResponse> {
+  constructor(data?: PartialMessage<MsgAbortStuckCCTXResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgAbortStuckCCTXResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgAbortStuckCCTXResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgAbortStuckCCTXResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgAbortStuckCCTXResponse;
+
+  static equals(a: MsgAbortStuckCCTXResponse | PlainMessage<MsgAbortStuckCCTXResponse> | undefined, b: MsgAbortStuckCCTXResponse | PlainMessage<MsgAbortStuckCCTXResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgRefundAbortedCCTX
+ */
+export declare class MsgRefundAbortedCCTX extends Message<MsgRefundAbortedCCTX> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  /**
+   * address receiving the refund on ZetaChain, the sender of the inbound tx if empty
+   *
+   * @generated from field: string refund_address = 3;
+   */
+  refundAddress: string;
+
+  constructor(data?: PartialMessage<MsgRefundAbortedCCTX>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgRefundAbortedCCTX";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRefundAbortedCCTX;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRefundAbortedCCTX;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRefundAbortedCCTX;
+
+  static equals(a: MsgRefundAbortedCCTX | PlainMessage<MsgRefundAbortedCCTX> | undefined, b: MsgRefundAbortedCCTX | PlainMessage<MsgRefundAbortedCCTX> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgRefundAbortedCCTXResponse
+ */
+export declare class MsgRefundAbortedCCTXResponse extends Message<MsgRefundAbortedCCTXResponse> {
+  constructor(data?: PartialMessage<MsgRefundAbortedCCTXResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgRefundAbortedCCTXResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRefundAbortedCCTXResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRefundAbortedCCTXResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRefundAbortedCCTXResponse;
+
+  static equals(a: MsgRefundAbortedCCTXResponse | PlainMessage<MsgRefundAbortedCCTXResponse> | undefined, b: MsgRefundAbortedCCTXResponse | PlainMessage<MsgRefundAbortedCCTXResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateWithdrawalLimits
+ */
+export declare class MsgUpdateWithdrawalLimits extends Message<MsgUpdateWithdrawalLimits> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.WithdrawalLimits withdrawal_limits = 2;
+   */
+  withdrawalLimits?: WithdrawalLimits;
+
+  constructor(data?: PartialMessage<MsgUpdateWithdrawalLimits>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateWithdrawalLimits";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateWithdrawalLimits;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateWithdrawalLimits;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateWithdrawalLimits;
+
+  static equals(a: MsgUpdateWithdrawalLimits | PlainMessage<MsgUpdateWithdrawalLimits> | undefined, b: MsgUpdateWithdrawalLimits | PlainMessage<MsgUpdateWithdrawalLimits> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateWithdrawalLimitsResponse
+ */
+export declare class MsgUpdateWithdrawalLimitsResponse extends Message<MsgUpdateWithdrawalLimitsResponse> {
+  constructor(data?: PartialMessage<MsgUpdateWithdrawalLimitsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateWithdrawalLimitsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateWithdrawalLimitsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateWithdrawalLimitsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateWithdrawalLimitsResponse;
+
+  static equals(a: MsgUpdateWithdrawalLimitsResponse | PlainMessage<MsgUpdateWithdrawalLimitsResponse> | undefined, b: MsgUpdateWithdrawalLimitsResponse | PlainMessage<MsgUpdateWithdrawalLimitsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgReleaseQueuedWithdrawal
+ */
+export declare class MsgReleaseQueuedWithdrawal extends Message<MsgReleaseQueuedWithdrawal> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<MsgReleaseQueuedWithdrawal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgReleaseQueuedWithdrawal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgReleaseQueuedWithdrawal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgReleaseQueuedWithdrawal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgReleaseQueuedWithdrawal;
+
+  static equals(a: MsgReleaseQueuedWithdrawal | PlainMessage<MsgReleaseQueuedWithdrawal> | undefined, b: MsgReleaseQueuedWithdrawal | PlainMessage<MsgReleaseQueuedWithdrawal> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgReleaseQueuedWithdrawalResponse
+ */
+export declare class MsgReleaseQueuedWithdrawalResponse extends Message<MsgReleaseQueuedWithdrawalResponse> {
+  constructor(data?: PartialMessage<MsgReleaseQueuedWithdrawalResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgReleaseQueuedWithdrawalResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgReleaseQueuedWithdrawalResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgReleaseQueuedWithdrawalResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgReleaseQueuedWithdrawalResponse;
+
+  static equals(a: MsgReleaseQueuedWithdrawalResponse | PlainMessage<MsgReleaseQueuedWithdrawalResponse> | undefined, b: MsgReleaseQueuedWithdrawalResponse | PlainMessage<MsgReleaseQueuedWithdrawalResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgCancelQueuedWithdrawal
+ */
+export declare class MsgCancelQueuedWithdrawal extends Message<MsgCancelQueuedWithdrawal> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<MsgCancelQueuedWithdrawal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgCancelQueuedWithdrawal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgCancelQueuedWithdrawal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgCancelQueuedWithdrawal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgCancelQueuedWithdrawal;
+
+  static equals(a: MsgCancelQueuedWithdrawal | PlainMessage<MsgCancelQueuedWithdrawal> | undefined, b: MsgCancelQueuedWithdrawal | PlainMessage<MsgCancelQueuedWithdrawal> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgCancelQueuedWithdrawalResponse
+ */
+export declare class MsgCancelQueuedWithdrawalResponse extends Message<MsgCancelQueuedWithdrawalResponse> {
+  constructor(data?: PartialMessage<MsgCancelQueuedWithdrawalResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgCancelQueuedWithdrawalResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgCancelQueuedWithdrawalResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgCancelQueuedWithdrawalResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgCancelQueuedWithdrawalResponse;
+
+  static equals(a: MsgCancelQueuedWithdrawalResponse | PlainMessage<MsgCancelQueuedWithdrawalResponse> | undefined, b: MsgCancelQueuedWithdrawalResponse | PlainMessage<MsgCancelQueuedWithdrawalResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgCreateTSSVoter
  */
@@ -118,6 +366,57 @@ export declare class MsgMigrateTssFundsResponse extends Message<MsgMigrateTssFun
   static equals(a: MsgMigrateTssFundsResponse | PlainMessage<MsgMigrateTssFundsResponse> | undefined, b: MsgMigrateTssFundsResponse | PlainMessage<MsgMigrateTssFundsResponse> | undefined): boolean;
 }
 
+/**
+ * MsgMigrateAllTssFunds schedules the migration of the funds of the current TSS to the new TSS on every supported chain
+ * the migrated amounts are derived from the TSS balances voted by the observers
+ *
+ * @generated from message zetachain.zetacore.crosschain.MsgMigrateAllTssFunds
+ */
+export declare class MsgMigrateAllTssFunds extends Message<MsgMigrateAllTssFunds> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  constructor(data?: PartialMessage<MsgMigrateAllTssFunds>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgMigrateAllTssFunds";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgMigrateAllTssFunds;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgMigrateAllTssFunds;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgMigrateAllTssFunds;
+
+  static equals(a: MsgMigrateAllTssFunds | PlainMessage<MsgMigrateAllTssFunds> | undefined, b: MsgMigrateAllTssFunds | PlainMessage<MsgMigrateAllTssFunds> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgMigrateAllTssFundsResponse
+ */
+export declare class MsgMigrateAllTssFundsResponse extends Message<MsgMigrateAllTssFundsResponse> {
+  /**
+   * @generated from field: repeated string cctx_indexes = 1;
+   */
+  cctxIndexes: string[];
+
+  constructor(data?: PartialMessage<MsgMigrateAllTssFundsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgMigrateAllTssFundsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgMigrateAllTssFundsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgMigrateAllTssFundsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgMigrateAllTssFundsResponse;
+
+  static equals(a: MsgMigrateAllTssFundsResponse | PlainMessage<MsgMigrateAllTssFundsResponse> | undefined, b: MsgMigrateAllTssFundsResponse | PlainMessage<MsgMigrateAllTssFundsResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgUpdateTssAddress
  */
@@ -484,6 +783,11 @@ export declare class MsgGasPriceVoter extends Message<MsgGasPriceVoter> {
    */
   supply: string;
 
+  /**
+   * @generated from field: uint64 priority_fee = 6;
+   */
+  priorityFee: bigint;
+
   constructor(data?: PartialMessage<MsgGasPriceVoter>);
 
   static readonly runtime: typeof proto3;
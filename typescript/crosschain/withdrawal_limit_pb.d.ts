@@ -0,0 +1,243 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file crosschain/withdrawal_limit.proto (package zetachain.zetacore.crosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * ZRC20WithdrawalLimit limits the amount of a ZRC20 withdrawn from ZetaChain in the window
+ *
+ * @generated from message zetachain.zetacore.crosschain.ZRC20WithdrawalLimit
+ */
+export declare class ZRC20WithdrawalLimit extends Message<ZRC20WithdrawalLimit> {
+  /**
+   * @generated from field: string zrc20_contract_address = 1;
+   */
+  zrc20ContractAddress: string;
+
+  /**
+   * maximum amount withdrawn in the window in the ZRC20 unit, 0 for no limit
+   *
+   * @generated from field: string limit = 2;
+   */
+  limit: string;
+
+  /**
+   * azeta value of one unit of the ZRC20, used to count the withdrawals in the limit of the foreign chain
+   *
+   * @generated from field: string zeta_rate = 3;
+   */
+  zetaRate: string;
+
+  constructor(data?: PartialMessage<ZRC20WithdrawalLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ZRC20WithdrawalLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ZRC20WithdrawalLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ZRC20WithdrawalLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ZRC20WithdrawalLimit;
+
+  static equals(a: ZRC20WithdrawalLimit | PlainMessage<ZRC20WithdrawalLimit> | undefined, b: ZRC20WithdrawalLimit | PlainMessage<ZRC20WithdrawalLimit> | undefined): boolean;
+}
+
+/**
+ * ChainWithdrawalLimit limits the value withdrawn to a foreign chain in the window
+ *
+ * @generated from message zetachain.zetacore.crosschain.ChainWithdrawalLimit
+ */
+export declare class ChainWithdrawalLimit extends Message<ChainWithdrawalLimit> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * maximum value withdrawn in the window in azeta, 0 for no limit
+   *
+   * @generated from field: string limit = 2;
+   */
+  limit: string;
+
+  constructor(data?: PartialMessage<ChainWithdrawalLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ChainWithdrawalLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainWithdrawalLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainWithdrawalLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainWithdrawalLimit;
+
+  static equals(a: ChainWithdrawalLimit | PlainMessage<ChainWithdrawalLimit> | undefined, b: ChainWithdrawalLimit | PlainMessage<ChainWithdrawalLimit> | undefined): boolean;
+}
+
+/**
+ * WithdrawalLimits defines the limits of the withdrawals from ZetaChain over a sliding window of blocks
+ *
+ * @generated from message zetachain.zetacore.crosschain.WithdrawalLimits
+ */
+export declare class WithdrawalLimits extends Message<WithdrawalLimits> {
+  /**
+   * number of blocks of the sliding window, the limits are disabled if 0
+   *
+   * @generated from field: int64 window = 1;
+   */
+  window: bigint;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.ZRC20WithdrawalLimit zrc20_limits = 2;
+   */
+  zrc20Limits: ZRC20WithdrawalLimit[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.ChainWithdrawalLimit chain_limits = 3;
+   */
+  chainLimits: ChainWithdrawalLimit[];
+
+  constructor(data?: PartialMessage<WithdrawalLimits>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.WithdrawalLimits";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WithdrawalLimits;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WithdrawalLimits;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WithdrawalLimits;
+
+  static equals(a: WithdrawalLimits | PlainMessage<WithdrawalLimits> | undefined, b: WithdrawalLimits | PlainMessage<WithdrawalLimits> | undefined): boolean;
+}
+
+/**
+ * ZRC20WithdrawalUsage is the amount of a ZRC20 withdrawn in the current window
+ *
+ * @generated from message zetachain.zetacore.crosschain.ZRC20WithdrawalUsage
+ */
+export declare class ZRC20WithdrawalUsage extends Message<ZRC20WithdrawalUsage> {
+  /**
+   * @generated from field: string zrc20_contract_address = 1;
+   */
+  zrc20ContractAddress: string;
+
+  /**
+   * @generated from field: string amount = 2;
+   */
+  amount: string;
+
+  /**
+   * @generated from field: string limit = 3;
+   */
+  limit: string;
+
+  constructor(data?: PartialMessage<ZRC20WithdrawalUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ZRC20WithdrawalUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ZRC20WithdrawalUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ZRC20WithdrawalUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ZRC20WithdrawalUsage;
+
+  static equals(a: ZRC20WithdrawalUsage | PlainMessage<ZRC20WithdrawalUsage> | undefined, b: ZRC20WithdrawalUsage | PlainMessage<ZRC20WithdrawalUsage> | undefined): boolean;
+}
+
+/**
+ * ChainWithdrawalUsage is the value in azeta withdrawn to a foreign chain in the current window
+ *
+ * @generated from message zetachain.zetacore.crosschain.ChainWithdrawalUsage
+ */
+export declare class ChainWithdrawalUsage extends Message<ChainWithdrawalUsage> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string amount = 2;
+   */
+  amount: string;
+
+  /**
+   * @generated from field: string limit = 3;
+   */
+  limit: string;
+
+  constructor(data?: PartialMessage<ChainWithdrawalUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ChainWithdrawalUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainWithdrawalUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainWithdrawalUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainWithdrawalUsage;
+
+  static equals(a: ChainWithdrawalUsage | PlainMessage<ChainWithdrawalUsage> | undefined, b: ChainWithdrawalUsage | PlainMessage<ChainWithdrawalUsage> | undefined): boolean;
+}
+
+/**
+ * QueuedWithdrawal is a withdrawal exceeding the limits, its cctx waits for the admin to release or cancel it
+ *
+ * @generated from message zetachain.zetacore.crosschain.QueuedWithdrawal
+ */
+export declare class QueuedWithdrawal extends Message<QueuedWithdrawal> {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: string zrc20_contract_address = 2;
+   */
+  zrc20ContractAddress: string;
+
+  /**
+   * @generated from field: int64 chain_id = 3;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string amount = 4;
+   */
+  amount: string;
+
+  /**
+   * address the amount is deposited back to if the withdrawal is cancelled
+   *
+   * @generated from field: string refund_address = 5;
+   */
+  refundAddress: string;
+
+  /**
+   * @generated from field: int64 height = 6;
+   */
+  height: bigint;
+
+  constructor(data?: PartialMessage<QueuedWithdrawal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueuedWithdrawal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueuedWithdrawal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueuedWithdrawal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueuedWithdrawal;
+
+  static equals(a: QueuedWithdrawal | PlainMessage<QueuedWithdrawal> | undefined, b: QueuedWithdrawal | PlainMessage<QueuedWithdrawal> | undefined): boolean;
+}
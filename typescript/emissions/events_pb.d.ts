@@ -138,3 +138,47 @@ export declare class EventBlockEmissions extends Message<EventBlockEmissions> {
   static equals(a: EventBlockEmissions | PlainMessage<EventBlockEmissions> | undefined, b: EventBlockEmissions | PlainMessage<EventBlockEmissions> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.emissions.EventEmissionWithdrawn
+ */
+export declare class EventEmissionWithdrawn extends Message<EventEmissionWithdrawn> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: string amount = 3;
+   */
+  amount: string;
+
+  /**
+   * @generated from field: bool compounded = 4;
+   */
+  compounded: boolean;
+
+  /**
+   * @generated from field: string validator_address = 5;
+   */
+  validatorAddress: string;
+
+  constructor(data?: PartialMessage<EventEmissionWithdrawn>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.emissions.EventEmissionWithdrawn";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventEmissionWithdrawn;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventEmissionWithdrawn;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventEmissionWithdrawn;
+
+  static equals(a: EventEmissionWithdrawn | PlainMessage<EventEmissionWithdrawn> | undefined, b: EventEmissionWithdrawn | PlainMessage<EventEmissionWithdrawn> | undefined): boolean;
+}
+
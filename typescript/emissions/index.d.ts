@@ -2,4 +2,5 @@ export * from "./events_pb";
 export * from "./genesis_pb";
 export * from "./params_pb";
 export * from "./query_pb";
+export * from "./tx_pb";
 export * from "./withdrawable_emissions_pb";
@@ -0,0 +1,70 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file emissions/tx.proto (package zetachain.zetacore.emissions, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * MsgWithdrawEmission withdraws the emissions accumulated by an observer.
+ * A zero amount withdraws the full withdrawable balance.
+ * If compound is set, the withdrawn amount is delegated to the observer's validator.
+ *
+ * @generated from message zetachain.zetacore.emissions.MsgWithdrawEmission
+ */
+export declare class MsgWithdrawEmission extends Message<MsgWithdrawEmission> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string amount = 2;
+   */
+  amount: string;
+
+  /**
+   * @generated from field: bool compound = 3;
+   */
+  compound: boolean;
+
+  constructor(data?: PartialMessage<MsgWithdrawEmission>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.emissions.MsgWithdrawEmission";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgWithdrawEmission;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgWithdrawEmission;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgWithdrawEmission;
+
+  static equals(a: MsgWithdrawEmission | PlainMessage<MsgWithdrawEmission> | undefined, b: MsgWithdrawEmission | PlainMessage<MsgWithdrawEmission> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.emissions.MsgWithdrawEmissionResponse
+ */
+export declare class MsgWithdrawEmissionResponse extends Message<MsgWithdrawEmissionResponse> {
+  /**
+   * @generated from field: string amount = 1;
+   */
+  amount: string;
+
+  constructor(data?: PartialMessage<MsgWithdrawEmissionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.emissions.MsgWithdrawEmissionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgWithdrawEmissionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgWithdrawEmissionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgWithdrawEmissionResponse;
+
+  static equals(a: MsgWithdrawEmissionResponse | PlainMessage<MsgWithdrawEmissionResponse> | undefined, b: MsgWithdrawEmissionResponse | PlainMessage<MsgWithdrawEmissionResponse> | undefined): boolean;
+}
+
@@ -419,3 +419,59 @@ export declare class QueryCodeHashResponse extends Message<QueryCodeHashResponse
   static equals(a: QueryCodeHashResponse | PlainMessage<QueryCodeHashResponse> | undefined, b: QueryCodeHashResponse | PlainMessage<QueryCodeHashResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryZRC20TotalSupplyRequest
+ */
+export declare class QueryZRC20TotalSupplyRequest extends Message<QueryZRC20TotalSupplyRequest> {
+  /**
+   * @generated from field: string zrc20_address = 1;
+   */
+  zrc20Address: string;
+
+  constructor(data?: PartialMessage<QueryZRC20TotalSupplyRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryZRC20TotalSupplyRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryZRC20TotalSupplyRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryZRC20TotalSupplyRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryZRC20TotalSupplyRequest;
+
+  static equals(a: QueryZRC20TotalSupplyRequest | PlainMessage<QueryZRC20TotalSupplyRequest> | undefined, b: QueryZRC20TotalSupplyRequest | PlainMessage<QueryZRC20TotalSupplyRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryZRC20TotalSupplyResponse
+ */
+export declare class QueryZRC20TotalSupplyResponse extends Message<QueryZRC20TotalSupplyResponse> {
+  /**
+   * @generated from field: string total_supply = 1;
+   */
+  totalSupply: string;
+
+  /**
+   * the balance of the zrc20 held by the fungible module and the gas stability pool,
+   * the withdraw fees of a gas zrc20 are held by the protocol and not backed by the assets of the foreign chain
+   *
+   * @generated from field: string protocol_balance = 2;
+   */
+  protocolBalance: string;
+
+  constructor(data?: PartialMessage<QueryZRC20TotalSupplyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryZRC20TotalSupplyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryZRC20TotalSupplyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryZRC20TotalSupplyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryZRC20TotalSupplyResponse;
+
+  static equals(a: QueryZRC20TotalSupplyResponse | PlainMessage<QueryZRC20TotalSupplyResponse> | undefined, b: QueryZRC20TotalSupplyResponse | PlainMessage<QueryZRC20TotalSupplyResponse> | undefined): boolean;
+}
+
@@ -49,6 +49,27 @@ export declare enum BallotStatus {
   BallotInProgress = 2,
 }
 
+/**
+ * BallotWeighting defines how the votes of the observers are weighted when finalizing a ballot.
+ *
+ * @generated from enum zetachain.zetacore.observer.BallotWeighting
+ */
+export declare enum BallotWeighting {
+  /**
+   * every observer in the voter list has the same weight
+   *
+   * @generated from enum value: OneObserverOneVote = 0;
+   */
+  OneObserverOneVote = 0,
+
+  /**
+   * every observer is weighted by the bonded tokens of its validator, snapshotted at ballot creation
+   *
+   * @generated from enum value: BondedTokens = 1;
+   */
+  BondedTokens = 1,
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.Ballot
  */
@@ -93,6 +114,11 @@ export declare class Ballot extends Message<Ballot> {
    */
   ballotCreationHeight: bigint;
 
+  /**
+   * @generated from field: repeated string voter_weights = 9;
+   */
+  voterWeights: string[];
+
   constructor(data?: PartialMessage<Ballot>);
 
   static readonly runtime: typeof proto3;
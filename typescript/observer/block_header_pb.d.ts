@@ -30,6 +30,13 @@ export declare class BlockHeaderState extends Message<BlockHeaderState> {
    */
   latestBlockHash: Uint8Array;
 
+  /**
+   * hashes of the headers that have no child yet, one per competing fork
+   *
+   * @generated from field: repeated bytes tips = 5;
+   */
+  tips: Uint8Array[];
+
   constructor(data?: PartialMessage<BlockHeaderState>);
 
   static readonly runtime: typeof proto3;
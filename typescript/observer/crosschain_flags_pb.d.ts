@@ -84,6 +84,57 @@ export declare class BlockHeaderVerificationFlags extends Message<BlockHeaderVer
   static equals(a: BlockHeaderVerificationFlags | PlainMessage<BlockHeaderVerificationFlags> | undefined, b: BlockHeaderVerificationFlags | PlainMessage<BlockHeaderVerificationFlags> | undefined): boolean;
 }
 
+/**
+ * ChainCrosschainFlags overrides the global inbound and outbound flags for a single chain
+ * A chain can only be disabled by its override, it is enabled only if the global flag is enabled
+ *
+ * @generated from message zetachain.zetacore.observer.ChainCrosschainFlags
+ */
+export declare class ChainCrosschainFlags extends Message<ChainCrosschainFlags> {
+  /**
+   * @generated from field: int64 chainId = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: bool isInboundEnabled = 2;
+   */
+  isInboundEnabled: boolean;
+
+  /**
+   * @generated from field: bool isOutboundEnabled = 3;
+   */
+  isOutboundEnabled: boolean;
+
+  /**
+   * Block height at which the disabled inbound of the chain is enabled again, 0 if never enabled automatically
+   *
+   * @generated from field: int64 inboundReEnableHeight = 4;
+   */
+  inboundReEnableHeight: bigint;
+
+  /**
+   * Block height at which the disabled outbound of the chain is enabled again, 0 if never enabled automatically
+   *
+   * @generated from field: int64 outboundReEnableHeight = 5;
+   */
+  outboundReEnableHeight: bigint;
+
+  constructor(data?: PartialMessage<ChainCrosschainFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ChainCrosschainFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainCrosschainFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainCrosschainFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainCrosschainFlags;
+
+  static equals(a: ChainCrosschainFlags | PlainMessage<ChainCrosschainFlags> | undefined, b: ChainCrosschainFlags | PlainMessage<ChainCrosschainFlags> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.CrosschainFlags
  */
@@ -108,6 +159,25 @@ export declare class CrosschainFlags extends Message<CrosschainFlags> {
    */
   blockHeaderVerificationFlags?: BlockHeaderVerificationFlags;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ChainCrosschainFlags chainFlags = 5;
+   */
+  chainFlags: ChainCrosschainFlags[];
+
+  /**
+   * Block height at which the global inbound is enabled again, 0 if never enabled automatically
+   *
+   * @generated from field: int64 inboundReEnableHeight = 6;
+   */
+  inboundReEnableHeight: bigint;
+
+  /**
+   * Block height at which the global outbound is enabled again, 0 if never enabled automatically
+   *
+   * @generated from field: int64 outboundReEnableHeight = 7;
+   */
+  outboundReEnableHeight: bigint;
+
   constructor(data?: PartialMessage<CrosschainFlags>);
 
   static readonly runtime: typeof proto3;
@@ -5,7 +5,8 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { BlockHeaderVerificationFlags, GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { BallotStatus, VoteType } from "./ballot_pb.js";
+import type { BlockHeaderVerificationFlags, ChainCrosschainFlags, GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.EventBallotCreated
@@ -51,6 +52,55 @@ export declare class EventBallotCreated extends Message<EventBallotCreated> {
   static equals(a: EventBallotCreated | PlainMessage<EventBallotCreated> | undefined, b: EventBallotCreated | PlainMessage<EventBallotCreated> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.EventBallotArchived
+ */
+export declare class EventBallotArchived extends Message<EventBallotArchived> {
+  /**
+   * @generated from field: string ballot_identifier = 1;
+   */
+  ballotIdentifier: string;
+
+  /**
+   * @generated from field: string ballot_type = 2;
+   */
+  ballotType: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.BallotStatus ballot_status = 3;
+   */
+  ballotStatus: BallotStatus;
+
+  /**
+   * @generated from field: int64 ballot_creation_height = 4;
+   */
+  ballotCreationHeight: bigint;
+
+  /**
+   * @generated from field: repeated string voter_list = 5;
+   */
+  voterList: string[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.VoteType votes = 6;
+   */
+  votes: VoteType[];
+
+  constructor(data?: PartialMessage<EventBallotArchived>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventBallotArchived";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventBallotArchived;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventBallotArchived;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventBallotArchived;
+
+  static equals(a: EventBallotArchived | PlainMessage<EventBallotArchived> | undefined, b: EventBallotArchived | PlainMessage<EventBallotArchived> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.EventKeygenBlockUpdated
  */
@@ -163,6 +213,16 @@ export declare class EventCrosschainFlagsUpdated extends Message<EventCrosschain
    */
   blockHeaderVerificationFlags?: BlockHeaderVerificationFlags;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ChainCrosschainFlags chainFlags = 7;
+   */
+  chainFlags: ChainCrosschainFlags[];
+
+  /**
+   * @generated from field: int64 reEnableHeight = 8;
+   */
+  reEnableHeight: bigint;
+
   constructor(data?: PartialMessage<EventCrosschainFlagsUpdated>);
 
   static readonly runtime: typeof proto3;
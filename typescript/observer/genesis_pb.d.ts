@@ -12,7 +12,7 @@ import type { CrosschainFlags } from "./crosschain_flags_pb.js";
 import type { CoreParamsList, Params } from "./params_pb.js";
 import type { Keygen } from "./keygen_pb.js";
 import type { TSS } from "./tss_pb.js";
-import type { TssFundMigratorInfo } from "./tss_funds_migrator_pb.js";
+import type { TssBalance, TssFundMigratorInfo } from "./tss_funds_migrator_pb.js";
 import type { Blame } from "./blame_pb.js";
 import type { PendingNonces } from "./pending_nonces_pb.js";
 import type { ChainNonces } from "./chain_nonces_pb.js";
@@ -97,6 +97,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   nonceToCctx: NonceToCctx[];
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.TssBalance tss_balances = 16;
+   */
+  tssBalances: TssBalance[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
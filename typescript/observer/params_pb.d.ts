@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { Chain } from "../common/common_pb.js";
+import type { BallotWeighting } from "./ballot_pb.js";
 
 /**
  * @generated from enum zetachain.zetacore.observer.Policy_Type
@@ -105,6 +106,34 @@ export declare class CoreParams extends Message<CoreParams> {
    */
   outboundTxScheduleLookahead: bigint;
 
+  /**
+   * number of blocks below the latest header after which block headers are pruned, 0 disables pruning
+   *
+   * @generated from field: uint64 block_header_prune_window = 14;
+   */
+  blockHeaderPruneWindow: bigint;
+
+  /**
+   * gas price votes more than this number of blocks behind the most recent vote are ignored, 0 disables the check
+   *
+   * @generated from field: uint64 gas_price_stale_blocks = 15;
+   */
+  gasPriceStaleBlocks: bigint;
+
+  /**
+   * gas price votes cast more than this number of seconds ago are ignored, 0 disables the check
+   *
+   * @generated from field: uint64 gas_price_stale_seconds = 16;
+   */
+  gasPriceStaleSeconds: bigint;
+
+  /**
+   * gas price votes deviating from the median by more than this percentage are ignored, 0 disables the check
+   *
+   * @generated from field: uint64 gas_price_max_deviation_percent = 17;
+   */
+  gasPriceMaxDeviationPercent: bigint;
+
   constructor(data?: PartialMessage<CoreParams>);
 
   static readonly runtime: typeof proto3;
@@ -144,6 +173,11 @@ export declare class ObserverParams extends Message<ObserverParams> {
    */
   isSupported: boolean;
 
+  /**
+   * @generated from field: zetachain.zetacore.observer.BallotWeighting ballot_weighting = 6;
+   */
+  ballotWeighting: BallotWeighting;
+
   constructor(data?: PartialMessage<ObserverParams>);
 
   static readonly runtime: typeof proto3;
@@ -209,6 +243,13 @@ export declare class Params extends Message<Params> {
    */
   ballotMaturityBlocks: bigint;
 
+  /**
+   * emit an EventBallotArchived with the final votes of each matured ballot before it is deleted
+   *
+   * @generated from field: bool archive_matured_ballots = 4;
+   */
+  archiveMaturedBallots: boolean;
+
   constructor(data?: PartialMessage<Params>);
 
   static readonly runtime: typeof proto3;
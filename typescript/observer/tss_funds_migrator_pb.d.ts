@@ -20,6 +20,13 @@ export declare class TssFundMigratorInfo extends Message<TssFundMigratorInfo> {
    */
   migrationCctxIndex: string;
 
+  /**
+   * indexes of the cmd cctxs updating the TSS address in the connector contract of the chain
+   *
+   * @generated from field: repeated string contract_update_cctx_indexes = 3;
+   */
+  contractUpdateCctxIndexes: string[];
+
   constructor(data?: PartialMessage<TssFundMigratorInfo>);
 
   static readonly runtime: typeof proto3;
@@ -35,3 +42,45 @@ export declare class TssFundMigratorInfo extends Message<TssFundMigratorInfo> {
   static equals(a: TssFundMigratorInfo | PlainMessage<TssFundMigratorInfo> | undefined, b: TssFundMigratorInfo | PlainMessage<TssFundMigratorInfo> | undefined): boolean;
 }
 
+
+/**
+ * TssBalance is the balance of the TSS on a chain voted by the observers, used to migrate the funds to a new TSS
+ * For Bitcoin it's the value of the UTXOs spendable by one sweep transaction
+ *
+ * @generated from message zetachain.zetacore.observer.TssBalance
+ */
+export declare class TssBalance extends Message<TssBalance> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string tss_pubkey = 2;
+   */
+  tssPubkey: string;
+
+  /**
+   * @generated from field: string balance = 3;
+   */
+  balance: string;
+
+  /**
+   * @generated from field: int64 finalized_zeta_height = 4;
+   */
+  finalizedZetaHeight: bigint;
+
+  constructor(data?: PartialMessage<TssBalance>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.TssBalance";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TssBalance;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TssBalance;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TssBalance;
+
+  static equals(a: TssBalance | PlainMessage<TssBalance> | undefined, b: TssBalance | PlainMessage<TssBalance> | undefined): boolean;
+}
@@ -9,7 +9,7 @@ import type { ObserverUpdateReason } from "./observer_pb.js";
 import type { HeaderData } from "../common/common_pb.js";
 import type { CoreParams } from "./params_pb.js";
 import type { Blame } from "./blame_pb.js";
-import type { BlockHeaderVerificationFlags, GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { BlockHeaderVerificationFlags, ChainCrosschainFlags, GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.MsgUpdateObserver
@@ -320,6 +320,20 @@ export declare class MsgUpdateCrosschainFlags extends Message<MsgUpdateCrosschai
    */
   blockHeaderVerificationFlags?: BlockHeaderVerificationFlags;
 
+  /**
+   * Per chain overrides, an override with inbound and outbound enabled removes the override of the chain
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ChainCrosschainFlags chainFlags = 7;
+   */
+  chainFlags: ChainCrosschainFlags[];
+
+  /**
+   * Block height at which the inbound and outbound disabled by the message are enabled again, 0 to disable until updated
+   *
+   * @generated from field: int64 reEnableHeight = 8;
+   */
+  reEnableHeight: bigint;
+
   constructor(data?: PartialMessage<MsgUpdateCrosschainFlags>);
 
   static readonly runtime: typeof proto3;
@@ -402,3 +416,133 @@ export declare class MsgUpdateKeygenResponse extends Message<MsgUpdateKeygenResp
   static equals(a: MsgUpdateKeygenResponse | PlainMessage<MsgUpdateKeygenResponse> | undefined, b: MsgUpdateKeygenResponse | PlainMessage<MsgUpdateKeygenResponse> | undefined): boolean;
 }
 
+/**
+ * MsgVoteInboundHalt is the vote of an observer to halt the inbound of a chain
+ * when the supply of a ZRC20 of the chain is not backed by the assets held on the chain
+ *
+ * @generated from message zetachain.zetacore.observer.MsgVoteInboundHalt
+ */
+export declare class MsgVoteInboundHalt extends Message<MsgVoteInboundHalt> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string zrc20 = 3;
+   */
+  zrc20: string;
+
+  /**
+   * ZetaChain height of the solvency check confirming the deficit, the observers vote for the same check height
+   *
+   * @generated from field: int64 check_height = 4;
+   */
+  checkHeight: bigint;
+
+  /**
+   * ZRC20 supply not backed by the assets held on the chain, as observed by the voter
+   *
+   * @generated from field: string deficit = 5;
+   */
+  deficit: string;
+
+  constructor(data?: PartialMessage<MsgVoteInboundHalt>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgVoteInboundHalt";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgVoteInboundHalt;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgVoteInboundHalt;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgVoteInboundHalt;
+
+  static equals(a: MsgVoteInboundHalt | PlainMessage<MsgVoteInboundHalt> | undefined, b: MsgVoteInboundHalt | PlainMessage<MsgVoteInboundHalt> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgVoteInboundHaltResponse
+ */
+export declare class MsgVoteInboundHaltResponse extends Message<MsgVoteInboundHaltResponse> {
+  constructor(data?: PartialMessage<MsgVoteInboundHaltResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgVoteInboundHaltResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgVoteInboundHaltResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgVoteInboundHaltResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgVoteInboundHaltResponse;
+
+  static equals(a: MsgVoteInboundHaltResponse | PlainMessage<MsgVoteInboundHaltResponse> | undefined, b: MsgVoteInboundHaltResponse | PlainMessage<MsgVoteInboundHaltResponse> | undefined): boolean;
+}
+
+
+/**
+ * MsgVoteTssBalance is the vote of an observer on the balance of the current TSS on a chain
+ *
+ * @generated from message zetachain.zetacore.observer.MsgVoteTssBalance
+ */
+export declare class MsgVoteTssBalance extends Message<MsgVoteTssBalance> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string tss_pubkey = 3;
+   */
+  tssPubkey: string;
+
+  /**
+   * @generated from field: string balance = 4;
+   */
+  balance: string;
+
+  constructor(data?: PartialMessage<MsgVoteTssBalance>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgVoteTssBalance";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgVoteTssBalance;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgVoteTssBalance;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgVoteTssBalance;
+
+  static equals(a: MsgVoteTssBalance | PlainMessage<MsgVoteTssBalance> | undefined, b: MsgVoteTssBalance | PlainMessage<MsgVoteTssBalance> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgVoteTssBalanceResponse
+ */
+export declare class MsgVoteTssBalanceResponse extends Message<MsgVoteTssBalanceResponse> {
+  constructor(data?: PartialMessage<MsgVoteTssBalanceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgVoteTssBalanceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgVoteTssBalanceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgVoteTssBalanceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgVoteTssBalanceResponse;
+
+  static equals(a: MsgVoteTssBalanceResponse | PlainMessage<MsgVoteTssBalanceResponse> | undefined, b: MsgVoteTssBalanceResponse | PlainMessage<MsgVoteTssBalanceResponse> | undefined): boolean;
+}
@@ -88,6 +88,78 @@ func CmdPendingCctx() *cobra.Command {
 	return cmd
 }
 
+const (
+	flagSender          = "sender"
+	flagReceiver        = "receiver"
+	flagStatus          = "status"
+	flagSenderChainID   = "sender-chain-id"
+	flagReceiverChainID = "receiver-chain-id"
+	flagStartTimestamp  = "start-timestamp"
+	flagEndTimestamp    = "end-timestamp"
+)
+
+func CmdSearchCctx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-cctx",
+		Short: "search CCTX by sender, receiver, status, sender and receiver chain in a creation time range",
+		Example: `zetacored query crosschain search-cctx --status Aborted --receiver-chain-id 97 --start-timestamp 1700000000
+zetacored query crosschain search-cctx --sender 0x9c6a3f2bb6d17bbc9e5dc5bbcd43a6a1e4a0a5a4`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			params := &types.QueryCctxSearchRequest{
+				Pagination: pageReq,
+			}
+			if params.Sender, err = cmd.Flags().GetString(flagSender); err != nil {
+				return err
+			}
+			if params.Receiver, err = cmd.Flags().GetString(flagReceiver); err != nil {
+				return err
+			}
+			if params.Status, err = cmd.Flags().GetString(flagStatus); err != nil {
+				return err
+			}
+			if params.SenderChainId, err = cmd.Flags().GetInt64(flagSenderChainID); err != nil {
+				return err
+			}
+			if params.ReceiverChainId, err = cmd.Flags().GetInt64(flagReceiverChainID); err != nil {
+				return err
+			}
+			if params.StartTimestamp, err = cmd.Flags().GetInt64(flagStartTimestamp); err != nil {
+				return err
+			}
+			if params.EndTimestamp, err = cmd.Flags().GetInt64(flagEndTimestamp); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CctxSearch(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "sender of the inbound")
+	cmd.Flags().String(flagReceiver, "", "receiver of the outbound")
+	cmd.Flags().String(flagStatus, "", "status of the CCTX, e.g. Aborted")
+	cmd.Flags().Int64(flagSenderChainID, 0, "chain id of the sender")
+	cmd.Flags().Int64(flagReceiverChainID, 0, "chain id of the receiver")
+	cmd.Flags().Int64(flagStartTimestamp, 0, "unix timestamp from which the CCTX were created")
+	cmd.Flags().Int64(flagEndTimestamp, 0, "unix timestamp until which the CCTX were created")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowSend() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-cctx [index]",
@@ -281,3 +353,53 @@ func CmdCCTXOutboundVoter() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAbortStuckCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "abort-stuck-cctx [cctx-index]",
+		Short: "Abort a CCTX stuck in pending outbound or pending revert",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAbortStuckCCTX(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRefundAbortedCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-aborted-cctx [cctx-index] [refund-address]",
+		Short: "Refund the amount of an aborted CCTX on ZetaChain, to the inbound sender if no refund address is provided",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			refundAddress := ""
+			if len(args) > 1 {
+				refundAddress = args[1]
+			}
+			msg := types.NewMsgRefundAbortedCCTX(clientCtx.GetFromAddress().String(), args[0], refundAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
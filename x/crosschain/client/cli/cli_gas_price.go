@@ -11,6 +11,8 @@ import (
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 )
 
+const flagPriorityFee = "priority-fee"
+
 func CmdListGasPrice() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-gas-price",
@@ -72,6 +74,39 @@ func CmdShowGasPrice() *cobra.Command {
 	return cmd
 }
 
+func CmdGasPriceVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price-votes [chain-id]",
+		Short: "shows the gas price votes of a chain with their freshness",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGasPriceVotesRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.GasPriceVotes(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // Transaction CLI /////////////////////////
 
 func CmdGasPriceVoter() *cobra.Command {
@@ -94,12 +129,16 @@ func CmdGasPriceVoter() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			argsPriorityFee, err := cmd.Flags().GetUint64(flagPriorityFee)
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgGasPriceVoter(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsSupply, argsBlockNumber)
+			msg := types.NewMsgGasPriceVoter(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsPriorityFee, argsSupply, argsBlockNumber)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -107,6 +146,7 @@ func CmdGasPriceVoter() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagPriorityFee, 0, "priority fee (EIP-1559 tip) of the chain")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -111,3 +111,49 @@ func CmdMigrateTssFunds() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdMigrateAllTssFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-all-tss-funds",
+		Short: "Migrate the voted TSS funds of every supported chain to the latest TSS address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateAllTssFunds(clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdShowTssFundsMigrationProgress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-tss-funds-migration-progress",
+		Short: "Query the progress of the TSS funds migration of every chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryTssFundsMigrationProgressRequest{}
+			res, err := queryClient.TssFundsMigrationProgress(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
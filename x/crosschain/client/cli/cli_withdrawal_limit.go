@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func CmdShowWithdrawalLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-withdrawal-limits",
+		Short: "shows the withdrawal limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WithdrawalLimits(context.Background(), &types.QueryWithdrawalLimitsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdShowWithdrawalUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-withdrawal-usage",
+		Short: "shows the value withdrawn per limited ZRC20 and chain in the current window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WithdrawalUsage(context.Background(), &types.QueryWithdrawalUsageRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdListQueuedWithdrawals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-queued-withdrawals",
+		Short: "list the withdrawals queued for exceeding the withdrawal limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllQueuedWithdrawalRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.QueuedWithdrawalAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateWithdrawalLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-withdrawal-limits [withdrawal-limits.json]",
+		Short: "Update the withdrawal limits per ZRC20 and chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := filepath.Abs(args[0])
+			if err != nil {
+				return err
+			}
+			file = filepath.Clean(file)
+			input, err := os.ReadFile(file) // #nosec G304
+			if err != nil {
+				return err
+			}
+			var limits types.WithdrawalLimits
+			if err := clientCtx.Codec.UnmarshalJSON(input, &limits); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateWithdrawalLimits(clientCtx.GetFromAddress().String(), limits)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdReleaseQueuedWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-queued-withdrawal [cctx-index]",
+		Short: "Release a withdrawal queued for exceeding the withdrawal limits",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseQueuedWithdrawal(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelQueuedWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-queued-withdrawal [cctx-index]",
+		Short: "Cancel a withdrawal queued for exceeding the withdrawal limits and refund its amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelQueuedWithdrawal(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
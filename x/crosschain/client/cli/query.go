@@ -30,6 +30,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdShowOutTxTracker(),
 		CmdListGasPrice(),
 		CmdShowGasPrice(),
+		CmdGasPriceVotes(),
 
 		CmdListSend(),
 		CmdShowSend(),
@@ -40,9 +41,14 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdQueryParams(),
 
 		CmdPendingCctx(),
+		CmdSearchCctx(),
 		CmdListInTxTrackerByChain(),
 		CmdListInTxTrackers(),
 		CmdGetZetaAccounting(),
+		CmdShowWithdrawalLimits(),
+		CmdShowWithdrawalUsage(),
+		CmdListQueuedWithdrawals(),
+		CmdShowTssFundsMigrationProgress(),
 	)
 
 	return cmd
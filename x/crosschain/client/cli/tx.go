@@ -29,7 +29,13 @@ func GetTxCmd() *cobra.Command {
 		CmdRemoveFromWatchList(),
 		CmdUpdateTss(),
 		CmdMigrateTssFunds(),
+		CmdMigrateAllTssFunds(),
 		CmdAddToInTxTracker(),
+		CmdUpdateWithdrawalLimits(),
+		CmdReleaseQueuedWithdrawal(),
+		CmdCancelQueuedWithdrawal(),
+		CmdAbortStuckCCTX(),
+		CmdRefundAbortedCCTX(),
 	)
 
 	return cmd
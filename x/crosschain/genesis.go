@@ -13,6 +13,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.SetParams(ctx, genState.Params)
 
 	k.SetZetaAccounting(ctx, genState.ZetaAccounting)
+	k.SetWithdrawalLimits(ctx, genState.WithdrawalLimits)
+
+	// Set all the queued withdrawals
+	for _, elem := range genState.QueuedWithdrawals {
+		k.SetQueuedWithdrawal(ctx, elem)
+	}
+
 	// Set all the outTxTracker
 	for _, elem := range genState.OutTxTrackerList {
 		k.SetOutTxTracker(ctx, elem)
@@ -88,5 +95,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.ZetaAccounting = amount
 	}
 
+	withdrawalLimits, found := k.GetWithdrawalLimits(ctx)
+	if found {
+		genesis.WithdrawalLimits = withdrawalLimits
+	}
+	genesis.QueuedWithdrawals = k.GetAllQueuedWithdrawal(ctx)
+
 	return &genesis
 }
@@ -15,6 +15,9 @@ import (
 const (
 	// RemainingFeesToStabilityPoolPercent is the percentage of remaining fees used to fund the gas stability pool
 	RemainingFeesToStabilityPoolPercent = 95
+
+	// MaxExpiredOutboundsPerChain is the maximum number of outbounds expired for a chain in a block
+	MaxExpiredOutboundsPerChain = 10
 )
 
 // IterateAndUpdateCctxGasPrice iterates through all cctx and updates the gas price if pending for too long
@@ -91,6 +94,10 @@ func (k Keeper) CheckAndUpdateCctxGasPrice(
 		return math.ZeroUint(), math.ZeroUint(), err
 	}
 	newGasPrice := math.NewUint(currentGasPrice).Add(gasPriceIncrease)
+	currentPriorityFee, err := cctx.GetCurrentOutTxParam().GetGasPriorityFee()
+	if err != nil {
+		return math.ZeroUint(), math.ZeroUint(), err
+	}
 
 	// check limit -- use default limit if not set
 	gasPriceIncreaseMax := flags.GasPriceIncreaseMax
@@ -114,8 +121,83 @@ func (k Keeper) CheckAndUpdateCctxGasPrice(
 
 	// set new gas price and last update timestamp
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = newGasPrice.String()
+	if cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee != "" {
+		// the tip is increased as well, otherwise an EIP-1559 tx can't replace the pending one
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = math.NewUint(currentPriorityFee).Add(gasPriceIncrease).String()
+	}
 	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
 	k.SetCrossChainTx(ctx, cctx)
 
 	return gasPriceIncrease, additionalFees, nil
 }
+
+// IterateAndExpireCctxOutbound iterates through the pending outbounds of the chains with an outbound timeout and expires them
+// outbounds are expired in nonce order from the lowest pending nonce, the iteration for a chain stops at the first outbound not expired
+func (k Keeper) IterateAndExpireCctxOutbound(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if len(params.OutboundTimeouts) == 0 {
+		return nil
+	}
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return types.ErrCannotFindTSSKeys
+	}
+
+	for _, timeout := range params.OutboundTimeouts {
+		pendingNonces, found := k.GetObserverKeeper().GetPendingNonces(ctx, tss.TssPubkey, timeout.ChainId)
+		if !found {
+			continue
+		}
+		for nonce := pendingNonces.NonceLow; nonce < pendingNonces.NonceHigh && nonce < pendingNonces.NonceLow+MaxExpiredOutboundsPerChain; nonce++ {
+			nonceToCctx, found := k.GetObserverKeeper().GetNonceToCctx(ctx, tss.TssPubkey, timeout.ChainId, nonce)
+			if !found {
+				break
+			}
+			cctx, found := k.GetCrossChainTx(ctx, nonceToCctx.CctxIndex)
+			if !found {
+				break
+			}
+			if !k.CheckAndExpireCctxOutbound(ctx, cctx, params) {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckAndExpireCctxOutbound expires the pending outbound of the cctx if its timeout and grace period are reached
+// and no tx has been added to the outbound tracker for its nonce, observers stop signing the outbound once its timeout is reached
+// A tx signed before the timeout can still land, so the nonce is not released: observers sign a cancel tx for the nonce
+// of the expired outbound, the cctx is reverted once the cancel tx is observed or finalized if the signed tx lands instead
+// The function returns true if the outbound is expired
+func (k Keeper) CheckAndExpireCctxOutbound(
+	ctx sdk.Context,
+	cctx types.CrossChainTx,
+	params types.Params,
+) bool {
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingOutbound {
+		return false
+	}
+	outbound := cctx.GetCurrentOutTxParam()
+	if outbound.OutboundTxExpired {
+		return true
+	}
+	// #nosec G701 always positive
+	if !params.IsOutboundExpired(*outbound, uint64(ctx.BlockHeight())) {
+		return false
+	}
+	chainID, nonce := outbound.ReceiverChainId, outbound.OutboundTxTssNonce
+	if _, found := k.GetOutTxTracker(ctx, chainID, nonce); found {
+		return false
+	}
+
+	outbound.OutboundTxExpired = true
+	cctx.CctxStatus.StatusMessage = fmt.Sprintf("Outbound expired at height %d, cancel nonce %d", ctx.BlockHeight(), nonce)
+	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
+	k.SetCrossChainTx(ctx, cctx)
+	EmitOutboundExpired(ctx, cctx)
+	k.Logger(ctx).Info(fmt.Sprintf("outbound expired: cctx %s, chain id %d, nonce %d", cctx.Index, chainID, nonce))
+
+	return true
+}
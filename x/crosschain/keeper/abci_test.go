@@ -2,12 +2,18 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	testkeeper "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -52,6 +58,53 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
 			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
 		},
+		{
+			name: "can update gas price and priority fee of EIP-1559 tx",
+			cctx: types.CrossChainTx{
+				Index: "a1-eip1559",
+				CctxStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:          42,
+						OutboundTxGasLimit:       1000,
+						OutboundTxGasPrice:       "100",
+						OutboundTxGasPriorityFee: "10",
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         50,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
+			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
+		},
+		{
+			name: "can update bitcoin gas price to fund replace-by-fee",
+			cctx: types.CrossChainTx{
+				Index: "a1-btc",
+				CctxStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:    common.BtcMainnetChain().ChainId,
+						OutboundTxGasLimit: 254, // tx size in bytes
+						OutboundTxGasPrice: "20",
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         10,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(10),   // 100% medianGasPrice (sat/byte)
+			expectedAdditionalFees:                 math.NewUint(2540), // tx size * increase
+		},
 		{
 			name: "can update gas price at max limit",
 			cctx: types.CrossChainTx{
@@ -257,6 +310,8 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 			if err != nil {
 				previousGasPrice = 0
 			}
+			previousPriorityFee, err := tc.cctx.GetCurrentOutTxParam().GetGasPriorityFee()
+			require.NoError(t, err)
 
 			// set median gas price if not zero
 			if tc.medianGasPrice != 0 {
@@ -298,6 +353,14 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 			if !tc.expectedGasPriceIncrease.IsZero() {
 				cctx, found := k.GetCrossChainTx(ctx, tc.cctx.Index)
 				require.True(t, found)
+
+				// the priority fee is increased by the same amount if set
+				if tc.cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee != "" {
+					newPriorityFee, err := cctx.GetCurrentOutTxParam().GetGasPriorityFee()
+					require.NoError(t, err)
+					require.EqualValues(t, tc.expectedGasPriceIncrease.Uint64()+previousPriorityFee, newPriorityFee)
+				}
+
 				newGasPrice, err := cctx.GetCurrentOutTxParam().GetGasPrice()
 				require.NoError(t, err)
 				require.EqualValues(t, tc.expectedGasPriceIncrease.AddUint64(previousGasPrice).Uint64(), newGasPrice, "%d - %d", tc.expectedGasPriceIncrease.Uint64(), previousGasPrice)
@@ -306,3 +369,152 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 		})
 	}
 }
+
+// setupOutboundTimeout sets the TSS, the supported chains and the outbound timeout of the receiver chain
+func setupOutboundTimeout(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	zk testkeeper.ZetaKeepers,
+	receiverChainID int64,
+	timeout uint64,
+) observertypes.TSS {
+	params := zk.ObserverKeeper.GetParams(ctx)
+	for _, chain := range []common.Chain{*getValidEthChain(t), common.BscTestnetChain()} {
+		chain := chain
+		params.ObserverParams = append(params.ObserverParams, &observertypes.ObserverParams{
+			Chain:                 &chain,
+			BallotThreshold:       sdk.NewDec(0),
+			MinObserverDelegation: sdk.OneDec(),
+			IsSupported:           true,
+		})
+	}
+	zk.ObserverKeeper.SetParams(ctx, params)
+	tss := sample.Tss()
+	zk.ObserverKeeper.SetTssAndUpdateNonce(ctx, tss)
+	k.SetParams(ctx, types.Params{
+		OutboundTimeouts: []*types.OutboundTimeout{{ChainId: receiverChainID, TimeoutBlocks: timeout}},
+	})
+	return tss
+}
+
+// setPendingOutbound creates a pending outbound cctx to the receiver chain with the next nonce
+func setPendingOutbound(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	index string,
+	senderChainID int64,
+	receiverChainID int64,
+) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+	cctx.InboundTxParams.SenderChainId = senderChainID
+	cctx.InboundTxParams.Sender = sample.EthAddress().String()
+	cctx.InboundTxParams.CoinType = common.CoinType_Gas
+	cctx.InboundTxParams.Amount = math.NewUint(inputAmount)
+	cctx.OutboundTxParams = []*types.OutboundTxParams{{
+		Receiver:        sample.EthAddress().String(),
+		ReceiverChainId: receiverChainID,
+		CoinType:        common.CoinType_Gas,
+		Amount:          math.NewUint(inputAmount),
+	}}
+	require.NoError(t, k.UpdateNonce(ctx, receiverChainID, cctx))
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, *cctx)
+	return *cctx
+}
+
+func TestKeeper_IterateAndExpireCctxOutbound(t *testing.T) {
+	receiverChainID := common.BscTestnetChain().ChainId
+	zetaChainID := common.ZetaPrivnetChain().ChainId
+	timeout := uint64(10)
+	createdHeight := int64(100)
+	expiredHeight := createdHeight + int64(timeout) + types.OutboundTimeoutGracePeriod
+
+	t.Run("should expire outbounds and keep their nonces pending", func(t *testing.T) {
+		k, ctx, _, zk := testkeeper.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(createdHeight)
+		tss := setupOutboundTimeout(t, ctx, k, zk, receiverChainID, timeout)
+		cctx0 := setPendingOutbound(t, ctx, k, "0", zetaChainID, receiverChainID)
+		cctx1 := setPendingOutbound(t, ctx, k, "1", zetaChainID, receiverChainID)
+
+		ctx = ctx.WithBlockHeight(expiredHeight).WithEventManager(sdk.NewEventManager())
+		err := k.IterateAndExpireCctxOutbound(ctx)
+		require.NoError(t, err)
+
+		for _, index := range []string{cctx0.Index, cctx1.Index} {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+			require.True(t, cctx.GetCurrentOutTxParam().OutboundTxExpired)
+		}
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, receiverChainID)
+		require.True(t, found)
+		require.EqualValues(t, 0, pendingNonces.NonceLow)
+		require.EqualValues(t, 2, pendingNonces.NonceHigh)
+
+		var expiredEvents int
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&types.EventOutboundExpired{}) {
+				expiredEvents++
+			}
+		}
+		require.Equal(t, 2, expiredEvents)
+	})
+
+	t.Run("should not expire an outbound twice", func(t *testing.T) {
+		k, ctx, _, zk := testkeeper.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(createdHeight)
+		setupOutboundTimeout(t, ctx, k, zk, receiverChainID, timeout)
+		cctx := setPendingOutbound(t, ctx, k, "0", zetaChainID, receiverChainID)
+
+		err := k.IterateAndExpireCctxOutbound(ctx.WithBlockHeight(expiredHeight))
+		require.NoError(t, err)
+		cctx, _ = k.GetCrossChainTx(ctx, cctx.Index)
+		statusMessage := cctx.CctxStatus.StatusMessage
+
+		ctx = ctx.WithBlockHeight(expiredHeight + 1).WithEventManager(sdk.NewEventManager())
+		err = k.IterateAndExpireCctxOutbound(ctx)
+		require.NoError(t, err)
+		cctx, _ = k.GetCrossChainTx(ctx, cctx.Index)
+		require.Equal(t, statusMessage, cctx.CctxStatus.StatusMessage)
+		require.Empty(t, ctx.EventManager().Events())
+	})
+
+	t.Run("should not expire outbounds before the end of the grace period", func(t *testing.T) {
+		k, ctx, _, zk := testkeeper.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(createdHeight)
+		setupOutboundTimeout(t, ctx, k, zk, receiverChainID, timeout)
+		cctx := setPendingOutbound(t, ctx, k, "0", zetaChainID, receiverChainID)
+
+		err := k.IterateAndExpireCctxOutbound(ctx.WithBlockHeight(expiredHeight - 1))
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.False(t, cctx.GetCurrentOutTxParam().OutboundTxExpired)
+	})
+
+	t.Run("should stop at the first outbound with a tracker", func(t *testing.T) {
+		k, ctx, _, zk := testkeeper.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(createdHeight)
+		setupOutboundTimeout(t, ctx, k, zk, receiverChainID, timeout)
+		cctx0 := setPendingOutbound(t, ctx, k, "0", zetaChainID, receiverChainID)
+		cctx1 := setPendingOutbound(t, ctx, k, "1", zetaChainID, receiverChainID)
+		k.SetOutTxTracker(ctx, types.OutTxTracker{
+			Index:   fmt.Sprintf("%d-%d", receiverChainID, 0),
+			ChainId: receiverChainID,
+			Nonce:   0,
+		})
+
+		err := k.IterateAndExpireCctxOutbound(ctx.WithBlockHeight(expiredHeight))
+		require.NoError(t, err)
+
+		for _, index := range []string{cctx0.Index, cctx1.Index} {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.False(t, cctx.GetCurrentOutTxParam().OutboundTxExpired)
+		}
+	})
+}
@@ -16,23 +16,13 @@ import (
 // 2. set the mapping inTxHash -> cctxIndex , one inTxHash can be connected to multiple cctxindex
 // 3. set the mapping nonce => cctx
 // 4. update the zeta accounting
+// 5. update the secondary indexes of the cctx
 func (k Keeper) SetCctxAndNonceToCctxAndInTxHashToCctx(ctx sdk.Context, cctx types.CrossChainTx) {
+	k.updateCctxIndexes(ctx, cctx)
 	k.SetCrossChainTx(ctx, cctx)
 
 	// set mapping inTxHash -> cctxIndex
-	in, _ := k.GetInTxHashToCctx(ctx, cctx.InboundTxParams.InboundTxObservedHash)
-	in.InTxHash = cctx.InboundTxParams.InboundTxObservedHash
-	found := false
-	for _, cctxIndex := range in.CctxIndex {
-		if cctxIndex == cctx.Index {
-			found = true
-			break
-		}
-	}
-	if !found {
-		in.CctxIndex = append(in.CctxIndex, cctx.Index)
-	}
-	k.SetInTxHashToCctx(ctx, in)
+	k.AddCctxToInTxHashToCctx(ctx, cctx.InboundTxParams.InboundTxObservedHash, cctx.Index)
 
 	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
 	if !found {
@@ -94,6 +84,9 @@ func (k Keeper) GetAllCrossChainTx(ctx sdk.Context) (list []types.CrossChainTx)
 
 // RemoveCrossChainTx removes a send from the store
 func (k Keeper) RemoveCrossChainTx(ctx sdk.Context, index string) {
+	if cctx, found := k.GetCrossChainTx(ctx, index); found {
+		k.RemoveCctxIndexes(ctx, cctx)
+	}
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.SendKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
 	store.Delete(types.KeyPrefix(index))
@@ -133,6 +126,7 @@ func (k Keeper) CreateNewCCTX(ctx sdk.Context, msg *types.MsgVoteOnObservedInbou
 		Status:              s,
 		StatusMessage:       "",
 		LastUpdateTimestamp: ctx.BlockHeader().Time.Unix(),
+		CreatedTimestamp:    ctx.BlockHeader().Time.Unix(),
 	}
 	newCctx := types.CrossChainTx{
 		Creator:          msg.Creator,
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// cctxIndexEntry is the value of a cctx in one of the cctx secondary indexes
+type cctxIndexEntry struct {
+	indexKey string
+	value    string
+}
+
+// cctxIndexEntries returns the values of the cctx in the secondary indexes
+// the receiver is the receiver of the first outbound, the revert outbound being sent back to the sender
+func cctxIndexEntries(cctx types.CrossChainTx) []cctxIndexEntry {
+	var entries []cctxIndexEntry
+	if cctx.InboundTxParams != nil {
+		entries = append(entries,
+			cctxIndexEntry{types.CctxSenderIndexKey, cctx.InboundTxParams.Sender},
+			cctxIndexEntry{types.CctxSenderChainIndexKey, strconv.FormatInt(cctx.InboundTxParams.SenderChainId, 10)},
+		)
+	}
+	if len(cctx.OutboundTxParams) > 0 && cctx.OutboundTxParams[0] != nil {
+		entries = append(entries,
+			cctxIndexEntry{types.CctxReceiverIndexKey, cctx.OutboundTxParams[0].Receiver},
+			cctxIndexEntry{types.CctxReceiverChainIndexKey, strconv.FormatInt(cctx.OutboundTxParams[0].ReceiverChainId, 10)},
+		)
+	}
+	if cctx.CctxStatus != nil {
+		entries = append(entries, cctxIndexEntry{types.CctxStatusIndexKey, cctx.CctxStatus.Status.String()})
+	}
+	return entries
+}
+
+func cctxCreatedTimestamp(cctx types.CrossChainTx) int64 {
+	if cctx.CctxStatus == nil {
+		return 0
+	}
+	return cctx.CctxStatus.CreatedTimestamp
+}
+
+// SetCctxIndexes adds the cctx to the secondary indexes
+func (k Keeper) SetCctxIndexes(ctx sdk.Context, cctx types.CrossChainTx) {
+	for _, entry := range cctxIndexEntries(cctx) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(entry.indexKey))
+		store.Set(types.CctxIndexKey(entry.value, cctxCreatedTimestamp(cctx), cctx.Index), []byte(cctx.Index))
+	}
+}
+
+// RemoveCctxIndexes removes the cctx from the secondary indexes
+func (k Keeper) RemoveCctxIndexes(ctx sdk.Context, cctx types.CrossChainTx) {
+	for _, entry := range cctxIndexEntries(cctx) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(entry.indexKey))
+		store.Delete(types.CctxIndexKey(entry.value, cctxCreatedTimestamp(cctx), cctx.Index))
+	}
+}
+
+// StartCctxIndexBackfill schedules the backfill of the secondary indexes with the existing cctxs from the next block
+func (k Keeper) StartCctxIndexBackfill(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.CctxIndexBackfillCursorKey), types.KeyPrefix(types.SendKey))
+}
+
+// BackfillCctxIndexes adds at most limit cctxs from the backfill cursor to the secondary indexes
+// the cctxs created before the secondary indexes get their last update timestamp as creation timestamp
+// the cursor is moved to the next cctx, or removed once all the cctxs have been visited
+func (k Keeper) BackfillCctxIndexes(ctx sdk.Context, limit int) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.KeyPrefix(types.CctxIndexBackfillCursorKey))
+	if cursor == nil {
+		return
+	}
+
+	// the cctxs are read first since the store can't be written while iterating
+	var cctxs []types.CrossChainTx
+	var next []byte
+	iterator := store.Iterator(cursor, sdk.PrefixEndBytes(types.KeyPrefix(types.SendKey)))
+	for ; iterator.Valid(); iterator.Next() {
+		if len(cctxs) == limit {
+			next = iterator.Key()
+			break
+		}
+		var cctx types.CrossChainTx
+		k.cdc.MustUnmarshal(iterator.Value(), &cctx)
+		cctxs = append(cctxs, cctx)
+	}
+	iterator.Close()
+
+	for _, cctx := range cctxs {
+		if cctx.CctxStatus == nil {
+			continue
+		}
+		if cctx.CctxStatus.CreatedTimestamp == 0 {
+			// the cctx updated since the upgrade has entries at a zero creation timestamp, replaced here
+			k.RemoveCctxIndexes(ctx, cctx)
+			cctx.CctxStatus.CreatedTimestamp = cctx.CctxStatus.LastUpdateTimestamp
+			k.SetCrossChainTx(ctx, cctx)
+		}
+		k.SetCctxIndexes(ctx, cctx)
+	}
+
+	if next == nil {
+		store.Delete(types.KeyPrefix(types.CctxIndexBackfillCursorKey))
+		return
+	}
+	store.Set(types.KeyPrefix(types.CctxIndexBackfillCursorKey), next)
+}
+
+// updateCctxIndexes updates the secondary indexes with the new version of the cctx
+// only the entries changed from the stored version of the cctx are written, usually the status entry
+func (k Keeper) updateCctxIndexes(ctx sdk.Context, cctx types.CrossChainTx) {
+	storedCctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+	if !found {
+		k.SetCctxIndexes(ctx, cctx)
+		return
+	}
+
+	storedEntries := cctxIndexEntries(storedCctx)
+	entries := cctxIndexEntries(cctx)
+	for _, storedEntry := range storedEntries {
+		if !containsCctxIndexEntry(entries, storedEntry) || cctxCreatedTimestamp(storedCctx) != cctxCreatedTimestamp(cctx) {
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(storedEntry.indexKey))
+			store.Delete(types.CctxIndexKey(storedEntry.value, cctxCreatedTimestamp(storedCctx), storedCctx.Index))
+		}
+	}
+	for _, entry := range entries {
+		if !containsCctxIndexEntry(storedEntries, entry) || cctxCreatedTimestamp(storedCctx) != cctxCreatedTimestamp(cctx) {
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(entry.indexKey))
+			store.Set(types.CctxIndexKey(entry.value, cctxCreatedTimestamp(cctx), cctx.Index), []byte(cctx.Index))
+		}
+	}
+}
+
+func containsCctxIndexEntry(entries []cctxIndexEntry, entry cctxIndexEntry) bool {
+	for _, e := range entries {
+		if e == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// cctxIndexStore returns the store of the cctxs with the value in the secondary index
+func (k Keeper) cctxIndexStore(ctx sdk.Context, indexKey string, value string) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(indexKey), types.CctxIndexValuePrefix(value)...),
+	)
+}
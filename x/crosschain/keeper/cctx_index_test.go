@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_BackfillCctxIndexes(t *testing.T) {
+	t.Run("should backfill the indexes over several blocks", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		sender := sample.EthAddress().Hex()
+		for i := 0; i < 5; i++ {
+			cctx := sample.CrossChainTx(t, fmt.Sprintf("%d", i))
+			cctx.InboundTxParams.Sender = sender
+			cctx.CctxStatus.CreatedTimestamp = 0
+			cctx.CctxStatus.LastUpdateTimestamp = int64(100 * (i + 1))
+			k.SetCrossChainTx(ctx, *cctx)
+		}
+		k.StartCctxIndexBackfill(ctx)
+
+		search := func() []*types.CrossChainTx {
+			res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{Sender: sender})
+			require.NoError(t, err)
+			return res.CrossChainTx
+		}
+
+		k.BackfillCctxIndexes(ctx, 2)
+		require.Len(t, search(), 2)
+		k.BackfillCctxIndexes(ctx, 2)
+		require.Len(t, search(), 4)
+		k.BackfillCctxIndexes(ctx, 2)
+		require.Len(t, search(), 5)
+
+		cursor := ctx.KVStore(k.GetStoreKey()).Get(types.KeyPrefix(types.CctxIndexBackfillCursorKey))
+		require.Nil(t, cursor)
+		for i := 0; i < 5; i++ {
+			cctx, found := k.GetCrossChainTx(ctx, fmt.Sprintf("%d", i))
+			require.True(t, found)
+			require.EqualValues(t, 100*(i+1), cctx.CctxStatus.CreatedTimestamp)
+		}
+
+		// no-op once the backfill is done
+		k.BackfillCctxIndexes(ctx, 2)
+		require.Len(t, search(), 5)
+	})
+
+	t.Run("should replace the entries of a cctx updated before its backfill", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := sample.CrossChainTx(t, "0")
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		cctx.CctxStatus.CreatedTimestamp = 0
+		cctx.CctxStatus.LastUpdateTimestamp = 100
+		k.SetCrossChainTx(ctx, *cctx)
+		k.StartCctxIndexBackfill(ctx)
+
+		// the cctx is updated before the backfill reaches it
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		cctx.CctxStatus.LastUpdateTimestamp = 200
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, *cctx)
+
+		k.BackfillCctxIndexes(ctx, types.CctxIndexBackfillLimit)
+
+		res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Status: types.CctxStatus_OutboundMined.String(),
+		})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 1)
+		require.EqualValues(t, 200, res.CrossChainTx[0].CctxStatus.CreatedTimestamp)
+
+		res, err = k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Status:       types.CctxStatus_OutboundMined.String(),
+			EndTimestamp: 100,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+	})
+}
@@ -30,6 +30,8 @@ func (k Keeper) UpdateNonce(ctx sdk.Context, receiveChainID int64, cctx *types.C
 
 	// SET nonce
 	cctx.GetCurrentOutTxParam().OutboundTxTssNonce = nonce.Nonce
+	// #nosec G701 always positive
+	cctx.GetCurrentOutTxParam().OutboundTxCreatedZetaHeight = uint64(ctx.BlockHeight())
 	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
 	if !found {
 		return cosmoserrors.Wrap(types.ErrCannotFindTSSKeys, fmt.Sprintf("Chain(%s) | Identifiers : %s ", chain.ChainName.String(), cctx.LogIdentifierForCCTX()))
@@ -52,11 +54,46 @@ func (k Keeper) UpdateNonce(ctx sdk.Context, receiveChainID int64, cctx *types.C
 	return nil
 }
 
+// AddRevertOutbound appends the outbound reverting the cctx to the sender chain
+// the gas of the revert is paid with the amount of the outbound and the revert is assigned a nonce
+func (k Keeper) AddRevertOutbound(ctx sdk.Context, cctx *types.CrossChainTx) error {
+	gasLimit, err := k.GetRevertGasLimit(ctx, *cctx)
+	if err != nil {
+		return errors.New("can't get revert tx gas limit" + err.Error())
+	}
+	if gasLimit == 0 {
+		// use same gas limit of outbound as a fallback -- should not happen
+		gasLimit = cctx.OutboundTxParams[0].OutboundTxGasLimit
+	}
+
+	// create new OutboundTxParams for the revert
+	revertTxParams := &types.OutboundTxParams{
+		Receiver:           cctx.InboundTxParams.Sender,
+		ReceiverChainId:    cctx.InboundTxParams.SenderChainId,
+		Amount:             cctx.InboundTxParams.Amount,
+		CoinType:           cctx.InboundTxParams.CoinType,
+		OutboundTxGasLimit: gasLimit,
+	}
+	cctx.OutboundTxParams = append(cctx.OutboundTxParams, revertTxParams)
+
+	err = k.PayGasAndUpdateCctx(
+		ctx,
+		cctx.InboundTxParams.SenderChainId,
+		cctx,
+		cctx.OutboundTxParams[0].Amount,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	return k.UpdateNonce(ctx, cctx.InboundTxParams.SenderChainId, cctx)
+}
+
 // RefundAmountOnZetaChain refunds the amount of the cctx on ZetaChain in case of aborted cctx
 // NOTE: GetCurrentOutTxParam should contain the last up to date cctx amount
 func (k Keeper) RefundAmountOnZetaChain(ctx sdk.Context, cctx types.CrossChainTx, inputAmount math.Uint) error {
 	// preliminary checks
-	if cctx.InboundTxParams.CoinType != common.CoinType_ERC20 {
+	if cctx.InboundTxParams.CoinType != common.CoinType_ERC20 && cctx.InboundTxParams.CoinType != common.CoinType_Gas {
 		return errors.New("unsupported coin type for refund on ZetaChain")
 	}
 	if !common.IsEVMChain(cctx.InboundTxParams.SenderChainId) {
@@ -66,22 +103,45 @@ func (k Keeper) RefundAmountOnZetaChain(ctx sdk.Context, cctx types.CrossChainTx
 	if sender == (ethcommon.Address{}) {
 		return errors.New("invalid sender address")
 	}
+
+	return k.RefundAmountOnZetaChainToAddress(ctx, cctx, inputAmount, sender)
+}
+
+// RefundAmountOnZetaChainToAddress refunds the amount of the cctx on ZetaChain to the given address
+// the amount is deposited in the ZRC20 of the asset for ERC20 cctx and in the gas ZRC20 of the sender chain for Gas cctx
+func (k Keeper) RefundAmountOnZetaChainToAddress(ctx sdk.Context, cctx types.CrossChainTx, inputAmount math.Uint, refundAddress ethcommon.Address) error {
+	// preliminary checks
+	if refundAddress == (ethcommon.Address{}) {
+		return errors.New("invalid refund address")
+	}
 	if inputAmount.IsNil() || inputAmount.IsZero() {
 		return errors.New("no amount to refund")
 	}
 
 	// get address of the zrc20
-	fc, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, cctx.InboundTxParams.Asset, cctx.InboundTxParams.SenderChainId)
-	if !found {
-		return fmt.Errorf("asset %s zrc not found", cctx.InboundTxParams.Asset)
+	var fc fungibletypes.ForeignCoins
+	var found bool
+	switch cctx.InboundTxParams.CoinType {
+	case common.CoinType_ERC20:
+		fc, found = k.fungibleKeeper.GetForeignCoinFromAsset(ctx, cctx.InboundTxParams.Asset, cctx.InboundTxParams.SenderChainId)
+		if !found {
+			return fmt.Errorf("asset %s zrc not found", cctx.InboundTxParams.Asset)
+		}
+	case common.CoinType_Gas:
+		fc, found = k.fungibleKeeper.GetGasCoinForForeignCoin(ctx, cctx.InboundTxParams.SenderChainId)
+		if !found {
+			return fmt.Errorf("gas coin of chain %d zrc not found", cctx.InboundTxParams.SenderChainId)
+		}
+	default:
+		return errors.New("unsupported coin type for refund on ZetaChain")
 	}
 	zrc20 := ethcommon.HexToAddress(fc.Zrc20ContractAddress)
 	if zrc20 == (ethcommon.Address{}) {
 		return fmt.Errorf("asset %s invalid zrc address", cctx.InboundTxParams.Asset)
 	}
 
-	// deposit the amount to the sender
-	if _, err := k.fungibleKeeper.DepositZRC20(ctx, zrc20, sender, inputAmount.BigInt()); err != nil {
+	// deposit the amount to the refund address
+	if _, err := k.fungibleKeeper.DepositZRC20(ctx, zrc20, refundAddress, inputAmount.BigInt()); err != nil {
 		return errors.New("failed to deposit zrc20 on ZetaChain" + err.Error())
 	}
 
@@ -67,6 +67,32 @@ func TestKeeper_RefundAmountOnZetaChain(t *testing.T) {
 		require.Equal(t, uint64(84), balance.Uint64())
 	})
 
+	t.Run("should refund gas amount to the given address", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		refundAddress := sample.EthAddress()
+		chainID := getValidEthChainID(t)
+
+		// deploy gas coin
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20Addr := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+
+		err := k.RefundAmountOnZetaChainToAddress(ctx, types.CrossChainTx{
+			InboundTxParams: &types.InboundTxParams{
+				CoinType:      common.CoinType_Gas,
+				SenderChainId: chainID,
+				Sender:        sample.EthAddress().String(),
+			}},
+			math.NewUint(42),
+			refundAddress,
+		)
+		require.NoError(t, err)
+
+		balance, err := zk.FungibleKeeper.BalanceOfZRC4(ctx, zrc20Addr, refundAddress)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), balance.Uint64())
+	})
+
 	t.Run("should fail with invalid cctx", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
 
@@ -80,7 +106,7 @@ func TestKeeper_RefundAmountOnZetaChain(t *testing.T) {
 
 		err = k.RefundAmountOnZetaChain(ctx, types.CrossChainTx{
 			InboundTxParams: &types.InboundTxParams{
-				CoinType: common.CoinType_Gas,
+				CoinType: common.CoinType_Cmd,
 			}},
 			math.NewUint(42),
 		)
@@ -85,3 +85,16 @@ func EmitOutboundFailure(ctx sdk.Context, msg *types.MsgVoteOnObservedOutboundTx
 		ctx.Logger().Error("Error emitting MsgVoteOnObservedOutboundTx :", err)
 	}
 }
+
+func EmitOutboundExpired(ctx sdk.Context, cctx types.CrossChainTx) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventOutboundExpired{
+		MsgTypeUrl:    "/zetachain.zetacore.crosschain.internal.OutboundExpired",
+		CctxIndex:     cctx.Index,
+		ChainId:       cctx.GetCurrentOutTxParam().ReceiverChainId,
+		Nonce:         cctx.GetCurrentOutTxParam().OutboundTxTssNonce,
+		StatusMessage: cctx.CctxStatus.StatusMessage,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting OutboundExpired :", err)
+	}
+}
@@ -9,7 +9,6 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
-	"github.com/btcsuite/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -122,6 +121,9 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20W
 	if !found {
 		return fmt.Errorf("cannot find foreign coin with emittingContract address %s", event.Raw.Address.Hex())
 	}
+	if !k.zetaObserverKeeper.IsOutboundEnabledForChain(ctx, foreignCoin.ForeignChainId) {
+		return errorsmod.Wrapf(types.ErrNotEnoughPermissions, "outbound disabled for chain %d", foreignCoin.ForeignChainId)
+	}
 
 	receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(foreignCoin.ForeignChainId)
 	senderChain, err := common.ZetaChainFromChainID(ctx.ChainID())
@@ -157,7 +159,14 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20W
 	)
 	sendHash := msg.Digest()
 
-	cctx := k.CreateNewCCTX(ctx, msg, sendHash, tss.TssPubkey, types.CctxStatus_PendingOutbound, &senderChain, receiverChain)
+	// withdrawals exceeding the withdrawal limits are queued until released or cancelled by the admin policy
+	status := types.CctxStatus_PendingOutbound
+	withinLimits := k.RecordWithdrawal(ctx, foreignCoin.Zrc20ContractAddress, foreignCoin.ForeignChainId, math.NewUintFromBigInt(event.Value))
+	if !withinLimits {
+		status = types.CctxStatus_PendingWithdrawalLimit
+	}
+
+	cctx := k.CreateNewCCTX(ctx, msg, sendHash, tss.TssPubkey, status, &senderChain, receiverChain)
 
 	// Get gas price and amount
 	gasprice, found := k.GetGasPrice(ctx, receiverChain.ChainId)
@@ -166,9 +175,13 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20W
 		return fmt.Errorf("gasprice not found for %s", receiverChain)
 	}
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprintf("%d", gasprice.Prices[gasprice.MedianIndex])
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.medianGasPriorityFee(ctx, receiverChain.ChainId)
 	cctx.GetCurrentOutTxParam().Amount = cctx.InboundTxParams.Amount
 
 	EmitZRCWithdrawCreated(ctx, cctx)
+	if !withinLimits {
+		return k.QueueWithdrawal(ctx, cctx, foreignCoin.Zrc20ContractAddress, event.From.Hex())
+	}
 	return k.ProcessCCTX(ctx, cctx, receiverChain)
 }
 
@@ -183,6 +196,10 @@ func (k Keeper) ProcessZetaSentEvent(ctx sdk.Context, event *connectorzevm.ZetaC
 		event.DestinationChainId,
 	))
 
+	if !k.zetaObserverKeeper.IsOutboundEnabledForChain(ctx, event.DestinationChainId.Int64()) {
+		return errorsmod.Wrapf(types.ErrNotEnoughPermissions, "outbound disabled for chain %d", event.DestinationChainId.Int64())
+	}
+
 	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
 	if !found {
 		return errorsmod.Wrap(types.ErrCannotFindTSSKeys, "ProcessZetaSentEvent: cannot be processed without TSS keys")
@@ -252,10 +269,7 @@ func (k Keeper) ProcessZetaSentEvent(ctx sdk.Context, event *connectorzevm.ZetaC
 }
 
 func (k Keeper) ProcessCCTX(ctx sdk.Context, cctx types.CrossChainTx, receiverChain *common.Chain) error {
-	inCctxIndex, ok := ctx.Value("inCctxIndex").(string)
-	if ok {
-		cctx.InboundTxParams.InboundTxObservedHash = inCctxIndex
-	}
+	k.setInCctxIndex(ctx, &cctx)
 
 	if err := k.UpdateNonce(ctx, receiverChain.ChainId, &cctx); err != nil {
 		return fmt.Errorf("ProcessWithdrawalEvent: update nonce failed: %s", err.Error())
@@ -266,6 +280,17 @@ func (k Keeper) ProcessCCTX(ctx sdk.Context, cctx types.CrossChainTx, receiverCh
 	return nil
 }
 
+// setInCctxIndex sets the index of the inbound cctx as inbound hash of a cctx created by a deposit and call,
+// the cctx remains found by the hash of the zEVM tx emitting the withdrawal
+func (k Keeper) setInCctxIndex(ctx sdk.Context, cctx *types.CrossChainTx) {
+	inCctxIndex, ok := ctx.Value("inCctxIndex").(string)
+	if !ok {
+		return
+	}
+	k.AddCctxToInTxHashToCctx(ctx, cctx.InboundTxParams.InboundTxObservedHash, cctx.Index)
+	cctx.InboundTxParams.InboundTxObservedHash = inCctxIndex
+}
+
 // ParseZRC20WithdrawalEvent tries extracting Withdrawal event from registered ZRC20 contract;
 // returns error if the log entry is not a Withdrawal event, or is not emitted from a
 // registered ZRC20 contract
@@ -288,17 +313,12 @@ func (k Keeper) ParseZRC20WithdrawalEvent(ctx sdk.Context, log ethtypes.Log) (*z
 		if event.Value.Cmp(big.NewInt(0)) <= 0 {
 			return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: invalid amount %s", event.Value.String())
 		}
-		btcChainParams, err := common.GetBTCChainParams(chainID)
-		if err != nil {
-			return nil, err
-		}
-		addr, err := btcutil.DecodeAddress(string(event.To), btcChainParams)
+		addr, err := common.DecodeBtcAddress(string(event.To), chainID)
 		if err != nil {
 			return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: invalid address %s: %s", event.To, err)
 		}
-		_, ok := addr.(*btcutil.AddressWitnessPubKeyHash)
-		if !ok {
-			return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: invalid address %s (not P2WPKH address)", event.To)
+		if !common.IsBtcAddressSupported(addr) {
+			return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: unsupported address %s", string(event.To))
 		}
 	}
 	return event, nil
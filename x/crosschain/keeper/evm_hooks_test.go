@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	connectorzevm "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/connectorzevm.sol"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_ProcessZetaSentEvent(t *testing.T) {
+	t.Run("should fail if outbound is disabled for the destination chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		observerMock.On("IsInboundEnabled", mock.Anything).Return(true)
+		observerMock.On("IsOutboundEnabledForChain", mock.Anything, int64(5)).Return(false)
+
+		err := k.ProcessZetaSentEvent(ctx, &connectorzevm.ZetaConnectorZEVMZetaSent{
+			DestinationChainId: big.NewInt(5),
+			ZetaValueAndGas:    big.NewInt(1000),
+		}, sample.EthAddress(), "")
+		require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	})
+}
@@ -37,6 +37,40 @@ func (k Keeper) GetMedianGasPriceInUint(ctx sdk.Context, chainID int64) (sdk.Uin
 	return sdk.NewUint(gasPrice.Prices[mi]), true
 }
 
+// GetMedianGasPriorityFeeInUint returns the median priority fee of a chain, zero if no priority fee has been voted
+func (k Keeper) GetMedianGasPriorityFeeInUint(ctx sdk.Context, chainID int64) (sdk.Uint, bool) {
+	gasPrice, isFound := k.GetGasPrice(ctx, chainID)
+	if !isFound {
+		return math.ZeroUint(), isFound
+	}
+	mi := gasPrice.MedianPriorityFeeIndex
+	if mi >= uint64(len(gasPrice.PriorityFees)) {
+		return math.ZeroUint(), true
+	}
+	return sdk.NewUint(gasPrice.PriorityFees[mi]), true
+}
+
+// GetGasPriceVotes returns the votes of a gas price with their freshness using the staleness settings of the chain core params
+func (k Keeper) GetGasPriceVotes(ctx sdk.Context, gasPrice types.GasPrice) []types.GasPriceVote {
+	var staleBlocks, staleSeconds, maxDeviationPercent uint64
+	if coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, gasPrice.ChainId); found {
+		staleBlocks = coreParams.GasPriceStaleBlocks
+		staleSeconds = coreParams.GasPriceStaleSeconds
+		maxDeviationPercent = coreParams.GasPriceMaxDeviationPercent
+	}
+	return gasPrice.Votes(ctx.BlockTime().Unix(), staleBlocks, staleSeconds, maxDeviationPercent)
+}
+
+// medianGasPriorityFee returns the median priority fee of a chain to set in an outbound tx
+// an empty string is returned if no priority fee has been voted so the outbound falls back to legacy pricing
+func (k Keeper) medianGasPriorityFee(ctx sdk.Context, chainID int64) string {
+	priorityFee, _ := k.GetMedianGasPriorityFeeInUint(ctx, chainID)
+	if priorityFee.IsZero() {
+		return ""
+	}
+	return priorityFee.String()
+}
+
 // RemoveGasPrice removes a gasPrice from the store
 func (k Keeper) RemoveGasPrice(ctx sdk.Context, index string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GasPriceKey))
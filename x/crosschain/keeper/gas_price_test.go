@@ -45,3 +45,37 @@ func TestGasPriceGetAll(t *testing.T) {
 	items := createNGasPrice(keeper, ctx, 10)
 	assert.Equal(t, items, keeper.GetAllGasPrice(ctx))
 }
+
+func TestKeeper_GetMedianGasPriorityFeeInUint(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	_, found := keeper.GetMedianGasPriorityFeeInUint(ctx, 1)
+	assert.False(t, found)
+
+	// no priority fee voted
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10, 20, 30}})
+	priorityFee, found := keeper.GetMedianGasPriorityFeeInUint(ctx, 1)
+	assert.True(t, found)
+	assert.True(t, priorityFee.IsZero())
+
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10, 20, 30}, PriorityFees: []uint64{3, 1, 2}, MedianPriorityFeeIndex: 2})
+	priorityFee, found = keeper.GetMedianGasPriorityFeeInUint(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, uint64(2), priorityFee.Uint64())
+}
+
+func TestKeeper_medianGasPriorityFee(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	// no gas price
+	assert.Equal(t, "", keeper.medianGasPriorityFee(ctx, 1))
+
+	// no priority fee voted, the outbound falls back to legacy pricing
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10, 20, 30}})
+	assert.Equal(t, "", keeper.medianGasPriorityFee(ctx, 1))
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10, 20, 30}, PriorityFees: []uint64{0, 0, 0}, MedianPriorityFeeIndex: 1})
+	assert.Equal(t, "", keeper.medianGasPriorityFee(ctx, 1))
+
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10, 20, 30}, PriorityFees: []uint64{3, 1, 2}, MedianPriorityFeeIndex: 2})
+	assert.Equal(t, "2", keeper.medianGasPriorityFee(ctx, 1))
+}
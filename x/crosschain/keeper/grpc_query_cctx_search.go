@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MaxCctxSearchLimit is the maximum number of cctxs returned by a cctx search
+	MaxCctxSearchLimit = 500
+)
+
+// CctxSearch returns the cctxs matching the filters of the request, ordered by creation timestamp
+// the cctxs are iterated from the secondary index of the first set filter in the order sender, receiver, status,
+// receiver chain and sender chain, and the other filters are checked on the cctxs
+// only key based pagination is supported when the iteration is restricted to a time range
+func (k Keeper) CctxSearch(c context.Context, req *types.QueryCctxSearchRequest) (*types.QueryCctxSearchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Status != "" {
+		if _, ok := types.CctxStatus_value[req.Status]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid status %s", req.Status)
+		}
+	}
+	if req.EndTimestamp != 0 && req.EndTimestamp < req.StartTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "end timestamp is before start timestamp")
+	}
+
+	var indexKey, value string
+	switch {
+	case req.Sender != "":
+		indexKey, value = types.CctxSenderIndexKey, req.Sender
+	case req.Receiver != "":
+		indexKey, value = types.CctxReceiverIndexKey, req.Receiver
+	case req.Status != "":
+		indexKey, value = types.CctxStatusIndexKey, req.Status
+	case req.ReceiverChainId != 0:
+		indexKey, value = types.CctxReceiverChainIndexKey, strconv.FormatInt(req.ReceiverChainId, 10)
+	case req.SenderChainId != 0:
+		indexKey, value = types.CctxSenderChainIndexKey, strconv.FormatInt(req.SenderChainId, 10)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "at least one of sender, receiver, status, sender chain or receiver chain must be set")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Limit > MaxCctxSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit exceeds max limit of %d", MaxCctxSearchLimit)
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the time range of the iteration, the end is exclusive
+	start := types.CctxIndexTimestampKey(req.StartTimestamp)
+	var end []byte
+	if req.EndTimestamp != 0 {
+		end = types.CctxIndexTimestampKey(req.EndTimestamp + 1)
+	}
+	if len(pageReq.Key) > 0 {
+		if pageReq.Reverse {
+			// the end is exclusive, the key itself is included
+			end = append(append([]byte{}, pageReq.Key...), 0x00)
+		} else {
+			start = pageReq.Key
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := k.cctxIndexStore(ctx, indexKey, value)
+	var iterator sdk.Iterator
+	if pageReq.Reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	cctxs := make([]*types.CrossChainTx, 0)
+	pageRes := &query.PageResponse{}
+	skipped := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		// #nosec G701 len always positive
+		if uint64(len(cctxs)) >= limit {
+			pageRes.NextKey = iterator.Key()
+			break
+		}
+		cctx, found := k.GetCrossChainTx(ctx, string(iterator.Value()))
+		if !found || !matchCctxSearch(req, cctx) {
+			continue
+		}
+		if len(pageReq.Key) == 0 && skipped < pageReq.Offset {
+			skipped++
+			continue
+		}
+		cctxs = append(cctxs, &cctx)
+	}
+
+	return &types.QueryCctxSearchResponse{CrossChainTx: cctxs, Pagination: pageRes}, nil
+}
+
+// matchCctxSearch returns true if the cctx matches all the filters of the search request
+func matchCctxSearch(req *types.QueryCctxSearchRequest, cctx types.CrossChainTx) bool {
+	if cctx.InboundTxParams == nil || len(cctx.OutboundTxParams) == 0 || cctx.OutboundTxParams[0] == nil || cctx.CctxStatus == nil {
+		return false
+	}
+	if req.Sender != "" && !sameIndexValue(req.Sender, cctx.InboundTxParams.Sender) {
+		return false
+	}
+	if req.Receiver != "" && !sameIndexValue(req.Receiver, cctx.OutboundTxParams[0].Receiver) {
+		return false
+	}
+	if req.Status != "" && req.Status != cctx.CctxStatus.Status.String() {
+		return false
+	}
+	if req.SenderChainId != 0 && req.SenderChainId != cctx.InboundTxParams.SenderChainId {
+		return false
+	}
+	if req.ReceiverChainId != 0 && req.ReceiverChainId != cctx.OutboundTxParams[0].ReceiverChainId {
+		return false
+	}
+	return true
+}
+
+// sameIndexValue returns true if the two values are the same in the cctx secondary indexes
+func sameIndexValue(a, b string) bool {
+	return bytes.Equal(types.CctxIndexValuePrefix(a), types.CctxIndexValuePrefix(b))
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func sampleSearchCctx(
+	t *testing.T,
+	index string,
+	sender string,
+	receiver string,
+	status types.CctxStatus,
+	senderChainID int64,
+	receiverChainID int64,
+	createdTimestamp int64,
+) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.InboundTxParams.Sender = sender
+	cctx.InboundTxParams.SenderChainId = senderChainID
+	cctx.OutboundTxParams[0].Receiver = receiver
+	cctx.OutboundTxParams[0].ReceiverChainId = receiverChainID
+	cctx.CctxStatus.Status = status
+	cctx.CctxStatus.CreatedTimestamp = createdTimestamp
+	return *cctx
+}
+
+func cctxSearchIndexes(cctxs []*types.CrossChainTx) []string {
+	indexes := make([]string, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		indexes = append(indexes, cctx.Index)
+	}
+	return indexes
+}
+
+func TestKeeper_CctxSearch(t *testing.T) {
+	alice := sample.EthAddress().Hex()
+	bob := sample.EthAddress().Hex()
+
+	setup := func(t *testing.T) (*keeper.Keeper, sdk.Context) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		for _, cctx := range []types.CrossChainTx{
+			sampleSearchCctx(t, "0", alice, bob, types.CctxStatus_PendingOutbound, 1, 2, 100),
+			sampleSearchCctx(t, "1", alice, bob, types.CctxStatus_OutboundMined, 1, 3, 200),
+			sampleSearchCctx(t, "2", alice, alice, types.CctxStatus_PendingOutbound, 2, 3, 300),
+			sampleSearchCctx(t, "3", bob, alice, types.CctxStatus_Aborted, 2, 1, 400),
+			sampleSearchCctx(t, "4", bob, bob, types.CctxStatus_PendingOutbound, 3, 2, 500),
+		} {
+			k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+		}
+		return k, ctx
+	}
+
+	t.Run("should search by sender regardless of the address case", func(t *testing.T) {
+		k, ctx := setup(t)
+		res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Sender: strings.ToLower(alice),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "1", "2"}, cctxSearchIndexes(res.CrossChainTx))
+	})
+
+	t.Run("should search with several filters", func(t *testing.T) {
+		k, ctx := setup(t)
+		res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Status:          types.CctxStatus_PendingOutbound.String(),
+			ReceiverChainId: 3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"2"}, cctxSearchIndexes(res.CrossChainTx))
+
+		res, err = k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Receiver:      bob,
+			SenderChainId: 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "1"}, cctxSearchIndexes(res.CrossChainTx))
+	})
+
+	t.Run("should search within a time range", func(t *testing.T) {
+		k, ctx := setup(t)
+		res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Status:         types.CctxStatus_PendingOutbound.String(),
+			StartTimestamp: 200,
+			EndTimestamp:   500,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"2", "4"}, cctxSearchIndexes(res.CrossChainTx))
+
+		res, err = k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			SenderChainId: 2,
+			EndTimestamp:  300,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"2"}, cctxSearchIndexes(res.CrossChainTx))
+	})
+
+	t.Run("should update the status index when the status changes", func(t *testing.T) {
+		k, ctx := setup(t)
+		cctx, found := k.GetCrossChainTx(ctx, "0")
+		require.True(t, found)
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+		res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Status: types.CctxStatus_PendingOutbound.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"2", "4"}, cctxSearchIndexes(res.CrossChainTx))
+
+		res, err = k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Status: types.CctxStatus_OutboundMined.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "1"}, cctxSearchIndexes(res.CrossChainTx))
+	})
+
+	t.Run("should paginate the results", func(t *testing.T) {
+		k, ctx := setup(t)
+		req := &types.QueryCctxSearchRequest{
+			Status:     types.CctxStatus_PendingOutbound.String(),
+			Pagination: &query.PageRequest{Limit: 2},
+		}
+		res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "2"}, cctxSearchIndexes(res.CrossChainTx))
+		require.NotNil(t, res.Pagination.NextKey)
+
+		req.Pagination.Key = res.Pagination.NextKey
+		res, err = k.CctxSearch(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Equal(t, []string{"4"}, cctxSearchIndexes(res.CrossChainTx))
+		require.Nil(t, res.Pagination.NextKey)
+
+		res, err = k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+			Status:     types.CctxStatus_PendingOutbound.String(),
+			Pagination: &query.PageRequest{Offset: 1, Reverse: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"2", "0"}, cctxSearchIndexes(res.CrossChainTx))
+	})
+
+	t.Run("should fail for invalid requests", func(t *testing.T) {
+		k, ctx := setup(t)
+		for _, req := range []*types.QueryCctxSearchRequest{
+			nil,
+			{},
+			{Status: "invalid"},
+			{Sender: alice, StartTimestamp: 200, EndTimestamp: 100},
+			{Sender: alice, Pagination: &query.PageRequest{Limit: keeper.MaxCctxSearchLimit + 1}},
+		} {
+			_, err := k.CctxSearch(sdk.WrapSDKContext(ctx), req)
+			require.Error(t, err)
+		}
+	})
+}
@@ -56,3 +56,23 @@ func (k Keeper) GasPrice(c context.Context, req *types.QueryGetGasPriceRequest)
 
 	return &types.QueryGetGasPriceResponse{GasPrice: &val}, nil
 }
+
+// GasPriceVotes returns the gas price votes of a chain with their freshness at the current block and the medians of the fresh votes
+func (k Keeper) GasPriceVotes(c context.Context, req *types.QueryGasPriceVotesRequest) (*types.QueryGasPriceVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	gasPrice, found := k.GetGasPrice(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "gas price not found")
+	}
+
+	votes := k.GetGasPriceVotes(ctx, gasPrice)
+	res := &types.QueryGasPriceVotesResponse{Votes: votes}
+	if priceIndex, priorityFeeIndex, found := types.MedianIndexes(votes); found {
+		res.MedianPrice = votes[priceIndex].Price
+		res.MedianPriorityFee = votes[priorityFeeIndex].PriorityFee
+	}
+	return res, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TssFundsMigrationProgress returns the status of the cctxs of the TSS funds migration of each chain
+func (k Keeper) TssFundsMigrationProgress(c context.Context, req *types.QueryTssFundsMigrationProgressRequest) (*types.QueryTssFundsMigrationProgressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	migrators := k.zetaObserverKeeper.GetAllTssFundMigrators(ctx)
+	sort.SliceStable(migrators, func(i, j int) bool {
+		return migrators[i].ChainId < migrators[j].ChainId
+	})
+	progress := make([]types.TssFundsMigrationProgress, 0, len(migrators))
+	for _, migrator := range migrators {
+		chainProgress := types.TssFundsMigrationProgress{
+			ChainId:   migrator.ChainId,
+			Completed: true,
+		}
+		indexes := append(append([]string{}, migrator.ContractUpdateCctxIndexes...), migrator.MigrationCctxIndex)
+		for _, index := range indexes {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			if !found {
+				return nil, status.Errorf(codes.NotFound, "migration cctx %s of chain %d not found", index, migrator.ChainId)
+			}
+			outTxParams := cctx.GetCurrentOutTxParam()
+			chainProgress.Cctxs = append(chainProgress.Cctxs, types.TssFundsMigrationCctx{
+				Index:          index,
+				Command:        strings.Split(cctx.RelayedMessage, ":")[0],
+				Status:         cctx.CctxStatus.Status,
+				Nonce:          outTxParams.OutboundTxTssNonce,
+				Amount:         outTxParams.Amount,
+				OutboundTxHash: outTxParams.OutboundTxHash,
+			})
+			if cctx.CctxStatus.Status != types.CctxStatus_OutboundMined {
+				chainProgress.Completed = false
+			}
+		}
+		// a Bitcoin migration is completed once no utxos are left to sweep
+		if chainProgress.Completed && common.IsBitcoinChain(migrator.ChainId) {
+			tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+			if !found {
+				return nil, status.Error(codes.NotFound, "current tss not found")
+			}
+			completed, err := k.isTssFundsMigrationCompleted(ctx, migrator.ChainId, tss)
+			chainProgress.Completed = err == nil && completed
+		}
+		progress = append(progress, chainProgress)
+	}
+	return &types.QueryTssFundsMigrationProgressResponse{Progress: progress}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithdrawalLimits returns the withdrawal limits
+func (k Keeper) WithdrawalLimits(c context.Context, req *types.QueryWithdrawalLimitsRequest) (*types.QueryWithdrawalLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	limits, found := k.GetWithdrawalLimits(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "withdrawal limits not found")
+	}
+	return &types.QueryWithdrawalLimitsResponse{WithdrawalLimits: limits}, nil
+}
+
+// WithdrawalUsage returns the value withdrawn per limited ZRC20 and chain in the current window
+func (k Keeper) WithdrawalUsage(c context.Context, req *types.QueryWithdrawalUsageRequest) (*types.QueryWithdrawalUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	limits, found := k.GetWithdrawalLimits(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "withdrawal limits not found")
+	}
+
+	res := &types.QueryWithdrawalUsageResponse{
+		Window:      limits.Window,
+		Zrc20Usages: make([]types.ZRC20WithdrawalUsage, 0, len(limits.Zrc20Limits)),
+		ChainUsages: make([]types.ChainWithdrawalUsage, 0, len(limits.ChainLimits)),
+	}
+	for _, limit := range limits.Zrc20Limits {
+		res.Zrc20Usages = append(res.Zrc20Usages, types.ZRC20WithdrawalUsage{
+			Zrc20ContractAddress: limit.Zrc20ContractAddress,
+			Amount:               k.GetZRC20WithdrawalUsage(ctx, limit.Zrc20ContractAddress, limits.Window),
+			Limit:                limit.Limit,
+		})
+	}
+	for _, limit := range limits.ChainLimits {
+		res.ChainUsages = append(res.ChainUsages, types.ChainWithdrawalUsage{
+			ChainId: limit.ChainId,
+			Amount:  k.GetChainWithdrawalUsage(ctx, limit.ChainId, limits.Window),
+			Limit:   limit.Limit,
+		})
+	}
+	return res, nil
+}
+
+// QueuedWithdrawalAll returns the withdrawals queued for exceeding the withdrawal limits
+func (k Keeper) QueuedWithdrawalAll(c context.Context, req *types.QueryAllQueuedWithdrawalRequest) (*types.QueryAllQueuedWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	queuedWithdrawals, pageRes, err := k.GetAllQueuedWithdrawalPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllQueuedWithdrawalResponse{QueuedWithdrawals: queuedWithdrawals, Pagination: pageRes}, nil
+}
@@ -34,6 +34,19 @@ func (k Keeper) GetInTxHashToCctx(
 	return val, true
 }
 
+// AddCctxToInTxHashToCctx adds the cctx index to the cctxs of the inbound tx hash if not already present
+func (k Keeper) AddCctxToInTxHashToCctx(ctx sdk.Context, inTxHash string, cctxIndex string) {
+	in, _ := k.GetInTxHashToCctx(ctx, inTxHash)
+	in.InTxHash = inTxHash
+	for _, index := range in.CctxIndex {
+		if index == cctxIndex {
+			return
+		}
+	}
+	in.CctxIndex = append(in.CctxIndex, cctxIndex)
+	k.SetInTxHashToCctx(ctx, in)
+}
+
 // RemoveInTxHashToCctx removes a inTxHashToCctx from the store
 func (k Keeper) RemoveInTxHashToCctx(
 	ctx sdk.Context,
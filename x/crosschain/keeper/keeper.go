@@ -45,6 +45,11 @@ func NewKeeper(
 	//	panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	//}
 
+	// set KeyTable if it has not already been set
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:                cdc,
 		storeKey:           storeKey,
@@ -5,6 +5,7 @@ import (
 	v2 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v2"
 	v3 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v3"
 	v4 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v4"
+	v5 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -33,3 +34,8 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.crossChainKeeper.zetaObserverKeeper, m.crossChainKeeper.storeKey, m.crossChainKeeper.cdc)
 }
+
+// Migrate4to5 migrates the store from consensus version 4 to 5
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.crossChainKeeper)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const abortStuckCCTXMessage = "CCTX aborted with admin cmd"
+
+// AbortStuckCCTX aborts a cctx stuck in pending outbound or pending revert
+// The nonce of the cctx is removed from the pending nonces and its outbound tracker is removed
+// An EVM nonce is only consumed by a mined tx, the cctx of an EVM chain can only be aborted once a mined tx
+// of the same or a higher nonce has been observed, a pending EVM outbound is cancelled by its expiry instead
+// Only the admin policy account is authorized to abort a cctx
+func (k msgServer) AbortStuckCCTX(goCtx context.Context, msg *types.MsgAbortStuckCCTX) (*types.MsgAbortStuckCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Abort can only be executed by the correct policy account")
+	}
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCannotFindCctx, "cctx index %s", msg.CctxIndex)
+	}
+	if !IsPending(cctx) {
+		return nil, errorsmod.Wrapf(types.ErrStatusNotPending, "cctx status %s", cctx.CctxStatus.Status.String())
+	}
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrCannotFindTSSKeys, "cannot abort cctx without TSS keys")
+	}
+
+	outTxParams := cctx.GetCurrentOutTxParam()
+	if common.IsEVMChain(outTxParams.ReceiverChainId) {
+		pendingNonces, found := k.GetObserverKeeper().GetPendingNonces(ctx, tss.TssPubkey, outTxParams.ReceiverChainId)
+		// #nosec G701 always in range
+		if found && int64(outTxParams.OutboundTxTssNonce) >= pendingNonces.NonceLow {
+			return nil, errorsmod.Wrapf(types.ErrNonceNotConsumed,
+				"nonce %d of chain %d is pending, the outbound must expire to cancel the nonce", outTxParams.OutboundTxTssNonce, outTxParams.ReceiverChainId)
+		}
+	}
+	// #nosec G701 always in range
+	k.GetObserverKeeper().RemoveFromPendingNonces(ctx, tss.TssPubkey, outTxParams.ReceiverChainId, int64(outTxParams.OutboundTxTssNonce))
+	k.RemoveOutTxTracker(ctx, outTxParams.ReceiverChainId, outTxParams.OutboundTxTssNonce)
+
+	cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, abortStuckCCTXMessage)
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+	return &types.MsgAbortStuckCCTXResponse{}, nil
+}
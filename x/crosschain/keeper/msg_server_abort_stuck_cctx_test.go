@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_AbortStuckCCTX(t *testing.T) {
+	chainID := common.GoerliChain().ChainId
+
+	t.Run("should abort a pending cctx and release its nonce", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		btcChainID := common.BtcRegtestChain().ChainId
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			NonceLow:  10,
+			NonceHigh: 12,
+			ChainId:   btcChainID,
+			Tss:       tss.TssPubkey,
+		})
+
+		cctx := sample.CrossChainTx(t, "0")
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		cctx.GetCurrentOutTxParam().ReceiverChainId = btcChainID
+		cctx.GetCurrentOutTxParam().OutboundTxTssNonce = 10
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetOutTxTracker(ctx, types.OutTxTracker{
+			ChainId: btcChainID,
+			Nonce:   10,
+		})
+
+		_, err := msgServer.AbortStuckCCTX(ctx, types.NewMsgAbortStuckCCTX(admin, cctx.Index))
+		require.NoError(t, err)
+
+		aborted, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, aborted.CctxStatus.Status)
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, btcChainID)
+		require.True(t, found)
+		require.EqualValues(t, 11, pendingNonces.NonceLow)
+		_, found = k.GetOutTxTracker(ctx, btcChainID, 10)
+		require.False(t, found)
+	})
+
+	t.Run("should abort an evm cctx whose nonce is consumed by a mined tx", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		// the outbound of nonce 10 has been observed, the nonce 9 is consumed on chain
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			NonceLow:  11,
+			NonceHigh: 12,
+			ChainId:   chainID,
+			Tss:       tss.TssPubkey,
+		})
+
+		cctx := sample.CrossChainTx(t, "0")
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		cctx.GetCurrentOutTxParam().ReceiverChainId = chainID
+		cctx.GetCurrentOutTxParam().OutboundTxTssNonce = 9
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.AbortStuckCCTX(ctx, types.NewMsgAbortStuckCCTX(admin, cctx.Index))
+		require.NoError(t, err)
+
+		aborted, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, aborted.CctxStatus.Status)
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 11, pendingNonces.NonceLow)
+	})
+
+	t.Run("should fail if the nonce of the evm cctx is pending", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			NonceLow:  10,
+			NonceHigh: 12,
+			ChainId:   chainID,
+			Tss:       tss.TssPubkey,
+		})
+
+		cctx := sample.CrossChainTx(t, "0")
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		cctx.GetCurrentOutTxParam().ReceiverChainId = chainID
+		cctx.GetCurrentOutTxParam().OutboundTxTssNonce = 10
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.AbortStuckCCTX(ctx, types.NewMsgAbortStuckCCTX(admin, cctx.Index))
+		require.ErrorIs(t, err, types.ErrNonceNotConsumed)
+
+		pending, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, pending.CctxStatus.Status)
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 10, pendingNonces.NonceLow)
+	})
+
+	t.Run("should fail if the cctx is not pending", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		cctx := sample.CrossChainTx(t, "0")
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.AbortStuckCCTX(ctx, types.NewMsgAbortStuckCCTX(admin, cctx.Index))
+		require.ErrorIs(t, err, types.ErrStatusNotPending)
+	})
+
+	t.Run("should fail if not the admin policy account", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		cctx := sample.CrossChainTx(t, "0")
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.AbortStuckCCTX(ctx, types.NewMsgAbortStuckCCTX(sample.AccAddress(), cctx.Index))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// CancelQueuedWithdrawal cancels a withdrawal queued for exceeding the withdrawal limits
+// The withdrawn amount is refunded in ZRC20 to the sender of the withdrawal and the cctx is aborted
+// Only the admin policy account is authorized to cancel a withdrawal
+func (k msgServer) CancelQueuedWithdrawal(goCtx context.Context, msg *types.MsgCancelQueuedWithdrawal) (*types.MsgCancelQueuedWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Cancel can only be executed by the correct policy account")
+	}
+	queuedWithdrawal, found := k.GetQueuedWithdrawal(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrQueuedWithdrawalNotFound, "cctx index %s", msg.CctxIndex)
+	}
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCannotFindCctx, "cctx index %s", msg.CctxIndex)
+	}
+
+	if _, err := k.fungibleKeeper.DepositZRC20(
+		ctx,
+		ethcommon.HexToAddress(queuedWithdrawal.Zrc20ContractAddress),
+		ethcommon.HexToAddress(queuedWithdrawal.RefundAddress),
+		queuedWithdrawal.Amount.BigInt(),
+	); err != nil {
+		return nil, errorsmod.Wrap(types.ErrUnableToRefundWithdrawal, err.Error())
+	}
+
+	cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "withdrawal cancelled")
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+	k.RemoveQueuedWithdrawal(ctx, msg.CctxIndex)
+
+	return &types.MsgCancelQueuedWithdrawalResponse{}, nil
+}
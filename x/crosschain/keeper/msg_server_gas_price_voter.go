@@ -4,13 +4,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"sort"
 	"strconv"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -37,21 +37,34 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 	gasPrice, isFound := k.GetGasPrice(ctx, chain.ChainId)
 	if !isFound {
 		gasPrice = types.GasPrice{
-			Creator:     msg.Creator,
-			Index:       strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
-			ChainId:     chain.ChainId,
-			Prices:      []uint64{msg.Price},
-			BlockNums:   []uint64{msg.BlockNumber},
-			Signers:     []string{msg.Creator},
-			MedianIndex: 0,
+			Creator:      msg.Creator,
+			Index:        strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
+			ChainId:      chain.ChainId,
+			Prices:       []uint64{msg.Price},
+			PriorityFees: []uint64{msg.PriorityFee},
+			BlockNums:    []uint64{msg.BlockNumber},
+			Signers:      []string{msg.Creator},
+			Timestamps:   []int64{ctx.BlockTime().Unix()},
+			MedianIndex:  0,
 		}
 	} else {
+		// priority fees were not recorded before EIP-1559 support and timestamps before staleness checks
+		for len(gasPrice.PriorityFees) < len(gasPrice.Prices) {
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, 0)
+		}
+		for len(gasPrice.Timestamps) < len(gasPrice.Prices) {
+			gasPrice.Timestamps = append(gasPrice.Timestamps, 0)
+		}
+		k.evictGasPriceSigners(ctx, &gasPrice, chain)
+
 		signers := gasPrice.Signers
 		exist := false
 		for i, s := range signers {
 			if s == msg.Creator { // update existing entry
 				gasPrice.BlockNums[i] = msg.BlockNumber
 				gasPrice.Prices[i] = msg.Price
+				gasPrice.PriorityFees[i] = msg.PriorityFee
+				gasPrice.Timestamps[i] = ctx.BlockTime().Unix()
 				exist = true
 				break
 			}
@@ -60,11 +73,17 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 			gasPrice.Signers = append(gasPrice.Signers, msg.Creator)
 			gasPrice.BlockNums = append(gasPrice.BlockNums, msg.BlockNumber)
 			gasPrice.Prices = append(gasPrice.Prices, msg.Price)
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, msg.PriorityFee)
+			gasPrice.Timestamps = append(gasPrice.Timestamps, ctx.BlockTime().Unix())
 		}
-		// recompute the median gas price
-		mi := medianOfArray(gasPrice.Prices)
-		// #nosec G701 always positive
-		gasPrice.MedianIndex = uint64(mi)
+	}
+
+	// recompute the median gas price and priority fee from the fresh votes, the vote just cast is always fresh in time
+	votes := k.GetGasPriceVotes(ctx, gasPrice)
+	medianIndex, medianPriorityFeeIndex, found := types.MedianIndexes(votes)
+	if found {
+		gasPrice.MedianIndex = medianIndex
+		gasPrice.MedianPriorityFeeIndex = medianPriorityFeeIndex
 	}
 	k.SetGasPrice(ctx, gasPrice)
 	chainIDBigINT := big.NewInt(chain.ChainId)
@@ -84,21 +103,25 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 	return &types.MsgGasPriceVoterResponse{}, nil
 }
 
-type indexValue struct {
-	Index int
-	Value uint64
-}
-
-func medianOfArray(values []uint64) int {
-	array := make([]indexValue, len(values))
-	for i, v := range values {
-		array[i] = indexValue{Index: i, Value: v}
+// evictGasPriceSigners removes the votes of the signers that are no longer observers of the chain
+func (k msgServer) evictGasPriceSigners(ctx sdk.Context, gasPrice *types.GasPrice, chain *common.Chain) {
+	kept := 0
+	for i, signer := range gasPrice.Signers {
+		if !k.zetaObserverKeeper.IsAuthorized(ctx, signer, chain) {
+			continue
+		}
+		gasPrice.Signers[kept] = signer
+		gasPrice.BlockNums[kept] = gasPrice.BlockNums[i]
+		gasPrice.Prices[kept] = gasPrice.Prices[i]
+		gasPrice.PriorityFees[kept] = gasPrice.PriorityFees[i]
+		gasPrice.Timestamps[kept] = gasPrice.Timestamps[i]
+		kept++
 	}
-	sort.SliceStable(array, func(i, j int) bool {
-		return array[i].Value < array[j].Value
-	})
-	l := len(array)
-	return array[l/2].Index
+	gasPrice.Signers = gasPrice.Signers[:kept]
+	gasPrice.BlockNums = gasPrice.BlockNums[:kept]
+	gasPrice.Prices = gasPrice.Prices[:kept]
+	gasPrice.PriorityFees = gasPrice.PriorityFees[:kept]
+	gasPrice.Timestamps = gasPrice.Timestamps[:kept]
 }
 
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_GasPriceVoter(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+
+	t.Run("should aggregate the fresh votes of the observers and evict removed observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+			UseFungibleMock: true,
+		})
+		ctx = ctx.WithBlockTime(time.Unix(10000, 0))
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+
+		observerMock.On("GetParams", mock.Anything).Return(observertypes.Params{
+			ObserverParams: []*observertypes.ObserverParams{{Chain: &chain, IsSupported: true}},
+		})
+		observerMock.On("GetCoreParamsByChainID", mock.Anything, chain.ChainId).Return(&observertypes.CoreParams{
+			ChainId:              chain.ChainId,
+			GasPriceStaleBlocks:  10,
+			GasPriceStaleSeconds: 600,
+		}, true)
+		observerMock.On("IsAuthorized", mock.Anything, "removed", &chain).Return(false)
+		observerMock.On("IsAuthorized", mock.Anything, mock.Anything, &chain).Return(true)
+		fungibleMock.On("SetGasPrice", mock.Anything, big.NewInt(chain.ChainId), big.NewInt(110)).Return(uint64(1), nil)
+
+		k.SetGasPrice(ctx, types.GasPrice{
+			Index:        "1337",
+			ChainId:      chain.ChainId,
+			Signers:      []string{"stale", "removed", "fresh"},
+			BlockNums:    []uint64{1000, 1000, 1000},
+			Prices:       []uint64{10, 20, 100},
+			PriorityFees: []uint64{1, 2, 3},
+			Timestamps:   []int64{5000, 10000, 9990},
+		})
+
+		_, err := keeper.NewMsgServerImpl(*k).GasPriceVoter(ctx, &types.MsgGasPriceVoter{
+			Creator:     "voter",
+			ChainId:     chain.ChainId,
+			Price:       110,
+			PriorityFee: 4,
+			BlockNumber: 1001,
+		})
+		require.NoError(t, err)
+
+		gasPrice, found := k.GetGasPrice(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, []string{"stale", "fresh", "voter"}, gasPrice.Signers)
+		require.Equal(t, []uint64{10, 100, 110}, gasPrice.Prices)
+		require.Equal(t, []int64{5000, 9990, 10000}, gasPrice.Timestamps)
+		require.EqualValues(t, 2, gasPrice.MedianIndex)
+		require.EqualValues(t, 2, gasPrice.MedianPriorityFeeIndex)
+		fungibleMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// tssMigrationGasLimitEVM is the gas limit of the transfer of the gas tokens to the new TSS on EVM chains
+	tssMigrationGasLimitEVM = 21_000
+
+	// tssAddressUpdateGasLimit is the gas limit of the cmd cctxs updating the TSS address of the contracts on EVM chains
+	tssAddressUpdateGasLimit = 100_000
+
+	// tssMigrationSweepSizeBTC is the size in vBytes of the Bitcoin sweep transaction of the TSS UTXOs,
+	// a 21-input, 3-output SegWit tx: the nonce-mark and 20 UTXOs to the nonce-mark, the new TSS and the change
+	tssMigrationSweepSizeBTC = 3234
+)
+
+// MigrateAllTssFunds schedules the migration of the funds of the current TSS to the new TSS on every supported chain.
+//
+// The amount migrated on each chain is the TSS balance voted by the observers after the new TSS has been finalized,
+// minus a reserve paying the fees of the migration. On EVM chains the TSS address of the connector contract
+// is updated to the new TSS before the gas tokens are transferred. On Bitcoin the UTXOs
+// of the TSS are swept to the new TSS, a sweep spends 20 UTXOs at most so the message is broadcasted again
+// once the sweep is mined while the balance voted for the remaining UTXOs still covers the fees of a sweep.
+// The chains whose migration is mined and has no funds left to migrate are skipped.
+//
+// The ERC20Custody contract only accepts a TSS address update from its TSS updater, so the migration doesn't
+// update it. Once the migration is mined and before MsgUpdateTssAddress, the TSS updater key must call
+// updateTSSAddress with the new TSS address on the ERC20Custody contract of each EVM chain, the ERC20
+// withdrawals signed by the new TSS revert otherwise.
+//
+// Fails if inbound is enabled, if no new TSS has been generated, if a chain has pending nonces
+// or no TSS balance voted for the new TSS, or if no chain has funds left to migrate.
+//
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) MigrateAllTssFunds(goCtx context.Context, msg *types.MsgMigrateAllTssFunds) (*types.MsgMigrateAllTssFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Update can only be executed by the correct policy account")
+	}
+	if k.zetaObserverKeeper.IsInboundEnabled(ctx) {
+		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "cannot migrate funds while inbound is enabled")
+	}
+	tss, tssHistory, err := k.getTssMigration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexes []string
+	for _, chain := range k.zetaObserverKeeper.GetParams(ctx).GetSupportedChains() {
+		if common.IsZetaChain(chain.ChainId) {
+			continue
+		}
+		migrated, err := k.isTssFundsMigrationCompleted(ctx, chain.ChainId, tss)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrCannotMigrateTssFunds, "chain %d: %s", chain.ChainId, err.Error())
+		}
+		if migrated {
+			continue
+		}
+		chainIndexes, err := k.MigrateAllTSSFundsForChain(ctx, chain.ChainId, tss, tssHistory)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrCannotMigrateTssFunds, "chain %d: %s", chain.ChainId, err.Error())
+		}
+		indexes = append(indexes, chainIndexes...)
+	}
+	if len(indexes) == 0 {
+		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "no tss funds left to migrate")
+	}
+	return &types.MsgMigrateAllTssFundsResponse{CctxIndexes: indexes}, nil
+}
+
+// isTssFundsMigrationCompleted returns true if the migration of the chain is mined and the current TSS holds no funds
+// a new migration can move, the current TSS of a Bitcoin chain holding more UTXOs than a sweep spends is swept again
+func (k Keeper) isTssFundsMigrationCompleted(ctx sdk.Context, chainID int64, currentTss observertypes.TSS) (bool, error) {
+	migrator, found := k.zetaObserverKeeper.GetFundMigrator(ctx, chainID)
+	if !found {
+		return false, nil
+	}
+	migrationCctx, found := k.GetCrossChainTx(ctx, migrator.MigrationCctxIndex)
+	if !found || migrationCctx.CctxStatus.Status != types.CctxStatus_OutboundMined {
+		return false, nil
+	}
+	if !common.IsBitcoinChain(chainID) {
+		return true, nil
+	}
+	return k.isTssSwept(ctx, chainID, currentTss, migrationCctx)
+}
+
+// isTssSwept returns true if the balance of the current TSS voted after the sweep of the migration cctx
+// doesn't cover the fees of another sweep
+func (k Keeper) isTssSwept(ctx sdk.Context, chainID int64, currentTss observertypes.TSS, sweepCctx types.CrossChainTx) (bool, error) {
+	balance, found := k.zetaObserverKeeper.GetTssBalance(ctx, chainID)
+	// #nosec G701 always positive
+	if !found || balance.TssPubkey != currentTss.TssPubkey || balance.FinalizedZetaHeight <= int64(sweepCctx.GetCurrentOutTxParam().OutboundTxCreatedZetaHeight) {
+		return false, fmt.Errorf("no tss balance voted after the sweep %s", sweepCctx.Index)
+	}
+	pendingNonces, found := k.GetObserverKeeper().GetPendingNonces(ctx, currentTss.TssPubkey, chainID)
+	if !found {
+		return false, fmt.Errorf("cannot find pending nonces for chain")
+	}
+	medianGasPrice, isFound := k.GetMedianGasPriceInUint(ctx, chainID)
+	if !isFound {
+		return false, types.ErrUnableToGetGasPrice
+	}
+	return balance.Balance.LTE(getSweepFeeReserveBTC(medianGasPrice.MulUint64(2), pendingNonces.NonceHigh)), nil
+}
+
+// getSweepFeeReserveBTC returns the fees of a sweep of the TSS UTXOs and the nonce-mark it pays back to the current TSS
+func getSweepFeeReserveBTC(gasPrice sdkmath.Uint, nonce int64) sdkmath.Uint {
+	// #nosec G701 always positive
+	nonceMark := uint64(common.NonceMarkAmount(uint64(nonce)))
+	return gasPrice.MulUint64(tssMigrationSweepSizeBTC).AddUint64(nonceMark)
+}
+
+// MigrateAllTSSFundsForChain creates the cmd cctx updating the TSS address of the connector contract of the chain
+// and the cctx migrating the voted TSS balance, it returns the indexes of the cctxs in nonce order
+func (k Keeper) MigrateAllTSSFundsForChain(ctx sdk.Context, chainID int64, currentTss observertypes.TSS, tssList []observertypes.TSS) ([]string, error) {
+	newTss := tssList[len(tssList)-1]
+	pendingNonces, found := k.GetObserverKeeper().GetPendingNonces(ctx, currentTss.TssPubkey, chainID)
+	if !found {
+		return nil, fmt.Errorf("cannot find pending nonces for chain")
+	}
+	if pendingNonces.NonceLow != pendingNonces.NonceHigh {
+		return nil, fmt.Errorf("cannot migrate funds when there are pending nonces")
+	}
+	balance, found := k.zetaObserverKeeper.GetTssBalance(ctx, chainID)
+	if !found || balance.TssPubkey != currentTss.TssPubkey {
+		return nil, fmt.Errorf("no tss balance voted for the current tss")
+	}
+	if balance.FinalizedZetaHeight < newTss.FinalizedZetaHeight {
+		return nil, fmt.Errorf("tss balance voted at height %d before the new tss was finalized at height %d",
+			balance.FinalizedZetaHeight, newTss.FinalizedZetaHeight)
+	}
+	medianGasPrice, isFound := k.GetMedianGasPriceInUint(ctx, chainID)
+	if !isFound {
+		return nil, types.ErrUnableToGetGasPrice
+	}
+	gasPrice := medianGasPrice.MulUint64(2)
+
+	// the contract update is signed first, while the current TSS still holds the gas tokens to pay for it
+	// the ERC20Custody contract only accepts a TSS address update from its TSS updater, it is left to the TSS updater key
+	var indexes []string
+	var feeReserve sdkmath.Uint
+	switch {
+	case common.IsEVMChain(chainID):
+		coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, chainID)
+		if !found {
+			return nil, fmt.Errorf("core params not found")
+		}
+		newTssAddress, err := common.GetTssAddrEVM(newTss.TssPubkey)
+		if err != nil {
+			return nil, err
+		}
+		if ethcommon.HexToAddress(coreParams.ConnectorContractAddress) != (ethcommon.Address{}) {
+			index, err := k.createTssAddressUpdateCctx(ctx, chainID, common.CmdUpdateConnectorTssAddress, coreParams.ConnectorContractAddress, newTssAddress.Hex(), gasPrice, currentTss, newTss)
+			if err != nil {
+				return nil, err
+			}
+			indexes = append(indexes, index)
+		}
+		// #nosec G701 always positive
+		feeReserve = gasPrice.MulUint64(tssMigrationGasLimitEVM + uint64(len(indexes))*tssAddressUpdateGasLimit)
+	case common.IsBitcoinChain(chainID):
+		// the sweep pays the nonce-mark of its nonce back to the current TSS
+		feeReserve = getSweepFeeReserveBTC(gasPrice, pendingNonces.NonceHigh)
+	default:
+		return nil, errorsmod.Wrap(types.ErrReceiverIsEmpty, fmt.Sprintf("chain %d is not supported", chainID))
+	}
+	if balance.Balance.LTE(feeReserve) {
+		return nil, fmt.Errorf("tss balance %s does not cover the migration fees %s", balance.Balance, feeReserve)
+	}
+	amount := balance.Balance.Sub(feeReserve)
+
+	err := k.MigrateTSSFundsForChain(ctx, chainID, amount, currentTss, tssList)
+	if err != nil {
+		return nil, err
+	}
+	migrator, found := k.zetaObserverKeeper.GetFundMigrator(ctx, chainID)
+	if !found {
+		return nil, fmt.Errorf("cannot find migration info")
+	}
+	migrator.ContractUpdateCctxIndexes = indexes
+	k.zetaObserverKeeper.SetFundMigrator(ctx, migrator)
+
+	return append(indexes, migrator.MigrationCctxIndex), nil
+}
+
+// createTssAddressUpdateCctx creates a cmd cctx updating the TSS address of a contract to the new TSS
+func (k Keeper) createTssAddressUpdateCctx(
+	ctx sdk.Context,
+	chainID int64,
+	cmd string,
+	contractAddress string,
+	newTssAddress string,
+	gasPrice sdkmath.Uint,
+	currentTss observertypes.TSS,
+	newTss observertypes.TSS,
+) (string, error) {
+	indexString := GetIndexStringForTssMigration(currentTss.TssPubkey, newTss.TssPubkey, chainID, sdkmath.ZeroUint(), ctx.BlockHeight())
+	index := crypto.Keccak256Hash([]byte(indexString), []byte(cmd)).Hex()
+	currentTssAddress, err := common.GetTssAddrEVM(currentTss.TssPubkey)
+	if err != nil {
+		return "", err
+	}
+	cctx := types.CrossChainTx{
+		Creator:        "",
+		Index:          index,
+		ZetaFees:       sdkmath.ZeroUint(),
+		RelayedMessage: fmt.Sprintf("%s:%s", cmd, newTssAddress),
+		CctxStatus: &types.Status{
+			Status:              types.CctxStatus_PendingOutbound,
+			StatusMessage:       "",
+			LastUpdateTimestamp: 0,
+			CreatedTimestamp:    ctx.BlockHeader().Time.Unix(),
+		},
+		InboundTxParams: &types.InboundTxParams{
+			Sender:                          currentTssAddress.String(),
+			SenderChainId:                   chainID,
+			TxOrigin:                        "",
+			CoinType:                        common.CoinType_Cmd,
+			Asset:                           "",
+			Amount:                          sdkmath.ZeroUint(),
+			InboundTxObservedHash:           tmbytes.HexBytes(tmtypes.Tx(ctx.TxBytes()).Hash()).String(),
+			InboundTxObservedExternalHeight: 0,
+			InboundTxBallotIndex:            "",
+			InboundTxFinalizedZetaHeight:    0,
+		},
+		OutboundTxParams: []*types.OutboundTxParams{{
+			Receiver:                         contractAddress,
+			ReceiverChainId:                  chainID,
+			CoinType:                         common.CoinType_Cmd,
+			Amount:                           sdkmath.ZeroUint(),
+			OutboundTxTssNonce:               0,
+			OutboundTxGasLimit:               tssAddressUpdateGasLimit,
+			OutboundTxGasPrice:               gasPrice.String(),
+			OutboundTxHash:                   "",
+			OutboundTxBallotIndex:            "",
+			OutboundTxObservedExternalHeight: 0,
+			OutboundTxGasUsed:                0,
+			OutboundTxEffectiveGasPrice:      sdkmath.Int{},
+			OutboundTxEffectiveGasLimit:      0,
+			TssPubkey:                        currentTss.TssPubkey,
+		}}}
+	err = k.UpdateNonce(ctx, chainID, &cctx)
+	if err != nil {
+		return "", err
+	}
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+	EmitEventInboundFinalized(ctx, &cctx)
+	return index, nil
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_MigrateAllTssFunds(t *testing.T) {
+	setupBalance := func(zk keepertest.ZetaKeepers, ctx sdk.Context, chainID int64, tssPubkey string, balance uint64) {
+		newTss := zk.ObserverKeeper.GetAllTSS(ctx)
+		zk.ObserverKeeper.SetTssBalance(ctx, observertypes.TssBalance{
+			ChainId:             chainID,
+			TssPubkey:           tssPubkey,
+			Balance:             sdkmath.NewUint(balance),
+			FinalizedZetaHeight: newTss[len(newTss)-1].FinalizedZetaHeight + 10,
+		})
+	}
+	// only the migrated chain is kept supported, once, the default params supporting several chains
+	supportOnly := func(zk keepertest.ZetaKeepers, ctx sdk.Context, chainID int64) {
+		params := zk.ObserverKeeper.GetParamsIfExists(ctx)
+		supported := false
+		for _, observerParams := range params.ObserverParams {
+			observerParams.IsSupported = !supported && observerParams.Chain.ChainId == chainID
+			supported = supported || observerParams.IsSupported
+		}
+		zk.ObserverKeeper.SetParams(ctx, params)
+	}
+
+	t.Run("should update the connector and migrate the voted balance on evm chains", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chain := getValidEthChain(t)
+		_, tssPubkey := setupTssMigrationParams(zk, k, ctx, *chain, sdkmath.ZeroUint(), true, true)
+		supportOnly(zk, ctx, chain.ChainId)
+		coreParams := sample.CoreParams(chain.ChainId)
+		zk.ObserverKeeper.SetCoreParams(ctx, observertypes.CoreParamsList{CoreParams: []*observertypes.CoreParams{coreParams}})
+		setupBalance(zk, ctx, chain.ChainId, tssPubkey, 1_000_000)
+
+		res, err := msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.NoError(t, err)
+		require.Len(t, res.CctxIndexes, 2)
+
+		// the TSS address of the ERC20Custody is left to its TSS updater
+		connectorUpdate, found := k.GetCrossChainTx(ctx, res.CctxIndexes[0])
+		require.True(t, found)
+		require.Equal(t, coreParams.ConnectorContractAddress, connectorUpdate.GetCurrentOutTxParam().Receiver)
+		require.Contains(t, connectorUpdate.RelayedMessage, common.CmdUpdateConnectorTssAddress)
+		require.EqualValues(t, 1, connectorUpdate.GetCurrentOutTxParam().OutboundTxTssNonce)
+
+		// the fees of the transfer and the connector update are reserved at twice the median gas price
+		migration, found := k.GetCrossChainTx(ctx, res.CctxIndexes[1])
+		require.True(t, found)
+		require.EqualValues(t, 2, migration.GetCurrentOutTxParam().OutboundTxTssNonce)
+		require.Equal(t, sdkmath.NewUint(1_000_000-2*(21_000+100_000)), migration.GetCurrentOutTxParam().Amount)
+
+		migrator, found := k.GetObserverKeeper().GetFundMigrator(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, res.CctxIndexes[1], migrator.MigrationCctxIndex)
+		require.Equal(t, res.CctxIndexes[:1], migrator.ContractUpdateCctxIndexes)
+
+		progress, err := k.TssFundsMigrationProgress(ctx, &crosschaintypes.QueryTssFundsMigrationProgressRequest{})
+		require.NoError(t, err)
+		require.Len(t, progress.Progress, 1)
+		require.Len(t, progress.Progress[0].Cctxs, 2)
+		require.Equal(t, common.CmdMigrateTssFunds, progress.Progress[0].Cctxs[1].Command)
+		require.False(t, progress.Progress[0].Completed)
+	})
+
+	t.Run("should sweep the voted balance on bitcoin", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chain := common.BtcRegtestChain()
+		_, tssPubkey := setupTssMigrationParams(zk, k, ctx, chain, sdkmath.ZeroUint(), true, true)
+		supportOnly(zk, ctx, chain.ChainId)
+		setupBalance(zk, ctx, chain.ChainId, tssPubkey, 1_000_000)
+
+		res, err := msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.NoError(t, err)
+		require.Len(t, res.CctxIndexes, 1)
+		migration, found := k.GetCrossChainTx(ctx, res.CctxIndexes[0])
+		require.True(t, found)
+		// #nosec G701 test
+		nonceMark := uint64(common.NonceMarkAmount(1))
+		require.Equal(t, sdkmath.NewUint(1_000_000-2*3234-nonceMark), migration.GetCurrentOutTxParam().Amount)
+	})
+
+	t.Run("should sweep again the utxos left after the sweep on bitcoin", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chain := common.BtcRegtestChain()
+		_, tssPubkey := setupTssMigrationParams(zk, k, ctx, chain, sdkmath.ZeroUint(), true, true)
+		supportOnly(zk, ctx, chain.ChainId)
+		setupBalance(zk, ctx, chain.ChainId, tssPubkey, 1_000_000)
+		ctx = ctx.WithBlockHeight(2000)
+		res, err := msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.NoError(t, err)
+		require.Len(t, res.CctxIndexes, 1)
+
+		// mine the sweep of the 20 biggest utxos, the tss still holds utxos
+		mineSweep := func(index string, nonce int64) {
+			sweep, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			sweep.CctxStatus.Status = crosschaintypes.CctxStatus_OutboundMined
+			k.SetCrossChainTx(ctx, sweep)
+			k.GetObserverKeeper().SetPendingNonces(ctx, observertypes.PendingNonces{
+				NonceLow:  nonce,
+				NonceHigh: nonce,
+				ChainId:   chain.ChainId,
+				Tss:       tssPubkey,
+			})
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		}
+		mineSweep(res.CctxIndexes[0], 2)
+
+		// the balance voted before the sweep is not swept again
+		_, err = msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotMigrateTssFunds)
+		require.ErrorContains(t, err, "no tss balance voted after the sweep")
+
+		zk.ObserverKeeper.SetTssBalance(ctx, observertypes.TssBalance{
+			ChainId:             chain.ChainId,
+			TssPubkey:           tssPubkey,
+			Balance:             sdkmath.NewUint(500_000),
+			FinalizedZetaHeight: ctx.BlockHeight(),
+		})
+		progress, err := k.TssFundsMigrationProgress(ctx, &crosschaintypes.QueryTssFundsMigrationProgressRequest{})
+		require.NoError(t, err)
+		require.False(t, progress.Progress[0].Completed)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		res, err = msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.NoError(t, err)
+		require.Len(t, res.CctxIndexes, 1)
+		sweep, found := k.GetCrossChainTx(ctx, res.CctxIndexes[0])
+		require.True(t, found)
+		require.EqualValues(t, 2, sweep.GetCurrentOutTxParam().OutboundTxTssNonce)
+		// #nosec G701 test
+		nonceMark := uint64(common.NonceMarkAmount(2))
+		require.Equal(t, sdkmath.NewUint(500_000-2*3234-nonceMark), sweep.GetCurrentOutTxParam().Amount)
+		migrator, found := k.GetObserverKeeper().GetFundMigrator(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, res.CctxIndexes[0], migrator.MigrationCctxIndex)
+
+		// only the nonce-mark is left after the last sweep
+		mineSweep(res.CctxIndexes[0], 3)
+		zk.ObserverKeeper.SetTssBalance(ctx, observertypes.TssBalance{
+			ChainId:             chain.ChainId,
+			TssPubkey:           tssPubkey,
+			Balance:             sdkmath.NewUint(nonceMark),
+			FinalizedZetaHeight: ctx.BlockHeight(),
+		})
+		_, err = msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotMigrateTssFunds)
+		require.ErrorContains(t, err, "no tss funds left to migrate")
+		progress, err = k.TssFundsMigrationProgress(ctx, &crosschaintypes.QueryTssFundsMigrationProgressRequest{})
+		require.NoError(t, err)
+		require.True(t, progress.Progress[0].Completed)
+	})
+
+	t.Run("should fail without a balance voted after the new tss", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chain := common.BtcRegtestChain()
+		_, tssPubkey := setupTssMigrationParams(zk, k, ctx, chain, sdkmath.ZeroUint(), true, true)
+		supportOnly(zk, ctx, chain.ChainId)
+
+		_, err := msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotMigrateTssFunds)
+		require.ErrorContains(t, err, "no tss balance voted")
+
+		zk.ObserverKeeper.SetTssBalance(ctx, observertypes.TssBalance{
+			ChainId:             chain.ChainId,
+			TssPubkey:           tssPubkey,
+			Balance:             sdkmath.NewUint(1_000_000),
+			FinalizedZetaHeight: 0,
+		})
+		_, err = msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotMigrateTssFunds)
+		require.ErrorContains(t, err, "before the new tss was finalized")
+	})
+
+	t.Run("should fail if the balance does not cover the fees", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chain := common.BtcRegtestChain()
+		_, tssPubkey := setupTssMigrationParams(zk, k, ctx, chain, sdkmath.ZeroUint(), true, true)
+		supportOnly(zk, ctx, chain.ChainId)
+		setupBalance(zk, ctx, chain.ChainId, tssPubkey, 1000)
+
+		_, err := msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotMigrateTssFunds)
+		require.ErrorContains(t, err, "does not cover the migration fees")
+	})
+
+	t.Run("should fail if inbound is enabled", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chain := getValidEthChain(t)
+		setupTssMigrationParams(zk, k, ctx, *chain, sdkmath.ZeroUint(), true, true)
+		supportOnly(zk, ctx, chain.ChainId)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, *observertypes.DefaultCrosschainFlags())
+
+		_, err := msgServer.MigrateAllTssFunds(ctx, crosschaintypes.NewMsgMigrateAllTssFunds(admin))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotMigrateTssFunds)
+	})
+}
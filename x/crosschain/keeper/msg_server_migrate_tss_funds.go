@@ -25,20 +25,9 @@ func (k msgServer) MigrateTssFunds(goCtx context.Context, msg *types.MsgMigrateT
 	if k.zetaObserverKeeper.IsInboundEnabled(ctx) {
 		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "cannot migrate funds while inbound is enabled")
 	}
-	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
-	if !found {
-		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "cannot find current TSS")
-	}
-	tssHistory := k.zetaObserverKeeper.GetAllTSS(ctx)
-	sort.SliceStable(tssHistory, func(i, j int) bool {
-		return tssHistory[i].FinalizedZetaHeight < tssHistory[j].FinalizedZetaHeight
-	})
-	if tss.TssPubkey == tssHistory[len(tssHistory)-1].TssPubkey {
-		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "no new tss address has been generated")
-	}
-	// This check is to deal with an edge case where the current TSS is not part of the TSS history list at all
-	if tss.FinalizedZetaHeight >= tssHistory[len(tssHistory)-1].FinalizedZetaHeight {
-		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "current tss is the latest")
+	tss, tssHistory, err := k.getTssMigration(ctx)
+	if err != nil {
+		return nil, err
 	}
 	pendingNonces, found := k.GetObserverKeeper().GetPendingNonces(ctx, tss.TssPubkey, msg.ChainId)
 	if !found {
@@ -47,13 +36,34 @@ func (k msgServer) MigrateTssFunds(goCtx context.Context, msg *types.MsgMigrateT
 	if pendingNonces.NonceLow != pendingNonces.NonceHigh {
 		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "cannot migrate funds when there are pending nonces")
 	}
-	err := k.MigrateTSSFundsForChain(ctx, msg.ChainId, msg.Amount, tss, tssHistory)
+	err = k.MigrateTSSFundsForChain(ctx, msg.ChainId, msg.Amount, tss, tssHistory)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, err.Error())
 	}
 	return &types.MsgMigrateTssFundsResponse{}, nil
 }
 
+// getTssMigration returns the current TSS and the TSS history sorted by finalized height,
+// the last TSS of the history being the new TSS the funds are migrated to
+func (k Keeper) getTssMigration(ctx sdk.Context) (observertypes.TSS, []observertypes.TSS, error) {
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return tss, nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "cannot find current TSS")
+	}
+	tssHistory := k.zetaObserverKeeper.GetAllTSS(ctx)
+	sort.SliceStable(tssHistory, func(i, j int) bool {
+		return tssHistory[i].FinalizedZetaHeight < tssHistory[j].FinalizedZetaHeight
+	})
+	if tss.TssPubkey == tssHistory[len(tssHistory)-1].TssPubkey {
+		return tss, nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "no new tss address has been generated")
+	}
+	// This check is to deal with an edge case where the current TSS is not part of the TSS history list at all
+	if tss.FinalizedZetaHeight >= tssHistory[len(tssHistory)-1].FinalizedZetaHeight {
+		return tss, nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "current tss is the latest")
+	}
+	return tss, tssHistory, nil
+}
+
 func (k Keeper) MigrateTSSFundsForChain(ctx sdk.Context, chainID int64, amount sdkmath.Uint, currentTss observertypes.TSS, tssList []observertypes.TSS) error {
 	// Always migrate to the latest TSS if multiple TSS addresses have been generated
 	newTss := tssList[len(tssList)-1]
@@ -74,6 +84,7 @@ func (k Keeper) MigrateTSSFundsForChain(ctx sdk.Context, chainID int64, amount s
 			Status:              types.CctxStatus_PendingOutbound,
 			StatusMessage:       "",
 			LastUpdateTimestamp: 0,
+			CreatedTimestamp:    ctx.BlockHeader().Time.Unix(),
 		},
 		InboundTxParams: &types.InboundTxParams{
 			Sender:                          "",
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// RefundAbortedCCTX refunds the amount of an aborted cctx on ZetaChain
+// The amount is deposited in ZRC20 for ERC20 and Gas cctx and minted for Zeta cctx, lowering the aborted zeta amount
+// The refund address defaults to the sender of the inbound tx, an aborted cctx can only be refunded once
+// Only the admin policy account is authorized to refund a cctx
+func (k msgServer) RefundAbortedCCTX(goCtx context.Context, msg *types.MsgRefundAbortedCCTX) (*types.MsgRefundAbortedCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Refund can only be executed by the correct policy account")
+	}
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCannotFindCctx, "cctx index %s", msg.CctxIndex)
+	}
+	if cctx.CctxStatus.Status != types.CctxStatus_Aborted {
+		return nil, errorsmod.Wrapf(types.ErrStatusNotAborted, "cctx status %s", cctx.CctxStatus.Status.String())
+	}
+	if cctx.CctxStatus.IsAbortRefunded {
+		return nil, errorsmod.Wrapf(types.ErrAbortedCctxRefunded, "cctx index %s", msg.CctxIndex)
+	}
+
+	refundAddress, err := getRefundAddress(cctx, msg.RefundAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrUnableToRefundCctx, err.Error())
+	}
+
+	// refund the up to date amount of the cctx
+	amount := cctx.GetCurrentOutTxParam().Amount
+	if cctx.InboundTxParams.CoinType == common.CoinType_Zeta {
+		if amount.IsNil() || amount.IsZero() {
+			return nil, errorsmod.Wrap(types.ErrUnableToRefundCctx, "no amount to refund")
+		}
+		if err := k.RemoveZetaAbortedAmount(ctx, amount); err != nil {
+			return nil, errorsmod.Wrap(types.ErrUnableToRefundCctx, err.Error())
+		}
+		if err := k.fungibleKeeper.DepositCoinZeta(ctx, refundAddress, amount.BigInt()); err != nil {
+			return nil, errorsmod.Wrap(types.ErrUnableToRefundCctx, err.Error())
+		}
+	} else if err := k.RefundAmountOnZetaChainToAddress(ctx, cctx, amount, refundAddress); err != nil {
+		return nil, errorsmod.Wrap(types.ErrUnableToRefundCctx, err.Error())
+	}
+
+	cctx.CctxStatus.IsAbortRefunded = true
+	k.SetCrossChainTx(ctx, cctx)
+
+	return &types.MsgRefundAbortedCCTXResponse{}, nil
+}
+
+// getRefundAddress returns the refund address of an aborted cctx, the sender of the inbound tx if not provided
+func getRefundAddress(cctx types.CrossChainTx, refundAddress string) (ethcommon.Address, error) {
+	if refundAddress != "" {
+		return ethcommon.HexToAddress(refundAddress), nil
+	}
+	if !common.IsEVMChain(cctx.InboundTxParams.SenderChainId) && !common.IsZetaChain(cctx.InboundTxParams.SenderChainId) {
+		return ethcommon.Address{}, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "refund address required for sender chain %d", cctx.InboundTxParams.SenderChainId)
+	}
+	sender := ethcommon.HexToAddress(cctx.InboundTxParams.Sender)
+	if sender == (ethcommon.Address{}) {
+		return ethcommon.Address{}, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", cctx.InboundTxParams.Sender)
+	}
+	return sender, nil
+}
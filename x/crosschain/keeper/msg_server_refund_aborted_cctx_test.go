@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+// sampleAbortedCCTX returns an aborted cctx of the coin type sent from an EVM chain
+func sampleAbortedCCTX(t *testing.T, coinType common.CoinType, amount sdkmath.Uint) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, "0")
+	cctx.CctxStatus.Status = types.CctxStatus_Aborted
+	cctx.CctxStatus.IsAbortRefunded = false
+	cctx.InboundTxParams.CoinType = coinType
+	cctx.InboundTxParams.SenderChainId = getValidEthChainID(t)
+	cctx.InboundTxParams.Sender = sample.EthAddress().String()
+	cctx.GetCurrentOutTxParam().Amount = amount
+	return *cctx
+}
+
+func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
+	t.Run("should mint the zeta amount to the sender and lower the aborted amount", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		cctx := sampleAbortedCCTX(t, common.CoinType_Zeta, sdkmath.NewUint(42))
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetZetaAccounting(ctx, types.ZetaAccounting{AbortedZetaAmount: sdkmath.NewUint(100)})
+
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		fungibleMock.On("DepositCoinZeta", mock.Anything, ethcommon.HexToAddress(cctx.InboundTxParams.Sender), sdkmath.NewUint(42).BigInt()).
+			Return(nil)
+
+		_, err := msgServer.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, cctx.Index, ""))
+		require.NoError(t, err)
+		fungibleMock.AssertExpectations(t)
+
+		refunded, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.True(t, refunded.CctxStatus.IsAbortRefunded)
+		zetaAccounting, found := k.GetZetaAccounting(ctx)
+		require.True(t, found)
+		require.Equal(t, sdkmath.NewUint(58), zetaAccounting.AbortedZetaAmount)
+
+		// an aborted cctx can't be refunded twice
+		_, err = msgServer.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, cctx.Index, ""))
+		require.ErrorIs(t, err, types.ErrAbortedCctxRefunded)
+	})
+
+	t.Run("should deposit the erc20 amount to the refund address", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		cctx := sampleAbortedCCTX(t, common.CoinType_ERC20, sdkmath.NewUint(42))
+		k.SetCrossChainTx(ctx, cctx)
+		refundAddress := sample.EthAddress()
+		zrc20 := sample.EthAddress()
+
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		fungibleMock.On("GetForeignCoinFromAsset", mock.Anything, cctx.InboundTxParams.Asset, cctx.InboundTxParams.SenderChainId).
+			Return(fungibletypes.ForeignCoins{Zrc20ContractAddress: zrc20.Hex()}, true)
+		fungibleMock.On("DepositZRC20", mock.Anything, zrc20, refundAddress, sdkmath.NewUint(42).BigInt()).
+			Return(nil, nil)
+
+		_, err := msgServer.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, cctx.Index, refundAddress.Hex()))
+		require.NoError(t, err)
+		fungibleMock.AssertExpectations(t)
+		refunded, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.True(t, refunded.CctxStatus.IsAbortRefunded)
+	})
+
+	t.Run("should fail if the cctx is not aborted", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		cctx := sampleAbortedCCTX(t, common.CoinType_Zeta, sdkmath.NewUint(42))
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, err := msgServer.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, cctx.Index, ""))
+		require.ErrorIs(t, err, types.ErrStatusNotAborted)
+	})
+
+	t.Run("should fail if the aborted zeta amount is insufficient", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		cctx := sampleAbortedCCTX(t, common.CoinType_Zeta, sdkmath.NewUint(42))
+		k.SetCrossChainTx(ctx, cctx)
+		k.SetZetaAccounting(ctx, types.ZetaAccounting{AbortedZetaAmount: sdkmath.NewUint(10)})
+
+		_, err := msgServer.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, cctx.Index, ""))
+		require.ErrorIs(t, err, types.ErrUnableToRefundCctx)
+	})
+
+	t.Run("should fail if not the admin policy account", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+		msgServer := keeper.NewMsgServerImpl(*k)
+		cctx := sampleAbortedCCTX(t, common.CoinType_Zeta, sdkmath.NewUint(42))
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, err := msgServer.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(sample.AccAddress(), cctx.Index, ""))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// ReleaseQueuedWithdrawal releases a withdrawal queued for exceeding the withdrawal limits
+// The cctx of the withdrawal is assigned a nonce and becomes pending outbound
+// Only the admin policy account is authorized to release a withdrawal
+func (k msgServer) ReleaseQueuedWithdrawal(goCtx context.Context, msg *types.MsgReleaseQueuedWithdrawal) (*types.MsgReleaseQueuedWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Release can only be executed by the correct policy account")
+	}
+	queuedWithdrawal, found := k.GetQueuedWithdrawal(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrQueuedWithdrawalNotFound, "cctx index %s", msg.CctxIndex)
+	}
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCannotFindCctx, "cctx index %s", msg.CctxIndex)
+	}
+	if !k.zetaObserverKeeper.IsOutboundEnabledForChain(ctx, queuedWithdrawal.ChainId) {
+		return nil, errorsmod.Wrapf(types.ErrNotEnoughPermissions, "outbound disabled for chain %d", queuedWithdrawal.ChainId)
+	}
+	receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(queuedWithdrawal.ChainId)
+	if receiverChain == nil {
+		return nil, errorsmod.Wrapf(types.ErrUnsupportedChain, "chain id %d", queuedWithdrawal.ChainId)
+	}
+
+	cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingOutbound, "withdrawal released")
+	if err := k.ProcessCCTX(ctx, cctx, receiverChain); err != nil {
+		return nil, err
+	}
+	k.RemoveQueuedWithdrawal(ctx, msg.CctxIndex)
+
+	return &types.MsgReleaseQueuedWithdrawalResponse{}, nil
+}
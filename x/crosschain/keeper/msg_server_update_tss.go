@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -30,8 +31,13 @@ func (k msgServer) UpdateTssAddress(goCtx context.Context, msg *types.MsgUpdateT
 
 	tssMigrators := k.zetaObserverKeeper.GetAllTssFundMigrators(ctx)
 	// Each connected chain should have its own tss migrator
-	if len(k.zetaObserverKeeper.GetParams(ctx).GetSupportedChains()) != len(tssMigrators) {
-		return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, "cannot update tss address not enough migrations have been created and completed")
+	for _, chain := range k.zetaObserverKeeper.GetParams(ctx).GetSupportedChains() {
+		if common.IsZetaChain(chain.ChainId) {
+			continue
+		}
+		if _, found := k.zetaObserverKeeper.GetFundMigrator(ctx, chain.ChainId); !found {
+			return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, "cannot update tss address not enough migrations have been created and completed")
+		}
 	}
 	// GetAllTssFundMigrators would return the migrators created for the current migration
 	// if any of the migrations is still pending we should not allow the tss address to be updated
@@ -45,7 +51,27 @@ func (k msgServer) UpdateTssAddress(goCtx context.Context, msg *types.MsgUpdateT
 			return nil, errorsmod.Wrapf(types.ErrUnableToUpdateTss,
 				"cannot update tss address while there are pending migrations , current status of migration cctx : %s ", migratorTx.CctxStatus.Status.String())
 		}
-
+		// a sweep spends 20 UTXOs at most, the current TSS holding more UTXOs must be swept again before the update
+		if common.IsBitcoinChain(tssMigrator.ChainId) {
+			swept, err := k.isTssSwept(ctx, tssMigrator.ChainId, currentTss, migratorTx)
+			if err != nil {
+				return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, err.Error())
+			}
+			if !swept {
+				return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, "cannot update tss address while the current tss still holds utxos to sweep")
+			}
+		}
+		// the TSS address of the connector must be updated as well, the new TSS can't sign for the connector otherwise
+		for _, index := range tssMigrator.ContractUpdateCctxIndexes {
+			updateTx, found := k.GetCrossChainTx(ctx, index)
+			if !found {
+				return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, "contract update cross chain tx not found")
+			}
+			if updateTx.CctxStatus.Status != types.CctxStatus_OutboundMined {
+				return nil, errorsmod.Wrapf(types.ErrUnableToUpdateTss,
+					"cannot update tss address while the tss address of the contracts is not updated, current status of contract update cctx : %s ", updateTx.CctxStatus.Status.String())
+			}
+		}
 	}
 
 	k.GetObserverKeeper().SetTssAndUpdateNonce(ctx, tss)
@@ -3,7 +3,10 @@ package keeper_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
@@ -11,6 +14,31 @@ import (
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
+// setSweepBalance votes the balance of the tss left after the sweep of the migration cctx on a bitcoin chain
+func setSweepBalance(k *keeper.Keeper, zk keepertest.ZetaKeepers, ctx sdk.Context, chainID int64, tss types.TSS, cctx *crosschaintypes.CrossChainTx, balance uint64) {
+	if !common.IsBitcoinChain(chainID) {
+		return
+	}
+	k.GetObserverKeeper().SetPendingNonces(ctx, types.PendingNonces{
+		NonceLow:  1,
+		NonceHigh: 1,
+		ChainId:   chainID,
+		Tss:       tss.TssPubkey,
+	})
+	k.SetGasPrice(ctx, crosschaintypes.GasPrice{
+		ChainId:     chainID,
+		Prices:      []uint64{1, 1, 1},
+		MedianIndex: 1,
+	})
+	zk.ObserverKeeper.SetTssBalance(ctx, types.TssBalance{
+		ChainId:   chainID,
+		TssPubkey: tss.TssPubkey,
+		Balance:   sdkmath.NewUint(balance),
+		// #nosec G701 test
+		FinalizedZetaHeight: int64(cctx.GetCurrentOutTxParam().OutboundTxCreatedZetaHeight) + 1,
+	})
+}
+
 func TestMsgServer_UpdateTssAddress(t *testing.T) {
 	t.Run("successfully update tss address", func(t *testing.T) {
 		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
@@ -31,6 +59,7 @@ func TestMsgServer_UpdateTssAddress(t *testing.T) {
 			cctx := sample.CrossChainTx(t, index)
 			cctx.CctxStatus.Status = crosschaintypes.CctxStatus_OutboundMined
 			k.SetCrossChainTx(ctx, *cctx)
+			setSweepBalance(k, zk, ctx, chain.ChainId, tssOld, cctx, 0)
 		}
 		assert.Equal(t, len(k.GetObserverKeeper().GetAllTssFundMigrators(ctx)), len(k.GetObserverKeeper().GetParams(ctx).GetSupportedChains()))
 		_, err := msgServer.UpdateTssAddress(ctx, &crosschaintypes.MsgUpdateTssAddress{
@@ -44,6 +73,101 @@ func TestMsgServer_UpdateTssAddress(t *testing.T) {
 		migrators := k.GetObserverKeeper().GetAllTssFundMigrators(ctx)
 		assert.Equal(t, 0, len(migrators))
 	})
+	t.Run("unable to update tss when the connector update failed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		tssOld := sample.Tss()
+		tssNew := sample.Tss()
+		k.GetObserverKeeper().SetTSSHistory(ctx, tssOld)
+		k.GetObserverKeeper().SetTSSHistory(ctx, tssNew)
+		k.GetObserverKeeper().SetTSS(ctx, tssOld)
+		for _, chain := range k.GetObserverKeeper().GetParams(ctx).GetSupportedChains() {
+			index := chain.ChainName.String() + "_migration_tx_index"
+			connectorUpdateIndex := chain.ChainName.String() + "_connector_update_tx_index"
+			k.GetObserverKeeper().SetFundMigrator(ctx, types.TssFundMigratorInfo{
+				ChainId:                   chain.ChainId,
+				MigrationCctxIndex:        index,
+				ContractUpdateCctxIndexes: []string{connectorUpdateIndex},
+			})
+			cctx := sample.CrossChainTx(t, index)
+			cctx.CctxStatus.Status = crosschaintypes.CctxStatus_OutboundMined
+			k.SetCrossChainTx(ctx, *cctx)
+			connectorUpdate := sample.CrossChainTx(t, connectorUpdateIndex)
+			connectorUpdate.RelayedMessage = common.CmdUpdateConnectorTssAddress + ":" + sample.EthAddress().Hex()
+			connectorUpdate.CctxStatus.Status = crosschaintypes.CctxStatus_Aborted
+			k.SetCrossChainTx(ctx, *connectorUpdate)
+			setSweepBalance(k, zk, ctx, chain.ChainId, tssOld, cctx, 0)
+		}
+		_, err := msgServer.UpdateTssAddress(ctx, &crosschaintypes.MsgUpdateTssAddress{
+			Creator:   admin,
+			TssPubkey: tssNew.TssPubkey,
+		})
+		assert.ErrorIs(t, err, crosschaintypes.ErrUnableToUpdateTss)
+		tss, found := k.GetObserverKeeper().GetTSS(ctx)
+		assert.True(t, found)
+		assert.Equal(t, tssOld, tss)
+	})
+	t.Run("unable to update tss while the current tss still holds utxos to sweep", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		tssOld := sample.Tss()
+		tssNew := sample.Tss()
+		k.GetObserverKeeper().SetTSSHistory(ctx, tssOld)
+		k.GetObserverKeeper().SetTSSHistory(ctx, tssNew)
+		k.GetObserverKeeper().SetTSS(ctx, tssOld)
+		btcChainID := int64(0)
+		var btcCctx *crosschaintypes.CrossChainTx
+		for _, chain := range k.GetObserverKeeper().GetParams(ctx).GetSupportedChains() {
+			index := chain.ChainName.String() + "_migration_tx_index"
+			k.GetObserverKeeper().SetFundMigrator(ctx, types.TssFundMigratorInfo{
+				ChainId:            chain.ChainId,
+				MigrationCctxIndex: index,
+			})
+			cctx := sample.CrossChainTx(t, index)
+			cctx.CctxStatus.Status = crosschaintypes.CctxStatus_OutboundMined
+			k.SetCrossChainTx(ctx, *cctx)
+			if common.IsBitcoinChain(chain.ChainId) {
+				btcChainID, btcCctx = chain.ChainId, cctx
+			}
+		}
+		assert.NotZero(t, btcChainID)
+
+		// no balance voted after the sweep
+		_, err := msgServer.UpdateTssAddress(ctx, &crosschaintypes.MsgUpdateTssAddress{
+			Creator:   admin,
+			TssPubkey: tssNew.TssPubkey,
+		})
+		assert.ErrorIs(t, err, crosschaintypes.ErrUnableToUpdateTss)
+		assert.ErrorContains(t, err, "no tss balance voted after the sweep")
+
+		// the utxos left after the sweep of the 20 biggest utxos cover another sweep
+		setSweepBalance(k, zk, ctx, btcChainID, tssOld, btcCctx, 1_000_000)
+		_, err = msgServer.UpdateTssAddress(ctx, &crosschaintypes.MsgUpdateTssAddress{
+			Creator:   admin,
+			TssPubkey: tssNew.TssPubkey,
+		})
+		assert.ErrorIs(t, err, crosschaintypes.ErrUnableToUpdateTss)
+		assert.ErrorContains(t, err, "still holds utxos to sweep")
+		tss, found := k.GetObserverKeeper().GetTSS(ctx)
+		assert.True(t, found)
+		assert.Equal(t, tssOld, tss)
+
+		// only the nonce-mark is left after the last sweep
+		// #nosec G701 test
+		setSweepBalance(k, zk, ctx, btcChainID, tssOld, btcCctx, uint64(common.NonceMarkAmount(1)))
+		_, err = msgServer.UpdateTssAddress(ctx, &crosschaintypes.MsgUpdateTssAddress{
+			Creator:   admin,
+			TssPubkey: tssNew.TssPubkey,
+		})
+		assert.NoError(t, err)
+		tss, found = k.GetObserverKeeper().GetTSS(ctx)
+		assert.True(t, found)
+		assert.Equal(t, tssNew, tss)
+	})
 	t.Run("new tss has not been added to tss history", func(t *testing.T) {
 		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
 		admin := sample.AccAddress()
@@ -202,4 +326,39 @@ func TestMsgServer_UpdateTssAddress(t *testing.T) {
 		migrators := k.GetObserverKeeper().GetAllTssFundMigrators(ctx)
 		assert.Equal(t, len(k.GetObserverKeeper().GetParams(ctx).GetSupportedChains()), len(migrators))
 	})
+	t.Run("unable to update tss when the contract updates are pending", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		tssOld := sample.Tss()
+		tssNew := sample.Tss()
+		k.GetObserverKeeper().SetTSSHistory(ctx, tssOld)
+		k.GetObserverKeeper().SetTSSHistory(ctx, tssNew)
+		k.GetObserverKeeper().SetTSS(ctx, tssOld)
+		for _, chain := range k.GetObserverKeeper().GetParams(ctx).GetSupportedChains() {
+			index := chain.ChainName.String() + "_migration_tx_index"
+			updateIndex := chain.ChainName.String() + "_contract_update_tx_index"
+			k.GetObserverKeeper().SetFundMigrator(ctx, types.TssFundMigratorInfo{
+				ChainId:                   chain.ChainId,
+				MigrationCctxIndex:        index,
+				ContractUpdateCctxIndexes: []string{updateIndex},
+			})
+			cctx := sample.CrossChainTx(t, index)
+			cctx.CctxStatus.Status = crosschaintypes.CctxStatus_OutboundMined
+			k.SetCrossChainTx(ctx, *cctx)
+			updateCctx := sample.CrossChainTx(t, updateIndex)
+			updateCctx.CctxStatus.Status = crosschaintypes.CctxStatus_PendingOutbound
+			k.SetCrossChainTx(ctx, *updateCctx)
+		}
+		_, err := msgServer.UpdateTssAddress(ctx, &crosschaintypes.MsgUpdateTssAddress{
+			Creator:   admin,
+			TssPubkey: tssNew.TssPubkey,
+		})
+		assert.ErrorContains(t, err, "the tss address of the contracts is not updated")
+		assert.ErrorIs(t, err, crosschaintypes.ErrUnableToUpdateTss)
+		tss, found := k.GetObserverKeeper().GetTSS(ctx)
+		assert.True(t, found)
+		assert.Equal(t, tssOld, tss)
+	})
 }
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UpdateWithdrawalLimits updates the rolling-window limits on the value withdrawn per ZRC20 and per chain
+// Only the admin policy account is authorized to update the limits
+func (k msgServer) UpdateWithdrawalLimits(goCtx context.Context, msg *types.MsgUpdateWithdrawalLimits) (*types.MsgUpdateWithdrawalLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Update can only be executed by the correct policy account")
+	}
+	if err := msg.WithdrawalLimits.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWithdrawalLimits, err.Error())
+	}
+
+	k.SetWithdrawalLimits(ctx, msg.WithdrawalLimits)
+	return &types.MsgUpdateWithdrawalLimitsResponse{}, nil
+}
@@ -62,6 +62,9 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	if !k.zetaObserverKeeper.IsInboundEnabled(ctx) {
 		return nil, types.ErrNotEnoughPermissions
 	}
+	if !k.zetaObserverKeeper.IsInboundEnabledForChain(ctx, msg.SenderChainId) {
+		return nil, sdkerrors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("inbound disabled for chain %d", msg.SenderChainId))
+	}
 	// GetChainFromChainID makes sure we are getting only supported chains , if a chain support has been turned on using gov proposal, this function returns nil
 	observationChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(msg.SenderChainId)
 	if observationChain == nil {
@@ -89,6 +92,10 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 	if isNew {
+		// the ballot of a finalized inbound is deleted after maturity, a late vote must not create the cctx again
+		if _, found := k.GetCrossChainTx(ctx, index); found {
+			return nil, sdkerrors.Wrap(types.ErrObservedTxAlreadyFinalized, fmt.Sprintf("CCTX %s already exists", index))
+		}
 		observerKeeper.EmitEventBallotCreated(ctx, ballot, msg.InTxHash, observationChain.String())
 	}
 	// AddVoteToBallot adds a vote and sets the ballot
@@ -193,6 +200,8 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 							"sender", cctx.InboundTxParams.Sender,
 							"amount", cctx.InboundTxParams.Amount.String(),
 						)
+					} else {
+						cctx.CctxStatus.IsAbortRefunded = true
 					}
 				}
 
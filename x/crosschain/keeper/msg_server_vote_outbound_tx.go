@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math/big"
 
@@ -95,6 +94,10 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 		return nil, err
 	}
 	if isNew {
+		// the finalizing ballot of an outbound is deleted after maturity, a late vote must not create it again
+		if !isOutboundPending(cctx) && cctx.GetCurrentOutTxParam().OutboundTxBallotIndex == ballotIndex {
+			return nil, sdkerrors.Wrap(types.ErrObservedTxAlreadyFinalized, fmt.Sprintf("CCTX %s has status %s", msg.CctxHash, cctx.CctxStatus.Status))
+		}
 		observerKeeper.EmitEventBallotCreated(ctx, ballot, msg.ObservedOutTxHash, observationChain.String())
 		// Set this the first time when the ballot is created
 		// The ballot might change if there are more votes in a different outbound ballot for this cctx hash
@@ -112,6 +115,10 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 		// Return nil here to add vote to ballot and commit state
 		return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
 	}
+	// the votes of a ballot finalized after the outbound are kept, the cctx is not finalized again
+	if !isOutboundPending(cctx) {
+		return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
+	}
 	if ballot.BallotStatus != observerTypes.BallotStatus_BallotFinalized_FailureObservation {
 		if !msg.ValueReceived.Equal(cctx.GetCurrentOutTxParam().Amount) {
 			log.Error().Msgf("VoteOnObservedOutboundTx: Mint mismatch: %s value received vs %s cctx amount",
@@ -164,38 +171,7 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 			} else {
 				switch oldStatus {
 				case types.CctxStatus_PendingOutbound:
-
-					gasLimit, err := k.GetRevertGasLimit(ctx, cctx)
-					if err != nil {
-						return errors.New("can't get revert tx gas limit" + err.Error())
-					}
-					if gasLimit == 0 {
-						// use same gas limit of outbound as a fallback -- should not happen
-						gasLimit = cctx.OutboundTxParams[0].OutboundTxGasLimit
-					}
-
-					// create new OutboundTxParams for the revert
-					revertTxParams := &types.OutboundTxParams{
-						Receiver:           cctx.InboundTxParams.Sender,
-						ReceiverChainId:    cctx.InboundTxParams.SenderChainId,
-						Amount:             cctx.InboundTxParams.Amount,
-						CoinType:           cctx.InboundTxParams.CoinType,
-						OutboundTxGasLimit: gasLimit,
-					}
-					cctx.OutboundTxParams = append(cctx.OutboundTxParams, revertTxParams)
-
-					err = k.PayGasAndUpdateCctx(
-						tmpCtx,
-						cctx.InboundTxParams.SenderChainId,
-						&cctx,
-						cctx.OutboundTxParams[0].Amount,
-						false,
-					)
-					if err != nil {
-						return err
-					}
-					err = k.UpdateNonce(tmpCtx, cctx.InboundTxParams.SenderChainId, &cctx)
-					if err != nil {
+					if err := k.AddRevertOutbound(tmpCtx, &cctx); err != nil {
 						return err
 					}
 					cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingRevert, "Outbound failed, start revert")
@@ -229,6 +205,11 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
 }
 
+// isOutboundPending returns true if the outbound of the cctx is not finalized yet
+func isOutboundPending(cctx types.CrossChainTx) bool {
+	return cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound || cctx.CctxStatus.Status == types.CctxStatus_PendingRevert
+}
+
 func percentOf(n *big.Int, percent int64) *big.Int {
 	n = n.Mul(n, big.NewInt(percent))
 	n = n.Div(n, big.NewInt(100))
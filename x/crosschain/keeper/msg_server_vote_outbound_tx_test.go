@@ -7,10 +7,15 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	testkeeper "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 func TestKeeper_FundGasStabilityPoolFromRemainingFees(t *testing.T) {
@@ -111,3 +116,117 @@ func TestKeeper_FundGasStabilityPoolFromRemainingFees(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServer_VoteOnObservedOutboundTx(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+
+	setup := func(t *testing.T, status types.CctxStatus) (*keeper.Keeper, sdk.Context, *types.MsgVoteOnObservedOutboundTx) {
+		k, ctx, _, _ := testkeeper.CrosschainKeeperWithMocks(t, testkeeper.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := testkeeper.GetCrosschainObserverMock(t, k)
+		observerMock.On("GetParams", mock.Anything).Return(observertypes.Params{
+			ObserverParams: []*observertypes.ObserverParams{{Chain: &chain, IsSupported: true}},
+		})
+		observerMock.On("IsAuthorized", mock.Anything, mock.Anything, &chain).Return(true)
+
+		cctx := sample.CrossChainTx(t, "cctx")
+		cctx.CctxStatus.Status = status
+		k.SetCrossChainTx(ctx, *cctx)
+
+		return k, ctx, &types.MsgVoteOnObservedOutboundTx{
+			Creator:           sample.AccAddress(),
+			CctxHash:          cctx.Index,
+			ObservedOutTxHash: sample.Hash().String(),
+			ValueReceived:     cctx.GetCurrentOutTxParam().Amount,
+			Status:            common.ReceiveStatus_Success,
+			OutTxChain:        chain.ChainId,
+			OutTxTssNonce:     cctx.GetCurrentOutTxParam().OutboundTxTssNonce,
+			CoinType:          common.CoinType_Gas,
+		}
+	}
+
+	t.Run("should reject a vote for a finalized outbound with a pruned ballot", func(t *testing.T) {
+		for _, status := range []types.CctxStatus{
+			types.CctxStatus_OutboundMined,
+			types.CctxStatus_Reverted,
+			types.CctxStatus_Aborted,
+		} {
+			k, ctx, msg := setup(t, status)
+			cctx, found := k.GetCrossChainTx(ctx, msg.CctxHash)
+			require.True(t, found)
+			cctx.GetCurrentOutTxParam().OutboundTxBallotIndex = msg.Digest()
+			k.SetCrossChainTx(ctx, cctx)
+			observerMock := testkeeper.GetCrosschainObserverMock(t, k)
+			observerMock.On("FindBallot", mock.Anything, msg.Digest(), &chain, observertypes.ObservationType_OutBoundTx).
+				Return(observertypes.Ballot{BallotIdentifier: msg.Digest()}, true, nil)
+
+			// the pruned ballot is not created again
+			_, err := keeper.NewMsgServerImpl(*k).VoteOnObservedOutboundTx(sdk.WrapSDKContext(ctx), msg)
+			require.ErrorIs(t, err, types.ErrObservedTxAlreadyFinalized)
+			observerMock.AssertNotCalled(t, "AddVoteToBallot")
+		}
+	})
+
+	t.Run("should add a late vote to the ballot of a finalized outbound", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_OutboundMined)
+		ballot := observertypes.Ballot{
+			BallotIdentifier: msg.Digest(),
+			BallotStatus:     observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+		}
+		observerMock := testkeeper.GetCrosschainObserverMock(t, k)
+		observerMock.On("FindBallot", mock.Anything, msg.Digest(), &chain, observertypes.ObservationType_OutBoundTx).
+			Return(ballot, false, nil)
+		observerMock.On("AddVoteToBallot", mock.Anything, ballot, msg.Creator, observertypes.VoteType_SuccessObservation).
+			Return(ballot, nil)
+		observerMock.On("CheckIfFinalizingVote", mock.Anything, ballot).Return(ballot, false)
+
+		_, err := keeper.NewMsgServerImpl(*k).VoteOnObservedOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		observerMock.AssertCalled(t, "AddVoteToBallot", mock.Anything, ballot, msg.Creator, observertypes.VoteType_SuccessObservation)
+
+		cctx, found := k.GetCrossChainTx(ctx, msg.CctxHash)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+	})
+
+	t.Run("should not finalize a finalized outbound again with a late ballot", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_OutboundMined)
+		ballot := observertypes.Ballot{BallotIdentifier: msg.Digest()}
+		finalizedBallot := observertypes.Ballot{
+			BallotIdentifier: msg.Digest(),
+			BallotStatus:     observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+		}
+		observerMock := testkeeper.GetCrosschainObserverMock(t, k)
+		observerMock.On("FindBallot", mock.Anything, msg.Digest(), &chain, observertypes.ObservationType_OutBoundTx).
+			Return(ballot, true, nil)
+		observerMock.On("AddVoteToBallot", mock.Anything, ballot, msg.Creator, observertypes.VoteType_SuccessObservation).
+			Return(ballot, nil)
+		observerMock.On("CheckIfFinalizingVote", mock.Anything, ballot).Return(finalizedBallot, true)
+		cctx, found := k.GetCrossChainTx(ctx, msg.CctxHash)
+		require.True(t, found)
+
+		_, err := keeper.NewMsgServerImpl(*k).VoteOnObservedOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		// the cctx is unchanged
+		storedCctx, found := k.GetCrossChainTx(ctx, msg.CctxHash)
+		require.True(t, found)
+		require.Equal(t, cctx, storedCctx)
+		observerMock.AssertNotCalled(t, "GetTSS", mock.Anything)
+	})
+
+	t.Run("should vote for a pending outbound", func(t *testing.T) {
+		for _, status := range []types.CctxStatus{
+			types.CctxStatus_PendingOutbound,
+			types.CctxStatus_PendingRevert,
+		} {
+			k, ctx, msg := setup(t, status)
+			testkeeper.GetCrosschainObserverMock(t, k).On("FindBallot", mock.Anything, msg.Digest(), &chain, observertypes.ObservationType_OutBoundTx).
+				Return(observertypes.Ballot{}, false, errors.New("find ballot"))
+
+			_, err := keeper.NewMsgServerImpl(*k).VoteOnObservedOutboundTx(sdk.WrapSDKContext(ctx), msg)
+			require.ErrorContains(t, err, "find ballot")
+		}
+	})
+}
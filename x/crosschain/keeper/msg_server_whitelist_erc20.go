@@ -114,6 +114,7 @@ func (k msgServer) WhitelistERC20(goCtx context.Context, msg *types.MsgWhitelist
 			Status:              types.CctxStatus_PendingOutbound,
 			StatusMessage:       "",
 			LastUpdateTimestamp: 0,
+			CreatedTimestamp:    ctx.BlockHeader().Time.Unix(),
 		},
 		InboundTxParams: &types.InboundTxParams{
 			Sender:                          "",
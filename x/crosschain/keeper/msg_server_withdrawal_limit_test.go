@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setQueuedWithdrawal sets a cctx queued for exceeding the withdrawal limits to the chain
+func setQueuedWithdrawal(t *testing.T, ctx sdk.Context, k *keeper.Keeper, index string, chainID int64) types.QueuedWithdrawal {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.CctxStatus.Status = types.CctxStatus_PendingWithdrawalLimit
+	cctx.GetCurrentOutTxParam().ReceiverChainId = chainID
+	k.SetCrossChainTx(ctx, *cctx)
+
+	queuedWithdrawal := sample.QueuedWithdrawal(t, index)
+	queuedWithdrawal.ChainId = chainID
+	k.SetQueuedWithdrawal(ctx, queuedWithdrawal)
+	return queuedWithdrawal
+}
+
+func TestMsgServer_UpdateWithdrawalLimits(t *testing.T) {
+	limits := types.WithdrawalLimits{
+		Window: 100,
+		Zrc20Limits: []types.ZRC20WithdrawalLimit{
+			{Zrc20ContractAddress: sample.EthAddress().Hex(), Limit: sdkmath.NewUint(1000), ZetaRate: sdk.NewDec(2)},
+		},
+		ChainLimits: []types.ChainWithdrawalLimit{
+			{ChainId: 5, Limit: sdkmath.NewUint(5000)},
+		},
+	}
+
+	t.Run("should update the withdrawal limits", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.UpdateWithdrawalLimits(ctx, types.NewMsgUpdateWithdrawalLimits(admin, limits))
+		require.NoError(t, err)
+		stored, found := k.GetWithdrawalLimits(ctx)
+		require.True(t, found)
+		require.Equal(t, limits, stored)
+	})
+
+	t.Run("should fail if not the admin policy account", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.UpdateWithdrawalLimits(ctx, types.NewMsgUpdateWithdrawalLimits(sample.AccAddress(), limits))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		_, found := k.GetWithdrawalLimits(ctx)
+		require.False(t, found)
+	})
+}
+
+func TestMsgServer_ReleaseQueuedWithdrawal(t *testing.T) {
+	t.Run("should assign a nonce to the cctx and remove the queued withdrawal", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chain := common.GoerliChain()
+		tss := sample.Tss()
+		params := zk.ObserverKeeper.GetParamsIfExists(ctx)
+		params.ObserverParams = append(params.ObserverParams, &observertypes.ObserverParams{
+			Chain:                 &chain,
+			BallotThreshold:       sdk.NewDec(0),
+			MinObserverDelegation: sdk.OneDec(),
+			IsSupported:           true,
+		})
+		zk.ObserverKeeper.SetParams(ctx, params)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, *observertypes.DefaultCrosschainFlags())
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{
+			Index:   chain.ChainName.String(),
+			ChainId: chain.ChainId,
+			Nonce:   42,
+		})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			NonceLow:  42,
+			NonceHigh: 42,
+			ChainId:   chain.ChainId,
+			Tss:       tss.TssPubkey,
+		})
+		queuedWithdrawal := setQueuedWithdrawal(t, ctx, k, "0", chain.ChainId)
+
+		_, err := msgServer.ReleaseQueuedWithdrawal(ctx, types.NewMsgReleaseQueuedWithdrawal(admin, queuedWithdrawal.CctxIndex))
+		require.NoError(t, err)
+		cctx, found := k.GetCrossChainTx(ctx, queuedWithdrawal.CctxIndex)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.EqualValues(t, 42, cctx.GetCurrentOutTxParam().OutboundTxTssNonce)
+		_, found = k.GetQueuedWithdrawal(ctx, queuedWithdrawal.CctxIndex)
+		require.False(t, found)
+	})
+
+	t.Run("should fail if outbound is disabled for the chain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chainID := common.GoerliChain().ChainId
+		flags := observertypes.DefaultCrosschainFlags()
+		flags.SetChainFlags(observertypes.ChainCrosschainFlags{
+			ChainId:           chainID,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: false,
+		})
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, *flags)
+		queuedWithdrawal := setQueuedWithdrawal(t, ctx, k, "0", chainID)
+
+		_, err := msgServer.ReleaseQueuedWithdrawal(ctx, types.NewMsgReleaseQueuedWithdrawal(admin, queuedWithdrawal.CctxIndex))
+		require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+		_, found := k.GetQueuedWithdrawal(ctx, queuedWithdrawal.CctxIndex)
+		require.True(t, found)
+	})
+
+	t.Run("should fail if the withdrawal is not queued", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.ReleaseQueuedWithdrawal(ctx, types.NewMsgReleaseQueuedWithdrawal(admin, "0"))
+		require.ErrorIs(t, err, types.ErrQueuedWithdrawalNotFound)
+	})
+}
+
+func TestMsgServer_CancelQueuedWithdrawal(t *testing.T) {
+	t.Run("should refund the amount and abort the cctx", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		queuedWithdrawal := setQueuedWithdrawal(t, ctx, k, "0", common.GoerliChain().ChainId)
+
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		fungibleMock.On(
+			"DepositZRC20",
+			mock.Anything,
+			ethcommon.HexToAddress(queuedWithdrawal.Zrc20ContractAddress),
+			ethcommon.HexToAddress(queuedWithdrawal.RefundAddress),
+			queuedWithdrawal.Amount.BigInt(),
+		).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
+
+		_, err := msgServer.CancelQueuedWithdrawal(ctx, types.NewMsgCancelQueuedWithdrawal(admin, queuedWithdrawal.CctxIndex))
+		require.NoError(t, err)
+		fungibleMock.AssertExpectations(t)
+		cctx, found := k.GetCrossChainTx(ctx, queuedWithdrawal.CctxIndex)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		_, found = k.GetQueuedWithdrawal(ctx, queuedWithdrawal.CctxIndex)
+		require.False(t, found)
+	})
+
+	t.Run("should keep the withdrawal queued if the refund fails", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		queuedWithdrawal := setQueuedWithdrawal(t, ctx, k, "0", common.GoerliChain().ChainId)
+
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		fungibleMock.On("DepositZRC20", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("deposit failed"))
+
+		_, err := msgServer.CancelQueuedWithdrawal(ctx, types.NewMsgCancelQueuedWithdrawal(admin, queuedWithdrawal.CctxIndex))
+		require.ErrorIs(t, err, types.ErrUnableToRefundWithdrawal)
+		_, found := k.GetQueuedWithdrawal(ctx, queuedWithdrawal.CctxIndex)
+		require.True(t, found)
+	})
+
+	t.Run("should fail if not the admin policy account", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+		msgServer := keeper.NewMsgServerImpl(*k)
+		queuedWithdrawal := setQueuedWithdrawal(t, ctx, k, "0", common.GoerliChain().ChainId)
+
+		_, err := msgServer.CancelQueuedWithdrawal(ctx, types.NewMsgCancelQueuedWithdrawal(sample.AccAddress(), queuedWithdrawal.CctxIndex))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+}
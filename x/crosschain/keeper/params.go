@@ -10,8 +10,11 @@ import (
 )
 
 // GetParams get all parameters as types.Params
-func (k Keeper) GetParams(_ sdk.Context) types.Params {
-	return types.NewParams()
+// the default params are returned for the params not set in the store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.NewParams()
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams set the params
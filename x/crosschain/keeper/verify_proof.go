@@ -37,14 +37,14 @@ func (k Keeper) VerifyProof(ctx sdk.Context, proof *common.Proof, chainID int64,
 		return nil, fmt.Errorf("chain %d does not support block header-based verification", chainID)
 	}
 
-	// get block header from the store
+	// get block header from the store, it must be on the canonical chain and confirmed
 	hashBytes, err := common.StringToHash(chainID, blockHash)
 	if err != nil {
 		return nil, fmt.Errorf("block hash %s conversion failed %s", blockHash, err)
 	}
-	res, found := k.zetaObserverKeeper.GetBlockHeader(ctx, hashBytes)
-	if !found {
-		return nil, fmt.Errorf("block header not found %s", blockHash)
+	res, err := k.zetaObserverKeeper.GetProvableBlockHeader(ctx, hashBytes)
+	if err != nil {
+		return nil, err
 	}
 
 	// verify merkle proof
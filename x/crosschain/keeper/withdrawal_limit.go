@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// SetWithdrawalLimits sets the withdrawal limits in the store
+func (k Keeper) SetWithdrawalLimits(ctx sdk.Context, limits types.WithdrawalLimits) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&limits)
+	store.Set([]byte(types.WithdrawalLimitsKey), b)
+}
+
+// GetWithdrawalLimits returns the withdrawal limits
+func (k Keeper) GetWithdrawalLimits(ctx sdk.Context) (val types.WithdrawalLimits, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.WithdrawalLimitsKey))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// zrc20UsageStore returns the store of the amounts of a ZRC20 withdrawn per block
+func (k Keeper) zrc20UsageStore(ctx sdk.Context, zrc20Address string) prefix.Store {
+	key := types.WithdrawalZRC20UsageKey + ethcommon.HexToAddress(zrc20Address).Hex() + "/"
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(key))
+}
+
+// chainUsageStore returns the store of the values in azeta withdrawn to a chain per block
+func (k Keeper) chainUsageStore(ctx sdk.Context, chainID int64) prefix.Store {
+	key := types.WithdrawalChainUsageKey + strconv.FormatInt(chainID, 10) + "/"
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(key))
+}
+
+// windowStart returns the first block height of the window ending at the current block
+func windowStart(ctx sdk.Context, window int64) uint64 {
+	start := ctx.BlockHeight() - window + 1
+	if start < 0 {
+		return 0
+	}
+	// #nosec G701 always positive
+	return uint64(start)
+}
+
+// windowUsage returns the sum of the amounts withdrawn in the window ending at the current block
+func windowUsage(ctx sdk.Context, store prefix.Store, window int64) math.Uint {
+	// #nosec G701 always positive
+	end := uint64(ctx.BlockHeight()) + 1
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(windowStart(ctx, window)), sdk.Uint64ToBigEndian(end))
+	defer iterator.Close()
+
+	usage := math.ZeroUint()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Uint
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			continue
+		}
+		usage = usage.Add(amount)
+	}
+	return usage
+}
+
+// addWindowUsage adds an amount withdrawn at the current block and prunes the amounts withdrawn before the window
+func addWindowUsage(ctx sdk.Context, store prefix.Store, window int64, amount math.Uint) {
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(windowStart(ctx, window)))
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	// #nosec G701 always positive
+	key := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
+	usage := math.ZeroUint()
+	if b := store.Get(key); b != nil {
+		if err := usage.Unmarshal(b); err != nil {
+			usage = math.ZeroUint()
+		}
+	}
+	b, err := usage.Add(amount).Marshal()
+	if err != nil {
+		return
+	}
+	store.Set(key, b)
+}
+
+// GetZRC20WithdrawalUsage returns the amount of a ZRC20 withdrawn in the window ending at the current block
+func (k Keeper) GetZRC20WithdrawalUsage(ctx sdk.Context, zrc20Address string, window int64) math.Uint {
+	return windowUsage(ctx, k.zrc20UsageStore(ctx, zrc20Address), window)
+}
+
+// GetChainWithdrawalUsage returns the value in azeta withdrawn to a chain in the window ending at the current block
+func (k Keeper) GetChainWithdrawalUsage(ctx sdk.Context, chainID int64, window int64) math.Uint {
+	return windowUsage(ctx, k.chainUsageStore(ctx, chainID), window)
+}
+
+// RecordWithdrawal records a withdrawal of a ZRC20 to its foreign chain in the usage of the withdrawal limits
+// It returns false without recording the withdrawal if it exceeds the limits.
+// The withdrawal exceeds the limit of its chain if the ZRC20 has no zeta rate to value it.
+func (k Keeper) RecordWithdrawal(ctx sdk.Context, zrc20Address string, chainID int64, amount math.Uint) bool {
+	limits, found := k.GetWithdrawalLimits(ctx)
+	if !found || limits.Window <= 0 {
+		return true
+	}
+
+	zrc20Limit, found := limits.ZRC20Limit(zrc20Address)
+	if found && !zrc20Limit.Limit.IsZero() {
+		usage := k.GetZRC20WithdrawalUsage(ctx, zrc20Address, limits.Window)
+		if usage.Add(amount).GT(zrc20Limit.Limit) {
+			return false
+		}
+	}
+
+	value, valued := zrc20Limit.ZetaValue(amount)
+	chainLimit, found := limits.ChainLimit(chainID)
+	if found && !chainLimit.Limit.IsZero() {
+		if !valued {
+			return false
+		}
+		usage := k.GetChainWithdrawalUsage(ctx, chainID, limits.Window)
+		if usage.Add(value).GT(chainLimit.Limit) {
+			return false
+		}
+	}
+
+	addWindowUsage(ctx, k.zrc20UsageStore(ctx, zrc20Address), limits.Window, amount)
+	if valued {
+		addWindowUsage(ctx, k.chainUsageStore(ctx, chainID), limits.Window, value)
+	}
+	return true
+}
+
+// SetQueuedWithdrawal sets a queued withdrawal in the store
+func (k Keeper) SetQueuedWithdrawal(ctx sdk.Context, queuedWithdrawal types.QueuedWithdrawal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedWithdrawalKey))
+	b := k.cdc.MustMarshal(&queuedWithdrawal)
+	store.Set(types.KeyPrefix(queuedWithdrawal.CctxIndex), b)
+}
+
+// GetQueuedWithdrawal returns a queued withdrawal from the index of its cctx
+func (k Keeper) GetQueuedWithdrawal(ctx sdk.Context, cctxIndex string) (val types.QueuedWithdrawal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedWithdrawalKey))
+	b := store.Get(types.KeyPrefix(cctxIndex))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveQueuedWithdrawal removes a queued withdrawal from the store
+func (k Keeper) RemoveQueuedWithdrawal(ctx sdk.Context, cctxIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedWithdrawalKey))
+	store.Delete(types.KeyPrefix(cctxIndex))
+}
+
+// GetAllQueuedWithdrawal returns all queued withdrawals
+func (k Keeper) GetAllQueuedWithdrawal(ctx sdk.Context) (queuedWithdrawals []types.QueuedWithdrawal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedWithdrawalKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.QueuedWithdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		queuedWithdrawals = append(queuedWithdrawals, val)
+	}
+	return
+}
+
+// GetAllQueuedWithdrawalPaginated returns the queued withdrawals with pagination
+func (k Keeper) GetAllQueuedWithdrawalPaginated(ctx sdk.Context, pagination *query.PageRequest) (queuedWithdrawals []types.QueuedWithdrawal, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedWithdrawalKey))
+	pageRes, err = query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var queuedWithdrawal types.QueuedWithdrawal
+		if err := k.cdc.Unmarshal(value, &queuedWithdrawal); err != nil {
+			return err
+		}
+		queuedWithdrawals = append(queuedWithdrawals, queuedWithdrawal)
+		return nil
+	})
+	return
+}
+
+// QueueWithdrawal saves a cctx exceeding the withdrawal limits without assigning it a nonce
+// the withdrawal remains queued until released or cancelled by the admin policy
+func (k Keeper) QueueWithdrawal(ctx sdk.Context, cctx types.CrossChainTx, zrc20Address string, refundAddress string) error {
+	k.setInCctxIndex(ctx, &cctx)
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+	k.SetQueuedWithdrawal(ctx, types.QueuedWithdrawal{
+		CctxIndex:            cctx.Index,
+		Zrc20ContractAddress: zrc20Address,
+		ChainId:              cctx.GetCurrentOutTxParam().ReceiverChainId,
+		Amount:               cctx.GetCurrentOutTxParam().Amount,
+		RefundAddress:        refundAddress,
+		Height:               ctx.BlockHeight(),
+	})
+	return nil
+}
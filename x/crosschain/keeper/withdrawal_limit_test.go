@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_RecordWithdrawal(t *testing.T) {
+	zrc20 := sample.EthAddress().Hex()
+	chainID := int64(5)
+
+	t.Run("should record any withdrawal if no limits are set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		require.True(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(1000)))
+	})
+
+	t.Run("should limit the amount of a zrc20 withdrawn in the window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetWithdrawalLimits(ctx, types.WithdrawalLimits{
+			Window: 10,
+			Zrc20Limits: []types.ZRC20WithdrawalLimit{
+				{Zrc20ContractAddress: zrc20, Limit: sdkmath.NewUint(1000), ZetaRate: sdk.ZeroDec()},
+			},
+		})
+
+		ctx = ctx.WithBlockHeight(100)
+		require.True(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(600)))
+		ctx = ctx.WithBlockHeight(105)
+		require.False(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(600)))
+		require.True(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(400)))
+		require.Equal(t, sdkmath.NewUint(1000), k.GetZRC20WithdrawalUsage(ctx, zrc20, 10))
+
+		// the first withdrawal leaves the window
+		ctx = ctx.WithBlockHeight(110)
+		require.Equal(t, sdkmath.NewUint(400), k.GetZRC20WithdrawalUsage(ctx, zrc20, 10))
+		require.True(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(600)))
+		require.False(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(1)))
+	})
+
+	t.Run("should limit the value withdrawn to a chain in the window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		otherZRC20 := sample.EthAddress().Hex()
+		k.SetWithdrawalLimits(ctx, types.WithdrawalLimits{
+			Window: 10,
+			Zrc20Limits: []types.ZRC20WithdrawalLimit{
+				{Zrc20ContractAddress: zrc20, Limit: sdkmath.ZeroUint(), ZetaRate: sdk.NewDec(2)},
+				{Zrc20ContractAddress: otherZRC20, Limit: sdkmath.ZeroUint(), ZetaRate: sdk.NewDec(3)},
+			},
+			ChainLimits: []types.ChainWithdrawalLimit{
+				{ChainId: chainID, Limit: sdkmath.NewUint(1000)},
+			},
+		})
+
+		require.True(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(200)))
+		require.False(t, k.RecordWithdrawal(ctx, otherZRC20, chainID, sdkmath.NewUint(201)))
+		require.True(t, k.RecordWithdrawal(ctx, otherZRC20, chainID, sdkmath.NewUint(200)))
+		require.Equal(t, sdkmath.NewUint(1000), k.GetChainWithdrawalUsage(ctx, chainID, 10))
+
+		// withdrawals to other chains are not limited
+		require.True(t, k.RecordWithdrawal(ctx, zrc20, 1, sdkmath.NewUint(1000)))
+	})
+
+	t.Run("should not record withdrawals of a zrc20 without zeta rate to a limited chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetWithdrawalLimits(ctx, types.WithdrawalLimits{
+			Window: 10,
+			ChainLimits: []types.ChainWithdrawalLimit{
+				{ChainId: chainID, Limit: sdkmath.NewUint(1000)},
+			},
+		})
+
+		require.False(t, k.RecordWithdrawal(ctx, zrc20, chainID, sdkmath.NewUint(1)))
+	})
+}
+
+func TestKeeper_QueuedWithdrawal(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	queuedWithdrawals := make([]types.QueuedWithdrawal, 3)
+	for i := range queuedWithdrawals {
+		queuedWithdrawals[i] = sample.QueuedWithdrawal(t, fmt.Sprintf("%d", i))
+		k.SetQueuedWithdrawal(ctx, queuedWithdrawals[i])
+	}
+
+	queuedWithdrawal, found := k.GetQueuedWithdrawal(ctx, queuedWithdrawals[0].CctxIndex)
+	require.True(t, found)
+	require.Equal(t, queuedWithdrawals[0], queuedWithdrawal)
+	require.ElementsMatch(t, queuedWithdrawals, k.GetAllQueuedWithdrawal(ctx))
+
+	k.RemoveQueuedWithdrawal(ctx, queuedWithdrawals[0].CctxIndex)
+	_, found = k.GetQueuedWithdrawal(ctx, queuedWithdrawals[0].CctxIndex)
+	require.False(t, found)
+	require.Len(t, k.GetAllQueuedWithdrawal(ctx), 2)
+}
+
+func TestKeeper_QueueWithdrawal(t *testing.T) {
+	t.Run("should find the withdrawal of a deposit and call by the inbound cctx and by the zevm tx hash", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		zevmTxHash := sample.Hash().Hex()
+		cctx := sample.CrossChainTx(t, "withdrawal")
+		cctx.InboundTxParams.InboundTxObservedHash = zevmTxHash
+		ctx = ctx.WithValue("inCctxIndex", "deposit")
+
+		err := k.QueueWithdrawal(ctx, *cctx, sample.EthAddress().Hex(), sample.EthAddress().Hex())
+		require.NoError(t, err)
+
+		cctxFound, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, "deposit", cctxFound.InboundTxParams.InboundTxObservedHash)
+		for _, hash := range []string{"deposit", zevmTxHash} {
+			in, found := k.GetInTxHashToCctx(ctx, hash)
+			require.True(t, found)
+			require.Equal(t, []string{cctx.Index}, in.CctxIndex)
+		}
+	})
+}
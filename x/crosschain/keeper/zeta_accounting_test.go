@@ -47,3 +47,32 @@ func TestKeeper_AddZetaAccounting(t *testing.T) {
 	})
 
 }
+
+func TestKeeper_RemoveZetaAbortedAmount(t *testing.T) {
+	t.Run("should remove aborted zeta amount", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetZetaAccounting(ctx, types.ZetaAccounting{
+			AbortedZetaAmount: sdkmath.NewUint(100),
+		})
+		err := k.RemoveZetaAbortedAmount(ctx, sdkmath.NewUint(40))
+		require.NoError(t, err)
+		val, found := k.GetZetaAccounting(ctx)
+		require.True(t, found)
+		require.Equal(t, sdkmath.NewUint(60), val.AbortedZetaAmount)
+	})
+
+	t.Run("should fail if the aborted amount is insufficient", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetZetaAccounting(ctx, types.ZetaAccounting{
+			AbortedZetaAmount: sdkmath.NewUint(100),
+		})
+		err := k.RemoveZetaAbortedAmount(ctx, sdkmath.NewUint(101))
+		require.ErrorIs(t, err, types.ErrInsufficientZetaAmount)
+	})
+
+	t.Run("should fail if zeta accounting is not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		err := k.RemoveZetaAbortedAmount(ctx, sdkmath.NewUint(1))
+		require.ErrorIs(t, err, types.ErrUnableToFindZetaAccounting)
+	})
+}
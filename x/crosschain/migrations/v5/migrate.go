@@ -0,0 +1,17 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CrosschainKeeper interface {
+	StartCctxIndexBackfill(ctx sdk.Context)
+}
+
+// MigrateStore migrates the x/crosschain module state from the consensus version 4 to 5
+// It schedules the backfill of the secondary indexes by sender, receiver, status, sender and receiver chain
+// with the existing cctxs, done by the begin blocker over the next blocks to keep the upgrade block short
+func MigrateStore(ctx sdk.Context, k CrosschainKeeper) error {
+	k.StartCctxIndexBackfill(ctx)
+	return nil
+}
@@ -0,0 +1,42 @@
+package v5_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	v5 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v5"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	sender := sample.EthAddress().Hex()
+
+	for i, index := range []string{"0", "1", "2"} {
+		cctx := sample.CrossChainTx(t, index)
+		cctx.InboundTxParams.Sender = sender
+		cctx.CctxStatus.LastUpdateTimestamp = int64(100 * (i + 1))
+		k.SetCrossChainTx(ctx, *cctx)
+	}
+
+	err := v5.MigrateStore(ctx, k)
+	require.NoError(t, err)
+	k.BackfillCctxIndexes(ctx, types.CctxIndexBackfillLimit)
+
+	for i, index := range []string{"0", "1", "2"} {
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		require.True(t, found)
+		require.EqualValues(t, 100*(i+1), cctx.CctxStatus.CreatedTimestamp)
+	}
+	res, err := k.CctxSearch(sdk.WrapSDKContext(ctx), &types.QueryCctxSearchRequest{
+		Sender:         sender,
+		StartTimestamp: 200,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.CrossChainTx, 2)
+	require.Equal(t, "1", res.CrossChainTx[0].Index)
+	require.Equal(t, "2", res.CrossChainTx[1].Index)
+}
@@ -157,6 +157,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the crosschain module's invariants.
@@ -184,7 +187,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the crosschain module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -192,6 +195,11 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	if err != nil {
 		ctx.Logger().Error("Error iterating and updating pending cctx gas price", "err", err.Error())
 	}
+	err = am.keeper.IterateAndExpireCctxOutbound(ctx)
+	if err != nil {
+		ctx.Logger().Error("Error iterating and expiring pending cctx outbound", "err", err.Error())
+	}
+	am.keeper.BackfillCctxIndexes(ctx, types.CctxIndexBackfillLimit)
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the crosschain module. It
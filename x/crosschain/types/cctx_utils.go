@@ -43,6 +43,8 @@ func GetAllAuthzZetaclientTxTypes() []string {
 		sdk.MsgTypeURL(&MsgAddToOutTxTracker{}),
 		sdk.MsgTypeURL(&observertypes.MsgAddBlameVote{}),
 		sdk.MsgTypeURL(&observertypes.MsgAddBlockHeader{}),
+		sdk.MsgTypeURL(&observertypes.MsgVoteInboundHalt{}),
+		sdk.MsgTypeURL(&observertypes.MsgVoteTssBalance{}),
 	}
 }
 
@@ -55,3 +57,16 @@ func (m OutboundTxParams) GetGasPrice() (uint64, error) {
 
 	return gasPrice, nil
 }
+
+// GetGasPriorityFee returns the priority fee of the outbound tx, zero if not set
+func (m OutboundTxParams) GetGasPriorityFee() (uint64, error) {
+	if m.OutboundTxGasPriorityFee == "" {
+		return 0, nil
+	}
+	priorityFee, err := strconv.ParseUint(m.OutboundTxGasPriorityFee, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse cctx gas priority fee %s: %s", m.OutboundTxGasPriorityFee, err.Error())
+	}
+
+	return priorityFee, nil
+}
@@ -65,3 +65,23 @@ func TestOutboundTxParams_GetGasPrice(t *testing.T) {
 	_, err = outTxParams.GetGasPrice()
 	require.Error(t, err)
 }
+
+func TestOutboundTxParams_GetGasPriorityFee(t *testing.T) {
+	// #nosec G404 - random seed is not used for security purposes
+	r := rand.New(rand.NewSource(42))
+	outTxParams := sample.OutboundTxParams(r)
+
+	outTxParams.OutboundTxGasPriorityFee = "42"
+	priorityFee, err := outTxParams.GetGasPriorityFee()
+	require.NoError(t, err)
+	require.EqualValues(t, uint64(42), priorityFee)
+
+	outTxParams.OutboundTxGasPriorityFee = ""
+	priorityFee, err = outTxParams.GetGasPriorityFee()
+	require.NoError(t, err)
+	require.EqualValues(t, uint64(0), priorityFee)
+
+	outTxParams.OutboundTxGasPriorityFee = "invalid"
+	_, err = outTxParams.GetGasPriorityFee()
+	require.Error(t, err)
+}
@@ -17,7 +17,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVoteOnObservedInboundTx{}, "crosschain/VoteOnObservedInboundTx", nil)
 	cdc.RegisterConcrete(&MsgWhitelistERC20{}, "crosschain/WhitelistERC20", nil)
 	cdc.RegisterConcrete(&MsgMigrateTssFunds{}, "crosschain/MigrateTssFunds", nil)
+	cdc.RegisterConcrete(&MsgMigrateAllTssFunds{}, "crosschain/MigrateAllTssFunds", nil)
 	cdc.RegisterConcrete(&MsgUpdateTssAddress{}, "crosschain/UpdateTssAddress", nil)
+	cdc.RegisterConcrete(&MsgUpdateWithdrawalLimits{}, "crosschain/UpdateWithdrawalLimits", nil)
+	cdc.RegisterConcrete(&MsgReleaseQueuedWithdrawal{}, "crosschain/ReleaseQueuedWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgCancelQueuedWithdrawal{}, "crosschain/CancelQueuedWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgAbortStuckCCTX{}, "crosschain/AbortStuckCCTX", nil)
+	cdc.RegisterConcrete(&MsgRefundAbortedCCTX{}, "crosschain/RefundAbortedCCTX", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -31,7 +37,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVoteOnObservedInboundTx{},
 		&MsgWhitelistERC20{},
 		&MsgMigrateTssFunds{},
+		&MsgMigrateAllTssFunds{},
 		&MsgUpdateTssAddress{},
+		&MsgUpdateWithdrawalLimits{},
+		&MsgReleaseQueuedWithdrawal{},
+		&MsgCancelQueuedWithdrawal{},
+		&MsgAbortStuckCCTX{},
+		&MsgRefundAbortedCCTX{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -29,12 +29,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CctxStatus int32
 
 const (
-	CctxStatus_PendingInbound  CctxStatus = 0
-	CctxStatus_PendingOutbound CctxStatus = 1
-	CctxStatus_OutboundMined   CctxStatus = 3
-	CctxStatus_PendingRevert   CctxStatus = 4
-	CctxStatus_Reverted        CctxStatus = 5
-	CctxStatus_Aborted         CctxStatus = 6
+	CctxStatus_PendingInbound         CctxStatus = 0
+	CctxStatus_PendingOutbound        CctxStatus = 1
+	CctxStatus_OutboundMined          CctxStatus = 3
+	CctxStatus_PendingRevert          CctxStatus = 4
+	CctxStatus_Reverted               CctxStatus = 5
+	CctxStatus_Aborted                CctxStatus = 6
+	CctxStatus_PendingWithdrawalLimit CctxStatus = 7
 )
 
 var CctxStatus_name = map[int32]string{
@@ -44,15 +45,17 @@ var CctxStatus_name = map[int32]string{
 	4: "PendingRevert",
 	5: "Reverted",
 	6: "Aborted",
+	7: "PendingWithdrawalLimit",
 }
 
 var CctxStatus_value = map[string]int32{
-	"PendingInbound":  0,
-	"PendingOutbound": 1,
-	"OutboundMined":   3,
-	"PendingRevert":   4,
-	"Reverted":        5,
-	"Aborted":         6,
+	"PendingInbound":         0,
+	"PendingOutbound":        1,
+	"OutboundMined":          3,
+	"PendingRevert":          4,
+	"Reverted":               5,
+	"Aborted":                6,
+	"PendingWithdrawalLimit": 7,
 }
 
 func (x CctxStatus) String() string {
@@ -218,6 +221,12 @@ type OutboundTxParams struct {
 	OutboundTxTssNonce uint64                                  `protobuf:"varint,5,opt,name=outbound_tx_tss_nonce,json=outboundTxTssNonce,proto3" json:"outbound_tx_tss_nonce,omitempty"`
 	OutboundTxGasLimit uint64                                  `protobuf:"varint,6,opt,name=outbound_tx_gas_limit,json=outboundTxGasLimit,proto3" json:"outbound_tx_gas_limit,omitempty"`
 	OutboundTxGasPrice string                                  `protobuf:"bytes,7,opt,name=outbound_tx_gas_price,json=outboundTxGasPrice,proto3" json:"outbound_tx_gas_price,omitempty"`
+	// the priority fee (EIP-1559 tip) of the outbound tx, the gas price is used as fee cap
+	OutboundTxGasPriorityFee string `protobuf:"bytes,23,opt,name=outbound_tx_gas_priority_fee,json=outboundTxGasPriorityFee,proto3" json:"outbound_tx_gas_priority_fee,omitempty"`
+	// the ZetaChain height at which the outbound tx was assigned its nonce, used for the outbound timeout
+	OutboundTxCreatedZetaHeight uint64 `protobuf:"varint,24,opt,name=outbound_tx_created_zeta_height,json=outboundTxCreatedZetaHeight,proto3" json:"outbound_tx_created_zeta_height,omitempty"`
+	// the outbound tx expired, its nonce is used by a cancel tx and the cctx is reverted once the cancel tx is observed
+	OutboundTxExpired bool `protobuf:"varint,25,opt,name=outbound_tx_expired,json=outboundTxExpired,proto3" json:"outbound_tx_expired,omitempty"`
 	// the above are commands for zetaclients
 	// the following fields are used when the outbound tx is mined
 	OutboundTxHash                   string                                 `protobuf:"bytes,8,opt,name=outbound_tx_hash,json=outboundTxHash,proto3" json:"outbound_tx_hash,omitempty"`
@@ -304,6 +313,27 @@ func (m *OutboundTxParams) GetOutboundTxGasPrice() string {
 	return ""
 }
 
+func (m *OutboundTxParams) GetOutboundTxGasPriorityFee() string {
+	if m != nil {
+		return m.OutboundTxGasPriorityFee
+	}
+	return ""
+}
+
+func (m *OutboundTxParams) GetOutboundTxCreatedZetaHeight() uint64 {
+	if m != nil {
+		return m.OutboundTxCreatedZetaHeight
+	}
+	return 0
+}
+
+func (m *OutboundTxParams) GetOutboundTxExpired() bool {
+	if m != nil {
+		return m.OutboundTxExpired
+	}
+	return false
+}
+
 func (m *OutboundTxParams) GetOutboundTxHash() string {
 	if m != nil {
 		return m.OutboundTxHash
@@ -350,6 +380,10 @@ type Status struct {
 	Status              CctxStatus `protobuf:"varint,1,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
 	StatusMessage       string     `protobuf:"bytes,2,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
 	LastUpdateTimestamp int64      `protobuf:"varint,3,opt,name=lastUpdate_timestamp,json=lastUpdateTimestamp,proto3" json:"lastUpdate_timestamp,omitempty"`
+	// true if the amount of the aborted cctx has been refunded on ZetaChain
+	IsAbortRefunded bool `protobuf:"varint,4,opt,name=is_abort_refunded,json=isAbortRefunded,proto3" json:"is_abort_refunded,omitempty"`
+	// the timestamp of the block in which the cctx was created
+	CreatedTimestamp int64 `protobuf:"varint,5,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
 }
 
 func (m *Status) Reset()         { *m = Status{} }
@@ -406,6 +440,20 @@ func (m *Status) GetLastUpdateTimestamp() int64 {
 	return 0
 }
 
+func (m *Status) GetIsAbortRefunded() bool {
+	if m != nil {
+		return m.IsAbortRefunded
+	}
+	return false
+}
+
+func (m *Status) GetCreatedTimestamp() int64 {
+	if m != nil {
+		return m.CreatedTimestamp
+	}
+	return 0
+}
+
 type CrossChainTx struct {
 	Creator          string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Index            string                                  `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -503,72 +551,80 @@ func init() {
 func init() { proto.RegisterFile("crosschain/cross_chain_tx.proto", fileDescriptor_af3a0ad055343c21) }
 
 var fileDescriptor_af3a0ad055343c21 = []byte{
-	// 1037 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6e, 0x1b, 0xb7,
-	0x13, 0xf6, 0xfe, 0x24, 0xcb, 0xd2, 0x28, 0x96, 0xd6, 0xb4, 0x9c, 0xdf, 0xc2, 0x69, 0x24, 0x41,
-	0x6d, 0x12, 0xa5, 0x80, 0x25, 0xd8, 0x45, 0x11, 0xa0, 0x37, 0xdb, 0x8d, 0x13, 0xa3, 0x49, 0x6c,
-	0x6c, 0xed, 0x8b, 0x81, 0x62, 0x4b, 0xed, 0x8e, 0x25, 0x22, 0xd2, 0x52, 0x5d, 0x52, 0x86, 0x1c,
-	0xf4, 0xd4, 0x27, 0xe8, 0x43, 0xb4, 0x40, 0xfb, 0x26, 0x39, 0xf4, 0x90, 0x63, 0xd1, 0x83, 0x51,
-	0xd8, 0x6f, 0xd0, 0x27, 0x28, 0x48, 0xee, 0x4a, 0x2b, 0xd5, 0x8e, 0xfb, 0xe7, 0xb4, 0xc3, 0x21,
-	0xbf, 0x8f, 0x33, 0xc3, 0x6f, 0xc8, 0x85, 0x9a, 0x1f, 0x71, 0x21, 0xfc, 0x1e, 0x65, 0x61, 0x5b,
-	0x9b, 0x9e, 0xb6, 0x3d, 0x39, 0x6e, 0x0d, 0x23, 0x2e, 0x39, 0xb9, 0xff, 0x06, 0x25, 0xd5, 0xbe,
-	0x96, 0xb6, 0x78, 0x84, 0xad, 0x29, 0x66, 0x7d, 0xd5, 0xe7, 0x83, 0x01, 0x0f, 0xdb, 0xe6, 0x63,
-	0x30, 0xeb, 0x95, 0x2e, 0xef, 0x72, 0x6d, 0xb6, 0x95, 0x65, 0xbc, 0x8d, 0xef, 0xb2, 0x50, 0xde,
-	0x0f, 0x3b, 0x7c, 0x14, 0x06, 0x47, 0xe3, 0x43, 0x1a, 0xd1, 0x81, 0x20, 0x77, 0x21, 0x27, 0x30,
-	0x0c, 0x30, 0x72, 0xac, 0xba, 0xd5, 0x2c, 0xb8, 0xf1, 0x88, 0x3c, 0x84, 0xb2, 0xb1, 0xe2, 0x70,
-	0x58, 0xe0, 0xfc, 0xaf, 0x6e, 0x35, 0x33, 0xee, 0xb2, 0x71, 0xef, 0x2a, 0xef, 0x7e, 0x40, 0xee,
-	0x41, 0x41, 0x8e, 0x3d, 0x1e, 0xb1, 0x2e, 0x0b, 0x9d, 0x8c, 0xa6, 0xc8, 0xcb, 0xf1, 0x81, 0x1e,
-	0x93, 0x0d, 0x28, 0xf8, 0x5c, 0xe5, 0x72, 0x3e, 0x44, 0x27, 0x5b, 0xb7, 0x9a, 0xa5, 0x2d, 0xbb,
-	0x15, 0x07, 0xba, 0xcb, 0x59, 0x78, 0x74, 0x3e, 0x44, 0x37, 0xef, 0xc7, 0x16, 0xa9, 0xc0, 0x22,
-	0x15, 0x02, 0xa5, 0xb3, 0xa8, 0x79, 0xcc, 0x80, 0x3c, 0x83, 0x1c, 0x1d, 0xf0, 0x51, 0x28, 0x9d,
-	0x9c, 0x72, 0xef, 0xb4, 0xdf, 0x5e, 0xd4, 0x16, 0x7e, 0xbb, 0xa8, 0x3d, 0xea, 0x32, 0xd9, 0x1b,
-	0x75, 0x14, 0x5f, 0xdb, 0xe7, 0x62, 0xc0, 0x45, 0xfc, 0xd9, 0x10, 0xc1, 0xeb, 0xb6, 0xda, 0x52,
-	0xb4, 0x8e, 0x59, 0x28, 0xdd, 0x18, 0x4e, 0x9e, 0x80, 0xc3, 0x4c, 0xf6, 0x9e, 0x0a, 0xb9, 0x23,
-	0x30, 0x3a, 0xc3, 0xc0, 0xeb, 0x51, 0xd1, 0x73, 0x96, 0xf4, 0x8e, 0x6b, 0x2c, 0xa9, 0xce, 0x41,
-	0x3c, 0xfb, 0x9c, 0x8a, 0x1e, 0x79, 0x01, 0x1f, 0x5e, 0x07, 0xc4, 0xb1, 0xc4, 0x28, 0xa4, 0x7d,
-	0xaf, 0x87, 0xac, 0xdb, 0x93, 0x4e, 0xbe, 0x6e, 0x35, 0xb3, 0x6e, 0xed, 0x2f, 0x1c, 0x4f, 0xe3,
-	0x75, 0xcf, 0xf5, 0x32, 0xf2, 0x29, 0xfc, 0x3f, 0xc5, 0xd6, 0xa1, 0xfd, 0x3e, 0x97, 0x1e, 0x0b,
-	0x03, 0x1c, 0x3b, 0x05, 0x1d, 0x45, 0x65, 0xc2, 0xb0, 0xa3, 0x27, 0xf7, 0xd5, 0x1c, 0xd9, 0x83,
-	0x7a, 0x0a, 0x76, 0xca, 0x42, 0xda, 0x67, 0x6f, 0x30, 0xf0, 0x94, 0x26, 0x92, 0x08, 0x40, 0x47,
-	0xf0, 0xc1, 0x04, 0xbf, 0x97, 0xac, 0x3a, 0x41, 0x49, 0xcd, 0xf6, 0x8d, 0x6f, 0xa0, 0xa4, 0x46,
-	0xdb, 0xbe, 0xaf, 0x8a, 0xc2, 0xc2, 0x2e, 0xf1, 0x60, 0x95, 0x76, 0x78, 0x24, 0x13, 0xb2, 0xb8,
-	0xda, 0xd6, 0xbf, 0xab, 0xf6, 0x4a, 0xcc, 0xa5, 0x37, 0xd1, 0x4c, 0x8d, 0x9f, 0x73, 0x60, 0x1f,
-	0x8c, 0xe4, 0xac, 0xf0, 0xd6, 0x21, 0x1f, 0xa1, 0x8f, 0xec, 0x6c, 0x22, 0xbd, 0xc9, 0x98, 0x3c,
-	0x06, 0x3b, 0xb1, 0x8d, 0xfc, 0xf6, 0x13, 0xf5, 0x95, 0x13, 0x7f, 0xa2, 0xbf, 0x19, 0x89, 0x65,
-	0x6e, 0x95, 0xd8, 0x54, 0x4c, 0xd9, 0xff, 0x26, 0xa6, 0x4d, 0x58, 0xe3, 0x71, 0x4a, 0xea, 0x3c,
-	0xa4, 0x10, 0x5e, 0xc8, 0x43, 0x1f, 0xb5, 0x76, 0xb3, 0x2e, 0xe1, 0x93, 0x7c, 0x8f, 0x84, 0x78,
-	0xa5, 0x66, 0xe6, 0x21, 0x5d, 0x2a, 0xbc, 0x3e, 0x1b, 0x30, 0xa3, 0xeb, 0x19, 0xc8, 0x33, 0x2a,
-	0x5e, 0xa8, 0x99, 0xeb, 0x20, 0xc3, 0x88, 0xf9, 0x18, 0xeb, 0x75, 0x16, 0x72, 0xa8, 0x66, 0x48,
-	0x13, 0xec, 0x34, 0x44, 0xab, 0x3b, 0xaf, 0x57, 0x97, 0xa6, 0xab, 0xb5, 0xac, 0x9f, 0x80, 0x93,
-	0x5e, 0x79, 0x8d, 0x12, 0xd7, 0xa6, 0x88, 0xb4, 0x14, 0x5f, 0xc1, 0x47, 0x69, 0xe0, 0x8d, 0x0d,
-	0x61, 0xe4, 0x58, 0x9f, 0x92, 0xdc, 0xd0, 0x11, 0x6d, 0xa8, 0xcc, 0x67, 0x39, 0x12, 0x18, 0x38,
-	0x15, 0x8d, 0x5f, 0x99, 0x49, 0xf2, 0x58, 0x60, 0x40, 0x24, 0xd4, 0xd2, 0x00, 0x3c, 0x3d, 0x45,
-	0x5f, 0xb2, 0x33, 0x4c, 0x15, 0x68, 0x4d, 0x1f, 0x6f, 0x2b, 0x3e, 0xde, 0x87, 0x7f, 0xe3, 0x78,
-	0xf7, 0x43, 0xe9, 0xde, 0x9b, 0xee, 0xf5, 0x34, 0x21, 0x9d, 0x54, 0xf6, 0xf3, 0xf7, 0xed, 0x6a,
-	0x4e, 0xf2, 0xae, 0x8e, 0xf8, 0x06, 0x16, 0x73, 0xa4, 0xf7, 0x01, 0x94, 0x58, 0x86, 0xa3, 0xce,
-	0x6b, 0x3c, 0x77, 0x8a, 0xba, 0xce, 0x05, 0x29, 0xc4, 0xa1, 0x76, 0x34, 0x7e, 0xb4, 0x20, 0xf7,
-	0xa5, 0xa4, 0x72, 0x24, 0xc8, 0x36, 0xe4, 0x84, 0xb6, 0x74, 0x7f, 0x94, 0xb6, 0x1e, 0xb7, 0xde,
-	0xfb, 0x12, 0xb4, 0x76, 0x7d, 0x39, 0x36, 0x50, 0x37, 0x06, 0x92, 0x07, 0x50, 0x32, 0x96, 0x37,
-	0x40, 0x21, 0x68, 0x17, 0x75, 0x1b, 0x15, 0xdc, 0x65, 0xe3, 0x7d, 0x69, 0x9c, 0x64, 0x13, 0x2a,
-	0x7d, 0x2a, 0xe4, 0xf1, 0x30, 0xa0, 0x12, 0x3d, 0xc9, 0x06, 0x28, 0x24, 0x1d, 0x0c, 0x75, 0x3f,
-	0x65, 0xdc, 0xd5, 0xe9, 0xdc, 0x51, 0x32, 0xd5, 0xf8, 0x25, 0x03, 0x77, 0x76, 0xd5, 0xde, 0xba,
-	0x11, 0x8f, 0xc6, 0xc4, 0x81, 0x25, 0x3f, 0x42, 0x2a, 0x79, 0xd2, 0xce, 0xc9, 0x50, 0x5d, 0xeb,
-	0x46, 0x54, 0x66, 0x6f, 0x33, 0x20, 0x5f, 0x43, 0x41, 0xdf, 0x36, 0xa7, 0x88, 0xc2, 0x5c, 0xf8,
-	0x3b, 0xbb, 0xff, 0xb0, 0x19, 0xff, 0xb8, 0xa8, 0xd9, 0xe7, 0x74, 0xd0, 0xff, 0xac, 0x31, 0x61,
-	0x6a, 0xb8, 0x79, 0x65, 0xef, 0x21, 0x0a, 0xf2, 0x08, 0xca, 0x11, 0xf6, 0xe9, 0x39, 0x06, 0x93,
-	0xec, 0x73, 0xa6, 0x11, 0x62, 0x77, 0x92, 0xfe, 0x1e, 0x14, 0x7d, 0x5f, 0x8e, 0xbd, 0xb8, 0xda,
-	0xaa, 0x5b, 0x8a, 0x5b, 0x0f, 0x6e, 0xa9, 0x76, 0x5c, 0x69, 0xf0, 0x27, 0x55, 0x27, 0x27, 0xb0,
-	0x92, 0xba, 0xa2, 0x87, 0xfa, 0x9e, 0xd3, 0x9d, 0x54, 0xdc, 0x6a, 0xdd, 0xc2, 0x36, 0xf7, 0x2c,
-	0xbb, 0x65, 0x36, 0xf7, 0x4e, 0x7f, 0x05, 0x24, 0x2d, 0xbe, 0x98, 0x1c, 0xea, 0x99, 0x66, 0x71,
-	0xab, 0x7d, 0x0b, 0xf9, 0xfc, 0xdd, 0xeb, 0xda, 0x7c, 0xce, 0xf3, 0xf1, 0xb7, 0x00, 0x53, 0xf9,
-	0x10, 0x02, 0xa5, 0x43, 0x0c, 0x03, 0x16, 0x76, 0xe3, 0xb8, 0xec, 0x05, 0xb2, 0x0a, 0xe5, 0xd8,
-	0x97, 0xd0, 0xd9, 0x16, 0x59, 0x81, 0xe5, 0x64, 0xf4, 0x92, 0x85, 0x18, 0xd8, 0x19, 0xe5, 0x8a,
-	0xd7, 0xb9, 0x78, 0x86, 0x91, 0xb4, 0xb3, 0xe4, 0x0e, 0xe4, 0x8d, 0x8d, 0x81, 0xbd, 0x48, 0x8a,
-	0xb0, 0xb4, 0x6d, 0x9e, 0x08, 0x3b, 0xb7, 0x9e, 0xfd, 0xe9, 0x87, 0xaa, 0xb5, 0xf3, 0xc5, 0xdb,
-	0xcb, 0xaa, 0xf5, 0xee, 0xb2, 0x6a, 0xfd, 0x7e, 0x59, 0xb5, 0xbe, 0xbf, 0xaa, 0x2e, 0xbc, 0xbb,
-	0xaa, 0x2e, 0xfc, 0x7a, 0x55, 0x5d, 0x38, 0xd9, 0x4c, 0x49, 0x41, 0xa5, 0xb6, 0x61, 0x7e, 0x94,
-	0x92, 0x2c, 0xdb, 0xe3, 0x76, 0xea, 0xf7, 0x49, 0x2b, 0xa3, 0x93, 0xd3, 0x3f, 0x3b, 0x9f, 0xfc,
-	0x19, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x5f, 0xb2, 0x04, 0x59, 0x09, 0x00, 0x00,
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0x1a, 0x47,
+	0x14, 0xf6, 0x16, 0x8c, 0xe1, 0x90, 0xc0, 0x32, 0xc6, 0xc9, 0xd6, 0x49, 0x00, 0xd1, 0x26, 0x21,
+	0xa9, 0x02, 0x8a, 0xab, 0x2a, 0x52, 0x2f, 0x2a, 0xd9, 0x24, 0x4e, 0xac, 0xe6, 0xc7, 0xda, 0xda,
+	0xaa, 0x64, 0xa9, 0xda, 0x0e, 0xbb, 0x07, 0x18, 0x05, 0x76, 0xe9, 0xce, 0xe0, 0x42, 0x2e, 0xfb,
+	0x04, 0xbd, 0xec, 0x03, 0xf4, 0xa2, 0x8f, 0x92, 0x8b, 0x5e, 0xe4, 0xb2, 0xea, 0x85, 0x5b, 0xd9,
+	0x6f, 0xd0, 0x07, 0xa8, 0xaa, 0x9d, 0x99, 0x85, 0x85, 0x3a, 0x71, 0x7f, 0xae, 0xf6, 0xcc, 0x39,
+	0xe7, 0xfb, 0x66, 0xe6, 0xcc, 0x77, 0x66, 0x16, 0xaa, 0x6e, 0x18, 0x70, 0xee, 0xf6, 0x29, 0xf3,
+	0x5b, 0xd2, 0x74, 0xa4, 0xed, 0x88, 0x49, 0x73, 0x14, 0x06, 0x22, 0x20, 0x37, 0x5e, 0xa1, 0xa0,
+	0xd2, 0xd7, 0x94, 0x56, 0x10, 0x62, 0x73, 0x8e, 0xd9, 0x5c, 0x77, 0x83, 0xe1, 0x30, 0xf0, 0x5b,
+	0xea, 0xa3, 0x30, 0x9b, 0xe5, 0x5e, 0xd0, 0x0b, 0xa4, 0xd9, 0x8a, 0x2c, 0xe5, 0xad, 0x7f, 0x97,
+	0x86, 0xe2, 0x9e, 0xdf, 0x09, 0xc6, 0xbe, 0x77, 0x30, 0xd9, 0xa7, 0x21, 0x1d, 0x72, 0x72, 0x05,
+	0x32, 0x1c, 0x7d, 0x0f, 0x43, 0xcb, 0xa8, 0x19, 0x8d, 0x9c, 0xad, 0x47, 0xe4, 0x16, 0x14, 0x95,
+	0xa5, 0x97, 0xc3, 0x3c, 0xeb, 0xbd, 0x9a, 0xd1, 0x48, 0xd9, 0x97, 0x95, 0xbb, 0x1d, 0x79, 0xf7,
+	0x3c, 0x72, 0x0d, 0x72, 0x62, 0xe2, 0x04, 0x21, 0xeb, 0x31, 0xdf, 0x4a, 0x49, 0x8a, 0xac, 0x98,
+	0xbc, 0x90, 0x63, 0x72, 0x0f, 0x72, 0x6e, 0x10, 0xed, 0x65, 0x3a, 0x42, 0x2b, 0x5d, 0x33, 0x1a,
+	0x85, 0x2d, 0xb3, 0xa9, 0x17, 0xda, 0x0e, 0x98, 0x7f, 0x30, 0x1d, 0xa1, 0x9d, 0x75, 0xb5, 0x45,
+	0xca, 0xb0, 0x4a, 0x39, 0x47, 0x61, 0xad, 0x4a, 0x1e, 0x35, 0x20, 0x8f, 0x21, 0x43, 0x87, 0xc1,
+	0xd8, 0x17, 0x56, 0x26, 0x72, 0xef, 0xb4, 0x5e, 0x9f, 0x54, 0x57, 0x7e, 0x3d, 0xa9, 0xde, 0xee,
+	0x31, 0xd1, 0x1f, 0x77, 0x22, 0xbe, 0x96, 0x1b, 0xf0, 0x61, 0xc0, 0xf5, 0xe7, 0x1e, 0xf7, 0x5e,
+	0xb6, 0xa2, 0x29, 0x79, 0xf3, 0x90, 0xf9, 0xc2, 0xd6, 0x70, 0xf2, 0x00, 0x2c, 0xa6, 0x76, 0xef,
+	0x44, 0x4b, 0xee, 0x70, 0x0c, 0x8f, 0xd1, 0x73, 0xfa, 0x94, 0xf7, 0xad, 0x35, 0x39, 0xe3, 0x06,
+	0x8b, 0xab, 0xf3, 0x42, 0x47, 0x9f, 0x50, 0xde, 0x27, 0x4f, 0xe1, 0x83, 0xf3, 0x80, 0x38, 0x11,
+	0x18, 0xfa, 0x74, 0xe0, 0xf4, 0x91, 0xf5, 0xfa, 0xc2, 0xca, 0xd6, 0x8c, 0x46, 0xda, 0xae, 0xfe,
+	0x8d, 0xe3, 0x91, 0xce, 0x7b, 0x22, 0xd3, 0xc8, 0x27, 0x70, 0x35, 0xc1, 0xd6, 0xa1, 0x83, 0x41,
+	0x20, 0x1c, 0xe6, 0x7b, 0x38, 0xb1, 0x72, 0x72, 0x15, 0xe5, 0x19, 0xc3, 0x8e, 0x0c, 0xee, 0x45,
+	0x31, 0xb2, 0x0b, 0xb5, 0x04, 0xac, 0xcb, 0x7c, 0x3a, 0x60, 0xaf, 0xd0, 0x73, 0x22, 0x4d, 0xc4,
+	0x2b, 0x00, 0xb9, 0x82, 0xeb, 0x33, 0xfc, 0x6e, 0x9c, 0x75, 0x84, 0x82, 0xaa, 0xe9, 0xeb, 0xdf,
+	0x40, 0x21, 0x1a, 0x6d, 0xbb, 0x6e, 0x54, 0x14, 0xe6, 0xf7, 0x88, 0x03, 0xeb, 0xb4, 0x13, 0x84,
+	0x22, 0x26, 0xd3, 0xd5, 0x36, 0xfe, 0x5b, 0xb5, 0x4b, 0x9a, 0x4b, 0x4e, 0x22, 0x99, 0xea, 0xbf,
+	0xad, 0x81, 0xf9, 0x62, 0x2c, 0x16, 0x85, 0xb7, 0x09, 0xd9, 0x10, 0x5d, 0x64, 0xc7, 0x33, 0xe9,
+	0xcd, 0xc6, 0xe4, 0x0e, 0x98, 0xb1, 0xad, 0xe4, 0xb7, 0x17, 0xab, 0xaf, 0x18, 0xfb, 0x63, 0xfd,
+	0x2d, 0x48, 0x2c, 0x75, 0xa1, 0xc4, 0xe6, 0x62, 0x4a, 0xff, 0x3f, 0x31, 0xdd, 0x87, 0x8d, 0x60,
+	0x2c, 0x66, 0xe7, 0x21, 0x38, 0x77, 0xfc, 0xc0, 0x77, 0x51, 0x6a, 0x37, 0x6d, 0x93, 0x60, 0xb6,
+	0xdf, 0x03, 0xce, 0x9f, 0x47, 0x91, 0x65, 0x48, 0x8f, 0x72, 0x67, 0xc0, 0x86, 0x4c, 0xe9, 0x7a,
+	0x01, 0xf2, 0x98, 0xf2, 0xa7, 0x51, 0xe4, 0x3c, 0xc8, 0x28, 0x64, 0x2e, 0x6a, 0xbd, 0x2e, 0x42,
+	0xf6, 0xa3, 0x08, 0xf9, 0x0c, 0xae, 0x9f, 0x03, 0x09, 0x42, 0x26, 0xa6, 0x4e, 0x17, 0xd1, 0xba,
+	0x2a, 0x91, 0xd6, 0x32, 0x52, 0x26, 0xec, 0x22, 0x92, 0x87, 0x50, 0x4d, 0xe2, 0xdd, 0x10, 0xa9,
+	0x58, 0x92, 0x99, 0x25, 0xd7, 0x7b, 0x6d, 0x4e, 0xd1, 0x56, 0x49, 0x73, 0x95, 0x91, 0x26, 0xac,
+	0x27, 0x59, 0x70, 0x32, 0x62, 0x21, 0x7a, 0xd6, 0xfb, 0x35, 0xa3, 0x91, 0xb5, 0x4b, 0x73, 0xe4,
+	0x23, 0x15, 0x20, 0x0d, 0x30, 0x93, 0xf9, 0xb2, 0x27, 0xb3, 0x72, 0xa5, 0x85, 0x79, 0xb2, 0x6c,
+	0xc6, 0x07, 0x60, 0x25, 0x33, 0xcf, 0xe9, 0x9f, 0x8d, 0x39, 0x22, 0xd9, 0x40, 0xcf, 0xe1, 0xc3,
+	0x24, 0xf0, 0xad, 0x6d, 0xac, 0x9a, 0xa8, 0x36, 0x27, 0x79, 0x4b, 0x1f, 0xb7, 0xa0, 0xbc, 0x5c,
+	0xe8, 0x31, 0x47, 0xcf, 0x2a, 0x4b, 0x7c, 0x69, 0xa1, 0xc0, 0x87, 0x1c, 0x3d, 0x22, 0x16, 0x2b,
+	0x8b, 0xdd, 0x2e, 0xba, 0x82, 0x1d, 0x63, 0xe2, 0x58, 0x37, 0xa4, 0x28, 0x9b, 0x5a, 0x94, 0xb7,
+	0xfe, 0x81, 0x28, 0xf7, 0x7c, 0x91, 0x3c, 0x89, 0x47, 0x31, 0xe9, 0x4c, 0x0f, 0x0f, 0xdf, 0x35,
+	0xab, 0xd2, 0xdf, 0x95, 0xe5, 0xf3, 0x4c, 0xb2, 0x28, 0x21, 0xde, 0x00, 0x88, 0x24, 0x3e, 0x1a,
+	0x77, 0x5e, 0xe2, 0xd4, 0xca, 0xcb, 0x3a, 0xe7, 0x04, 0xe7, 0xfb, 0xd2, 0x51, 0xff, 0xd3, 0x80,
+	0xcc, 0x17, 0x82, 0x8a, 0x31, 0x27, 0xdb, 0x90, 0xe1, 0xd2, 0x92, 0x5d, 0x5d, 0xd8, 0xba, 0xd3,
+	0x7c, 0xe7, 0xfb, 0xd5, 0x6c, 0xbb, 0x62, 0xa2, 0xa0, 0xb6, 0x06, 0x92, 0x9b, 0x50, 0x50, 0x96,
+	0x33, 0x44, 0xce, 0x69, 0x0f, 0x65, 0xf3, 0xe7, 0xec, 0xcb, 0xca, 0xfb, 0x4c, 0x39, 0xc9, 0x7d,
+	0x28, 0x0f, 0x28, 0x17, 0x87, 0x23, 0x8f, 0x0a, 0x74, 0x04, 0x1b, 0x22, 0x17, 0x74, 0x38, 0x92,
+	0xb7, 0x40, 0xca, 0x5e, 0x9f, 0xc7, 0x0e, 0xe2, 0x10, 0xb9, 0x0b, 0x25, 0xc6, 0x1d, 0x79, 0x43,
+	0x39, 0x21, 0x76, 0xc7, 0xbe, 0x87, 0x9e, 0xbc, 0x09, 0xb2, 0x76, 0x91, 0xf1, 0xed, 0xc8, 0x6f,
+	0x6b, 0x37, 0xf9, 0x08, 0x4a, 0xb1, 0xf8, 0xe7, 0xdc, 0xab, 0x92, 0xdb, 0xd4, 0x81, 0x19, 0x71,
+	0xfd, 0xe7, 0x14, 0x5c, 0x6a, 0x47, 0x9b, 0x92, 0xf7, 0xd2, 0xc1, 0x84, 0x58, 0xb0, 0x26, 0x93,
+	0x82, 0xf8, 0x76, 0x8b, 0x87, 0xd1, 0x2b, 0xa7, 0xd4, 0xaa, 0x36, 0xa5, 0x06, 0xe4, 0x6b, 0xc8,
+	0xc9, 0x16, 0xeb, 0x22, 0x72, 0xf5, 0xfe, 0xed, 0xb4, 0xff, 0xe5, 0xdd, 0xf4, 0xc7, 0x49, 0xd5,
+	0x9c, 0xd2, 0xe1, 0xe0, 0xd3, 0xfa, 0x8c, 0xa9, 0x6e, 0x67, 0x23, 0x7b, 0x17, 0x91, 0x93, 0xdb,
+	0x50, 0x0c, 0x71, 0x40, 0xa7, 0xe8, 0xcd, 0xca, 0x9a, 0x51, 0x1d, 0xa6, 0xdd, 0x71, 0x5d, 0x77,
+	0x21, 0xef, 0xba, 0x62, 0xe2, 0xe8, 0x63, 0x8c, 0xda, 0x30, 0xbf, 0x75, 0xf3, 0x82, 0x63, 0xd4,
+	0x47, 0x08, 0xee, 0xec, 0x38, 0xc9, 0x11, 0x94, 0x12, 0x2f, 0xd6, 0x48, 0x5e, 0xfb, 0xb2, 0x45,
+	0xf3, 0x5b, 0xcd, 0x0b, 0xd8, 0x96, 0xfe, 0x52, 0xec, 0x22, 0x5b, 0x74, 0x90, 0xaf, 0x80, 0x24,
+	0x55, 0xad, 0xc9, 0xa1, 0x96, 0x6a, 0xe4, 0xb7, 0x5a, 0x17, 0x90, 0x2f, 0x3f, 0x45, 0xb6, 0x19,
+	0x2c, 0x79, 0xee, 0xfe, 0x60, 0x00, 0xcc, 0x85, 0x49, 0x08, 0x14, 0xf6, 0xd1, 0xf7, 0x98, 0xdf,
+	0xd3, 0x0b, 0x33, 0x57, 0xc8, 0x3a, 0x14, 0xb5, 0x2f, 0xe6, 0x33, 0x0d, 0x52, 0x82, 0xcb, 0xf1,
+	0xe8, 0x19, 0xf3, 0xd1, 0x33, 0x53, 0x91, 0x4b, 0xe7, 0xd9, 0x78, 0x8c, 0xa1, 0x30, 0xd3, 0xe4,
+	0x12, 0x64, 0x95, 0x8d, 0x9e, 0xb9, 0x4a, 0xf2, 0xb0, 0xb6, 0xad, 0x9e, 0x4c, 0x33, 0x43, 0x36,
+	0xe1, 0x8a, 0xce, 0xfe, 0x92, 0x89, 0xbe, 0x17, 0xd2, 0x6f, 0xe9, 0x40, 0x76, 0xa0, 0xb9, 0xb6,
+	0x99, 0xfe, 0xe9, 0xc7, 0x8a, 0xb1, 0xf3, 0xf9, 0xeb, 0xd3, 0x8a, 0xf1, 0xe6, 0xb4, 0x62, 0xfc,
+	0x7e, 0x5a, 0x31, 0xbe, 0x3f, 0xab, 0xac, 0xbc, 0x39, 0xab, 0xac, 0xfc, 0x72, 0x56, 0x59, 0x39,
+	0xba, 0x9f, 0xd0, 0x49, 0xb4, 0xef, 0x7b, 0xea, 0xa7, 0x32, 0x2e, 0x41, 0x6b, 0xd2, 0x4a, 0xfc,
+	0x6a, 0x4a, 0xd9, 0x74, 0x32, 0xf2, 0xc7, 0xf0, 0xe3, 0xbf, 0x06, 0x00, 0x1c, 0x4a, 0xd7, 0xcb,
+	0x85, 0x0a, 0x00, 0x00,
 }
 
 func (m *InboundTxParams) Marshal() (dAtA []byte, err error) {
@@ -712,6 +768,34 @@ func (m *OutboundTxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OutboundTxExpired {
+		i--
+		if m.OutboundTxExpired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.OutboundTxCreatedZetaHeight != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.OutboundTxCreatedZetaHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.OutboundTxGasPriorityFee) > 0 {
+		i -= len(m.OutboundTxGasPriorityFee)
+		copy(dAtA[i:], m.OutboundTxGasPriorityFee)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.OutboundTxGasPriorityFee)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.OutboundTxEffectiveGasLimit))
 		i--
@@ -831,6 +915,21 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreatedTimestamp != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.CreatedTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsAbortRefunded {
+		i--
+		if m.IsAbortRefunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastUpdateTimestamp != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.LastUpdateTimestamp))
 		i--
@@ -1059,6 +1158,16 @@ func (m *OutboundTxParams) Size() (n int) {
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		n += 2 + sovCrossChainTx(uint64(m.OutboundTxEffectiveGasLimit))
 	}
+	l = len(m.OutboundTxGasPriorityFee)
+	if l > 0 {
+		n += 2 + l + sovCrossChainTx(uint64(l))
+	}
+	if m.OutboundTxCreatedZetaHeight != 0 {
+		n += 2 + sovCrossChainTx(uint64(m.OutboundTxCreatedZetaHeight))
+	}
+	if m.OutboundTxExpired {
+		n += 3
+	}
 	return n
 }
 
@@ -1078,6 +1187,12 @@ func (m *Status) Size() (n int) {
 	if m.LastUpdateTimestamp != 0 {
 		n += 1 + sovCrossChainTx(uint64(m.LastUpdateTimestamp))
 	}
+	if m.IsAbortRefunded {
+		n += 2
+	}
+	if m.CreatedTimestamp != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.CreatedTimestamp))
+	}
 	return n
 }
 
@@ -1918,6 +2033,77 @@ func (m *OutboundTxParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxGasPriorityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTxGasPriorityFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxCreatedZetaHeight", wireType)
+			}
+			m.OutboundTxCreatedZetaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundTxCreatedZetaHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxExpired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutboundTxExpired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -2038,6 +2224,45 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAbortRefunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAbortRefunded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTimestamp", wireType)
+			}
+			m.CreatedTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
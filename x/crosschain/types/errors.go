@@ -34,12 +34,22 @@ var (
 	ErrCannotFindCctx        = errorsmod.Register(ModuleName, 1134, "cannot find cctx")
 	ErrStatusNotPending      = errorsmod.Register(ModuleName, 1135, "Status not pending")
 
-	ErrCannotFindGasParams    = errorsmod.Register(ModuleName, 1136, "cannot find gas params")
-	ErrInvalidGasAmount       = errorsmod.Register(ModuleName, 1137, "invalid gas amount")
-	ErrNoLiquidityPool        = errorsmod.Register(ModuleName, 1138, "no liquidity pool")
-	ErrInvalidCoinType        = errorsmod.Register(ModuleName, 1139, "invalid coin type")
-	ErrCannotMigrateTssFunds  = errorsmod.Register(ModuleName, 1140, "cannot migrate TSS funds")
-	ErrTxBodyVerificationFail = errorsmod.Register(ModuleName, 1141, "transaction body verification fail")
-	ErrReceiverIsEmpty        = errorsmod.Register(ModuleName, 1142, "receiver is empty")
-	ErrUnsupportedStatus      = errorsmod.Register(ModuleName, 1143, "unsupported status")
+	ErrCannotFindGasParams        = errorsmod.Register(ModuleName, 1136, "cannot find gas params")
+	ErrInvalidGasAmount           = errorsmod.Register(ModuleName, 1137, "invalid gas amount")
+	ErrNoLiquidityPool            = errorsmod.Register(ModuleName, 1138, "no liquidity pool")
+	ErrInvalidCoinType            = errorsmod.Register(ModuleName, 1139, "invalid coin type")
+	ErrCannotMigrateTssFunds      = errorsmod.Register(ModuleName, 1140, "cannot migrate TSS funds")
+	ErrTxBodyVerificationFail     = errorsmod.Register(ModuleName, 1141, "transaction body verification fail")
+	ErrReceiverIsEmpty            = errorsmod.Register(ModuleName, 1142, "receiver is empty")
+	ErrUnsupportedStatus          = errorsmod.Register(ModuleName, 1143, "unsupported status")
+	ErrObservedTxAlreadyFinalized = errorsmod.Register(ModuleName, 1144, "observed tx already finalized")
+	ErrInvalidWithdrawalLimits    = errorsmod.Register(ModuleName, 1145, "invalid withdrawal limits")
+	ErrQueuedWithdrawalNotFound   = errorsmod.Register(ModuleName, 1146, "queued withdrawal not found")
+	ErrUnableToRefundWithdrawal   = errorsmod.Register(ModuleName, 1147, "unable to refund withdrawal")
+	ErrStatusNotAborted           = errorsmod.Register(ModuleName, 1148, "cctx status is not aborted")
+	ErrAbortedCctxRefunded        = errorsmod.Register(ModuleName, 1149, "aborted cctx already refunded")
+	ErrUnableToRefundCctx         = errorsmod.Register(ModuleName, 1150, "unable to refund cctx")
+	ErrUnableToFindZetaAccounting = errorsmod.Register(ModuleName, 1151, "unable to find zeta accounting")
+	ErrInsufficientZetaAmount     = errorsmod.Register(ModuleName, 1152, "insufficient zeta amount")
+	ErrNonceNotConsumed           = errorsmod.Register(ModuleName, 1153, "outbound nonce not consumed")
 )
@@ -509,55 +509,135 @@ func (m *EventOutboundSuccess) GetValueReceived() string {
 	return ""
 }
 
+type EventOutboundExpired struct {
+	MsgTypeUrl    string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	CctxIndex     string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	ChainId       int64  `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce         uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	StatusMessage string `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+}
+
+func (m *EventOutboundExpired) Reset()         { *m = EventOutboundExpired{} }
+func (m *EventOutboundExpired) String() string { return proto.CompactTextString(m) }
+func (*EventOutboundExpired) ProtoMessage()    {}
+func (*EventOutboundExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{5}
+}
+func (m *EventOutboundExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOutboundExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOutboundExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOutboundExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOutboundExpired.Merge(m, src)
+}
+func (m *EventOutboundExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOutboundExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOutboundExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOutboundExpired proto.InternalMessageInfo
+
+func (m *EventOutboundExpired) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventOutboundExpired) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *EventOutboundExpired) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventOutboundExpired) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventOutboundExpired) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
 	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
 	proto.RegisterType((*EventZetaWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZetaWithdrawCreated")
 	proto.RegisterType((*EventOutboundFailure)(nil), "zetachain.zetacore.crosschain.EventOutboundFailure")
 	proto.RegisterType((*EventOutboundSuccess)(nil), "zetachain.zetacore.crosschain.EventOutboundSuccess")
+	proto.RegisterType((*EventOutboundExpired)(nil), "zetachain.zetacore.crosschain.EventOutboundExpired")
 }
 
 func init() { proto.RegisterFile("crosschain/events.proto", fileDescriptor_7398db8b12b87b9e) }
 
 var fileDescriptor_7398db8b12b87b9e = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
-	0x14, 0x6e, 0xda, 0xdd, 0xed, 0xee, 0xf4, 0x0f, 0x62, 0xb5, 0x63, 0xb1, 0xa1, 0x2e, 0xf8, 0x73,
-	0xe3, 0x06, 0xf1, 0x0d, 0x5a, 0x94, 0x16, 0x91, 0x42, 0x5b, 0x11, 0x7a, 0x33, 0xcc, 0x26, 0x87,
-	0x64, 0x30, 0x99, 0x59, 0x66, 0x26, 0xbb, 0xd9, 0x3e, 0x85, 0x2f, 0x22, 0xf8, 0x00, 0x3e, 0x80,
-	0x97, 0xbd, 0xf0, 0xc2, 0x4b, 0xd9, 0x7d, 0x11, 0x99, 0x99, 0x44, 0xbb, 0xa9, 0xe8, 0x85, 0x28,
-	0x78, 0x95, 0x73, 0xbe, 0x73, 0x72, 0xf2, 0xcd, 0xf7, 0x4d, 0x0e, 0xda, 0x89, 0xa4, 0x50, 0x2a,
-	0x4a, 0x29, 0xe3, 0x21, 0x8c, 0x81, 0x6b, 0x35, 0x18, 0x49, 0xa1, 0x85, 0xbf, 0x77, 0x09, 0x9a,
-	0x5a, 0x7c, 0x60, 0x23, 0x21, 0x61, 0xf0, 0xa3, 0x77, 0xf7, 0x56, 0x24, 0xf2, 0x5c, 0xf0, 0xd0,
-	0x3d, 0xdc, 0x3b, 0xbb, 0xdb, 0x89, 0x48, 0x84, 0x0d, 0x43, 0x13, 0x39, 0xb4, 0xff, 0x79, 0x05,
-	0xdd, 0x7e, 0x6e, 0x46, 0x1f, 0xf3, 0xa1, 0x28, 0x78, 0xfc, 0x82, 0x71, 0x9a, 0xb1, 0x4b, 0x88,
-	0xfd, 0x7d, 0xb4, 0x9e, 0xab, 0x84, 0xe8, 0xe9, 0x08, 0x48, 0x21, 0x33, 0xec, 0xed, 0x7b, 0x8f,
-	0x7b, 0xa7, 0x28, 0x57, 0xc9, 0xf9, 0x74, 0x04, 0xaf, 0x65, 0xe6, 0xef, 0x21, 0x14, 0x45, 0xba,
-	0x24, 0x8c, 0xc7, 0x50, 0xe2, 0x65, 0x5b, 0xef, 0x19, 0xe4, 0xd8, 0x00, 0xfe, 0x1d, 0xd4, 0x51,
-	0xc0, 0x63, 0x90, 0x78, 0xc5, 0x96, 0xaa, 0xcc, 0xbf, 0x8b, 0xba, 0xba, 0x24, 0x42, 0x26, 0x8c,
-	0xe3, 0x96, 0xad, 0xac, 0xea, 0xf2, 0xc4, 0xa4, 0xfe, 0x36, 0x6a, 0x53, 0xa5, 0x40, 0xe3, 0xb6,
-	0xc5, 0x5d, 0xe2, 0xdf, 0x43, 0x88, 0x71, 0xa2, 0x4b, 0x92, 0x52, 0x95, 0xe2, 0x8e, 0x2d, 0x75,
-	0x19, 0x3f, 0x2f, 0x8f, 0xa8, 0x4a, 0xfd, 0x87, 0x68, 0x8b, 0x71, 0x32, 0xcc, 0x44, 0xf4, 0x96,
-	0xa4, 0xc0, 0x92, 0x54, 0xe3, 0x55, 0xdb, 0xb2, 0xc1, 0xf8, 0x81, 0x41, 0x8f, 0x2c, 0xe8, 0xef,
-	0xa2, 0xae, 0x84, 0x08, 0xd8, 0x18, 0x24, 0xee, 0xba, 0x19, 0x75, 0xee, 0x3f, 0x40, 0x9b, 0x75,
-	0x4c, 0xac, 0x84, 0xb8, 0xe7, 0x46, 0xd4, 0xe8, 0xa1, 0x01, 0xcd, 0x89, 0x68, 0x2e, 0x0a, 0xae,
-	0x31, 0x72, 0x27, 0x72, 0x99, 0xff, 0x08, 0x6d, 0x49, 0xc8, 0xe8, 0x14, 0x62, 0x92, 0x83, 0x52,
-	0x34, 0x01, 0xbc, 0x66, 0x1b, 0x36, 0x2b, 0xf8, 0x95, 0x43, 0x8d, 0x62, 0x1c, 0x26, 0x44, 0x69,
-	0xaa, 0x0b, 0x85, 0xd7, 0x9d, 0x62, 0x1c, 0x26, 0x67, 0x16, 0x30, 0x34, 0x5c, 0xe9, 0xfb, 0x98,
-	0x0d, 0x47, 0xc3, 0xa1, 0xf5, 0x94, 0xfb, 0x68, 0xdd, 0x49, 0x59, 0x71, 0xdd, 0xb4, 0x4d, 0x6b,
-	0x0e, 0xb3, 0x4c, 0xfb, 0xef, 0x97, 0xd1, 0x8e, 0xb5, 0xf5, 0x42, 0x46, 0x6f, 0x98, 0x4e, 0x63,
-	0x49, 0x27, 0x87, 0x12, 0xa8, 0xfe, 0x9b, 0xc6, 0x36, 0x79, 0xb5, 0x6e, 0xf0, 0x6a, 0x58, 0xd9,
-	0x6e, 0x58, 0x79, 0xdd, 0xa2, 0xce, 0x6f, 0x2d, 0x5a, 0xfd, 0xb5, 0x45, 0xdd, 0x05, 0x8b, 0x16,
-	0x95, 0xef, 0x35, 0x94, 0xef, 0x7f, 0xf0, 0x10, 0x76, 0x7a, 0x81, 0xa6, 0xff, 0x4c, 0xb0, 0x45,
-	0x35, 0x5a, 0x0d, 0x35, 0x16, 0x29, 0xb7, 0x9b, 0x94, 0x3f, 0x7a, 0x68, 0xdb, 0x52, 0x3e, 0x29,
-	0xb4, 0xfb, 0x75, 0x29, 0xcb, 0x0a, 0x09, 0x7f, 0x4e, 0x77, 0x0f, 0x21, 0x91, 0xc5, 0xf5, 0x87,
-	0x1d, 0xe5, 0x9e, 0xc8, 0xe2, 0xea, 0x96, 0x2e, 0xf2, 0x6a, 0xfd, 0xe4, 0x12, 0x8f, 0x69, 0x56,
-	0x00, 0xa9, 0x8c, 0x89, 0x2b, 0xea, 0x1b, 0x16, 0x3d, 0xad, 0xc0, 0x9b, 0xf4, 0xcf, 0x8a, 0x28,
-	0x02, 0xa5, 0xfe, 0x0f, 0xfa, 0x07, 0x2f, 0x3f, 0xcd, 0x02, 0xef, 0x6a, 0x16, 0x78, 0x5f, 0x67,
-	0x81, 0xf7, 0x6e, 0x1e, 0x2c, 0x5d, 0xcd, 0x83, 0xa5, 0x2f, 0xf3, 0x60, 0xe9, 0xe2, 0x69, 0xc2,
-	0x74, 0x5a, 0x0c, 0x07, 0x91, 0xc8, 0x43, 0xb3, 0x9c, 0x9f, 0xb8, 0xfd, 0x5d, 0xef, 0xe9, 0xb0,
-	0x0c, 0xaf, 0x6d, 0x75, 0x73, 0x4a, 0x35, 0xec, 0xd8, 0x5d, 0xfc, 0xec, 0x5b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xee, 0x0c, 0x96, 0x59, 0xf0, 0x05, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcb, 0x4e, 0xdc, 0x3c,
+	0x14, 0xc7, 0x09, 0xcc, 0xd5, 0xdc, 0xa4, 0x7c, 0x7c, 0xc5, 0xa0, 0x32, 0xa2, 0x48, 0xbd, 0x6c,
+	0x3a, 0x51, 0xd5, 0x37, 0x00, 0x51, 0x81, 0xaa, 0x0a, 0x69, 0xa0, 0xaa, 0xc4, 0xc6, 0xf2, 0x38,
+	0x47, 0x89, 0xd5, 0xc4, 0x1e, 0xd9, 0x0e, 0x04, 0x9e, 0xa2, 0x2f, 0x52, 0xb5, 0x0f, 0xd0, 0x07,
+	0xe8, 0x92, 0x45, 0x17, 0x5d, 0x56, 0xf0, 0x22, 0x95, 0xed, 0xa4, 0x65, 0x02, 0x6a, 0x17, 0xbd,
+	0x48, 0x5d, 0x8d, 0xcf, 0xff, 0x78, 0xce, 0xfc, 0xfc, 0x3f, 0x1e, 0x1f, 0xb4, 0xca, 0x94, 0xd4,
+	0x9a, 0xa5, 0x94, 0x8b, 0x08, 0x4e, 0x40, 0x18, 0x3d, 0x9c, 0x28, 0x69, 0x64, 0xb8, 0x71, 0x0e,
+	0x86, 0x3a, 0x7d, 0xe8, 0x56, 0x52, 0xc1, 0xf0, 0xfb, 0xde, 0xf5, 0xff, 0x98, 0xcc, 0x73, 0x29,
+	0x22, 0xff, 0xe1, 0xbf, 0xb3, 0xbe, 0x92, 0xc8, 0x44, 0xba, 0x65, 0x64, 0x57, 0x5e, 0xdd, 0xfa,
+	0x34, 0x87, 0xfe, 0xdf, 0xb5, 0xa5, 0xf7, 0xc5, 0x58, 0x16, 0x22, 0x7e, 0xc6, 0x05, 0xcd, 0xf8,
+	0x39, 0xc4, 0xe1, 0x26, 0x5a, 0xc8, 0x75, 0x42, 0xcc, 0xd9, 0x04, 0x48, 0xa1, 0x32, 0x1c, 0x6c,
+	0x06, 0x8f, 0xfa, 0x23, 0x94, 0xeb, 0xe4, 0xe8, 0x6c, 0x02, 0x2f, 0x55, 0x16, 0x6e, 0x20, 0xc4,
+	0x98, 0x29, 0x09, 0x17, 0x31, 0x94, 0x78, 0xd6, 0xe5, 0xfb, 0x56, 0xd9, 0xb7, 0x42, 0x78, 0x07,
+	0x75, 0x34, 0x88, 0x18, 0x14, 0x9e, 0x73, 0xa9, 0x2a, 0x0a, 0xd7, 0x50, 0xcf, 0x94, 0x44, 0xaa,
+	0x84, 0x0b, 0xdc, 0x72, 0x99, 0xae, 0x29, 0x0f, 0x6c, 0x18, 0xae, 0xa0, 0x36, 0xd5, 0x1a, 0x0c,
+	0x6e, 0x3b, 0xdd, 0x07, 0xe1, 0x5d, 0x84, 0xb8, 0x20, 0xa6, 0x24, 0x29, 0xd5, 0x29, 0xee, 0xb8,
+	0x54, 0x8f, 0x8b, 0xa3, 0x72, 0x8f, 0xea, 0x34, 0x7c, 0x80, 0x96, 0xb9, 0x20, 0xe3, 0x4c, 0xb2,
+	0xd7, 0x24, 0x05, 0x9e, 0xa4, 0x06, 0x77, 0xdd, 0x96, 0x45, 0x2e, 0xb6, 0xad, 0xba, 0xe7, 0xc4,
+	0x70, 0x1d, 0xf5, 0x14, 0x30, 0xe0, 0x27, 0xa0, 0x70, 0xcf, 0xd7, 0xa8, 0xe3, 0xf0, 0x3e, 0x5a,
+	0xaa, 0xd7, 0xc4, 0x59, 0x88, 0xfb, 0xbe, 0x44, 0xad, 0xee, 0x58, 0xd1, 0x9e, 0x88, 0xe6, 0xb2,
+	0x10, 0x06, 0x23, 0x7f, 0x22, 0x1f, 0x85, 0x0f, 0xd1, 0xb2, 0x82, 0x8c, 0x9e, 0x41, 0x4c, 0x72,
+	0xd0, 0x9a, 0x26, 0x80, 0xe7, 0xdd, 0x86, 0xa5, 0x4a, 0x7e, 0xe1, 0x55, 0xeb, 0x98, 0x80, 0x53,
+	0xa2, 0x0d, 0x35, 0x85, 0xc6, 0x0b, 0xde, 0x31, 0x01, 0xa7, 0x87, 0x4e, 0xb0, 0x18, 0x3e, 0xf5,
+	0xad, 0xcc, 0xa2, 0xc7, 0xf0, 0x6a, 0x5d, 0xe5, 0x1e, 0x5a, 0xf0, 0x56, 0x56, 0xac, 0x4b, 0x6e,
+	0xd3, 0xbc, 0xd7, 0x1c, 0xe9, 0xd6, 0xdb, 0x59, 0xb4, 0xea, 0xda, 0x7a, 0xac, 0xd8, 0x2b, 0x6e,
+	0xd2, 0x58, 0xd1, 0xd3, 0x1d, 0x05, 0xd4, 0xfc, 0xc9, 0xc6, 0x36, 0xb9, 0x5a, 0x37, 0xb8, 0x1a,
+	0xad, 0x6c, 0x37, 0x5a, 0x79, 0xbd, 0x45, 0x9d, 0x9f, 0xb6, 0xa8, 0xfb, 0xe3, 0x16, 0xf5, 0xa6,
+	0x5a, 0x34, 0xed, 0x7c, 0xbf, 0xe1, 0xfc, 0xd6, 0xfb, 0x00, 0x61, 0xef, 0x17, 0x18, 0xfa, 0xd7,
+	0x0c, 0x9b, 0x76, 0xa3, 0xd5, 0x70, 0x63, 0x1a, 0xb9, 0xdd, 0x44, 0xfe, 0x10, 0xa0, 0x15, 0x87,
+	0x7c, 0x50, 0x18, 0xff, 0xd7, 0xa5, 0x3c, 0x2b, 0x14, 0xfc, 0x3a, 0xee, 0x06, 0x42, 0x32, 0x8b,
+	0xeb, 0x1f, 0xf6, 0xc8, 0x7d, 0x99, 0xc5, 0xd5, 0x2d, 0x9d, 0xe6, 0x6a, 0xdd, 0x72, 0x89, 0x4f,
+	0x68, 0x56, 0x00, 0xa9, 0x1a, 0x13, 0x57, 0xe8, 0x8b, 0x4e, 0x1d, 0x55, 0xe2, 0x4d, 0xfc, 0xc3,
+	0x82, 0x31, 0xd0, 0xfa, 0x1f, 0xc1, 0x7f, 0xd7, 0xc4, 0xdf, 0x2d, 0x27, 0x5c, 0xfd, 0x8e, 0xcb,
+	0xb2, 0x86, 0x7a, 0xee, 0x7e, 0x13, 0x1e, 0x3b, 0xf8, 0xb9, 0x51, 0xd7, 0xc5, 0xfb, 0xb1, 0x7d,
+	0x1e, 0x85, 0x14, 0x0c, 0x1c, 0x75, 0x6b, 0xe4, 0x83, 0x5b, 0x5e, 0x8d, 0xf6, 0x2d, 0xaf, 0xc6,
+	0xf6, 0xf3, 0x8f, 0x97, 0x83, 0xe0, 0xe2, 0x72, 0x10, 0x7c, 0xb9, 0x1c, 0x04, 0x6f, 0xae, 0x06,
+	0x33, 0x17, 0x57, 0x83, 0x99, 0xcf, 0x57, 0x83, 0x99, 0xe3, 0x27, 0x09, 0x37, 0x69, 0x31, 0x1e,
+	0x32, 0x99, 0x47, 0x76, 0x9c, 0x3c, 0xf6, 0x13, 0xa7, 0x9e, 0x2c, 0x51, 0x19, 0x5d, 0x9b, 0x43,
+	0xf6, 0x60, 0x7a, 0xdc, 0x71, 0xd3, 0xe3, 0xe9, 0xd7, 0x01, 0x00, 0xd8, 0x73, 0x6d, 0x9d, 0xa2,
+	0x06, 0x00, 0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -941,6 +1021,60 @@ func (m *EventOutboundSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOutboundExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutboundExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutboundExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StatusMessage) > 0 {
+		i -= len(m.StatusMessage)
+		copy(dAtA[i:], m.StatusMessage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StatusMessage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1149,6 +1283,33 @@ func (m *EventOutboundSuccess) Size() (n int) {
 	return n
 }
 
+func (m *EventOutboundExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	l = len(m.StatusMessage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2621,6 +2782,190 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOutboundExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutboundExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutboundExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -57,6 +57,8 @@ type ZetaObserverKeeper interface {
 	GetAllNodeAccount(ctx sdk.Context) (nodeAccounts []observertypes.NodeAccount)
 	SetNodeAccount(ctx sdk.Context, nodeAccount observertypes.NodeAccount)
 	IsInboundEnabled(ctx sdk.Context) (found bool)
+	IsInboundEnabledForChain(ctx sdk.Context, chainID int64) bool
+	IsOutboundEnabledForChain(ctx sdk.Context, chainID int64) bool
 	GetCrosschainFlags(ctx sdk.Context) (val observertypes.CrosschainFlags, found bool)
 	GetKeygen(ctx sdk.Context) (val observertypes.Keygen, found bool)
 	SetKeygen(ctx sdk.Context, keygen observertypes.Keygen)
@@ -68,6 +70,7 @@ type ZetaObserverKeeper interface {
 	FindBallot(ctx sdk.Context, index string, chain *common.Chain, observationType observertypes.ObservationType) (ballot observertypes.Ballot, isNew bool, err error)
 	AddBallotToList(ctx sdk.Context, ballot observertypes.Ballot)
 	GetBlockHeader(ctx sdk.Context, hash []byte) (val common.BlockHeader, found bool)
+	GetProvableBlockHeader(ctx sdk.Context, hash []byte) (common.BlockHeader, error)
 	CheckIfTssPubkeyHasBeenGenerated(ctx sdk.Context, tssPubkey string) (observertypes.TSS, bool)
 	GetPreviousTSS(ctx sdk.Context) (val observertypes.TSS, found bool)
 	GetAllTSS(ctx sdk.Context) (list []observertypes.TSS)
@@ -79,6 +82,7 @@ type ZetaObserverKeeper interface {
 	SetFundMigrator(ctx sdk.Context, fm observertypes.TssFundMigratorInfo)
 	GetFundMigrator(ctx sdk.Context, chainID int64) (val observertypes.TssFundMigratorInfo, found bool)
 	GetAllTssFundMigrators(ctx sdk.Context) (fms []observertypes.TssFundMigratorInfo)
+	GetTssBalance(ctx sdk.Context, chainID int64) (val observertypes.TssBalance, found bool)
 	RemoveAllExistingMigrators(ctx sdk.Context)
 	SetChainNonces(ctx sdk.Context, chainNonces observertypes.ChainNonces)
 	GetChainNonces(ctx sdk.Context, index string) (val observertypes.ChainNonces, found bool)
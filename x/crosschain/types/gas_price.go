@@ -0,0 +1,101 @@
+package types
+
+import (
+	"sort"
+)
+
+// Votes returns the gas price votes with their freshness at the given ZetaChain time
+// A vote is stale if it was cast more than staleSeconds ago, or if it's more than staleBlocks behind the most recent block
+// voted by a vote fresh in time. Fresh votes whose price deviates from their median by more than maxDeviationPercent
+// are outliers. A zero value disables the corresponding check.
+func (m GasPrice) Votes(now int64, staleBlocks uint64, staleSeconds uint64, maxDeviationPercent uint64) []GasPriceVote {
+	votes := make([]GasPriceVote, len(m.Signers))
+	var latestBlock uint64
+	for i, signer := range m.Signers {
+		votes[i] = GasPriceVote{
+			Signer:      signer,
+			BlockNum:    valueAt(m.BlockNums, i),
+			Price:       valueAt(m.Prices, i),
+			PriorityFee: valueAt(m.PriorityFees, i),
+			Timestamp:   valueAt(m.Timestamps, i),
+		}
+		if staleSeconds > 0 && now-votes[i].Timestamp > int64(staleSeconds) {
+			votes[i].Freshness = GasPriceVoteFreshness_StaleTime
+			continue
+		}
+		if votes[i].BlockNum > latestBlock {
+			latestBlock = votes[i].BlockNum
+		}
+	}
+
+	var prices []uint64
+	for i := range votes {
+		if votes[i].Freshness != GasPriceVoteFreshness_Fresh {
+			continue
+		}
+		if staleBlocks > 0 && votes[i].BlockNum+staleBlocks < latestBlock {
+			votes[i].Freshness = GasPriceVoteFreshness_StaleBlocks
+			continue
+		}
+		prices = append(prices, votes[i].Price)
+	}
+
+	if maxDeviationPercent > 0 && len(prices) > 0 {
+		median := prices[medianOfArray(prices)]
+		maxDeviation := median * maxDeviationPercent / 100
+		for i := range votes {
+			if votes[i].Freshness != GasPriceVoteFreshness_Fresh {
+				continue
+			}
+			if votes[i].Price > median+maxDeviation || votes[i].Price+maxDeviation < median {
+				votes[i].Freshness = GasPriceVoteFreshness_Outlier
+			}
+		}
+	}
+	return votes
+}
+
+// MedianIndexes returns the index of the median price and of the median priority fee among the fresh votes
+// it returns false if no vote is fresh
+func MedianIndexes(votes []GasPriceVote) (priceIndex uint64, priorityFeeIndex uint64, found bool) {
+	var indexes []int
+	var prices, priorityFees []uint64
+	for i, vote := range votes {
+		if vote.Freshness == GasPriceVoteFreshness_Fresh {
+			indexes = append(indexes, i)
+			prices = append(prices, vote.Price)
+			priorityFees = append(priorityFees, vote.PriorityFee)
+		}
+	}
+	if len(indexes) == 0 {
+		return 0, 0, false
+	}
+	// #nosec G701 always positive
+	return uint64(indexes[medianOfArray(prices)]), uint64(indexes[medianOfArray(priorityFees)]), true
+}
+
+type indexValue struct {
+	Index int
+	Value uint64
+}
+
+// medianOfArray returns the index of the median of the values
+func medianOfArray(values []uint64) int {
+	array := make([]indexValue, len(values))
+	for i, v := range values {
+		array[i] = indexValue{Index: i, Value: v}
+	}
+	sort.SliceStable(array, func(i, j int) bool {
+		return array[i].Value < array[j].Value
+	})
+	l := len(array)
+	return array[l/2].Index
+}
+
+// valueAt returns the value at the index, or the zero value if the slice is too short
+func valueAt[T any](values []T, i int) (val T) {
+	if i < len(values) {
+		return values[i]
+	}
+	return val
+}
@@ -23,6 +23,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type GasPriceVoteFreshness int32
+
+const (
+	GasPriceVoteFreshness_Fresh       GasPriceVoteFreshness = 0
+	GasPriceVoteFreshness_StaleBlocks GasPriceVoteFreshness = 1
+	GasPriceVoteFreshness_StaleTime   GasPriceVoteFreshness = 2
+	GasPriceVoteFreshness_Outlier     GasPriceVoteFreshness = 3
+)
+
+var GasPriceVoteFreshness_name = map[int32]string{
+	0: "Fresh",
+	1: "StaleBlocks",
+	2: "StaleTime",
+	3: "Outlier",
+}
+
+var GasPriceVoteFreshness_value = map[string]int32{
+	"Fresh":       0,
+	"StaleBlocks": 1,
+	"StaleTime":   2,
+	"Outlier":     3,
+}
+
+func (x GasPriceVoteFreshness) String() string {
+	return proto.EnumName(GasPriceVoteFreshness_name, int32(x))
+}
+
+func (GasPriceVoteFreshness) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a9c78c67aa323583, []int{0}
+}
+
 type GasPrice struct {
 	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Index       string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -31,6 +62,11 @@ type GasPrice struct {
 	BlockNums   []uint64 `protobuf:"varint,5,rep,packed,name=block_nums,json=blockNums,proto3" json:"block_nums,omitempty"`
 	Prices      []uint64 `protobuf:"varint,6,rep,packed,name=prices,proto3" json:"prices,omitempty"`
 	MedianIndex uint64   `protobuf:"varint,7,opt,name=median_index,json=medianIndex,proto3" json:"median_index,omitempty"`
+	// priority fees (EIP-1559 tips) voted along with prices, empty for non EIP-1559 chains
+	PriorityFees []uint64 `protobuf:"varint,8,rep,packed,name=priority_fees,json=priorityFees,proto3" json:"priority_fees,omitempty"`
+	// ZetaChain block time (unix seconds) of each vote, 0 for votes cast before it was recorded
+	Timestamps             []int64 `protobuf:"varint,9,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	MedianPriorityFeeIndex uint64  `protobuf:"varint,10,opt,name=median_priority_fee_index,json=medianPriorityFeeIndex,proto3" json:"median_priority_fee_index,omitempty"`
 }
 
 func (m *GasPrice) Reset()         { *m = GasPrice{} }
@@ -115,32 +151,151 @@ func (m *GasPrice) GetMedianIndex() uint64 {
 	return 0
 }
 
+func (m *GasPrice) GetPriorityFees() []uint64 {
+	if m != nil {
+		return m.PriorityFees
+	}
+	return nil
+}
+
+func (m *GasPrice) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *GasPrice) GetMedianPriorityFeeIndex() uint64 {
+	if m != nil {
+		return m.MedianPriorityFeeIndex
+	}
+	return 0
+}
+
+type GasPriceVote struct {
+	Signer      string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	BlockNum    uint64                `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Price       uint64                `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	PriorityFee uint64                `protobuf:"varint,4,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
+	Timestamp   int64                 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Freshness   GasPriceVoteFreshness `protobuf:"varint,6,opt,name=freshness,proto3,enum=zetachain.zetacore.crosschain.GasPriceVoteFreshness" json:"freshness,omitempty"`
+}
+
+func (m *GasPriceVote) Reset()         { *m = GasPriceVote{} }
+func (m *GasPriceVote) String() string { return proto.CompactTextString(m) }
+func (*GasPriceVote) ProtoMessage()    {}
+func (*GasPriceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c78c67aa323583, []int{1}
+}
+func (m *GasPriceVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceVote.Merge(m, src)
+}
+func (m *GasPriceVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceVote proto.InternalMessageInfo
+
+func (m *GasPriceVote) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *GasPriceVote) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *GasPriceVote) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *GasPriceVote) GetPriorityFee() uint64 {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return 0
+}
+
+func (m *GasPriceVote) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GasPriceVote) GetFreshness() GasPriceVoteFreshness {
+	if m != nil {
+		return m.Freshness
+	}
+	return GasPriceVoteFreshness_Fresh
+}
+
 func init() {
+	proto.RegisterEnum("zetachain.zetacore.crosschain.GasPriceVoteFreshness", GasPriceVoteFreshness_name, GasPriceVoteFreshness_value)
 	proto.RegisterType((*GasPrice)(nil), "zetachain.zetacore.crosschain.GasPrice")
+	proto.RegisterType((*GasPriceVote)(nil), "zetachain.zetacore.crosschain.GasPriceVote")
 }
 
 func init() { proto.RegisterFile("crosschain/gas_price.proto", fileDescriptor_a9c78c67aa323583) }
 
 var fileDescriptor_a9c78c67aa323583 = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x41, 0x4a, 0xc4, 0x30,
-	0x14, 0x86, 0x27, 0xb6, 0xd3, 0xce, 0x44, 0x57, 0x41, 0x24, 0x0a, 0x13, 0xaa, 0xab, 0x6e, 0x6c,
-	0x11, 0x6f, 0xe0, 0x46, 0x06, 0x41, 0xa4, 0x4b, 0x37, 0x25, 0x4d, 0x43, 0x27, 0x68, 0x9b, 0x92,
-	0xd7, 0xc2, 0xe8, 0x29, 0x3c, 0x96, 0xee, 0x66, 0xe9, 0x52, 0xda, 0x8b, 0x48, 0xd2, 0x19, 0x74,
-	0x97, 0xef, 0x7f, 0x79, 0x3f, 0x8f, 0x0f, 0x5f, 0x08, 0xa3, 0x01, 0xc4, 0x86, 0xab, 0x26, 0xad,
-	0x38, 0xe4, 0xad, 0x51, 0x42, 0x26, 0xad, 0xd1, 0x9d, 0x26, 0xab, 0x77, 0xd9, 0x71, 0x37, 0x4a,
-	0xdc, 0x4b, 0x1b, 0x99, 0xfc, 0x7d, 0xbf, 0xfa, 0x42, 0x78, 0x71, 0xcf, 0xe1, 0xc9, 0x6e, 0x10,
-	0x8a, 0x43, 0x61, 0x24, 0xef, 0xb4, 0xa1, 0x28, 0x42, 0xf1, 0x32, 0x3b, 0x20, 0x39, 0xc5, 0x73,
-	0xd5, 0x94, 0x72, 0x4b, 0x8f, 0x5c, 0x3e, 0x01, 0x39, 0xc7, 0x0b, 0xd7, 0x92, 0xab, 0x92, 0x7a,
-	0x11, 0x8a, 0xbd, 0x2c, 0x74, 0xbc, 0x2e, 0x6d, 0x15, 0xa8, 0xaa, 0x91, 0x06, 0xa8, 0x1f, 0x79,
-	0xb6, 0x6a, 0x8f, 0x64, 0x85, 0x71, 0xf1, 0xaa, 0xc5, 0x4b, 0xde, 0xf4, 0x35, 0xd0, 0x79, 0xe4,
-	0xc5, 0x7e, 0xb6, 0x74, 0xc9, 0x63, 0x5f, 0x03, 0x39, 0xc3, 0x81, 0x3b, 0x1f, 0x68, 0xe0, 0x46,
-	0x7b, 0x22, 0x97, 0xf8, 0xa4, 0x96, 0xa5, 0xe2, 0x4d, 0x3e, 0x1d, 0x12, 0x46, 0x28, 0xf6, 0xb3,
-	0xe3, 0x29, 0x5b, 0xdb, 0xe8, 0xee, 0xe1, 0x73, 0x60, 0x68, 0x37, 0x30, 0xf4, 0x33, 0x30, 0xf4,
-	0x31, 0xb2, 0xd9, 0x6e, 0x64, 0xb3, 0xef, 0x91, 0xcd, 0x9e, 0x6f, 0x2a, 0xd5, 0x6d, 0xfa, 0x22,
-	0x11, 0xba, 0x4e, 0xad, 0x85, 0xeb, 0xc9, 0xd5, 0x41, 0x48, 0xba, 0x4d, 0xff, 0x19, 0xec, 0xde,
-	0x5a, 0x09, 0x45, 0xe0, 0xf4, 0xdd, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x63, 0xdc, 0xbf, 0x3d,
-	0x5c, 0x01, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0xcc, 0xc6, 0xce, 0x87, 0x5f, 0x52, 0x88, 0x56, 0x50, 0x6d, 0x81, 0x5a, 0xa6, 0x5c, 0x2c,
+	0x24, 0x1c, 0xf1, 0x71, 0xe1, 0xda, 0x43, 0x51, 0x85, 0x44, 0x2b, 0x83, 0x38, 0x70, 0x89, 0x1c,
+	0xe7, 0x35, 0x59, 0x11, 0x7f, 0x68, 0x77, 0x23, 0xb5, 0xfc, 0x0a, 0x7e, 0x16, 0xdc, 0x7a, 0xe4,
+	0x88, 0x92, 0x3b, 0xbf, 0x01, 0xed, 0xb3, 0xdd, 0xf8, 0x80, 0xb8, 0x79, 0x66, 0x77, 0xe7, 0xcd,
+	0x8c, 0x77, 0xe1, 0x51, 0xaa, 0x0a, 0xad, 0xd3, 0x55, 0x22, 0xf3, 0xe9, 0x32, 0xd1, 0xb3, 0x52,
+	0xc9, 0x14, 0xa3, 0x52, 0x15, 0xa6, 0xe0, 0xc7, 0xdf, 0xd0, 0x24, 0xb4, 0x14, 0xd1, 0x57, 0xa1,
+	0x30, 0xda, 0x6f, 0x3f, 0xf9, 0xd9, 0x85, 0xe1, 0xbb, 0x44, 0x5f, 0xda, 0x13, 0x5c, 0xc0, 0x20,
+	0x55, 0x98, 0x98, 0x42, 0x09, 0x16, 0xb0, 0xd0, 0x8b, 0x1b, 0xc8, 0x1f, 0x40, 0x4f, 0xe6, 0x0b,
+	0xbc, 0x16, 0x5d, 0xe2, 0x2b, 0xc0, 0x8f, 0x60, 0x48, 0x2a, 0x33, 0xb9, 0x10, 0x4e, 0xc0, 0x42,
+	0x27, 0x1e, 0x10, 0x3e, 0x5f, 0x58, 0x29, 0x2d, 0x97, 0x39, 0x2a, 0x2d, 0xdc, 0xc0, 0xb1, 0x52,
+	0x35, 0xe4, 0xc7, 0x00, 0xf3, 0x75, 0x91, 0x7e, 0x9d, 0xe5, 0x9b, 0x4c, 0x8b, 0x5e, 0xe0, 0x84,
+	0x6e, 0xec, 0x11, 0xf3, 0x61, 0x93, 0x69, 0x7e, 0x08, 0x7d, 0xb2, 0xaf, 0x45, 0x9f, 0x96, 0x6a,
+	0xc4, 0x9f, 0xc2, 0x38, 0xc3, 0x85, 0x4c, 0xf2, 0x59, 0x65, 0x64, 0x10, 0xb0, 0xd0, 0x8d, 0x47,
+	0x15, 0x77, 0x4e, 0x76, 0x9e, 0xc1, 0x41, 0xa9, 0x64, 0xa1, 0xa4, 0xb9, 0x99, 0x5d, 0x21, 0x6a,
+	0x31, 0x24, 0x85, 0x71, 0x43, 0x9e, 0x21, 0x6a, 0xee, 0x03, 0x18, 0x99, 0xa1, 0x36, 0x49, 0x56,
+	0x6a, 0xe1, 0x05, 0x4e, 0xe8, 0xc4, 0x2d, 0x86, 0xbf, 0x85, 0xa3, 0x7a, 0x4e, 0x5b, 0xab, 0x1e,
+	0x0a, 0x34, 0xf4, 0xb0, 0xda, 0x70, 0xb9, 0x97, 0xa5, 0xf9, 0x27, 0x7f, 0x18, 0x8c, 0x9b, 0x2e,
+	0x3f, 0x17, 0x06, 0x6d, 0x96, 0x2a, 0x75, 0x5d, 0x67, 0x8d, 0xf8, 0x63, 0xf0, 0xee, 0x2a, 0xa0,
+	0x46, 0xdd, 0x78, 0xd8, 0x34, 0x60, 0xab, 0xa6, 0xc8, 0xd4, 0xa8, 0x1b, 0x57, 0xc0, 0xc6, 0x6f,
+	0xfb, 0x11, 0x6e, 0x15, 0xbf, 0x15, 0x8d, 0x3f, 0x01, 0xef, 0x2e, 0x87, 0xe8, 0xd1, 0xef, 0xd8,
+	0x13, 0x3c, 0x06, 0xef, 0x4a, 0xa1, 0x5e, 0xe5, 0xa8, 0x6d, 0xb5, 0x2c, 0xbc, 0xf7, 0xea, 0x4d,
+	0xf4, 0xdf, 0xbb, 0x11, 0xb5, 0xb3, 0x9c, 0x35, 0x67, 0xe3, 0xbd, 0xcc, 0xf3, 0x0b, 0x78, 0xf8,
+	0xcf, 0x3d, 0xdc, 0x83, 0x1e, 0x81, 0x49, 0x87, 0xdf, 0x87, 0xd1, 0x47, 0x93, 0xac, 0xf1, 0xd4,
+	0xe6, 0xd3, 0x13, 0xc6, 0x0f, 0xc0, 0x23, 0xe2, 0x93, 0xcc, 0x70, 0xd2, 0xe5, 0x23, 0x18, 0x5c,
+	0x6c, 0xcc, 0x5a, 0xa2, 0x9a, 0x38, 0xa7, 0xef, 0x7f, 0x6c, 0x7d, 0x76, 0xbb, 0xf5, 0xd9, 0xef,
+	0xad, 0xcf, 0xbe, 0xef, 0xfc, 0xce, 0xed, 0xce, 0xef, 0xfc, 0xda, 0xf9, 0x9d, 0x2f, 0x2f, 0x97,
+	0xd2, 0xac, 0x36, 0xf3, 0x28, 0x2d, 0xb2, 0xa9, 0xf5, 0xfa, 0xa2, 0xba, 0xed, 0x8d, 0xed, 0xe9,
+	0xf5, 0xb4, 0xf5, 0x06, 0xcc, 0x4d, 0x89, 0x7a, 0xde, 0xa7, 0x07, 0xf0, 0xfa, 0x6f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xf7, 0x07, 0x86, 0xcc, 0x1e, 0x03, 0x00, 0x00,
 }
 
 func (m *GasPrice) Marshal() (dAtA []byte, err error) {
@@ -163,15 +318,16 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MedianIndex != 0 {
-		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
+	if m.MedianPriorityFeeIndex != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianPriorityFeeIndex))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x50
 	}
-	if len(m.Prices) > 0 {
-		dAtA2 := make([]byte, len(m.Prices)*10)
+	if len(m.Timestamps) > 0 {
+		dAtA2 := make([]byte, len(m.Timestamps)*10)
 		var j1 int
-		for _, num := range m.Prices {
+		for _, num1 := range m.Timestamps {
+			num := uint64(num1)
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -184,12 +340,12 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA2[:j1])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x4a
 	}
-	if len(m.BlockNums) > 0 {
-		dAtA4 := make([]byte, len(m.BlockNums)*10)
+	if len(m.PriorityFees) > 0 {
+		dAtA4 := make([]byte, len(m.PriorityFees)*10)
 		var j3 int
-		for _, num := range m.BlockNums {
+		for _, num := range m.PriorityFees {
 			for num >= 1<<7 {
 				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -202,6 +358,47 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA4[:j3])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j3))
 		i--
+		dAtA[i] = 0x42
+	}
+	if m.MedianIndex != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Prices) > 0 {
+		dAtA6 := make([]byte, len(m.Prices)*10)
+		var j5 int
+		for _, num := range m.Prices {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockNums) > 0 {
+		dAtA8 := make([]byte, len(m.BlockNums)*10)
+		var j7 int
+		for _, num := range m.BlockNums {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j7))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.Signers) > 0 {
@@ -235,6 +432,61 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasPriceVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Freshness != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.Freshness))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PriorityFee != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.PriorityFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Price != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockNum != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.BlockNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGasPrice(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGasPrice(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGasPrice(v)
 	base := offset
@@ -286,6 +538,51 @@ func (m *GasPrice) Size() (n int) {
 	if m.MedianIndex != 0 {
 		n += 1 + sovGasPrice(uint64(m.MedianIndex))
 	}
+	if len(m.PriorityFees) > 0 {
+		l = 0
+		for _, e := range m.PriorityFees {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
+	if len(m.Timestamps) > 0 {
+		l = 0
+		for _, e := range m.Timestamps {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
+	if m.MedianPriorityFeeIndex != 0 {
+		n += 1 + sovGasPrice(uint64(m.MedianPriorityFeeIndex))
+	}
+	return n
+}
+
+func (m *GasPriceVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGasPrice(uint64(l))
+	}
+	if m.BlockNum != 0 {
+		n += 1 + sovGasPrice(uint64(m.BlockNum))
+	}
+	if m.Price != 0 {
+		n += 1 + sovGasPrice(uint64(m.Price))
+	}
+	if m.PriorityFee != 0 {
+		n += 1 + sovGasPrice(uint64(m.PriorityFee))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGasPrice(uint64(m.Timestamp))
+	}
+	if m.Freshness != 0 {
+		n += 1 + sovGasPrice(uint64(m.Freshness))
+	}
 	return n
 }
 
@@ -610,6 +907,354 @@ func (m *GasPrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PriorityFees = append(m.PriorityFees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PriorityFees) == 0 {
+					m.PriorityFees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PriorityFees = append(m.PriorityFees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFees", wireType)
+			}
+		case 9:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Timestamps = append(m.Timestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Timestamps) == 0 {
+					m.Timestamps = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Timestamps = append(m.Timestamps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPriorityFeeIndex", wireType)
+			}
+			m.MedianPriorityFeeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianPriorityFeeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasPrice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasPrice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasPrice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasPrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			}
+			m.BlockNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			m.PriorityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freshness", wireType)
+			}
+			m.Freshness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Freshness |= GasPriceVoteFreshness(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasPrice(dAtA[iNdEx:])
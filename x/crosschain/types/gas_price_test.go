@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func freshness(votes []types.GasPriceVote) []types.GasPriceVoteFreshness {
+	res := make([]types.GasPriceVoteFreshness, len(votes))
+	for i, vote := range votes {
+		res[i] = vote.Freshness
+	}
+	return res
+}
+
+func TestGasPrice_Votes(t *testing.T) {
+	gasPrice := types.GasPrice{
+		Signers:      []string{"a", "b", "c", "d", "e"},
+		BlockNums:    []uint64{1000, 995, 900, 2000, 1001},
+		Prices:       []uint64{100, 110, 50, 120, 500},
+		PriorityFees: []uint64{1, 2, 3, 4, 5},
+		Timestamps:   []int64{10000, 9990, 9900, 5000, 10000},
+	}
+
+	t.Run("all votes are fresh if checks are disabled", func(t *testing.T) {
+		votes := gasPrice.Votes(10000, 0, 0, 0)
+		require.Len(t, votes, 5)
+		require.Equal(t, types.GasPriceVote{
+			Signer:      "b",
+			BlockNum:    995,
+			Price:       110,
+			PriorityFee: 2,
+			Timestamp:   9990,
+			Freshness:   types.GasPriceVoteFreshness_Fresh,
+		}, votes[1])
+		for _, f := range freshness(votes) {
+			require.Equal(t, types.GasPriceVoteFreshness_Fresh, f)
+		}
+	})
+
+	t.Run("votes are stale in time before stale in blocks", func(t *testing.T) {
+		// d voted the highest block but long ago, the blocks of the other votes are compared to e
+		votes := gasPrice.Votes(10000, 10, 600, 0)
+		require.Equal(t, []types.GasPriceVoteFreshness{
+			types.GasPriceVoteFreshness_Fresh,
+			types.GasPriceVoteFreshness_Fresh,
+			types.GasPriceVoteFreshness_StaleBlocks,
+			types.GasPriceVoteFreshness_StaleTime,
+			types.GasPriceVoteFreshness_Fresh,
+		}, freshness(votes))
+	})
+
+	t.Run("fresh votes deviating from the median are outliers", func(t *testing.T) {
+		votes := gasPrice.Votes(10000, 10, 600, 20)
+		require.Equal(t, []types.GasPriceVoteFreshness{
+			types.GasPriceVoteFreshness_Fresh,
+			types.GasPriceVoteFreshness_Fresh,
+			types.GasPriceVoteFreshness_StaleBlocks,
+			types.GasPriceVoteFreshness_StaleTime,
+			types.GasPriceVoteFreshness_Outlier,
+		}, freshness(votes))
+	})
+
+	t.Run("votes without timestamp are stale in time", func(t *testing.T) {
+		legacy := types.GasPrice{
+			Signers:   []string{"a", "b"},
+			BlockNums: []uint64{1000, 1000},
+			Prices:    []uint64{100, 110},
+		}
+		votes := legacy.Votes(10000, 10, 600, 0)
+		require.Equal(t, []types.GasPriceVoteFreshness{
+			types.GasPriceVoteFreshness_StaleTime,
+			types.GasPriceVoteFreshness_StaleTime,
+		}, freshness(votes))
+	})
+}
+
+func TestMedianIndexes(t *testing.T) {
+	t.Run("no fresh vote", func(t *testing.T) {
+		_, _, found := types.MedianIndexes([]types.GasPriceVote{
+			{Price: 100, Freshness: types.GasPriceVoteFreshness_StaleTime},
+		})
+		require.False(t, found)
+	})
+
+	t.Run("medians of the fresh votes", func(t *testing.T) {
+		priceIndex, priorityFeeIndex, found := types.MedianIndexes([]types.GasPriceVote{
+			{Price: 100, PriorityFee: 9, Freshness: types.GasPriceVoteFreshness_Fresh},
+			{Price: 1, PriorityFee: 1, Freshness: types.GasPriceVoteFreshness_StaleBlocks},
+			{Price: 300, PriorityFee: 3, Freshness: types.GasPriceVoteFreshness_Fresh},
+			{Price: 200, PriorityFee: 5, Freshness: types.GasPriceVoteFreshness_Fresh},
+			{Price: 1000, PriorityFee: 1000, Freshness: types.GasPriceVoteFreshness_Outlier},
+		})
+		require.True(t, found)
+		require.EqualValues(t, 3, priceIndex)
+		require.EqualValues(t, 3, priorityFeeIndex)
+	})
+}
@@ -56,6 +56,24 @@ func (gs GenesisState) Validate() error {
 		gasPriceIndexMap[elem.Index] = true
 	}
 
+	if err := gs.WithdrawalLimits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Check for duplicated index in queuedWithdrawals
+	queuedWithdrawalIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.QueuedWithdrawals {
+		if _, ok := queuedWithdrawalIndexMap[elem.CctxIndex]; ok {
+			return fmt.Errorf("duplicated index for queuedWithdrawal")
+		}
+		queuedWithdrawalIndexMap[elem.CctxIndex] = true
+	}
+
 	// Check for duplicated index in send
 	//sendIndexMap := make(map[string]bool)
 
@@ -34,6 +34,8 @@ type GenesisState struct {
 	InTxHashToCctxList  []InTxHashToCctx   `protobuf:"bytes,9,rep,name=inTxHashToCctxList,proto3" json:"inTxHashToCctxList"`
 	InTxTrackerList     []InTxTracker      `protobuf:"bytes,11,rep,name=in_tx_tracker_list,json=inTxTrackerList,proto3" json:"in_tx_tracker_list"`
 	ZetaAccounting      ZetaAccounting     `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
+	WithdrawalLimits    WithdrawalLimits   `protobuf:"bytes,13,opt,name=withdrawal_limits,json=withdrawalLimits,proto3" json:"withdrawal_limits"`
+	QueuedWithdrawals   []QueuedWithdrawal `protobuf:"bytes,14,rep,name=queued_withdrawals,json=queuedWithdrawals,proto3" json:"queued_withdrawals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +127,20 @@ func (m *GenesisState) GetZetaAccounting() ZetaAccounting {
 	return ZetaAccounting{}
 }
 
+func (m *GenesisState) GetWithdrawalLimits() WithdrawalLimits {
+	if m != nil {
+		return m.WithdrawalLimits
+	}
+	return WithdrawalLimits{}
+}
+
+func (m *GenesisState) GetQueuedWithdrawals() []QueuedWithdrawal {
+	if m != nil {
+		return m.QueuedWithdrawals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.crosschain.GenesisState")
 }
@@ -132,37 +148,41 @@ func init() {
 func init() { proto.RegisterFile("crosschain/genesis.proto", fileDescriptor_dd51403692d571f4) }
 
 var fileDescriptor_dd51403692d571f4 = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x51, 0x6b, 0x14, 0x31,
-	0x10, 0xc7, 0x6f, 0xd5, 0x56, 0xcd, 0x9d, 0x56, 0xa2, 0xe0, 0x72, 0xe0, 0xb6, 0x54, 0xc4, 0xa2,
-	0x74, 0x17, 0xeb, 0x27, 0xb0, 0xf7, 0xd0, 0x4a, 0x0b, 0xd6, 0xf3, 0x9e, 0x8a, 0x25, 0xe6, 0x42,
-	0xd8, 0x0d, 0xbd, 0x6e, 0x96, 0xcd, 0x2c, 0xac, 0xfd, 0x14, 0x7e, 0xac, 0x3e, 0xf6, 0x49, 0x7c,
-	0x12, 0xb9, 0xfb, 0x22, 0x92, 0xd9, 0x58, 0xb3, 0x5c, 0xe9, 0xf6, 0x6d, 0xc8, 0xcc, 0xff, 0xf7,
-	0x9f, 0xcc, 0x24, 0x24, 0x14, 0xa5, 0x36, 0x46, 0x64, 0x5c, 0xe5, 0x49, 0x2a, 0x73, 0x69, 0x94,
-	0x89, 0x8b, 0x52, 0x83, 0xa6, 0x2f, 0xce, 0x25, 0x70, 0x4c, 0xc4, 0x18, 0xe9, 0x52, 0xc6, 0xff,
-	0x8b, 0x87, 0xeb, 0x9e, 0x10, 0x43, 0x86, 0x31, 0x83, 0xba, 0xd1, 0x0f, 0x87, 0x3e, 0x99, 0x1b,
-	0x56, 0x94, 0x4a, 0x48, 0x97, 0x7b, 0xe9, 0xe5, 0x50, 0xc3, 0x32, 0x6e, 0x32, 0x06, 0x9a, 0x09,
-	0x71, 0x05, 0x88, 0x96, 0x8a, 0xa0, 0xe4, 0xe2, 0x54, 0x96, 0x2e, 0xbf, 0xe9, 0xe5, 0x67, 0xdc,
-	0x00, 0x9b, 0xce, 0xb4, 0x38, 0x65, 0x99, 0x54, 0x69, 0x06, 0xae, 0xc6, 0xef, 0x52, 0x57, 0xb0,
-	0x0c, 0x79, 0xee, 0x15, 0x14, 0xbc, 0xe4, 0x67, 0xee, 0xfa, 0xc3, 0x67, 0xa9, 0x4e, 0x35, 0x86,
-	0x89, 0x8d, 0x9a, 0xd3, 0xcd, 0x9f, 0x2b, 0x64, 0xb0, 0xd7, 0x8c, 0xe9, 0x0b, 0x70, 0x90, 0x74,
-	0x44, 0x56, 0x1b, 0x59, 0x18, 0x6c, 0x04, 0x5b, 0xfd, 0x9d, 0x57, 0xf1, 0x8d, 0x63, 0x8b, 0x8f,
-	0xb0, 0x78, 0xf7, 0xde, 0xc5, 0xef, 0xf5, 0xde, 0xd8, 0x49, 0xe9, 0x09, 0x79, 0xa2, 0x2b, 0x98,
-	0xd4, 0x93, 0xa6, 0xb5, 0x43, 0x65, 0x20, 0xbc, 0xb3, 0x71, 0x77, 0xab, 0xbf, 0xf3, 0xb6, 0x03,
-	0xf7, 0xc9, 0x93, 0x39, 0xe8, 0x12, 0x8a, 0x1e, 0x90, 0x41, 0xca, 0xcd, 0x91, 0x9d, 0x3f, 0xa2,
-	0x57, 0x10, 0xfd, 0xba, 0x03, 0xbd, 0xe7, 0x24, 0xe3, 0x96, 0x98, 0x7e, 0x26, 0x8f, 0x46, 0xb6,
-	0x68, 0x64, 0x8b, 0x26, 0xb5, 0x09, 0xef, 0xdf, 0xaa, 0x51, 0x5f, 0x33, 0x6e, 0x13, 0xe8, 0x37,
-	0xf2, 0xd4, 0xee, 0x6f, 0xd7, 0xae, 0x6f, 0x1f, 0xb7, 0x87, 0x6d, 0x3e, 0x40, 0x70, 0xdc, 0x01,
-	0x3e, 0x6c, 0x2b, 0xc7, 0xd7, 0xa1, 0xa8, 0x20, 0xd4, 0x5a, 0xed, 0x73, 0x93, 0x4d, 0xf4, 0x48,
-	0x40, 0x8d, 0x06, 0x0f, 0xd1, 0x60, 0xbb, 0xc3, 0xe0, 0x63, 0x4b, 0xe8, 0x86, 0x7c, 0x0d, 0x8e,
-	0x9e, 0x58, 0x13, 0xef, 0x85, 0xb1, 0x99, 0x35, 0xe9, 0xa3, 0xc9, 0x9b, 0x5b, 0x98, 0xb4, 0xd7,
-	0xb8, 0xa6, 0xf2, 0xf6, 0x16, 0xbf, 0x92, 0x35, 0xab, 0x64, 0x5c, 0x08, 0x5d, 0xe5, 0xa0, 0xf2,
-	0x34, 0x1c, 0xe0, 0x93, 0xeb, 0xba, 0xc0, 0xb1, 0x04, 0xfe, 0xe1, 0x4a, 0xe4, 0xf0, 0x8f, 0xcf,
-	0xdb, 0xa7, 0x07, 0x17, 0xf3, 0x28, 0xb8, 0x9c, 0x47, 0xc1, 0x9f, 0x79, 0x14, 0xfc, 0x58, 0x44,
-	0xbd, 0xcb, 0x45, 0xd4, 0xfb, 0xb5, 0x88, 0x7a, 0xc7, 0xef, 0x52, 0x05, 0x59, 0x35, 0x8d, 0x85,
-	0x3e, 0x4b, 0xac, 0x68, 0xbb, 0xf9, 0x2c, 0xff, 0x9c, 0x92, 0x3a, 0xf1, 0xbe, 0x10, 0x7c, 0x2f,
-	0xa4, 0x99, 0xae, 0xe2, 0x67, 0x79, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x06, 0xe1, 0xb6, 0x7c,
-	0x5d, 0x04, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x5b, 0xfe, 0x6c, 0xe0, 0x75, 0x1b, 0x33, 0x48, 0x44, 0x95, 0xc8, 0xc6, 0x10, 0x62,
+	0x02, 0x2d, 0x11, 0xe3, 0x09, 0x68, 0x2f, 0x36, 0xb4, 0x4a, 0x6c, 0xa5, 0x12, 0xd2, 0xc4, 0x64,
+	0x5c, 0xd7, 0x4a, 0xac, 0xb5, 0x75, 0x17, 0x3b, 0x6a, 0xd9, 0x53, 0xf0, 0x08, 0x3c, 0xce, 0x2e,
+	0x77, 0xc9, 0x15, 0x42, 0xed, 0x8b, 0x20, 0x9f, 0xb8, 0xad, 0xd3, 0x4e, 0x64, 0x77, 0x47, 0x3e,
+	0xe7, 0xfb, 0x7d, 0x27, 0xe7, 0x38, 0x46, 0x1e, 0x4b, 0xa4, 0x52, 0x2c, 0xa6, 0xa2, 0x1f, 0x46,
+	0xbc, 0xcf, 0x95, 0x50, 0xc1, 0x20, 0x91, 0x5a, 0xe2, 0x17, 0x57, 0x5c, 0x53, 0x48, 0x04, 0x10,
+	0xc9, 0x84, 0x07, 0xf3, 0xe2, 0xea, 0xb6, 0x23, 0x84, 0x90, 0x40, 0x4c, 0xf4, 0x28, 0xd3, 0x57,
+	0xab, 0x2e, 0x99, 0x2a, 0x32, 0x48, 0x04, 0xe3, 0x36, 0xf7, 0xca, 0xc9, 0x81, 0x86, 0xc4, 0x54,
+	0xc5, 0x44, 0x4b, 0xc2, 0xd8, 0x0c, 0xe0, 0x2f, 0x15, 0xe9, 0x84, 0xb2, 0x0b, 0x9e, 0xd8, 0xfc,
+	0xae, 0x93, 0xef, 0x52, 0xa5, 0x49, 0xbb, 0x2b, 0xd9, 0x05, 0x89, 0xb9, 0x88, 0x62, 0x6d, 0x6b,
+	0xdc, 0x2e, 0x65, 0xaa, 0x97, 0x21, 0xcf, 0x9d, 0x82, 0x01, 0x4d, 0x68, 0xcf, 0x7e, 0x7e, 0xf5,
+	0xa5, 0x93, 0x18, 0x0a, 0x1d, 0x77, 0x12, 0x3a, 0xa4, 0x5d, 0xd2, 0x15, 0x3d, 0x31, 0x85, 0x3f,
+	0x8b, 0x64, 0x24, 0x21, 0x0c, 0x4d, 0x94, 0x9d, 0xee, 0xfe, 0x5a, 0x45, 0x95, 0xc3, 0x6c, 0x92,
+	0x5f, 0x34, 0xd5, 0x1c, 0xd7, 0xd1, 0x4a, 0x46, 0xf6, 0xca, 0x3b, 0xe5, 0xbd, 0xb5, 0x83, 0xd7,
+	0xc1, 0x7f, 0x27, 0x1b, 0x9c, 0x40, 0x71, 0xed, 0xc1, 0xf5, 0x9f, 0xed, 0x52, 0xd3, 0x4a, 0xf1,
+	0x39, 0x7a, 0x22, 0x53, 0xdd, 0x1a, 0xb5, 0xb2, 0xee, 0x1b, 0x42, 0x69, 0xef, 0xde, 0xce, 0xfd,
+	0xbd, 0xb5, 0x83, 0x77, 0x05, 0xb8, 0xcf, 0x8e, 0xcc, 0x42, 0x97, 0x50, 0xf8, 0x18, 0x55, 0x22,
+	0xaa, 0x4e, 0xcc, 0x8a, 0x00, 0xfd, 0x10, 0xd0, 0x6f, 0x0a, 0xd0, 0x87, 0x56, 0xd2, 0xcc, 0x89,
+	0xf1, 0x29, 0x5a, 0xaf, 0x9b, 0xa2, 0xba, 0x29, 0x6a, 0x8d, 0x94, 0xb7, 0x7a, 0xa7, 0x46, 0x5d,
+	0x4d, 0x33, 0x4f, 0xc0, 0xdf, 0xd1, 0x53, 0xb3, 0xe2, 0x9a, 0xd9, 0xf0, 0x11, 0x2c, 0x18, 0xda,
+	0x7c, 0x04, 0xe0, 0xa0, 0x00, 0xdc, 0xc8, 0x2b, 0x9b, 0xb7, 0xa1, 0x30, 0x43, 0xd8, 0x58, 0x1d,
+	0x51, 0x15, 0xb7, 0x64, 0x9d, 0xe9, 0x11, 0x18, 0x3c, 0x06, 0x83, 0xfd, 0x02, 0x83, 0x4f, 0x39,
+	0xa1, 0x1d, 0xf2, 0x2d, 0x38, 0x7c, 0x6e, 0x4c, 0x9c, 0x4b, 0x48, 0xba, 0xc6, 0x64, 0x0d, 0x4c,
+	0xde, 0xde, 0xc1, 0x24, 0xbf, 0xc6, 0x4d, 0xd1, 0xcf, 0x6f, 0xf1, 0x1b, 0xda, 0x34, 0x4a, 0x42,
+	0x19, 0x93, 0x69, 0x5f, 0x8b, 0x7e, 0xe4, 0x55, 0xe0, 0xca, 0x15, 0x7d, 0xc0, 0x19, 0xd7, 0xf4,
+	0xe3, 0x4c, 0x64, 0xf1, 0x1b, 0x57, 0xb9, 0x53, 0xdc, 0x46, 0x5b, 0x8b, 0x3f, 0x82, 0xf2, 0xd6,
+	0x81, 0x1f, 0x16, 0xf0, 0xbf, 0xce, 0x74, 0x0d, 0x90, 0x4d, 0xef, 0xe1, 0x70, 0xe1, 0x1c, 0x77,
+	0x10, 0xbe, 0x4c, 0x79, 0xca, 0x3b, 0x64, 0x9e, 0x52, 0xde, 0x06, 0x0c, 0xa8, 0xc8, 0xe4, 0x14,
+	0x84, 0x73, 0x2b, 0x6b, 0xb2, 0x75, 0xb9, 0x70, 0xae, 0x6a, 0xc7, 0xd7, 0x63, 0xbf, 0x7c, 0x33,
+	0xf6, 0xcb, 0x7f, 0xc7, 0x7e, 0xf9, 0xe7, 0xc4, 0x2f, 0xdd, 0x4c, 0xfc, 0xd2, 0xef, 0x89, 0x5f,
+	0x3a, 0x7b, 0x1f, 0x09, 0x1d, 0xa7, 0xed, 0x80, 0xc9, 0x5e, 0x68, 0x3c, 0xf6, 0xb3, 0x07, 0x60,
+	0x6a, 0x17, 0x8e, 0x42, 0xe7, 0x59, 0xd0, 0x3f, 0x06, 0x5c, 0xb5, 0x57, 0xe0, 0xb7, 0xff, 0xf0,
+	0x2f, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xca, 0xd4, 0x86, 0x4a, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +205,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueuedWithdrawals) > 0 {
+		for iNdEx := len(m.QueuedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.WithdrawalLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	{
 		size, err := m.ZetaAccounting.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -349,6 +393,14 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.ZetaAccounting.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.WithdrawalLimits.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.QueuedWithdrawals) > 0 {
+		for _, e := range m.QueuedWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -657,6 +709,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedWithdrawals = append(m.QueuedWithdrawals, QueuedWithdrawal{})
+			if err := m.QueuedWithdrawals[len(m.QueuedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -49,8 +50,28 @@ const (
 	// #nosec G101: Potential hardcoded credentials (gosec)
 	// ZetaAccountingKey value is used as prefix for storing ZetaAccountingKey
 	ZetaAccountingKey = "ZetaAccounting-value-"
+
+	WithdrawalLimitsKey     = "WithdrawalLimits-value-"
+	WithdrawalZRC20UsageKey = "WithdrawalZRC20Usage-value-"
+	WithdrawalChainUsageKey = "WithdrawalChainUsage-value-"
+	QueuedWithdrawalKey     = "QueuedWithdrawal-value-"
+
+	OutboundTimeoutsParamsKey = "OutboundTimeoutsParams"
+
+	// the secondary indexes of the cctxs
+	CctxSenderIndexKey        = "CctxSenderIndex-value-"
+	CctxReceiverIndexKey      = "CctxReceiverIndex-value-"
+	CctxStatusIndexKey        = "CctxStatusIndex-value-"
+	CctxSenderChainIndexKey   = "CctxSenderChainIndex-value-"
+	CctxReceiverChainIndexKey = "CctxReceiverChainIndex-value-"
+
+	// CctxIndexBackfillCursorKey is the key of the cctx store key the backfill of the secondary indexes resumes from in the next block
+	CctxIndexBackfillCursorKey = "CctxIndexBackfillCursor-value-"
 )
 
+// CctxIndexBackfillLimit is the maximum number of cctxs added per block to the secondary indexes by the backfill
+const CctxIndexBackfillLimit = 500
+
 // OutTxTrackerKey returns the store key to retrieve a OutTxTracker from the index fields
 func OutTxTrackerKey(
 	index string,
@@ -64,6 +85,32 @@ func OutTxTrackerKey(
 	return key
 }
 
+// CctxIndexValuePrefix returns the prefix of the keys of a value in a cctx secondary index
+// hex addresses are lower cased so the index is case insensitive for EVM addresses
+func CctxIndexValuePrefix(value string) []byte {
+	if common.IsHexAddress(value) {
+		value = strings.ToLower(value)
+	}
+	return []byte(value + "/")
+}
+
+// CctxIndexTimestampKey returns the timestamp part of a key in a cctx secondary index
+// the timestamp is big endian encoded so the cctxs of a value are ordered by creation timestamp
+func CctxIndexTimestampKey(timestamp int64) []byte {
+	if timestamp < 0 {
+		timestamp = 0
+	}
+	// #nosec G701 always positive
+	return sdk.Uint64ToBigEndian(uint64(timestamp))
+}
+
+// CctxIndexKey returns the key of a cctx in a cctx secondary index
+func CctxIndexKey(value string, timestamp int64, cctxIndex string) []byte {
+	key := CctxIndexValuePrefix(value)
+	key = append(key, CctxIndexTimestampKey(timestamp)...)
+	return append(key, []byte(cctxIndex)...)
+}
+
 // TODO: what's the purpose of this log identifier?
 func (m CrossChainTx) LogIdentifierForCCTX() string {
 	if len(m.OutboundTxParams) == 0 {
@@ -8,11 +8,12 @@ import (
 
 var _ sdk.Msg = &MsgGasPriceVoter{}
 
-func NewMsgGasPriceVoter(creator string, chain int64, price uint64, supply string, blockNumber uint64) *MsgGasPriceVoter {
+func NewMsgGasPriceVoter(creator string, chain int64, price uint64, priorityFee uint64, supply string, blockNumber uint64) *MsgGasPriceVoter {
 	return &MsgGasPriceVoter{
 		Creator:     creator,
 		ChainId:     chain,
 		Price:       price,
+		PriorityFee: priorityFee,
 		BlockNumber: blockNumber,
 		Supply:      supply,
 	}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgMigrateAllTssFunds{}
+
+func NewMsgMigrateAllTssFunds(creator string) *MsgMigrateAllTssFunds {
+	return &MsgMigrateAllTssFunds{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgMigrateAllTssFunds) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMigrateAllTssFunds) Type() string {
+	return "MigrateAllTssFunds"
+}
+
+func (msg *MsgMigrateAllTssFunds) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMigrateAllTssFunds) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMigrateAllTssFunds) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
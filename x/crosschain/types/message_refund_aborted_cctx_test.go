@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgRefundAbortedCCTX_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   *types.MsgRefundAbortedCCTX
+		error bool
+	}{
+		{
+			name:  "invalid creator",
+			msg:   types.NewMsgRefundAbortedCCTX("invalid_address", "0x123", ""),
+			error: true,
+		},
+		{
+			name:  "empty cctx index",
+			msg:   types.NewMsgRefundAbortedCCTX(sample.AccAddress(), "", ""),
+			error: true,
+		},
+		{
+			name:  "invalid refund address",
+			msg:   types.NewMsgRefundAbortedCCTX(sample.AccAddress(), "0x123", "invalid_address"),
+			error: true,
+		},
+		{
+			name:  "valid msg without refund address",
+			msg:   types.NewMsgRefundAbortedCCTX(sample.AccAddress(), "0x123", ""),
+			error: false,
+		},
+		{
+			name:  "valid msg with refund address",
+			msg:   types.NewMsgRefundAbortedCCTX(sample.AccAddress(), "0x123", sample.EthAddress().String()),
+			error: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keeper.SetConfig(false)
+			err := tt.msg.ValidateBasic()
+			if tt.error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReleaseQueuedWithdrawal{}
+
+func NewMsgReleaseQueuedWithdrawal(creator string, cctxIndex string) *MsgReleaseQueuedWithdrawal {
+	return &MsgReleaseQueuedWithdrawal{
+		Creator:   creator,
+		CctxIndex: cctxIndex,
+	}
+}
+
+func (msg *MsgReleaseQueuedWithdrawal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseQueuedWithdrawal) Type() string {
+	return "ReleaseQueuedWithdrawal"
+}
+
+func (msg *MsgReleaseQueuedWithdrawal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReleaseQueuedWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseQueuedWithdrawal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CctxIndex == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cctx index cannot be empty")
+	}
+	return nil
+}
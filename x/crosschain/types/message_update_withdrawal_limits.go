@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateWithdrawalLimits{}
+
+func NewMsgUpdateWithdrawalLimits(creator string, limits WithdrawalLimits) *MsgUpdateWithdrawalLimits {
+	return &MsgUpdateWithdrawalLimits{
+		Creator:          creator,
+		WithdrawalLimits: limits,
+	}
+}
+
+func (msg *MsgUpdateWithdrawalLimits) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateWithdrawalLimits) Type() string {
+	return "UpdateWithdrawalLimits"
+}
+
+func (msg *MsgUpdateWithdrawalLimits) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateWithdrawalLimits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateWithdrawalLimits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.WithdrawalLimits.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidWithdrawalLimits, err.Error())
+	}
+	return nil
+}
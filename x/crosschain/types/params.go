@@ -1,10 +1,18 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/zeta-chain/zetacore/common"
 	"gopkg.in/yaml.v2"
 )
 
+// OutboundTimeoutGracePeriod is the number of blocks after the outbound timeout during which a pending outbound is not expired
+// observers stop signing an outbound once its timeout is reached, the grace period leaves time for a tx signed before the timeout
+// to be added to the outbound tracker, an outbound with a tracker is never expired
+const OutboundTimeoutGracePeriod = 100
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -26,12 +34,14 @@ func DefaultParams() Params {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPrefix(OutboundTimeoutsParamsKey), &p.OutboundTimeouts, validateOutboundTimeouts),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	return validateOutboundTimeouts(p.OutboundTimeouts)
 }
 
 // String implements the Stringer interface.
@@ -42,3 +52,54 @@ func (p Params) String() string {
 	}
 	return string(out)
 }
+
+// GetOutboundTimeout returns the outbound timeout in blocks of the chain, 0 if the outbounds of the chain don't expire
+func (p Params) GetOutboundTimeout(chainID int64) uint64 {
+	for _, timeout := range p.OutboundTimeouts {
+		if timeout != nil && timeout.ChainId == chainID {
+			return timeout.TimeoutBlocks
+		}
+	}
+	return 0
+}
+
+// IsOutboundTimedOut returns true if the outbound timeout is reached at the given height and the outbound must no longer be signed
+// outbounds created before the timeout was introduced don't have a creation height and never time out
+func (p Params) IsOutboundTimedOut(outbound OutboundTxParams, height uint64) bool {
+	timeout := p.GetOutboundTimeout(outbound.ReceiverChainId)
+	if timeout == 0 || outbound.OutboundTxCreatedZetaHeight == 0 {
+		return false
+	}
+	return height >= outbound.OutboundTxCreatedZetaHeight+timeout
+}
+
+// IsOutboundExpired returns true if the outbound timeout and its grace period are reached at the given height
+func (p Params) IsOutboundExpired(outbound OutboundTxParams, height uint64) bool {
+	if height < OutboundTimeoutGracePeriod {
+		return false
+	}
+	return p.IsOutboundTimedOut(outbound, height-OutboundTimeoutGracePeriod)
+}
+
+// validateOutboundTimeouts checks the outbound timeouts are only set once for supported EVM chains
+// Bitcoin outbounds are chained through the nonce-mark UTXO and can't be skipped
+func validateOutboundTimeouts(i interface{}) error {
+	timeouts, ok := i.([]*OutboundTimeout)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	chainIDs := make(map[int64]bool)
+	for _, timeout := range timeouts {
+		if timeout == nil {
+			return fmt.Errorf("outbound timeout cannot be nil")
+		}
+		if common.GetChainFromChainID(timeout.ChainId) == nil || !common.IsEVMChain(timeout.ChainId) {
+			return fmt.Errorf("outbound timeout is only supported for EVM chains: chain id %d", timeout.ChainId)
+		}
+		if chainIDs[timeout.ChainId] {
+			return fmt.Errorf("duplicated outbound timeout for chain id %d", timeout.ChainId)
+		}
+		chainIDs[timeout.ChainId] = true
+	}
+	return nil
+}
@@ -26,7 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enabled          bool               `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	OutboundTimeouts []*OutboundTimeout `protobuf:"bytes,2,rep,name=outbound_timeouts,json=outboundTimeouts,proto3" json:"outbound_timeouts,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -68,25 +69,93 @@ func (m *Params) GetEnabled() bool {
 	return false
 }
 
+func (m *Params) GetOutboundTimeouts() []*OutboundTimeout {
+	if m != nil {
+		return m.OutboundTimeouts
+	}
+	return nil
+}
+
+// OutboundTimeout defines the number of ZetaChain blocks after which a pending outbound of an EVM chain
+// is no longer signed by the observers and can be expired into a revert
+type OutboundTimeout struct {
+	ChainId       int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TimeoutBlocks uint64 `protobuf:"varint,2,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+}
+
+func (m *OutboundTimeout) Reset()         { *m = OutboundTimeout{} }
+func (m *OutboundTimeout) String() string { return proto.CompactTextString(m) }
+func (*OutboundTimeout) ProtoMessage()    {}
+func (*OutboundTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd6915e32c251e53, []int{1}
+}
+func (m *OutboundTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundTimeout.Merge(m, src)
+}
+func (m *OutboundTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundTimeout proto.InternalMessageInfo
+
+func (m *OutboundTimeout) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *OutboundTimeout) GetTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.TimeoutBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.crosschain.Params")
+	proto.RegisterType((*OutboundTimeout)(nil), "zetachain.zetacore.crosschain.OutboundTimeout")
 }
 
 func init() { proto.RegisterFile("crosschain/params.proto", fileDescriptor_cd6915e32c251e53) }
 
 var fileDescriptor_cd6915e32c251e53 = []byte{
-	// 175 bytes of a gzipped FileDescriptorProto
+	// 270 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2e, 0xca, 0x2f,
 	0x2e, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
 	0xca, 0x2f, 0xc9, 0x17, 0x92, 0xad, 0x4a, 0x2d, 0x49, 0x04, 0x8b, 0xeb, 0x81, 0x59, 0xf9, 0x45,
 	0xa9, 0x7a, 0x08, 0xb5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x95, 0xfa, 0x20, 0x16, 0x44,
-	0x93, 0x92, 0x06, 0x17, 0x5b, 0x00, 0xd8, 0x10, 0x21, 0x09, 0x2e, 0xf6, 0xd4, 0xbc, 0xc4, 0xa4,
-	0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x18, 0xd7, 0x8a, 0x65, 0xc6, 0x02,
-	0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
-	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c,
-	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xd9, 0xac, 0x0b, 0x71, 0x1c,
-	0xcc, 0x11, 0xfa, 0x15, 0xfa, 0x48, 0x4e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xdb,
-	0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x96, 0x4e, 0x4b, 0x16, 0xcd, 0x00, 0x00, 0x00,
+	0x93, 0x52, 0x2b, 0x23, 0x17, 0x5b, 0x00, 0xd8, 0x14, 0x21, 0x09, 0x2e, 0xf6, 0xd4, 0xbc, 0xc4,
+	0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x18, 0x57, 0x28, 0x9a, 0x4b,
+	0x30, 0xbf, 0xb4, 0x24, 0x29, 0xbf, 0x34, 0x2f, 0x25, 0xbe, 0x24, 0x33, 0x37, 0x35, 0xbf, 0xb4,
+	0xa4, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x4f, 0x0f, 0xaf, 0xad, 0x7a, 0xfe, 0x50,
+	0x7d, 0x21, 0x10, 0x6d, 0x41, 0x02, 0xf9, 0xa8, 0x02, 0xc5, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33,
+	0x28, 0x05, 0x73, 0xf1, 0xa3, 0x29, 0x15, 0x92, 0xe4, 0xe2, 0x00, 0x9b, 0x11, 0x9f, 0x09, 0x71,
+	0x10, 0x73, 0x10, 0x3b, 0x98, 0xef, 0x99, 0x22, 0xa4, 0xca, 0xc5, 0x07, 0x75, 0x47, 0x7c, 0x52,
+	0x4e, 0x7e, 0x72, 0x36, 0xc8, 0x35, 0x8c, 0x1a, 0x2c, 0x41, 0xbc, 0x50, 0x51, 0x27, 0xb0, 0xa0,
+	0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
+	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa6, 0x67, 0x96,
+	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x9c, 0xad, 0x0b, 0x09, 0x4f, 0x98, 0x0f,
+	0xf4, 0x2b, 0xf4, 0x91, 0x42, 0xb9, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x60, 0xc6,
+	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0xdc, 0xef, 0xdd, 0x80, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +178,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutboundTimeouts) > 0 {
+		for iNdEx := len(m.OutboundTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -122,6 +205,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OutboundTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -142,6 +258,27 @@ func (m *Params) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	if len(m.OutboundTimeouts) > 0 {
+		for _, e := range m.OutboundTimeouts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutboundTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovParams(uint64(m.ChainId))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovParams(uint64(m.TimeoutBlocks))
+	}
 	return n
 }
 
@@ -200,6 +337,128 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTimeouts = append(m.OutboundTimeouts, &OutboundTimeout{})
+			if err := m.OutboundTimeouts[len(m.OutboundTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboundTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	ethChainID := common.GoerliLocalnetChain().ChainId
+
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.Params{
+		OutboundTimeouts: []*types.OutboundTimeout{{ChainId: ethChainID, TimeoutBlocks: 100}},
+	}.Validate())
+	require.Error(t, types.Params{
+		OutboundTimeouts: []*types.OutboundTimeout{{ChainId: common.BtcRegtestChain().ChainId, TimeoutBlocks: 100}},
+	}.Validate())
+	require.Error(t, types.Params{
+		OutboundTimeouts: []*types.OutboundTimeout{{ChainId: 999, TimeoutBlocks: 100}},
+	}.Validate())
+	require.Error(t, types.Params{
+		OutboundTimeouts: []*types.OutboundTimeout{
+			{ChainId: ethChainID, TimeoutBlocks: 100},
+			{ChainId: ethChainID, TimeoutBlocks: 200},
+		},
+	}.Validate())
+}
+
+func TestParams_IsOutboundExpired(t *testing.T) {
+	ethChainID := common.GoerliLocalnetChain().ChainId
+	params := types.Params{
+		OutboundTimeouts: []*types.OutboundTimeout{{ChainId: ethChainID, TimeoutBlocks: 100}},
+	}
+	outbound := types.OutboundTxParams{
+		ReceiverChainId:             ethChainID,
+		OutboundTxCreatedZetaHeight: 1000,
+	}
+
+	require.False(t, params.IsOutboundTimedOut(outbound, 1099))
+	require.True(t, params.IsOutboundTimedOut(outbound, 1100))
+	require.False(t, params.IsOutboundExpired(outbound, 1100+types.OutboundTimeoutGracePeriod-1))
+	require.True(t, params.IsOutboundExpired(outbound, 1100+types.OutboundTimeoutGracePeriod))
+
+	// no timeout for the chain
+	outbound.ReceiverChainId = common.BscTestnetChain().ChainId
+	require.False(t, params.IsOutboundExpired(outbound, 1_000_000))
+
+	// outbound created before the timeout was introduced
+	outbound.ReceiverChainId = ethChainID
+	outbound.OutboundTxCreatedZetaHeight = 0
+	require.False(t, params.IsOutboundExpired(outbound, 1_000_000))
+}
@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -31,21 +32,21 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type QueryZetaAccountingRequest struct {
+type QueryTssFundsMigrationProgressRequest struct {
 }
 
-func (m *QueryZetaAccountingRequest) Reset()         { *m = QueryZetaAccountingRequest{} }
-func (m *QueryZetaAccountingRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryZetaAccountingRequest) ProtoMessage()    {}
-func (*QueryZetaAccountingRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTssFundsMigrationProgressRequest) Reset()         { *m = QueryTssFundsMigrationProgressRequest{} }
+func (m *QueryTssFundsMigrationProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTssFundsMigrationProgressRequest) ProtoMessage()    {}
+func (*QueryTssFundsMigrationProgressRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{0}
 }
-func (m *QueryZetaAccountingRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTssFundsMigrationProgressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZetaAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTssFundsMigrationProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZetaAccountingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTssFundsMigrationProgressRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -55,34 +56,41 @@ func (m *QueryZetaAccountingRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryZetaAccountingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZetaAccountingRequest.Merge(m, src)
+func (m *QueryTssFundsMigrationProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTssFundsMigrationProgressRequest.Merge(m, src)
 }
-func (m *QueryZetaAccountingRequest) XXX_Size() int {
+func (m *QueryTssFundsMigrationProgressRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZetaAccountingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZetaAccountingRequest.DiscardUnknown(m)
+func (m *QueryTssFundsMigrationProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTssFundsMigrationProgressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZetaAccountingRequest proto.InternalMessageInfo
-
-type QueryZetaAccountingResponse struct {
-	AbortedZetaAmount string `protobuf:"bytes,1,opt,name=aborted_zeta_amount,json=abortedZetaAmount,proto3" json:"aborted_zeta_amount,omitempty"`
-}
+var xxx_messageInfo_QueryTssFundsMigrationProgressRequest proto.InternalMessageInfo
 
-func (m *QueryZetaAccountingResponse) Reset()         { *m = QueryZetaAccountingResponse{} }
-func (m *QueryZetaAccountingResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryZetaAccountingResponse) ProtoMessage()    {}
-func (*QueryZetaAccountingResponse) Descriptor() ([]byte, []int) {
+// TssFundsMigrationCctx is a cmd cctx of the TSS funds migration of a chain
+type TssFundsMigrationCctx struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// the command of the cctx, e.g. cmd_migrate_tss_funds
+	Command        string                                  `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Status         CctxStatus                              `protobuf:"varint,3,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
+	Nonce          uint64                                  `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	OutboundTxHash string                                  `protobuf:"bytes,6,opt,name=outbound_tx_hash,json=outboundTxHash,proto3" json:"outbound_tx_hash,omitempty"`
+}
+
+func (m *TssFundsMigrationCctx) Reset()         { *m = TssFundsMigrationCctx{} }
+func (m *TssFundsMigrationCctx) String() string { return proto.CompactTextString(m) }
+func (*TssFundsMigrationCctx) ProtoMessage()    {}
+func (*TssFundsMigrationCctx) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{1}
 }
-func (m *QueryZetaAccountingResponse) XXX_Unmarshal(b []byte) error {
+func (m *TssFundsMigrationCctx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZetaAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TssFundsMigrationCctx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZetaAccountingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TssFundsMigrationCctx.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -92,41 +100,73 @@ func (m *QueryZetaAccountingResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryZetaAccountingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZetaAccountingResponse.Merge(m, src)
+func (m *TssFundsMigrationCctx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TssFundsMigrationCctx.Merge(m, src)
 }
-func (m *QueryZetaAccountingResponse) XXX_Size() int {
+func (m *TssFundsMigrationCctx) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZetaAccountingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZetaAccountingResponse.DiscardUnknown(m)
+func (m *TssFundsMigrationCctx) XXX_DiscardUnknown() {
+	xxx_messageInfo_TssFundsMigrationCctx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZetaAccountingResponse proto.InternalMessageInfo
+var xxx_messageInfo_TssFundsMigrationCctx proto.InternalMessageInfo
 
-func (m *QueryZetaAccountingResponse) GetAbortedZetaAmount() string {
+func (m *TssFundsMigrationCctx) GetIndex() string {
 	if m != nil {
-		return m.AbortedZetaAmount
+		return m.Index
 	}
 	return ""
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *TssFundsMigrationCctx) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *TssFundsMigrationCctx) GetStatus() CctxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CctxStatus_PendingInbound
+}
+
+func (m *TssFundsMigrationCctx) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TssFundsMigrationCctx) GetOutboundTxHash() string {
+	if m != nil {
+		return m.OutboundTxHash
+	}
+	return ""
+}
+
+type TssFundsMigrationProgress struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the contract updates first, the funds migration last
+	Cctxs []TssFundsMigrationCctx `protobuf:"bytes,2,rep,name=cctxs,proto3" json:"cctxs"`
+	// all the cctxs of the chain are mined
+	Completed bool `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+}
+
+func (m *TssFundsMigrationProgress) Reset()         { *m = TssFundsMigrationProgress{} }
+func (m *TssFundsMigrationProgress) String() string { return proto.CompactTextString(m) }
+func (*TssFundsMigrationProgress) ProtoMessage()    {}
+func (*TssFundsMigrationProgress) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{2}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *TssFundsMigrationProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TssFundsMigrationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TssFundsMigrationProgress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -136,36 +176,57 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *TssFundsMigrationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TssFundsMigrationProgress.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *TssFundsMigrationProgress) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *TssFundsMigrationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_TssFundsMigrationProgress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TssFundsMigrationProgress proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *TssFundsMigrationProgress) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *TssFundsMigrationProgress) GetCctxs() []TssFundsMigrationCctx {
+	if m != nil {
+		return m.Cctxs
+	}
+	return nil
+}
+
+func (m *TssFundsMigrationProgress) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+type QueryTssFundsMigrationProgressResponse struct {
+	Progress []TssFundsMigrationProgress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress"`
+}
+
+func (m *QueryTssFundsMigrationProgressResponse) Reset() {
+	*m = QueryTssFundsMigrationProgressResponse{}
+}
+func (m *QueryTssFundsMigrationProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTssFundsMigrationProgressResponse) ProtoMessage()    {}
+func (*QueryTssFundsMigrationProgressResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{3}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTssFundsMigrationProgressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTssFundsMigrationProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTssFundsMigrationProgressResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -175,42 +236,40 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryTssFundsMigrationProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTssFundsMigrationProgressResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryTssFundsMigrationProgressResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryTssFundsMigrationProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTssFundsMigrationProgressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTssFundsMigrationProgressResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryTssFundsMigrationProgressResponse) GetProgress() []TssFundsMigrationProgress {
 	if m != nil {
-		return m.Params
+		return m.Progress
 	}
-	return Params{}
+	return nil
 }
 
-type QueryGetOutTxTrackerRequest struct {
-	ChainID int64  `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+type QueryWithdrawalLimitsRequest struct {
 }
 
-func (m *QueryGetOutTxTrackerRequest) Reset()         { *m = QueryGetOutTxTrackerRequest{} }
-func (m *QueryGetOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetOutTxTrackerRequest) ProtoMessage()    {}
-func (*QueryGetOutTxTrackerRequest) Descriptor() ([]byte, []int) {
+func (m *QueryWithdrawalLimitsRequest) Reset()         { *m = QueryWithdrawalLimitsRequest{} }
+func (m *QueryWithdrawalLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalLimitsRequest) ProtoMessage()    {}
+func (*QueryWithdrawalLimitsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{4}
 }
-func (m *QueryGetOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryWithdrawalLimitsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetOutTxTrackerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWithdrawalLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetOutTxTrackerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWithdrawalLimitsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -220,48 +279,34 @@ func (m *QueryGetOutTxTrackerRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetOutTxTrackerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetOutTxTrackerRequest.Merge(m, src)
+func (m *QueryWithdrawalLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalLimitsRequest.Merge(m, src)
 }
-func (m *QueryGetOutTxTrackerRequest) XXX_Size() int {
+func (m *QueryWithdrawalLimitsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetOutTxTrackerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetOutTxTrackerRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryGetOutTxTrackerRequest proto.InternalMessageInfo
-
-func (m *QueryGetOutTxTrackerRequest) GetChainID() int64 {
-	if m != nil {
-		return m.ChainID
-	}
-	return 0
+func (m *QueryWithdrawalLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetOutTxTrackerRequest) GetNonce() uint64 {
-	if m != nil {
-		return m.Nonce
-	}
-	return 0
-}
+var xxx_messageInfo_QueryWithdrawalLimitsRequest proto.InternalMessageInfo
 
-type QueryGetOutTxTrackerResponse struct {
-	OutTxTracker OutTxTracker `protobuf:"bytes,1,opt,name=outTxTracker,proto3" json:"outTxTracker"`
+type QueryWithdrawalLimitsResponse struct {
+	WithdrawalLimits WithdrawalLimits `protobuf:"bytes,1,opt,name=withdrawal_limits,json=withdrawalLimits,proto3" json:"withdrawal_limits"`
 }
 
-func (m *QueryGetOutTxTrackerResponse) Reset()         { *m = QueryGetOutTxTrackerResponse{} }
-func (m *QueryGetOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetOutTxTrackerResponse) ProtoMessage()    {}
-func (*QueryGetOutTxTrackerResponse) Descriptor() ([]byte, []int) {
+func (m *QueryWithdrawalLimitsResponse) Reset()         { *m = QueryWithdrawalLimitsResponse{} }
+func (m *QueryWithdrawalLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalLimitsResponse) ProtoMessage()    {}
+func (*QueryWithdrawalLimitsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{5}
 }
-func (m *QueryGetOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryWithdrawalLimitsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWithdrawalLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetOutTxTrackerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWithdrawalLimitsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -271,41 +316,40 @@ func (m *QueryGetOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetOutTxTrackerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetOutTxTrackerResponse.Merge(m, src)
+func (m *QueryWithdrawalLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalLimitsResponse.Merge(m, src)
 }
-func (m *QueryGetOutTxTrackerResponse) XXX_Size() int {
+func (m *QueryWithdrawalLimitsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetOutTxTrackerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetOutTxTrackerResponse.DiscardUnknown(m)
+func (m *QueryWithdrawalLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalLimitsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetOutTxTrackerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryWithdrawalLimitsResponse proto.InternalMessageInfo
 
-func (m *QueryGetOutTxTrackerResponse) GetOutTxTracker() OutTxTracker {
+func (m *QueryWithdrawalLimitsResponse) GetWithdrawalLimits() WithdrawalLimits {
 	if m != nil {
-		return m.OutTxTracker
+		return m.WithdrawalLimits
 	}
-	return OutTxTracker{}
+	return WithdrawalLimits{}
 }
 
-type QueryAllOutTxTrackerRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryWithdrawalUsageRequest struct {
 }
 
-func (m *QueryAllOutTxTrackerRequest) Reset()         { *m = QueryAllOutTxTrackerRequest{} }
-func (m *QueryAllOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllOutTxTrackerRequest) ProtoMessage()    {}
-func (*QueryAllOutTxTrackerRequest) Descriptor() ([]byte, []int) {
+func (m *QueryWithdrawalUsageRequest) Reset()         { *m = QueryWithdrawalUsageRequest{} }
+func (m *QueryWithdrawalUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalUsageRequest) ProtoMessage()    {}
+func (*QueryWithdrawalUsageRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{6}
 }
-func (m *QueryAllOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryWithdrawalUsageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllOutTxTrackerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWithdrawalUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllOutTxTrackerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWithdrawalUsageRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -315,42 +359,36 @@ func (m *QueryAllOutTxTrackerRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllOutTxTrackerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllOutTxTrackerRequest.Merge(m, src)
+func (m *QueryWithdrawalUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalUsageRequest.Merge(m, src)
 }
-func (m *QueryAllOutTxTrackerRequest) XXX_Size() int {
+func (m *QueryWithdrawalUsageRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllOutTxTrackerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllOutTxTrackerRequest.DiscardUnknown(m)
+func (m *QueryWithdrawalUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalUsageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllOutTxTrackerRequest proto.InternalMessageInfo
-
-func (m *QueryAllOutTxTrackerRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryWithdrawalUsageRequest proto.InternalMessageInfo
 
-type QueryAllOutTxTrackerResponse struct {
-	OutTxTracker []OutTxTracker      `protobuf:"bytes,1,rep,name=outTxTracker,proto3" json:"outTxTracker"`
-	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryWithdrawalUsageResponse struct {
+	Window      int64                  `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Zrc20Usages []ZRC20WithdrawalUsage `protobuf:"bytes,2,rep,name=zrc20_usages,json=zrc20Usages,proto3" json:"zrc20_usages"`
+	ChainUsages []ChainWithdrawalUsage `protobuf:"bytes,3,rep,name=chain_usages,json=chainUsages,proto3" json:"chain_usages"`
 }
 
-func (m *QueryAllOutTxTrackerResponse) Reset()         { *m = QueryAllOutTxTrackerResponse{} }
-func (m *QueryAllOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllOutTxTrackerResponse) ProtoMessage()    {}
-func (*QueryAllOutTxTrackerResponse) Descriptor() ([]byte, []int) {
+func (m *QueryWithdrawalUsageResponse) Reset()         { *m = QueryWithdrawalUsageResponse{} }
+func (m *QueryWithdrawalUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalUsageResponse) ProtoMessage()    {}
+func (*QueryWithdrawalUsageResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{7}
 }
-func (m *QueryAllOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryWithdrawalUsageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWithdrawalUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllOutTxTrackerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWithdrawalUsageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -360,49 +398,55 @@ func (m *QueryAllOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllOutTxTrackerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllOutTxTrackerResponse.Merge(m, src)
+func (m *QueryWithdrawalUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalUsageResponse.Merge(m, src)
 }
-func (m *QueryAllOutTxTrackerResponse) XXX_Size() int {
+func (m *QueryWithdrawalUsageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllOutTxTrackerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllOutTxTrackerResponse.DiscardUnknown(m)
+func (m *QueryWithdrawalUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalUsageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllOutTxTrackerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryWithdrawalUsageResponse proto.InternalMessageInfo
 
-func (m *QueryAllOutTxTrackerResponse) GetOutTxTracker() []OutTxTracker {
+func (m *QueryWithdrawalUsageResponse) GetWindow() int64 {
 	if m != nil {
-		return m.OutTxTracker
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryWithdrawalUsageResponse) GetZrc20Usages() []ZRC20WithdrawalUsage {
+	if m != nil {
+		return m.Zrc20Usages
 	}
 	return nil
 }
 
-func (m *QueryAllOutTxTrackerResponse) GetPagination() *query.PageResponse {
+func (m *QueryWithdrawalUsageResponse) GetChainUsages() []ChainWithdrawalUsage {
 	if m != nil {
-		return m.Pagination
+		return m.ChainUsages
 	}
 	return nil
 }
 
-type QueryAllOutTxTrackerByChainRequest struct {
-	Chain      int64              `protobuf:"varint,1,opt,name=chain,proto3" json:"chain,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllQueuedWithdrawalRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) Reset()         { *m = QueryAllOutTxTrackerByChainRequest{} }
-func (m *QueryAllOutTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllOutTxTrackerByChainRequest) ProtoMessage()    {}
-func (*QueryAllOutTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllQueuedWithdrawalRequest) Reset()         { *m = QueryAllQueuedWithdrawalRequest{} }
+func (m *QueryAllQueuedWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedWithdrawalRequest) ProtoMessage()    {}
+func (*QueryAllQueuedWithdrawalRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{8}
 }
-func (m *QueryAllOutTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllQueuedWithdrawalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllOutTxTrackerByChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllQueuedWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllOutTxTrackerByChainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllQueuedWithdrawalRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -412,49 +456,42 @@ func (m *QueryAllOutTxTrackerByChainRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllOutTxTrackerByChainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllOutTxTrackerByChainRequest.Merge(m, src)
+func (m *QueryAllQueuedWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedWithdrawalRequest.Merge(m, src)
 }
-func (m *QueryAllOutTxTrackerByChainRequest) XXX_Size() int {
+func (m *QueryAllQueuedWithdrawalRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllOutTxTrackerByChainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllOutTxTrackerByChainRequest.DiscardUnknown(m)
+func (m *QueryAllQueuedWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedWithdrawalRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllOutTxTrackerByChainRequest proto.InternalMessageInfo
-
-func (m *QueryAllOutTxTrackerByChainRequest) GetChain() int64 {
-	if m != nil {
-		return m.Chain
-	}
-	return 0
-}
+var xxx_messageInfo_QueryAllQueuedWithdrawalRequest proto.InternalMessageInfo
 
-func (m *QueryAllOutTxTrackerByChainRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllQueuedWithdrawalRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllOutTxTrackerByChainResponse struct {
-	OutTxTracker []OutTxTracker      `protobuf:"bytes,1,rep,name=outTxTracker,proto3" json:"outTxTracker"`
-	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllQueuedWithdrawalResponse struct {
+	QueuedWithdrawals []QueuedWithdrawal  `protobuf:"bytes,1,rep,name=queued_withdrawals,json=queuedWithdrawals,proto3" json:"queued_withdrawals"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) Reset()         { *m = QueryAllOutTxTrackerByChainResponse{} }
-func (m *QueryAllOutTxTrackerByChainResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllOutTxTrackerByChainResponse) ProtoMessage()    {}
-func (*QueryAllOutTxTrackerByChainResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllQueuedWithdrawalResponse) Reset()         { *m = QueryAllQueuedWithdrawalResponse{} }
+func (m *QueryAllQueuedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedWithdrawalResponse) ProtoMessage()    {}
+func (*QueryAllQueuedWithdrawalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{9}
 }
-func (m *QueryAllOutTxTrackerByChainResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllQueuedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllOutTxTrackerByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllQueuedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllOutTxTrackerByChainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllQueuedWithdrawalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -464,49 +501,47 @@ func (m *QueryAllOutTxTrackerByChainResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryAllOutTxTrackerByChainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllOutTxTrackerByChainResponse.Merge(m, src)
+func (m *QueryAllQueuedWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedWithdrawalResponse.Merge(m, src)
 }
-func (m *QueryAllOutTxTrackerByChainResponse) XXX_Size() int {
+func (m *QueryAllQueuedWithdrawalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllOutTxTrackerByChainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllOutTxTrackerByChainResponse.DiscardUnknown(m)
+func (m *QueryAllQueuedWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedWithdrawalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllOutTxTrackerByChainResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllQueuedWithdrawalResponse proto.InternalMessageInfo
 
-func (m *QueryAllOutTxTrackerByChainResponse) GetOutTxTracker() []OutTxTracker {
+func (m *QueryAllQueuedWithdrawalResponse) GetQueuedWithdrawals() []QueuedWithdrawal {
 	if m != nil {
-		return m.OutTxTracker
+		return m.QueuedWithdrawals
 	}
 	return nil
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllQueuedWithdrawalResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllInTxTrackerByChainRequest struct {
-	ChainId    int64              `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryZetaAccountingRequest struct {
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) Reset()         { *m = QueryAllInTxTrackerByChainRequest{} }
-func (m *QueryAllInTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllInTxTrackerByChainRequest) ProtoMessage()    {}
-func (*QueryAllInTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{10}
-}
-func (m *QueryAllInTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryZetaAccountingRequest) Reset()         { *m = QueryZetaAccountingRequest{} }
+func (m *QueryZetaAccountingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryZetaAccountingRequest) ProtoMessage()    {}
+func (*QueryZetaAccountingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{10}
+}
+func (m *QueryZetaAccountingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllInTxTrackerByChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryZetaAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllInTxTrackerByChainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryZetaAccountingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -516,49 +551,34 @@ func (m *QueryAllInTxTrackerByChainRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllInTxTrackerByChainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllInTxTrackerByChainRequest.Merge(m, src)
+func (m *QueryZetaAccountingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZetaAccountingRequest.Merge(m, src)
 }
-func (m *QueryAllInTxTrackerByChainRequest) XXX_Size() int {
+func (m *QueryZetaAccountingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllInTxTrackerByChainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllInTxTrackerByChainRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryAllInTxTrackerByChainRequest proto.InternalMessageInfo
-
-func (m *QueryAllInTxTrackerByChainRequest) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
+func (m *QueryZetaAccountingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZetaAccountingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryZetaAccountingRequest proto.InternalMessageInfo
 
-type QueryAllInTxTrackerByChainResponse struct {
-	InTxTracker []InTxTracker       `protobuf:"bytes,1,rep,name=inTxTracker,proto3" json:"inTxTracker"`
-	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryZetaAccountingResponse struct {
+	AbortedZetaAmount string `protobuf:"bytes,1,opt,name=aborted_zeta_amount,json=abortedZetaAmount,proto3" json:"aborted_zeta_amount,omitempty"`
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) Reset()         { *m = QueryAllInTxTrackerByChainResponse{} }
-func (m *QueryAllInTxTrackerByChainResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllInTxTrackerByChainResponse) ProtoMessage()    {}
-func (*QueryAllInTxTrackerByChainResponse) Descriptor() ([]byte, []int) {
+func (m *QueryZetaAccountingResponse) Reset()         { *m = QueryZetaAccountingResponse{} }
+func (m *QueryZetaAccountingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryZetaAccountingResponse) ProtoMessage()    {}
+func (*QueryZetaAccountingResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{11}
 }
-func (m *QueryAllInTxTrackerByChainResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryZetaAccountingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllInTxTrackerByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryZetaAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllInTxTrackerByChainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryZetaAccountingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -568,48 +588,41 @@ func (m *QueryAllInTxTrackerByChainResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllInTxTrackerByChainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllInTxTrackerByChainResponse.Merge(m, src)
+func (m *QueryZetaAccountingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZetaAccountingResponse.Merge(m, src)
 }
-func (m *QueryAllInTxTrackerByChainResponse) XXX_Size() int {
+func (m *QueryZetaAccountingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllInTxTrackerByChainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllInTxTrackerByChainResponse.DiscardUnknown(m)
+func (m *QueryZetaAccountingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZetaAccountingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllInTxTrackerByChainResponse proto.InternalMessageInfo
-
-func (m *QueryAllInTxTrackerByChainResponse) GetInTxTracker() []InTxTracker {
-	if m != nil {
-		return m.InTxTracker
-	}
-	return nil
-}
+var xxx_messageInfo_QueryZetaAccountingResponse proto.InternalMessageInfo
 
-func (m *QueryAllInTxTrackerByChainResponse) GetPagination() *query.PageResponse {
+func (m *QueryZetaAccountingResponse) GetAbortedZetaAmount() string {
 	if m != nil {
-		return m.Pagination
+		return m.AbortedZetaAmount
 	}
-	return nil
+	return ""
 }
 
-type QueryAllInTxTrackersRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryAllInTxTrackersRequest) Reset()         { *m = QueryAllInTxTrackersRequest{} }
-func (m *QueryAllInTxTrackersRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllInTxTrackersRequest) ProtoMessage()    {}
-func (*QueryAllInTxTrackersRequest) Descriptor() ([]byte, []int) {
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{12}
 }
-func (m *QueryAllInTxTrackersRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllInTxTrackersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllInTxTrackersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -619,42 +632,36 @@ func (m *QueryAllInTxTrackersRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllInTxTrackersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllInTxTrackersRequest.Merge(m, src)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-func (m *QueryAllInTxTrackersRequest) XXX_Size() int {
+func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllInTxTrackersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllInTxTrackersRequest.DiscardUnknown(m)
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllInTxTrackersRequest proto.InternalMessageInfo
-
-func (m *QueryAllInTxTrackersRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-type QueryAllInTxTrackersResponse struct {
-	InTxTracker []InTxTracker       `protobuf:"bytes,1,rep,name=inTxTracker,proto3" json:"inTxTracker"`
-	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryAllInTxTrackersResponse) Reset()         { *m = QueryAllInTxTrackersResponse{} }
-func (m *QueryAllInTxTrackersResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllInTxTrackersResponse) ProtoMessage()    {}
-func (*QueryAllInTxTrackersResponse) Descriptor() ([]byte, []int) {
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{13}
 }
-func (m *QueryAllInTxTrackersResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllInTxTrackersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllInTxTrackersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -664,48 +671,42 @@ func (m *QueryAllInTxTrackersResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllInTxTrackersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllInTxTrackersResponse.Merge(m, src)
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-func (m *QueryAllInTxTrackersResponse) XXX_Size() int {
+func (m *QueryParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllInTxTrackersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllInTxTrackersResponse.DiscardUnknown(m)
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllInTxTrackersResponse proto.InternalMessageInfo
-
-func (m *QueryAllInTxTrackersResponse) GetInTxTracker() []InTxTracker {
-	if m != nil {
-		return m.InTxTracker
-	}
-	return nil
-}
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
-func (m *QueryAllInTxTrackersResponse) GetPagination() *query.PageResponse {
+func (m *QueryParamsResponse) GetParams() Params {
 	if m != nil {
-		return m.Pagination
+		return m.Params
 	}
-	return nil
+	return Params{}
 }
 
-type QueryGetInTxHashToCctxRequest struct {
-	InTxHash string `protobuf:"bytes,1,opt,name=inTxHash,proto3" json:"inTxHash,omitempty"`
+type QueryGetOutTxTrackerRequest struct {
+	ChainID int64  `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryGetInTxHashToCctxRequest) Reset()         { *m = QueryGetInTxHashToCctxRequest{} }
-func (m *QueryGetInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetInTxHashToCctxRequest) ProtoMessage()    {}
-func (*QueryGetInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetOutTxTrackerRequest) Reset()         { *m = QueryGetOutTxTrackerRequest{} }
+func (m *QueryGetOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutTxTrackerRequest) ProtoMessage()    {}
+func (*QueryGetOutTxTrackerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{14}
 }
-func (m *QueryGetInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetInTxHashToCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetOutTxTrackerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetInTxHashToCctxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetOutTxTrackerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -715,41 +716,48 @@ func (m *QueryGetInTxHashToCctxRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryGetInTxHashToCctxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetInTxHashToCctxRequest.Merge(m, src)
+func (m *QueryGetOutTxTrackerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutTxTrackerRequest.Merge(m, src)
 }
-func (m *QueryGetInTxHashToCctxRequest) XXX_Size() int {
+func (m *QueryGetOutTxTrackerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetInTxHashToCctxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetInTxHashToCctxRequest.DiscardUnknown(m)
+func (m *QueryGetOutTxTrackerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutTxTrackerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetInTxHashToCctxRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetOutTxTrackerRequest proto.InternalMessageInfo
 
-func (m *QueryGetInTxHashToCctxRequest) GetInTxHash() string {
+func (m *QueryGetOutTxTrackerRequest) GetChainID() int64 {
 	if m != nil {
-		return m.InTxHash
+		return m.ChainID
 	}
-	return ""
+	return 0
 }
 
-type QueryGetInTxHashToCctxResponse struct {
-	InTxHashToCctx InTxHashToCctx `protobuf:"bytes,1,opt,name=inTxHashToCctx,proto3" json:"inTxHashToCctx"`
+func (m *QueryGetOutTxTrackerRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *QueryGetInTxHashToCctxResponse) Reset()         { *m = QueryGetInTxHashToCctxResponse{} }
-func (m *QueryGetInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetInTxHashToCctxResponse) ProtoMessage()    {}
-func (*QueryGetInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
+type QueryGetOutTxTrackerResponse struct {
+	OutTxTracker OutTxTracker `protobuf:"bytes,1,opt,name=outTxTracker,proto3" json:"outTxTracker"`
+}
+
+func (m *QueryGetOutTxTrackerResponse) Reset()         { *m = QueryGetOutTxTrackerResponse{} }
+func (m *QueryGetOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutTxTrackerResponse) ProtoMessage()    {}
+func (*QueryGetOutTxTrackerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{15}
 }
-func (m *QueryGetInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetInTxHashToCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetInTxHashToCctxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetOutTxTrackerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -759,41 +767,41 @@ func (m *QueryGetInTxHashToCctxResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetInTxHashToCctxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetInTxHashToCctxResponse.Merge(m, src)
+func (m *QueryGetOutTxTrackerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutTxTrackerResponse.Merge(m, src)
 }
-func (m *QueryGetInTxHashToCctxResponse) XXX_Size() int {
+func (m *QueryGetOutTxTrackerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetInTxHashToCctxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetInTxHashToCctxResponse.DiscardUnknown(m)
+func (m *QueryGetOutTxTrackerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutTxTrackerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetInTxHashToCctxResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetOutTxTrackerResponse proto.InternalMessageInfo
 
-func (m *QueryGetInTxHashToCctxResponse) GetInTxHashToCctx() InTxHashToCctx {
+func (m *QueryGetOutTxTrackerResponse) GetOutTxTracker() OutTxTracker {
 	if m != nil {
-		return m.InTxHashToCctx
+		return m.OutTxTracker
 	}
-	return InTxHashToCctx{}
+	return OutTxTracker{}
 }
 
-type QueryInTxHashToCctxDataRequest struct {
-	InTxHash string `protobuf:"bytes,1,opt,name=inTxHash,proto3" json:"inTxHash,omitempty"`
+type QueryAllOutTxTrackerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryInTxHashToCctxDataRequest) Reset()         { *m = QueryInTxHashToCctxDataRequest{} }
-func (m *QueryInTxHashToCctxDataRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryInTxHashToCctxDataRequest) ProtoMessage()    {}
-func (*QueryInTxHashToCctxDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{16}
-}
-func (m *QueryInTxHashToCctxDataRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllOutTxTrackerRequest) Reset()         { *m = QueryAllOutTxTrackerRequest{} }
+func (m *QueryAllOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutTxTrackerRequest) ProtoMessage()    {}
+func (*QueryAllOutTxTrackerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{16}
+}
+func (m *QueryAllOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryInTxHashToCctxDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllOutTxTrackerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryInTxHashToCctxDataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllOutTxTrackerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -803,41 +811,42 @@ func (m *QueryInTxHashToCctxDataRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryInTxHashToCctxDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryInTxHashToCctxDataRequest.Merge(m, src)
+func (m *QueryAllOutTxTrackerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutTxTrackerRequest.Merge(m, src)
 }
-func (m *QueryInTxHashToCctxDataRequest) XXX_Size() int {
+func (m *QueryAllOutTxTrackerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryInTxHashToCctxDataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryInTxHashToCctxDataRequest.DiscardUnknown(m)
+func (m *QueryAllOutTxTrackerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutTxTrackerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryInTxHashToCctxDataRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllOutTxTrackerRequest proto.InternalMessageInfo
 
-func (m *QueryInTxHashToCctxDataRequest) GetInTxHash() string {
+func (m *QueryAllOutTxTrackerRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.InTxHash
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryInTxHashToCctxDataResponse struct {
-	CrossChainTxs []CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs"`
+type QueryAllOutTxTrackerResponse struct {
+	OutTxTracker []OutTxTracker      `protobuf:"bytes,1,rep,name=outTxTracker,proto3" json:"outTxTracker"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryInTxHashToCctxDataResponse) Reset()         { *m = QueryInTxHashToCctxDataResponse{} }
-func (m *QueryInTxHashToCctxDataResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryInTxHashToCctxDataResponse) ProtoMessage()    {}
-func (*QueryInTxHashToCctxDataResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllOutTxTrackerResponse) Reset()         { *m = QueryAllOutTxTrackerResponse{} }
+func (m *QueryAllOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutTxTrackerResponse) ProtoMessage()    {}
+func (*QueryAllOutTxTrackerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{17}
 }
-func (m *QueryInTxHashToCctxDataResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryInTxHashToCctxDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryInTxHashToCctxDataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllOutTxTrackerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -847,41 +856,49 @@ func (m *QueryInTxHashToCctxDataResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryInTxHashToCctxDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryInTxHashToCctxDataResponse.Merge(m, src)
+func (m *QueryAllOutTxTrackerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutTxTrackerResponse.Merge(m, src)
 }
-func (m *QueryInTxHashToCctxDataResponse) XXX_Size() int {
+func (m *QueryAllOutTxTrackerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryInTxHashToCctxDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryInTxHashToCctxDataResponse.DiscardUnknown(m)
+func (m *QueryAllOutTxTrackerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutTxTrackerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryInTxHashToCctxDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllOutTxTrackerResponse proto.InternalMessageInfo
 
-func (m *QueryInTxHashToCctxDataResponse) GetCrossChainTxs() []CrossChainTx {
+func (m *QueryAllOutTxTrackerResponse) GetOutTxTracker() []OutTxTracker {
 	if m != nil {
-		return m.CrossChainTxs
+		return m.OutTxTracker
 	}
 	return nil
 }
 
-type QueryAllInTxHashToCctxRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryAllOutTxTrackerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllInTxHashToCctxRequest) Reset()         { *m = QueryAllInTxHashToCctxRequest{} }
-func (m *QueryAllInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllInTxHashToCctxRequest) ProtoMessage()    {}
-func (*QueryAllInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
+type QueryAllOutTxTrackerByChainRequest struct {
+	Chain      int64              `protobuf:"varint,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllOutTxTrackerByChainRequest) Reset()         { *m = QueryAllOutTxTrackerByChainRequest{} }
+func (m *QueryAllOutTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutTxTrackerByChainRequest) ProtoMessage()    {}
+func (*QueryAllOutTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{18}
 }
-func (m *QueryAllInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllOutTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllInTxHashToCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllOutTxTrackerByChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllInTxHashToCctxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllOutTxTrackerByChainRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -891,42 +908,49 @@ func (m *QueryAllInTxHashToCctxRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAllInTxHashToCctxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllInTxHashToCctxRequest.Merge(m, src)
+func (m *QueryAllOutTxTrackerByChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutTxTrackerByChainRequest.Merge(m, src)
 }
-func (m *QueryAllInTxHashToCctxRequest) XXX_Size() int {
+func (m *QueryAllOutTxTrackerByChainRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllInTxHashToCctxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllInTxHashToCctxRequest.DiscardUnknown(m)
+func (m *QueryAllOutTxTrackerByChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutTxTrackerByChainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllInTxHashToCctxRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllOutTxTrackerByChainRequest proto.InternalMessageInfo
 
-func (m *QueryAllInTxHashToCctxRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllOutTxTrackerByChainRequest) GetChain() int64 {
+	if m != nil {
+		return m.Chain
+	}
+	return 0
+}
+
+func (m *QueryAllOutTxTrackerByChainRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllInTxHashToCctxResponse struct {
-	InTxHashToCctx []InTxHashToCctx    `protobuf:"bytes,1,rep,name=inTxHashToCctx,proto3" json:"inTxHashToCctx"`
-	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllOutTxTrackerByChainResponse struct {
+	OutTxTracker []OutTxTracker      `protobuf:"bytes,1,rep,name=outTxTracker,proto3" json:"outTxTracker"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllInTxHashToCctxResponse) Reset()         { *m = QueryAllInTxHashToCctxResponse{} }
-func (m *QueryAllInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllInTxHashToCctxResponse) ProtoMessage()    {}
-func (*QueryAllInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllOutTxTrackerByChainResponse) Reset()         { *m = QueryAllOutTxTrackerByChainResponse{} }
+func (m *QueryAllOutTxTrackerByChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutTxTrackerByChainResponse) ProtoMessage()    {}
+func (*QueryAllOutTxTrackerByChainResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{19}
 }
-func (m *QueryAllInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllOutTxTrackerByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllInTxHashToCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllOutTxTrackerByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllInTxHashToCctxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllOutTxTrackerByChainResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -936,48 +960,49 @@ func (m *QueryAllInTxHashToCctxResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAllInTxHashToCctxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllInTxHashToCctxResponse.Merge(m, src)
+func (m *QueryAllOutTxTrackerByChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutTxTrackerByChainResponse.Merge(m, src)
 }
-func (m *QueryAllInTxHashToCctxResponse) XXX_Size() int {
+func (m *QueryAllOutTxTrackerByChainResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllInTxHashToCctxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllInTxHashToCctxResponse.DiscardUnknown(m)
+func (m *QueryAllOutTxTrackerByChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutTxTrackerByChainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllInTxHashToCctxResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllOutTxTrackerByChainResponse proto.InternalMessageInfo
 
-func (m *QueryAllInTxHashToCctxResponse) GetInTxHashToCctx() []InTxHashToCctx {
+func (m *QueryAllOutTxTrackerByChainResponse) GetOutTxTracker() []OutTxTracker {
 	if m != nil {
-		return m.InTxHashToCctx
+		return m.OutTxTracker
 	}
 	return nil
 }
 
-func (m *QueryAllInTxHashToCctxResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllOutTxTrackerByChainResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetGasPriceRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+type QueryAllInTxTrackerByChainRequest struct {
+	ChainId    int64              `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetGasPriceRequest) Reset()         { *m = QueryGetGasPriceRequest{} }
-func (m *QueryGetGasPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetGasPriceRequest) ProtoMessage()    {}
-func (*QueryGetGasPriceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllInTxTrackerByChainRequest) Reset()         { *m = QueryAllInTxTrackerByChainRequest{} }
+func (m *QueryAllInTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInTxTrackerByChainRequest) ProtoMessage()    {}
+func (*QueryAllInTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{20}
 }
-func (m *QueryGetGasPriceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllInTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllInTxTrackerByChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetGasPriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllInTxTrackerByChainRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -987,41 +1012,49 @@ func (m *QueryGetGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetGasPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetGasPriceRequest.Merge(m, src)
+func (m *QueryAllInTxTrackerByChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInTxTrackerByChainRequest.Merge(m, src)
 }
-func (m *QueryGetGasPriceRequest) XXX_Size() int {
+func (m *QueryAllInTxTrackerByChainRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetGasPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetGasPriceRequest.DiscardUnknown(m)
+func (m *QueryAllInTxTrackerByChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInTxTrackerByChainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetGasPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllInTxTrackerByChainRequest proto.InternalMessageInfo
 
-func (m *QueryGetGasPriceRequest) GetIndex() string {
+func (m *QueryAllInTxTrackerByChainRequest) GetChainId() int64 {
 	if m != nil {
-		return m.Index
+		return m.ChainId
 	}
-	return ""
+	return 0
 }
 
-type QueryGetGasPriceResponse struct {
-	GasPrice *GasPrice `protobuf:"bytes,1,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+func (m *QueryAllInTxTrackerByChainRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetGasPriceResponse) Reset()         { *m = QueryGetGasPriceResponse{} }
-func (m *QueryGetGasPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetGasPriceResponse) ProtoMessage()    {}
-func (*QueryGetGasPriceResponse) Descriptor() ([]byte, []int) {
+type QueryAllInTxTrackerByChainResponse struct {
+	InTxTracker []InTxTracker       `protobuf:"bytes,1,rep,name=inTxTracker,proto3" json:"inTxTracker"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllInTxTrackerByChainResponse) Reset()         { *m = QueryAllInTxTrackerByChainResponse{} }
+func (m *QueryAllInTxTrackerByChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInTxTrackerByChainResponse) ProtoMessage()    {}
+func (*QueryAllInTxTrackerByChainResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{21}
 }
-func (m *QueryGetGasPriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllInTxTrackerByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllInTxTrackerByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetGasPriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllInTxTrackerByChainResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1031,41 +1064,48 @@ func (m *QueryGetGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryGetGasPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetGasPriceResponse.Merge(m, src)
+func (m *QueryAllInTxTrackerByChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInTxTrackerByChainResponse.Merge(m, src)
 }
-func (m *QueryGetGasPriceResponse) XXX_Size() int {
+func (m *QueryAllInTxTrackerByChainResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetGasPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetGasPriceResponse.DiscardUnknown(m)
+func (m *QueryAllInTxTrackerByChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInTxTrackerByChainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetGasPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllInTxTrackerByChainResponse proto.InternalMessageInfo
 
-func (m *QueryGetGasPriceResponse) GetGasPrice() *GasPrice {
+func (m *QueryAllInTxTrackerByChainResponse) GetInTxTracker() []InTxTracker {
 	if m != nil {
-		return m.GasPrice
+		return m.InTxTracker
 	}
 	return nil
 }
 
-type QueryAllGasPriceRequest struct {
+func (m *QueryAllInTxTrackerByChainResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllInTxTrackersRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllGasPriceRequest) Reset()         { *m = QueryAllGasPriceRequest{} }
-func (m *QueryAllGasPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllGasPriceRequest) ProtoMessage()    {}
-func (*QueryAllGasPriceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllInTxTrackersRequest) Reset()         { *m = QueryAllInTxTrackersRequest{} }
+func (m *QueryAllInTxTrackersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInTxTrackersRequest) ProtoMessage()    {}
+func (*QueryAllInTxTrackersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{22}
 }
-func (m *QueryAllGasPriceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllInTxTrackersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllInTxTrackersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllGasPriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllInTxTrackersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1075,42 +1115,42 @@ func (m *QueryAllGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllGasPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllGasPriceRequest.Merge(m, src)
+func (m *QueryAllInTxTrackersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInTxTrackersRequest.Merge(m, src)
 }
-func (m *QueryAllGasPriceRequest) XXX_Size() int {
+func (m *QueryAllInTxTrackersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllGasPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllGasPriceRequest.DiscardUnknown(m)
+func (m *QueryAllInTxTrackersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInTxTrackersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllGasPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllInTxTrackersRequest proto.InternalMessageInfo
 
-func (m *QueryAllGasPriceRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllInTxTrackersRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllGasPriceResponse struct {
-	GasPrice   []*GasPrice         `protobuf:"bytes,1,rep,name=GasPrice,proto3" json:"GasPrice,omitempty"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllInTxTrackersResponse struct {
+	InTxTracker []InTxTracker       `protobuf:"bytes,1,rep,name=inTxTracker,proto3" json:"inTxTracker"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllGasPriceResponse) Reset()         { *m = QueryAllGasPriceResponse{} }
-func (m *QueryAllGasPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllGasPriceResponse) ProtoMessage()    {}
-func (*QueryAllGasPriceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllInTxTrackersResponse) Reset()         { *m = QueryAllInTxTrackersResponse{} }
+func (m *QueryAllInTxTrackersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInTxTrackersResponse) ProtoMessage()    {}
+func (*QueryAllInTxTrackersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{23}
 }
-func (m *QueryAllGasPriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllInTxTrackersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllInTxTrackersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllGasPriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllInTxTrackersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1120,48 +1160,48 @@ func (m *QueryAllGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryAllGasPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllGasPriceResponse.Merge(m, src)
+func (m *QueryAllInTxTrackersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInTxTrackersResponse.Merge(m, src)
 }
-func (m *QueryAllGasPriceResponse) XXX_Size() int {
+func (m *QueryAllInTxTrackersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllGasPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllGasPriceResponse.DiscardUnknown(m)
+func (m *QueryAllInTxTrackersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInTxTrackersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllGasPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllInTxTrackersResponse proto.InternalMessageInfo
 
-func (m *QueryAllGasPriceResponse) GetGasPrice() []*GasPrice {
+func (m *QueryAllInTxTrackersResponse) GetInTxTracker() []InTxTracker {
 	if m != nil {
-		return m.GasPrice
+		return m.InTxTracker
 	}
 	return nil
 }
 
-func (m *QueryAllGasPriceResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllInTxTrackersResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetLastBlockHeightRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+type QueryGetInTxHashToCctxRequest struct {
+	InTxHash string `protobuf:"bytes,1,opt,name=inTxHash,proto3" json:"inTxHash,omitempty"`
 }
 
-func (m *QueryGetLastBlockHeightRequest) Reset()         { *m = QueryGetLastBlockHeightRequest{} }
-func (m *QueryGetLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetLastBlockHeightRequest) ProtoMessage()    {}
-func (*QueryGetLastBlockHeightRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetInTxHashToCctxRequest) Reset()         { *m = QueryGetInTxHashToCctxRequest{} }
+func (m *QueryGetInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetInTxHashToCctxRequest) ProtoMessage()    {}
+func (*QueryGetInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{24}
 }
-func (m *QueryGetLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetLastBlockHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetInTxHashToCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetLastBlockHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetInTxHashToCctxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1171,41 +1211,41 @@ func (m *QueryGetLastBlockHeightRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetLastBlockHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetLastBlockHeightRequest.Merge(m, src)
+func (m *QueryGetInTxHashToCctxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetInTxHashToCctxRequest.Merge(m, src)
 }
-func (m *QueryGetLastBlockHeightRequest) XXX_Size() int {
+func (m *QueryGetInTxHashToCctxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetLastBlockHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetLastBlockHeightRequest.DiscardUnknown(m)
+func (m *QueryGetInTxHashToCctxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetInTxHashToCctxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetLastBlockHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetInTxHashToCctxRequest proto.InternalMessageInfo
 
-func (m *QueryGetLastBlockHeightRequest) GetIndex() string {
+func (m *QueryGetInTxHashToCctxRequest) GetInTxHash() string {
 	if m != nil {
-		return m.Index
+		return m.InTxHash
 	}
 	return ""
 }
 
-type QueryGetLastBlockHeightResponse struct {
-	LastBlockHeight *LastBlockHeight `protobuf:"bytes,1,opt,name=LastBlockHeight,proto3" json:"LastBlockHeight,omitempty"`
+type QueryGetInTxHashToCctxResponse struct {
+	InTxHashToCctx InTxHashToCctx `protobuf:"bytes,1,opt,name=inTxHashToCctx,proto3" json:"inTxHashToCctx"`
 }
 
-func (m *QueryGetLastBlockHeightResponse) Reset()         { *m = QueryGetLastBlockHeightResponse{} }
-func (m *QueryGetLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetLastBlockHeightResponse) ProtoMessage()    {}
-func (*QueryGetLastBlockHeightResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetInTxHashToCctxResponse) Reset()         { *m = QueryGetInTxHashToCctxResponse{} }
+func (m *QueryGetInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetInTxHashToCctxResponse) ProtoMessage()    {}
+func (*QueryGetInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{25}
 }
-func (m *QueryGetLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetLastBlockHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetInTxHashToCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetLastBlockHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetInTxHashToCctxResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1215,41 +1255,41 @@ func (m *QueryGetLastBlockHeightResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetLastBlockHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetLastBlockHeightResponse.Merge(m, src)
+func (m *QueryGetInTxHashToCctxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetInTxHashToCctxResponse.Merge(m, src)
 }
-func (m *QueryGetLastBlockHeightResponse) XXX_Size() int {
+func (m *QueryGetInTxHashToCctxResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetLastBlockHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetLastBlockHeightResponse.DiscardUnknown(m)
+func (m *QueryGetInTxHashToCctxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetInTxHashToCctxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetLastBlockHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetInTxHashToCctxResponse proto.InternalMessageInfo
 
-func (m *QueryGetLastBlockHeightResponse) GetLastBlockHeight() *LastBlockHeight {
+func (m *QueryGetInTxHashToCctxResponse) GetInTxHashToCctx() InTxHashToCctx {
 	if m != nil {
-		return m.LastBlockHeight
+		return m.InTxHashToCctx
 	}
-	return nil
+	return InTxHashToCctx{}
 }
 
-type QueryAllLastBlockHeightRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryInTxHashToCctxDataRequest struct {
+	InTxHash string `protobuf:"bytes,1,opt,name=inTxHash,proto3" json:"inTxHash,omitempty"`
 }
 
-func (m *QueryAllLastBlockHeightRequest) Reset()         { *m = QueryAllLastBlockHeightRequest{} }
-func (m *QueryAllLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllLastBlockHeightRequest) ProtoMessage()    {}
-func (*QueryAllLastBlockHeightRequest) Descriptor() ([]byte, []int) {
+func (m *QueryInTxHashToCctxDataRequest) Reset()         { *m = QueryInTxHashToCctxDataRequest{} }
+func (m *QueryInTxHashToCctxDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInTxHashToCctxDataRequest) ProtoMessage()    {}
+func (*QueryInTxHashToCctxDataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{26}
 }
-func (m *QueryAllLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryInTxHashToCctxDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllLastBlockHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryInTxHashToCctxDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllLastBlockHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryInTxHashToCctxDataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1259,42 +1299,41 @@ func (m *QueryAllLastBlockHeightRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAllLastBlockHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllLastBlockHeightRequest.Merge(m, src)
+func (m *QueryInTxHashToCctxDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInTxHashToCctxDataRequest.Merge(m, src)
 }
-func (m *QueryAllLastBlockHeightRequest) XXX_Size() int {
+func (m *QueryInTxHashToCctxDataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllLastBlockHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllLastBlockHeightRequest.DiscardUnknown(m)
+func (m *QueryInTxHashToCctxDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInTxHashToCctxDataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllLastBlockHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryInTxHashToCctxDataRequest proto.InternalMessageInfo
 
-func (m *QueryAllLastBlockHeightRequest) GetPagination() *query.PageRequest {
+func (m *QueryInTxHashToCctxDataRequest) GetInTxHash() string {
 	if m != nil {
-		return m.Pagination
+		return m.InTxHash
 	}
-	return nil
+	return ""
 }
 
-type QueryAllLastBlockHeightResponse struct {
-	LastBlockHeight []*LastBlockHeight  `protobuf:"bytes,1,rep,name=LastBlockHeight,proto3" json:"LastBlockHeight,omitempty"`
-	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryInTxHashToCctxDataResponse struct {
+	CrossChainTxs []CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs"`
 }
 
-func (m *QueryAllLastBlockHeightResponse) Reset()         { *m = QueryAllLastBlockHeightResponse{} }
-func (m *QueryAllLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllLastBlockHeightResponse) ProtoMessage()    {}
-func (*QueryAllLastBlockHeightResponse) Descriptor() ([]byte, []int) {
+func (m *QueryInTxHashToCctxDataResponse) Reset()         { *m = QueryInTxHashToCctxDataResponse{} }
+func (m *QueryInTxHashToCctxDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInTxHashToCctxDataResponse) ProtoMessage()    {}
+func (*QueryInTxHashToCctxDataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{27}
 }
-func (m *QueryAllLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryInTxHashToCctxDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllLastBlockHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryInTxHashToCctxDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllLastBlockHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryInTxHashToCctxDataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1304,48 +1343,41 @@ func (m *QueryAllLastBlockHeightResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryAllLastBlockHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllLastBlockHeightResponse.Merge(m, src)
+func (m *QueryInTxHashToCctxDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInTxHashToCctxDataResponse.Merge(m, src)
 }
-func (m *QueryAllLastBlockHeightResponse) XXX_Size() int {
+func (m *QueryInTxHashToCctxDataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllLastBlockHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllLastBlockHeightResponse.DiscardUnknown(m)
+func (m *QueryInTxHashToCctxDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInTxHashToCctxDataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllLastBlockHeightResponse proto.InternalMessageInfo
-
-func (m *QueryAllLastBlockHeightResponse) GetLastBlockHeight() []*LastBlockHeight {
-	if m != nil {
-		return m.LastBlockHeight
-	}
-	return nil
-}
+var xxx_messageInfo_QueryInTxHashToCctxDataResponse proto.InternalMessageInfo
 
-func (m *QueryAllLastBlockHeightResponse) GetPagination() *query.PageResponse {
+func (m *QueryInTxHashToCctxDataResponse) GetCrossChainTxs() []CrossChainTx {
 	if m != nil {
-		return m.Pagination
+		return m.CrossChainTxs
 	}
 	return nil
 }
 
-type QueryGetCctxRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+type QueryAllInTxHashToCctxRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetCctxRequest) Reset()         { *m = QueryGetCctxRequest{} }
-func (m *QueryGetCctxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCctxRequest) ProtoMessage()    {}
-func (*QueryGetCctxRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllInTxHashToCctxRequest) Reset()         { *m = QueryAllInTxHashToCctxRequest{} }
+func (m *QueryAllInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInTxHashToCctxRequest) ProtoMessage()    {}
+func (*QueryAllInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{28}
 }
-func (m *QueryGetCctxRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllInTxHashToCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCctxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllInTxHashToCctxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1355,42 +1387,42 @@ func (m *QueryGetCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCctxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCctxRequest.Merge(m, src)
+func (m *QueryAllInTxHashToCctxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInTxHashToCctxRequest.Merge(m, src)
 }
-func (m *QueryGetCctxRequest) XXX_Size() int {
+func (m *QueryAllInTxHashToCctxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCctxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCctxRequest.DiscardUnknown(m)
+func (m *QueryAllInTxHashToCctxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInTxHashToCctxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCctxRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllInTxHashToCctxRequest proto.InternalMessageInfo
 
-func (m *QueryGetCctxRequest) GetIndex() string {
+func (m *QueryAllInTxHashToCctxRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Index
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryGetCctxByNonceRequest struct {
-	ChainID int64  `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+type QueryAllInTxHashToCctxResponse struct {
+	InTxHashToCctx []InTxHashToCctx    `protobuf:"bytes,1,rep,name=inTxHashToCctx,proto3" json:"inTxHashToCctx"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetCctxByNonceRequest) Reset()         { *m = QueryGetCctxByNonceRequest{} }
-func (m *QueryGetCctxByNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCctxByNonceRequest) ProtoMessage()    {}
-func (*QueryGetCctxByNonceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllInTxHashToCctxResponse) Reset()         { *m = QueryAllInTxHashToCctxResponse{} }
+func (m *QueryAllInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInTxHashToCctxResponse) ProtoMessage()    {}
+func (*QueryAllInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{29}
 }
-func (m *QueryGetCctxByNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCctxByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllInTxHashToCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCctxByNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllInTxHashToCctxResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1400,48 +1432,48 @@ func (m *QueryGetCctxByNonceRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCctxByNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCctxByNonceRequest.Merge(m, src)
+func (m *QueryAllInTxHashToCctxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInTxHashToCctxResponse.Merge(m, src)
 }
-func (m *QueryGetCctxByNonceRequest) XXX_Size() int {
+func (m *QueryAllInTxHashToCctxResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCctxByNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCctxByNonceRequest.DiscardUnknown(m)
+func (m *QueryAllInTxHashToCctxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInTxHashToCctxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCctxByNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllInTxHashToCctxResponse proto.InternalMessageInfo
 
-func (m *QueryGetCctxByNonceRequest) GetChainID() int64 {
+func (m *QueryAllInTxHashToCctxResponse) GetInTxHashToCctx() []InTxHashToCctx {
 	if m != nil {
-		return m.ChainID
+		return m.InTxHashToCctx
 	}
-	return 0
+	return nil
 }
 
-func (m *QueryGetCctxByNonceRequest) GetNonce() uint64 {
+func (m *QueryAllInTxHashToCctxResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Nonce
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-type QueryGetCctxResponse struct {
-	CrossChainTx *CrossChainTx `protobuf:"bytes,1,opt,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+type QueryGetGasPriceRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryGetCctxResponse) Reset()         { *m = QueryGetCctxResponse{} }
-func (m *QueryGetCctxResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCctxResponse) ProtoMessage()    {}
-func (*QueryGetCctxResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetGasPriceRequest) Reset()         { *m = QueryGetGasPriceRequest{} }
+func (m *QueryGetGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGasPriceRequest) ProtoMessage()    {}
+func (*QueryGetGasPriceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{30}
 }
-func (m *QueryGetCctxResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCctxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetGasPriceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1451,41 +1483,41 @@ func (m *QueryGetCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCctxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCctxResponse.Merge(m, src)
+func (m *QueryGetGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGasPriceRequest.Merge(m, src)
 }
-func (m *QueryGetCctxResponse) XXX_Size() int {
+func (m *QueryGetGasPriceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCctxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCctxResponse.DiscardUnknown(m)
+func (m *QueryGetGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGasPriceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCctxResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetGasPriceRequest proto.InternalMessageInfo
 
-func (m *QueryGetCctxResponse) GetCrossChainTx() *CrossChainTx {
+func (m *QueryGetGasPriceRequest) GetIndex() string {
 	if m != nil {
-		return m.CrossChainTx
+		return m.Index
 	}
-	return nil
+	return ""
 }
 
-type QueryAllCctxRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetGasPriceResponse struct {
+	GasPrice *GasPrice `protobuf:"bytes,1,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
 }
 
-func (m *QueryAllCctxRequest) Reset()         { *m = QueryAllCctxRequest{} }
-func (m *QueryAllCctxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllCctxRequest) ProtoMessage()    {}
-func (*QueryAllCctxRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetGasPriceResponse) Reset()         { *m = QueryGetGasPriceResponse{} }
+func (m *QueryGetGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGasPriceResponse) ProtoMessage()    {}
+func (*QueryGetGasPriceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{31}
 }
-func (m *QueryAllCctxRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllCctxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetGasPriceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1495,42 +1527,41 @@ func (m *QueryAllCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryAllCctxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllCctxRequest.Merge(m, src)
+func (m *QueryGetGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGasPriceResponse.Merge(m, src)
 }
-func (m *QueryAllCctxRequest) XXX_Size() int {
+func (m *QueryGetGasPriceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllCctxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllCctxRequest.DiscardUnknown(m)
+func (m *QueryGetGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGasPriceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllCctxRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetGasPriceResponse proto.InternalMessageInfo
 
-func (m *QueryAllCctxRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetGasPriceResponse) GetGasPrice() *GasPrice {
 	if m != nil {
-		return m.Pagination
+		return m.GasPrice
 	}
 	return nil
 }
 
-type QueryAllCctxResponse struct {
-	CrossChainTx []*CrossChainTx     `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
-	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllGasPriceRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllCctxResponse) Reset()         { *m = QueryAllCctxResponse{} }
-func (m *QueryAllCctxResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllCctxResponse) ProtoMessage()    {}
-func (*QueryAllCctxResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllGasPriceRequest) Reset()         { *m = QueryAllGasPriceRequest{} }
+func (m *QueryAllGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGasPriceRequest) ProtoMessage()    {}
+func (*QueryAllGasPriceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{32}
 }
-func (m *QueryAllCctxResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllCctxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllGasPriceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1540,49 +1571,42 @@ func (m *QueryAllCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryAllCctxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllCctxResponse.Merge(m, src)
+func (m *QueryAllGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGasPriceRequest.Merge(m, src)
 }
-func (m *QueryAllCctxResponse) XXX_Size() int {
+func (m *QueryAllGasPriceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllCctxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllCctxResponse.DiscardUnknown(m)
+func (m *QueryAllGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGasPriceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllCctxResponse proto.InternalMessageInfo
-
-func (m *QueryAllCctxResponse) GetCrossChainTx() []*CrossChainTx {
-	if m != nil {
-		return m.CrossChainTx
-	}
-	return nil
-}
+var xxx_messageInfo_QueryAllGasPriceRequest proto.InternalMessageInfo
 
-func (m *QueryAllCctxResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllGasPriceRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryListCctxPendingRequest struct {
-	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Limit   uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+type QueryAllGasPriceResponse struct {
+	GasPrice   []*GasPrice         `protobuf:"bytes,1,rep,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListCctxPendingRequest) Reset()         { *m = QueryListCctxPendingRequest{} }
-func (m *QueryListCctxPendingRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListCctxPendingRequest) ProtoMessage()    {}
-func (*QueryListCctxPendingRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllGasPriceResponse) Reset()         { *m = QueryAllGasPriceResponse{} }
+func (m *QueryAllGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGasPriceResponse) ProtoMessage()    {}
+func (*QueryAllGasPriceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{33}
 }
-func (m *QueryListCctxPendingRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListCctxPendingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListCctxPendingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllGasPriceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1592,49 +1616,48 @@ func (m *QueryListCctxPendingRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryListCctxPendingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListCctxPendingRequest.Merge(m, src)
+func (m *QueryAllGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGasPriceResponse.Merge(m, src)
 }
-func (m *QueryListCctxPendingRequest) XXX_Size() int {
+func (m *QueryAllGasPriceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListCctxPendingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListCctxPendingRequest.DiscardUnknown(m)
+func (m *QueryAllGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGasPriceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListCctxPendingRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllGasPriceResponse proto.InternalMessageInfo
 
-func (m *QueryListCctxPendingRequest) GetChainId() int64 {
+func (m *QueryAllGasPriceResponse) GetGasPrice() []*GasPrice {
 	if m != nil {
-		return m.ChainId
+		return m.GasPrice
 	}
-	return 0
+	return nil
 }
 
-func (m *QueryListCctxPendingRequest) GetLimit() uint32 {
+func (m *QueryAllGasPriceResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Limit
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-type QueryListCctxPendingResponse struct {
-	CrossChainTx []*CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
-	TotalPending uint64          `protobuf:"varint,2,opt,name=totalPending,proto3" json:"totalPending,omitempty"`
+type QueryGasPriceVotesRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryListCctxPendingResponse) Reset()         { *m = QueryListCctxPendingResponse{} }
-func (m *QueryListCctxPendingResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListCctxPendingResponse) ProtoMessage()    {}
-func (*QueryListCctxPendingResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGasPriceVotesRequest) Reset()         { *m = QueryGasPriceVotesRequest{} }
+func (m *QueryGasPriceVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceVotesRequest) ProtoMessage()    {}
+func (*QueryGasPriceVotesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{34}
 }
-func (m *QueryListCctxPendingResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGasPriceVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListCctxPendingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGasPriceVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListCctxPendingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGasPriceVotesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1644,47 +1667,43 @@ func (m *QueryListCctxPendingResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryListCctxPendingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListCctxPendingResponse.Merge(m, src)
+func (m *QueryGasPriceVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceVotesRequest.Merge(m, src)
 }
-func (m *QueryListCctxPendingResponse) XXX_Size() int {
+func (m *QueryGasPriceVotesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListCctxPendingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListCctxPendingResponse.DiscardUnknown(m)
+func (m *QueryGasPriceVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceVotesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListCctxPendingResponse proto.InternalMessageInfo
-
-func (m *QueryListCctxPendingResponse) GetCrossChainTx() []*CrossChainTx {
-	if m != nil {
-		return m.CrossChainTx
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGasPriceVotesRequest proto.InternalMessageInfo
 
-func (m *QueryListCctxPendingResponse) GetTotalPending() uint64 {
+func (m *QueryGasPriceVotesRequest) GetChainId() int64 {
 	if m != nil {
-		return m.TotalPending
+		return m.ChainId
 	}
 	return 0
 }
 
-type QueryLastZetaHeightRequest struct {
+type QueryGasPriceVotesResponse struct {
+	Votes             []GasPriceVote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+	MedianPrice       uint64         `protobuf:"varint,2,opt,name=median_price,json=medianPrice,proto3" json:"median_price,omitempty"`
+	MedianPriorityFee uint64         `protobuf:"varint,3,opt,name=median_priority_fee,json=medianPriorityFee,proto3" json:"median_priority_fee,omitempty"`
 }
 
-func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
-func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
-func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGasPriceVotesResponse) Reset()         { *m = QueryGasPriceVotesResponse{} }
+func (m *QueryGasPriceVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceVotesResponse) ProtoMessage()    {}
+func (*QueryGasPriceVotesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{35}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGasPriceVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGasPriceVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGasPriceVotesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1694,34 +1713,55 @@ func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
+func (m *QueryGasPriceVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceVotesResponse.Merge(m, src)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+func (m *QueryGasPriceVotesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
+func (m *QueryGasPriceVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceVotesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGasPriceVotesResponse proto.InternalMessageInfo
 
-type QueryLastZetaHeightResponse struct {
-	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+func (m *QueryGasPriceVotesResponse) GetVotes() []GasPriceVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
 
-func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
-func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
-func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGasPriceVotesResponse) GetMedianPrice() uint64 {
+	if m != nil {
+		return m.MedianPrice
+	}
+	return 0
+}
+
+func (m *QueryGasPriceVotesResponse) GetMedianPriorityFee() uint64 {
+	if m != nil {
+		return m.MedianPriorityFee
+	}
+	return 0
+}
+
+type QueryGetLastBlockHeightRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryGetLastBlockHeightRequest) Reset()         { *m = QueryGetLastBlockHeightRequest{} }
+func (m *QueryGetLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLastBlockHeightRequest) ProtoMessage()    {}
+func (*QueryGetLastBlockHeightRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{36}
 }
-func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetLastBlockHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastZetaHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetLastBlockHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1731,42 +1771,41 @@ func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryLastZetaHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastZetaHeightResponse.Merge(m, src)
+func (m *QueryGetLastBlockHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLastBlockHeightRequest.Merge(m, src)
 }
-func (m *QueryLastZetaHeightResponse) XXX_Size() int {
+func (m *QueryGetLastBlockHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastZetaHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastZetaHeightResponse.DiscardUnknown(m)
+func (m *QueryGetLastBlockHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLastBlockHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastZetaHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetLastBlockHeightRequest proto.InternalMessageInfo
 
-func (m *QueryLastZetaHeightResponse) GetHeight() int64 {
+func (m *QueryGetLastBlockHeightRequest) GetIndex() string {
 	if m != nil {
-		return m.Height
+		return m.Index
 	}
-	return 0
+	return ""
 }
 
-type QueryConvertGasToZetaRequest struct {
-	ChainId  int64  `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	GasLimit string `protobuf:"bytes,2,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+type QueryGetLastBlockHeightResponse struct {
+	LastBlockHeight *LastBlockHeight `protobuf:"bytes,1,opt,name=LastBlockHeight,proto3" json:"LastBlockHeight,omitempty"`
 }
 
-func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZetaRequest{} }
-func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
-func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetLastBlockHeightResponse) Reset()         { *m = QueryGetLastBlockHeightResponse{} }
+func (m *QueryGetLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLastBlockHeightResponse) ProtoMessage()    {}
+func (*QueryGetLastBlockHeightResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{37}
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetLastBlockHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConvertGasToZetaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetLastBlockHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1776,50 +1815,41 @@ func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConvertGasToZetaRequest.Merge(m, src)
+func (m *QueryGetLastBlockHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLastBlockHeightResponse.Merge(m, src)
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Size() int {
+func (m *QueryGetLastBlockHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConvertGasToZetaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConvertGasToZetaRequest.DiscardUnknown(m)
+func (m *QueryGetLastBlockHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLastBlockHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConvertGasToZetaRequest proto.InternalMessageInfo
-
-func (m *QueryConvertGasToZetaRequest) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryGetLastBlockHeightResponse proto.InternalMessageInfo
 
-func (m *QueryConvertGasToZetaRequest) GetGasLimit() string {
+func (m *QueryGetLastBlockHeightResponse) GetLastBlockHeight() *LastBlockHeight {
 	if m != nil {
-		return m.GasLimit
+		return m.LastBlockHeight
 	}
-	return ""
+	return nil
 }
 
-type QueryConvertGasToZetaResponse struct {
-	OutboundGasInZeta string `protobuf:"bytes,1,opt,name=outboundGasInZeta,proto3" json:"outboundGasInZeta,omitempty"`
-	ProtocolFeeInZeta string `protobuf:"bytes,2,opt,name=protocolFeeInZeta,proto3" json:"protocolFeeInZeta,omitempty"`
-	ZetaBlockHeight   uint64 `protobuf:"varint,3,opt,name=ZetaBlockHeight,proto3" json:"ZetaBlockHeight,omitempty"`
+type QueryAllLastBlockHeightRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasToZetaResponse{} }
-func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
-func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllLastBlockHeightRequest) Reset()         { *m = QueryAllLastBlockHeightRequest{} }
+func (m *QueryAllLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLastBlockHeightRequest) ProtoMessage()    {}
+func (*QueryAllLastBlockHeightRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{38}
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllLastBlockHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConvertGasToZetaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllLastBlockHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1829,54 +1859,93 @@ func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConvertGasToZetaResponse.Merge(m, src)
+func (m *QueryAllLastBlockHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLastBlockHeightRequest.Merge(m, src)
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Size() int {
+func (m *QueryAllLastBlockHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConvertGasToZetaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConvertGasToZetaResponse.DiscardUnknown(m)
+func (m *QueryAllLastBlockHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLastBlockHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConvertGasToZetaResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllLastBlockHeightRequest proto.InternalMessageInfo
 
-func (m *QueryConvertGasToZetaResponse) GetOutboundGasInZeta() string {
+func (m *QueryAllLastBlockHeightRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.OutboundGasInZeta
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryConvertGasToZetaResponse) GetProtocolFeeInZeta() string {
+type QueryAllLastBlockHeightResponse struct {
+	LastBlockHeight []*LastBlockHeight  `protobuf:"bytes,1,rep,name=LastBlockHeight,proto3" json:"LastBlockHeight,omitempty"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllLastBlockHeightResponse) Reset()         { *m = QueryAllLastBlockHeightResponse{} }
+func (m *QueryAllLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLastBlockHeightResponse) ProtoMessage()    {}
+func (*QueryAllLastBlockHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{39}
+}
+func (m *QueryAllLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllLastBlockHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllLastBlockHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllLastBlockHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLastBlockHeightResponse.Merge(m, src)
+}
+func (m *QueryAllLastBlockHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllLastBlockHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLastBlockHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllLastBlockHeightResponse proto.InternalMessageInfo
+
+func (m *QueryAllLastBlockHeightResponse) GetLastBlockHeight() []*LastBlockHeight {
 	if m != nil {
-		return m.ProtocolFeeInZeta
+		return m.LastBlockHeight
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryConvertGasToZetaResponse) GetZetaBlockHeight() uint64 {
+func (m *QueryAllLastBlockHeightResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.ZetaBlockHeight
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-type QueryMessagePassingProtocolFeeRequest struct {
+type QueryGetCctxRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMessagePassingProtocolFeeRequest{} }
-func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
-func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{39}
+func (m *QueryGetCctxRequest) Reset()         { *m = QueryGetCctxRequest{} }
+func (m *QueryGetCctxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCctxRequest) ProtoMessage()    {}
+func (*QueryGetCctxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{40}
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCctxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1886,36 +1955,42 @@ func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Merge(m, src)
+func (m *QueryGetCctxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCctxRequest.Merge(m, src)
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Size() int {
+func (m *QueryGetCctxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.DiscardUnknown(m)
+func (m *QueryGetCctxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCctxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMessagePassingProtocolFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCctxRequest proto.InternalMessageInfo
 
-type QueryMessagePassingProtocolFeeResponse struct {
-	FeeInZeta string `protobuf:"bytes,1,opt,name=feeInZeta,proto3" json:"feeInZeta,omitempty"`
+func (m *QueryGetCctxRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
-	*m = QueryMessagePassingProtocolFeeResponse{}
+type QueryGetCctxByNonceRequest struct {
+	ChainID int64  `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
-func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
-func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{40}
+
+func (m *QueryGetCctxByNonceRequest) Reset()         { *m = QueryGetCctxByNonceRequest{} }
+func (m *QueryGetCctxByNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCctxByNonceRequest) ProtoMessage()    {}
+func (*QueryGetCctxByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{41}
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetCctxByNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCctxByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCctxByNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1925,1632 +2000,1989 @@ func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Merge(m, src)
+func (m *QueryGetCctxByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCctxByNonceRequest.Merge(m, src)
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Size() int {
+func (m *QueryGetCctxByNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.DiscardUnknown(m)
+func (m *QueryGetCctxByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCctxByNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMessagePassingProtocolFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCctxByNonceRequest proto.InternalMessageInfo
 
-func (m *QueryMessagePassingProtocolFeeResponse) GetFeeInZeta() string {
+func (m *QueryGetCctxByNonceRequest) GetChainID() int64 {
 	if m != nil {
-		return m.FeeInZeta
+		return m.ChainID
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryZetaAccountingRequest)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingRequest")
-	proto.RegisterType((*QueryZetaAccountingResponse)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.crosschain.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.crosschain.QueryParamsResponse")
-	proto.RegisterType((*QueryGetOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerRequest")
-	proto.RegisterType((*QueryGetOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerResponse")
-	proto.RegisterType((*QueryAllOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerRequest")
-	proto.RegisterType((*QueryAllOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerResponse")
-	proto.RegisterType((*QueryAllOutTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainRequest")
-	proto.RegisterType((*QueryAllOutTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainResponse")
-	proto.RegisterType((*QueryAllInTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainRequest")
-	proto.RegisterType((*QueryAllInTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainResponse")
-	proto.RegisterType((*QueryAllInTxTrackersRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersRequest")
-	proto.RegisterType((*QueryAllInTxTrackersResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersResponse")
-	proto.RegisterType((*QueryGetInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxRequest")
-	proto.RegisterType((*QueryGetInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxResponse")
-	proto.RegisterType((*QueryInTxHashToCctxDataRequest)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataRequest")
-	proto.RegisterType((*QueryInTxHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataResponse")
-	proto.RegisterType((*QueryAllInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxRequest")
-	proto.RegisterType((*QueryAllInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxResponse")
-	proto.RegisterType((*QueryGetGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceRequest")
-	proto.RegisterType((*QueryGetGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceResponse")
-	proto.RegisterType((*QueryAllGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceRequest")
-	proto.RegisterType((*QueryAllGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceResponse")
-	proto.RegisterType((*QueryGetLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightRequest")
-	proto.RegisterType((*QueryGetLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightResponse")
-	proto.RegisterType((*QueryAllLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightRequest")
-	proto.RegisterType((*QueryAllLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightResponse")
-	proto.RegisterType((*QueryGetCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxRequest")
-	proto.RegisterType((*QueryGetCctxByNonceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxByNonceRequest")
-	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
-	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
-	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
-	proto.RegisterType((*QueryListCctxPendingRequest)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingRequest")
-	proto.RegisterType((*QueryListCctxPendingResponse)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingResponse")
-	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
-	proto.RegisterType((*QueryLastZetaHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightResponse")
-	proto.RegisterType((*QueryConvertGasToZetaRequest)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaRequest")
-	proto.RegisterType((*QueryConvertGasToZetaResponse)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaResponse")
-	proto.RegisterType((*QueryMessagePassingProtocolFeeRequest)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeRequest")
-	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
-}
-
-func init() { proto.RegisterFile("crosschain/query.proto", fileDescriptor_65a992045e92a606) }
-
-var fileDescriptor_65a992045e92a606 = []byte{
-	// 1782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdf, 0x6f, 0x14, 0x55,
-	0x14, 0xee, 0xed, 0xd2, 0x52, 0x6e, 0x0b, 0x95, 0x4b, 0x85, 0x3a, 0xb4, 0x5b, 0x98, 0x5a, 0x5a,
-	0xc1, 0xee, 0xd0, 0x02, 0x45, 0xa0, 0x18, 0xb7, 0x45, 0x0a, 0xb1, 0x40, 0xdd, 0xd4, 0x68, 0x30,
-	0x66, 0x73, 0x3b, 0x3b, 0xce, 0x4e, 0x98, 0xce, 0x94, 0x9d, 0x59, 0xd2, 0xd2, 0xf4, 0x85, 0x07,
-	0x5f, 0x7c, 0x31, 0x21, 0xd1, 0x17, 0x5f, 0x8d, 0x3e, 0xf8, 0xe0, 0x83, 0xd1, 0x07, 0x13, 0x8c,
-	0x51, 0x91, 0x47, 0x12, 0x13, 0x63, 0x34, 0x31, 0x06, 0xfc, 0x43, 0xcc, 0xdc, 0x39, 0xb3, 0x7b,
-	0xe7, 0xd7, 0xee, 0xed, 0x76, 0x79, 0xe0, 0xa9, 0x3b, 0x73, 0xef, 0x39, 0xe7, 0xfb, 0xbe, 0xfb,
-	0x63, 0xce, 0x39, 0xc5, 0x07, 0xd5, 0x8a, 0xed, 0x38, 0x6a, 0x99, 0x1a, 0x96, 0x72, 0xbb, 0xaa,
-	0x55, 0x36, 0x72, 0x6b, 0x15, 0xdb, 0xb5, 0xc9, 0xf0, 0x5d, 0xcd, 0xa5, 0xec, 0x75, 0x8e, 0xfd,
-	0xb2, 0x2b, 0x5a, 0xae, 0x3e, 0x55, 0x3a, 0xae, 0xda, 0xce, 0xaa, 0xed, 0x28, 0x2b, 0xd4, 0xd1,
-	0x7c, 0x3b, 0xe5, 0xce, 0xd4, 0x8a, 0xe6, 0xd2, 0x29, 0x65, 0x8d, 0xea, 0x86, 0x45, 0x5d, 0xc3,
-	0xb6, 0x7c, 0x57, 0xd2, 0x08, 0x17, 0x82, 0xfd, 0x2c, 0xb2, 0xdf, 0x45, 0x77, 0x1d, 0x26, 0x48,
-	0xdc, 0x04, 0x9d, 0x3a, 0xc5, 0xb5, 0x8a, 0xa1, 0x6a, 0x30, 0x36, 0xca, 0x8d, 0x31, 0x9b, 0x62,
-	0x99, 0x3a, 0xe5, 0xa2, 0x6b, 0x17, 0x55, 0xb5, 0xe6, 0x20, 0x1b, 0x9b, 0xe4, 0x56, 0xa8, 0x7a,
-	0x4b, 0xab, 0xc0, 0xb8, 0xcc, 0x8d, 0x9b, 0xd4, 0x71, 0x8b, 0x2b, 0xa6, 0xad, 0xde, 0x2a, 0x96,
-	0x35, 0x43, 0x2f, 0xbb, 0x09, 0x28, 0xed, 0xaa, 0x1b, 0x77, 0x72, 0x88, 0x9b, 0xb0, 0x46, 0x2b,
-	0x74, 0xd5, 0x81, 0x81, 0x01, 0xdd, 0xd6, 0x6d, 0xf6, 0x53, 0xf1, 0x7e, 0xc1, 0xdb, 0x21, 0xdd,
-	0xb6, 0x75, 0x53, 0x53, 0xe8, 0x9a, 0xa1, 0x50, 0xcb, 0xb2, 0x5d, 0x26, 0x09, 0xd8, 0xc8, 0x43,
-	0x58, 0x7a, 0xdb, 0x53, 0xed, 0xa6, 0xe6, 0xd2, 0xbc, 0xaa, 0xda, 0x55, 0xcb, 0x35, 0x2c, 0xbd,
-	0xa0, 0xdd, 0xae, 0x6a, 0x8e, 0x2b, 0x5f, 0xc3, 0x87, 0x13, 0x47, 0x9d, 0x35, 0xdb, 0x72, 0x34,
-	0x92, 0xc3, 0x07, 0xe8, 0x8a, 0x5d, 0x71, 0xb5, 0x52, 0xd1, 0x5b, 0x9b, 0x22, 0x5d, 0xf5, 0x66,
-	0x0c, 0xa2, 0x23, 0x68, 0x62, 0x4f, 0x61, 0x3f, 0x0c, 0x31, 0x5b, 0x36, 0x20, 0x0f, 0x60, 0xc2,
-	0xdc, 0x2d, 0x31, 0xd4, 0x41, 0x90, 0x9b, 0xf8, 0x40, 0xe8, 0x2d, 0x38, 0x9f, 0xc7, 0xdd, 0x3e,
-	0x3b, 0xe6, 0xaf, 0x77, 0x7a, 0x2c, 0xd7, 0x70, 0x27, 0xe4, 0x7c, 0xf3, 0xb9, 0x5d, 0x8f, 0xfe,
-	0x19, 0xe9, 0x28, 0x80, 0x69, 0x8d, 0xc0, 0x82, 0xe6, 0xde, 0xa8, 0xba, 0xcb, 0xeb, 0xcb, 0xbe,
-	0x92, 0x10, 0x9a, 0x0c, 0xe2, 0xdd, 0xcc, 0xf8, 0xea, 0x25, 0x16, 0x24, 0x53, 0x08, 0x1e, 0xc9,
-	0x00, 0xee, 0xb2, 0x6c, 0x4b, 0xd5, 0x06, 0x3b, 0x8f, 0xa0, 0x89, 0x5d, 0x05, 0xff, 0x41, 0xae,
-	0xe2, 0xa1, 0x64, 0x77, 0x80, 0xf9, 0x1d, 0xdc, 0x67, 0x73, 0xef, 0x01, 0xf9, 0x89, 0x26, 0xc8,
-	0x79, 0x57, 0x80, 0x3f, 0xe4, 0x46, 0xd6, 0x80, 0x45, 0xde, 0x34, 0x93, 0x58, 0x5c, 0xc6, 0xb8,
-	0xbe, 0xd7, 0x21, 0xe6, 0xb1, 0x9c, 0x7f, 0x30, 0x72, 0xde, 0xc1, 0xc8, 0xf9, 0x07, 0x0a, 0x0e,
-	0x46, 0x6e, 0x89, 0xea, 0x1a, 0xd8, 0x16, 0x38, 0x4b, 0xf9, 0x01, 0x02, 0x7a, 0xb1, 0x38, 0xa9,
-	0xf4, 0x32, 0x6d, 0xa0, 0x47, 0x16, 0x42, 0xf8, 0x3b, 0x19, 0xfe, 0xf1, 0xa6, 0xf8, 0x7d, 0x4c,
-	0x21, 0x02, 0xf7, 0x10, 0x96, 0x93, 0x08, 0xcc, 0x6d, 0xcc, 0x7b, 0x48, 0x02, 0xbd, 0x06, 0x70,
-	0x17, 0x43, 0x06, 0x6b, 0xee, 0x3f, 0x44, 0x54, 0xec, 0x6c, 0x59, 0xc5, 0x5f, 0x11, 0x1e, 0x6d,
-	0x08, 0xe2, 0x39, 0x11, 0xf3, 0x23, 0x84, 0x8f, 0x06, 0x3c, 0xae, 0x5a, 0x69, 0x5a, 0xbe, 0x84,
-	0x7b, 0xfc, 0x4b, 0xd4, 0x28, 0x85, 0x8f, 0x50, 0xa9, 0x6d, 0x82, 0xfe, 0xc4, 0xad, 0x6a, 0x12,
-	0x10, 0xd0, 0xb3, 0x80, 0x7b, 0x0d, 0x2b, 0x2a, 0xe7, 0xf1, 0x26, 0x72, 0xf2, 0xfe, 0x7c, 0x35,
-	0x79, 0x27, 0xed, 0x13, 0x93, 0x3b, 0xc1, 0x5c, 0x48, 0xa7, 0xdd, 0x27, 0xf8, 0x07, 0xee, 0x04,
-	0x87, 0xe3, 0x3c, 0x0f, 0x22, 0x5d, 0xc0, 0xc3, 0xc1, 0xed, 0xea, 0x85, 0xbc, 0x42, 0x9d, 0xf2,
-	0xb2, 0x3d, 0xaf, 0xba, 0xeb, 0x81, 0x4c, 0x12, 0xee, 0x31, 0x60, 0x00, 0x3e, 0x32, 0xb5, 0x67,
-	0x79, 0x0b, 0x67, 0xd3, 0x8c, 0x81, 0xfb, 0xfb, 0x78, 0x9f, 0x11, 0x1a, 0x01, 0xa1, 0x27, 0x05,
-	0xe8, 0xd7, 0x8d, 0x40, 0x81, 0x88, 0x2b, 0x79, 0x16, 0xc2, 0x87, 0x27, 0x5f, 0xa2, 0x2e, 0x15,
-	0x01, 0x7f, 0x17, 0x8f, 0xa4, 0x5a, 0x03, 0xfa, 0x77, 0xf1, 0xde, 0x79, 0x0f, 0x13, 0xdb, 0xf4,
-	0xcb, 0xeb, 0x8e, 0xe0, 0x7d, 0xc1, 0xdb, 0x00, 0xf4, 0xb0, 0x1f, 0x59, 0x07, 0xd5, 0x61, 0xcb,
-	0xc4, 0x55, 0x6f, 0xd7, 0xe6, 0x7c, 0x88, 0x40, 0xa3, 0x84, 0x48, 0x0d, 0x96, 0x28, 0xd3, 0xa6,
-	0x25, 0x6a, 0xdf, 0x3e, 0x55, 0xf0, 0xa1, 0x60, 0xab, 0x2d, 0x50, 0x67, 0xc9, 0x4b, 0x12, 0xb9,
-	0x4f, 0x8b, 0x61, 0x95, 0xb4, 0x75, 0x58, 0x61, 0xff, 0x41, 0x2e, 0xe2, 0xc1, 0xb8, 0x41, 0x2d,
-	0xcd, 0xe9, 0x09, 0xde, 0x81, 0xb6, 0xe3, 0x4d, 0xc8, 0xd6, 0x5c, 0xd4, 0x0c, 0x65, 0x0a, 0x88,
-	0xf2, 0xa6, 0x19, 0x45, 0xd4, 0xae, 0xd5, 0xfb, 0x0a, 0x01, 0x89, 0x50, 0x8c, 0x44, 0x12, 0x99,
-	0x96, 0x48, 0xb4, 0x6f, 0x7d, 0x66, 0xea, 0x57, 0xc1, 0x22, 0x75, 0xdc, 0x39, 0x2f, 0xc7, 0xbe,
-	0xc2, 0x52, 0xec, 0xc6, 0xcb, 0xb4, 0x09, 0xa7, 0x30, 0xc9, 0x0e, 0x88, 0xbe, 0x87, 0xfb, 0x23,
-	0x43, 0x20, 0x69, 0xae, 0x09, 0xdf, 0xa8, 0xc3, 0xa8, 0x1b, 0xb9, 0x5c, 0x3f, 0x1c, 0x29, 0xa0,
-	0xdb, 0xb5, 0x92, 0xbf, 0x20, 0xe0, 0x99, 0x14, 0xaa, 0x11, 0xcf, 0x4c, 0x1b, 0x78, 0xb6, 0x6f,
-	0x95, 0x4f, 0x40, 0xd9, 0xb0, 0xa0, 0xb9, 0xfc, 0x6d, 0x95, 0xbc, 0xb4, 0x8b, 0x50, 0xe6, 0xc0,
-	0xe4, 0xb9, 0x8d, 0xeb, 0x5e, 0x3e, 0xdf, 0x6a, 0x19, 0xa0, 0xe3, 0x81, 0x70, 0x68, 0x50, 0xed,
-	0x06, 0xee, 0xe3, 0xef, 0x56, 0xc1, 0xf4, 0x9f, 0x37, 0x29, 0x84, 0x1c, 0xc8, 0x1f, 0x00, 0xc7,
-	0xbc, 0x69, 0x3e, 0x8b, 0x1b, 0xf9, 0x1b, 0x04, 0x44, 0x6a, 0xfe, 0x53, 0x89, 0x64, 0x76, 0x44,
-	0xa4, 0x7d, 0xab, 0x7e, 0x1d, 0x12, 0xa9, 0x45, 0xc3, 0x61, 0xda, 0x2f, 0x69, 0x56, 0xa9, 0x5e,
-	0xb0, 0x36, 0x4a, 0x47, 0x07, 0x70, 0x97, 0x69, 0xac, 0x1a, 0x2e, 0x8b, 0xbe, 0xb7, 0xe0, 0x3f,
-	0xc8, 0xf7, 0x83, 0x8c, 0x29, 0xe6, 0xf0, 0x59, 0x49, 0x21, 0xe3, 0x3e, 0xd7, 0x76, 0xa9, 0x09,
-	0x81, 0x60, 0x67, 0x85, 0xde, 0xd5, 0xaa, 0x72, 0xef, 0xf0, 0x78, 0xf5, 0x73, 0xe8, 0x22, 0x90,
-	0xcf, 0x04, 0x1a, 0x44, 0x46, 0x01, 0xf1, 0x41, 0xdc, 0xcd, 0x5d, 0x4d, 0x99, 0x02, 0x3c, 0xc9,
-	0xcb, 0xc0, 0x74, 0xde, 0xb6, 0xee, 0x68, 0x15, 0xef, 0x4b, 0xb4, 0x6c, 0x7b, 0xe6, 0xb1, 0x53,
-	0x10, 0x93, 0x4e, 0xc2, 0x3d, 0x3a, 0x75, 0x16, 0x6b, 0xea, 0xed, 0x29, 0xd4, 0x9e, 0xe5, 0x2f,
-	0x10, 0xe4, 0x0f, 0x71, 0xb7, 0x80, 0xe7, 0x55, 0xbc, 0xdf, 0xae, 0xba, 0x2b, 0x76, 0xd5, 0x2a,
-	0x2d, 0x50, 0xe7, 0xaa, 0xe5, 0x0d, 0x06, 0x3d, 0x82, 0xd8, 0x80, 0x37, 0x9b, 0x75, 0x26, 0x54,
-	0xdb, 0xbc, 0xac, 0x69, 0x30, 0xdb, 0x0f, 0x1a, 0x1f, 0x20, 0x13, 0xb8, 0xdf, 0xfb, 0xcb, 0xdf,
-	0x53, 0x19, 0xa6, 0x67, 0xf4, 0xb5, 0x3c, 0x8e, 0xc7, 0x18, 0xcc, 0x6b, 0x9a, 0xe3, 0x50, 0x5d,
-	0x5b, 0xa2, 0x8e, 0x63, 0x58, 0xfa, 0x52, 0xdd, 0x63, 0xa0, 0xee, 0x65, 0x7c, 0xac, 0xd9, 0x44,
-	0x20, 0x36, 0x84, 0xf7, 0x7c, 0x58, 0x83, 0xe8, 0x13, 0xaa, 0xbf, 0x98, 0xfe, 0x78, 0x04, 0x77,
-	0x31, 0x47, 0xe4, 0x53, 0x84, 0xbb, 0xfd, 0xee, 0x04, 0x99, 0x6a, 0xb2, 0x6f, 0xe2, 0xed, 0x11,
-	0x69, 0x7a, 0x3b, 0x26, 0x3e, 0x32, 0x79, 0xec, 0xde, 0xef, 0xff, 0xdd, 0xef, 0x1c, 0x21, 0xc3,
-	0x8a, 0x67, 0x31, 0xc9, 0xb5, 0xbc, 0xf8, 0xb6, 0x11, 0x79, 0x88, 0x70, 0x1f, 0x5f, 0x50, 0x92,
-	0xf3, 0x22, 0xb1, 0x92, 0x7b, 0x29, 0xd2, 0x85, 0x96, 0x6c, 0x01, 0xf0, 0x45, 0x06, 0xf8, 0x2c,
-	0x39, 0x93, 0x02, 0x98, 0x2f, 0x71, 0x95, 0x4d, 0xb8, 0x9d, 0xb7, 0x94, 0x4d, 0x76, 0x1f, 0x6f,
-	0x91, 0xef, 0x11, 0xee, 0xe7, 0xfd, 0xe6, 0x4d, 0x53, 0x8c, 0x4b, 0x72, 0x47, 0x45, 0x8c, 0x4b,
-	0x4a, 0x97, 0x44, 0x3e, 0xc1, 0xb8, 0x8c, 0x91, 0x51, 0x01, 0x2e, 0xe4, 0x6f, 0x84, 0x0f, 0x46,
-	0x90, 0x43, 0x61, 0x4b, 0xf2, 0x2d, 0x80, 0x08, 0x57, 0xe7, 0xd2, 0xdc, 0x4e, 0x5c, 0x00, 0x9d,
-	0xf3, 0x8c, 0xce, 0x69, 0x32, 0x2d, 0x40, 0x07, 0x6c, 0x61, 0x85, 0xb6, 0xc8, 0x5f, 0x08, 0xbf,
-	0xc8, 0x55, 0x8f, 0x1c, 0xb9, 0x37, 0x04, 0x91, 0xa5, 0x76, 0x1e, 0xa4, 0xfc, 0x0e, 0x3c, 0x00,
-	0xb5, 0x59, 0x46, 0x6d, 0x86, 0x9c, 0x4e, 0xa1, 0x66, 0x58, 0x29, 0xcc, 0x8a, 0x46, 0x69, 0x8b,
-	0x7c, 0x87, 0xf0, 0xbe, 0x30, 0x39, 0xe1, 0x3d, 0x97, 0xd0, 0x03, 0x10, 0xde, 0x73, 0x49, 0x75,
-	0x7d, 0xd3, 0x3d, 0xc7, 0x31, 0x71, 0xc8, 0x6f, 0x00, 0x9c, 0xab, 0x8d, 0x66, 0x05, 0x0f, 0x6f,
-	0x62, 0x85, 0x28, 0x5d, 0x6c, 0xd1, 0x1a, 0xc0, 0xbf, 0xc6, 0xc0, 0x4f, 0x93, 0x93, 0x0d, 0xc0,
-	0xd7, 0xcd, 0x94, 0xcd, 0xe0, 0x79, 0x8b, 0xfc, 0x81, 0x30, 0x89, 0xd7, 0xcc, 0x44, 0x08, 0x4f,
-	0x6a, 0xa5, 0x2e, 0xbd, 0xde, 0xaa, 0x39, 0xf0, 0xc9, 0x33, 0x3e, 0x17, 0xc8, 0xb9, 0x54, 0x3e,
-	0xd1, 0x7f, 0x1f, 0x14, 0x4b, 0xd4, 0xa5, 0x3c, 0xb1, 0x1f, 0x11, 0xde, 0x1f, 0x8e, 0xe0, 0x6d,
-	0xaf, 0xd9, 0x6d, 0x6c, 0x91, 0x16, 0x57, 0x29, 0xb5, 0x36, 0x97, 0x27, 0x19, 0xab, 0x71, 0x32,
-	0x26, 0xb4, 0x4a, 0xe4, 0x6b, 0x54, 0xaf, 0x09, 0xc9, 0x8c, 0xe0, 0x06, 0x89, 0x14, 0xaf, 0xd2,
-	0xd9, 0x6d, 0xdb, 0x01, 0x58, 0x85, 0x81, 0x7d, 0x85, 0x8c, 0xa7, 0x80, 0xd5, 0xc1, 0xc0, 0xd3,
-	0xbc, 0xa4, 0xad, 0x6f, 0x91, 0x2f, 0x11, 0xee, 0x0d, 0xbc, 0x78, 0x52, 0xcf, 0x08, 0x8a, 0xd5,
-	0x12, 0xe2, 0x84, 0x12, 0x5a, 0x1e, 0x67, 0x88, 0x8f, 0x92, 0x91, 0x26, 0x88, 0xc9, 0x03, 0x84,
-	0x5f, 0x88, 0xe6, 0x5a, 0x44, 0xe8, 0xf2, 0x48, 0x49, 0xfc, 0xa4, 0xd9, 0xd6, 0x8c, 0x05, 0xa5,
-	0x56, 0xa3, 0x58, 0x1f, 0x22, 0xdc, 0xcb, 0xa5, 0x53, 0xe4, 0x92, 0x48, 0xf8, 0x66, 0x69, 0x9b,
-	0xf4, 0xe6, 0x0e, 0xbd, 0x00, 0x9b, 0xe3, 0x8c, 0xcd, 0xcb, 0x44, 0x4e, 0xcb, 0x9c, 0x38, 0xe0,
-	0x8f, 0x50, 0xac, 0x4a, 0x26, 0xa2, 0x57, 0x61, 0x72, 0x8d, 0x2f, 0x76, 0xf5, 0xa4, 0xf7, 0x27,
-	0xe4, 0x19, 0x06, 0xff, 0x24, 0xc9, 0xa5, 0xc0, 0x37, 0xc3, 0x76, 0xb5, 0xed, 0xff, 0x33, 0xc2,
-	0x24, 0xe2, 0xd3, 0x3b, 0x05, 0xa2, 0x57, 0xc6, 0x4e, 0xd8, 0xa4, 0x77, 0x21, 0xe4, 0x1c, 0x63,
-	0x33, 0x41, 0x8e, 0x89, 0xb1, 0x21, 0x9f, 0x23, 0xbc, 0x8b, 0x5d, 0x3e, 0xd3, 0x82, 0x32, 0xf2,
-	0xd7, 0xe3, 0xa9, 0x6d, 0xd9, 0x08, 0x7e, 0x77, 0x55, 0xf8, 0x60, 0x31, 0x91, 0xbf, 0x45, 0xb8,
-	0x97, 0xeb, 0x3e, 0x90, 0x73, 0xdb, 0x88, 0x18, 0xee, 0x58, 0xb4, 0x06, 0xf6, 0x0c, 0x03, 0xab,
-	0x90, 0xc9, 0x86, 0x60, 0x63, 0xc9, 0xf5, 0x67, 0x08, 0xef, 0x0e, 0xbe, 0x40, 0xd3, 0x82, 0x2b,
-	0xba, 0x6d, 0x61, 0x23, 0x1d, 0x08, 0x79, 0x94, 0x61, 0x1d, 0x26, 0x87, 0x1b, 0x60, 0xf5, 0x32,
-	0xb0, 0x7e, 0xcf, 0xca, 0xab, 0xdd, 0xa1, 0x74, 0x16, 0x4b, 0xc1, 0x92, 0xbb, 0x07, 0x62, 0x29,
-	0x58, 0x4a, 0xa3, 0xa0, 0xe9, 0xcd, 0xa1, 0xd6, 0x6d, 0x58, 0xea, 0x18, 0xfe, 0x9f, 0xba, 0xd8,
-	0x66, 0x48, 0xfc, 0x2f, 0xbd, 0x74, 0xbe, 0x15, 0x53, 0xc1, 0xaf, 0xfa, 0xdd, 0x30, 0x4a, 0x0f,
-	0x78, 0xb8, 0xed, 0x20, 0x06, 0x3c, 0xb1, 0x91, 0x21, 0x06, 0x3c, 0xb9, 0xcb, 0xd1, 0x14, 0xb8,
-	0x19, 0x32, 0x9b, 0x7b, 0xeb, 0xd1, 0x93, 0x2c, 0x7a, 0xfc, 0x24, 0x8b, 0xfe, 0x7d, 0x92, 0x45,
-	0x9f, 0x3c, 0xcd, 0x76, 0x3c, 0x7e, 0x9a, 0xed, 0xf8, 0xf3, 0x69, 0xb6, 0xe3, 0xe6, 0x94, 0x6e,
-	0xb8, 0xe5, 0xea, 0x4a, 0x4e, 0xb5, 0x57, 0x79, 0x57, 0x01, 0x1e, 0x65, 0x9d, 0xf7, 0xea, 0x6e,
-	0xac, 0x69, 0xce, 0x4a, 0x37, 0xfb, 0x0a, 0x9c, 0xfa, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x77,
-	0x46, 0x98, 0xb4, 0x22, 0x00, 0x00,
+func (m *QueryGetCctxByNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a OutTxTracker by index.
-	OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error)
-	// Queries a list of OutTxTracker items.
-	OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error)
-	OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error)
-	InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error)
-	InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error)
-	// Queries a InTxHashToCctx by index.
-	InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error)
-	// Queries a InTxHashToCctx data by index.
-	InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error)
-	// Queries a list of InTxHashToCctx items.
-	InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error)
-	// Queries a gasPrice by index.
-	GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error)
-	// Queries a list of gasPrice items.
-	GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error)
-	ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error)
-	ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error)
-	// Queries a lastBlockHeight by index.
-	LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error)
-	// Queries a list of lastBlockHeight items.
-	LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error)
-	// Queries a send by index.
-	Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
-	// Queries a send by nonce.
-	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
-	// Queries a list of send items.
-	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
-	// Queries a list of pending cctxs.
-	CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error)
-	ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error)
-	// Queries a list of lastMetaHeight items.
-	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
+type QueryGetCctxResponse struct {
+	CrossChainTx *CrossChainTx `protobuf:"bytes,1,opt,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetCctxResponse) Reset()         { *m = QueryGetCctxResponse{} }
+func (m *QueryGetCctxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCctxResponse) ProtoMessage()    {}
+func (*QueryGetCctxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{42}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetCctxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCctxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error) {
-	out := new(QueryGetOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetCctxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCctxResponse.Merge(m, src)
 }
-
-func (c *queryClient) OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error) {
-	out := new(QueryAllOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetCctxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCctxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCctxResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error) {
-	out := new(QueryAllOutTxTrackerByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCctxResponse proto.InternalMessageInfo
+
+func (m *QueryGetCctxResponse) GetCrossChainTx() *CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error) {
-	out := new(QueryAllInTxTrackerByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllCctxRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error) {
-	out := new(QueryAllInTxTrackersResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllCctxRequest) Reset()         { *m = QueryAllCctxRequest{} }
+func (m *QueryAllCctxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxRequest) ProtoMessage()    {}
+func (*QueryAllCctxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{43}
+}
+func (m *QueryAllCctxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCctxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCctxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllCctxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxRequest.Merge(m, src)
+}
+func (m *QueryAllCctxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCctxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error) {
-	out := new(QueryGetInTxHashToCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllCctxRequest proto.InternalMessageInfo
+
+func (m *QueryAllCctxRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error) {
-	out := new(QueryInTxHashToCctxDataResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllCctxResponse struct {
+	CrossChainTx []*CrossChainTx     `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error) {
-	out := new(QueryAllInTxHashToCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllCctxResponse) Reset()         { *m = QueryAllCctxResponse{} }
+func (m *QueryAllCctxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxResponse) ProtoMessage()    {}
+func (*QueryAllCctxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{44}
+}
+func (m *QueryAllCctxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCctxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCctxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllCctxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxResponse.Merge(m, src)
+}
+func (m *QueryAllCctxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCctxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error) {
-	out := new(QueryGetGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllCctxResponse proto.InternalMessageInfo
+
+func (m *QueryAllCctxResponse) GetCrossChainTx() []*CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error) {
-	out := new(QueryAllGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllCctxResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error) {
-	out := new(QueryConvertGasToZetaResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryListCctxPendingRequest struct {
+	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Limit   uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (c *queryClient) ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error) {
-	out := new(QueryMessagePassingProtocolFeeResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ProtocolFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListCctxPendingRequest) Reset()         { *m = QueryListCctxPendingRequest{} }
+func (m *QueryListCctxPendingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListCctxPendingRequest) ProtoMessage()    {}
+func (*QueryListCctxPendingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{45}
+}
+func (m *QueryListCctxPendingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCctxPendingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCctxPendingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListCctxPendingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCctxPendingRequest.Merge(m, src)
+}
+func (m *QueryListCctxPendingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCctxPendingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCctxPendingRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error) {
-	out := new(QueryGetLastBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListCctxPendingRequest proto.InternalMessageInfo
+
+func (m *QueryListCctxPendingRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error) {
-	out := new(QueryAllLastBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListCctxPendingRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
-	out := new(QueryGetCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Cctx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryListCctxPendingResponse struct {
+	CrossChainTx []*CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+	TotalPending uint64          `protobuf:"varint,2,opt,name=totalPending,proto3" json:"totalPending,omitempty"`
 }
 
-func (c *queryClient) CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
-	out := new(QueryGetCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListCctxPendingResponse) Reset()         { *m = QueryListCctxPendingResponse{} }
+func (m *QueryListCctxPendingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListCctxPendingResponse) ProtoMessage()    {}
+func (*QueryListCctxPendingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{46}
+}
+func (m *QueryListCctxPendingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCctxPendingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCctxPendingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListCctxPendingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCctxPendingResponse.Merge(m, src)
+}
+func (m *QueryListCctxPendingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCctxPendingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCctxPendingResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
-	out := new(QueryAllCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListCctxPendingResponse proto.InternalMessageInfo
+
+func (m *QueryListCctxPendingResponse) GetCrossChainTx() []*CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error) {
-	out := new(QueryListCctxPendingResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxListPending", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListCctxPendingResponse) GetTotalPending() uint64 {
+	if m != nil {
+		return m.TotalPending
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error) {
-	out := new(QueryZetaAccountingResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ZetaAccounting", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryCctxSearchRequest filters the cctxs on the set fields, at least one of sender, receiver, status,
+// sender_chain_id or receiver_chain_id must be set
+// the time range applies on the creation timestamp of the cctxs, a zero timestamp leaves the range unbounded
+type QueryCctxSearchRequest struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the name of the cctx status, e.g. Aborted
+	Status          string             `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	SenderChainId   int64              `protobuf:"varint,4,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	ReceiverChainId int64              `protobuf:"varint,5,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	StartTimestamp  int64              `protobuf:"varint,6,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp    int64              `protobuf:"varint,7,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCctxSearchRequest) Reset()         { *m = QueryCctxSearchRequest{} }
+func (m *QueryCctxSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxSearchRequest) ProtoMessage()    {}
+func (*QueryCctxSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{47}
+}
+func (m *QueryCctxSearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxSearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCctxSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxSearchRequest.Merge(m, src)
+}
+func (m *QueryCctxSearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxSearchRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error) {
-	out := new(QueryLastZetaHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastZetaHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCctxSearchRequest proto.InternalMessageInfo
+
+func (m *QueryCctxSearchRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a OutTxTracker by index.
-	OutTxTracker(context.Context, *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error)
-	// Queries a list of OutTxTracker items.
-	OutTxTrackerAll(context.Context, *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error)
-	OutTxTrackerAllByChain(context.Context, *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error)
-	InTxTrackerAllByChain(context.Context, *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error)
-	InTxTrackerAll(context.Context, *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error)
-	// Queries a InTxHashToCctx by index.
-	InTxHashToCctx(context.Context, *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error)
-	// Queries a InTxHashToCctx data by index.
-	InTxHashToCctxData(context.Context, *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error)
-	// Queries a list of InTxHashToCctx items.
-	InTxHashToCctxAll(context.Context, *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error)
-	// Queries a gasPrice by index.
-	GasPrice(context.Context, *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error)
-	// Queries a list of gasPrice items.
-	GasPriceAll(context.Context, *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error)
-	ConvertGasToZeta(context.Context, *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error)
-	ProtocolFee(context.Context, *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error)
-	// Queries a lastBlockHeight by index.
-	LastBlockHeight(context.Context, *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error)
-	// Queries a list of lastBlockHeight items.
-	LastBlockHeightAll(context.Context, *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error)
-	// Queries a send by index.
-	Cctx(context.Context, *QueryGetCctxRequest) (*QueryGetCctxResponse, error)
-	// Queries a send by nonce.
-	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
-	// Queries a list of send items.
-	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
-	// Queries a list of pending cctxs.
-	CctxListPending(context.Context, *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error)
-	ZetaAccounting(context.Context, *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error)
-	// Queries a list of lastMetaHeight items.
-	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
+func (m *QueryCctxSearchRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCctxSearchRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
+func (m *QueryCctxSearchRequest) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) OutTxTrackerAll(ctx context.Context, req *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAll not implemented")
+
+func (m *QueryCctxSearchRequest) GetReceiverChainId() int64 {
+	if m != nil {
+		return m.ReceiverChainId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) OutTxTrackerAllByChain(ctx context.Context, req *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAllByChain not implemented")
+
+func (m *QueryCctxSearchRequest) GetStartTimestamp() int64 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) InTxTrackerAllByChain(ctx context.Context, req *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAllByChain not implemented")
+
+func (m *QueryCctxSearchRequest) GetEndTimestamp() int64 {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) InTxTrackerAll(ctx context.Context, req *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAll not implemented")
+
+func (m *QueryCctxSearchRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) InTxHashToCctx(ctx context.Context, req *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctx not implemented")
+
+type QueryCctxSearchResponse struct {
+	CrossChainTx []*CrossChainTx     `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) InTxHashToCctxData(ctx context.Context, req *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxData not implemented")
+
+func (m *QueryCctxSearchResponse) Reset()         { *m = QueryCctxSearchResponse{} }
+func (m *QueryCctxSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxSearchResponse) ProtoMessage()    {}
+func (*QueryCctxSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{48}
 }
-func (*UnimplementedQueryServer) InTxHashToCctxAll(ctx context.Context, req *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxAll not implemented")
+func (m *QueryCctxSearchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+func (m *QueryCctxSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxSearchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GasPriceAll(ctx context.Context, req *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPriceAll not implemented")
+func (m *QueryCctxSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxSearchResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ConvertGasToZeta(ctx context.Context, req *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertGasToZeta not implemented")
+func (m *QueryCctxSearchResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ProtocolFee(ctx context.Context, req *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFee not implemented")
+func (m *QueryCctxSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxSearchResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) LastBlockHeight(ctx context.Context, req *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeight not implemented")
+
+var xxx_messageInfo_QueryCctxSearchResponse proto.InternalMessageInfo
+
+func (m *QueryCctxSearchResponse) GetCrossChainTx() []*CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) LastBlockHeightAll(ctx context.Context, req *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeightAll not implemented")
+
+func (m *QueryCctxSearchResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Cctx(ctx context.Context, req *QueryGetCctxRequest) (*QueryGetCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cctx not implemented")
+
+type QueryLastZetaHeightRequest struct {
 }
-func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxByNonce not implemented")
+
+func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
+func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
+func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{49}
 }
-func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
+func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CctxListPending(ctx context.Context, req *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxListPending not implemented")
+func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ZetaAccounting(ctx context.Context, req *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ZetaAccounting not implemented")
+func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastZetaHeight not implemented")
+func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
 
-func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOutTxTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTracker(ctx, req.(*QueryGetOutTxTrackerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryLastZetaHeightResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
-func _Query_OutTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllOutTxTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTrackerAll(ctx, req.(*QueryAllOutTxTrackerRequest))
+func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
+func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
+func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{50}
+}
+func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastZetaHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLastZetaHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastZetaHeightResponse.Merge(m, src)
+}
+func (m *QueryLastZetaHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastZetaHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastZetaHeightResponse.DiscardUnknown(m)
 }
 
-func _Query_OutTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllOutTxTrackerByChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, req.(*QueryAllOutTxTrackerByChainRequest))
+var xxx_messageInfo_QueryLastZetaHeightResponse proto.InternalMessageInfo
+
+func (m *QueryLastZetaHeightResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_InTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInTxTrackerByChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxTrackerAllByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxTrackerAllByChain(ctx, req.(*QueryAllInTxTrackerByChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryConvertGasToZetaRequest struct {
+	ChainId  int64  `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	GasLimit string `protobuf:"bytes,2,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
 }
 
-func _Query_InTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInTxTrackersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxTrackerAll(ctx, req.(*QueryAllInTxTrackersRequest))
+func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZetaRequest{} }
+func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
+func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{51}
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertGasToZetaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertGasToZetaRequest.Merge(m, src)
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertGasToZetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertGasToZetaRequest.DiscardUnknown(m)
 }
 
-func _Query_InTxHashToCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetInTxHashToCctxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctx(ctx, req.(*QueryGetInTxHashToCctxRequest))
+var xxx_messageInfo_QueryConvertGasToZetaRequest proto.InternalMessageInfo
+
+func (m *QueryConvertGasToZetaRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_InTxHashToCctxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInTxHashToCctxDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctxData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctxData(ctx, req.(*QueryInTxHashToCctxDataRequest))
+func (m *QueryConvertGasToZetaRequest) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_InTxHashToCctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInTxHashToCctxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctxAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctxAll(ctx, req.(*QueryAllInTxHashToCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryConvertGasToZetaResponse struct {
+	OutboundGasInZeta string `protobuf:"bytes,1,opt,name=outboundGasInZeta,proto3" json:"outboundGasInZeta,omitempty"`
+	ProtocolFeeInZeta string `protobuf:"bytes,2,opt,name=protocolFeeInZeta,proto3" json:"protocolFeeInZeta,omitempty"`
+	ZetaBlockHeight   uint64 `protobuf:"varint,3,opt,name=ZetaBlockHeight,proto3" json:"ZetaBlockHeight,omitempty"`
 }
 
-func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPrice(ctx, req.(*QueryGetGasPriceRequest))
+func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasToZetaResponse{} }
+func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
+func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{52}
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertGasToZetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertGasToZetaResponse.Merge(m, src)
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertGasToZetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertGasToZetaResponse.DiscardUnknown(m)
 }
 
-func _Query_GasPriceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPriceAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPriceAll(ctx, req.(*QueryAllGasPriceRequest))
+var xxx_messageInfo_QueryConvertGasToZetaResponse proto.InternalMessageInfo
+
+func (m *QueryConvertGasToZetaResponse) GetOutboundGasInZeta() string {
+	if m != nil {
+		return m.OutboundGasInZeta
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ConvertGasToZeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConvertGasToZetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConvertGasToZeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConvertGasToZeta(ctx, req.(*QueryConvertGasToZetaRequest))
+func (m *QueryConvertGasToZetaResponse) GetProtocolFeeInZeta() string {
+	if m != nil {
+		return m.ProtocolFeeInZeta
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ProtocolFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMessagePassingProtocolFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProtocolFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ProtocolFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProtocolFee(ctx, req.(*QueryMessagePassingProtocolFeeRequest))
+func (m *QueryConvertGasToZetaResponse) GetZetaBlockHeight() uint64 {
+	if m != nil {
+		return m.ZetaBlockHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LastBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLastBlockHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastBlockHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastBlockHeight(ctx, req.(*QueryGetLastBlockHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryMessagePassingProtocolFeeRequest struct {
 }
 
-func _Query_LastBlockHeightAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLastBlockHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastBlockHeightAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastBlockHeightAll(ctx, req.(*QueryAllLastBlockHeightRequest))
+func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMessagePassingProtocolFeeRequest{} }
+func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
+func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{53}
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Merge(m, src)
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.DiscardUnknown(m)
 }
 
-func _Query_Cctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCctxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cctx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/Cctx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cctx(ctx, req.(*QueryGetCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryMessagePassingProtocolFeeRequest proto.InternalMessageInfo
+
+type QueryMessagePassingProtocolFeeResponse struct {
+	FeeInZeta string `protobuf:"bytes,1,opt,name=feeInZeta,proto3" json:"feeInZeta,omitempty"`
 }
 
-func _Query_CctxByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCctxByNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxByNonce(ctx, req.(*QueryGetCctxByNonceRequest))
+func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
+	*m = QueryMessagePassingProtocolFeeResponse{}
+}
+func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
+func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{54}
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Merge(m, src)
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.DiscardUnknown(m)
 }
 
-func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCctxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxAll(ctx, in)
+var xxx_messageInfo_QueryMessagePassingProtocolFeeResponse proto.InternalMessageInfo
+
+func (m *QueryMessagePassingProtocolFeeResponse) GetFeeInZeta() string {
+	if m != nil {
+		return m.FeeInZeta
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAll",
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryTssFundsMigrationProgressRequest)(nil), "zetachain.zetacore.crosschain.QueryTssFundsMigrationProgressRequest")
+	proto.RegisterType((*TssFundsMigrationCctx)(nil), "zetachain.zetacore.crosschain.TssFundsMigrationCctx")
+	proto.RegisterType((*TssFundsMigrationProgress)(nil), "zetachain.zetacore.crosschain.TssFundsMigrationProgress")
+	proto.RegisterType((*QueryTssFundsMigrationProgressResponse)(nil), "zetachain.zetacore.crosschain.QueryTssFundsMigrationProgressResponse")
+	proto.RegisterType((*QueryWithdrawalLimitsRequest)(nil), "zetachain.zetacore.crosschain.QueryWithdrawalLimitsRequest")
+	proto.RegisterType((*QueryWithdrawalLimitsResponse)(nil), "zetachain.zetacore.crosschain.QueryWithdrawalLimitsResponse")
+	proto.RegisterType((*QueryWithdrawalUsageRequest)(nil), "zetachain.zetacore.crosschain.QueryWithdrawalUsageRequest")
+	proto.RegisterType((*QueryWithdrawalUsageResponse)(nil), "zetachain.zetacore.crosschain.QueryWithdrawalUsageResponse")
+	proto.RegisterType((*QueryAllQueuedWithdrawalRequest)(nil), "zetachain.zetacore.crosschain.QueryAllQueuedWithdrawalRequest")
+	proto.RegisterType((*QueryAllQueuedWithdrawalResponse)(nil), "zetachain.zetacore.crosschain.QueryAllQueuedWithdrawalResponse")
+	proto.RegisterType((*QueryZetaAccountingRequest)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingRequest")
+	proto.RegisterType((*QueryZetaAccountingResponse)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.crosschain.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.crosschain.QueryParamsResponse")
+	proto.RegisterType((*QueryGetOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerRequest")
+	proto.RegisterType((*QueryGetOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerResponse")
+	proto.RegisterType((*QueryAllOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerRequest")
+	proto.RegisterType((*QueryAllOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerResponse")
+	proto.RegisterType((*QueryAllOutTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainRequest")
+	proto.RegisterType((*QueryAllOutTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainResponse")
+	proto.RegisterType((*QueryAllInTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainRequest")
+	proto.RegisterType((*QueryAllInTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainResponse")
+	proto.RegisterType((*QueryAllInTxTrackersRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersRequest")
+	proto.RegisterType((*QueryAllInTxTrackersResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersResponse")
+	proto.RegisterType((*QueryGetInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxRequest")
+	proto.RegisterType((*QueryGetInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxResponse")
+	proto.RegisterType((*QueryInTxHashToCctxDataRequest)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataRequest")
+	proto.RegisterType((*QueryInTxHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataResponse")
+	proto.RegisterType((*QueryAllInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxRequest")
+	proto.RegisterType((*QueryAllInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxResponse")
+	proto.RegisterType((*QueryGetGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceRequest")
+	proto.RegisterType((*QueryGetGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceResponse")
+	proto.RegisterType((*QueryAllGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceRequest")
+	proto.RegisterType((*QueryAllGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceResponse")
+	proto.RegisterType((*QueryGasPriceVotesRequest)(nil), "zetachain.zetacore.crosschain.QueryGasPriceVotesRequest")
+	proto.RegisterType((*QueryGasPriceVotesResponse)(nil), "zetachain.zetacore.crosschain.QueryGasPriceVotesResponse")
+	proto.RegisterType((*QueryGetLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightRequest")
+	proto.RegisterType((*QueryGetLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightResponse")
+	proto.RegisterType((*QueryAllLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightRequest")
+	proto.RegisterType((*QueryAllLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightResponse")
+	proto.RegisterType((*QueryGetCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxRequest")
+	proto.RegisterType((*QueryGetCctxByNonceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxByNonceRequest")
+	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
+	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
+	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
+	proto.RegisterType((*QueryListCctxPendingRequest)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingRequest")
+	proto.RegisterType((*QueryListCctxPendingResponse)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingResponse")
+	proto.RegisterType((*QueryCctxSearchRequest)(nil), "zetachain.zetacore.crosschain.QueryCctxSearchRequest")
+	proto.RegisterType((*QueryCctxSearchResponse)(nil), "zetachain.zetacore.crosschain.QueryCctxSearchResponse")
+	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
+	proto.RegisterType((*QueryLastZetaHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightResponse")
+	proto.RegisterType((*QueryConvertGasToZetaRequest)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaRequest")
+	proto.RegisterType((*QueryConvertGasToZetaResponse)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaResponse")
+	proto.RegisterType((*QueryMessagePassingProtocolFeeRequest)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeRequest")
+	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
+}
+
+func init() { proto.RegisterFile("crosschain/query.proto", fileDescriptor_65a992045e92a606) }
+
+var fileDescriptor_65a992045e92a606 = []byte{
+	// 2560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xf9, 0x2b, 0xf6, 0xb3, 0x13, 0xaf, 0x2b, 0xde, 0x64, 0xd2, 0xf1, 0x47, 0xd2, 0x26,
+	0xb1, 0x93, 0x90, 0x99, 0x64, 0x92, 0x38, 0x1f, 0xf6, 0x2e, 0xd8, 0xce, 0xda, 0x6b, 0x91, 0xec,
+	0x7a, 0x07, 0x87, 0x45, 0x01, 0x34, 0x6a, 0xf7, 0xd4, 0x8e, 0x5b, 0x99, 0xe9, 0x76, 0xa6, 0x7a,
+	0x62, 0x27, 0x91, 0x2f, 0x0b, 0xe2, 0x8c, 0x14, 0x09, 0x2e, 0x5c, 0x38, 0xb0, 0x70, 0xe0, 0x80,
+	0x04, 0x02, 0x04, 0x62, 0x11, 0x02, 0xb2, 0x39, 0xa1, 0x45, 0x48, 0x08, 0x2d, 0xd2, 0x0a, 0x25,
+	0xfc, 0x05, 0xfc, 0x05, 0xa8, 0xab, 0x5f, 0x4f, 0x7f, 0x4c, 0xf7, 0x4c, 0xb9, 0x3d, 0x2b, 0xb1,
+	0x97, 0xc4, 0x5d, 0x55, 0xef, 0xd5, 0xef, 0xf7, 0xea, 0xf5, 0xab, 0xea, 0xdf, 0x14, 0x1c, 0xd5,
+	0x6b, 0x16, 0xe7, 0xfa, 0xa6, 0x66, 0x98, 0xb9, 0x07, 0x75, 0x56, 0x7b, 0x94, 0xdd, 0xaa, 0x59,
+	0xb6, 0x45, 0xc7, 0x1f, 0x33, 0x5b, 0x13, 0xcd, 0x59, 0xf1, 0x97, 0x55, 0x63, 0x59, 0x7f, 0xa8,
+	0x72, 0x4e, 0xb7, 0x78, 0xd5, 0xe2, 0xb9, 0x0d, 0x8d, 0x33, 0xd7, 0x2e, 0xf7, 0xf0, 0xd2, 0x06,
+	0xb3, 0xb5, 0x4b, 0xb9, 0x2d, 0xad, 0x6c, 0x98, 0x9a, 0x6d, 0x58, 0xa6, 0xeb, 0x4a, 0x99, 0x0c,
+	0x4c, 0x21, 0xfe, 0x2c, 0x8a, 0xbf, 0x8b, 0xf6, 0x0e, 0x0e, 0x50, 0x02, 0x03, 0xca, 0x1a, 0x2f,
+	0x6e, 0xd5, 0x0c, 0x9d, 0x61, 0xdf, 0x54, 0xa0, 0x4f, 0xd8, 0x14, 0x37, 0x35, 0xbe, 0x59, 0xb4,
+	0xad, 0xa2, 0xae, 0x37, 0x1c, 0x4c, 0x34, 0x0d, 0xb2, 0x6b, 0x9a, 0x7e, 0x9f, 0xd5, 0xb0, 0x5f,
+	0x0d, 0xf4, 0x57, 0x34, 0x6e, 0x17, 0x37, 0x2a, 0x96, 0x7e, 0xbf, 0xb8, 0xc9, 0x8c, 0xf2, 0xa6,
+	0x1d, 0x83, 0xd2, 0xaa, 0xdb, 0xcd, 0x4e, 0x8e, 0x05, 0x06, 0x6c, 0x69, 0x35, 0xad, 0xca, 0xb1,
+	0xe3, 0x54, 0xa0, 0x63, 0xdb, 0xb0, 0x37, 0x4b, 0x35, 0x6d, 0x5b, 0xab, 0x14, 0x2b, 0x46, 0xd5,
+	0xf0, 0x9c, 0x8f, 0x96, 0xad, 0xb2, 0x25, 0xfe, 0xcc, 0x39, 0x7f, 0x61, 0xeb, 0x58, 0xd9, 0xb2,
+	0xca, 0x15, 0x96, 0xd3, 0xb6, 0x8c, 0x9c, 0x66, 0x9a, 0x96, 0x2d, 0xa2, 0x86, 0x6e, 0xd5, 0x69,
+	0x38, 0xfd, 0x8e, 0x13, 0xd8, 0x75, 0xce, 0x97, 0xeb, 0x66, 0x89, 0xdf, 0x31, 0xca, 0x35, 0x31,
+	0x60, 0xad, 0x66, 0x95, 0x6b, 0x8c, 0xf3, 0x02, 0x7b, 0x50, 0x67, 0xdc, 0x56, 0x9f, 0x76, 0xc1,
+	0xab, 0x4d, 0x83, 0x96, 0x74, 0x7b, 0x87, 0x8e, 0x42, 0xaf, 0x61, 0x96, 0xd8, 0x4e, 0x86, 0x9c,
+	0x24, 0x33, 0x03, 0x05, 0xf7, 0x81, 0x66, 0xe0, 0xa0, 0x6e, 0x55, 0xab, 0x9a, 0x59, 0xca, 0x74,
+	0x89, 0x76, 0xef, 0x91, 0x2e, 0x40, 0x1f, 0xb7, 0x35, 0xbb, 0xce, 0x33, 0xdd, 0x27, 0xc9, 0xcc,
+	0xe1, 0xfc, 0xd9, 0x6c, 0xcb, 0x2c, 0xc8, 0x3a, 0x93, 0x7c, 0x55, 0x18, 0x14, 0xd0, 0xd0, 0x99,
+	0xd2, 0xb4, 0x4c, 0x9d, 0x65, 0x7a, 0x4e, 0x92, 0x99, 0x9e, 0x82, 0xfb, 0x40, 0x57, 0xa0, 0x4f,
+	0xab, 0x5a, 0x75, 0xd3, 0xce, 0xf4, 0x3a, 0x33, 0x2e, 0xe6, 0x9e, 0x7f, 0x3a, 0x79, 0xe0, 0x93,
+	0x4f, 0x27, 0xa7, 0xcb, 0x86, 0xbd, 0x59, 0xdf, 0xc8, 0xea, 0x56, 0x35, 0x87, 0x19, 0xe5, 0xfe,
+	0x77, 0x81, 0x97, 0xee, 0xe7, 0xec, 0x47, 0x5b, 0x8c, 0x67, 0xef, 0x1a, 0xa6, 0x5d, 0x40, 0x73,
+	0x3a, 0x03, 0xaf, 0x58, 0x75, 0x7b, 0xc3, 0xaa, 0x9b, 0x25, 0x2f, 0x17, 0x32, 0x7d, 0x82, 0xc4,
+	0x61, 0xaf, 0x7d, 0x7d, 0xe7, 0x4d, 0x8d, 0x6f, 0xaa, 0x1f, 0x10, 0x38, 0x9e, 0x18, 0x3a, 0x7a,
+	0x1c, 0xfa, 0xdd, 0x24, 0x34, 0x4a, 0x22, 0x38, 0xdd, 0x85, 0x83, 0xe2, 0x79, 0xb5, 0x44, 0xd7,
+	0xa0, 0xd7, 0x49, 0x2d, 0x9e, 0xe9, 0x3a, 0xd9, 0x3d, 0x33, 0x98, 0xbf, 0xd2, 0x26, 0x06, 0xb1,
+	0x91, 0x5f, 0xec, 0x71, 0x08, 0x16, 0x5c, 0x47, 0x74, 0x0c, 0x06, 0x74, 0xab, 0xba, 0x55, 0x61,
+	0x36, 0x2b, 0x89, 0xc8, 0xf6, 0x17, 0xfc, 0x06, 0xf5, 0x3b, 0x04, 0xce, 0xb4, 0x5b, 0x68, 0xbe,
+	0x65, 0x99, 0x9c, 0xd1, 0x7b, 0xd0, 0xbf, 0x85, 0x6d, 0x19, 0x22, 0xd0, 0x5d, 0xdf, 0x2b, 0x3a,
+	0xcf, 0x27, 0x22, 0x6c, 0xf8, 0x53, 0x27, 0x60, 0x4c, 0xa0, 0x78, 0xb7, 0x91, 0xc1, 0xb7, 0x9d,
+	0x04, 0x6e, 0x64, 0xd9, 0xb7, 0x09, 0x8c, 0x27, 0x0c, 0x40, 0x74, 0x1b, 0x30, 0x12, 0x4d, 0x7f,
+	0x2e, 0x82, 0x3b, 0x98, 0xcf, 0xb5, 0x81, 0x19, 0xf5, 0x89, 0xe8, 0x5e, 0xd9, 0x8e, 0xb4, 0xab,
+	0xe3, 0x70, 0x22, 0x02, 0xe2, 0x2e, 0xd7, 0xca, 0xcc, 0x03, 0xf9, 0x5f, 0x02, 0x63, 0xf1, 0xfd,
+	0x88, 0xf1, 0x28, 0xf4, 0x6d, 0x1b, 0x66, 0xc9, 0xda, 0xc6, 0x55, 0xc7, 0x27, 0xfa, 0x4d, 0x18,
+	0x7a, 0x5c, 0xd3, 0xf3, 0x17, 0x8b, 0x75, 0x67, 0xb8, 0xb7, 0xf6, 0x97, 0xdb, 0xc0, 0xbe, 0x57,
+	0x58, 0xca, 0x5f, 0x8c, 0x4c, 0x85, 0xd0, 0x07, 0x85, 0x3b, 0xd1, 0xc2, 0x1d, 0xef, 0x6e, 0xb6,
+	0xa1, 0xf7, 0x6e, 0x29, 0xef, 0x4b, 0xce, 0xbf, 0x09, 0xde, 0xc5, 0x08, 0xd7, 0xbb, 0x6a, 0xc0,
+	0xa4, 0xe0, 0xbc, 0x50, 0xa9, 0xbc, 0x53, 0x67, 0x75, 0x56, 0xf2, 0x6d, 0x30, 0x2e, 0x74, 0x19,
+	0xc0, 0x2f, 0xcb, 0xb8, 0x26, 0x67, 0xb2, 0xee, 0xab, 0x96, 0x75, 0x6a, 0x78, 0xd6, 0xad, 0xfd,
+	0x58, 0xc3, 0xb3, 0x6b, 0x7e, 0x4c, 0x0b, 0x01, 0x4b, 0xf5, 0x6f, 0x04, 0x4e, 0x26, 0xcf, 0x85,
+	0x31, 0x2e, 0x01, 0x7d, 0x20, 0xfa, 0x8a, 0xfe, 0xf2, 0x79, 0xf9, 0xda, 0x2e, 0x11, 0xa2, 0x4e,
+	0x91, 0xef, 0xc8, 0x83, 0x48, 0x3b, 0xa7, 0x2b, 0x21, 0x4a, 0x5d, 0x82, 0xd2, 0x74, 0x5b, 0x4a,
+	0x2e, 0xc4, 0x10, 0xa7, 0x31, 0x50, 0x04, 0xa5, 0x7b, 0xcc, 0xd6, 0x16, 0x74, 0xdd, 0x29, 0x33,
+	0x86, 0x59, 0xf6, 0x32, 0xea, 0x0e, 0x9c, 0x88, 0xed, 0x45, 0xae, 0x59, 0x38, 0xa2, 0x6d, 0x58,
+	0x35, 0x9b, 0x95, 0x8a, 0x0e, 0x9d, 0x22, 0x56, 0x39, 0xb7, 0xde, 0x8e, 0x60, 0x97, 0xb0, 0x15,
+	0x1d, 0xea, 0x28, 0x50, 0xe1, 0x6e, 0x4d, 0x6c, 0x20, 0xde, 0x24, 0xf7, 0xe0, 0x48, 0xa8, 0x15,
+	0x9d, 0x2f, 0x41, 0x9f, 0xbb, 0xd1, 0xe0, 0x8a, 0x9d, 0x6e, 0x13, 0x3c, 0xd7, 0x1c, 0x43, 0x86,
+	0xa6, 0x0d, 0x02, 0x2b, 0xcc, 0x7e, 0xbb, 0x6e, 0xaf, 0xef, 0xac, 0xbb, 0x9b, 0x9a, 0x97, 0x19,
+	0xce, 0x66, 0x20, 0x0a, 0xdf, 0xad, 0x70, 0x1d, 0xbc, 0xe5, 0x57, 0xf2, 0xae, 0x40, 0x25, 0x57,
+	0xeb, 0x30, 0x16, 0xef, 0x0e, 0x31, 0xdf, 0x85, 0x21, 0x2b, 0xd0, 0x8e, 0xc8, 0xcf, 0xb7, 0x41,
+	0x1e, 0x74, 0x85, 0xf8, 0x43, 0x6e, 0x54, 0x86, 0x2c, 0x16, 0x2a, 0x95, 0x38, 0x16, 0x9d, 0xca,
+	0xef, 0x0f, 0xbd, 0xfa, 0xd1, 0x34, 0x4f, 0x22, 0xbd, 0xee, 0x0e, 0xd0, 0xeb, 0x5c, 0x32, 0xbf,
+	0x4f, 0x40, 0x8d, 0x23, 0xb0, 0xf8, 0x48, 0x54, 0x13, 0x2f, 0x5e, 0xa3, 0xd0, 0x2b, 0x90, 0xe1,
+	0x9a, 0xbb, 0x0f, 0x74, 0x39, 0x06, 0x45, 0x9a, 0x28, 0xfe, 0x85, 0xc0, 0x54, 0x4b, 0x10, 0x9f,
+	0x93, 0x60, 0x7e, 0x97, 0xc0, 0x29, 0x8f, 0xc7, 0xaa, 0x99, 0x14, 0xcb, 0x16, 0x47, 0x89, 0x4e,
+	0x05, 0xf4, 0x8f, 0x81, 0x55, 0x8d, 0x03, 0x82, 0xf1, 0x2c, 0xc0, 0xa0, 0x61, 0x46, 0xc3, 0x79,
+	0xae, 0x4d, 0x38, 0x57, 0xcd, 0x68, 0x34, 0x83, 0x4e, 0x3a, 0x17, 0xcc, 0xc0, 0x1b, 0x1c, 0x98,
+	0x92, 0x77, 0xfa, 0x0d, 0xfe, 0x5d, 0xe0, 0x0d, 0x0e, 0xcf, 0xf3, 0x79, 0x08, 0xd2, 0x1c, 0x9e,
+	0xb1, 0x56, 0x98, 0xbd, 0x6a, 0xba, 0x07, 0xd9, 0x75, 0xcb, 0x39, 0x50, 0x7a, 0x61, 0x52, 0xa0,
+	0xdf, 0xc0, 0x0e, 0xdc, 0x64, 0x1a, 0xcf, 0xea, 0x2e, 0x4c, 0x24, 0x19, 0x23, 0xf7, 0x6f, 0xc0,
+	0x61, 0x23, 0xd4, 0x83, 0x81, 0xbe, 0x20, 0x41, 0xdf, 0x37, 0xc2, 0x08, 0x44, 0x5c, 0xa9, 0xf3,
+	0x38, 0x7d, 0x78, 0xf0, 0x2d, 0xcd, 0xd6, 0x64, 0xc0, 0x3f, 0x86, 0xc9, 0x44, 0x6b, 0x44, 0xff,
+	0x2e, 0x1c, 0x5a, 0x72, 0x30, 0x89, 0xa4, 0x5f, 0xdf, 0xe1, 0x92, 0xf5, 0x22, 0x68, 0x83, 0xd0,
+	0xc3, 0x7e, 0xd4, 0x32, 0x8c, 0x07, 0x53, 0xa6, 0x39, 0xea, 0x9d, 0x4a, 0xce, 0x67, 0x04, 0x26,
+	0x92, 0x66, 0x6a, 0xb1, 0x44, 0xdd, 0x1d, 0x5a, 0xa2, 0xce, 0xe5, 0x69, 0x0e, 0x8e, 0x79, 0xa9,
+	0xb6, 0xa2, 0xf1, 0xb5, 0x9a, 0xa1, 0xb3, 0xc0, 0xd6, 0xd2, 0xfc, 0xcd, 0xa9, 0x16, 0x21, 0xd3,
+	0x6c, 0xd0, 0x38, 0xe6, 0xf4, 0x7b, 0x6d, 0x18, 0xdb, 0xe9, 0x36, 0x64, 0x1b, 0x2e, 0x1a, 0x86,
+	0xaa, 0x86, 0x88, 0x16, 0x2a, 0x95, 0x28, 0xa2, 0x4e, 0xad, 0xde, 0x4f, 0x09, 0x64, 0x9a, 0xe7,
+	0x88, 0x25, 0xd1, 0x9d, 0x8a, 0x44, 0xe7, 0xd6, 0x67, 0x16, 0x8e, 0xbb, 0xe1, 0x46, 0xcf, 0x5f,
+	0xb3, 0x6c, 0xc6, 0xdb, 0x6f, 0x58, 0xea, 0x6f, 0x08, 0x28, 0x71, 0x86, 0x48, 0x72, 0x05, 0x7a,
+	0x1f, 0x3a, 0x0d, 0x92, 0x6f, 0x5e, 0xd0, 0x89, 0xf7, 0x45, 0x2c, 0xec, 0xe9, 0x29, 0x18, 0xaa,
+	0xb2, 0x92, 0xa1, 0x99, 0xae, 0xd6, 0x83, 0x47, 0xcc, 0x41, 0xb7, 0xcd, 0x8d, 0x45, 0x16, 0x8e,
+	0xf8, 0x43, 0xac, 0x9a, 0x61, 0x3f, 0x2a, 0xbe, 0xc7, 0x98, 0xf8, 0x7c, 0xee, 0x29, 0x8c, 0x34,
+	0x46, 0x8a, 0x9e, 0x65, 0xc6, 0xd4, 0x59, 0xbf, 0xfa, 0xdd, 0xd6, 0xb8, 0xbd, 0xe8, 0x28, 0x3c,
+	0x6f, 0x0a, 0x81, 0xa7, 0x75, 0x66, 0x3e, 0x81, 0xc9, 0x44, 0x3b, 0xa4, 0xfd, 0x75, 0x18, 0x8e,
+	0x74, 0x61, 0x16, 0x65, 0xdb, 0x04, 0x20, 0xea, 0x30, 0xea, 0x46, 0xdd, 0xf4, 0xeb, 0x41, 0x02,
+	0xe8, 0x4e, 0x25, 0xef, 0x9f, 0x09, 0x4c, 0x26, 0x4e, 0xd5, 0x8a, 0x67, 0x77, 0x07, 0x78, 0x76,
+	0x2e, 0xb1, 0xcf, 0xe3, 0x97, 0xd2, 0x0a, 0xb3, 0x83, 0x05, 0x3a, 0x7e, 0x69, 0x6f, 0x83, 0x12,
+	0x1c, 0xbc, 0xf8, 0xe8, 0x2d, 0xcb, 0xd4, 0x59, 0xda, 0x2f, 0x9f, 0x32, 0x8c, 0x86, 0xa7, 0xc6,
+	0xa8, 0xbd, 0x0d, 0x43, 0xc1, 0xed, 0x44, 0xf2, 0x8b, 0x27, 0x68, 0x52, 0x08, 0x39, 0x50, 0xbf,
+	0x85, 0x1c, 0x17, 0x2a, 0x95, 0xcf, 0x62, 0x13, 0xfa, 0x39, 0x81, 0xd1, 0xb0, 0xff, 0x44, 0x22,
+	0xdd, 0xfb, 0x22, 0xd2, 0xb9, 0x55, 0x7f, 0x0b, 0xcf, 0x8e, 0xb7, 0x0d, 0x2e, 0x62, 0xbf, 0xc6,
+	0xcc, 0x92, 0xff, 0x8d, 0xde, 0xea, 0x04, 0x3e, 0x0a, 0xbd, 0x42, 0x88, 0x12, 0xb3, 0x1f, 0x2a,
+	0xb8, 0x0f, 0xea, 0x53, 0xef, 0x90, 0xd8, 0xe4, 0xf0, 0xb3, 0x0a, 0x85, 0x0a, 0x43, 0xb6, 0x65,
+	0x6b, 0x15, 0x9c, 0x08, 0x33, 0x2b, 0xd4, 0xa6, 0xfe, 0xb5, 0x0b, 0x8e, 0x0a, 0x54, 0x42, 0x56,
+	0x65, 0x5a, 0x4d, 0xdf, 0xf4, 0x18, 0x1e, 0x85, 0x3e, 0xce, 0xcc, 0x12, 0x7e, 0x4f, 0x0f, 0x14,
+	0xf0, 0xc9, 0x39, 0x51, 0xd5, 0x98, 0xce, 0x8c, 0x87, 0xac, 0x86, 0x5a, 0x6e, 0xe3, 0x59, 0xd8,
+	0xf8, 0x62, 0xee, 0x40, 0x43, 0xa1, 0x3d, 0x03, 0xc3, 0xae, 0x75, 0xb1, 0x11, 0xb4, 0x1e, 0x11,
+	0xb4, 0x43, 0x6e, 0xf3, 0x12, 0x86, 0xee, 0x1c, 0x8c, 0x78, 0xbe, 0xfc, 0x91, 0xbd, 0x62, 0xe4,
+	0xb0, 0xd7, 0xe1, 0x8d, 0x9d, 0x86, 0x61, 0x6e, 0x6b, 0x35, 0xbb, 0x68, 0x1b, 0x55, 0xc6, 0x6d,
+	0xad, 0xba, 0x25, 0x54, 0xd9, 0xee, 0xc2, 0x61, 0xd1, 0xbc, 0xee, 0xb5, 0xd2, 0x29, 0x38, 0xc4,
+	0x1c, 0xe9, 0xb6, 0x31, 0xec, 0xa0, 0x18, 0x36, 0xc4, 0xcc, 0x92, 0x3f, 0x28, 0x9c, 0xe9, 0xfd,
+	0xa9, 0x33, 0xfd, 0x17, 0x04, 0x8e, 0x35, 0x05, 0xf4, 0xff, 0x3e, 0xd9, 0x3d, 0x3d, 0xca, 0xa9,
+	0xa1, 0x8e, 0x72, 0x14, 0xda, 0x0f, 0xd4, 0xab, 0x70, 0x22, 0xb6, 0xd7, 0xd7, 0x37, 0x03, 0x3b,
+	0x54, 0x77, 0x01, 0x9f, 0xd4, 0x75, 0x4c, 0xf8, 0x25, 0xcb, 0x7c, 0xc8, 0x6a, 0xce, 0x19, 0x6c,
+	0xdd, 0x72, 0xcc, 0x9b, 0x8a, 0x61, 0xd3, 0x1b, 0xa4, 0x40, 0x7f, 0x59, 0xe3, 0xb7, 0x1b, 0x2f,
+	0xd1, 0x40, 0xa1, 0xf1, 0xac, 0xfe, 0xd8, 0xd3, 0x84, 0x9b, 0xdd, 0x22, 0x9e, 0x2f, 0xc2, 0x88,
+	0xa7, 0xcb, 0xaf, 0x68, 0x7c, 0xd5, 0x74, 0x3a, 0x3d, 0x75, 0xac, 0xa9, 0xc3, 0x19, 0x2d, 0x7e,
+	0xfb, 0xd0, 0xad, 0xca, 0x32, 0x63, 0x38, 0xda, 0x9d, 0xb4, 0xb9, 0x83, 0xce, 0xc0, 0xb0, 0xf3,
+	0x7f, 0x70, 0xbb, 0x72, 0x4f, 0x07, 0xd1, 0xe6, 0xc6, 0x4f, 0x29, 0x77, 0x18, 0xe7, 0x5a, 0x99,
+	0xad, 0x69, 0x9c, 0x1b, 0x66, 0x79, 0xcd, 0xf7, 0xe8, 0x45, 0x77, 0x19, 0xce, 0xb4, 0x1b, 0x88,
+	0xc4, 0xc6, 0x60, 0xe0, 0x3d, 0xc6, 0x42, 0x84, 0xfc, 0x86, 0xfc, 0x8f, 0x66, 0xa0, 0x57, 0x38,
+	0xa2, 0xdf, 0x27, 0xd0, 0xe7, 0xea, 0x72, 0xf4, 0x52, 0x7b, 0xed, 0x33, 0x22, 0x0c, 0x2a, 0xf9,
+	0xbd, 0x98, 0xb8, 0xc8, 0xd4, 0xd3, 0xef, 0xff, 0xfd, 0x3f, 0x4f, 0xbb, 0x26, 0xe9, 0x78, 0xce,
+	0xb1, 0xb8, 0x10, 0xf8, 0xdd, 0x2d, 0xf8, 0xdb, 0x15, 0x7d, 0x46, 0x60, 0x28, 0x28, 0xa5, 0xd0,
+	0x9b, 0x32, 0x73, 0xc5, 0xab, 0x88, 0xca, 0x5c, 0x2a, 0x5b, 0x04, 0xfc, 0x9a, 0x00, 0x7c, 0x8d,
+	0x5e, 0x4d, 0x00, 0x1c, 0x14, 0x77, 0x72, 0x4f, 0x70, 0x93, 0xde, 0xcd, 0x3d, 0x11, 0xdb, 0xf2,
+	0x2e, 0xfd, 0x35, 0x81, 0xe1, 0xa0, 0xdf, 0x85, 0x4a, 0x45, 0x8e, 0x4b, 0xbc, 0x96, 0xa8, 0xcc,
+	0xa5, 0xb2, 0x45, 0x2e, 0xe7, 0x05, 0x97, 0xd3, 0x74, 0x4a, 0x82, 0x0b, 0xfd, 0x17, 0x81, 0xa3,
+	0x11, 0xe4, 0x28, 0xe9, 0xd0, 0x85, 0x14, 0x20, 0xc2, 0xba, 0x94, 0xb2, 0xb8, 0x1f, 0x17, 0x48,
+	0xe7, 0xa6, 0xa0, 0x73, 0x85, 0xe6, 0x25, 0xe8, 0xa0, 0x2d, 0xae, 0xd0, 0x2e, 0xfd, 0x84, 0xc0,
+	0xab, 0xab, 0x66, 0x1c, 0xb9, 0x2f, 0x4b, 0x22, 0x4b, 0xd4, 0xdc, 0x94, 0x85, 0x7d, 0x78, 0x40,
+	0x6a, 0xf3, 0x82, 0xda, 0x2c, 0xbd, 0x92, 0x40, 0xcd, 0x30, 0x13, 0x98, 0x15, 0x8d, 0xd2, 0x2e,
+	0xfd, 0x15, 0x81, 0xc3, 0xab, 0x66, 0xaa, 0x9c, 0x8b, 0x51, 0xbf, 0x94, 0xb9, 0x54, 0xb6, 0x92,
+	0x39, 0x17, 0x60, 0xc2, 0xe9, 0x47, 0x08, 0x3c, 0xa0, 0x0a, 0xcc, 0x4b, 0xbe, 0xbc, 0xb1, 0xda,
+	0x88, 0xf2, 0x5a, 0x4a, 0x6b, 0x04, 0x7f, 0x5d, 0x80, 0xcf, 0xd3, 0x8b, 0x2d, 0xc0, 0xfb, 0x66,
+	0xb9, 0x27, 0xde, 0xf3, 0x2e, 0xfd, 0x07, 0x01, 0xda, 0xac, 0x16, 0x51, 0x29, 0x3c, 0x89, 0x1a,
+	0x95, 0xf2, 0x7a, 0x5a, 0x73, 0xe4, 0xb3, 0x20, 0xf8, 0xcc, 0xd1, 0x1b, 0x89, 0x7c, 0xa2, 0x77,
+	0x18, 0x8a, 0x25, 0xcd, 0xd6, 0x82, 0xc4, 0xfe, 0x40, 0x60, 0x24, 0x3c, 0x83, 0x93, 0x5e, 0xf3,
+	0x7b, 0x48, 0x91, 0x94, 0xab, 0x94, 0xa8, 0x4a, 0xa9, 0x17, 0x04, 0xab, 0x69, 0x7a, 0x5a, 0x6a,
+	0x95, 0xe8, 0xcf, 0x88, 0xaf, 0x86, 0xd0, 0x59, 0xc9, 0x04, 0x89, 0xc8, 0x36, 0xca, 0xb5, 0x3d,
+	0xdb, 0x21, 0xd8, 0x9c, 0x00, 0x7b, 0x96, 0x4e, 0x27, 0x80, 0x2d, 0xa3, 0x81, 0x13, 0xf3, 0x12,
+	0xdb, 0xd9, 0xa5, 0x3f, 0x21, 0x30, 0xe8, 0x79, 0x71, 0x42, 0x3d, 0x2b, 0x19, 0xac, 0x54, 0x88,
+	0x63, 0xc4, 0x23, 0x75, 0x5a, 0x20, 0x3e, 0x45, 0x27, 0xdb, 0x20, 0xa6, 0xbf, 0x27, 0x70, 0x28,
+	0x24, 0xcd, 0xd0, 0xeb, 0x52, 0x51, 0x8a, 0x91, 0x81, 0x94, 0x1b, 0x29, 0x2c, 0x11, 0xef, 0x35,
+	0x81, 0xf7, 0x12, 0xcd, 0xb5, 0xc1, 0x2b, 0xac, 0x82, 0x65, 0xf3, 0x43, 0x02, 0xaf, 0x44, 0xcf,
+	0x8a, 0x54, 0xaa, 0xf8, 0x25, 0x1c, 0x5c, 0x95, 0xf9, 0x74, 0xc6, 0x92, 0xa9, 0xa2, 0x47, 0xb1,
+	0x3e, 0x23, 0x30, 0x18, 0x38, 0x0e, 0xd2, 0x5b, 0x32, 0xd3, 0xb7, 0x3b, 0x76, 0x2a, 0x6f, 0xec,
+	0xd3, 0x0b, 0xb2, 0x39, 0x27, 0xd8, 0x7c, 0x81, 0xaa, 0x49, 0x27, 0xbf, 0x00, 0xf0, 0xe7, 0xa4,
+	0x49, 0xec, 0xa1, 0xb2, 0xa5, 0x3c, 0x5e, 0xaa, 0x52, 0x5e, 0x4f, 0x6b, 0x8e, 0xf0, 0x67, 0x05,
+	0xfc, 0x8b, 0x34, 0x9b, 0x00, 0xbf, 0x12, 0xb6, 0x6b, 0xbc, 0xbe, 0x7f, 0x22, 0x40, 0x23, 0x3e,
+	0x9d, 0xb7, 0x58, 0xb6, 0xe4, 0xed, 0x87, 0x4d, 0xb2, 0x98, 0xa6, 0x66, 0x05, 0x9b, 0x19, 0x7a,
+	0x46, 0x8e, 0x0d, 0xfd, 0x21, 0x81, 0x1e, 0x51, 0x3c, 0xf3, 0x92, 0x61, 0x0c, 0x96, 0xf7, 0xcb,
+	0x7b, 0xb2, 0x91, 0x3c, 0x37, 0xe8, 0xb8, 0xe1, 0x8a, 0x20, 0xff, 0x92, 0xc0, 0x60, 0x40, 0x44,
+	0xa3, 0x37, 0xf6, 0x30, 0x63, 0x58, 0x78, 0x4b, 0x07, 0xf6, 0xaa, 0x00, 0x9b, 0xa3, 0x17, 0x5a,
+	0x82, 0x6d, 0xfa, 0x38, 0xf8, 0x01, 0x81, 0x83, 0xde, 0x0e, 0x9a, 0x97, 0x5c, 0xd1, 0x3d, 0x07,
+	0x36, 0x22, 0xa4, 0xa9, 0x53, 0x02, 0xeb, 0x38, 0x3d, 0xd1, 0x02, 0xab, 0x73, 0x82, 0x1c, 0x76,
+	0xac, 0x1c, 0x09, 0x0a, 0x15, 0x20, 0xb9, 0x23, 0x64, 0xbc, 0x08, 0xa6, 0xcc, 0xa5, 0xb2, 0x95,
+	0xac, 0x1c, 0xba, 0x6f, 0x43, 0x3f, 0x20, 0x00, 0xbe, 0xa0, 0x42, 0xaf, 0x4a, 0x15, 0xe0, 0xa8,
+	0xa2, 0xa5, 0xcc, 0xee, 0xd5, 0x0c, 0x91, 0x9e, 0x15, 0x48, 0xa7, 0xe8, 0xa9, 0x16, 0x48, 0x11,
+	0x99, 0x73, 0x46, 0x0f, 0x5f, 0xdb, 0x91, 0xcb, 0xda, 0xd8, 0x8b, 0x40, 0xca, 0xcd, 0x34, 0xa6,
+	0x92, 0xc7, 0xa7, 0xc7, 0x61, 0x94, 0x0e, 0xf0, 0xb0, 0xbe, 0x23, 0x07, 0x3c, 0x56, 0x31, 0x52,
+	0x6e, 0xa6, 0x31, 0x95, 0x04, 0x5e, 0x09, 0xa3, 0x74, 0xb6, 0xf7, 0xe8, 0x55, 0x3e, 0xb9, 0xed,
+	0x3d, 0xe1, 0xd6, 0xa1, 0x32, 0x9f, 0xce, 0x58, 0x72, 0x7b, 0x8f, 0x5e, 0x2f, 0xa4, 0xbf, 0x25,
+	0x30, 0x1c, 0xb9, 0x71, 0x27, 0xf7, 0x52, 0xc6, 0xdf, 0x47, 0x54, 0xe6, 0x52, 0xd9, 0x4a, 0xee,
+	0x20, 0xdb, 0x11, 0xa0, 0x1f, 0x11, 0xf1, 0xc3, 0x41, 0xe8, 0x9e, 0x9c, 0x53, 0xf7, 0x64, 0x77,
+	0xb2, 0x84, 0xcb, 0x83, 0xca, 0x97, 0x52, 0xdb, 0x4b, 0x2e, 0x43, 0xf4, 0x72, 0x1f, 0x7d, 0xd1,
+	0xf2, 0xee, 0xae, 0xd4, 0x99, 0xab, 0xdd, 0xad, 0x69, 0xe5, 0x8d, 0x7d, 0x7a, 0x91, 0xfc, 0x7e,
+	0xb5, 0x13, 0x2f, 0xe0, 0x7e, 0xe5, 0xf9, 0x8b, 0x09, 0xf2, 0xf1, 0x8b, 0x09, 0xf2, 0xef, 0x17,
+	0x13, 0xe4, 0x7b, 0x2f, 0x27, 0x0e, 0x7c, 0xfc, 0x72, 0xe2, 0xc0, 0x3f, 0x5f, 0x4e, 0x1c, 0xb8,
+	0x77, 0x29, 0x70, 0x2b, 0x3a, 0xe0, 0xd5, 0x43, 0x99, 0xdb, 0x09, 0x4d, 0xe0, 0x5c, 0x92, 0xde,
+	0xe8, 0x13, 0x67, 0xbb, 0xcb, 0xff, 0x1b, 0x00, 0x85, 0xde, 0x0e, 0x15, 0xcf, 0x2f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a OutTxTracker by index.
+	OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error)
+	// Queries a list of OutTxTracker items.
+	OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error)
+	OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error)
+	InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error)
+	InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error)
+	// Queries a InTxHashToCctx by index.
+	InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error)
+	// Queries a InTxHashToCctx data by index.
+	InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error)
+	// Queries a list of InTxHashToCctx items.
+	InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error)
+	// Queries a gasPrice by index.
+	GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error)
+	// Queries a list of gasPrice items.
+	GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error)
+	// Queries the gas price votes of a chain with their freshness.
+	GasPriceVotes(ctx context.Context, in *QueryGasPriceVotesRequest, opts ...grpc.CallOption) (*QueryGasPriceVotesResponse, error)
+	ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error)
+	ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error)
+	// Queries a lastBlockHeight by index.
+	LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error)
+	// Queries a list of lastBlockHeight items.
+	LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error)
+	// Queries a send by index.
+	Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
+	// Queries a send by nonce.
+	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
+	// Queries a list of send items.
+	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries a list of pending cctxs.
+	CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error)
+	// Queries a list of cctxs filtered by sender, receiver, status, sender and receiver chain in a creation time range.
+	CctxSearch(ctx context.Context, in *QueryCctxSearchRequest, opts ...grpc.CallOption) (*QueryCctxSearchResponse, error)
+	ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error)
+	// Queries a list of lastMetaHeight items.
+	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
+	// Queries the withdrawal limits.
+	WithdrawalLimits(ctx context.Context, in *QueryWithdrawalLimitsRequest, opts ...grpc.CallOption) (*QueryWithdrawalLimitsResponse, error)
+	// Queries the amounts withdrawn in the current window of the withdrawal limits.
+	WithdrawalUsage(ctx context.Context, in *QueryWithdrawalUsageRequest, opts ...grpc.CallOption) (*QueryWithdrawalUsageResponse, error)
+	// Queries a list of withdrawals queued for exceeding the withdrawal limits.
+	QueuedWithdrawalAll(ctx context.Context, in *QueryAllQueuedWithdrawalRequest, opts ...grpc.CallOption) (*QueryAllQueuedWithdrawalResponse, error)
+	// Queries the progress of the TSS funds migration of each chain.
+	TssFundsMigrationProgress(ctx context.Context, in *QueryTssFundsMigrationProgressRequest, opts ...grpc.CallOption) (*QueryTssFundsMigrationProgressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxAll(ctx, req.(*QueryAllCctxRequest))
+	return out, nil
+}
+
+func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error) {
+	out := new(QueryGetOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CctxListPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListCctxPendingRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error) {
+	out := new(QueryAllOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxListPending(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error) {
+	out := new(QueryAllOutTxTrackerByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxListPending",
+	return out, nil
+}
+
+func (c *queryClient) InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error) {
+	out := new(QueryAllInTxTrackerByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxListPending(ctx, req.(*QueryListCctxPendingRequest))
+	return out, nil
+}
+
+func (c *queryClient) InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error) {
+	out := new(QueryAllInTxTrackersResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ZetaAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryZetaAccountingRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error) {
+	out := new(QueryGetInTxHashToCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctx", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ZetaAccounting(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error) {
+	out := new(QueryInTxHashToCctxDataResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ZetaAccounting",
+	return out, nil
+}
+
+func (c *queryClient) InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error) {
+	out := new(QueryAllInTxHashToCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ZetaAccounting(ctx, req.(*QueryZetaAccountingRequest))
+	return out, nil
+}
+
+func (c *queryClient) GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error) {
+	out := new(QueryGetGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LastZetaHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastZetaHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error) {
+	out := new(QueryAllGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastZetaHeight(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GasPriceVotes(ctx context.Context, in *QueryGasPriceVotesRequest, opts ...grpc.CallOption) (*QueryGasPriceVotesResponse, error) {
+	out := new(QueryGasPriceVotesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastZetaHeight",
+	return out, nil
+}
+
+func (c *queryClient) ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error) {
+	out := new(QueryConvertGasToZetaResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastZetaHeight(ctx, req.(*QueryLastZetaHeightRequest))
+	return out, nil
+}
+
+func (c *queryClient) ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error) {
+	out := new(QueryMessagePassingProtocolFeeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ProtocolFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.crosschain.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "OutTxTracker",
-			Handler:    _Query_OutTxTracker_Handler,
-		},
-		{
-			MethodName: "OutTxTrackerAll",
-			Handler:    _Query_OutTxTrackerAll_Handler,
-		},
-		{
-			MethodName: "OutTxTrackerAllByChain",
-			Handler:    _Query_OutTxTrackerAllByChain_Handler,
-		},
-		{
-			MethodName: "InTxTrackerAllByChain",
-			Handler:    _Query_InTxTrackerAllByChain_Handler,
-		},
-		{
-			MethodName: "InTxTrackerAll",
-			Handler:    _Query_InTxTrackerAll_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctx",
-			Handler:    _Query_InTxHashToCctx_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctxData",
-			Handler:    _Query_InTxHashToCctxData_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctxAll",
-			Handler:    _Query_InTxHashToCctxAll_Handler,
-		},
-		{
-			MethodName: "GasPrice",
-			Handler:    _Query_GasPrice_Handler,
-		},
-		{
-			MethodName: "GasPriceAll",
-			Handler:    _Query_GasPriceAll_Handler,
-		},
-		{
-			MethodName: "ConvertGasToZeta",
-			Handler:    _Query_ConvertGasToZeta_Handler,
-		},
-		{
-			MethodName: "ProtocolFee",
-			Handler:    _Query_ProtocolFee_Handler,
-		},
-		{
-			MethodName: "LastBlockHeight",
-			Handler:    _Query_LastBlockHeight_Handler,
-		},
-		{
-			MethodName: "LastBlockHeightAll",
-			Handler:    _Query_LastBlockHeightAll_Handler,
-		},
-		{
-			MethodName: "Cctx",
-			Handler:    _Query_Cctx_Handler,
-		},
-		{
-			MethodName: "CctxByNonce",
-			Handler:    _Query_CctxByNonce_Handler,
-		},
-		{
-			MethodName: "CctxAll",
-			Handler:    _Query_CctxAll_Handler,
-		},
-		{
-			MethodName: "CctxListPending",
-			Handler:    _Query_CctxListPending_Handler,
-		},
-		{
-			MethodName: "ZetaAccounting",
-			Handler:    _Query_ZetaAccounting_Handler,
-		},
-		{
-			MethodName: "LastZetaHeight",
-			Handler:    _Query_LastZetaHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "crosschain/query.proto",
-}
-
-func (m *QueryZetaAccountingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error) {
+	out := new(QueryGetLastBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryZetaAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZetaAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryZetaAccountingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error) {
+	out := new(QueryAllLastBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryZetaAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryZetaAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AbortedZetaAmount) > 0 {
-		i -= len(m.AbortedZetaAmount)
-		copy(dAtA[i:], m.AbortedZetaAmount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbortedZetaAmount)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
+	out := new(QueryGetCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Cctx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
+	out := new(QueryGetCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxByNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error) {
+	out := new(QueryListCctxPendingResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxListPending", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxSearch(ctx context.Context, in *QueryCctxSearchRequest, opts ...grpc.CallOption) (*QueryCctxSearchResponse, error) {
+	out := new(QueryCctxSearchResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error) {
+	out := new(QueryZetaAccountingResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ZetaAccounting", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error) {
+	out := new(QueryLastZetaHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastZetaHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) WithdrawalLimits(ctx context.Context, in *QueryWithdrawalLimitsRequest, opts ...grpc.CallOption) (*QueryWithdrawalLimitsResponse, error) {
+	out := new(QueryWithdrawalLimitsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/WithdrawalLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) WithdrawalUsage(ctx context.Context, in *QueryWithdrawalUsageRequest, opts ...grpc.CallOption) (*QueryWithdrawalUsageResponse, error) {
+	out := new(QueryWithdrawalUsageResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/WithdrawalUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueuedWithdrawalAll(ctx context.Context, in *QueryAllQueuedWithdrawalRequest, opts ...grpc.CallOption) (*QueryAllQueuedWithdrawalResponse, error) {
+	out := new(QueryAllQueuedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/QueuedWithdrawalAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TssFundsMigrationProgress(ctx context.Context, in *QueryTssFundsMigrationProgressRequest, opts ...grpc.CallOption) (*QueryTssFundsMigrationProgressResponse, error) {
+	out := new(QueryTssFundsMigrationProgressResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/TssFundsMigrationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OutTxTracker.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a OutTxTracker by index.
+	OutTxTracker(context.Context, *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error)
+	// Queries a list of OutTxTracker items.
+	OutTxTrackerAll(context.Context, *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error)
+	OutTxTrackerAllByChain(context.Context, *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error)
+	InTxTrackerAllByChain(context.Context, *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error)
+	InTxTrackerAll(context.Context, *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error)
+	// Queries a InTxHashToCctx by index.
+	InTxHashToCctx(context.Context, *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error)
+	// Queries a InTxHashToCctx data by index.
+	InTxHashToCctxData(context.Context, *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error)
+	// Queries a list of InTxHashToCctx items.
+	InTxHashToCctxAll(context.Context, *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error)
+	// Queries a gasPrice by index.
+	GasPrice(context.Context, *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error)
+	// Queries a list of gasPrice items.
+	GasPriceAll(context.Context, *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error)
+	// Queries the gas price votes of a chain with their freshness.
+	GasPriceVotes(context.Context, *QueryGasPriceVotesRequest) (*QueryGasPriceVotesResponse, error)
+	ConvertGasToZeta(context.Context, *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error)
+	ProtocolFee(context.Context, *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error)
+	// Queries a lastBlockHeight by index.
+	LastBlockHeight(context.Context, *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error)
+	// Queries a list of lastBlockHeight items.
+	LastBlockHeightAll(context.Context, *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error)
+	// Queries a send by index.
+	Cctx(context.Context, *QueryGetCctxRequest) (*QueryGetCctxResponse, error)
+	// Queries a send by nonce.
+	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
+	// Queries a list of send items.
+	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
+	// Queries a list of pending cctxs.
+	CctxListPending(context.Context, *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error)
+	// Queries a list of cctxs filtered by sender, receiver, status, sender and receiver chain in a creation time range.
+	CctxSearch(context.Context, *QueryCctxSearchRequest) (*QueryCctxSearchResponse, error)
+	ZetaAccounting(context.Context, *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error)
+	// Queries a list of lastMetaHeight items.
+	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
+	// Queries the withdrawal limits.
+	WithdrawalLimits(context.Context, *QueryWithdrawalLimitsRequest) (*QueryWithdrawalLimitsResponse, error)
+	// Queries the amounts withdrawn in the current window of the withdrawal limits.
+	WithdrawalUsage(context.Context, *QueryWithdrawalUsageRequest) (*QueryWithdrawalUsageResponse, error)
+	// Queries a list of withdrawals queued for exceeding the withdrawal limits.
+	QueuedWithdrawalAll(context.Context, *QueryAllQueuedWithdrawalRequest) (*QueryAllQueuedWithdrawalResponse, error)
+	// Queries the progress of the TSS funds migration of each chain.
+	TssFundsMigrationProgress(context.Context, *QueryTssFundsMigrationProgressRequest) (*QueryTssFundsMigrationProgressResponse, error)
 }
 
-func (m *QueryAllOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryAllOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
 }
-
-func (m *QueryAllOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) OutTxTrackerAll(ctx context.Context, req *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) OutTxTrackerAllByChain(ctx context.Context, req *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAllByChain not implemented")
+}
+func (*UnimplementedQueryServer) InTxTrackerAllByChain(ctx context.Context, req *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAllByChain not implemented")
+}
+func (*UnimplementedQueryServer) InTxTrackerAll(ctx context.Context, req *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctx(ctx context.Context, req *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctx not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctxData(ctx context.Context, req *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxData not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctxAll(ctx context.Context, req *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxAll not implemented")
+}
+func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceAll(ctx context.Context, req *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceAll not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceVotes(ctx context.Context, req *QueryGasPriceVotesRequest) (*QueryGasPriceVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceVotes not implemented")
+}
+func (*UnimplementedQueryServer) ConvertGasToZeta(ctx context.Context, req *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertGasToZeta not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFee(ctx context.Context, req *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFee not implemented")
+}
+func (*UnimplementedQueryServer) LastBlockHeight(ctx context.Context, req *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeight not implemented")
+}
+func (*UnimplementedQueryServer) LastBlockHeightAll(ctx context.Context, req *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeightAll not implemented")
+}
+func (*UnimplementedQueryServer) Cctx(ctx context.Context, req *QueryGetCctxRequest) (*QueryGetCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cctx not implemented")
+}
+func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxByNonce not implemented")
+}
+func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
+}
+func (*UnimplementedQueryServer) CctxListPending(ctx context.Context, req *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxListPending not implemented")
+}
+func (*UnimplementedQueryServer) CctxSearch(ctx context.Context, req *QueryCctxSearchRequest) (*QueryCctxSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxSearch not implemented")
+}
+func (*UnimplementedQueryServer) ZetaAccounting(ctx context.Context, req *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZetaAccounting not implemented")
+}
+func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastZetaHeight not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalLimits(ctx context.Context, req *QueryWithdrawalLimitsRequest) (*QueryWithdrawalLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalLimits not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalUsage(ctx context.Context, req *QueryWithdrawalUsageRequest) (*QueryWithdrawalUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalUsage not implemented")
+}
+func (*UnimplementedQueryServer) QueuedWithdrawalAll(ctx context.Context, req *QueryAllQueuedWithdrawalRequest) (*QueryAllQueuedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedWithdrawalAll not implemented")
+}
+func (*UnimplementedQueryServer) TssFundsMigrationProgress(ctx context.Context, req *QueryTssFundsMigrationProgressRequest) (*QueryTssFundsMigrationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssFundsMigrationProgress not implemented")
 }
 
-func (m *QueryAllOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OutTxTracker) > 0 {
-		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutTxTrackerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllOutTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllOutTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTracker(ctx, in)
 	}
-	if m.Chain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTracker",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTracker(ctx, req.(*QueryGetOutTxTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutTxTrackerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllOutTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTrackerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTrackerAll(ctx, req.(*QueryAllOutTxTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OutTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutTxTrackerByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OutTxTracker) > 0 {
-		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, req.(*QueryAllOutTxTrackerByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInTxTrackerByChainRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).InTxTrackerAllByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxTrackerAllByChain(ctx, req.(*QueryAllInTxTrackerByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_InTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInTxTrackersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InTxTrackerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxTrackerAll(ctx, req.(*QueryAllInTxTrackersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_InTxHashToCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInTxHashToCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctx(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctx(ctx, req.(*QueryGetInTxHashToCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InTxHashToCctxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInTxHashToCctxDataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctxData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctxData(ctx, req.(*QueryInTxHashToCctxDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_InTxHashToCctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInTxHashToCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctxAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctxAll(ctx, req.(*QueryAllInTxHashToCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InTxTracker) > 0 {
-		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrice(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrice(ctx, req.(*QueryGetGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GasPriceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGasPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceAll(ctx, req.(*QueryAllGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GasPriceVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPriceVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceVotes(ctx, req.(*QueryGasPriceVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_ConvertGasToZeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvertGasToZetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ConvertGasToZeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvertGasToZeta(ctx, req.(*QueryConvertGasToZetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProtocolFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessagePassingProtocolFeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllInTxTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllInTxTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFee(ctx, in)
 	}
-	if len(m.InTxTracker) > 0 {
-		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ProtocolFee",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFee(ctx, req.(*QueryMessagePassingProtocolFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLastBlockHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockHeight(ctx, req.(*QueryGetLastBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LastBlockHeightAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLastBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockHeightAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockHeightAll(ctx, req.(*QueryAllLastBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0xa
+func _Query_Cctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Cctx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/Cctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cctx(ctx, req.(*QueryGetCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCctxByNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxByNonce(ctx, req.(*QueryGetCctxByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAll(ctx, req.(*QueryAllCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InTxHashToCctx.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_CctxListPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCctxPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxListPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxListPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxListPending(ctx, req.(*QueryListCctxPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCctxSearchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxSearch(ctx, req.(*QueryCctxSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ZetaAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZetaAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ZetaAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ZetaAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZetaAccounting(ctx, req.(*QueryZetaAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0xa
+func _Query_LastZetaHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastZetaHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastZetaHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastZetaHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastZetaHeight(ctx, req.(*QueryLastZetaHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
+func _Query_WithdrawalLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/WithdrawalLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalLimits(ctx, req.(*QueryWithdrawalLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/WithdrawalUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalUsage(ctx, req.(*QueryWithdrawalUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedWithdrawalAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQueuedWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedWithdrawalAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/QueuedWithdrawalAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedWithdrawalAll(ctx, req.(*QueryAllQueuedWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TssFundsMigrationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTssFundsMigrationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TssFundsMigrationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/TssFundsMigrationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TssFundsMigrationProgress(ctx, req.(*QueryTssFundsMigrationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.crosschain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OutTxTracker",
+			Handler:    _Query_OutTxTracker_Handler,
+		},
+		{
+			MethodName: "OutTxTrackerAll",
+			Handler:    _Query_OutTxTrackerAll_Handler,
+		},
+		{
+			MethodName: "OutTxTrackerAllByChain",
+			Handler:    _Query_OutTxTrackerAllByChain_Handler,
+		},
+		{
+			MethodName: "InTxTrackerAllByChain",
+			Handler:    _Query_InTxTrackerAllByChain_Handler,
+		},
+		{
+			MethodName: "InTxTrackerAll",
+			Handler:    _Query_InTxTrackerAll_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctx",
+			Handler:    _Query_InTxHashToCctx_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctxData",
+			Handler:    _Query_InTxHashToCctxData_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctxAll",
+			Handler:    _Query_InTxHashToCctxAll_Handler,
+		},
+		{
+			MethodName: "GasPrice",
+			Handler:    _Query_GasPrice_Handler,
+		},
+		{
+			MethodName: "GasPriceAll",
+			Handler:    _Query_GasPriceAll_Handler,
+		},
+		{
+			MethodName: "GasPriceVotes",
+			Handler:    _Query_GasPriceVotes_Handler,
+		},
+		{
+			MethodName: "ConvertGasToZeta",
+			Handler:    _Query_ConvertGasToZeta_Handler,
+		},
+		{
+			MethodName: "ProtocolFee",
+			Handler:    _Query_ProtocolFee_Handler,
+		},
+		{
+			MethodName: "LastBlockHeight",
+			Handler:    _Query_LastBlockHeight_Handler,
+		},
+		{
+			MethodName: "LastBlockHeightAll",
+			Handler:    _Query_LastBlockHeightAll_Handler,
+		},
+		{
+			MethodName: "Cctx",
+			Handler:    _Query_Cctx_Handler,
+		},
+		{
+			MethodName: "CctxByNonce",
+			Handler:    _Query_CctxByNonce_Handler,
+		},
+		{
+			MethodName: "CctxAll",
+			Handler:    _Query_CctxAll_Handler,
+		},
+		{
+			MethodName: "CctxListPending",
+			Handler:    _Query_CctxListPending_Handler,
+		},
+		{
+			MethodName: "CctxSearch",
+			Handler:    _Query_CctxSearch_Handler,
+		},
+		{
+			MethodName: "ZetaAccounting",
+			Handler:    _Query_ZetaAccounting_Handler,
+		},
+		{
+			MethodName: "LastZetaHeight",
+			Handler:    _Query_LastZetaHeight_Handler,
+		},
+		{
+			MethodName: "WithdrawalLimits",
+			Handler:    _Query_WithdrawalLimits_Handler,
+		},
+		{
+			MethodName: "WithdrawalUsage",
+			Handler:    _Query_WithdrawalUsage_Handler,
+		},
+		{
+			MethodName: "QueuedWithdrawalAll",
+			Handler:    _Query_QueuedWithdrawalAll_Handler,
+		},
+		{
+			MethodName: "TssFundsMigrationProgress",
+			Handler:    _Query_TssFundsMigrationProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crosschain/query.proto",
+}
+
+func (m *QueryTssFundsMigrationProgressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3560,34 +3992,20 @@ func (m *QueryInTxHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInTxHashToCctxDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigrationProgressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInTxHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigrationProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CrossChainTxs) > 0 {
-		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *TssFundsMigrationCctx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3597,32 +4015,61 @@ func (m *QueryAllInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TssFundsMigrationCctx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TssFundsMigrationCctx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.OutboundTxHash) > 0 {
+		i -= len(m.OutboundTxHash)
+		copy(dAtA[i:], m.OutboundTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundTxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Command) > 0 {
+		i -= len(m.Command)
+		copy(dAtA[i:], m.Command)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Command)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *TssFundsMigrationProgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3632,32 +4079,30 @@ func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TssFundsMigrationProgress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TssFundsMigrationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.InTxHashToCctx) > 0 {
-		for iNdEx := len(m.InTxHashToCctx) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Cctxs) > 0 {
+		for iNdEx := len(m.Cctxs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InTxHashToCctx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Cctxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3665,13 +4110,18 @@ func (m *QueryAllInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigrationProgressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3681,62 +4131,34 @@ func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigrationProgressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigrationProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasPrice != nil {
-		{
-			size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Progress) > 0 {
+		for iNdEx := len(m.Progress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Progress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWithdrawalLimitsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3746,32 +4168,20 @@ func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWithdrawalLimitsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3781,46 +4191,30 @@ func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GasPrice) > 0 {
-		for iNdEx := len(m.GasPrice) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.WithdrawalLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWithdrawalUsageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3830,27 +4224,20 @@ func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalUsageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWithdrawalUsageResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3860,32 +4247,53 @@ func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalUsageResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastBlockHeight != nil {
-		{
-			size, err := m.LastBlockHeight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ChainUsages) > 0 {
+		for iNdEx := len(m.ChainUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Zrc20Usages) > 0 {
+		for iNdEx := len(m.Zrc20Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zrc20Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllQueuedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3895,12 +4303,12 @@ func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllQueuedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllQueuedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3920,7 +4328,7 @@ func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllQueuedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3930,12 +4338,12 @@ func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllQueuedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllQueuedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3952,10 +4360,10 @@ func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.LastBlockHeight) > 0 {
-		for iNdEx := len(m.LastBlockHeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.QueuedWithdrawals) > 0 {
+		for iNdEx := len(m.QueuedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LastBlockHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.QueuedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3969,7 +4377,7 @@ func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryZetaAccountingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3979,27 +4387,20 @@ func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryZetaAccountingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4009,30 +4410,27 @@ func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+	if len(m.AbortedZetaAmount) > 0 {
+		i -= len(m.AbortedZetaAmount)
+		copy(dAtA[i:], m.AbortedZetaAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbortedZetaAmount)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4042,32 +4440,20 @@ func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CrossChainTx != nil {
-		{
-			size, err := m.CrossChainTx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4077,32 +4463,30 @@ func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4112,46 +4496,30 @@ func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.CrossChainTx) > 0 {
-		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.ChainID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListCctxPendingRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4161,30 +4529,65 @@ func (m *QueryListCctxPendingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListCctxPendingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListCctxPendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.OutTxTracker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListCctxPendingResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4194,25 +4597,32 @@ func (m *QueryListCctxPendingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListCctxPendingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TotalPending != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.CrossChainTx) > 0 {
-		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OutTxTracker) > 0 {
+		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4226,7 +4636,7 @@ func (m *QueryListCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4236,20 +4646,37 @@ func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Chain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4259,25 +4686,46 @@ func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.OutTxTracker) > 0 {
+		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4287,20 +4735,25 @@ func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryConvertGasToZetaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConvertGasToZetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GasLimit) > 0 {
-		i -= len(m.GasLimit)
-		copy(dAtA[i:], m.GasLimit)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4312,7 +4765,7 @@ func (m *QueryConvertGasToZetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4322,39 +4775,46 @@ func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryConvertGasToZetaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConvertGasToZetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ZetaBlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ZetaBlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ProtocolFeeInZeta) > 0 {
-		i -= len(m.ProtocolFeeInZeta)
-		copy(dAtA[i:], m.ProtocolFeeInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolFeeInZeta)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.OutboundGasInZeta) > 0 {
-		i -= len(m.OutboundGasInZeta)
-		copy(dAtA[i:], m.OutboundGasInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundGasInZeta)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.InTxTracker) > 0 {
+		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4364,20 +4824,32 @@ func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4387,631 +4859,3485 @@ func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeInZeta) > 0 {
-		i -= len(m.FeeInZeta)
-		copy(dAtA[i:], m.FeeInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeInZeta)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.InTxTracker) > 0 {
+		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryZetaAccountingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZetaAccountingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AbortedZetaAmount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != 0 {
-		n += 1 + sovQuery(uint64(m.ChainID))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	{
+		size, err := m.InTxHashToCctx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInTxHashToCctxDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.OutTxTracker.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryInTxHashToCctxDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInTxHashToCctxDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutTxTracker) > 0 {
-		for _, e := range m.OutTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Chain != 0 {
-		n += 1 + sovQuery(uint64(m.Chain))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryInTxHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInTxHashToCctxDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInTxHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutTxTracker) > 0 {
-		for _, e := range m.OutTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.CrossChainTxs) > 0 {
+		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.InTxTracker) > 0 {
-		for _, e := range m.InTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInTxTrackersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxTrackersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.InTxTracker) > 0 {
-		for _, e := range m.InTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InTxHashToCctx) > 0 {
+		for iNdEx := len(m.InTxHashToCctx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InTxHashToCctx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetInTxHashToCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetInTxHashToCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InTxHashToCctx.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInTxHashToCctxDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInTxHashToCctxDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CrossChainTxs) > 0 {
-		for _, e := range m.CrossChainTxs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.GasPrice != nil {
+		{
+			size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxHashToCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxHashToCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.InTxHashToCctx) > 0 {
-		for _, e := range m.InTxHashToCctx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GasPrice) > 0 {
+		for iNdEx := len(m.GasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGasPriceVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGasPriceVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGasPriceVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGasPriceVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GasPrice) > 0 {
-		for _, e := range m.GasPrice {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.MedianPriorityFee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MedianPriorityFee))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MedianPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MedianPrice))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.LastBlockHeight != nil {
-		l = m.LastBlockHeight.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.LastBlockHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LastBlockHeight) > 0 {
-		for _, e := range m.LastBlockHeight {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LastBlockHeight) > 0 {
+		for iNdEx := len(m.LastBlockHeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastBlockHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainID != 0 {
-		n += 1 + sovQuery(uint64(m.ChainID))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	return n
+func (m *QueryGetCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CrossChainTx != nil {
-		l = m.CrossChainTx.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCctxByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCctxByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CrossChainTx) > 0 {
-		for _, e := range m.CrossChainTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListCctxPendingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	if m.CrossChainTx != nil {
+		{
+			size, err := m.CrossChainTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListCctxPendingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CrossChainTx) > 0 {
-		for _, e := range m.CrossChainTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.TotalPending != 0 {
-		n += 1 + sovQuery(uint64(m.TotalPending))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListCctxPendingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCctxPendingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCctxPendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.GasLimit)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListCctxPendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCctxPendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OutboundGasInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ProtocolFeeInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TotalPending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ZetaBlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.ZetaBlockHeight))
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EndTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReceiverChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiverChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SenderChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvertGasToZetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertGasToZetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasLimit) > 0 {
+		i -= len(m.GasLimit)
+		copy(dAtA[i:], m.GasLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvertGasToZetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertGasToZetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ZetaBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ZetaBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProtocolFeeInZeta) > 0 {
+		i -= len(m.ProtocolFeeInZeta)
+		copy(dAtA[i:], m.ProtocolFeeInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolFeeInZeta)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutboundGasInZeta) > 0 {
+		i -= len(m.OutboundGasInZeta)
+		copy(dAtA[i:], m.OutboundGasInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundGasInZeta)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeInZeta) > 0 {
+		i -= len(m.FeeInZeta)
+		copy(dAtA[i:], m.FeeInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeInZeta)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTssFundsMigrationProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TssFundsMigrationCctx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.OutboundTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TssFundsMigrationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if len(m.Cctxs) > 0 {
+		for _, e := range m.Cctxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Completed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTssFundsMigrationProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Progress) > 0 {
+		for _, e := range m.Progress {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWithdrawalLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWithdrawalLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WithdrawalLimits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWithdrawalUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWithdrawalUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if len(m.Zrc20Usages) > 0 {
+		for _, e := range m.Zrc20Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ChainUsages) > 0 {
+		for _, e := range m.ChainUsages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllQueuedWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllQueuedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedWithdrawals) > 0 {
+		for _, e := range m.QueuedWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZetaAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryZetaAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AbortedZetaAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutTxTracker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutTxTracker) > 0 {
+		for _, e := range m.OutTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutTxTrackerByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutTxTrackerByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutTxTracker) > 0 {
+		for _, e := range m.OutTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackerByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackerByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InTxTracker) > 0 {
+		for _, e := range m.InTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InTxTracker) > 0 {
+		for _, e := range m.InTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInTxHashToCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInTxHashToCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InTxHashToCctx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInTxHashToCctxDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInTxHashToCctxDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTxs) > 0 {
+		for _, e := range m.CrossChainTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllInTxHashToCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxHashToCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InTxHashToCctx) > 0 {
+		for _, e := range m.InTxHashToCctx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrice) > 0 {
+		for _, e := range m.GasPrice {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasPriceVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGasPriceVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MedianPrice != 0 {
+		n += 1 + sovQuery(uint64(m.MedianPrice))
+	}
+	if m.MedianPriorityFee != 0 {
+		n += 1 + sovQuery(uint64(m.MedianPriorityFee))
+	}
+	return n
+}
+
+func (m *QueryGetLastBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLastBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastBlockHeight != nil {
+		l = m.LastBlockHeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLastBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLastBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LastBlockHeight) > 0 {
+		for _, e := range m.LastBlockHeight {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCctxByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrossChainTx != nil {
+		l = m.CrossChainTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCctxPendingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryListCctxPendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPending != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPending))
+	}
+	return n
+}
+
+func (m *QueryCctxSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SenderChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SenderChainId))
+	}
+	if m.ReceiverChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiverChainId))
+	}
+	if m.StartTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.StartTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.EndTimestamp))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCctxSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastZetaHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastZetaHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConvertGasToZetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConvertGasToZetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutboundGasInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProtocolFeeInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ZetaBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ZetaBlockHeight))
+	}
+	return n
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTssFundsMigrationProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssFundsMigrationProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssFundsMigrationProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TssFundsMigrationCctx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TssFundsMigrationCctx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TssFundsMigrationCctx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CctxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TssFundsMigrationProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TssFundsMigrationProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TssFundsMigrationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cctxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cctxs = append(m.Cctxs, TssFundsMigrationCctx{})
+			if err := m.Cctxs[len(m.Cctxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssFundsMigrationProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssFundsMigrationProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssFundsMigrationProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = append(m.Progress, TssFundsMigrationProgress{})
+			if err := m.Progress[len(m.Progress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Usages = append(m.Zrc20Usages, ZRC20WithdrawalUsage{})
+			if err := m.Zrc20Usages[len(m.Zrc20Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainUsages = append(m.ChainUsages, ChainWithdrawalUsage{})
+			if err := m.ChainUsages[len(m.ChainUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueuedWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueuedWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueuedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueuedWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueuedWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueuedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedWithdrawals = append(m.QueuedWithdrawals, QueuedWithdrawal{})
+			if err := m.QueuedWithdrawals[len(m.QueuedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZetaAccountingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZetaAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZetaAccountingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZetaAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortedZetaAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbortedZetaAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryMessagePassingProtocolFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			m.ChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryMessagePassingProtocolFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeeInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5034,12 +8360,48 @@ func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZetaAccountingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZetaAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5061,7 +8423,7 @@ func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5084,17 +8446,17 @@ func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZetaAccountingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZetaAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortedZetaAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5104,23 +8466,61 @@ func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbortedZetaAmount = string(dAtA[iNdEx:postIndex])
+			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
+			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5143,7 +8543,7 @@ func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5166,12 +8566,67 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5193,7 +8648,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5212,19 +8667,53 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
+			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5251,7 +8740,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5276,7 +8768,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5299,17 +8791,17 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.ChainID = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5319,16 +8811,16 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainID |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5338,11 +8830,28 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5364,7 +8873,7 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5387,15 +8896,15 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5422,7 +8931,44 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
+			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5447,7 +8993,7 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5470,10 +9016,10 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5533,7 +9079,7 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5556,15 +9102,15 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5591,8 +9137,8 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
-			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
+			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5653,7 +9199,7 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5676,36 +9222,17 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			m.Chain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Chain |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5715,27 +9242,23 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5758,7 +9281,7 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5781,49 +9304,15 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
-			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5850,10 +9339,7 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InTxHashToCctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5878,7 +9364,7 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5901,36 +9387,17 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5940,27 +9407,23 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5983,7 +9446,7 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6006,49 +9469,15 @@ func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
-			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6075,10 +9504,8 @@ func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
+			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6103,7 +9530,7 @@ func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6126,10 +9553,10 @@ func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6189,7 +9616,7 @@ func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6212,15 +9639,15 @@ func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6247,8 +9674,8 @@ func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
-			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InTxHashToCctx = append(m.InTxHashToCctx, InTxHashToCctx{})
+			if err := m.InTxHashToCctx[len(m.InTxHashToCctx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6309,7 +9736,7 @@ func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6332,15 +9759,15 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6368,7 +9795,7 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6391,7 +9818,7 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6414,15 +9841,15 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6449,7 +9876,10 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTxHashToCctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GasPrice == nil {
+				m.GasPrice = &GasPrice{}
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6474,7 +9904,7 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6497,17 +9927,17 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6517,23 +9947,27 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6556,7 +9990,7 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6579,15 +10013,15 @@ func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6614,8 +10048,44 @@ func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
-			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasPrice = append(m.GasPrice, &GasPrice{})
+			if err := m.GasPrice[len(m.GasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6640,7 +10110,7 @@ func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6663,17 +10133,17 @@ func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6683,28 +10153,11 @@ func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6726,7 +10179,7 @@ func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6749,15 +10202,15 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6784,16 +10237,16 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHashToCctx = append(m.InTxHashToCctx, InTxHashToCctx{})
-			if err := m.InTxHashToCctx[len(m.InTxHashToCctx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, GasPriceVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
 			}
-			var msglen int
+			m.MedianPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6803,28 +10256,30 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MedianPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPriorityFee", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MedianPriorityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianPriorityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6846,7 +10301,7 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6869,10 +10324,10 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6928,7 +10383,7 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6951,15 +10406,15 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6986,10 +10441,10 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPrice == nil {
-				m.GasPrice = &GasPrice{}
+			if m.LastBlockHeight == nil {
+				m.LastBlockHeight = &LastBlockHeight{}
 			}
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7014,7 +10469,7 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7037,10 +10492,10 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7100,7 +10555,7 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7123,15 +10578,15 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7158,8 +10613,8 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPrice = append(m.GasPrice, &GasPrice{})
-			if err := m.GasPrice[len(m.GasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
+			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7220,7 +10675,7 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7243,10 +10698,10 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7302,7 +10757,7 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7325,17 +10780,17 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			m.ChainID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7345,28 +10800,30 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastBlockHeight == nil {
-				m.LastBlockHeight = &LastBlockHeight{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7388,7 +10845,7 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7411,15 +10868,15 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7446,10 +10903,10 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.CrossChainTx == nil {
+				m.CrossChainTx = &CrossChainTx{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CrossChainTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7474,7 +10931,7 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7497,47 +10954,13 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
-			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7567,7 +10990,7 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7594,7 +11017,7 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7617,17 +11040,17 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7637,79 +11060,31 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ChainID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7719,30 +11094,28 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainID |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7764,7 +11137,7 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7787,17 +11160,36 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxPendingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxPendingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7807,28 +11199,11 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CrossChainTx == nil {
-				m.CrossChainTx = &CrossChainTx{}
-			}
-			if err := m.CrossChainTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7850,7 +11225,7 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7873,15 +11248,15 @@ func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxPendingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxPendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7908,13 +11283,30 @@ func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+			}
+			m.TotalPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7936,7 +11328,7 @@ func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCctxSearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7959,17 +11351,17 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCctxSearchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCctxSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7979,31 +11371,29 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
-			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8013,83 +11403,99 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.SenderChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListCctxPendingRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListCctxPendingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ReceiverChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
 			}
-			m.ChainId = 0
+			m.StartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8099,16 +11505,16 @@ func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.StartTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
 			}
-			m.Limit = 0
+			m.EndTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8118,11 +11524,47 @@ func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				m.EndTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8144,7 +11586,7 @@ func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCctxSearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8167,10 +11609,10 @@ func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListCctxPendingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCctxSearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListCctxPendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCctxSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8208,10 +11650,10 @@ func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.TotalPending = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8221,11 +11663,28 @@ func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPending |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -48,6 +48,11 @@ func stateTransitionMap() map[CctxStatus][]CctxStatus {
 		CctxStatus_Reverted,
 	}
 
+	stateTransitionMap[CctxStatus_PendingWithdrawalLimit] = []CctxStatus{
+		CctxStatus_PendingOutbound,
+		CctxStatus_Aborted,
+	}
+
 	stateTransitionMap[CctxStatus_PendingRevert] = []CctxStatus{
 		CctxStatus_Aborted,
 		CctxStatus_OutboundMined,
@@ -31,25 +31,23 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type MsgCreateTSSVoter struct {
-	Creator          string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TssPubkey        string               `protobuf:"bytes,2,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
-	KeyGenZetaHeight int64                `protobuf:"varint,3,opt,name=keyGenZetaHeight,proto3" json:"keyGenZetaHeight,omitempty"`
-	Status           common.ReceiveStatus `protobuf:"varint,4,opt,name=status,proto3,enum=common.ReceiveStatus" json:"status,omitempty"`
+type MsgAbortStuckCCTX struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
 }
 
-func (m *MsgCreateTSSVoter) Reset()         { *m = MsgCreateTSSVoter{} }
-func (m *MsgCreateTSSVoter) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateTSSVoter) ProtoMessage()    {}
-func (*MsgCreateTSSVoter) Descriptor() ([]byte, []int) {
+func (m *MsgAbortStuckCCTX) Reset()         { *m = MsgAbortStuckCCTX{} }
+func (m *MsgAbortStuckCCTX) String() string { return proto.CompactTextString(m) }
+func (*MsgAbortStuckCCTX) ProtoMessage()    {}
+func (*MsgAbortStuckCCTX) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{0}
 }
-func (m *MsgCreateTSSVoter) XXX_Unmarshal(b []byte) error {
+func (m *MsgAbortStuckCCTX) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateTSSVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAbortStuckCCTX) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateTSSVoter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAbortStuckCCTX.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -59,61 +57,47 @@ func (m *MsgCreateTSSVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateTSSVoter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateTSSVoter.Merge(m, src)
+func (m *MsgAbortStuckCCTX) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAbortStuckCCTX.Merge(m, src)
 }
-func (m *MsgCreateTSSVoter) XXX_Size() int {
+func (m *MsgAbortStuckCCTX) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateTSSVoter) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateTSSVoter.DiscardUnknown(m)
+func (m *MsgAbortStuckCCTX) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAbortStuckCCTX.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateTSSVoter proto.InternalMessageInfo
+var xxx_messageInfo_MsgAbortStuckCCTX proto.InternalMessageInfo
 
-func (m *MsgCreateTSSVoter) GetCreator() string {
+func (m *MsgAbortStuckCCTX) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateTSSVoter) GetTssPubkey() string {
+func (m *MsgAbortStuckCCTX) GetCctxIndex() string {
 	if m != nil {
-		return m.TssPubkey
+		return m.CctxIndex
 	}
 	return ""
 }
 
-func (m *MsgCreateTSSVoter) GetKeyGenZetaHeight() int64 {
-	if m != nil {
-		return m.KeyGenZetaHeight
-	}
-	return 0
-}
-
-func (m *MsgCreateTSSVoter) GetStatus() common.ReceiveStatus {
-	if m != nil {
-		return m.Status
-	}
-	return common.ReceiveStatus_Created
-}
-
-type MsgCreateTSSVoterResponse struct {
+type MsgAbortStuckCCTXResponse struct {
 }
 
-func (m *MsgCreateTSSVoterResponse) Reset()         { *m = MsgCreateTSSVoterResponse{} }
-func (m *MsgCreateTSSVoterResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateTSSVoterResponse) ProtoMessage()    {}
-func (*MsgCreateTSSVoterResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAbortStuckCCTXResponse) Reset()         { *m = MsgAbortStuckCCTXResponse{} }
+func (m *MsgAbortStuckCCTXResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAbortStuckCCTXResponse) ProtoMessage()    {}
+func (*MsgAbortStuckCCTXResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{1}
 }
-func (m *MsgCreateTSSVoterResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAbortStuckCCTXResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateTSSVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAbortStuckCCTXResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateTSSVoterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAbortStuckCCTXResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -123,36 +107,37 @@ func (m *MsgCreateTSSVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateTSSVoterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateTSSVoterResponse.Merge(m, src)
+func (m *MsgAbortStuckCCTXResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAbortStuckCCTXResponse.Merge(m, src)
 }
-func (m *MsgCreateTSSVoterResponse) XXX_Size() int {
+func (m *MsgAbortStuckCCTXResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateTSSVoterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateTSSVoterResponse.DiscardUnknown(m)
+func (m *MsgAbortStuckCCTXResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAbortStuckCCTXResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateTSSVoterResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAbortStuckCCTXResponse proto.InternalMessageInfo
 
-type MsgMigrateTssFunds struct {
-	Creator string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId int64                                   `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Amount  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+type MsgRefundAbortedCCTX struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	// address receiving the refund on ZetaChain, the sender of the inbound tx if empty
+	RefundAddress string `protobuf:"bytes,3,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
-func (m *MsgMigrateTssFunds) Reset()         { *m = MsgMigrateTssFunds{} }
-func (m *MsgMigrateTssFunds) String() string { return proto.CompactTextString(m) }
-func (*MsgMigrateTssFunds) ProtoMessage()    {}
-func (*MsgMigrateTssFunds) Descriptor() ([]byte, []int) {
+func (m *MsgRefundAbortedCCTX) Reset()         { *m = MsgRefundAbortedCCTX{} }
+func (m *MsgRefundAbortedCCTX) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundAbortedCCTX) ProtoMessage()    {}
+func (*MsgRefundAbortedCCTX) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{2}
 }
-func (m *MsgMigrateTssFunds) XXX_Unmarshal(b []byte) error {
+func (m *MsgRefundAbortedCCTX) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMigrateTssFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRefundAbortedCCTX) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMigrateTssFunds.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRefundAbortedCCTX.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -162,47 +147,54 @@ func (m *MsgMigrateTssFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgMigrateTssFunds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMigrateTssFunds.Merge(m, src)
+func (m *MsgRefundAbortedCCTX) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundAbortedCCTX.Merge(m, src)
 }
-func (m *MsgMigrateTssFunds) XXX_Size() int {
+func (m *MsgRefundAbortedCCTX) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMigrateTssFunds) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMigrateTssFunds.DiscardUnknown(m)
+func (m *MsgRefundAbortedCCTX) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundAbortedCCTX.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMigrateTssFunds proto.InternalMessageInfo
+var xxx_messageInfo_MsgRefundAbortedCCTX proto.InternalMessageInfo
 
-func (m *MsgMigrateTssFunds) GetCreator() string {
+func (m *MsgRefundAbortedCCTX) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgMigrateTssFunds) GetChainId() int64 {
+func (m *MsgRefundAbortedCCTX) GetCctxIndex() string {
 	if m != nil {
-		return m.ChainId
+		return m.CctxIndex
 	}
-	return 0
+	return ""
 }
 
-type MsgMigrateTssFundsResponse struct {
+func (m *MsgRefundAbortedCCTX) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
 }
 
-func (m *MsgMigrateTssFundsResponse) Reset()         { *m = MsgMigrateTssFundsResponse{} }
-func (m *MsgMigrateTssFundsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMigrateTssFundsResponse) ProtoMessage()    {}
-func (*MsgMigrateTssFundsResponse) Descriptor() ([]byte, []int) {
+type MsgRefundAbortedCCTXResponse struct {
+}
+
+func (m *MsgRefundAbortedCCTXResponse) Reset()         { *m = MsgRefundAbortedCCTXResponse{} }
+func (m *MsgRefundAbortedCCTXResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundAbortedCCTXResponse) ProtoMessage()    {}
+func (*MsgRefundAbortedCCTXResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{3}
 }
-func (m *MsgMigrateTssFundsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRefundAbortedCCTXResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMigrateTssFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRefundAbortedCCTXResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMigrateTssFundsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRefundAbortedCCTXResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -212,35 +204,35 @@ func (m *MsgMigrateTssFundsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgMigrateTssFundsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMigrateTssFundsResponse.Merge(m, src)
+func (m *MsgRefundAbortedCCTXResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundAbortedCCTXResponse.Merge(m, src)
 }
-func (m *MsgMigrateTssFundsResponse) XXX_Size() int {
+func (m *MsgRefundAbortedCCTXResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMigrateTssFundsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMigrateTssFundsResponse.DiscardUnknown(m)
+func (m *MsgRefundAbortedCCTXResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundAbortedCCTXResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMigrateTssFundsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRefundAbortedCCTXResponse proto.InternalMessageInfo
 
-type MsgUpdateTssAddress struct {
-	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TssPubkey string `protobuf:"bytes,2,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
+type MsgUpdateWithdrawalLimits struct {
+	Creator          string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	WithdrawalLimits WithdrawalLimits `protobuf:"bytes,2,opt,name=withdrawal_limits,json=withdrawalLimits,proto3" json:"withdrawal_limits"`
 }
 
-func (m *MsgUpdateTssAddress) Reset()         { *m = MsgUpdateTssAddress{} }
-func (m *MsgUpdateTssAddress) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTssAddress) ProtoMessage()    {}
-func (*MsgUpdateTssAddress) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateWithdrawalLimits) Reset()         { *m = MsgUpdateWithdrawalLimits{} }
+func (m *MsgUpdateWithdrawalLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWithdrawalLimits) ProtoMessage()    {}
+func (*MsgUpdateWithdrawalLimits) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{4}
 }
-func (m *MsgUpdateTssAddress) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateWithdrawalLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTssAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWithdrawalLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTssAddress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWithdrawalLimits.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -250,47 +242,47 @@ func (m *MsgUpdateTssAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTssAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTssAddress.Merge(m, src)
+func (m *MsgUpdateWithdrawalLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWithdrawalLimits.Merge(m, src)
 }
-func (m *MsgUpdateTssAddress) XXX_Size() int {
+func (m *MsgUpdateWithdrawalLimits) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTssAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTssAddress.DiscardUnknown(m)
+func (m *MsgUpdateWithdrawalLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWithdrawalLimits.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTssAddress proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWithdrawalLimits proto.InternalMessageInfo
 
-func (m *MsgUpdateTssAddress) GetCreator() string {
+func (m *MsgUpdateWithdrawalLimits) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateTssAddress) GetTssPubkey() string {
+func (m *MsgUpdateWithdrawalLimits) GetWithdrawalLimits() WithdrawalLimits {
 	if m != nil {
-		return m.TssPubkey
+		return m.WithdrawalLimits
 	}
-	return ""
+	return WithdrawalLimits{}
 }
 
-type MsgUpdateTssAddressResponse struct {
+type MsgUpdateWithdrawalLimitsResponse struct {
 }
 
-func (m *MsgUpdateTssAddressResponse) Reset()         { *m = MsgUpdateTssAddressResponse{} }
-func (m *MsgUpdateTssAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTssAddressResponse) ProtoMessage()    {}
-func (*MsgUpdateTssAddressResponse) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateWithdrawalLimitsResponse) Reset()         { *m = MsgUpdateWithdrawalLimitsResponse{} }
+func (m *MsgUpdateWithdrawalLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWithdrawalLimitsResponse) ProtoMessage()    {}
+func (*MsgUpdateWithdrawalLimitsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{5}
 }
-func (m *MsgUpdateTssAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateWithdrawalLimitsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTssAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWithdrawalLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTssAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWithdrawalLimitsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -300,40 +292,35 @@ func (m *MsgUpdateTssAddressResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTssAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTssAddressResponse.Merge(m, src)
+func (m *MsgUpdateWithdrawalLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWithdrawalLimitsResponse.Merge(m, src)
 }
-func (m *MsgUpdateTssAddressResponse) XXX_Size() int {
+func (m *MsgUpdateWithdrawalLimitsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTssAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTssAddressResponse.DiscardUnknown(m)
+func (m *MsgUpdateWithdrawalLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWithdrawalLimitsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTssAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWithdrawalLimitsResponse proto.InternalMessageInfo
 
-type MsgAddToInTxTracker struct {
-	Creator   string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId   int64           `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	TxHash    string          `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	CoinType  common.CoinType `protobuf:"varint,4,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
-	Proof     *common.Proof   `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
-	BlockHash string          `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	TxIndex   int64           `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+type MsgReleaseQueuedWithdrawal struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
 }
 
-func (m *MsgAddToInTxTracker) Reset()         { *m = MsgAddToInTxTracker{} }
-func (m *MsgAddToInTxTracker) String() string { return proto.CompactTextString(m) }
-func (*MsgAddToInTxTracker) ProtoMessage()    {}
-func (*MsgAddToInTxTracker) Descriptor() ([]byte, []int) {
+func (m *MsgReleaseQueuedWithdrawal) Reset()         { *m = MsgReleaseQueuedWithdrawal{} }
+func (m *MsgReleaseQueuedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQueuedWithdrawal) ProtoMessage()    {}
+func (*MsgReleaseQueuedWithdrawal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{6}
 }
-func (m *MsgAddToInTxTracker) XXX_Unmarshal(b []byte) error {
+func (m *MsgReleaseQueuedWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddToInTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReleaseQueuedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddToInTxTracker.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReleaseQueuedWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -343,82 +330,47 @@ func (m *MsgAddToInTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddToInTxTracker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddToInTxTracker.Merge(m, src)
+func (m *MsgReleaseQueuedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQueuedWithdrawal.Merge(m, src)
 }
-func (m *MsgAddToInTxTracker) XXX_Size() int {
+func (m *MsgReleaseQueuedWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddToInTxTracker) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddToInTxTracker.DiscardUnknown(m)
+func (m *MsgReleaseQueuedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQueuedWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddToInTxTracker proto.InternalMessageInfo
+var xxx_messageInfo_MsgReleaseQueuedWithdrawal proto.InternalMessageInfo
 
-func (m *MsgAddToInTxTracker) GetCreator() string {
+func (m *MsgReleaseQueuedWithdrawal) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgAddToInTxTracker) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
-}
-
-func (m *MsgAddToInTxTracker) GetTxHash() string {
-	if m != nil {
-		return m.TxHash
-	}
-	return ""
-}
-
-func (m *MsgAddToInTxTracker) GetCoinType() common.CoinType {
-	if m != nil {
-		return m.CoinType
-	}
-	return common.CoinType_Zeta
-}
-
-func (m *MsgAddToInTxTracker) GetProof() *common.Proof {
-	if m != nil {
-		return m.Proof
-	}
-	return nil
-}
-
-func (m *MsgAddToInTxTracker) GetBlockHash() string {
+func (m *MsgReleaseQueuedWithdrawal) GetCctxIndex() string {
 	if m != nil {
-		return m.BlockHash
+		return m.CctxIndex
 	}
 	return ""
 }
 
-func (m *MsgAddToInTxTracker) GetTxIndex() int64 {
-	if m != nil {
-		return m.TxIndex
-	}
-	return 0
-}
-
-type MsgAddToInTxTrackerResponse struct {
+type MsgReleaseQueuedWithdrawalResponse struct {
 }
 
-func (m *MsgAddToInTxTrackerResponse) Reset()         { *m = MsgAddToInTxTrackerResponse{} }
-func (m *MsgAddToInTxTrackerResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddToInTxTrackerResponse) ProtoMessage()    {}
-func (*MsgAddToInTxTrackerResponse) Descriptor() ([]byte, []int) {
+func (m *MsgReleaseQueuedWithdrawalResponse) Reset()         { *m = MsgReleaseQueuedWithdrawalResponse{} }
+func (m *MsgReleaseQueuedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQueuedWithdrawalResponse) ProtoMessage()    {}
+func (*MsgReleaseQueuedWithdrawalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{7}
 }
-func (m *MsgAddToInTxTrackerResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgReleaseQueuedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddToInTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReleaseQueuedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddToInTxTrackerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReleaseQueuedWithdrawalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -428,40 +380,35 @@ func (m *MsgAddToInTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddToInTxTrackerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddToInTxTrackerResponse.Merge(m, src)
+func (m *MsgReleaseQueuedWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQueuedWithdrawalResponse.Merge(m, src)
 }
-func (m *MsgAddToInTxTrackerResponse) XXX_Size() int {
+func (m *MsgReleaseQueuedWithdrawalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddToInTxTrackerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddToInTxTrackerResponse.DiscardUnknown(m)
+func (m *MsgReleaseQueuedWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQueuedWithdrawalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddToInTxTrackerResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgReleaseQueuedWithdrawalResponse proto.InternalMessageInfo
 
-type MsgWhitelistERC20 struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
-	ChainId      int64  `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Name         string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol       string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Decimals     uint32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	GasLimit     int64  `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+type MsgCancelQueuedWithdrawal struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
 }
 
-func (m *MsgWhitelistERC20) Reset()         { *m = MsgWhitelistERC20{} }
-func (m *MsgWhitelistERC20) String() string { return proto.CompactTextString(m) }
-func (*MsgWhitelistERC20) ProtoMessage()    {}
-func (*MsgWhitelistERC20) Descriptor() ([]byte, []int) {
+func (m *MsgCancelQueuedWithdrawal) Reset()         { *m = MsgCancelQueuedWithdrawal{} }
+func (m *MsgCancelQueuedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedWithdrawal) ProtoMessage()    {}
+func (*MsgCancelQueuedWithdrawal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_81d6d611190b7635, []int{8}
 }
-func (m *MsgWhitelistERC20) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelQueuedWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWhitelistERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelQueuedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWhitelistERC20.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelQueuedWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -471,84 +418,87 @@ func (m *MsgWhitelistERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgWhitelistERC20) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWhitelistERC20.Merge(m, src)
+func (m *MsgCancelQueuedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedWithdrawal.Merge(m, src)
 }
-func (m *MsgWhitelistERC20) XXX_Size() int {
+func (m *MsgCancelQueuedWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWhitelistERC20) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWhitelistERC20.DiscardUnknown(m)
+func (m *MsgCancelQueuedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWhitelistERC20 proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelQueuedWithdrawal proto.InternalMessageInfo
 
-func (m *MsgWhitelistERC20) GetCreator() string {
+func (m *MsgCancelQueuedWithdrawal) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgWhitelistERC20) GetErc20Address() string {
+func (m *MsgCancelQueuedWithdrawal) GetCctxIndex() string {
 	if m != nil {
-		return m.Erc20Address
+		return m.CctxIndex
 	}
 	return ""
 }
 
-func (m *MsgWhitelistERC20) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
+type MsgCancelQueuedWithdrawalResponse struct {
 }
 
-func (m *MsgWhitelistERC20) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *MsgCancelQueuedWithdrawalResponse) Reset()         { *m = MsgCancelQueuedWithdrawalResponse{} }
+func (m *MsgCancelQueuedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelQueuedWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{9}
 }
-
-func (m *MsgWhitelistERC20) GetSymbol() string {
-	if m != nil {
-		return m.Symbol
+func (m *MsgCancelQueuedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *MsgWhitelistERC20) GetDecimals() uint32 {
-	if m != nil {
-		return m.Decimals
-	}
-	return 0
+func (m *MsgCancelQueuedWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedWithdrawalResponse.Merge(m, src)
 }
-
-func (m *MsgWhitelistERC20) GetGasLimit() int64 {
-	if m != nil {
-		return m.GasLimit
-	}
-	return 0
+func (m *MsgCancelQueuedWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedWithdrawalResponse.DiscardUnknown(m)
 }
 
-type MsgWhitelistERC20Response struct {
-	Zrc20Address string `protobuf:"bytes,1,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
-	CctxIndex    string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+var xxx_messageInfo_MsgCancelQueuedWithdrawalResponse proto.InternalMessageInfo
+
+type MsgCreateTSSVoter struct {
+	Creator          string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TssPubkey        string               `protobuf:"bytes,2,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
+	KeyGenZetaHeight int64                `protobuf:"varint,3,opt,name=keyGenZetaHeight,proto3" json:"keyGenZetaHeight,omitempty"`
+	Status           common.ReceiveStatus `protobuf:"varint,4,opt,name=status,proto3,enum=common.ReceiveStatus" json:"status,omitempty"`
 }
 
-func (m *MsgWhitelistERC20Response) Reset()         { *m = MsgWhitelistERC20Response{} }
-func (m *MsgWhitelistERC20Response) String() string { return proto.CompactTextString(m) }
-func (*MsgWhitelistERC20Response) ProtoMessage()    {}
-func (*MsgWhitelistERC20Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{9}
+func (m *MsgCreateTSSVoter) Reset()         { *m = MsgCreateTSSVoter{} }
+func (m *MsgCreateTSSVoter) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTSSVoter) ProtoMessage()    {}
+func (*MsgCreateTSSVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{10}
 }
-func (m *MsgWhitelistERC20Response) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateTSSVoter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWhitelistERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateTSSVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWhitelistERC20Response.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateTSSVoter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -558,54 +508,61 @@ func (m *MsgWhitelistERC20Response) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgWhitelistERC20Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWhitelistERC20Response.Merge(m, src)
+func (m *MsgCreateTSSVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTSSVoter.Merge(m, src)
 }
-func (m *MsgWhitelistERC20Response) XXX_Size() int {
+func (m *MsgCreateTSSVoter) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWhitelistERC20Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWhitelistERC20Response.DiscardUnknown(m)
+func (m *MsgCreateTSSVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTSSVoter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWhitelistERC20Response proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateTSSVoter proto.InternalMessageInfo
 
-func (m *MsgWhitelistERC20Response) GetZrc20Address() string {
+func (m *MsgCreateTSSVoter) GetCreator() string {
 	if m != nil {
-		return m.Zrc20Address
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgWhitelistERC20Response) GetCctxIndex() string {
+func (m *MsgCreateTSSVoter) GetTssPubkey() string {
 	if m != nil {
-		return m.CctxIndex
+		return m.TssPubkey
 	}
 	return ""
 }
 
-type MsgAddToOutTxTracker struct {
-	Creator   string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId   int64         `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Nonce     uint64        `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	TxHash    string        `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	Proof     *common.Proof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
-	BlockHash string        `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	TxIndex   int64         `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+func (m *MsgCreateTSSVoter) GetKeyGenZetaHeight() int64 {
+	if m != nil {
+		return m.KeyGenZetaHeight
+	}
+	return 0
 }
 
-func (m *MsgAddToOutTxTracker) Reset()         { *m = MsgAddToOutTxTracker{} }
-func (m *MsgAddToOutTxTracker) String() string { return proto.CompactTextString(m) }
-func (*MsgAddToOutTxTracker) ProtoMessage()    {}
-func (*MsgAddToOutTxTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{10}
+func (m *MsgCreateTSSVoter) GetStatus() common.ReceiveStatus {
+	if m != nil {
+		return m.Status
+	}
+	return common.ReceiveStatus_Created
 }
-func (m *MsgAddToOutTxTracker) XXX_Unmarshal(b []byte) error {
+
+type MsgCreateTSSVoterResponse struct {
+}
+
+func (m *MsgCreateTSSVoterResponse) Reset()         { *m = MsgCreateTSSVoterResponse{} }
+func (m *MsgCreateTSSVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTSSVoterResponse) ProtoMessage()    {}
+func (*MsgCreateTSSVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{11}
+}
+func (m *MsgCreateTSSVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddToOutTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateTSSVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddToOutTxTracker.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateTSSVoterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -615,83 +572,86 @@ func (m *MsgAddToOutTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgAddToOutTxTracker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddToOutTxTracker.Merge(m, src)
+func (m *MsgCreateTSSVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTSSVoterResponse.Merge(m, src)
 }
-func (m *MsgAddToOutTxTracker) XXX_Size() int {
+func (m *MsgCreateTSSVoterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddToOutTxTracker) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddToOutTxTracker.DiscardUnknown(m)
+func (m *MsgCreateTSSVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTSSVoterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddToOutTxTracker proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateTSSVoterResponse proto.InternalMessageInfo
 
-func (m *MsgAddToOutTxTracker) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
+type MsgMigrateTssFunds struct {
+	Creator string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64                                   `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
 }
 
-func (m *MsgAddToOutTxTracker) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
+func (m *MsgMigrateTssFunds) Reset()         { *m = MsgMigrateTssFunds{} }
+func (m *MsgMigrateTssFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTssFunds) ProtoMessage()    {}
+func (*MsgMigrateTssFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{12}
 }
-
-func (m *MsgAddToOutTxTracker) GetNonce() uint64 {
-	if m != nil {
-		return m.Nonce
-	}
-	return 0
+func (m *MsgMigrateTssFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgAddToOutTxTracker) GetTxHash() string {
-	if m != nil {
-		return m.TxHash
+func (m *MsgMigrateTssFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateTssFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *MsgAddToOutTxTracker) GetProof() *common.Proof {
-	if m != nil {
-		return m.Proof
-	}
-	return nil
+func (m *MsgMigrateTssFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTssFunds.Merge(m, src)
+}
+func (m *MsgMigrateTssFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateTssFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTssFunds.DiscardUnknown(m)
 }
 
-func (m *MsgAddToOutTxTracker) GetBlockHash() string {
+var xxx_messageInfo_MsgMigrateTssFunds proto.InternalMessageInfo
+
+func (m *MsgMigrateTssFunds) GetCreator() string {
 	if m != nil {
-		return m.BlockHash
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgAddToOutTxTracker) GetTxIndex() int64 {
+func (m *MsgMigrateTssFunds) GetChainId() int64 {
 	if m != nil {
-		return m.TxIndex
+		return m.ChainId
 	}
 	return 0
 }
 
-type MsgAddToOutTxTrackerResponse struct {
-	IsRemoved bool `protobuf:"varint,1,opt,name=is_removed,json=isRemoved,proto3" json:"is_removed,omitempty"`
+type MsgMigrateTssFundsResponse struct {
 }
 
-func (m *MsgAddToOutTxTrackerResponse) Reset()         { *m = MsgAddToOutTxTrackerResponse{} }
-func (m *MsgAddToOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddToOutTxTrackerResponse) ProtoMessage()    {}
-func (*MsgAddToOutTxTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{11}
+func (m *MsgMigrateTssFundsResponse) Reset()         { *m = MsgMigrateTssFundsResponse{} }
+func (m *MsgMigrateTssFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTssFundsResponse) ProtoMessage()    {}
+func (*MsgMigrateTssFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{13}
 }
-func (m *MsgAddToOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMigrateTssFundsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddToOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMigrateTssFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddToOutTxTrackerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMigrateTssFundsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -701,43 +661,36 @@ func (m *MsgAddToOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddToOutTxTrackerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddToOutTxTrackerResponse.Merge(m, src)
+func (m *MsgMigrateTssFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTssFundsResponse.Merge(m, src)
 }
-func (m *MsgAddToOutTxTrackerResponse) XXX_Size() int {
+func (m *MsgMigrateTssFundsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddToOutTxTrackerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddToOutTxTrackerResponse.DiscardUnknown(m)
+func (m *MsgMigrateTssFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTssFundsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddToOutTxTrackerResponse proto.InternalMessageInfo
-
-func (m *MsgAddToOutTxTrackerResponse) GetIsRemoved() bool {
-	if m != nil {
-		return m.IsRemoved
-	}
-	return false
-}
+var xxx_messageInfo_MsgMigrateTssFundsResponse proto.InternalMessageInfo
 
-type MsgRemoveFromOutTxTracker struct {
+// MsgMigrateAllTssFunds schedules the migration of the funds of the current TSS to the new TSS on every supported chain
+// the migrated amounts are derived from the TSS balances voted by the observers
+type MsgMigrateAllTssFunds struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Nonce   uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *MsgRemoveFromOutTxTracker) Reset()         { *m = MsgRemoveFromOutTxTracker{} }
-func (m *MsgRemoveFromOutTxTracker) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveFromOutTxTracker) ProtoMessage()    {}
-func (*MsgRemoveFromOutTxTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{12}
+func (m *MsgMigrateAllTssFunds) Reset()         { *m = MsgMigrateAllTssFunds{} }
+func (m *MsgMigrateAllTssFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAllTssFunds) ProtoMessage()    {}
+func (*MsgMigrateAllTssFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{14}
 }
-func (m *MsgRemoveFromOutTxTracker) XXX_Unmarshal(b []byte) error {
+func (m *MsgMigrateAllTssFunds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveFromOutTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMigrateAllTssFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveFromOutTxTracker.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMigrateAllTssFunds.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -747,54 +700,41 @@ func (m *MsgRemoveFromOutTxTracker) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveFromOutTxTracker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveFromOutTxTracker.Merge(m, src)
+func (m *MsgMigrateAllTssFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAllTssFunds.Merge(m, src)
 }
-func (m *MsgRemoveFromOutTxTracker) XXX_Size() int {
+func (m *MsgMigrateAllTssFunds) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveFromOutTxTracker) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveFromOutTxTracker.DiscardUnknown(m)
+func (m *MsgMigrateAllTssFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAllTssFunds.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveFromOutTxTracker proto.InternalMessageInfo
+var xxx_messageInfo_MsgMigrateAllTssFunds proto.InternalMessageInfo
 
-func (m *MsgRemoveFromOutTxTracker) GetCreator() string {
+func (m *MsgMigrateAllTssFunds) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRemoveFromOutTxTracker) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
-}
-
-func (m *MsgRemoveFromOutTxTracker) GetNonce() uint64 {
-	if m != nil {
-		return m.Nonce
-	}
-	return 0
-}
-
-type MsgRemoveFromOutTxTrackerResponse struct {
+type MsgMigrateAllTssFundsResponse struct {
+	CctxIndexes []string `protobuf:"bytes,1,rep,name=cctx_indexes,json=cctxIndexes,proto3" json:"cctx_indexes,omitempty"`
 }
 
-func (m *MsgRemoveFromOutTxTrackerResponse) Reset()         { *m = MsgRemoveFromOutTxTrackerResponse{} }
-func (m *MsgRemoveFromOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveFromOutTxTrackerResponse) ProtoMessage()    {}
-func (*MsgRemoveFromOutTxTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{13}
+func (m *MsgMigrateAllTssFundsResponse) Reset()         { *m = MsgMigrateAllTssFundsResponse{} }
+func (m *MsgMigrateAllTssFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAllTssFundsResponse) ProtoMessage()    {}
+func (*MsgMigrateAllTssFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{15}
 }
-func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMigrateAllTssFundsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMigrateAllTssFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMigrateAllTssFundsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -804,38 +744,42 @@ func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse.Merge(m, src)
+func (m *MsgMigrateAllTssFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAllTssFundsResponse.Merge(m, src)
 }
-func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Size() int {
+func (m *MsgMigrateAllTssFundsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveFromOutTxTrackerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse.DiscardUnknown(m)
+func (m *MsgMigrateAllTssFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAllTssFundsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMigrateAllTssFundsResponse proto.InternalMessageInfo
 
-type MsgGasPriceVoter struct {
-	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId     int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Price       uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Supply      string `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`
+func (m *MsgMigrateAllTssFundsResponse) GetCctxIndexes() []string {
+	if m != nil {
+		return m.CctxIndexes
+	}
+	return nil
 }
 
-func (m *MsgGasPriceVoter) Reset()         { *m = MsgGasPriceVoter{} }
-func (m *MsgGasPriceVoter) String() string { return proto.CompactTextString(m) }
-func (*MsgGasPriceVoter) ProtoMessage()    {}
-func (*MsgGasPriceVoter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{14}
+type MsgUpdateTssAddress struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TssPubkey string `protobuf:"bytes,2,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
 }
-func (m *MsgGasPriceVoter) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateTssAddress) Reset()         { *m = MsgUpdateTssAddress{} }
+func (m *MsgUpdateTssAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTssAddress) ProtoMessage()    {}
+func (*MsgUpdateTssAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{16}
+}
+func (m *MsgUpdateTssAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGasPriceVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateTssAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGasPriceVoter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateTssAddress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -845,68 +789,47 @@ func (m *MsgGasPriceVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgGasPriceVoter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGasPriceVoter.Merge(m, src)
+func (m *MsgUpdateTssAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTssAddress.Merge(m, src)
 }
-func (m *MsgGasPriceVoter) XXX_Size() int {
+func (m *MsgUpdateTssAddress) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGasPriceVoter) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGasPriceVoter.DiscardUnknown(m)
+func (m *MsgUpdateTssAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTssAddress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGasPriceVoter proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateTssAddress proto.InternalMessageInfo
 
-func (m *MsgGasPriceVoter) GetCreator() string {
+func (m *MsgUpdateTssAddress) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgGasPriceVoter) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
-}
-
-func (m *MsgGasPriceVoter) GetPrice() uint64 {
-	if m != nil {
-		return m.Price
-	}
-	return 0
-}
-
-func (m *MsgGasPriceVoter) GetBlockNumber() uint64 {
-	if m != nil {
-		return m.BlockNumber
-	}
-	return 0
-}
-
-func (m *MsgGasPriceVoter) GetSupply() string {
+func (m *MsgUpdateTssAddress) GetTssPubkey() string {
 	if m != nil {
-		return m.Supply
+		return m.TssPubkey
 	}
 	return ""
 }
 
-type MsgGasPriceVoterResponse struct {
+type MsgUpdateTssAddressResponse struct {
 }
 
-func (m *MsgGasPriceVoterResponse) Reset()         { *m = MsgGasPriceVoterResponse{} }
-func (m *MsgGasPriceVoterResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGasPriceVoterResponse) ProtoMessage()    {}
-func (*MsgGasPriceVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{15}
+func (m *MsgUpdateTssAddressResponse) Reset()         { *m = MsgUpdateTssAddressResponse{} }
+func (m *MsgUpdateTssAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTssAddressResponse) ProtoMessage()    {}
+func (*MsgUpdateTssAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{17}
 }
-func (m *MsgGasPriceVoterResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateTssAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGasPriceVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateTssAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGasPriceVoterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateTssAddressResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -916,45 +839,40 @@ func (m *MsgGasPriceVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgGasPriceVoterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGasPriceVoterResponse.Merge(m, src)
+func (m *MsgUpdateTssAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTssAddressResponse.Merge(m, src)
 }
-func (m *MsgGasPriceVoterResponse) XXX_Size() int {
+func (m *MsgUpdateTssAddressResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGasPriceVoterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGasPriceVoterResponse.DiscardUnknown(m)
+func (m *MsgUpdateTssAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTssAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGasPriceVoterResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateTssAddressResponse proto.InternalMessageInfo
 
-type MsgVoteOnObservedOutboundTx struct {
-	Creator                        string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	CctxHash                       string                                  `protobuf:"bytes,2,opt,name=cctx_hash,json=cctxHash,proto3" json:"cctx_hash,omitempty"`
-	ObservedOutTxHash              string                                  `protobuf:"bytes,3,opt,name=observed_outTx_hash,json=observedOutTxHash,proto3" json:"observed_outTx_hash,omitempty"`
-	ObservedOutTxBlockHeight       uint64                                  `protobuf:"varint,4,opt,name=observed_outTx_blockHeight,json=observedOutTxBlockHeight,proto3" json:"observed_outTx_blockHeight,omitempty"`
-	ObservedOutTxGasUsed           uint64                                  `protobuf:"varint,10,opt,name=observed_outTx_gas_used,json=observedOutTxGasUsed,proto3" json:"observed_outTx_gas_used,omitempty"`
-	ObservedOutTxEffectiveGasPrice github_com_cosmos_cosmos_sdk_types.Int  `protobuf:"bytes,11,opt,name=observed_outTx_effective_gas_price,json=observedOutTxEffectiveGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"observed_outTx_effective_gas_price"`
-	ObservedOutTxEffectiveGasLimit uint64                                  `protobuf:"varint,12,opt,name=observed_outTx_effective_gas_limit,json=observedOutTxEffectiveGasLimit,proto3" json:"observed_outTx_effective_gas_limit,omitempty"`
-	ValueReceived                  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=value_received,json=valueReceived,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"value_received" yaml:"value_received"`
-	Status                         common.ReceiveStatus                    `protobuf:"varint,6,opt,name=status,proto3,enum=common.ReceiveStatus" json:"status,omitempty"`
-	OutTxChain                     int64                                   `protobuf:"varint,7,opt,name=outTx_chain,json=outTxChain,proto3" json:"outTx_chain,omitempty"`
-	OutTxTssNonce                  uint64                                  `protobuf:"varint,8,opt,name=outTx_tss_nonce,json=outTxTssNonce,proto3" json:"outTx_tss_nonce,omitempty"`
-	CoinType                       common.CoinType                         `protobuf:"varint,9,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
+type MsgAddToInTxTracker struct {
+	Creator   string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId   int64           `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TxHash    string          `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	CoinType  common.CoinType `protobuf:"varint,4,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
+	Proof     *common.Proof   `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	BlockHash string          `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxIndex   int64           `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 }
 
-func (m *MsgVoteOnObservedOutboundTx) Reset()         { *m = MsgVoteOnObservedOutboundTx{} }
-func (m *MsgVoteOnObservedOutboundTx) String() string { return proto.CompactTextString(m) }
-func (*MsgVoteOnObservedOutboundTx) ProtoMessage()    {}
-func (*MsgVoteOnObservedOutboundTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{16}
+func (m *MsgAddToInTxTracker) Reset()         { *m = MsgAddToInTxTracker{} }
+func (m *MsgAddToInTxTracker) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToInTxTracker) ProtoMessage()    {}
+func (*MsgAddToInTxTracker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{18}
 }
-func (m *MsgVoteOnObservedOutboundTx) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddToInTxTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgVoteOnObservedOutboundTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddToInTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgVoteOnObservedOutboundTx.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddToInTxTracker.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -964,103 +882,82 @@ func (m *MsgVoteOnObservedOutboundTx) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgVoteOnObservedOutboundTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgVoteOnObservedOutboundTx.Merge(m, src)
+func (m *MsgAddToInTxTracker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToInTxTracker.Merge(m, src)
 }
-func (m *MsgVoteOnObservedOutboundTx) XXX_Size() int {
+func (m *MsgAddToInTxTracker) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgVoteOnObservedOutboundTx) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgVoteOnObservedOutboundTx.DiscardUnknown(m)
+func (m *MsgAddToInTxTracker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToInTxTracker.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgVoteOnObservedOutboundTx proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddToInTxTracker proto.InternalMessageInfo
 
-func (m *MsgVoteOnObservedOutboundTx) GetCreator() string {
+func (m *MsgAddToInTxTracker) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedOutboundTx) GetCctxHash() string {
-	if m != nil {
-		return m.CctxHash
-	}
-	return ""
-}
-
-func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxHash() string {
-	if m != nil {
-		return m.ObservedOutTxHash
-	}
-	return ""
-}
-
-func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxBlockHeight() uint64 {
+func (m *MsgAddToInTxTracker) GetChainId() int64 {
 	if m != nil {
-		return m.ObservedOutTxBlockHeight
+		return m.ChainId
 	}
 	return 0
 }
 
-func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxGasUsed() uint64 {
+func (m *MsgAddToInTxTracker) GetTxHash() string {
 	if m != nil {
-		return m.ObservedOutTxGasUsed
+		return m.TxHash
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxEffectiveGasLimit() uint64 {
+func (m *MsgAddToInTxTracker) GetCoinType() common.CoinType {
 	if m != nil {
-		return m.ObservedOutTxEffectiveGasLimit
+		return m.CoinType
 	}
-	return 0
+	return common.CoinType_Zeta
 }
 
-func (m *MsgVoteOnObservedOutboundTx) GetStatus() common.ReceiveStatus {
+func (m *MsgAddToInTxTracker) GetProof() *common.Proof {
 	if m != nil {
-		return m.Status
+		return m.Proof
 	}
-	return common.ReceiveStatus_Created
+	return nil
 }
 
-func (m *MsgVoteOnObservedOutboundTx) GetOutTxChain() int64 {
+func (m *MsgAddToInTxTracker) GetBlockHash() string {
 	if m != nil {
-		return m.OutTxChain
+		return m.BlockHash
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgVoteOnObservedOutboundTx) GetOutTxTssNonce() uint64 {
+func (m *MsgAddToInTxTracker) GetTxIndex() int64 {
 	if m != nil {
-		return m.OutTxTssNonce
+		return m.TxIndex
 	}
 	return 0
 }
 
-func (m *MsgVoteOnObservedOutboundTx) GetCoinType() common.CoinType {
-	if m != nil {
-		return m.CoinType
-	}
-	return common.CoinType_Zeta
-}
-
-type MsgVoteOnObservedOutboundTxResponse struct {
+type MsgAddToInTxTrackerResponse struct {
 }
 
-func (m *MsgVoteOnObservedOutboundTxResponse) Reset()         { *m = MsgVoteOnObservedOutboundTxResponse{} }
-func (m *MsgVoteOnObservedOutboundTxResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgVoteOnObservedOutboundTxResponse) ProtoMessage()    {}
-func (*MsgVoteOnObservedOutboundTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{17}
+func (m *MsgAddToInTxTrackerResponse) Reset()         { *m = MsgAddToInTxTrackerResponse{} }
+func (m *MsgAddToInTxTrackerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToInTxTrackerResponse) ProtoMessage()    {}
+func (*MsgAddToInTxTrackerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{19}
 }
-func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddToInTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddToInTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddToInTxTrackerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1070,50 +967,40 @@ func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse.Merge(m, src)
+func (m *MsgAddToInTxTrackerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToInTxTrackerResponse.Merge(m, src)
 }
-func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Size() int {
+func (m *MsgAddToInTxTrackerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgVoteOnObservedOutboundTxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse.DiscardUnknown(m)
+func (m *MsgAddToInTxTrackerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToInTxTrackerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddToInTxTrackerResponse proto.InternalMessageInfo
 
-type MsgVoteOnObservedInboundTx struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Sender        string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
-	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
-	// string zeta_burnt = 6;
-	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
-	// string mMint = 7;
-	Message       string          `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
-	InTxHash      string          `protobuf:"bytes,9,opt,name=in_tx_hash,json=inTxHash,proto3" json:"in_tx_hash,omitempty"`
-	InBlockHeight uint64          `protobuf:"varint,10,opt,name=in_block_height,json=inBlockHeight,proto3" json:"in_block_height,omitempty"`
-	GasLimit      uint64          `protobuf:"varint,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	CoinType      common.CoinType `protobuf:"varint,12,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
-	TxOrigin      string          `protobuf:"bytes,13,opt,name=tx_origin,json=txOrigin,proto3" json:"tx_origin,omitempty"`
-	Asset         string          `protobuf:"bytes,14,opt,name=asset,proto3" json:"asset,omitempty"`
-	// event index of the sent asset in the observed tx
-	EventIndex uint64 `protobuf:"varint,15,opt,name=event_index,json=eventIndex,proto3" json:"event_index,omitempty"`
+type MsgWhitelistERC20 struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	ChainId      int64  `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Name         string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol       string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals     uint32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	GasLimit     int64  `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (m *MsgVoteOnObservedInboundTx) Reset()         { *m = MsgVoteOnObservedInboundTx{} }
-func (m *MsgVoteOnObservedInboundTx) String() string { return proto.CompactTextString(m) }
-func (*MsgVoteOnObservedInboundTx) ProtoMessage()    {}
-func (*MsgVoteOnObservedInboundTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{18}
+func (m *MsgWhitelistERC20) Reset()         { *m = MsgWhitelistERC20{} }
+func (m *MsgWhitelistERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgWhitelistERC20) ProtoMessage()    {}
+func (*MsgWhitelistERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{20}
 }
-func (m *MsgVoteOnObservedInboundTx) XXX_Unmarshal(b []byte) error {
+func (m *MsgWhitelistERC20) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgVoteOnObservedInboundTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWhitelistERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgVoteOnObservedInboundTx.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWhitelistERC20.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1123,124 +1010,227 @@ func (m *MsgVoteOnObservedInboundTx) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgVoteOnObservedInboundTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgVoteOnObservedInboundTx.Merge(m, src)
+func (m *MsgWhitelistERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWhitelistERC20.Merge(m, src)
 }
-func (m *MsgVoteOnObservedInboundTx) XXX_Size() int {
+func (m *MsgWhitelistERC20) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgVoteOnObservedInboundTx) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgVoteOnObservedInboundTx.DiscardUnknown(m)
+func (m *MsgWhitelistERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWhitelistERC20.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgVoteOnObservedInboundTx proto.InternalMessageInfo
+var xxx_messageInfo_MsgWhitelistERC20 proto.InternalMessageInfo
 
-func (m *MsgVoteOnObservedInboundTx) GetCreator() string {
+func (m *MsgWhitelistERC20) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetSender() string {
+func (m *MsgWhitelistERC20) GetErc20Address() string {
 	if m != nil {
-		return m.Sender
+		return m.Erc20Address
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetSenderChainId() int64 {
+func (m *MsgWhitelistERC20) GetChainId() int64 {
 	if m != nil {
-		return m.SenderChainId
+		return m.ChainId
 	}
 	return 0
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetReceiver() string {
+func (m *MsgWhitelistERC20) GetName() string {
 	if m != nil {
-		return m.Receiver
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetReceiverChain() int64 {
+func (m *MsgWhitelistERC20) GetSymbol() string {
 	if m != nil {
-		return m.ReceiverChain
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MsgWhitelistERC20) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
 	}
 	return 0
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetMessage() string {
+func (m *MsgWhitelistERC20) GetGasLimit() int64 {
 	if m != nil {
-		return m.Message
+		return m.GasLimit
+	}
+	return 0
+}
+
+type MsgWhitelistERC20Response struct {
+	Zrc20Address string `protobuf:"bytes,1,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
+	CctxIndex    string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+}
+
+func (m *MsgWhitelistERC20Response) Reset()         { *m = MsgWhitelistERC20Response{} }
+func (m *MsgWhitelistERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgWhitelistERC20Response) ProtoMessage()    {}
+func (*MsgWhitelistERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{21}
+}
+func (m *MsgWhitelistERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWhitelistERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWhitelistERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWhitelistERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWhitelistERC20Response.Merge(m, src)
+}
+func (m *MsgWhitelistERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWhitelistERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWhitelistERC20Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWhitelistERC20Response proto.InternalMessageInfo
+
+func (m *MsgWhitelistERC20Response) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetInTxHash() string {
+func (m *MsgWhitelistERC20Response) GetCctxIndex() string {
 	if m != nil {
-		return m.InTxHash
+		return m.CctxIndex
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetInBlockHeight() uint64 {
+type MsgAddToOutTxTracker struct {
+	Creator   string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId   int64         `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce     uint64        `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TxHash    string        `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Proof     *common.Proof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	BlockHash string        `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxIndex   int64         `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (m *MsgAddToOutTxTracker) Reset()         { *m = MsgAddToOutTxTracker{} }
+func (m *MsgAddToOutTxTracker) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToOutTxTracker) ProtoMessage()    {}
+func (*MsgAddToOutTxTracker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{22}
+}
+func (m *MsgAddToOutTxTracker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToOutTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToOutTxTracker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddToOutTxTracker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToOutTxTracker.Merge(m, src)
+}
+func (m *MsgAddToOutTxTracker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToOutTxTracker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToOutTxTracker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddToOutTxTracker proto.InternalMessageInfo
+
+func (m *MsgAddToOutTxTracker) GetCreator() string {
 	if m != nil {
-		return m.InBlockHeight
+		return m.Creator
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetGasLimit() uint64 {
+func (m *MsgAddToOutTxTracker) GetChainId() int64 {
 	if m != nil {
-		return m.GasLimit
+		return m.ChainId
 	}
 	return 0
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetCoinType() common.CoinType {
+func (m *MsgAddToOutTxTracker) GetNonce() uint64 {
 	if m != nil {
-		return m.CoinType
+		return m.Nonce
 	}
-	return common.CoinType_Zeta
+	return 0
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetTxOrigin() string {
+func (m *MsgAddToOutTxTracker) GetTxHash() string {
 	if m != nil {
-		return m.TxOrigin
+		return m.TxHash
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetAsset() string {
+func (m *MsgAddToOutTxTracker) GetProof() *common.Proof {
 	if m != nil {
-		return m.Asset
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MsgAddToOutTxTracker) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *MsgVoteOnObservedInboundTx) GetEventIndex() uint64 {
+func (m *MsgAddToOutTxTracker) GetTxIndex() int64 {
 	if m != nil {
-		return m.EventIndex
+		return m.TxIndex
 	}
 	return 0
 }
 
-type MsgVoteOnObservedInboundTxResponse struct {
+type MsgAddToOutTxTrackerResponse struct {
+	IsRemoved bool `protobuf:"varint,1,opt,name=is_removed,json=isRemoved,proto3" json:"is_removed,omitempty"`
 }
 
-func (m *MsgVoteOnObservedInboundTxResponse) Reset()         { *m = MsgVoteOnObservedInboundTxResponse{} }
-func (m *MsgVoteOnObservedInboundTxResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgVoteOnObservedInboundTxResponse) ProtoMessage()    {}
-func (*MsgVoteOnObservedInboundTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{19}
+func (m *MsgAddToOutTxTrackerResponse) Reset()         { *m = MsgAddToOutTxTrackerResponse{} }
+func (m *MsgAddToOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToOutTxTrackerResponse) ProtoMessage()    {}
+func (*MsgAddToOutTxTrackerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{23}
 }
-func (m *MsgVoteOnObservedInboundTxResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddToOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgVoteOnObservedInboundTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddToOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgVoteOnObservedInboundTxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddToOutTxTrackerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1250,1858 +1240,4056 @@ func (m *MsgVoteOnObservedInboundTxResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgVoteOnObservedInboundTxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgVoteOnObservedInboundTxResponse.Merge(m, src)
+func (m *MsgAddToOutTxTrackerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToOutTxTrackerResponse.Merge(m, src)
 }
-func (m *MsgVoteOnObservedInboundTxResponse) XXX_Size() int {
+func (m *MsgAddToOutTxTrackerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgVoteOnObservedInboundTxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgVoteOnObservedInboundTxResponse.DiscardUnknown(m)
+func (m *MsgAddToOutTxTrackerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToOutTxTrackerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgVoteOnObservedInboundTxResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddToOutTxTrackerResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateTSSVoter)(nil), "zetachain.zetacore.crosschain.MsgCreateTSSVoter")
-	proto.RegisterType((*MsgCreateTSSVoterResponse)(nil), "zetachain.zetacore.crosschain.MsgCreateTSSVoterResponse")
-	proto.RegisterType((*MsgMigrateTssFunds)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFunds")
-	proto.RegisterType((*MsgMigrateTssFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFundsResponse")
-	proto.RegisterType((*MsgUpdateTssAddress)(nil), "zetachain.zetacore.crosschain.MsgUpdateTssAddress")
-	proto.RegisterType((*MsgUpdateTssAddressResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateTssAddressResponse")
-	proto.RegisterType((*MsgAddToInTxTracker)(nil), "zetachain.zetacore.crosschain.MsgAddToInTxTracker")
-	proto.RegisterType((*MsgAddToInTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgAddToInTxTrackerResponse")
-	proto.RegisterType((*MsgWhitelistERC20)(nil), "zetachain.zetacore.crosschain.MsgWhitelistERC20")
-	proto.RegisterType((*MsgWhitelistERC20Response)(nil), "zetachain.zetacore.crosschain.MsgWhitelistERC20Response")
-	proto.RegisterType((*MsgAddToOutTxTracker)(nil), "zetachain.zetacore.crosschain.MsgAddToOutTxTracker")
-	proto.RegisterType((*MsgAddToOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgAddToOutTxTrackerResponse")
-	proto.RegisterType((*MsgRemoveFromOutTxTracker)(nil), "zetachain.zetacore.crosschain.MsgRemoveFromOutTxTracker")
-	proto.RegisterType((*MsgRemoveFromOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgRemoveFromOutTxTrackerResponse")
-	proto.RegisterType((*MsgGasPriceVoter)(nil), "zetachain.zetacore.crosschain.MsgGasPriceVoter")
-	proto.RegisterType((*MsgGasPriceVoterResponse)(nil), "zetachain.zetacore.crosschain.MsgGasPriceVoterResponse")
-	proto.RegisterType((*MsgVoteOnObservedOutboundTx)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedOutboundTx")
-	proto.RegisterType((*MsgVoteOnObservedOutboundTxResponse)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedOutboundTxResponse")
-	proto.RegisterType((*MsgVoteOnObservedInboundTx)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedInboundTx")
-	proto.RegisterType((*MsgVoteOnObservedInboundTxResponse)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedInboundTxResponse")
+func (m *MsgAddToOutTxTrackerResponse) GetIsRemoved() bool {
+	if m != nil {
+		return m.IsRemoved
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("crosschain/tx.proto", fileDescriptor_81d6d611190b7635) }
-
-var fileDescriptor_81d6d611190b7635 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x6d, 0x4f, 0x1b, 0xc7,
-	0x13, 0xe7, 0xfe, 0x80, 0xb1, 0x07, 0x0c, 0xe4, 0x20, 0x89, 0x73, 0x04, 0x43, 0x8e, 0x7f, 0x52,
-	0x54, 0x09, 0x3b, 0x21, 0xaa, 0x9a, 0xa4, 0xad, 0xd4, 0x80, 0x12, 0x42, 0x5b, 0x42, 0x74, 0x71,
-	0x5a, 0x29, 0x6f, 0x4e, 0xe7, 0xbb, 0xe5, 0x7c, 0xc2, 0xb7, 0x6b, 0xdd, 0xae, 0x91, 0x8d, 0x2a,
-	0x55, 0x8a, 0xd4, 0xf7, 0x55, 0x55, 0xa9, 0x55, 0xbf, 0x40, 0xbf, 0x4a, 0x5e, 0x46, 0x7d, 0xd5,
-	0x54, 0x6a, 0x54, 0x85, 0x4f, 0xd0, 0x7e, 0x82, 0x6a, 0x1f, 0xee, 0xf0, 0x19, 0xfc, 0x00, 0x51,
-	0x5e, 0xdd, 0xce, 0xec, 0xce, 0xec, 0xcc, 0x6f, 0x66, 0x76, 0xf6, 0x16, 0xe6, 0xdc, 0x88, 0x50,
-	0xea, 0xd6, 0x9c, 0x00, 0x97, 0x59, 0xab, 0xd4, 0x88, 0x08, 0x23, 0xfa, 0xe2, 0x21, 0x62, 0x8e,
-	0xe0, 0x95, 0xc4, 0x88, 0x44, 0xa8, 0x74, 0xbc, 0xce, 0x98, 0x73, 0x49, 0x18, 0x12, 0x5c, 0x96,
-	0x1f, 0x29, 0x63, 0xcc, 0xfb, 0xc4, 0x27, 0x62, 0x58, 0xe6, 0x23, 0xc9, 0x35, 0x7f, 0xd3, 0xe0,
-	0xc2, 0x0e, 0xf5, 0x37, 0x23, 0xe4, 0x30, 0x54, 0x79, 0xfa, 0xf4, 0x6b, 0xc2, 0x50, 0xa4, 0x17,
-	0x60, 0xc2, 0xe5, 0x1c, 0x12, 0x15, 0xb4, 0x65, 0x6d, 0x35, 0x67, 0xc5, 0xa4, 0xbe, 0x08, 0xc0,
-	0x28, 0xb5, 0x1b, 0xcd, 0xea, 0x3e, 0x6a, 0x17, 0xfe, 0x27, 0x26, 0x73, 0x8c, 0xd2, 0x27, 0x82,
-	0xa1, 0x7f, 0x08, 0xb3, 0xfb, 0xa8, 0xbd, 0x85, 0xf0, 0x73, 0xc4, 0x9c, 0x47, 0x28, 0xf0, 0x6b,
-	0xac, 0x30, 0xba, 0xac, 0xad, 0x8e, 0x5a, 0x27, 0xf8, 0xfa, 0x1a, 0x64, 0x28, 0x73, 0x58, 0x93,
-	0x16, 0xc6, 0x96, 0xb5, 0xd5, 0xe9, 0xf5, 0x8b, 0x25, 0x65, 0xaf, 0x85, 0x5c, 0x14, 0x1c, 0xa0,
-	0xa7, 0x62, 0xd2, 0x52, 0x8b, 0xcc, 0x05, 0xb8, 0x72, 0xc2, 0x50, 0x0b, 0xd1, 0x06, 0xc1, 0x14,
-	0x99, 0x3f, 0x6a, 0xa0, 0xef, 0x50, 0x7f, 0x27, 0xf0, 0x23, 0x3e, 0x4d, 0xe9, 0xc3, 0x26, 0xf6,
-	0x68, 0x1f, 0x3f, 0xae, 0x40, 0x56, 0x60, 0x65, 0x07, 0x9e, 0xf0, 0x62, 0xd4, 0x9a, 0x10, 0xf4,
-	0xb6, 0xa7, 0x6f, 0x41, 0xc6, 0x09, 0x49, 0x13, 0x4b, 0xcb, 0x73, 0x1b, 0xe5, 0x97, 0x6f, 0x96,
-	0x46, 0xfe, 0x7c, 0xb3, 0xf4, 0x81, 0x1f, 0xb0, 0x5a, 0xb3, 0xca, 0xad, 0x2c, 0xbb, 0x84, 0x86,
-	0x84, 0xaa, 0xcf, 0x1a, 0xf5, 0xf6, 0xcb, 0xac, 0xdd, 0x40, 0xb4, 0xf4, 0x2c, 0xc0, 0xcc, 0x52,
-	0xe2, 0xe6, 0x55, 0x30, 0x4e, 0xda, 0x94, 0x98, 0xfc, 0x18, 0xe6, 0x76, 0xa8, 0xff, 0xac, 0xe1,
-	0xc9, 0xc9, 0xfb, 0x9e, 0x17, 0x21, 0x4a, 0xcf, 0x0d, 0xbd, 0xb9, 0x08, 0x0b, 0xa7, 0xe8, 0x4b,
-	0xb6, 0xfb, 0x47, 0x13, 0xfb, 0xdd, 0xf7, 0xbc, 0x0a, 0xd9, 0xc6, 0x95, 0x56, 0x25, 0x72, 0xdc,
-	0xfd, 0xbe, 0xa1, 0xee, 0x03, 0xd1, 0x65, 0x98, 0x60, 0x2d, 0xbb, 0xe6, 0xd0, 0x9a, 0xc4, 0xc8,
-	0xca, 0xb0, 0xd6, 0x23, 0x87, 0xd6, 0xf4, 0x35, 0xc8, 0xb9, 0x24, 0xc0, 0x36, 0x47, 0x43, 0x85,
-	0x75, 0x36, 0x0e, 0xeb, 0x26, 0x09, 0x70, 0xa5, 0xdd, 0x40, 0x56, 0xd6, 0x55, 0x23, 0x7d, 0x05,
-	0xc6, 0x1b, 0x11, 0x21, 0x7b, 0x85, 0xf1, 0x65, 0x6d, 0x75, 0x72, 0x3d, 0x1f, 0x2f, 0x7d, 0xc2,
-	0x99, 0x96, 0x9c, 0xe3, 0x7e, 0x57, 0xeb, 0xc4, 0xdd, 0x97, 0xfb, 0x65, 0xa4, 0xdf, 0x82, 0x23,
-	0xb6, 0xbc, 0x02, 0x59, 0xd6, 0xb2, 0x03, 0xec, 0xa1, 0x56, 0x61, 0x42, 0x9a, 0xc9, 0x5a, 0xdb,
-	0x9c, 0x54, 0x90, 0x74, 0xbb, 0x9c, 0x40, 0xf2, 0xbb, 0xcc, 0xfd, 0x6f, 0x6a, 0x01, 0x43, 0xf5,
-	0x80, 0xb2, 0x07, 0xd6, 0xe6, 0xfa, 0xcd, 0x3e, 0x80, 0xac, 0x40, 0x1e, 0x45, 0xee, 0xfa, 0x4d,
-	0xdb, 0x91, 0xd8, 0xaa, 0x18, 0x4c, 0x09, 0x66, 0x1c, 0xbf, 0x4e, 0xd4, 0x46, 0xd3, 0xa8, 0xe9,
-	0x30, 0x86, 0x9d, 0x50, 0xe2, 0x92, 0xb3, 0xc4, 0x58, 0xbf, 0x04, 0x19, 0xda, 0x0e, 0xab, 0xa4,
-	0x2e, 0x20, 0xc8, 0x59, 0x8a, 0xd2, 0x0d, 0xc8, 0x7a, 0xc8, 0x0d, 0x42, 0xa7, 0x4e, 0x85, 0xcb,
-	0x79, 0x2b, 0xa1, 0xf5, 0x05, 0xc8, 0xf9, 0x0e, 0xb5, 0xeb, 0x41, 0x18, 0x30, 0xe5, 0x72, 0xd6,
-	0x77, 0xe8, 0x57, 0x9c, 0x36, 0x6d, 0x51, 0x26, 0x69, 0x9f, 0x62, 0x8f, 0xb9, 0x07, 0x87, 0x29,
-	0x0f, 0xa4, 0x87, 0x53, 0x87, 0x9d, 0x1e, 0x2c, 0x02, 0xb8, 0x6e, 0x02, 0xa9, 0xca, 0x33, 0xce,
-	0x91, 0xa0, 0xbe, 0xd6, 0x60, 0x3e, 0x46, 0x75, 0xb7, 0xc9, 0xde, 0x31, 0x93, 0xe6, 0x61, 0x1c,
-	0x13, 0xec, 0x22, 0x81, 0xd5, 0x98, 0x25, 0x89, 0xce, 0xfc, 0x1a, 0x4b, 0xe5, 0xd7, 0x7b, 0x4e,
-	0x98, 0xcf, 0xe0, 0xea, 0x69, 0xae, 0x25, 0xf8, 0x2d, 0x02, 0x04, 0xd4, 0x8e, 0x50, 0x48, 0x0e,
-	0x90, 0x27, 0xbc, 0xcc, 0x5a, 0xb9, 0x80, 0x5a, 0x92, 0x61, 0xee, 0x09, 0xec, 0x25, 0xf5, 0x30,
-	0x22, 0xe1, 0x7b, 0x82, 0xc7, 0x5c, 0x81, 0x6b, 0x3d, 0xf7, 0x49, 0xb2, 0xfb, 0x17, 0x0d, 0x66,
-	0x77, 0xa8, 0xbf, 0xe5, 0xd0, 0x27, 0x51, 0xe0, 0xa2, 0x41, 0x07, 0x7b, 0x7f, 0x23, 0x1a, 0x5c,
-	0x45, 0x6c, 0x84, 0x20, 0xf4, 0x6b, 0x30, 0x25, 0x51, 0xc6, 0xcd, 0xb0, 0x8a, 0x22, 0x11, 0xa8,
-	0x31, 0x6b, 0x52, 0xf0, 0x1e, 0x0b, 0x96, 0x48, 0xee, 0x66, 0xa3, 0x51, 0x6f, 0x27, 0xc9, 0x2d,
-	0x28, 0xd3, 0x80, 0x42, 0xb7, 0x65, 0x89, 0xd9, 0xaf, 0xc7, 0x45, 0xd1, 0x72, 0xe6, 0x2e, 0xde,
-	0xad, 0x52, 0x14, 0x1d, 0x20, 0x6f, 0xb7, 0xc9, 0xaa, 0xa4, 0x89, 0xbd, 0x4a, 0xab, 0x8f, 0x07,
-	0x0b, 0x20, 0xb2, 0x54, 0x46, 0x5d, 0xa6, 0x6d, 0x96, 0x33, 0x44, 0xd0, 0x4b, 0x30, 0x47, 0x94,
-	0x32, 0x9b, 0x70, 0xb8, 0x3a, 0x4f, 0xaf, 0x0b, 0xe4, 0x78, 0x9f, 0x8a, 0x5c, 0xff, 0x29, 0x18,
-	0x5d, 0xeb, 0x65, 0x02, 0xc9, 0x96, 0x26, 0x7d, 0x2d, 0xa4, 0xc4, 0x36, 0x8e, 0xe7, 0xf5, 0x8f,
-	0xe0, 0x72, 0x97, 0x34, 0x2f, 0xd8, 0x26, 0x45, 0x5e, 0x01, 0x84, 0xe8, 0x7c, 0x4a, 0x74, 0xcb,
-	0xa1, 0xcf, 0x28, 0xf2, 0xf4, 0x43, 0x30, 0xbb, 0xc4, 0xd0, 0xde, 0x1e, 0x72, 0x59, 0x70, 0x80,
-	0x84, 0x02, 0x19, 0x85, 0x49, 0xd1, 0x95, 0x4a, 0xaa, 0x2b, 0xdd, 0x18, 0xa2, 0x2b, 0x6d, 0x63,
-	0x66, 0x15, 0x53, 0x3b, 0x3e, 0x88, 0xf5, 0xc6, 0x41, 0xd0, 0xbf, 0x18, 0xb0, 0xb7, 0x3c, 0x6d,
-	0xa6, 0x84, 0xf5, 0xbd, 0x75, 0x89, 0x33, 0x48, 0x27, 0x30, 0x7d, 0xe0, 0xd4, 0x9b, 0xc8, 0x8e,
-	0x64, 0x27, 0xf7, 0x64, 0xfc, 0x37, 0x1e, 0x9d, 0xb1, 0x93, 0xfe, 0xfb, 0x66, 0xe9, 0x62, 0xdb,
-	0x09, 0xeb, 0xf7, 0xcc, 0xb4, 0x3a, 0xd3, 0xca, 0x0b, 0x86, 0xba, 0x28, 0x78, 0x1d, 0x57, 0x89,
-	0xcc, 0x10, 0x57, 0x09, 0x7d, 0x09, 0x26, 0xa5, 0x8b, 0x22, 0xc3, 0xd5, 0x21, 0x00, 0x82, 0xb5,
-	0xc9, 0x39, 0xfa, 0x0d, 0x98, 0x91, 0x0b, 0x78, 0xc3, 0x95, 0x05, 0x98, 0x15, 0x9e, 0xe7, 0x05,
-	0xbb, 0x42, 0xe9, 0x63, 0x71, 0x4e, 0xa5, 0xda, 0x5d, 0x6e, 0x50, 0xbb, 0x33, 0xaf, 0xc3, 0x4a,
-	0x9f, 0xd4, 0x4e, 0x4a, 0xe0, 0xc5, 0x98, 0xb8, 0x38, 0xa4, 0xd7, 0x6d, 0xe3, 0xc1, 0x15, 0xc0,
-	0xeb, 0x0d, 0x61, 0x0f, 0x45, 0x2a, 0xfd, 0x15, 0xc5, 0xdd, 0x91, 0x23, 0xbb, 0xab, 0x35, 0xe5,
-	0x25, 0x7b, 0x53, 0x15, 0xba, 0x01, 0x59, 0x05, 0x71, 0xa4, 0xce, 0xdd, 0x84, 0xd6, 0xaf, 0xc3,
-	0x74, 0x3c, 0x56, 0xb0, 0x8d, 0x4b, 0x15, 0x31, 0x57, 0x22, 0x77, 0x7c, 0x79, 0xca, 0xbc, 0xd3,
-	0xe5, 0x89, 0x7b, 0x19, 0x22, 0x4a, 0x1d, 0x5f, 0x42, 0x9f, 0xb3, 0x62, 0x52, 0xbf, 0x0a, 0xc0,
-	0x21, 0x57, 0x15, 0x9c, 0x93, 0x76, 0x06, 0x58, 0x15, 0xee, 0x0d, 0x98, 0x09, 0xb0, 0xad, 0xce,
-	0x7f, 0x59, 0xad, 0xb2, 0xe4, 0xf2, 0x01, 0xee, 0x2c, 0xd1, 0x54, 0x13, 0x9d, 0x14, 0x2b, 0x92,
-	0x26, 0x9a, 0x8e, 0xeb, 0xd4, 0xc0, 0x6b, 0xcc, 0x02, 0xe4, 0x58, 0xcb, 0x26, 0x51, 0xe0, 0x07,
-	0xb8, 0x90, 0x97, 0x06, 0xb1, 0xd6, 0xae, 0xa0, 0xf9, 0xe9, 0xe9, 0x50, 0x8a, 0x58, 0x61, 0x5a,
-	0x4c, 0x48, 0x82, 0xa7, 0x20, 0x3a, 0x40, 0x98, 0xa9, 0x3e, 0x34, 0x23, 0x0c, 0x00, 0xc1, 0x92,
-	0xad, 0xe8, 0xff, 0x60, 0xf6, 0xce, 0x81, 0x38, 0x55, 0xd6, 0xff, 0x02, 0x18, 0xdd, 0xa1, 0xbe,
-	0xfe, 0xbd, 0x06, 0x17, 0x4e, 0x76, 0xe4, 0xdb, 0xa5, 0xbe, 0xff, 0x09, 0xa5, 0xd3, 0x7a, 0x9d,
-	0xf1, 0xc9, 0x39, 0x84, 0x92, 0x06, 0xf9, 0x42, 0x83, 0xd9, 0x13, 0x57, 0xcc, 0xf5, 0x21, 0x35,
-	0x76, 0xc8, 0x18, 0xf7, 0xce, 0x2e, 0x93, 0x18, 0xf1, 0x93, 0x06, 0x97, 0x7a, 0x34, 0xe1, 0x3b,
-	0x83, 0xd5, 0x9e, 0x2e, 0x69, 0x7c, 0x7e, 0x5e, 0xc9, 0xc4, 0xac, 0x36, 0xe4, 0xd3, 0xcd, 0xb8,
-	0x3c, 0x58, 0x65, 0x4a, 0xc0, 0xf8, 0xf8, 0x8c, 0x02, 0xc9, 0xd6, 0xbf, 0x6a, 0x50, 0xe8, 0xd9,
-	0x51, 0x87, 0x80, 0xba, 0x97, 0xac, 0xb1, 0x71, 0x7e, 0xd9, 0xc4, 0xb8, 0x9f, 0x35, 0xb8, 0xdc,
-	0xeb, 0xac, 0xbb, 0x7b, 0x56, 0xfd, 0x89, 0xa8, 0x71, 0xff, 0xdc, 0xa2, 0x89, 0x65, 0xdf, 0xc2,
-	0x74, 0xd7, 0xcf, 0xc1, 0xcd, 0xc1, 0x4a, 0xd3, 0x12, 0xc6, 0x9d, 0xb3, 0x4a, 0xa4, 0x6a, 0xe9,
-	0xc4, 0xef, 0xe1, 0x10, 0xb5, 0xd4, 0x2d, 0x33, 0x4c, 0x2d, 0xf5, 0xfa, 0x6d, 0xd4, 0xbf, 0x83,
-	0x99, 0xee, 0x9f, 0xea, 0x5b, 0x83, 0xd5, 0x75, 0x89, 0x18, 0x77, 0xcf, 0x2c, 0xd2, 0x19, 0x83,
-	0xae, 0xc7, 0x89, 0x21, 0x62, 0x90, 0x96, 0x18, 0x26, 0x06, 0xa7, 0xbf, 0x2b, 0x6c, 0x7c, 0xf9,
-	0xf2, 0x6d, 0x51, 0x7b, 0xf5, 0xb6, 0xa8, 0xfd, 0xfd, 0xb6, 0xa8, 0xfd, 0x70, 0x54, 0x1c, 0x79,
-	0x75, 0x54, 0x1c, 0xf9, 0xe3, 0xa8, 0x38, 0xf2, 0xfc, 0x56, 0x47, 0x43, 0xe3, 0x3a, 0xd7, 0xe4,
-	0x13, 0x4d, 0xac, 0xbe, 0xdc, 0x2a, 0x77, 0x3e, 0xdc, 0xf0, 0xfe, 0x56, 0xcd, 0x88, 0x27, 0x97,
-	0xdb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x47, 0x0c, 0x03, 0xae, 0xd3, 0x11, 0x00, 0x00,
+type MsgRemoveFromOutTxTracker struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce   uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgRemoveFromOutTxTracker) Reset()         { *m = MsgRemoveFromOutTxTracker{} }
+func (m *MsgRemoveFromOutTxTracker) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromOutTxTracker) ProtoMessage()    {}
+func (*MsgRemoveFromOutTxTracker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{24}
+}
+func (m *MsgRemoveFromOutTxTracker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromOutTxTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromOutTxTracker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFromOutTxTracker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromOutTxTracker.Merge(m, src)
+}
+func (m *MsgRemoveFromOutTxTracker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromOutTxTracker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromOutTxTracker.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRemoveFromOutTxTracker proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddToOutTxTracker(ctx context.Context, in *MsgAddToOutTxTracker, opts ...grpc.CallOption) (*MsgAddToOutTxTrackerResponse, error)
-	AddToInTxTracker(ctx context.Context, in *MsgAddToInTxTracker, opts ...grpc.CallOption) (*MsgAddToInTxTrackerResponse, error)
-	RemoveFromOutTxTracker(ctx context.Context, in *MsgRemoveFromOutTxTracker, opts ...grpc.CallOption) (*MsgRemoveFromOutTxTrackerResponse, error)
-	GasPriceVoter(ctx context.Context, in *MsgGasPriceVoter, opts ...grpc.CallOption) (*MsgGasPriceVoterResponse, error)
-	VoteOnObservedOutboundTx(ctx context.Context, in *MsgVoteOnObservedOutboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedOutboundTxResponse, error)
-	VoteOnObservedInboundTx(ctx context.Context, in *MsgVoteOnObservedInboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedInboundTxResponse, error)
-	WhitelistERC20(ctx context.Context, in *MsgWhitelistERC20, opts ...grpc.CallOption) (*MsgWhitelistERC20Response, error)
-	UpdateTssAddress(ctx context.Context, in *MsgUpdateTssAddress, opts ...grpc.CallOption) (*MsgUpdateTssAddressResponse, error)
-	MigrateTssFunds(ctx context.Context, in *MsgMigrateTssFunds, opts ...grpc.CallOption) (*MsgMigrateTssFundsResponse, error)
-	CreateTSSVoter(ctx context.Context, in *MsgCreateTSSVoter, opts ...grpc.CallOption) (*MsgCreateTSSVoterResponse, error)
+func (m *MsgRemoveFromOutTxTracker) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRemoveFromOutTxTracker) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRemoveFromOutTxTracker) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (c *msgClient) AddToOutTxTracker(ctx context.Context, in *MsgAddToOutTxTracker, opts ...grpc.CallOption) (*MsgAddToOutTxTrackerResponse, error) {
-	out := new(MsgAddToOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/AddToOutTxTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRemoveFromOutTxTrackerResponse struct {
 }
 
-func (c *msgClient) AddToInTxTracker(ctx context.Context, in *MsgAddToInTxTracker, opts ...grpc.CallOption) (*MsgAddToInTxTrackerResponse, error) {
-	out := new(MsgAddToInTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/AddToInTxTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFromOutTxTrackerResponse) Reset()         { *m = MsgRemoveFromOutTxTrackerResponse{} }
+func (m *MsgRemoveFromOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromOutTxTrackerResponse) ProtoMessage()    {}
+func (*MsgRemoveFromOutTxTrackerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{25}
+}
+func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse.Merge(m, src)
+}
+func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromOutTxTrackerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveFromOutTxTracker(ctx context.Context, in *MsgRemoveFromOutTxTracker, opts ...grpc.CallOption) (*MsgRemoveFromOutTxTrackerResponse, error) {
-	out := new(MsgRemoveFromOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/RemoveFromOutTxTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse proto.InternalMessageInfo
+
+type MsgGasPriceVoter struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId     int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Price       uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Supply      string `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`
+	PriorityFee uint64 `protobuf:"varint,6,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
 }
 
-func (c *msgClient) GasPriceVoter(ctx context.Context, in *MsgGasPriceVoter, opts ...grpc.CallOption) (*MsgGasPriceVoterResponse, error) {
-	out := new(MsgGasPriceVoterResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/GasPriceVoter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGasPriceVoter) Reset()         { *m = MsgGasPriceVoter{} }
+func (m *MsgGasPriceVoter) String() string { return proto.CompactTextString(m) }
+func (*MsgGasPriceVoter) ProtoMessage()    {}
+func (*MsgGasPriceVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{26}
+}
+func (m *MsgGasPriceVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasPriceVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasPriceVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgGasPriceVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasPriceVoter.Merge(m, src)
+}
+func (m *MsgGasPriceVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasPriceVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasPriceVoter.DiscardUnknown(m)
 }
 
-func (c *msgClient) VoteOnObservedOutboundTx(ctx context.Context, in *MsgVoteOnObservedOutboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedOutboundTxResponse, error) {
-	out := new(MsgVoteOnObservedOutboundTxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/VoteOnObservedOutboundTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGasPriceVoter proto.InternalMessageInfo
+
+func (m *MsgGasPriceVoter) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) VoteOnObservedInboundTx(ctx context.Context, in *MsgVoteOnObservedInboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedInboundTxResponse, error) {
-	out := new(MsgVoteOnObservedInboundTxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/VoteOnObservedInboundTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGasPriceVoter) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WhitelistERC20(ctx context.Context, in *MsgWhitelistERC20, opts ...grpc.CallOption) (*MsgWhitelistERC20Response, error) {
-	out := new(MsgWhitelistERC20Response)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/WhitelistERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGasPriceVoter) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateTssAddress(ctx context.Context, in *MsgUpdateTssAddress, opts ...grpc.CallOption) (*MsgUpdateTssAddressResponse, error) {
-	out := new(MsgUpdateTssAddressResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/UpdateTssAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGasPriceVoter) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) MigrateTssFunds(ctx context.Context, in *MsgMigrateTssFunds, opts ...grpc.CallOption) (*MsgMigrateTssFundsResponse, error) {
-	out := new(MsgMigrateTssFundsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/MigrateTssFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGasPriceVoter) GetSupply() string {
+	if m != nil {
+		return m.Supply
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateTSSVoter(ctx context.Context, in *MsgCreateTSSVoter, opts ...grpc.CallOption) (*MsgCreateTSSVoterResponse, error) {
-	out := new(MsgCreateTSSVoterResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/CreateTSSVoter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGasPriceVoter) GetPriorityFee() uint64 {
+	if m != nil {
+		return m.PriorityFee
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddToOutTxTracker(context.Context, *MsgAddToOutTxTracker) (*MsgAddToOutTxTrackerResponse, error)
-	AddToInTxTracker(context.Context, *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error)
-	RemoveFromOutTxTracker(context.Context, *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error)
-	GasPriceVoter(context.Context, *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error)
-	VoteOnObservedOutboundTx(context.Context, *MsgVoteOnObservedOutboundTx) (*MsgVoteOnObservedOutboundTxResponse, error)
-	VoteOnObservedInboundTx(context.Context, *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error)
-	WhitelistERC20(context.Context, *MsgWhitelistERC20) (*MsgWhitelistERC20Response, error)
-	UpdateTssAddress(context.Context, *MsgUpdateTssAddress) (*MsgUpdateTssAddressResponse, error)
-	MigrateTssFunds(context.Context, *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error)
-	CreateTSSVoter(context.Context, *MsgCreateTSSVoter) (*MsgCreateTSSVoterResponse, error)
+type MsgGasPriceVoterResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgGasPriceVoterResponse) Reset()         { *m = MsgGasPriceVoterResponse{} }
+func (m *MsgGasPriceVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGasPriceVoterResponse) ProtoMessage()    {}
+func (*MsgGasPriceVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{27}
 }
-
-func (*UnimplementedMsgServer) AddToOutTxTracker(ctx context.Context, req *MsgAddToOutTxTracker) (*MsgAddToOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToOutTxTracker not implemented")
+func (m *MsgGasPriceVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddToInTxTracker(ctx context.Context, req *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToInTxTracker not implemented")
+func (m *MsgGasPriceVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasPriceVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveFromOutTxTracker(ctx context.Context, req *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromOutTxTracker not implemented")
+func (m *MsgGasPriceVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasPriceVoterResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) GasPriceVoter(ctx context.Context, req *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPriceVoter not implemented")
+func (m *MsgGasPriceVoterResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) VoteOnObservedOutboundTx(ctx context.Context, req *MsgVoteOnObservedOutboundTx) (*MsgVoteOnObservedOutboundTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteOnObservedOutboundTx not implemented")
+func (m *MsgGasPriceVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasPriceVoterResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) VoteOnObservedInboundTx(ctx context.Context, req *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteOnObservedInboundTx not implemented")
+
+var xxx_messageInfo_MsgGasPriceVoterResponse proto.InternalMessageInfo
+
+type MsgVoteOnObservedOutboundTx struct {
+	Creator                        string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxHash                       string                                  `protobuf:"bytes,2,opt,name=cctx_hash,json=cctxHash,proto3" json:"cctx_hash,omitempty"`
+	ObservedOutTxHash              string                                  `protobuf:"bytes,3,opt,name=observed_outTx_hash,json=observedOutTxHash,proto3" json:"observed_outTx_hash,omitempty"`
+	ObservedOutTxBlockHeight       uint64                                  `protobuf:"varint,4,opt,name=observed_outTx_blockHeight,json=observedOutTxBlockHeight,proto3" json:"observed_outTx_blockHeight,omitempty"`
+	ObservedOutTxGasUsed           uint64                                  `protobuf:"varint,10,opt,name=observed_outTx_gas_used,json=observedOutTxGasUsed,proto3" json:"observed_outTx_gas_used,omitempty"`
+	ObservedOutTxEffectiveGasPrice github_com_cosmos_cosmos_sdk_types.Int  `protobuf:"bytes,11,opt,name=observed_outTx_effective_gas_price,json=observedOutTxEffectiveGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"observed_outTx_effective_gas_price"`
+	ObservedOutTxEffectiveGasLimit uint64                                  `protobuf:"varint,12,opt,name=observed_outTx_effective_gas_limit,json=observedOutTxEffectiveGasLimit,proto3" json:"observed_outTx_effective_gas_limit,omitempty"`
+	ValueReceived                  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=value_received,json=valueReceived,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"value_received" yaml:"value_received"`
+	Status                         common.ReceiveStatus                    `protobuf:"varint,6,opt,name=status,proto3,enum=common.ReceiveStatus" json:"status,omitempty"`
+	OutTxChain                     int64                                   `protobuf:"varint,7,opt,name=outTx_chain,json=outTxChain,proto3" json:"outTx_chain,omitempty"`
+	OutTxTssNonce                  uint64                                  `protobuf:"varint,8,opt,name=outTx_tss_nonce,json=outTxTssNonce,proto3" json:"outTx_tss_nonce,omitempty"`
+	CoinType                       common.CoinType                         `protobuf:"varint,9,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
 }
-func (*UnimplementedMsgServer) WhitelistERC20(ctx context.Context, req *MsgWhitelistERC20) (*MsgWhitelistERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhitelistERC20 not implemented")
+
+func (m *MsgVoteOnObservedOutboundTx) Reset()         { *m = MsgVoteOnObservedOutboundTx{} }
+func (m *MsgVoteOnObservedOutboundTx) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteOnObservedOutboundTx) ProtoMessage()    {}
+func (*MsgVoteOnObservedOutboundTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{28}
 }
-func (*UnimplementedMsgServer) UpdateTssAddress(ctx context.Context, req *MsgUpdateTssAddress) (*MsgUpdateTssAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTssAddress not implemented")
+func (m *MsgVoteOnObservedOutboundTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MigrateTssFunds(ctx context.Context, req *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateTssFunds not implemented")
+func (m *MsgVoteOnObservedOutboundTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteOnObservedOutboundTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateTSSVoter(ctx context.Context, req *MsgCreateTSSVoter) (*MsgCreateTSSVoterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTSSVoter not implemented")
+func (m *MsgVoteOnObservedOutboundTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteOnObservedOutboundTx.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgVoteOnObservedOutboundTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteOnObservedOutboundTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteOnObservedOutboundTx.DiscardUnknown(m)
 }
 
-func _Msg_AddToOutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddToOutTxTracker)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddToOutTxTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/AddToOutTxTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddToOutTxTracker(ctx, req.(*MsgAddToOutTxTracker))
+var xxx_messageInfo_MsgVoteOnObservedOutboundTx proto.InternalMessageInfo
+
+func (m *MsgVoteOnObservedOutboundTx) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddToInTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddToInTxTracker)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddToInTxTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/AddToInTxTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddToInTxTracker(ctx, req.(*MsgAddToInTxTracker))
+func (m *MsgVoteOnObservedOutboundTx) GetCctxHash() string {
+	if m != nil {
+		return m.CctxHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveFromOutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveFromOutTxTracker)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveFromOutTxTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/RemoveFromOutTxTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveFromOutTxTracker(ctx, req.(*MsgRemoveFromOutTxTracker))
+func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxHash() string {
+	if m != nil {
+		return m.ObservedOutTxHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_GasPriceVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGasPriceVoter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GasPriceVoter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/GasPriceVoter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GasPriceVoter(ctx, req.(*MsgGasPriceVoter))
+func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxBlockHeight() uint64 {
+	if m != nil {
+		return m.ObservedOutTxBlockHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_VoteOnObservedOutboundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteOnObservedOutboundTx)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteOnObservedOutboundTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/VoteOnObservedOutboundTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteOnObservedOutboundTx(ctx, req.(*MsgVoteOnObservedOutboundTx))
+func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxGasUsed() uint64 {
+	if m != nil {
+		return m.ObservedOutTxGasUsed
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_VoteOnObservedInboundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteOnObservedInboundTx)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteOnObservedInboundTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/VoteOnObservedInboundTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteOnObservedInboundTx(ctx, req.(*MsgVoteOnObservedInboundTx))
+func (m *MsgVoteOnObservedOutboundTx) GetObservedOutTxEffectiveGasLimit() uint64 {
+	if m != nil {
+		return m.ObservedOutTxEffectiveGasLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_WhitelistERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWhitelistERC20)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WhitelistERC20(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/WhitelistERC20",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WhitelistERC20(ctx, req.(*MsgWhitelistERC20))
+func (m *MsgVoteOnObservedOutboundTx) GetStatus() common.ReceiveStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return common.ReceiveStatus_Created
 }
 
-func _Msg_UpdateTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTssAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTssAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/UpdateTssAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTssAddress(ctx, req.(*MsgUpdateTssAddress))
+func (m *MsgVoteOnObservedOutboundTx) GetOutTxChain() int64 {
+	if m != nil {
+		return m.OutTxChain
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_MigrateTssFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateTssFunds)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateTssFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/MigrateTssFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateTssFunds(ctx, req.(*MsgMigrateTssFunds))
+func (m *MsgVoteOnObservedOutboundTx) GetOutTxTssNonce() uint64 {
+	if m != nil {
+		return m.OutTxTssNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateTSSVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTSSVoter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTSSVoter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Msg/CreateTSSVoter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTSSVoter(ctx, req.(*MsgCreateTSSVoter))
+func (m *MsgVoteOnObservedOutboundTx) GetCoinType() common.CoinType {
+	if m != nil {
+		return m.CoinType
 	}
-	return interceptor(ctx, in, info, handler)
+	return common.CoinType_Zeta
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.crosschain.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddToOutTxTracker",
-			Handler:    _Msg_AddToOutTxTracker_Handler,
-		},
-		{
-			MethodName: "AddToInTxTracker",
-			Handler:    _Msg_AddToInTxTracker_Handler,
-		},
-		{
-			MethodName: "RemoveFromOutTxTracker",
-			Handler:    _Msg_RemoveFromOutTxTracker_Handler,
-		},
-		{
-			MethodName: "GasPriceVoter",
-			Handler:    _Msg_GasPriceVoter_Handler,
-		},
-		{
-			MethodName: "VoteOnObservedOutboundTx",
-			Handler:    _Msg_VoteOnObservedOutboundTx_Handler,
-		},
-		{
-			MethodName: "VoteOnObservedInboundTx",
-			Handler:    _Msg_VoteOnObservedInboundTx_Handler,
-		},
-		{
-			MethodName: "WhitelistERC20",
-			Handler:    _Msg_WhitelistERC20_Handler,
-		},
-		{
-			MethodName: "UpdateTssAddress",
-			Handler:    _Msg_UpdateTssAddress_Handler,
-		},
-		{
-			MethodName: "MigrateTssFunds",
-			Handler:    _Msg_MigrateTssFunds_Handler,
-		},
-		{
-			MethodName: "CreateTSSVoter",
-			Handler:    _Msg_CreateTSSVoter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "crosschain/tx.proto",
+type MsgVoteOnObservedOutboundTxResponse struct {
 }
 
-func (m *MsgCreateTSSVoter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteOnObservedOutboundTxResponse) Reset()         { *m = MsgVoteOnObservedOutboundTxResponse{} }
+func (m *MsgVoteOnObservedOutboundTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteOnObservedOutboundTxResponse) ProtoMessage()    {}
+func (*MsgVoteOnObservedOutboundTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{29}
+}
+func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse.Merge(m, src)
+}
+func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteOnObservedOutboundTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTSSVoter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgVoteOnObservedOutboundTxResponse proto.InternalMessageInfo
+
+type MsgVoteOnObservedInboundTx struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Sender        string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
+	// string zeta_burnt = 6;
+	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	// string mMint = 7;
+	Message       string          `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	InTxHash      string          `protobuf:"bytes,9,opt,name=in_tx_hash,json=inTxHash,proto3" json:"in_tx_hash,omitempty"`
+	InBlockHeight uint64          `protobuf:"varint,10,opt,name=in_block_height,json=inBlockHeight,proto3" json:"in_block_height,omitempty"`
+	GasLimit      uint64          `protobuf:"varint,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	CoinType      common.CoinType `protobuf:"varint,12,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
+	TxOrigin      string          `protobuf:"bytes,13,opt,name=tx_origin,json=txOrigin,proto3" json:"tx_origin,omitempty"`
+	Asset         string          `protobuf:"bytes,14,opt,name=asset,proto3" json:"asset,omitempty"`
+	// event index of the sent asset in the observed tx
+	EventIndex uint64 `protobuf:"varint,15,opt,name=event_index,json=eventIndex,proto3" json:"event_index,omitempty"`
 }
 
-func (m *MsgCreateTSSVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.KeyGenZetaHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeyGenZetaHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TssPubkey) > 0 {
-		i -= len(m.TssPubkey)
-		copy(dAtA[i:], m.TssPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgVoteOnObservedInboundTx) Reset()         { *m = MsgVoteOnObservedInboundTx{} }
+func (m *MsgVoteOnObservedInboundTx) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteOnObservedInboundTx) ProtoMessage()    {}
+func (*MsgVoteOnObservedInboundTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{30}
+}
+func (m *MsgVoteOnObservedInboundTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteOnObservedInboundTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteOnObservedInboundTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgVoteOnObservedInboundTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteOnObservedInboundTx.Merge(m, src)
+}
+func (m *MsgVoteOnObservedInboundTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteOnObservedInboundTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteOnObservedInboundTx.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTSSVoterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgVoteOnObservedInboundTx proto.InternalMessageInfo
+
+func (m *MsgVoteOnObservedInboundTx) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateTSSVoterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteOnObservedInboundTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgCreateTSSVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgVoteOnObservedInboundTx) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
+	}
+	return 0
 }
 
-func (m *MsgMigrateTssFunds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteOnObservedInboundTx) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMigrateTssFunds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteOnObservedInboundTx) GetReceiverChain() int64 {
+	if m != nil {
+		return m.ReceiverChain
+	}
+	return 0
 }
 
-func (m *MsgMigrateTssFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgVoteOnObservedInboundTx) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMigrateTssFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteOnObservedInboundTx) GetInTxHash() string {
+	if m != nil {
+		return m.InTxHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMigrateTssFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMigrateTssFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgVoteOnObservedInboundTx) GetInBlockHeight() uint64 {
+	if m != nil {
+		return m.InBlockHeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateTssAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteOnObservedInboundTx) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateTssAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteOnObservedInboundTx) GetCoinType() common.CoinType {
+	if m != nil {
+		return m.CoinType
+	}
+	return common.CoinType_Zeta
 }
 
-func (m *MsgUpdateTssAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TssPubkey) > 0 {
-		i -= len(m.TssPubkey)
-		copy(dAtA[i:], m.TssPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgVoteOnObservedInboundTx) GetTxOrigin() string {
+	if m != nil {
+		return m.TxOrigin
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateTssAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteOnObservedInboundTx) GetAsset() string {
+	if m != nil {
+		return m.Asset
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteOnObservedInboundTx) GetEventIndex() uint64 {
+	if m != nil {
+		return m.EventIndex
+	}
+	return 0
 }
 
-func (m *MsgUpdateTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgVoteOnObservedInboundTxResponse struct {
 }
 
-func (m *MsgAddToInTxTracker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteOnObservedInboundTxResponse) Reset()         { *m = MsgVoteOnObservedInboundTxResponse{} }
+func (m *MsgVoteOnObservedInboundTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteOnObservedInboundTxResponse) ProtoMessage()    {}
+func (*MsgVoteOnObservedInboundTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{31}
+}
+func (m *MsgVoteOnObservedInboundTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteOnObservedInboundTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteOnObservedInboundTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgVoteOnObservedInboundTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteOnObservedInboundTxResponse.Merge(m, src)
+}
+func (m *MsgVoteOnObservedInboundTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteOnObservedInboundTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteOnObservedInboundTxResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddToInTxTracker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgVoteOnObservedInboundTxResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAbortStuckCCTX)(nil), "zetachain.zetacore.crosschain.MsgAbortStuckCCTX")
+	proto.RegisterType((*MsgAbortStuckCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgAbortStuckCCTXResponse")
+	proto.RegisterType((*MsgRefundAbortedCCTX)(nil), "zetachain.zetacore.crosschain.MsgRefundAbortedCCTX")
+	proto.RegisterType((*MsgRefundAbortedCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgRefundAbortedCCTXResponse")
+	proto.RegisterType((*MsgUpdateWithdrawalLimits)(nil), "zetachain.zetacore.crosschain.MsgUpdateWithdrawalLimits")
+	proto.RegisterType((*MsgUpdateWithdrawalLimitsResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateWithdrawalLimitsResponse")
+	proto.RegisterType((*MsgReleaseQueuedWithdrawal)(nil), "zetachain.zetacore.crosschain.MsgReleaseQueuedWithdrawal")
+	proto.RegisterType((*MsgReleaseQueuedWithdrawalResponse)(nil), "zetachain.zetacore.crosschain.MsgReleaseQueuedWithdrawalResponse")
+	proto.RegisterType((*MsgCancelQueuedWithdrawal)(nil), "zetachain.zetacore.crosschain.MsgCancelQueuedWithdrawal")
+	proto.RegisterType((*MsgCancelQueuedWithdrawalResponse)(nil), "zetachain.zetacore.crosschain.MsgCancelQueuedWithdrawalResponse")
+	proto.RegisterType((*MsgCreateTSSVoter)(nil), "zetachain.zetacore.crosschain.MsgCreateTSSVoter")
+	proto.RegisterType((*MsgCreateTSSVoterResponse)(nil), "zetachain.zetacore.crosschain.MsgCreateTSSVoterResponse")
+	proto.RegisterType((*MsgMigrateTssFunds)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFunds")
+	proto.RegisterType((*MsgMigrateTssFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFundsResponse")
+	proto.RegisterType((*MsgMigrateAllTssFunds)(nil), "zetachain.zetacore.crosschain.MsgMigrateAllTssFunds")
+	proto.RegisterType((*MsgMigrateAllTssFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateAllTssFundsResponse")
+	proto.RegisterType((*MsgUpdateTssAddress)(nil), "zetachain.zetacore.crosschain.MsgUpdateTssAddress")
+	proto.RegisterType((*MsgUpdateTssAddressResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateTssAddressResponse")
+	proto.RegisterType((*MsgAddToInTxTracker)(nil), "zetachain.zetacore.crosschain.MsgAddToInTxTracker")
+	proto.RegisterType((*MsgAddToInTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgAddToInTxTrackerResponse")
+	proto.RegisterType((*MsgWhitelistERC20)(nil), "zetachain.zetacore.crosschain.MsgWhitelistERC20")
+	proto.RegisterType((*MsgWhitelistERC20Response)(nil), "zetachain.zetacore.crosschain.MsgWhitelistERC20Response")
+	proto.RegisterType((*MsgAddToOutTxTracker)(nil), "zetachain.zetacore.crosschain.MsgAddToOutTxTracker")
+	proto.RegisterType((*MsgAddToOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgAddToOutTxTrackerResponse")
+	proto.RegisterType((*MsgRemoveFromOutTxTracker)(nil), "zetachain.zetacore.crosschain.MsgRemoveFromOutTxTracker")
+	proto.RegisterType((*MsgRemoveFromOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgRemoveFromOutTxTrackerResponse")
+	proto.RegisterType((*MsgGasPriceVoter)(nil), "zetachain.zetacore.crosschain.MsgGasPriceVoter")
+	proto.RegisterType((*MsgGasPriceVoterResponse)(nil), "zetachain.zetacore.crosschain.MsgGasPriceVoterResponse")
+	proto.RegisterType((*MsgVoteOnObservedOutboundTx)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedOutboundTx")
+	proto.RegisterType((*MsgVoteOnObservedOutboundTxResponse)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedOutboundTxResponse")
+	proto.RegisterType((*MsgVoteOnObservedInboundTx)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedInboundTx")
+	proto.RegisterType((*MsgVoteOnObservedInboundTxResponse)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedInboundTxResponse")
 }
 
-func (m *MsgAddToInTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.CoinType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CoinType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("crosschain/tx.proto", fileDescriptor_81d6d611190b7635) }
+
+var fileDescriptor_81d6d611190b7635 = []byte{
+	// 1715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x6e, 0xdb, 0xc8,
+	0x15, 0x36, 0x6b, 0x59, 0x96, 0x8e, 0x2d, 0xff, 0xd0, 0x4e, 0x22, 0xd3, 0xb6, 0x9c, 0xd0, 0x4d,
+	0x1a, 0x14, 0xb0, 0x94, 0x38, 0x2d, 0xea, 0xfc, 0x14, 0x88, 0x2d, 0x24, 0x8e, 0xdb, 0x38, 0x4e,
+	0x69, 0xb9, 0x29, 0x72, 0x23, 0x50, 0xe4, 0x58, 0x22, 0x2c, 0x71, 0x04, 0xce, 0xd0, 0x91, 0x8c,
+	0x02, 0x05, 0x02, 0x14, 0xe8, 0x65, 0x51, 0x14, 0x58, 0xec, 0xbe, 0xc0, 0xbe, 0xc1, 0xde, 0xec,
+	0x0b, 0xe4, 0x32, 0xd8, 0xab, 0xcd, 0x5e, 0x04, 0x8b, 0xe4, 0x09, 0x76, 0x9f, 0x60, 0xc1, 0x19,
+	0x72, 0x24, 0x52, 0xff, 0xca, 0xe6, 0x4a, 0x9c, 0xc3, 0xf9, 0xce, 0xdf, 0x7c, 0x67, 0xce, 0x70,
+	0x04, 0x4b, 0x86, 0x83, 0x09, 0x31, 0x2a, 0xba, 0x65, 0xe7, 0x68, 0x23, 0x5b, 0x77, 0x30, 0xc5,
+	0xf2, 0xfa, 0x05, 0xa2, 0x3a, 0x93, 0x65, 0xd9, 0x13, 0x76, 0x50, 0xb6, 0x35, 0x4f, 0x59, 0x32,
+	0x70, 0xad, 0x86, 0xed, 0x1c, 0xff, 0xe1, 0x18, 0xe5, 0x5a, 0x9b, 0xa2, 0x57, 0x16, 0xad, 0x98,
+	0x8e, 0xfe, 0x4a, 0xaf, 0x16, 0xab, 0x56, 0xcd, 0xa2, 0xfe, 0x94, 0xe5, 0x32, 0x2e, 0x63, 0xf6,
+	0x98, 0xf3, 0x9e, 0xb8, 0x54, 0x7d, 0x0a, 0x8b, 0x87, 0xa4, 0xbc, 0x5b, 0xc2, 0x0e, 0x3d, 0xa6,
+	0xae, 0x71, 0x96, 0xcf, 0x17, 0xfe, 0x21, 0xa7, 0x61, 0xda, 0x70, 0x90, 0x4e, 0xb1, 0x93, 0x96,
+	0xae, 0x4a, 0x37, 0x93, 0x5a, 0x30, 0x94, 0xd7, 0x01, 0x0c, 0x83, 0x36, 0x8a, 0x96, 0x6d, 0xa2,
+	0x46, 0xfa, 0x37, 0xec, 0x65, 0xd2, 0x93, 0x1c, 0x78, 0x02, 0x75, 0x15, 0x56, 0x3a, 0xb4, 0x69,
+	0x88, 0xd4, 0xb1, 0x4d, 0x90, 0x7a, 0x0e, 0xcb, 0x87, 0xa4, 0xac, 0xa1, 0x53, 0xd7, 0x36, 0xd9,
+	0x14, 0x64, 0x7e, 0x92, 0x35, 0xf9, 0x3a, 0xcc, 0x39, 0x4c, 0x5b, 0x51, 0x37, 0x4d, 0x07, 0x11,
+	0x92, 0x9e, 0x64, 0x53, 0x52, 0x5c, 0xba, 0xcb, 0x85, 0x6a, 0x06, 0xd6, 0xba, 0xd9, 0x15, 0x7e,
+	0x7d, 0x29, 0x31, 0xaf, 0x4f, 0xea, 0xa6, 0x4e, 0xd1, 0x0b, 0x91, 0xbc, 0xa7, 0x5e, 0xee, 0x48,
+	0x1f, 0xef, 0x4a, 0xb0, 0x18, 0x4d, 0x35, 0x61, 0x4e, 0xce, 0x6c, 0xe7, 0xb2, 0x7d, 0xd7, 0x30,
+	0x1b, 0xb5, 0xb2, 0x17, 0x7b, 0xf3, 0x7e, 0x63, 0x42, 0x5b, 0x78, 0x15, 0x91, 0xab, 0x9b, 0x70,
+	0xad, 0xa7, 0x6b, 0x22, 0x80, 0x13, 0x50, 0x58, 0x80, 0x55, 0xa4, 0x13, 0xf4, 0x37, 0x17, 0xb9,
+	0xc8, 0x6c, 0xcd, 0x1d, 0x7f, 0x31, 0x7f, 0x0b, 0x6a, 0x6f, 0xb5, 0xc2, 0x78, 0x81, 0x25, 0x2f,
+	0xaf, 0xdb, 0x06, 0xaa, 0xfe, 0x7a, 0xb6, 0x79, 0xdc, 0xdd, 0xb5, 0x0a, 0xd3, 0x5f, 0x4b, 0x8c,
+	0xbc, 0x79, 0x4f, 0x25, 0x2a, 0x1c, 0x1f, 0xff, 0x1d, 0x53, 0xe4, 0xf4, 0xb7, 0x49, 0x09, 0x29,
+	0xd6, 0xdd, 0xd2, 0x19, 0x6a, 0x06, 0x36, 0x29, 0x21, 0xcf, 0x99, 0x40, 0xfe, 0x3d, 0x2c, 0x9c,
+	0xa1, 0xe6, 0x3e, 0xb2, 0x5f, 0x22, 0xaa, 0x3f, 0x41, 0x56, 0xb9, 0x42, 0x19, 0xa1, 0x26, 0xb5,
+	0x0e, 0xb9, 0xbc, 0x05, 0x71, 0x42, 0x75, 0xea, 0x92, 0x74, 0xec, 0xaa, 0x74, 0x73, 0x6e, 0xfb,
+	0x52, 0xd6, 0x2f, 0x47, 0x0d, 0x19, 0xc8, 0x3a, 0x47, 0xc7, 0xec, 0xa5, 0xe6, 0x4f, 0xf2, 0xeb,
+	0x22, 0xec, 0xa8, 0x08, 0xe3, 0x7f, 0x12, 0xc8, 0x87, 0xa4, 0x7c, 0x68, 0x95, 0x1d, 0xef, 0x35,
+	0x21, 0x8f, 0x5d, 0xdb, 0xec, 0x47, 0xbc, 0x15, 0x48, 0x30, 0x1a, 0x15, 0x2d, 0x93, 0x45, 0x31,
+	0xa9, 0x4d, 0xb3, 0xf1, 0x81, 0x29, 0xef, 0x43, 0x5c, 0xaf, 0x61, 0xd7, 0xe6, 0x9e, 0x27, 0xf7,
+	0x72, 0x1e, 0xaf, 0x7e, 0x78, 0xbf, 0xf1, 0xbb, 0xb2, 0x45, 0x2b, 0x6e, 0xc9, 0xf3, 0x32, 0x67,
+	0x60, 0x52, 0xc3, 0xc4, 0xff, 0xd9, 0x22, 0xe6, 0x59, 0x8e, 0x36, 0xeb, 0x88, 0x64, 0x4f, 0x2c,
+	0x9b, 0x6a, 0x3e, 0x5c, 0x5d, 0x03, 0xa5, 0xd3, 0x27, 0xe1, 0xf2, 0x6d, 0xb8, 0xd4, 0x7a, 0xbb,
+	0x5b, 0xad, 0x0e, 0x76, 0x5a, 0xdd, 0x83, 0xf5, 0xae, 0x90, 0x40, 0xa7, 0x7c, 0x0d, 0x66, 0x5b,
+	0x8c, 0x40, 0x24, 0x2d, 0x5d, 0x9d, 0xbc, 0x99, 0xd4, 0x66, 0x04, 0x27, 0x10, 0x51, 0x9f, 0xc1,
+	0x92, 0xa8, 0x86, 0x02, 0x21, 0x7e, 0x81, 0x8f, 0xbd, 0xe2, 0xea, 0x3a, 0xac, 0x76, 0xd1, 0x27,
+	0xa2, 0xfc, 0x49, 0x62, 0xf6, 0x76, 0x4d, 0xb3, 0x80, 0x0f, 0xec, 0x42, 0xa3, 0xe0, 0xe8, 0xc6,
+	0x19, 0x72, 0xc6, 0x5b, 0x99, 0x2b, 0x30, 0x4d, 0x1b, 0xc5, 0x8a, 0x4e, 0x2a, 0xfe, 0x2e, 0x15,
+	0xa7, 0x8d, 0x27, 0x3a, 0xa9, 0xc8, 0x5b, 0x90, 0x34, 0xb0, 0x65, 0x17, 0xbd, 0x45, 0xf0, 0xd9,
+	0xb4, 0x10, 0xb0, 0x29, 0x8f, 0x2d, 0xbb, 0xd0, 0xac, 0x23, 0x2d, 0x61, 0xf8, 0x4f, 0xf2, 0x26,
+	0x4c, 0xd5, 0x1d, 0x8c, 0x4f, 0xd3, 0x53, 0x6c, 0xa7, 0x49, 0x05, 0x53, 0x9f, 0x7b, 0x42, 0x8d,
+	0xbf, 0xf3, 0xe2, 0x2e, 0x55, 0xb1, 0x71, 0xc6, 0xed, 0xc5, 0x79, 0xdc, 0x4c, 0xc2, 0x4c, 0xae,
+	0x40, 0x42, 0x94, 0xde, 0x34, 0x77, 0x33, 0x28, 0x3c, 0x9e, 0x92, 0x68, 0xc8, 0x22, 0x25, 0xdf,
+	0xf1, 0x92, 0x7b, 0x51, 0xb1, 0x28, 0xaa, 0x5a, 0x84, 0x3e, 0xd2, 0xf2, 0xdb, 0xb7, 0xfa, 0x24,
+	0x64, 0x13, 0x52, 0xc8, 0x31, 0xb6, 0x6f, 0x89, 0x1d, 0x9a, 0xaf, 0xc1, 0x2c, 0x13, 0x06, 0xeb,
+	0xd7, 0x9e, 0xb5, 0xc9, 0x70, 0xd6, 0x64, 0x88, 0xd9, 0x7a, 0x8d, 0xe7, 0x25, 0xa9, 0xb1, 0x67,
+	0xf9, 0x32, 0xc4, 0x49, 0xb3, 0x56, 0xc2, 0x55, 0x96, 0x82, 0xa4, 0xe6, 0x8f, 0x64, 0x05, 0x12,
+	0x26, 0x32, 0xac, 0x9a, 0x5e, 0x25, 0x2c, 0xe4, 0x94, 0x26, 0xc6, 0xf2, 0x2a, 0x24, 0xcb, 0x3a,
+	0xe1, 0x9b, 0xb4, 0x1f, 0x72, 0xa2, 0xac, 0x13, 0xb6, 0x91, 0xaa, 0x45, 0x58, 0xe9, 0x88, 0x49,
+	0xd0, 0x72, 0x13, 0x52, 0x17, 0xa1, 0x08, 0x78, 0x84, 0xb3, 0x17, 0xed, 0x11, 0x0c, 0xd8, 0xcd,
+	0xde, 0x49, 0xb0, 0x1c, 0x64, 0xf5, 0xc8, 0xa5, 0x9f, 0xc8, 0xa4, 0x65, 0x98, 0xb2, 0xb1, 0x6d,
+	0x20, 0x96, 0xab, 0x98, 0xc6, 0x07, 0xed, 0xfc, 0x8a, 0x85, 0xf8, 0xf5, 0x99, 0x09, 0xf3, 0x67,
+	0x58, 0xeb, 0x16, 0x9a, 0xc8, 0xdf, 0x3a, 0x80, 0x45, 0x8a, 0x0e, 0xaa, 0xe1, 0x73, 0x64, 0xb2,
+	0x28, 0x13, 0x5a, 0xd2, 0x22, 0x1a, 0x17, 0xa8, 0xa7, 0x2c, 0xf7, 0x7c, 0xf4, 0xd8, 0xc1, 0xb5,
+	0xcf, 0x94, 0x1e, 0xbf, 0xa1, 0x74, 0xb7, 0x23, 0xd8, 0xfd, 0xad, 0x04, 0x0b, 0x87, 0xa4, 0xbc,
+	0xaf, 0x93, 0xe7, 0x8e, 0x65, 0xa0, 0x41, 0xfd, 0xa4, 0xbf, 0x13, 0x75, 0xc7, 0x6a, 0x39, 0xc1,
+	0x06, 0xde, 0x16, 0xc7, 0xb3, 0x6c, 0xbb, 0xb5, 0x12, 0x72, 0xd8, 0x42, 0xc5, 0xb4, 0x19, 0x26,
+	0x7b, 0xc6, 0x44, 0x8c, 0xdc, 0x6e, 0xbd, 0x5e, 0x6d, 0x0a, 0x72, 0xb3, 0x91, 0x07, 0xad, 0x3b,
+	0x16, 0x76, 0x2c, 0xda, 0x2c, 0x9e, 0x22, 0xc4, 0x96, 0x28, 0xa6, 0xcd, 0x04, 0xb2, 0xc7, 0x08,
+	0xa9, 0x0a, 0xa4, 0xa3, 0xce, 0x8b, 0xc8, 0xde, 0x4d, 0xb1, 0xba, 0xf6, 0x84, 0x47, 0xf6, 0x51,
+	0x89, 0x20, 0xe7, 0x1c, 0x99, 0x47, 0x2e, 0x2d, 0x61, 0xd7, 0x36, 0x0b, 0x8d, 0x3e, 0x41, 0xae,
+	0x02, 0x23, 0x32, 0x27, 0x06, 0x67, 0x76, 0xc2, 0x13, 0x30, 0x5e, 0x64, 0x61, 0x09, 0xfb, 0xca,
+	0x8a, 0xd8, 0xcb, 0x68, 0xfb, 0x06, 0xb7, 0x88, 0x5b, 0x76, 0x0a, 0x7c, 0xfe, 0x03, 0x50, 0x22,
+	0xf3, 0x39, 0xc7, 0x78, 0xb3, 0xe5, 0xe9, 0x48, 0x87, 0x60, 0x7b, 0xad, 0xf7, 0xf2, 0x1f, 0xe1,
+	0x4a, 0x04, 0xed, 0xd5, 0xb4, 0x4b, 0x90, 0x99, 0x06, 0x06, 0x5d, 0x0e, 0x41, 0xf7, 0x75, 0x72,
+	0x42, 0x90, 0x29, 0x5f, 0x80, 0x1a, 0x81, 0xa1, 0xd3, 0x53, 0x64, 0x50, 0xeb, 0x1c, 0x31, 0x05,
+	0x7c, 0xa1, 0x66, 0x58, 0xbf, 0xcc, 0xfa, 0xfd, 0xf2, 0xc6, 0x10, 0xfd, 0xf2, 0xc0, 0xa6, 0x5a,
+	0x26, 0x64, 0xf1, 0x51, 0xa0, 0x37, 0x58, 0x04, 0xf9, 0x2f, 0x03, 0x6c, 0xf3, 0x0d, 0x69, 0x96,
+	0x79, 0xdf, 0x5b, 0x17, 0xdb, 0xa6, 0x64, 0x0c, 0x73, 0xe7, 0x7a, 0xd5, 0x45, 0x45, 0x87, 0x9f,
+	0x31, 0x4c, 0x4e, 0x91, 0xbd, 0x27, 0x23, 0xf6, 0xf8, 0x9f, 0xdf, 0x6f, 0x5c, 0x6a, 0xea, 0xb5,
+	0xea, 0x3d, 0x35, 0xac, 0x4e, 0xd5, 0x52, 0x4c, 0xe0, 0x1f, 0x61, 0xcc, 0xb6, 0x43, 0x4e, 0x7c,
+	0x88, 0x43, 0x8e, 0xbc, 0x01, 0x33, 0x3c, 0x44, 0x56, 0x04, 0xfe, 0x3e, 0x01, 0x4c, 0x94, 0xf7,
+	0x24, 0xf2, 0x0d, 0x98, 0xe7, 0x13, 0xbc, 0x9e, 0xcc, 0x6b, 0x34, 0xc1, 0x22, 0x4f, 0x31, 0x71,
+	0x81, 0x90, 0x67, 0x9e, 0x30, 0xdc, 0x11, 0x93, 0x83, 0x3a, 0xa2, 0x7a, 0x1d, 0x36, 0xfb, 0x50,
+	0x5b, 0x94, 0xc0, 0xeb, 0x18, 0x28, 0x1d, 0xf3, 0x0e, 0xec, 0xc1, 0x15, 0xe0, 0x95, 0x24, 0xb2,
+	0x4d, 0xe4, 0xf8, 0xf4, 0xf7, 0x47, 0x5e, 0x38, 0xfc, 0xa9, 0x18, 0xe9, 0x5e, 0x29, 0x2e, 0xce,
+	0xfb, 0x7b, 0x81, 0x02, 0x09, 0x3f, 0xc5, 0x8e, 0xbf, 0x35, 0x8b, 0x31, 0xff, 0x84, 0xe1, 0xcf,
+	0x7e, 0xda, 0xa6, 0xb8, 0x8a, 0x40, 0xca, 0x33, 0xd7, 0x3a, 0xd6, 0xc5, 0x3f, 0xe9, 0x58, 0xe7,
+	0x45, 0x59, 0x43, 0x84, 0xe8, 0x65, 0x9e, 0xfa, 0xa4, 0x16, 0x0c, 0xe5, 0x35, 0x00, 0x2f, 0xe5,
+	0x7e, 0x05, 0x27, 0xb9, 0x9f, 0x96, 0xed, 0x17, 0xee, 0x0d, 0x98, 0xb7, 0xec, 0xa2, 0xdf, 0x22,
+	0x78, 0xb5, 0xf2, 0x92, 0x4b, 0x59, 0x76, 0x7b, 0x89, 0x86, 0xfa, 0xec, 0x0c, 0x9b, 0x21, 0xfa,
+	0x6c, 0x78, 0x5d, 0x67, 0x07, 0x9e, 0x74, 0x56, 0x21, 0x49, 0x1b, 0x45, 0xec, 0x58, 0x65, 0xcb,
+	0x4e, 0xa7, 0xb8, 0x43, 0xb4, 0x71, 0xc4, 0xc6, 0xde, 0x06, 0xab, 0x13, 0x82, 0x68, 0x7a, 0x8e,
+	0xbd, 0xe0, 0x03, 0x8f, 0x82, 0xe8, 0x1c, 0xd9, 0xd4, 0x6f, 0x55, 0xf3, 0xcc, 0x01, 0x60, 0xa2,
+	0xf6, 0x6f, 0x9a, 0x1e, 0x1c, 0x08, 0xa8, 0xb2, 0xfd, 0xcd, 0x22, 0x4c, 0x1e, 0x92, 0xb2, 0xfc,
+	0x6f, 0x09, 0x16, 0x3b, 0x9b, 0xf6, 0x9d, 0x01, 0x1f, 0x77, 0xdd, 0xda, 0xa1, 0x72, 0x7f, 0x0c,
+	0x90, 0xe8, 0xa1, 0xaf, 0x25, 0x58, 0xe8, 0x38, 0x85, 0x6e, 0x0f, 0xa9, 0xb1, 0x0d, 0xa3, 0xdc,
+	0x1b, 0x1d, 0x23, 0x9c, 0xf8, 0xbf, 0x04, 0x97, 0x7b, 0xf4, 0xe9, 0x9d, 0xc1, 0x6a, 0xbb, 0x23,
+	0x95, 0x87, 0xe3, 0x22, 0x85, 0x5b, 0x4d, 0x48, 0x85, 0xfb, 0x75, 0x6e, 0xb0, 0xca, 0x10, 0x40,
+	0xf9, 0xd3, 0x88, 0x00, 0x61, 0xfa, 0x2b, 0x09, 0xd2, 0x3d, 0x3b, 0xea, 0x10, 0xa9, 0xee, 0x85,
+	0x55, 0xf6, 0xc6, 0xc7, 0x0a, 0xe7, 0xbe, 0x90, 0xe0, 0x4a, 0xaf, 0xbd, 0xee, 0xee, 0xa8, 0xfa,
+	0x05, 0x54, 0xd9, 0x1d, 0x1b, 0x2a, 0x3c, 0xfb, 0x27, 0xcc, 0x45, 0xbe, 0x1f, 0x6e, 0x0d, 0x56,
+	0x1a, 0x46, 0x28, 0x3b, 0xa3, 0x22, 0x42, 0xb5, 0xd4, 0xf1, 0x05, 0x39, 0x44, 0x2d, 0x45, 0x31,
+	0xca, 0xbd, 0xd1, 0x31, 0xc2, 0x89, 0x7f, 0xc1, 0x7c, 0xf4, 0x73, 0xff, 0xf6, 0x60, 0x75, 0x11,
+	0x88, 0x72, 0x77, 0x64, 0x88, 0x70, 0xe0, 0x3f, 0xde, 0x9d, 0x43, 0xe7, 0xe7, 0xfb, 0x1f, 0x86,
+	0xd6, 0xd8, 0x86, 0x52, 0x1e, 0x8c, 0x83, 0x6a, 0xa7, 0x43, 0xe4, 0x06, 0x67, 0x08, 0x3a, 0x84,
+	0x11, 0xca, 0xce, 0xa8, 0x88, 0xd0, 0xae, 0xd6, 0xe3, 0xe6, 0x6f, 0x67, 0xd8, 0x05, 0x8e, 0x22,
+	0x95, 0x87, 0xe3, 0x22, 0x43, 0xd5, 0xdb, 0xeb, 0x42, 0xef, 0xee, 0x30, 0x7b, 0x66, 0x57, 0xa8,
+	0xb2, 0x3b, 0x36, 0x34, 0x94, 0xb0, 0x1e, 0xb7, 0x7d, 0xc3, 0xac, 0x42, 0x57, 0xa4, 0xf2, 0x70,
+	0x5c, 0x64, 0x3b, 0x8b, 0x22, 0x97, 0xd8, 0x43, 0xb0, 0x28, 0x8c, 0x50, 0x76, 0x46, 0x45, 0x08,
+	0xeb, 0xde, 0x41, 0xa1, 0xf3, 0x62, 0xfb, 0xce, 0x30, 0xd9, 0x8e, 0x80, 0x94, 0xfb, 0x63, 0x80,
+	0x02, 0x3f, 0xf6, 0xfe, 0xfa, 0xe6, 0x43, 0x46, 0x7a, 0xfb, 0x21, 0x23, 0xfd, 0xf8, 0x21, 0x23,
+	0xfd, 0xf7, 0x63, 0x66, 0xe2, 0xed, 0xc7, 0xcc, 0xc4, 0xf7, 0x1f, 0x33, 0x13, 0x2f, 0x6f, 0xb7,
+	0x9d, 0x14, 0x3d, 0xb5, 0x5b, 0xfc, 0xbf, 0x82, 0xc0, 0x42, 0xae, 0x91, 0x6b, 0xff, 0x2b, 0xc2,
+	0x3b, 0x38, 0x96, 0xe2, 0xec, 0x1f, 0x82, 0x3b, 0xbf, 0x0c, 0x00, 0x2f, 0xab, 0x7a, 0xb0, 0xa5,
+	0x18, 0x00, 0x00,
 }
 
-func (m *MsgAddToInTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddToOutTxTracker(ctx context.Context, in *MsgAddToOutTxTracker, opts ...grpc.CallOption) (*MsgAddToOutTxTrackerResponse, error)
+	AddToInTxTracker(ctx context.Context, in *MsgAddToInTxTracker, opts ...grpc.CallOption) (*MsgAddToInTxTrackerResponse, error)
+	RemoveFromOutTxTracker(ctx context.Context, in *MsgRemoveFromOutTxTracker, opts ...grpc.CallOption) (*MsgRemoveFromOutTxTrackerResponse, error)
+	GasPriceVoter(ctx context.Context, in *MsgGasPriceVoter, opts ...grpc.CallOption) (*MsgGasPriceVoterResponse, error)
+	VoteOnObservedOutboundTx(ctx context.Context, in *MsgVoteOnObservedOutboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedOutboundTxResponse, error)
+	VoteOnObservedInboundTx(ctx context.Context, in *MsgVoteOnObservedInboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedInboundTxResponse, error)
+	WhitelistERC20(ctx context.Context, in *MsgWhitelistERC20, opts ...grpc.CallOption) (*MsgWhitelistERC20Response, error)
+	UpdateTssAddress(ctx context.Context, in *MsgUpdateTssAddress, opts ...grpc.CallOption) (*MsgUpdateTssAddressResponse, error)
+	MigrateTssFunds(ctx context.Context, in *MsgMigrateTssFunds, opts ...grpc.CallOption) (*MsgMigrateTssFundsResponse, error)
+	MigrateAllTssFunds(ctx context.Context, in *MsgMigrateAllTssFunds, opts ...grpc.CallOption) (*MsgMigrateAllTssFundsResponse, error)
+	CreateTSSVoter(ctx context.Context, in *MsgCreateTSSVoter, opts ...grpc.CallOption) (*MsgCreateTSSVoterResponse, error)
+	UpdateWithdrawalLimits(ctx context.Context, in *MsgUpdateWithdrawalLimits, opts ...grpc.CallOption) (*MsgUpdateWithdrawalLimitsResponse, error)
+	ReleaseQueuedWithdrawal(ctx context.Context, in *MsgReleaseQueuedWithdrawal, opts ...grpc.CallOption) (*MsgReleaseQueuedWithdrawalResponse, error)
+	CancelQueuedWithdrawal(ctx context.Context, in *MsgCancelQueuedWithdrawal, opts ...grpc.CallOption) (*MsgCancelQueuedWithdrawalResponse, error)
+	AbortStuckCCTX(ctx context.Context, in *MsgAbortStuckCCTX, opts ...grpc.CallOption) (*MsgAbortStuckCCTXResponse, error)
+	RefundAbortedCCTX(ctx context.Context, in *MsgRefundAbortedCCTX, opts ...grpc.CallOption) (*MsgRefundAbortedCCTXResponse, error)
 }
 
-func (m *MsgAddToInTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgAddToInTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgWhitelistERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddToOutTxTracker(ctx context.Context, in *MsgAddToOutTxTracker, opts ...grpc.CallOption) (*MsgAddToOutTxTrackerResponse, error) {
+	out := new(MsgAddToOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/AddToOutTxTracker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWhitelistERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgWhitelistERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddToInTxTracker(ctx context.Context, in *MsgAddToInTxTracker, opts ...grpc.CallOption) (*MsgAddToInTxTrackerResponse, error) {
+	out := new(MsgAddToInTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/AddToInTxTracker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWhitelistERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveFromOutTxTracker(ctx context.Context, in *MsgRemoveFromOutTxTracker, opts ...grpc.CallOption) (*MsgRemoveFromOutTxTrackerResponse, error) {
+	out := new(MsgRemoveFromOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/RemoveFromOutTxTracker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWhitelistERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GasPriceVoter(ctx context.Context, in *MsgGasPriceVoter, opts ...grpc.CallOption) (*MsgGasPriceVoterResponse, error) {
+	out := new(MsgGasPriceVoterResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/GasPriceVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWhitelistERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CctxIndex) > 0 {
-		i -= len(m.CctxIndex)
-		copy(dAtA[i:], m.CctxIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Zrc20Address) > 0 {
-		i -= len(m.Zrc20Address)
-		copy(dAtA[i:], m.Zrc20Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Zrc20Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) VoteOnObservedOutboundTx(ctx context.Context, in *MsgVoteOnObservedOutboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedOutboundTxResponse, error) {
+	out := new(MsgVoteOnObservedOutboundTxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/VoteOnObservedOutboundTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddToOutTxTracker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VoteOnObservedInboundTx(ctx context.Context, in *MsgVoteOnObservedInboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedInboundTxResponse, error) {
+	out := new(MsgVoteOnObservedInboundTxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/VoteOnObservedInboundTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddToOutTxTracker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WhitelistERC20(ctx context.Context, in *MsgWhitelistERC20, opts ...grpc.CallOption) (*MsgWhitelistERC20Response, error) {
+	out := new(MsgWhitelistERC20Response)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/WhitelistERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddToOutTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateTssAddress(ctx context.Context, in *MsgUpdateTssAddress, opts ...grpc.CallOption) (*MsgUpdateTssAddressResponse, error) {
+	out := new(MsgUpdateTssAddressResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/UpdateTssAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddToOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MigrateTssFunds(ctx context.Context, in *MsgMigrateTssFunds, opts ...grpc.CallOption) (*MsgMigrateTssFundsResponse, error) {
+	out := new(MsgMigrateTssFundsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/MigrateTssFunds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddToOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MigrateAllTssFunds(ctx context.Context, in *MsgMigrateAllTssFunds, opts ...grpc.CallOption) (*MsgMigrateAllTssFundsResponse, error) {
+	out := new(MsgMigrateAllTssFundsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/MigrateAllTssFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddToOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsRemoved {
-		i--
-		if m.IsRemoved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) CreateTSSVoter(ctx context.Context, in *MsgCreateTSSVoter, opts ...grpc.CallOption) (*MsgCreateTSSVoterResponse, error) {
+	out := new(MsgCreateTSSVoterResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/CreateTSSVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveFromOutTxTracker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateWithdrawalLimits(ctx context.Context, in *MsgUpdateWithdrawalLimits, opts ...grpc.CallOption) (*MsgUpdateWithdrawalLimitsResponse, error) {
+	out := new(MsgUpdateWithdrawalLimitsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/UpdateWithdrawalLimits", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveFromOutTxTracker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReleaseQueuedWithdrawal(ctx context.Context, in *MsgReleaseQueuedWithdrawal, opts ...grpc.CallOption) (*MsgReleaseQueuedWithdrawalResponse, error) {
+	out := new(MsgReleaseQueuedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/ReleaseQueuedWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveFromOutTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelQueuedWithdrawal(ctx context.Context, in *MsgCancelQueuedWithdrawal, opts ...grpc.CallOption) (*MsgCancelQueuedWithdrawalResponse, error) {
+	out := new(MsgCancelQueuedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/CancelQueuedWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveFromOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AbortStuckCCTX(ctx context.Context, in *MsgAbortStuckCCTX, opts ...grpc.CallOption) (*MsgAbortStuckCCTXResponse, error) {
+	out := new(MsgAbortStuckCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/AbortStuckCCTX", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveFromOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveFromOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgGasPriceVoter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RefundAbortedCCTX(ctx context.Context, in *MsgRefundAbortedCCTX, opts ...grpc.CallOption) (*MsgRefundAbortedCCTXResponse, error) {
+	out := new(MsgRefundAbortedCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/RefundAbortedCCTX", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgGasPriceVoter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddToOutTxTracker(context.Context, *MsgAddToOutTxTracker) (*MsgAddToOutTxTrackerResponse, error)
+	AddToInTxTracker(context.Context, *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error)
+	RemoveFromOutTxTracker(context.Context, *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error)
+	GasPriceVoter(context.Context, *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error)
+	VoteOnObservedOutboundTx(context.Context, *MsgVoteOnObservedOutboundTx) (*MsgVoteOnObservedOutboundTxResponse, error)
+	VoteOnObservedInboundTx(context.Context, *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error)
+	WhitelistERC20(context.Context, *MsgWhitelistERC20) (*MsgWhitelistERC20Response, error)
+	UpdateTssAddress(context.Context, *MsgUpdateTssAddress) (*MsgUpdateTssAddressResponse, error)
+	MigrateTssFunds(context.Context, *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error)
+	MigrateAllTssFunds(context.Context, *MsgMigrateAllTssFunds) (*MsgMigrateAllTssFundsResponse, error)
+	CreateTSSVoter(context.Context, *MsgCreateTSSVoter) (*MsgCreateTSSVoterResponse, error)
+	UpdateWithdrawalLimits(context.Context, *MsgUpdateWithdrawalLimits) (*MsgUpdateWithdrawalLimitsResponse, error)
+	ReleaseQueuedWithdrawal(context.Context, *MsgReleaseQueuedWithdrawal) (*MsgReleaseQueuedWithdrawalResponse, error)
+	CancelQueuedWithdrawal(context.Context, *MsgCancelQueuedWithdrawal) (*MsgCancelQueuedWithdrawalResponse, error)
+	AbortStuckCCTX(context.Context, *MsgAbortStuckCCTX) (*MsgAbortStuckCCTXResponse, error)
+	RefundAbortedCCTX(context.Context, *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error)
 }
 
-func (m *MsgGasPriceVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Supply) > 0 {
-		i -= len(m.Supply)
-		copy(dAtA[i:], m.Supply)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Supply)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgGasPriceVoterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddToOutTxTracker(ctx context.Context, req *MsgAddToOutTxTracker) (*MsgAddToOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToOutTxTracker not implemented")
 }
-
-func (m *MsgGasPriceVoterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) AddToInTxTracker(ctx context.Context, req *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToInTxTracker not implemented")
 }
-
-func (m *MsgGasPriceVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) RemoveFromOutTxTracker(ctx context.Context, req *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromOutTxTracker not implemented")
 }
-
-func (m *MsgVoteOnObservedOutboundTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) GasPriceVoter(ctx context.Context, req *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceVoter not implemented")
+}
+func (*UnimplementedMsgServer) VoteOnObservedOutboundTx(ctx context.Context, req *MsgVoteOnObservedOutboundTx) (*MsgVoteOnObservedOutboundTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteOnObservedOutboundTx not implemented")
+}
+func (*UnimplementedMsgServer) VoteOnObservedInboundTx(ctx context.Context, req *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteOnObservedInboundTx not implemented")
+}
+func (*UnimplementedMsgServer) WhitelistERC20(ctx context.Context, req *MsgWhitelistERC20) (*MsgWhitelistERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistERC20 not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTssAddress(ctx context.Context, req *MsgUpdateTssAddress) (*MsgUpdateTssAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTssAddress not implemented")
+}
+func (*UnimplementedMsgServer) MigrateTssFunds(ctx context.Context, req *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTssFunds not implemented")
+}
+func (*UnimplementedMsgServer) MigrateAllTssFunds(ctx context.Context, req *MsgMigrateAllTssFunds) (*MsgMigrateAllTssFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAllTssFunds not implemented")
+}
+func (*UnimplementedMsgServer) CreateTSSVoter(ctx context.Context, req *MsgCreateTSSVoter) (*MsgCreateTSSVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTSSVoter not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWithdrawalLimits(ctx context.Context, req *MsgUpdateWithdrawalLimits) (*MsgUpdateWithdrawalLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWithdrawalLimits not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseQueuedWithdrawal(ctx context.Context, req *MsgReleaseQueuedWithdrawal) (*MsgReleaseQueuedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQueuedWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) CancelQueuedWithdrawal(ctx context.Context, req *MsgCancelQueuedWithdrawal) (*MsgCancelQueuedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) AbortStuckCCTX(ctx context.Context, req *MsgAbortStuckCCTX) (*MsgAbortStuckCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortStuckCCTX not implemented")
+}
+func (*UnimplementedMsgServer) RefundAbortedCCTX(ctx context.Context, req *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundAbortedCCTX not implemented")
 }
 
-func (m *MsgVoteOnObservedOutboundTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgVoteOnObservedOutboundTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ObservedOutTxEffectiveGasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ObservedOutTxEffectiveGasLimit))
-		i--
-		dAtA[i] = 0x60
+func _Msg_AddToOutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToOutTxTracker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.ObservedOutTxEffectiveGasPrice.Size()
-		i -= size
-		if _, err := m.ObservedOutTxEffectiveGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).AddToOutTxTracker(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x5a
-	if m.ObservedOutTxGasUsed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ObservedOutTxGasUsed))
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/AddToOutTxTracker",
 	}
-	if m.CoinType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CoinType))
-		i--
-		dAtA[i] = 0x48
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToOutTxTracker(ctx, req.(*MsgAddToOutTxTracker))
 	}
-	if m.OutTxTssNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OutTxTssNonce))
-		i--
-		dAtA[i] = 0x40
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToInTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToInTxTracker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OutTxChain != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OutTxChain))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(MsgServer).AddToInTxTracker(ctx, in)
 	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/AddToInTxTracker",
 	}
-	{
-		size := m.ValueReceived.Size()
-		i -= size
-		if _, err := m.ValueReceived.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToInTxTracker(ctx, req.(*MsgAddToInTxTracker))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.ObservedOutTxBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ObservedOutTxBlockHeight))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromOutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromOutTxTracker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ObservedOutTxHash) > 0 {
-		i -= len(m.ObservedOutTxHash)
-		copy(dAtA[i:], m.ObservedOutTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObservedOutTxHash)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromOutTxTracker(ctx, in)
 	}
-	if len(m.CctxHash) > 0 {
-		i -= len(m.CctxHash)
-		copy(dAtA[i:], m.CctxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxHash)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/RemoveFromOutTxTracker",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromOutTxTracker(ctx, req.(*MsgRemoveFromOutTxTracker))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedOutboundTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_GasPriceVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGasPriceVoter)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgVoteOnObservedOutboundTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).GasPriceVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/GasPriceVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GasPriceVoter(ctx, req.(*MsgGasPriceVoter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedOutboundTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_VoteOnObservedOutboundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteOnObservedOutboundTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteOnObservedOutboundTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/VoteOnObservedOutboundTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteOnObservedOutboundTx(ctx, req.(*MsgVoteOnObservedOutboundTx))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedInboundTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_VoteOnObservedInboundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteOnObservedInboundTx)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).VoteOnObservedInboundTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/VoteOnObservedInboundTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteOnObservedInboundTx(ctx, req.(*MsgVoteOnObservedInboundTx))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedInboundTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WhitelistERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWhitelistERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WhitelistERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/WhitelistERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WhitelistERC20(ctx, req.(*MsgWhitelistERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedInboundTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EventIndex))
-		i--
-		dAtA[i] = 0x78
+func _Msg_UpdateTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTssAddress)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Asset) > 0 {
-		i -= len(m.Asset)
-		copy(dAtA[i:], m.Asset)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Asset)))
-		i--
-		dAtA[i] = 0x72
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTssAddress(ctx, in)
 	}
-	if len(m.TxOrigin) > 0 {
-		i -= len(m.TxOrigin)
-		copy(dAtA[i:], m.TxOrigin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxOrigin)))
-		i--
-		dAtA[i] = 0x6a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/UpdateTssAddress",
 	}
-	if m.CoinType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CoinType))
-		i--
-		dAtA[i] = 0x60
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTssAddress(ctx, req.(*MsgUpdateTssAddress))
 	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x58
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateTssFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateTssFunds)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.InBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.InBlockHeight))
-		i--
-		dAtA[i] = 0x50
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateTssFunds(ctx, in)
 	}
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0x4a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/MigrateTssFunds",
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x42
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateTssFunds(ctx, req.(*MsgMigrateTssFunds))
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateAllTssFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAllTssFunds)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.ReceiverChain != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ReceiverChain))
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAllTssFunds(ctx, in)
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/MigrateAllTssFunds",
 	}
-	if m.SenderChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SenderChainId))
-		i--
-		dAtA[i] = 0x18
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAllTssFunds(ctx, req.(*MsgMigrateAllTssFunds))
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTSSVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTSSVoter)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTSSVoter(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/CreateTSSVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTSSVoter(ctx, req.(*MsgCreateTSSVoter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedInboundTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateWithdrawalLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWithdrawalLimits)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWithdrawalLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/UpdateWithdrawalLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWithdrawalLimits(ctx, req.(*MsgUpdateWithdrawalLimits))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedInboundTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ReleaseQueuedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseQueuedWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseQueuedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/ReleaseQueuedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseQueuedWithdrawal(ctx, req.(*MsgReleaseQueuedWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteOnObservedInboundTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_CancelQueuedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/CancelQueuedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedWithdrawal(ctx, req.(*MsgCancelQueuedWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateTSSVoter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_AbortStuckCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAbortStuckCCTX)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.TssPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AbortStuckCCTX(ctx, in)
 	}
-	if m.KeyGenZetaHeight != 0 {
-		n += 1 + sovTx(uint64(m.KeyGenZetaHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/AbortStuckCCTX",
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AbortStuckCCTX(ctx, req.(*MsgAbortStuckCCTX))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTSSVoterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RefundAbortedCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundAbortedCCTX)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgMigrateTssFunds) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).RefundAbortedCCTX(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/RefundAbortedCCTX",
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundAbortedCCTX(ctx, req.(*MsgRefundAbortedCCTX))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateTssFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.crosschain.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddToOutTxTracker",
+			Handler:    _Msg_AddToOutTxTracker_Handler,
+		},
+		{
+			MethodName: "AddToInTxTracker",
+			Handler:    _Msg_AddToInTxTracker_Handler,
+		},
+		{
+			MethodName: "RemoveFromOutTxTracker",
+			Handler:    _Msg_RemoveFromOutTxTracker_Handler,
+		},
+		{
+			MethodName: "GasPriceVoter",
+			Handler:    _Msg_GasPriceVoter_Handler,
+		},
+		{
+			MethodName: "VoteOnObservedOutboundTx",
+			Handler:    _Msg_VoteOnObservedOutboundTx_Handler,
+		},
+		{
+			MethodName: "VoteOnObservedInboundTx",
+			Handler:    _Msg_VoteOnObservedInboundTx_Handler,
+		},
+		{
+			MethodName: "WhitelistERC20",
+			Handler:    _Msg_WhitelistERC20_Handler,
+		},
+		{
+			MethodName: "UpdateTssAddress",
+			Handler:    _Msg_UpdateTssAddress_Handler,
+		},
+		{
+			MethodName: "MigrateTssFunds",
+			Handler:    _Msg_MigrateTssFunds_Handler,
+		},
+		{
+			MethodName: "MigrateAllTssFunds",
+			Handler:    _Msg_MigrateAllTssFunds_Handler,
+		},
+		{
+			MethodName: "CreateTSSVoter",
+			Handler:    _Msg_CreateTSSVoter_Handler,
+		},
+		{
+			MethodName: "UpdateWithdrawalLimits",
+			Handler:    _Msg_UpdateWithdrawalLimits_Handler,
+		},
+		{
+			MethodName: "ReleaseQueuedWithdrawal",
+			Handler:    _Msg_ReleaseQueuedWithdrawal_Handler,
+		},
+		{
+			MethodName: "CancelQueuedWithdrawal",
+			Handler:    _Msg_CancelQueuedWithdrawal_Handler,
+		},
+		{
+			MethodName: "AbortStuckCCTX",
+			Handler:    _Msg_AbortStuckCCTX_Handler,
+		},
+		{
+			MethodName: "RefundAbortedCCTX",
+			Handler:    _Msg_RefundAbortedCCTX_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crosschain/tx.proto",
 }
 
-func (m *MsgUpdateTssAddress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAbortStuckCCTX) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAbortStuckCCTX) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAbortStuckCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TssPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAbortStuckCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAbortStuckCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAbortStuckCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToInTxTracker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRefundAbortedCCTX) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundAbortedCCTX) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundAbortedCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CoinType != 0 {
-		n += 1 + sovTx(uint64(m.CoinType))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTx(uint64(m.TxIndex))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToInTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRefundAbortedCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundAbortedCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundAbortedCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWhitelistERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovTx(uint64(m.Decimals))
-	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
+func (m *MsgUpdateWithdrawalLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWhitelistERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Zrc20Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CctxIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateWithdrawalLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddToOutTxTracker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateWithdrawalLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.WithdrawalLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTx(uint64(m.TxIndex))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateWithdrawalLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWithdrawalLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWithdrawalLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsRemoved {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveFromOutTxTracker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleaseQueuedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQueuedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQueuedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveFromOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleaseQueuedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQueuedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQueuedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGasPriceVoter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelQueuedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.BlockNumber))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Supply)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGasPriceVoterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelQueuedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteOnObservedOutboundTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateTSSVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CctxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObservedOutTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ObservedOutTxBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.ObservedOutTxBlockHeight))
-	}
-	l = m.ValueReceived.Size()
-	n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTSSVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTSSVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	if m.OutTxChain != 0 {
-		n += 1 + sovTx(uint64(m.OutTxChain))
-	}
-	if m.OutTxTssNonce != 0 {
-		n += 1 + sovTx(uint64(m.OutTxTssNonce))
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CoinType != 0 {
-		n += 1 + sovTx(uint64(m.CoinType))
+	if m.KeyGenZetaHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyGenZetaHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ObservedOutTxGasUsed != 0 {
-		n += 1 + sovTx(uint64(m.ObservedOutTxGasUsed))
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ObservedOutTxEffectiveGasPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.ObservedOutTxEffectiveGasLimit != 0 {
-		n += 1 + sovTx(uint64(m.ObservedOutTxEffectiveGasLimit))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteOnObservedOutboundTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateTSSVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTSSVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTSSVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteOnObservedInboundTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateTssFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTssFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTssFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SenderChainId != 0 {
-		n += 1 + sovTx(uint64(m.SenderChainId))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateTssFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ReceiverChain != 0 {
-		n += 1 + sovTx(uint64(m.ReceiverChain))
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTssFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTssFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateAllTssFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAllTssFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllTssFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateAllTssFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.InBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.InBlockHeight))
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAllTssFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllTssFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndexes) > 0 {
+		for iNdEx := len(m.CctxIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CctxIndexes[iNdEx])
+			copy(dAtA[i:], m.CctxIndexes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTssAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.CoinType != 0 {
-		n += 1 + sovTx(uint64(m.CoinType))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTssAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTssAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TxOrigin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTssAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToInTxTracker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToInTxTracker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToInTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToInTxTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToInTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToInTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWhitelistERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWhitelistERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelistERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWhitelistERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWhitelistERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelistERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToOutTxTracker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToOutTxTracker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToOutTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsRemoved {
+		i--
+		if m.IsRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromOutTxTracker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromOutTxTracker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromOutTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasPriceVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasPriceVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasPriceVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriorityFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriorityFee))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Supply) > 0 {
+		i -= len(m.Supply)
+		copy(dAtA[i:], m.Supply)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Supply)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Price != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasPriceVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasPriceVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasPriceVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteOnObservedOutboundTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteOnObservedOutboundTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteOnObservedOutboundTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObservedOutTxEffectiveGasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ObservedOutTxEffectiveGasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.ObservedOutTxEffectiveGasPrice.Size()
+		i -= size
+		if _, err := m.ObservedOutTxEffectiveGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.ObservedOutTxGasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ObservedOutTxGasUsed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.OutTxTssNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutTxTssNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OutTxChain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutTxChain))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ValueReceived.Size()
+		i -= size
+		if _, err := m.ValueReceived.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ObservedOutTxBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ObservedOutTxBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObservedOutTxHash) > 0 {
+		i -= len(m.ObservedOutTxHash)
+		copy(dAtA[i:], m.ObservedOutTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObservedOutTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CctxHash) > 0 {
+		i -= len(m.CctxHash)
+		copy(dAtA[i:], m.CctxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteOnObservedOutboundTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteOnObservedOutboundTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteOnObservedOutboundTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteOnObservedInboundTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteOnObservedInboundTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteOnObservedInboundTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EventIndex))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.TxOrigin) > 0 {
+		i -= len(m.TxOrigin)
+		copy(dAtA[i:], m.TxOrigin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxOrigin)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.InBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InBlockHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ReceiverChain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceiverChain))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SenderChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteOnObservedInboundTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteOnObservedInboundTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteOnObservedInboundTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAbortStuckCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAbortStuckCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundAbortedCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundAbortedCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateWithdrawalLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WithdrawalLimits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateWithdrawalLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseQueuedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseQueuedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelQueuedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelQueuedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTSSVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyGenZetaHeight != 0 {
+		n += 1 + sovTx(uint64(m.KeyGenZetaHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgCreateTSSVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateTssFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateTssFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateAllTssFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateAllTssFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CctxIndexes) > 0 {
+		for _, s := range m.CctxIndexes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTssAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToInTxTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovTx(uint64(m.CoinType))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *MsgAddToInTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWhitelistERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgWhitelistERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddToOutTxTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *MsgAddToOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRemoved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveFromOutTxTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRemoveFromOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGasPriceVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.Price != 0 {
+		n += 1 + sovTx(uint64(m.Price))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	l = len(m.Supply)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PriorityFee != 0 {
+		n += 1 + sovTx(uint64(m.PriorityFee))
+	}
+	return n
+}
+
+func (m *MsgGasPriceVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteOnObservedOutboundTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObservedOutTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ObservedOutTxBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.ObservedOutTxBlockHeight))
+	}
+	l = m.ValueReceived.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.OutTxChain != 0 {
+		n += 1 + sovTx(uint64(m.OutTxChain))
+	}
+	if m.OutTxTssNonce != 0 {
+		n += 1 + sovTx(uint64(m.OutTxTssNonce))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovTx(uint64(m.CoinType))
+	}
+	if m.ObservedOutTxGasUsed != 0 {
+		n += 1 + sovTx(uint64(m.ObservedOutTxGasUsed))
+	}
+	l = m.ObservedOutTxEffectiveGasPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ObservedOutTxEffectiveGasLimit != 0 {
+		n += 1 + sovTx(uint64(m.ObservedOutTxEffectiveGasLimit))
+	}
+	return n
+}
+
+func (m *MsgVoteOnObservedOutboundTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteOnObservedInboundTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SenderChainId != 0 {
+		n += 1 + sovTx(uint64(m.SenderChainId))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceiverChain != 0 {
+		n += 1 + sovTx(uint64(m.ReceiverChain))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.InBlockHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovTx(uint64(m.CoinType))
+	}
+	l = len(m.TxOrigin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Asset)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.EventIndex != 0 {
-		n += 1 + sovTx(uint64(m.EventIndex))
+	if m.EventIndex != 0 {
+		n += 1 + sovTx(uint64(m.EventIndex))
+	}
+	return n
+}
+
+func (m *MsgVoteOnObservedInboundTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAbortStuckCCTX) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTX: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAbortStuckCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTX: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWithdrawalLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWithdrawalLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseQueuedWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseQueuedWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseQueuedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseQueuedWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseQueuedWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseQueuedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelQueuedWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgVoteOnObservedInboundTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCancelQueuedWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *MsgCreateTSSVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -3490,6 +5678,170 @@ func (m *MsgMigrateTssFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateAllTssFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateAllTssFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateAllTssFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateAllTssFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateAllTssFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateAllTssFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndexes = append(m.CctxIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateTssAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4921,6 +7273,25 @@ func (m *MsgGasPriceVoter) Unmarshal(dAtA []byte) error {
 			}
 			m.Supply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			m.PriorityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
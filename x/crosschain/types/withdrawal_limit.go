@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Validate checks the withdrawal limits are valid
+func (m WithdrawalLimits) Validate() error {
+	if m.Window < 0 {
+		return fmt.Errorf("window must not be negative")
+	}
+
+	zrc20s := make(map[ethcommon.Address]bool)
+	for _, limit := range m.Zrc20Limits {
+		if !ethcommon.IsHexAddress(limit.Zrc20ContractAddress) {
+			return fmt.Errorf("invalid zrc20 contract address %s", limit.Zrc20ContractAddress)
+		}
+		address := ethcommon.HexToAddress(limit.Zrc20ContractAddress)
+		if zrc20s[address] {
+			return fmt.Errorf("duplicate limit for zrc20 %s", limit.Zrc20ContractAddress)
+		}
+		zrc20s[address] = true
+		if limit.Limit.IsNil() {
+			return fmt.Errorf("limit of zrc20 %s is not set", limit.Zrc20ContractAddress)
+		}
+		if !limit.ZetaRate.IsNil() && limit.ZetaRate.IsNegative() {
+			return fmt.Errorf("zeta rate of zrc20 %s must not be negative", limit.Zrc20ContractAddress)
+		}
+	}
+
+	chains := make(map[int64]bool)
+	for _, limit := range m.ChainLimits {
+		if chains[limit.ChainId] {
+			return fmt.Errorf("duplicate limit for chain %d", limit.ChainId)
+		}
+		chains[limit.ChainId] = true
+		if limit.Limit.IsNil() {
+			return fmt.Errorf("limit of chain %d is not set", limit.ChainId)
+		}
+	}
+	return nil
+}
+
+// ZRC20Limit returns the withdrawal limit of a ZRC20
+func (m WithdrawalLimits) ZRC20Limit(zrc20Address string) (ZRC20WithdrawalLimit, bool) {
+	address := ethcommon.HexToAddress(zrc20Address)
+	for _, limit := range m.Zrc20Limits {
+		if ethcommon.HexToAddress(limit.Zrc20ContractAddress) == address {
+			return limit, true
+		}
+	}
+	return ZRC20WithdrawalLimit{}, false
+}
+
+// ChainLimit returns the withdrawal limit of a foreign chain
+func (m WithdrawalLimits) ChainLimit(chainID int64) (ChainWithdrawalLimit, bool) {
+	for _, limit := range m.ChainLimits {
+		if limit.ChainId == chainID {
+			return limit, true
+		}
+	}
+	return ChainWithdrawalLimit{}, false
+}
+
+// ZetaValue returns the value in azeta of an amount of the ZRC20, false if the ZRC20 has no zeta rate
+func (m ZRC20WithdrawalLimit) ZetaValue(amount math.Uint) (math.Uint, bool) {
+	if m.ZetaRate.IsNil() || !m.ZetaRate.IsPositive() {
+		return math.ZeroUint(), false
+	}
+	value := m.ZetaRate.MulInt(math.NewIntFromBigInt(amount.BigInt())).TruncateInt()
+	return math.NewUintFromBigInt(value.BigInt()), true
+}
@@ -0,0 +1,1825 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crosschain/withdrawal_limit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ZRC20WithdrawalLimit limits the amount of a ZRC20 withdrawn from ZetaChain in the window
+type ZRC20WithdrawalLimit struct {
+	Zrc20ContractAddress string `protobuf:"bytes,1,opt,name=zrc20_contract_address,json=zrc20ContractAddress,proto3" json:"zrc20_contract_address,omitempty"`
+	// maximum amount withdrawn in the window in the ZRC20 unit, 0 for no limit
+	Limit github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"limit"`
+	// azeta value of one unit of the ZRC20, used to count the withdrawals in the limit of the foreign chain
+	ZetaRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=zeta_rate,json=zetaRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"zeta_rate"`
+}
+
+func (m *ZRC20WithdrawalLimit) Reset()         { *m = ZRC20WithdrawalLimit{} }
+func (m *ZRC20WithdrawalLimit) String() string { return proto.CompactTextString(m) }
+func (*ZRC20WithdrawalLimit) ProtoMessage()    {}
+func (*ZRC20WithdrawalLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e7eb33977110e1, []int{0}
+}
+func (m *ZRC20WithdrawalLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZRC20WithdrawalLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZRC20WithdrawalLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZRC20WithdrawalLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZRC20WithdrawalLimit.Merge(m, src)
+}
+func (m *ZRC20WithdrawalLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZRC20WithdrawalLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZRC20WithdrawalLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZRC20WithdrawalLimit proto.InternalMessageInfo
+
+func (m *ZRC20WithdrawalLimit) GetZrc20ContractAddress() string {
+	if m != nil {
+		return m.Zrc20ContractAddress
+	}
+	return ""
+}
+
+// ChainWithdrawalLimit limits the value withdrawn to a foreign chain in the window
+type ChainWithdrawalLimit struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// maximum value withdrawn in the window in azeta, 0 for no limit
+	Limit github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"limit"`
+}
+
+func (m *ChainWithdrawalLimit) Reset()         { *m = ChainWithdrawalLimit{} }
+func (m *ChainWithdrawalLimit) String() string { return proto.CompactTextString(m) }
+func (*ChainWithdrawalLimit) ProtoMessage()    {}
+func (*ChainWithdrawalLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e7eb33977110e1, []int{1}
+}
+func (m *ChainWithdrawalLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainWithdrawalLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainWithdrawalLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainWithdrawalLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainWithdrawalLimit.Merge(m, src)
+}
+func (m *ChainWithdrawalLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainWithdrawalLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainWithdrawalLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainWithdrawalLimit proto.InternalMessageInfo
+
+func (m *ChainWithdrawalLimit) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// WithdrawalLimits defines the limits of the withdrawals from ZetaChain over a sliding window of blocks
+type WithdrawalLimits struct {
+	// number of blocks of the sliding window, the limits are disabled if 0
+	Window      int64                  `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Zrc20Limits []ZRC20WithdrawalLimit `protobuf:"bytes,2,rep,name=zrc20_limits,json=zrc20Limits,proto3" json:"zrc20_limits"`
+	ChainLimits []ChainWithdrawalLimit `protobuf:"bytes,3,rep,name=chain_limits,json=chainLimits,proto3" json:"chain_limits"`
+}
+
+func (m *WithdrawalLimits) Reset()         { *m = WithdrawalLimits{} }
+func (m *WithdrawalLimits) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalLimits) ProtoMessage()    {}
+func (*WithdrawalLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e7eb33977110e1, []int{2}
+}
+func (m *WithdrawalLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalLimits.Merge(m, src)
+}
+func (m *WithdrawalLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalLimits proto.InternalMessageInfo
+
+func (m *WithdrawalLimits) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *WithdrawalLimits) GetZrc20Limits() []ZRC20WithdrawalLimit {
+	if m != nil {
+		return m.Zrc20Limits
+	}
+	return nil
+}
+
+func (m *WithdrawalLimits) GetChainLimits() []ChainWithdrawalLimit {
+	if m != nil {
+		return m.ChainLimits
+	}
+	return nil
+}
+
+// ZRC20WithdrawalUsage is the amount of a ZRC20 withdrawn in the current window
+type ZRC20WithdrawalUsage struct {
+	Zrc20ContractAddress string                                  `protobuf:"bytes,1,opt,name=zrc20_contract_address,json=zrc20ContractAddress,proto3" json:"zrc20_contract_address,omitempty"`
+	Amount               github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	Limit                github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"limit"`
+}
+
+func (m *ZRC20WithdrawalUsage) Reset()         { *m = ZRC20WithdrawalUsage{} }
+func (m *ZRC20WithdrawalUsage) String() string { return proto.CompactTextString(m) }
+func (*ZRC20WithdrawalUsage) ProtoMessage()    {}
+func (*ZRC20WithdrawalUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e7eb33977110e1, []int{3}
+}
+func (m *ZRC20WithdrawalUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZRC20WithdrawalUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZRC20WithdrawalUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZRC20WithdrawalUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZRC20WithdrawalUsage.Merge(m, src)
+}
+func (m *ZRC20WithdrawalUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZRC20WithdrawalUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZRC20WithdrawalUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZRC20WithdrawalUsage proto.InternalMessageInfo
+
+func (m *ZRC20WithdrawalUsage) GetZrc20ContractAddress() string {
+	if m != nil {
+		return m.Zrc20ContractAddress
+	}
+	return ""
+}
+
+// ChainWithdrawalUsage is the value in azeta withdrawn to a foreign chain in the current window
+type ChainWithdrawalUsage struct {
+	ChainId int64                                   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	Limit   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"limit"`
+}
+
+func (m *ChainWithdrawalUsage) Reset()         { *m = ChainWithdrawalUsage{} }
+func (m *ChainWithdrawalUsage) String() string { return proto.CompactTextString(m) }
+func (*ChainWithdrawalUsage) ProtoMessage()    {}
+func (*ChainWithdrawalUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e7eb33977110e1, []int{4}
+}
+func (m *ChainWithdrawalUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainWithdrawalUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainWithdrawalUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainWithdrawalUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainWithdrawalUsage.Merge(m, src)
+}
+func (m *ChainWithdrawalUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainWithdrawalUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainWithdrawalUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainWithdrawalUsage proto.InternalMessageInfo
+
+func (m *ChainWithdrawalUsage) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// QueuedWithdrawal is a withdrawal exceeding the limits, its cctx waits for the admin to release or cancel it
+type QueuedWithdrawal struct {
+	CctxIndex            string                                  `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	Zrc20ContractAddress string                                  `protobuf:"bytes,2,opt,name=zrc20_contract_address,json=zrc20ContractAddress,proto3" json:"zrc20_contract_address,omitempty"`
+	ChainId              int64                                   `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Amount               github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	// address the amount is deposited back to if the withdrawal is cancelled
+	RefundAddress string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	Height        int64  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueuedWithdrawal) Reset()         { *m = QueuedWithdrawal{} }
+func (m *QueuedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*QueuedWithdrawal) ProtoMessage()    {}
+func (*QueuedWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e7eb33977110e1, []int{5}
+}
+func (m *QueuedWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedWithdrawal.Merge(m, src)
+}
+func (m *QueuedWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedWithdrawal proto.InternalMessageInfo
+
+func (m *QueuedWithdrawal) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *QueuedWithdrawal) GetZrc20ContractAddress() string {
+	if m != nil {
+		return m.Zrc20ContractAddress
+	}
+	return ""
+}
+
+func (m *QueuedWithdrawal) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueuedWithdrawal) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *QueuedWithdrawal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ZRC20WithdrawalLimit)(nil), "zetachain.zetacore.crosschain.ZRC20WithdrawalLimit")
+	proto.RegisterType((*ChainWithdrawalLimit)(nil), "zetachain.zetacore.crosschain.ChainWithdrawalLimit")
+	proto.RegisterType((*WithdrawalLimits)(nil), "zetachain.zetacore.crosschain.WithdrawalLimits")
+	proto.RegisterType((*ZRC20WithdrawalUsage)(nil), "zetachain.zetacore.crosschain.ZRC20WithdrawalUsage")
+	proto.RegisterType((*ChainWithdrawalUsage)(nil), "zetachain.zetacore.crosschain.ChainWithdrawalUsage")
+	proto.RegisterType((*QueuedWithdrawal)(nil), "zetachain.zetacore.crosschain.QueuedWithdrawal")
+}
+
+func init() { proto.RegisterFile("crosschain/withdrawal_limit.proto", fileDescriptor_42e7eb33977110e1) }
+
+var fileDescriptor_42e7eb33977110e1 = []byte{
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6a, 0x13, 0x41,
+	0x1c, 0xcf, 0x66, 0xdb, 0xb5, 0x99, 0x56, 0x29, 0x4b, 0x08, 0xab, 0xd0, 0x6d, 0x0d, 0xa8, 0xbd,
+	0x74, 0xb7, 0xa6, 0xbe, 0x80, 0x89, 0x22, 0xa5, 0x5e, 0x5c, 0x28, 0x42, 0x29, 0x2c, 0xd3, 0x99,
+	0x71, 0x77, 0xb0, 0xd9, 0x29, 0x33, 0xb3, 0x24, 0xf6, 0x11, 0x3c, 0xf9, 0x4a, 0xde, 0x7a, 0xec,
+	0x51, 0x44, 0x4a, 0x49, 0xc0, 0xe7, 0x90, 0xf9, 0x48, 0x13, 0xda, 0xba, 0x94, 0xc6, 0x83, 0xa7,
+	0xdd, 0xf9, 0xf8, 0xff, 0xe6, 0xf7, 0xf1, 0x9f, 0x01, 0x4f, 0x11, 0x67, 0x42, 0xa0, 0x1c, 0xd2,
+	0x22, 0x1e, 0x50, 0x99, 0x63, 0x0e, 0x07, 0xf0, 0x38, 0x3d, 0xa6, 0x7d, 0x2a, 0xa3, 0x13, 0xce,
+	0x24, 0xf3, 0xd7, 0x4e, 0x89, 0x84, 0x7a, 0x47, 0xa4, 0xff, 0x18, 0x27, 0xd1, 0xb4, 0xea, 0x49,
+	0x33, 0x63, 0x19, 0xd3, 0x3b, 0x63, 0xf5, 0x67, 0x8a, 0xda, 0x97, 0x0e, 0x68, 0x1e, 0x24, 0xbd,
+	0xce, 0xf6, 0xc7, 0x2b, 0xd0, 0xf7, 0x0a, 0xd3, 0x7f, 0x05, 0x5a, 0xa7, 0x1c, 0x75, 0xb6, 0x53,
+	0xc4, 0x0a, 0xc9, 0x21, 0x92, 0x29, 0xc4, 0x98, 0x13, 0x21, 0x02, 0x67, 0xc3, 0xd9, 0x6c, 0x24,
+	0x4d, 0xbd, 0xda, 0xb3, 0x8b, 0xaf, 0xcd, 0x9a, 0xff, 0x16, 0x2c, 0x6a, 0x4a, 0x41, 0x5d, 0x6d,
+	0xea, 0xc6, 0x67, 0x17, 0xeb, 0xb5, 0x9f, 0x17, 0xeb, 0x2f, 0x32, 0x2a, 0xf3, 0xf2, 0x28, 0x42,
+	0xac, 0x1f, 0x23, 0x26, 0xfa, 0x4c, 0xd8, 0xcf, 0x96, 0xc0, 0x9f, 0x63, 0xf9, 0xe5, 0x84, 0x88,
+	0x68, 0x9f, 0x16, 0x32, 0x31, 0xd5, 0xfe, 0x1e, 0x68, 0x28, 0x09, 0x29, 0x87, 0x92, 0x04, 0xae,
+	0x86, 0x8a, 0x2c, 0xd4, 0xf3, 0x3b, 0x40, 0xbd, 0x21, 0x28, 0x59, 0x52, 0x00, 0x09, 0x94, 0xa4,
+	0x3d, 0x04, 0xcd, 0x9e, 0x72, 0xe0, 0xba, 0xc2, 0xc7, 0x60, 0x49, 0x3b, 0x93, 0x52, 0xac, 0x35,
+	0xb9, 0xc9, 0x03, 0x3d, 0xde, 0xc5, 0xff, 0x48, 0x46, 0xfb, 0xb7, 0x03, 0x56, 0xaf, 0x9d, 0x2a,
+	0xfc, 0x16, 0xf0, 0x06, 0xb4, 0xc0, 0x6c, 0x60, 0x0f, 0xb5, 0x23, 0xff, 0x10, 0xac, 0x18, 0xc3,
+	0x75, 0xad, 0x08, 0xea, 0x1b, 0xee, 0xe6, 0x72, 0x67, 0x27, 0xaa, 0x4c, 0x35, 0xba, 0x2d, 0xbb,
+	0xee, 0x82, 0xe2, 0x9b, 0x2c, 0x6b, 0x38, 0x7b, 0xea, 0x21, 0x58, 0x31, 0x62, 0x2d, 0xba, 0x7b,
+	0x27, 0xf4, 0xdb, 0x7c, 0x9b, 0xa0, 0xeb, 0x1d, 0x06, 0xbd, 0xfd, 0xeb, 0x66, 0x17, 0xed, 0x0b,
+	0x98, 0x91, 0x7b, 0x76, 0xd1, 0x3b, 0xe0, 0xc1, 0x3e, 0x2b, 0x8b, 0x7b, 0xfb, 0x6f, 0xcb, 0xa7,
+	0x39, 0xba, 0x73, 0xe5, 0xf8, 0xdd, 0xb9, 0xd1, 0x42, 0x46, 0x5e, 0x45, 0x0b, 0xfd, 0x6f, 0x1a,
+	0xbe, 0xd6, 0xc1, 0xea, 0x87, 0x92, 0x94, 0x04, 0x4f, 0x45, 0xf8, 0x6b, 0x00, 0x20, 0x24, 0x87,
+	0x29, 0x2d, 0x30, 0x19, 0xda, 0x48, 0x1a, 0x6a, 0x66, 0x57, 0x4d, 0x54, 0xa4, 0x57, 0xaf, 0x48,
+	0x6f, 0xd6, 0x14, 0xf7, 0x6f, 0xa6, 0x2c, 0xcc, 0x67, 0xca, 0x33, 0xf0, 0x88, 0x93, 0x4f, 0x65,
+	0x81, 0xaf, 0x18, 0x2d, 0x6a, 0x46, 0x0f, 0xcd, 0xec, 0x84, 0x4a, 0x0b, 0x78, 0x39, 0xa1, 0x59,
+	0x2e, 0x03, 0xcf, 0xdc, 0x35, 0x33, 0xea, 0xee, 0x9d, 0x8d, 0x42, 0xe7, 0x7c, 0x14, 0x3a, 0x97,
+	0xa3, 0xd0, 0xf9, 0x36, 0x0e, 0x6b, 0xe7, 0xe3, 0xb0, 0xf6, 0x63, 0x1c, 0xd6, 0x0e, 0x5e, 0xce,
+	0x30, 0x51, 0x37, 0x62, 0xcb, 0x3c, 0xb9, 0x93, 0xcb, 0x11, 0x0f, 0xe3, 0x99, 0x87, 0x58, 0x13,
+	0x3b, 0xf2, 0xf4, 0x4b, 0xba, 0xf3, 0x27, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x99, 0x90, 0xbd, 0xa3,
+	0x05, 0x00, 0x00,
+}
+
+func (m *ZRC20WithdrawalLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZRC20WithdrawalLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZRC20WithdrawalLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ZetaRate.Size()
+		i -= size
+		if _, err := m.ZetaRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Zrc20ContractAddress) > 0 {
+		i -= len(m.Zrc20ContractAddress)
+		copy(dAtA[i:], m.Zrc20ContractAddress)
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(len(m.Zrc20ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainWithdrawalLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainWithdrawalLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainWithdrawalLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainLimits) > 0 {
+		for iNdEx := len(m.ChainLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Zrc20Limits) > 0 {
+		for iNdEx := len(m.Zrc20Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zrc20Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZRC20WithdrawalUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZRC20WithdrawalUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZRC20WithdrawalUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Zrc20ContractAddress) > 0 {
+		i -= len(m.Zrc20ContractAddress)
+		copy(dAtA[i:], m.Zrc20ContractAddress)
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(len(m.Zrc20ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainWithdrawalUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainWithdrawalUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainWithdrawalUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ChainId != 0 {
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Zrc20ContractAddress) > 0 {
+		i -= len(m.Zrc20ContractAddress)
+		copy(dAtA[i:], m.Zrc20ContractAddress)
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(len(m.Zrc20ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintWithdrawalLimit(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWithdrawalLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWithdrawalLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ZRC20WithdrawalLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovWithdrawalLimit(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	l = m.ZetaRate.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	return n
+}
+
+func (m *ChainWithdrawalLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovWithdrawalLimit(uint64(m.ChainId))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	return n
+}
+
+func (m *WithdrawalLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovWithdrawalLimit(uint64(m.Window))
+	}
+	if len(m.Zrc20Limits) > 0 {
+		for _, e := range m.Zrc20Limits {
+			l = e.Size()
+			n += 1 + l + sovWithdrawalLimit(uint64(l))
+		}
+	}
+	if len(m.ChainLimits) > 0 {
+		for _, e := range m.ChainLimits {
+			l = e.Size()
+			n += 1 + l + sovWithdrawalLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ZRC20WithdrawalUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovWithdrawalLimit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	return n
+}
+
+func (m *ChainWithdrawalUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovWithdrawalLimit(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	return n
+}
+
+func (m *QueuedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovWithdrawalLimit(uint64(l))
+	}
+	l = len(m.Zrc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovWithdrawalLimit(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovWithdrawalLimit(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovWithdrawalLimit(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovWithdrawalLimit(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovWithdrawalLimit(uint64(m.Height))
+	}
+	return n
+}
+
+func sovWithdrawalLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWithdrawalLimit(x uint64) (n int) {
+	return sovWithdrawalLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ZRC20WithdrawalLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawalLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZRC20WithdrawalLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZRC20WithdrawalLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ZetaRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawalLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainWithdrawalLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawalLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainWithdrawalLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainWithdrawalLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawalLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawalLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Limits = append(m.Zrc20Limits, ZRC20WithdrawalLimit{})
+			if err := m.Zrc20Limits[len(m.Zrc20Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainLimits = append(m.ChainLimits, ChainWithdrawalLimit{})
+			if err := m.ChainLimits[len(m.ChainLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawalLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZRC20WithdrawalUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawalLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZRC20WithdrawalUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZRC20WithdrawalUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawalLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainWithdrawalUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawalLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainWithdrawalUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainWithdrawalUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawalLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawalLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawalLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawalLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWithdrawalLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWithdrawalLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawalLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWithdrawalLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWithdrawalLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWithdrawalLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWithdrawalLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWithdrawalLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWithdrawalLimit = fmt.Errorf("proto: unexpected end of group")
+)
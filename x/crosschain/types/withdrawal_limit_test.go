@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestWithdrawalLimits_Validate(t *testing.T) {
+	zrc20 := sample.EthAddress().Hex()
+
+	tests := []struct {
+		name   string
+		limits types.WithdrawalLimits
+		error  bool
+	}{
+		{
+			name:   "empty limits",
+			limits: types.WithdrawalLimits{},
+			error:  false,
+		},
+		{
+			name: "valid limits",
+			limits: types.WithdrawalLimits{
+				Window: 100,
+				Zrc20Limits: []types.ZRC20WithdrawalLimit{
+					{Zrc20ContractAddress: zrc20, Limit: sdkmath.NewUint(1000), ZetaRate: sdk.NewDec(2)},
+				},
+				ChainLimits: []types.ChainWithdrawalLimit{
+					{ChainId: 5, Limit: sdkmath.NewUint(1000)},
+				},
+			},
+			error: false,
+		},
+		{
+			name:   "negative window",
+			limits: types.WithdrawalLimits{Window: -1},
+			error:  true,
+		},
+		{
+			name: "invalid zrc20 address",
+			limits: types.WithdrawalLimits{
+				Zrc20Limits: []types.ZRC20WithdrawalLimit{
+					{Zrc20ContractAddress: "invalid", Limit: sdkmath.NewUint(1000)},
+				},
+			},
+			error: true,
+		},
+		{
+			name: "duplicate zrc20",
+			limits: types.WithdrawalLimits{
+				Zrc20Limits: []types.ZRC20WithdrawalLimit{
+					{Zrc20ContractAddress: zrc20, Limit: sdkmath.NewUint(1000)},
+					{Zrc20ContractAddress: zrc20, Limit: sdkmath.NewUint(2000)},
+				},
+			},
+			error: true,
+		},
+		{
+			name: "negative zeta rate",
+			limits: types.WithdrawalLimits{
+				Zrc20Limits: []types.ZRC20WithdrawalLimit{
+					{Zrc20ContractAddress: zrc20, Limit: sdkmath.NewUint(1000), ZetaRate: sdk.NewDec(-1)},
+				},
+			},
+			error: true,
+		},
+		{
+			name: "duplicate chain",
+			limits: types.WithdrawalLimits{
+				ChainLimits: []types.ChainWithdrawalLimit{
+					{ChainId: 5, Limit: sdkmath.NewUint(1000)},
+					{ChainId: 5, Limit: sdkmath.NewUint(2000)},
+				},
+			},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.Validate()
+			if tt.error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestZRC20WithdrawalLimit_ZetaValue(t *testing.T) {
+	value, ok := types.ZRC20WithdrawalLimit{ZetaRate: sdk.MustNewDecFromStr("1.5")}.ZetaValue(sdkmath.NewUint(1001))
+	require.True(t, ok)
+	require.Equal(t, sdkmath.NewUint(1501), value)
+
+	_, ok = types.ZRC20WithdrawalLimit{}.ZetaValue(sdkmath.NewUint(1001))
+	require.False(t, ok)
+}
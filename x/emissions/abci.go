@@ -11,6 +11,9 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	// the matured ballots are not needed anymore once the rewards have been distributed for the block, they are pruned
+	// even if no rewards are distributed so they don't accumulate in the store
+	defer keeper.GetObserverKeeper().ClearMaturedBallots(ctx)
 
 	reservesFactor, bondFactor, durationFactor := keeper.GetBlockRewardComponents(ctx)
 	blockRewards := reservesFactor.Mul(bondFactor).Mul(durationFactor)
@@ -124,8 +127,6 @@ func DistributeObserverRewards(ctx sdk.Context, amount sdkmath.Int, keeper keepe
 		}
 	}
 	types.EmitObserverEmissions(ctx, finalDistributionList)
-	// TODO : Delete Ballots after distribution
-	// https://github.com/zeta-chain/node/issues/942
 	return nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/zeta-chain/zetacore/testutil/simapp"
 	emissionsModule "github.com/zeta-chain/zetacore/x/emissions"
 	emissionsModuleTypes "github.com/zeta-chain/zetacore/x/emissions/types"
+	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 func getaZetaFromString(amount string) sdk.Coins {
@@ -193,6 +194,25 @@ func TestAppModule_GetBlockRewardComponents(t *testing.T) {
 	}
 }
 
+func TestBeginBlocker_ClearMaturedBallotsWithoutRewards(t *testing.T) {
+	// the emission pool is empty so no block rewards are distributed
+	app, ctx, _, _ := SetupApp(t, emissionsModuleTypes.DefaultParams(), getaZetaFromString("0"))
+	observerParams := app.ZetaObserverKeeper.GetParams(ctx)
+
+	maturedHeight := ctx.BlockHeight()
+	ballot := observerTypes.Ballot{BallotIdentifier: "matured", BallotCreationHeight: maturedHeight}
+	app.ZetaObserverKeeper.SetBallot(ctx, &ballot)
+	app.ZetaObserverKeeper.AddBallotToList(ctx, ballot)
+
+	ctx = ctx.WithBlockHeight(maturedHeight + observerParams.BallotMaturityBlocks)
+	emissionsModule.BeginBlocker(ctx, app.EmissionsKeeper)
+
+	_, found := app.ZetaObserverKeeper.GetBallot(ctx, "matured")
+	assert.False(t, found)
+	_, found = app.ZetaObserverKeeper.GetBallotList(ctx, maturedHeight)
+	assert.False(t, found)
+}
+
 func GetInputData(fp string) ([]EmissionTestData, error) {
 	data := []EmissionTestData{}
 	file, err := filepath.Abs(fp)
@@ -19,5 +19,9 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		CmdWithdrawEmission(),
+	)
+
 	return cmd
 }
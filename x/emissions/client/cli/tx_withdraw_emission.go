@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+const flagCompound = "compound"
+
+func CmdWithdrawEmission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-emission [amount]",
+		Short: "Withdraw the emissions accumulated by the observer, the full amount is withdrawn if no amount is provided",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amount := sdkmath.ZeroInt()
+			if len(args) > 0 {
+				var ok bool
+				amount, ok = sdkmath.NewIntFromString(args[0])
+				if !ok {
+					return fmt.Errorf("invalid amount %s", args[0])
+				}
+			}
+			compound, err := cmd.Flags().GetBool(flagCompound)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawEmission(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				compound,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagCompound, false, "delegate the withdrawn emissions to the observer's validator")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// WithdrawEmission transfers the emissions accumulated by an observer from the undistributed observer rewards pool to the observer.
+// If no amount is specified, the full withdrawable amount is transferred.
+// If compound is set, the withdrawn amount is delegated to the validator operated by the observer.
+func (k msgServer) WithdrawEmission(goCtx context.Context, msg *types.MsgWithdrawEmission) (*types.MsgWithdrawEmissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check the observer has emissions to withdraw
+	we, found := k.GetWithdrawableEmission(ctx, msg.Creator)
+	if !found || !we.Amount.IsPositive() {
+		return nil, cosmoserrors.Wrapf(types.ErrEmissionsNotFound, "address %s", msg.Creator)
+	}
+	amount := we.Amount
+	if !msg.IsFullWithdrawal() {
+		if msg.Amount.GT(we.Amount) {
+			return nil, cosmoserrors.Wrapf(
+				types.ErrInsufficientEmissions,
+				"requested %s, withdrawable %s",
+				msg.Amount,
+				we.Amount,
+			)
+		}
+		amount = msg.Amount
+	}
+
+	// check the pool can cover the withdrawal
+	poolBalance := k.bankKeeper.GetBalance(ctx, types.UndistributedObserverRewardsPoolAddress, config.BaseDenom)
+	if poolBalance.Amount.LT(amount) {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInsufficientPoolBalance,
+			"requested %s, pool balance %s",
+			amount,
+			poolBalance.Amount,
+		)
+	}
+
+	// fetch the validator before moving any funds if the withdrawal is compounded
+	var validator stakingtypes.Validator
+	if msg.Compound {
+		valAddress, err := observertypes.GetOperatorAddressFromAccAddress(msg.Creator)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrValidatorNotFound, err.Error())
+		}
+		validator, found = k.stakingKeeper.GetValidator(ctx, valAddress)
+		if !found {
+			return nil, cosmoserrors.Wrapf(types.ErrValidatorNotFound, "validator %s", valAddress.String())
+		}
+		if bondDenom := k.stakingKeeper.BondDenom(ctx); bondDenom != config.BaseDenom {
+			return nil, cosmoserrors.Wrapf(types.ErrInvalidAmount, "cannot delegate emissions, bond denom is %s", bondDenom)
+		}
+	}
+
+	observerAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrParsingSenderAddress, err.Error())
+	}
+
+	// update the withdrawable amount and transfer the coins
+	we.Amount = we.Amount.Sub(amount)
+	k.SetWithdrawableEmission(ctx, we)
+	coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.UndistributedObserverRewardsPool, observerAddress, coins); err != nil {
+		return nil, cosmoserrors.Wrap(err, "failed to transfer emissions")
+	}
+
+	validatorAddress := ""
+	if msg.Compound {
+		if _, err := k.stakingKeeper.Delegate(ctx, observerAddress, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return nil, cosmoserrors.Wrap(err, "failed to delegate emissions")
+		}
+		validatorAddress = validator.GetOperator().String()
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEmissionWithdrawn{
+		MsgTypeUrl:       sdk.MsgTypeURL(&types.MsgWithdrawEmission{}),
+		ObserverAddress:  msg.Creator,
+		Amount:           amount,
+		Compounded:       msg.Compound,
+		ValidatorAddress: validatorAddress,
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to emit event",
+			"event", "EventEmissionWithdrawn",
+			"error", err.Error(),
+		)
+		return nil, cosmoserrors.Wrap(err, "failed to emit event")
+	}
+
+	return &types.MsgWithdrawEmissionResponse{Amount: amount}, nil
+}
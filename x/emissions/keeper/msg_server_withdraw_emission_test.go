@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/emissions/keeper"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+// fundRewardsPool mints the given amount to the undistributed observer rewards pool
+func fundRewardsPool(t *testing.T, ctx sdk.Context, sdkk keepertest.SDKKeepers, amount int64) {
+	coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdkmath.NewInt(amount)))
+	require.NoError(t, sdkk.BankKeeper.MintCoins(ctx, fungibletypes.ModuleName, coins))
+	require.NoError(t, sdkk.BankKeeper.SendCoinsFromModuleToModule(ctx, fungibletypes.ModuleName, types.UndistributedObserverRewardsPool, coins))
+}
+
+// setObserverValidator sets a validator in the store and returns the address of the observer operating it
+func setObserverValidator(t *testing.T, ctx sdk.Context, sdkk keepertest.SDKKeepers) (string, stakingtypes.Validator) {
+	// #nosec G404 test purpose - weak randomness is not an issue here
+	r := rand.New(rand.NewSource(9))
+	validator := sample.Validator(t, r)
+	sdkk.StakingKeeper.SetValidator(ctx, validator)
+	return sdk.AccAddress(validator.GetOperator()).String(), validator
+}
+
+func TestMsgServer_WithdrawEmission(t *testing.T) {
+	t.Run("can withdraw the full amount", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+
+		fundRewardsPool(t, ctx, sdkk, 1000)
+		k.AddObserverEmission(ctx, observer, sdkmath.NewInt(600))
+
+		res, err := msgServer.WithdrawEmission(ctx, types.NewMsgWithdrawEmission(observer, sdkmath.ZeroInt(), false))
+		require.NoError(t, err)
+		require.True(t, res.Amount.Equal(sdkmath.NewInt(600)))
+
+		we, found := k.GetWithdrawableEmission(ctx, observer)
+		require.True(t, found)
+		require.True(t, we.Amount.IsZero())
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(observer), config.BaseDenom)
+		require.True(t, balance.Amount.Equal(sdkmath.NewInt(600)))
+		poolBalance := sdkk.BankKeeper.GetBalance(ctx, types.UndistributedObserverRewardsPoolAddress, config.BaseDenom)
+		require.True(t, poolBalance.Amount.Equal(sdkmath.NewInt(400)))
+	})
+
+	t.Run("can withdraw a partial amount", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+
+		fundRewardsPool(t, ctx, sdkk, 1000)
+		k.AddObserverEmission(ctx, observer, sdkmath.NewInt(600))
+
+		res, err := msgServer.WithdrawEmission(ctx, types.NewMsgWithdrawEmission(observer, sdkmath.NewInt(250), false))
+		require.NoError(t, err)
+		require.True(t, res.Amount.Equal(sdkmath.NewInt(250)))
+
+		we, found := k.GetWithdrawableEmission(ctx, observer)
+		require.True(t, found)
+		require.True(t, we.Amount.Equal(sdkmath.NewInt(350)))
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(observer), config.BaseDenom)
+		require.True(t, balance.Amount.Equal(sdkmath.NewInt(250)))
+
+		// an event is emitted
+		events := ctx.EventManager().Events()
+		require.Equal(t, "zetachain.zetacore.emissions.EventEmissionWithdrawn", events[len(events)-1].Type)
+	})
+
+	t.Run("can withdraw and delegate to the observer validator", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observer, validator := setObserverValidator(t, ctx, sdkk)
+
+		stakingParams := sdkk.StakingKeeper.GetParams(ctx)
+		stakingParams.BondDenom = config.BaseDenom
+		sdkk.StakingKeeper.SetParams(ctx, stakingParams)
+
+		fundRewardsPool(t, ctx, sdkk, 1000)
+		k.AddObserverEmission(ctx, observer, sdkmath.NewInt(600))
+
+		_, err := msgServer.WithdrawEmission(ctx, types.NewMsgWithdrawEmission(observer, sdkmath.NewInt(500), true))
+		require.NoError(t, err)
+
+		// the withdrawn amount is bonded rather than left in the observer account
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(observer), config.BaseDenom)
+		require.True(t, balance.Amount.IsZero())
+		delegation, found := sdkk.StakingKeeper.GetDelegation(ctx, sdk.MustAccAddressFromBech32(observer), validator.GetOperator())
+		require.True(t, found)
+		require.True(t, delegation.Shares.Equal(sdk.NewDec(500)))
+	})
+
+	t.Run("should fail if no emissions to withdraw", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		fundRewardsPool(t, ctx, sdkk, 1000)
+
+		_, err := msgServer.WithdrawEmission(ctx, types.NewMsgWithdrawEmission(sample.AccAddress(), sdkmath.ZeroInt(), false))
+		require.ErrorIs(t, err, types.ErrEmissionsNotFound)
+	})
+
+	t.Run("should fail if amount is higher than withdrawable emissions", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+
+		fundRewardsPool(t, ctx, sdkk, 1000)
+		k.AddObserverEmission(ctx, observer, sdkmath.NewInt(600))
+
+		_, err := msgServer.WithdrawEmission(ctx, types.NewMsgWithdrawEmission(observer, sdkmath.NewInt(601), false))
+		require.ErrorIs(t, err, types.ErrInsufficientEmissions)
+	})
+
+	t.Run("should fail if pool balance is insufficient", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+
+		fundRewardsPool(t, ctx, sdkk, 100)
+		k.AddObserverEmission(ctx, observer, sdkmath.NewInt(600))
+
+		_, err := msgServer.WithdrawEmission(ctx, types.NewMsgWithdrawEmission(observer, sdkmath.ZeroInt(), false))
+		require.ErrorIs(t, err, types.ErrInsufficientPoolBalance)
+
+		we, found := k.GetWithdrawableEmission(ctx, observer)
+		require.True(t, found)
+		require.True(t, we.Amount.Equal(sdkmath.NewInt(600)))
+	})
+
+	t.Run("should fail to compound if observer has no validator", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+
+		fundRewardsPool(t, ctx, sdkk, 1000)
+		k.AddObserverEmission(ctx, observer, sdkmath.NewInt(600))
+
+		_, err := msgServer.WithdrawEmission(ctx, types.NewMsgWithdrawEmission(observer, sdkmath.ZeroInt(), true))
+		require.ErrorIs(t, err, types.ErrValidatorNotFound)
+	})
+}
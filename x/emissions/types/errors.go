@@ -11,4 +11,9 @@ var (
 	ErrEmissionTrackerNotFound = sdkerrors.Register(ModuleName, 1100, "Emission Tracker Not found")
 	ErrParsingSenderAddress    = sdkerrors.Register(ModuleName, 1101, "Unable to parse address of sender")
 	ErrAddingCoinstoTracker    = sdkerrors.Register(ModuleName, 1102, "Unable to add coins to emissionTracker ")
+	ErrEmissionsNotFound       = sdkerrors.Register(ModuleName, 1103, "No withdrawable emissions found")
+	ErrInsufficientEmissions   = sdkerrors.Register(ModuleName, 1104, "Insufficient withdrawable emissions")
+	ErrInsufficientPoolBalance = sdkerrors.Register(ModuleName, 1105, "Insufficient balance in undistributed rewards pool")
+	ErrValidatorNotFound       = sdkerrors.Register(ModuleName, 1106, "Validator not found for observer")
+	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 1107, "Invalid amount")
 )
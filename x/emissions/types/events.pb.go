@@ -247,48 +247,122 @@ func (m *EventBlockEmissions) GetTssRewardsForBlock() string {
 	return ""
 }
 
+type EventEmissionWithdrawn struct {
+	MsgTypeUrl       string                                 `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ObserverAddress  string                                 `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Compounded       bool                                   `protobuf:"varint,4,opt,name=compounded,proto3" json:"compounded,omitempty"`
+	ValidatorAddress string                                 `protobuf:"bytes,5,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *EventEmissionWithdrawn) Reset()         { *m = EventEmissionWithdrawn{} }
+func (m *EventEmissionWithdrawn) String() string { return proto.CompactTextString(m) }
+func (*EventEmissionWithdrawn) ProtoMessage()    {}
+func (*EventEmissionWithdrawn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff510015c00ef7ae, []int{3}
+}
+func (m *EventEmissionWithdrawn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEmissionWithdrawn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEmissionWithdrawn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEmissionWithdrawn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEmissionWithdrawn.Merge(m, src)
+}
+func (m *EventEmissionWithdrawn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEmissionWithdrawn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEmissionWithdrawn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEmissionWithdrawn proto.InternalMessageInfo
+
+func (m *EventEmissionWithdrawn) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventEmissionWithdrawn) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventEmissionWithdrawn) GetCompounded() bool {
+	if m != nil {
+		return m.Compounded
+	}
+	return false
+}
+
+func (m *EventEmissionWithdrawn) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.emissions.EmissionType", EmissionType_name, EmissionType_value)
 	proto.RegisterType((*ObserverEmission)(nil), "zetachain.zetacore.emissions.ObserverEmission")
 	proto.RegisterType((*EventObserverEmissions)(nil), "zetachain.zetacore.emissions.EventObserverEmissions")
 	proto.RegisterType((*EventBlockEmissions)(nil), "zetachain.zetacore.emissions.EventBlockEmissions")
+	proto.RegisterType((*EventEmissionWithdrawn)(nil), "zetachain.zetacore.emissions.EventEmissionWithdrawn")
 }
 
 func init() { proto.RegisterFile("emissions/events.proto", fileDescriptor_ff510015c00ef7ae) }
 
 var fileDescriptor_ff510015c00ef7ae = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xbd, 0x69, 0x9b, 0x2a, 0x93, 0xd0, 0x46, 0x0b, 0x14, 0x2b, 0x20, 0x27, 0xca, 0x01,
-	0x42, 0x45, 0x6d, 0x28, 0x47, 0xc4, 0x81, 0x48, 0x8d, 0x04, 0x42, 0xaa, 0x64, 0xe0, 0xc2, 0xc5,
-	0x5a, 0xdb, 0x5b, 0xc7, 0xaa, 0xed, 0x8d, 0x76, 0x36, 0x81, 0xf2, 0x04, 0x1c, 0x79, 0x08, 0x0e,
-	0x3c, 0x4a, 0x8f, 0x3d, 0x21, 0xe0, 0x50, 0xa1, 0xe4, 0x45, 0x90, 0x37, 0x76, 0x12, 0x05, 0x14,
-	0x89, 0x53, 0x36, 0xbf, 0xbe, 0xdf, 0x33, 0xff, 0xce, 0x2c, 0x1c, 0xf0, 0x34, 0x46, 0x8c, 0x45,
-	0x86, 0x0e, 0x9f, 0xf0, 0x4c, 0xa1, 0x3d, 0x92, 0x42, 0x09, 0x7a, 0xef, 0x13, 0x57, 0x2c, 0x18,
-	0xb2, 0x38, 0xb3, 0xf5, 0x49, 0x48, 0x6e, 0x2f, 0xd0, 0xd6, 0xad, 0x48, 0x44, 0x42, 0x83, 0x4e,
-	0x7e, 0x9a, 0x7b, 0xba, 0xdf, 0x09, 0x34, 0x4f, 0x7d, 0xe4, 0x72, 0xc2, 0xe5, 0x49, 0xc1, 0xd2,
-	0x53, 0xb8, 0x51, 0xfa, 0x3c, 0x75, 0x31, 0xe2, 0x26, 0xe9, 0x90, 0xde, 0xde, 0xf1, 0xa1, 0xbd,
-	0xa9, 0x80, 0x5d, 0xda, 0xdf, 0x5e, 0x8c, 0xb8, 0xdb, 0xe0, 0x2b, 0xff, 0xe8, 0x43, 0x68, 0x8a,
-	0xa2, 0x88, 0xc7, 0xc2, 0x50, 0x72, 0x44, 0xb3, 0xd2, 0x21, 0xbd, 0x9a, 0xbb, 0x5f, 0xea, 0x2f,
-	0xe6, 0x32, 0x1d, 0x40, 0x95, 0xa5, 0x62, 0x9c, 0x29, 0x73, 0x2b, 0x07, 0xfa, 0xf6, 0xe5, 0x75,
-	0xdb, 0xf8, 0x75, 0xdd, 0xbe, 0x1f, 0xc5, 0x6a, 0x38, 0xf6, 0xed, 0x40, 0xa4, 0x4e, 0x20, 0x30,
-	0x15, 0x58, 0xfc, 0x1c, 0x61, 0x78, 0xee, 0xe4, 0x5d, 0xa2, 0xfd, 0x32, 0x53, 0x6e, 0xe1, 0xee,
-	0x7e, 0x26, 0x70, 0x70, 0x92, 0xdf, 0xce, 0x7a, 0x3a, 0xa4, 0x1d, 0x68, 0xa4, 0x18, 0xe9, 0x64,
-	0xde, 0x58, 0x26, 0x3a, 0x5d, 0xcd, 0x85, 0x14, 0xa3, 0xbc, 0xd9, 0x77, 0x32, 0xa1, 0xaf, 0xa1,
-	0xb6, 0xc8, 0x65, 0x56, 0x3a, 0x5b, 0xbd, 0xfa, 0xb1, 0xbd, 0x39, 0xfc, 0x7a, 0x15, 0x77, 0xf9,
-	0x81, 0xee, 0xcf, 0x0a, 0xdc, 0xd4, 0xad, 0xf4, 0x13, 0x11, 0x9c, 0xff, 0x4f, 0x1f, 0x6d, 0xa8,
-	0xfb, 0x22, 0x0b, 0xbd, 0x33, 0x16, 0x28, 0x21, 0x8b, 0x2b, 0x83, 0x5c, 0x1a, 0x68, 0x85, 0x3e,
-	0x80, 0x7d, 0xc9, 0x75, 0x65, 0x2c, 0x21, 0x7d, 0x6d, 0xee, 0x5e, 0x29, 0x2f, 0xc1, 0x70, 0x2c,
-	0x99, 0xca, 0x47, 0x5a, 0x80, 0xdb, 0x73, 0xb0, 0x94, 0x0b, 0xf0, 0x39, 0xdc, 0x9d, 0xb0, 0x24,
-	0x0e, 0x99, 0x12, 0xd2, 0x93, 0xfc, 0x03, 0x93, 0x21, 0x7a, 0x67, 0x42, 0x7a, 0x7e, 0xde, 0xbc,
-	0xb9, 0xa3, 0x4d, 0xe6, 0x02, 0x71, 0xe7, 0xc4, 0x40, 0x48, 0x1d, 0x8e, 0x3e, 0x83, 0xd6, 0x62,
-	0xd2, 0x7f, 0xbb, 0xab, 0xda, 0x7d, 0xa7, 0x24, 0xd6, 0xcd, 0x4f, 0xe0, 0xb6, 0x42, 0xfc, 0x87,
-	0x6f, 0x57, 0xfb, 0xa8, 0x42, 0x5c, 0xb3, 0x1c, 0x3e, 0x82, 0xc6, 0xea, 0xde, 0xd1, 0x1a, 0xec,
-	0xbc, 0x49, 0x18, 0x0e, 0x9b, 0x06, 0xad, 0xc3, 0x6e, 0x41, 0x37, 0x49, 0x6b, 0xfb, 0xdb, 0x57,
-	0x8b, 0xf4, 0x5f, 0x5d, 0x4e, 0x2d, 0x72, 0x35, 0xb5, 0xc8, 0xef, 0xa9, 0x45, 0xbe, 0xcc, 0x2c,
-	0xe3, 0x6a, 0x66, 0x19, 0x3f, 0x66, 0x96, 0xf1, 0xfe, 0xf1, 0xca, 0x7a, 0xe5, 0xe3, 0x3d, 0xd2,
-	0x93, 0x76, 0xca, 0x49, 0x3b, 0x1f, 0x9d, 0xe5, 0xa3, 0xd3, 0xcb, 0xe6, 0x57, 0xf5, 0x03, 0x7a,
-	0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x8d, 0x2d, 0x0d, 0x8e, 0x03, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xfb, 0x91, 0x92, 0x49, 0x68, 0xc3, 0x02, 0xc5, 0x0a, 0xc8, 0x89, 0x72, 0x80, 0x50,
+	0xa8, 0x0d, 0xe5, 0x88, 0x38, 0x10, 0xa9, 0x91, 0x40, 0x48, 0x95, 0x0c, 0x08, 0x89, 0x8b, 0xb5,
+	0xf1, 0x6e, 0x13, 0xab, 0xb1, 0x37, 0xda, 0xd9, 0xa4, 0x94, 0x5f, 0xc0, 0x91, 0x1f, 0xc1, 0x81,
+	0x9f, 0xd2, 0x63, 0x4f, 0x08, 0x38, 0x54, 0x28, 0xf9, 0x17, 0x9c, 0x90, 0x37, 0x5e, 0x27, 0x0a,
+	0xa8, 0x82, 0x0b, 0xa7, 0x6c, 0xc6, 0xef, 0x79, 0xde, 0x7b, 0x33, 0x5e, 0xd8, 0xe6, 0x71, 0x84,
+	0x18, 0x89, 0x04, 0x3d, 0x3e, 0xe6, 0x89, 0x42, 0x77, 0x28, 0x85, 0x12, 0xe4, 0xd6, 0x7b, 0xae,
+	0x68, 0xd8, 0xa7, 0x51, 0xe2, 0xea, 0x93, 0x90, 0xdc, 0xcd, 0xa1, 0xb5, 0x6b, 0x3d, 0xd1, 0x13,
+	0x1a, 0xe8, 0xa5, 0xa7, 0x19, 0xa7, 0xf9, 0xc5, 0x82, 0xea, 0x41, 0x17, 0xb9, 0x1c, 0x73, 0xb9,
+	0x9f, 0x61, 0xc9, 0x01, 0x5c, 0x36, 0xbc, 0x40, 0x9d, 0x0c, 0xb9, 0x6d, 0x35, 0xac, 0xd6, 0xe6,
+	0xde, 0x8e, 0x7b, 0x51, 0x03, 0xd7, 0xd0, 0x5f, 0x9d, 0x0c, 0xb9, 0x5f, 0xe1, 0x0b, 0xff, 0xc8,
+	0x5d, 0xa8, 0x8a, 0xac, 0x49, 0x40, 0x19, 0x93, 0x1c, 0xd1, 0x5e, 0x69, 0x58, 0xad, 0x92, 0xbf,
+	0x65, 0xea, 0x4f, 0x67, 0x65, 0xd2, 0x81, 0x22, 0x8d, 0xc5, 0x28, 0x51, 0xf6, 0x6a, 0x0a, 0x68,
+	0xbb, 0xa7, 0xe7, 0xf5, 0xc2, 0xf7, 0xf3, 0xfa, 0xed, 0x5e, 0xa4, 0xfa, 0xa3, 0xae, 0x1b, 0x8a,
+	0xd8, 0x0b, 0x05, 0xc6, 0x02, 0xb3, 0x9f, 0x5d, 0x64, 0x47, 0x5e, 0xaa, 0x12, 0xdd, 0x67, 0x89,
+	0xf2, 0x33, 0x76, 0xf3, 0x83, 0x05, 0xdb, 0xfb, 0x69, 0x3a, 0xcb, 0xee, 0x90, 0x34, 0xa0, 0x12,
+	0x63, 0x4f, 0x3b, 0x0b, 0x46, 0x72, 0xa0, 0xdd, 0x95, 0x7c, 0x88, 0xb1, 0x97, 0x8a, 0x7d, 0x2d,
+	0x07, 0xe4, 0x05, 0x94, 0x72, 0x5f, 0xf6, 0x4a, 0x63, 0xb5, 0x55, 0xde, 0x73, 0x2f, 0x36, 0xbf,
+	0xdc, 0xc5, 0x9f, 0xbf, 0xa0, 0xf9, 0x6d, 0x05, 0xae, 0x6a, 0x29, 0xed, 0x81, 0x08, 0x8f, 0xfe,
+	0x45, 0x47, 0x1d, 0xca, 0x5d, 0x91, 0xb0, 0xe0, 0x90, 0x86, 0x4a, 0xc8, 0x2c, 0x32, 0x48, 0x4b,
+	0x1d, 0x5d, 0x21, 0x77, 0x60, 0x4b, 0x72, 0xdd, 0x19, 0x0d, 0x48, 0xc7, 0xe6, 0x6f, 0x9a, 0xf2,
+	0x1c, 0xc8, 0x46, 0x92, 0xaa, 0x74, 0xa4, 0x19, 0x70, 0x6d, 0x06, 0x34, 0xe5, 0x0c, 0xf8, 0x04,
+	0x6e, 0x8e, 0xe9, 0x20, 0x62, 0x54, 0x09, 0x19, 0x48, 0x7e, 0x4c, 0x25, 0xc3, 0xe0, 0x50, 0xc8,
+	0xa0, 0x9b, 0x8a, 0xb7, 0xd7, 0x35, 0xc9, 0xce, 0x21, 0xfe, 0x0c, 0xd1, 0x11, 0x52, 0x9b, 0x23,
+	0x8f, 0xa1, 0x96, 0x4f, 0xfa, 0x77, 0x76, 0x51, 0xb3, 0x6f, 0x18, 0xc4, 0x32, 0xf9, 0x21, 0x5c,
+	0x57, 0x88, 0x7f, 0xe0, 0x6d, 0x68, 0x1e, 0x51, 0x88, 0x4b, 0x94, 0xe6, 0x4f, 0x33, 0x66, 0x13,
+	0xeb, 0x9b, 0x48, 0xf5, 0x99, 0xa4, 0xc7, 0xc9, 0x5f, 0xc4, 0xfb, 0xff, 0xd7, 0x92, 0x38, 0x00,
+	0xa1, 0x88, 0x87, 0x62, 0x94, 0x30, 0xce, 0xf4, 0x08, 0x2e, 0xf9, 0x0b, 0x15, 0x72, 0x0f, 0xae,
+	0xcc, 0xe3, 0x37, 0x9a, 0x66, 0xa1, 0x57, 0xf3, 0x07, 0x99, 0xa8, 0x9d, 0xfb, 0x50, 0x59, 0xfc,
+	0xe8, 0x48, 0x09, 0xd6, 0x5f, 0x0e, 0x28, 0xf6, 0xab, 0x05, 0x52, 0x86, 0x8d, 0x2c, 0xaa, 0xaa,
+	0x55, 0x5b, 0xfb, 0xfc, 0xc9, 0xb1, 0xda, 0xcf, 0x4f, 0x27, 0x8e, 0x75, 0x36, 0x71, 0xac, 0x1f,
+	0x13, 0xc7, 0xfa, 0x38, 0x75, 0x0a, 0x67, 0x53, 0xa7, 0xf0, 0x75, 0xea, 0x14, 0xde, 0x3e, 0x58,
+	0x30, 0x91, 0xee, 0xf6, 0xae, 0x5e, 0x73, 0xcf, 0xac, 0xb9, 0xf7, 0xce, 0x9b, 0xdf, 0x38, 0xda,
+	0x52, 0xb7, 0xa8, 0x6f, 0x8f, 0x47, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x9a, 0xfa, 0xe2,
+	0x8b, 0x04, 0x00, 0x00,
 }
 
 func (m *ObserverEmission) Marshal() (dAtA []byte, err error) {
@@ -452,6 +526,70 @@ func (m *EventBlockEmissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEmissionWithdrawn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEmissionWithdrawn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEmissionWithdrawn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Compounded {
+		i--
+		if m.Compounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -537,6 +675,32 @@ func (m *EventBlockEmissions) Size() (n int) {
 	return n
 }
 
+func (m *EventEmissionWithdrawn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Compounded {
+		n += 2
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1068,6 +1232,206 @@ func (m *EventBlockEmissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEmissionWithdrawn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEmissionWithdrawn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEmissionWithdrawn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compounded = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,10 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/zeta-chain/zetacore/common"
 	zetaObserverTypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -24,6 +26,7 @@ type ZetaObserverKeeper interface {
 	GetParams(ctx sdk.Context) (params zetaObserverTypes.Params)
 	GetCoreParamsByChainID(ctx sdk.Context, chainID int64) (params *zetaObserverTypes.CoreParams, found bool)
 	GetMaturedBallotList(ctx sdk.Context) []string
+	ClearMaturedBallots(ctx sdk.Context)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -38,4 +41,14 @@ type BankKeeper interface {
 
 type StakingKeeper interface {
 	BondedRatio(ctx sdk.Context) sdk.Dec
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	Delegate(
+		ctx sdk.Context,
+		delAddr sdk.AccAddress,
+		bondAmt sdkmath.Int,
+		tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator,
+		subtractAccount bool,
+	) (newShares sdk.Dec, err error)
 }
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func TestMsgWithdrawEmission_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgWithdrawEmission
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgWithdrawEmission{
+				Creator: sample.AccAddress(),
+				Amount:  sdkmath.NewInt(1000),
+			},
+		},
+		{
+			name: "valid message with compound",
+			msg: types.MsgWithdrawEmission{
+				Creator:  sample.AccAddress(),
+				Amount:   sdkmath.NewInt(1000),
+				Compound: true,
+			},
+		},
+		{
+			name: "valid message with amount nil",
+			msg: types.MsgWithdrawEmission{
+				Creator: sample.AccAddress(),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgWithdrawEmission{
+				Creator: "invalid_address",
+				Amount:  sdkmath.NewInt(1000),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "negative amount",
+			msg: types.MsgWithdrawEmission{
+				Creator: sample.AccAddress(),
+				Amount:  sdkmath.NewInt(-1),
+			},
+			err: types.ErrInvalidAmount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgWithdrawEmission_IsFullWithdrawal(t *testing.T) {
+	require.True(t, types.NewMsgWithdrawEmission(sample.AccAddress(), sdkmath.Int{}, false).IsFullWithdrawal())
+	require.True(t, types.NewMsgWithdrawEmission(sample.AccAddress(), sdkmath.ZeroInt(), false).IsFullWithdrawal())
+	require.False(t, types.NewMsgWithdrawEmission(sample.AccAddress(), sdkmath.NewInt(1), false).IsFullWithdrawal())
+}
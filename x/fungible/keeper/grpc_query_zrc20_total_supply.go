@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ZRC20TotalSupply returns the total supply of a ZRC20 contract registered as a foreign coin
+// and the part of the supply held by the fungible module and the gas stability pool
+func (k Keeper) ZRC20TotalSupply(
+	c context.Context,
+	req *types.QueryZRC20TotalSupplyRequest,
+) (*types.QueryZRC20TotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !ethcommon.IsHexAddress(req.Zrc20Address) {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	zrc20 := ethcommon.HexToAddress(req.Zrc20Address)
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetForeignCoins(ctx, zrc20.Hex()); !found {
+		return nil, status.Error(codes.NotFound, "foreign coin not found")
+	}
+	totalSupply, err := k.TotalSupplyZRC4(ctx, zrc20)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	protocolBalance := big.NewInt(0)
+	for _, account := range []ethcommon.Address{types.ModuleAddressEVM, types.GasStabilityPoolAddressEVM()} {
+		balance, err := k.BalanceOfZRC4(ctx, zrc20, account)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		protocolBalance.Add(protocolBalance, balance)
+	}
+
+	return &types.QueryZRC20TotalSupplyResponse{
+		TotalSupply:     totalSupply.String(),
+		ProtocolBalance: protocolBalance.String(),
+	}, nil
+}
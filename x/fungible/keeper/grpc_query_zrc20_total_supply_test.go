@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestKeeper_ZRC20TotalSupply(t *testing.T) {
+	t.Run("should return the total supply of the zrc20 and the balance held by the protocol", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		chain := common.DefaultChainsList()[0]
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chain.ChainId, "foobar", "foobar")
+		initialTotalSupply, err := k.TotalSupplyZRC4(ctx, zrc20)
+		require.NoError(t, err)
+		initialProtocolBalance, err := k.BalanceOfZRC4(ctx, zrc20, types.ModuleAddressEVM)
+		require.NoError(t, err)
+		_, err = k.DepositZRC20(ctx, zrc20, sample.EthAddress(), big.NewInt(500))
+		require.NoError(t, err)
+		_, err = k.DepositZRC20(ctx, zrc20, types.ModuleAddressEVM, big.NewInt(20))
+		require.NoError(t, err)
+		err = k.FundGasStabilityPool(ctx, chain.ChainId, big.NewInt(30))
+		require.NoError(t, err)
+
+		res, err := k.ZRC20TotalSupply(ctx, &types.QueryZRC20TotalSupplyRequest{
+			Zrc20Address: zrc20.Hex(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, new(big.Int).Add(initialTotalSupply, big.NewInt(550)).String(), res.TotalSupply)
+		require.Equal(t, new(big.Int).Add(initialProtocolBalance, big.NewInt(50)).String(), res.ProtocolBalance)
+	})
+
+	t.Run("should return error if address is invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+
+		_, err := k.ZRC20TotalSupply(ctx, &types.QueryZRC20TotalSupplyRequest{
+			Zrc20Address: "invalid",
+		})
+		require.ErrorContains(t, err, "invalid address")
+	})
+
+	t.Run("should return error if the zrc20 is not a foreign coin", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+
+		_, err := k.ZRC20TotalSupply(ctx, &types.QueryZRC20TotalSupplyRequest{
+			Zrc20Address: sample.EthAddress().Hex(),
+		})
+		require.ErrorContains(t, err, "foreign coin not found")
+	})
+}
@@ -784,6 +784,104 @@ func (m *QueryCodeHashResponse) GetCodeHash() string {
 	return ""
 }
 
+type QueryZRC20TotalSupplyRequest struct {
+	Zrc20Address string `protobuf:"bytes,1,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
+}
+
+func (m *QueryZRC20TotalSupplyRequest) Reset()         { *m = QueryZRC20TotalSupplyRequest{} }
+func (m *QueryZRC20TotalSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryZRC20TotalSupplyRequest) ProtoMessage()    {}
+func (*QueryZRC20TotalSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d671b6e9298b37cd, []int{16}
+}
+func (m *QueryZRC20TotalSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZRC20TotalSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZRC20TotalSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryZRC20TotalSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZRC20TotalSupplyRequest.Merge(m, src)
+}
+func (m *QueryZRC20TotalSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZRC20TotalSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZRC20TotalSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryZRC20TotalSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryZRC20TotalSupplyRequest) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
+	}
+	return ""
+}
+
+type QueryZRC20TotalSupplyResponse struct {
+	TotalSupply string `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// the balance of the zrc20 held by the fungible module and the gas stability pool,
+	// the withdraw fees of a gas zrc20 are held by the protocol and not backed by the assets of the foreign chain
+	ProtocolBalance string `protobuf:"bytes,2,opt,name=protocol_balance,json=protocolBalance,proto3" json:"protocol_balance,omitempty"`
+}
+
+func (m *QueryZRC20TotalSupplyResponse) Reset()         { *m = QueryZRC20TotalSupplyResponse{} }
+func (m *QueryZRC20TotalSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryZRC20TotalSupplyResponse) ProtoMessage()    {}
+func (*QueryZRC20TotalSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d671b6e9298b37cd, []int{17}
+}
+func (m *QueryZRC20TotalSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZRC20TotalSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZRC20TotalSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryZRC20TotalSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZRC20TotalSupplyResponse.Merge(m, src)
+}
+func (m *QueryZRC20TotalSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZRC20TotalSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZRC20TotalSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryZRC20TotalSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryZRC20TotalSupplyResponse) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return ""
+}
+
+func (m *QueryZRC20TotalSupplyResponse) GetProtocolBalance() string {
+	if m != nil {
+		return m.ProtocolBalance
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.fungible.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.fungible.QueryParamsResponse")
@@ -802,70 +900,79 @@ func init() {
 	proto.RegisterType((*QueryAllGasStabilityPoolBalanceResponse_Balance)(nil), "zetachain.zetacore.fungible.QueryAllGasStabilityPoolBalanceResponse.Balance")
 	proto.RegisterType((*QueryCodeHashRequest)(nil), "zetachain.zetacore.fungible.QueryCodeHashRequest")
 	proto.RegisterType((*QueryCodeHashResponse)(nil), "zetachain.zetacore.fungible.QueryCodeHashResponse")
+	proto.RegisterType((*QueryZRC20TotalSupplyRequest)(nil), "zetachain.zetacore.fungible.QueryZRC20TotalSupplyRequest")
+	proto.RegisterType((*QueryZRC20TotalSupplyResponse)(nil), "zetachain.zetacore.fungible.QueryZRC20TotalSupplyResponse")
 }
 
 func init() { proto.RegisterFile("fungible/query.proto", fileDescriptor_d671b6e9298b37cd) }
 
 var fileDescriptor_d671b6e9298b37cd = []byte{
-	// 927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xa6, 0x34, 0x49, 0x5f, 0x43, 0x91, 0x06, 0x57, 0x84, 0x4d, 0xbb, 0x81, 0x69, 0x69,
-	0x4b, 0x28, 0xbb, 0x49, 0x8a, 0x44, 0x09, 0x11, 0xc2, 0x31, 0x6a, 0xa8, 0xc4, 0x21, 0x38, 0x17,
-	0xe0, 0x62, 0x8d, 0xd7, 0x93, 0xf5, 0x4a, 0xeb, 0x1d, 0xc7, 0xb3, 0x8e, 0x6a, 0xac, 0x5c, 0xb8,
-	0x72, 0xa9, 0xc4, 0x4f, 0xe0, 0x1f, 0x70, 0xe1, 0xc2, 0x0f, 0xe8, 0xb1, 0x12, 0x12, 0x82, 0x0b,
-	0x02, 0x87, 0x1f, 0x82, 0x3c, 0xfb, 0x66, 0xe3, 0x35, 0xbb, 0xde, 0xc5, 0xb9, 0xed, 0xcc, 0xbc,
-	0xef, 0xbd, 0xef, 0x7b, 0xf3, 0xfc, 0x8d, 0xa1, 0x72, 0xdc, 0x0f, 0x3d, 0xbf, 0x19, 0x70, 0xe7,
-	0xa4, 0xcf, 0x7b, 0x03, 0xbb, 0xdb, 0x13, 0x91, 0x20, 0xeb, 0xdf, 0xf2, 0x88, 0xb9, 0x6d, 0xe6,
-	0x87, 0xb6, 0xfa, 0x12, 0x3d, 0x6e, 0xeb, 0x40, 0x73, 0xd3, 0x15, 0xb2, 0x23, 0xa4, 0xd3, 0x64,
-	0x12, 0x51, 0xce, 0xe9, 0x76, 0x93, 0x47, 0x6c, 0xdb, 0xe9, 0x32, 0xcf, 0x0f, 0x59, 0xe4, 0x8b,
-	0x30, 0x4e, 0x64, 0xde, 0x4a, 0xd2, 0x1f, 0x8b, 0x1e, 0xf7, 0xbd, 0xb0, 0xe1, 0x0a, 0x3f, 0x94,
-	0x78, 0x7a, 0x33, 0x39, 0xed, 0xb2, 0x1e, 0xeb, 0xe8, 0x6d, 0x2b, 0xd9, 0x96, 0x03, 0x19, 0xf1,
-	0x4e, 0xc3, 0x15, 0x61, 0xd4, 0x63, 0x6e, 0x84, 0xe7, 0x15, 0x4f, 0x78, 0x42, 0x7d, 0x3a, 0xe3,
-	0x2f, 0x5d, 0xca, 0x13, 0xc2, 0x0b, 0xb8, 0xc3, 0xba, 0xbe, 0xc3, 0xc2, 0x50, 0x44, 0x8a, 0x07,
-	0xe6, 0xa4, 0x15, 0x20, 0x5f, 0x8e, 0xa9, 0x1e, 0xaa, 0x42, 0x75, 0x7e, 0xd2, 0xe7, 0x32, 0xa2,
-	0x5f, 0xc1, 0xeb, 0xa9, 0x5d, 0xd9, 0x15, 0xa1, 0xe4, 0xa4, 0x0a, 0x4b, 0x31, 0xa1, 0x35, 0xe3,
-	0x2d, 0xe3, 0xc1, 0xf5, 0x9d, 0x3b, 0xf6, 0x8c, 0x7e, 0xd8, 0x31, 0x78, 0xff, 0x95, 0x17, 0x7f,
-	0x6e, 0x2c, 0xd4, 0x11, 0x48, 0x1f, 0xc1, 0xba, 0xca, 0x7c, 0xc0, 0xa3, 0x27, 0xb1, 0xf2, 0xda,
-	0x58, 0x38, 0x16, 0x26, 0x15, 0xb8, 0xea, 0x87, 0x2d, 0xfe, 0x4c, 0x15, 0xb8, 0x56, 0x8f, 0x17,
-	0x54, 0xc2, 0xad, 0x6c, 0x10, 0xf2, 0x3a, 0x82, 0xd5, 0xe3, 0x89, 0x7d, 0x64, 0xf7, 0xee, 0x4c,
-	0x76, 0x93, 0x89, 0x90, 0x63, 0x2a, 0x09, 0xe5, 0xc8, 0xb4, 0x1a, 0x04, 0x59, 0x4c, 0x9f, 0x00,
-	0x5c, 0xdc, 0x2a, 0x56, 0xbc, 0x67, 0xc7, 0x23, 0x60, 0x8f, 0x47, 0xc0, 0x8e, 0x07, 0x07, 0x47,
-	0xc0, 0x3e, 0x64, 0x1e, 0x47, 0x6c, 0x7d, 0x02, 0x49, 0x7f, 0x31, 0x50, 0xdc, 0x7f, 0xea, 0xe4,
-	0x8a, 0xbb, 0x72, 0x69, 0x71, 0xe4, 0x20, 0xc5, 0x7e, 0x51, 0xb1, 0xbf, 0x5f, 0xc8, 0x3e, 0x66,
-	0x94, 0xa2, 0xbf, 0x01, 0xb7, 0xf5, 0xd5, 0x1c, 0xa9, 0xa1, 0xac, 0xe1, 0x4c, 0xea, 0x51, 0x1a,
-	0x82, 0x95, 0x17, 0x80, 0x02, 0xbf, 0x86, 0x1b, 0xe9, 0x13, 0xec, 0xe6, 0x7b, 0x33, 0x25, 0xa6,
-	0x21, 0x28, 0x72, 0x2a, 0x11, 0x7d, 0x1b, 0x36, 0x74, 0xf1, 0x03, 0x26, 0x8f, 0x22, 0xd6, 0xf4,
-	0x03, 0x3f, 0x1a, 0x1c, 0x0a, 0x11, 0x54, 0x5b, 0xad, 0x1e, 0x97, 0x92, 0x9e, 0xc0, 0xfd, 0x82,
-	0x90, 0x84, 0xe8, 0x3b, 0x70, 0x23, 0xee, 0x50, 0x83, 0xc5, 0x27, 0x38, 0xa5, 0xaf, 0xc6, 0xbb,
-	0x18, 0x4e, 0x36, 0xe0, 0x3a, 0x3f, 0xed, 0x24, 0x31, 0x8b, 0x2a, 0x06, 0xf8, 0x69, 0x47, 0x97,
-	0xdc, 0xcb, 0x67, 0xb5, 0xcf, 0x02, 0x16, 0xba, 0x9c, 0xbc, 0x09, 0x2b, 0x4a, 0x78, 0xc3, 0x6f,
-	0xa9, 0x22, 0x57, 0xea, 0xcb, 0x6a, 0xfd, 0xb4, 0x45, 0x6b, 0xf9, 0x84, 0x11, 0x9d, 0x10, 0x5e,
-	0x83, 0xe5, 0x66, 0xbc, 0x85, 0x2c, 0xf4, 0x32, 0x69, 0x4c, 0x35, 0x08, 0x72, 0x92, 0xd0, 0x3f,
-	0x0c, 0x2c, 0x94, 0x1f, 0x93, 0x14, 0x0a, 0x61, 0x05, 0x33, 0xeb, 0xf9, 0xfc, 0x62, 0xe6, 0xe5,
-	0x95, 0xcc, 0x6b, 0xe3, 0x1a, 0x6f, 0x37, 0xa9, 0x61, 0x7e, 0x02, 0xcb, 0xc5, 0x9d, 0x9a, 0x21,
-	0x7f, 0x0b, 0x2a, 0x8a, 0x42, 0x4d, 0xb4, 0xf8, 0xe7, 0x4c, 0xb6, 0xf5, 0x8f, 0x7a, 0x0d, 0x96,
-	0xd3, 0x57, 0xab, 0x97, 0xf4, 0x03, 0xb8, 0x39, 0x85, 0x40, 0xe9, 0xeb, 0x70, 0xcd, 0x15, 0x2d,
-	0xde, 0x68, 0x33, 0xd9, 0x46, 0xd0, 0x8a, 0x8b, 0x41, 0x3b, 0xdf, 0xaf, 0xc2, 0x55, 0x05, 0x23,
-	0xcf, 0x0d, 0x58, 0x8a, 0x0d, 0x91, 0x38, 0xc5, 0xad, 0x49, 0xb9, 0xb1, 0xb9, 0x55, 0x1e, 0x10,
-	0x93, 0xa2, 0x77, 0xbe, 0xfb, 0xf5, 0x9f, 0x1f, 0x16, 0x6f, 0x93, 0x75, 0x67, 0x1c, 0xff, 0xbe,
-	0x82, 0x3a, 0x53, 0x8f, 0x0a, 0xf9, 0xd9, 0x80, 0xd5, 0x49, 0xa3, 0x20, 0x8f, 0x8b, 0xeb, 0x64,
-	0xdb, 0xb6, 0xf9, 0xd1, 0x1c, 0x48, 0xa4, 0xba, 0xa3, 0xa8, 0x3e, 0x24, 0x9b, 0x99, 0x54, 0x53,
-	0xaf, 0xa3, 0x33, 0x54, 0xcf, 0xc1, 0x19, 0xf9, 0xc9, 0x80, 0xd7, 0x26, 0x93, 0x55, 0x83, 0xa0,
-	0x0c, 0xf9, 0x6c, 0x27, 0x2f, 0x43, 0x3e, 0xc7, 0x9b, 0xe9, 0xa6, 0x22, 0x7f, 0x97, 0xd0, 0x62,
-	0xf2, 0xe3, 0x76, 0x4f, 0xd9, 0x13, 0xd9, 0x2d, 0xd5, 0xb6, 0x4c, 0x5f, 0x35, 0x3f, 0x9e, 0x0b,
-	0x8b, 0xbc, 0x1f, 0x2a, 0xde, 0xf7, 0xc8, 0xdd, 0x4c, 0xde, 0x53, 0xff, 0x2e, 0xc8, 0x6f, 0x06,
-	0xbc, 0x91, 0xe3, 0x8d, 0x64, 0xaf, 0x14, 0x8d, 0x1c, 0xb4, 0xf9, 0xd9, 0x65, 0xd0, 0x89, 0x9a,
-	0x0f, 0x95, 0x9a, 0x6d, 0xe2, 0x64, 0xaa, 0xf1, 0x98, 0x6c, 0x48, 0x0d, 0x6f, 0x74, 0x85, 0x08,
-	0xb4, 0x35, 0x93, 0xbf, 0x33, 0x84, 0x69, 0x5f, 0x99, 0x4f, 0x18, 0xa2, 0xe7, 0x14, 0x36, 0x65,
-	0x7f, 0x74, 0x5f, 0x09, 0xdb, 0x23, 0xbb, 0x65, 0x85, 0xa1, 0xbf, 0x39, 0x43, 0x6d, 0x89, 0x67,
-	0x64, 0x64, 0x80, 0x99, 0x53, 0x67, 0xfc, 0xb3, 0xd9, 0xbb, 0x8c, 0x4f, 0x97, 0x91, 0x59, 0xec,
-	0xf2, 0xf4, 0x53, 0x25, 0x73, 0x97, 0x3c, 0x9e, 0x94, 0xa9, 0xd3, 0x95, 0xd1, 0x4b, 0x7e, 0x34,
-	0x60, 0x45, 0x3b, 0x33, 0xd9, 0x2e, 0x26, 0x35, 0xe5, 0xfb, 0xe6, 0xce, 0xff, 0x81, 0x20, 0xeb,
-	0x2d, 0xc5, 0x7a, 0x93, 0x3c, 0xc8, 0xbc, 0x9c, 0xe4, 0x4d, 0x70, 0x86, 0x38, 0x6d, 0x67, 0xfb,
-	0x4f, 0x5f, 0x8c, 0x2c, 0xe3, 0xe5, 0xc8, 0x32, 0xfe, 0x1a, 0x59, 0xc6, 0xf3, 0x73, 0x6b, 0xe1,
-	0xe5, 0xb9, 0xb5, 0xf0, 0xfb, 0xb9, 0xb5, 0xf0, 0x8d, 0xe3, 0xf9, 0x51, 0xbb, 0xdf, 0xb4, 0x5d,
-	0xd1, 0xc9, 0xec, 0xc1, 0xb3, 0x8b, 0xc4, 0xd1, 0xa0, 0xcb, 0x65, 0x73, 0x49, 0xfd, 0x7b, 0x7f,
-	0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x51, 0x58, 0xac, 0xa7, 0x0c, 0x00, 0x00,
+	// 1036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0x3b, 0xd6, 0x76, 0xa7, 0xed, 0x36, 0x5d, 0x32, 0x51, 0xdc, 0x2d, 0x65, 0xee, 0xd8,
+	0x9f, 0x32, 0xec, 0x34, 0x43, 0xda, 0x56, 0x2a, 0x20, 0x0d, 0x5a, 0x99, 0xc4, 0x43, 0x49, 0x79,
+	0x80, 0xbd, 0x44, 0x37, 0xce, 0xad, 0x63, 0xc9, 0xf1, 0x4d, 0x73, 0x9d, 0x6a, 0x59, 0xd4, 0x17,
+	0x3e, 0xc1, 0x24, 0x3e, 0x02, 0xdf, 0x80, 0x17, 0x5e, 0xf8, 0x00, 0x13, 0x4f, 0x93, 0x90, 0xd0,
+	0x78, 0x41, 0xd0, 0xf2, 0x41, 0x50, 0xae, 0xcf, 0x75, 0x63, 0xcf, 0x4e, 0x4c, 0xfa, 0x16, 0x1f,
+	0x9f, 0xdf, 0x39, 0xbf, 0xdf, 0xbd, 0xc7, 0xe7, 0x17, 0x28, 0x1c, 0xf4, 0x7c, 0xc7, 0x6d, 0x78,
+	0xcc, 0x3a, 0xec, 0xb1, 0x6e, 0xdf, 0xec, 0x74, 0x79, 0xc0, 0xc9, 0xea, 0x0b, 0x16, 0x50, 0xbb,
+	0x45, 0x5d, 0xdf, 0x94, 0xbf, 0x78, 0x97, 0x99, 0x2a, 0x51, 0xdf, 0xb0, 0xb9, 0x68, 0x73, 0x61,
+	0x35, 0xa8, 0x40, 0x94, 0x75, 0xb4, 0xd9, 0x60, 0x01, 0xdd, 0xb4, 0x3a, 0xd4, 0x71, 0x7d, 0x1a,
+	0xb8, 0xdc, 0x0f, 0x0b, 0xe9, 0xd7, 0xa3, 0xf2, 0x07, 0xbc, 0xcb, 0x5c, 0xc7, 0xaf, 0xdb, 0xdc,
+	0xf5, 0x05, 0xbe, 0xbd, 0x16, 0xbd, 0xed, 0xd0, 0x2e, 0x6d, 0xab, 0x70, 0x31, 0x0a, 0x8b, 0xbe,
+	0x08, 0x58, 0xbb, 0x6e, 0x73, 0x3f, 0xe8, 0x52, 0x3b, 0xc0, 0xf7, 0x05, 0x87, 0x3b, 0x5c, 0xfe,
+	0xb4, 0x86, 0xbf, 0x54, 0x2b, 0x87, 0x73, 0xc7, 0x63, 0x16, 0xed, 0xb8, 0x16, 0xf5, 0x7d, 0x1e,
+	0x48, 0x1e, 0x58, 0xd3, 0x28, 0x00, 0xf9, 0x66, 0x48, 0x75, 0x4f, 0x36, 0xaa, 0xb1, 0xc3, 0x1e,
+	0x13, 0x81, 0xf1, 0x1d, 0xbc, 0x1b, 0x8b, 0x8a, 0x0e, 0xf7, 0x05, 0x23, 0x15, 0x98, 0x0b, 0x09,
+	0xad, 0x68, 0x1f, 0x68, 0x77, 0x17, 0xcb, 0xeb, 0xe6, 0x98, 0xf3, 0x30, 0x43, 0xf0, 0xce, 0x3b,
+	0xaf, 0xfe, 0x5a, 0x9b, 0xa9, 0x21, 0xd0, 0x78, 0x00, 0xab, 0xb2, 0xf2, 0x2e, 0x0b, 0x9e, 0x84,
+	0xca, 0xab, 0x43, 0xe1, 0xd8, 0x98, 0x14, 0xe0, 0xa2, 0xeb, 0x37, 0xd9, 0x73, 0xd9, 0xe0, 0x52,
+	0x2d, 0x7c, 0x30, 0x04, 0x5c, 0x4f, 0x07, 0x21, 0xaf, 0x7d, 0x58, 0x3a, 0x18, 0x89, 0x23, 0xbb,
+	0x7b, 0x63, 0xd9, 0x8d, 0x16, 0x42, 0x8e, 0xb1, 0x22, 0x06, 0x43, 0xa6, 0x15, 0xcf, 0x4b, 0x63,
+	0xfa, 0x04, 0xe0, 0xec, 0x56, 0xb1, 0xe3, 0x6d, 0x33, 0x1c, 0x01, 0x73, 0x38, 0x02, 0x66, 0x38,
+	0x38, 0x38, 0x02, 0xe6, 0x1e, 0x75, 0x18, 0x62, 0x6b, 0x23, 0x48, 0xe3, 0x57, 0x0d, 0xc5, 0xbd,
+	0xd5, 0x27, 0x53, 0xdc, 0x85, 0x73, 0x8b, 0x23, 0xbb, 0x31, 0xf6, 0xb3, 0x92, 0xfd, 0x9d, 0x89,
+	0xec, 0x43, 0x46, 0x31, 0xfa, 0x6b, 0x70, 0x43, 0x5d, 0xcd, 0xbe, 0x1c, 0xca, 0x2a, 0xce, 0xa4,
+	0x1a, 0xa5, 0x01, 0x14, 0xb3, 0x12, 0x50, 0xe0, 0xf7, 0x70, 0x39, 0xfe, 0x06, 0x4f, 0xf3, 0xa3,
+	0xb1, 0x12, 0xe3, 0x10, 0x14, 0x99, 0x28, 0x64, 0xdc, 0x84, 0x35, 0xd5, 0x7c, 0x97, 0x8a, 0xfd,
+	0x80, 0x36, 0x5c, 0xcf, 0x0d, 0xfa, 0x7b, 0x9c, 0x7b, 0x95, 0x66, 0xb3, 0xcb, 0x84, 0x30, 0x0e,
+	0xe1, 0xce, 0x84, 0x94, 0x88, 0xe8, 0x87, 0x70, 0x39, 0x3c, 0xa1, 0x3a, 0x0d, 0xdf, 0xe0, 0x94,
+	0x2e, 0x87, 0x51, 0x4c, 0x27, 0x6b, 0xb0, 0xc8, 0x8e, 0xda, 0x51, 0xce, 0xac, 0xcc, 0x01, 0x76,
+	0xd4, 0x56, 0x2d, 0xb7, 0xb3, 0x59, 0xed, 0x50, 0x8f, 0xfa, 0x36, 0x23, 0xef, 0xc3, 0x82, 0x14,
+	0x5e, 0x77, 0x9b, 0xb2, 0xc9, 0x85, 0xda, 0xbc, 0x7c, 0x7e, 0xda, 0x34, 0xaa, 0xd9, 0x84, 0x11,
+	0x1d, 0x11, 0x5e, 0x81, 0xf9, 0x46, 0x18, 0x42, 0x16, 0xea, 0x31, 0x3a, 0x98, 0x8a, 0xe7, 0x65,
+	0x14, 0x31, 0xfe, 0xd4, 0xb0, 0x51, 0x76, 0x4e, 0xd4, 0xc8, 0x87, 0x05, 0xac, 0xac, 0xe6, 0xf3,
+	0xeb, 0xb1, 0x97, 0x97, 0xb3, 0xae, 0x89, 0xcf, 0x78, 0xbb, 0x51, 0x0f, 0xfd, 0x33, 0x98, 0x9f,
+	0x7c, 0x52, 0x63, 0xe4, 0x97, 0xa0, 0x20, 0x29, 0x54, 0x79, 0x93, 0x7d, 0x45, 0x45, 0x4b, 0x7d,
+	0xd4, 0x2b, 0x30, 0x1f, 0xbf, 0x5a, 0xf5, 0x68, 0x7c, 0x02, 0xd7, 0x12, 0x08, 0x94, 0xbe, 0x0a,
+	0x97, 0x6c, 0xde, 0x64, 0xf5, 0x16, 0x15, 0x2d, 0x04, 0x2d, 0xd8, 0x98, 0x64, 0x54, 0xf1, 0xdb,
+	0x7e, 0x56, 0xab, 0x96, 0x4b, 0xdf, 0xf2, 0x80, 0x7a, 0xfb, 0xbd, 0x4e, 0xc7, 0xeb, 0xab, 0x7e,
+	0xeb, 0xb0, 0xfc, 0xa2, 0x6b, 0x97, 0x4b, 0x89, 0x81, 0x5a, 0x92, 0x41, 0x35, 0x2e, 0x6d, 0xfc,
+	0xc4, 0xde, 0x2e, 0x82, 0x14, 0x6e, 0xc2, 0x52, 0x30, 0x0c, 0xd7, 0x85, 0x8c, 0x63, 0x91, 0xc5,
+	0xe0, 0x2c, 0x95, 0xdc, 0x83, 0xab, 0x72, 0xdf, 0xdb, 0xdc, 0xab, 0xc7, 0xcf, 0xe4, 0x8a, 0x8a,
+	0xe3, 0x81, 0x96, 0xdf, 0x2c, 0xc3, 0x45, 0xd9, 0x8f, 0xbc, 0xd4, 0x60, 0x2e, 0x5c, 0xe2, 0xc4,
+	0x9a, 0x7c, 0x9d, 0x31, 0x07, 0xd1, 0x4b, 0xf9, 0x01, 0xa1, 0x0a, 0x63, 0xfd, 0x87, 0xdf, 0xff,
+	0xfd, 0x71, 0xf6, 0x06, 0x59, 0xb5, 0x86, 0xf9, 0x1f, 0x4b, 0xa8, 0x95, 0x30, 0x42, 0xf2, 0x8b,
+	0x06, 0x4b, 0xa3, 0xcb, 0x8d, 0x3c, 0x9a, 0xdc, 0x27, 0xdd, 0x6a, 0xf4, 0xc7, 0x53, 0x20, 0x91,
+	0x6a, 0x59, 0x52, 0xbd, 0x4f, 0x36, 0x52, 0xa9, 0xc6, 0x1c, 0xdd, 0x1a, 0x48, 0x0b, 0x3b, 0x26,
+	0x3f, 0x6b, 0x70, 0x65, 0xb4, 0x58, 0xc5, 0xf3, 0xf2, 0x90, 0x4f, 0x77, 0x9f, 0x3c, 0xe4, 0x33,
+	0xfc, 0xc4, 0xd8, 0x90, 0xe4, 0x6f, 0x11, 0x63, 0x32, 0xf9, 0xe1, 0x71, 0x27, 0x56, 0x2a, 0xd9,
+	0xca, 0x75, 0x6c, 0xa9, 0x5e, 0xa0, 0x7f, 0x3a, 0x15, 0x16, 0x79, 0xdf, 0x97, 0xbc, 0x6f, 0x93,
+	0x5b, 0xa9, 0xbc, 0x13, 0xff, 0x88, 0xc8, 0x1f, 0x1a, 0xbc, 0x97, 0xb1, 0xcf, 0xc9, 0x76, 0x2e,
+	0x1a, 0x19, 0x68, 0xfd, 0xcb, 0xf3, 0xa0, 0x23, 0x35, 0x0f, 0xa5, 0x9a, 0x4d, 0x62, 0xa5, 0xaa,
+	0x71, 0xa8, 0xa8, 0x0b, 0x05, 0xaf, 0x77, 0x38, 0xf7, 0xd4, 0x86, 0x20, 0xff, 0xa4, 0x08, 0x53,
+	0xbb, 0x70, 0x3a, 0x61, 0x88, 0x9e, 0x52, 0x58, 0x62, 0x65, 0x1b, 0x3b, 0x52, 0xd8, 0x36, 0xd9,
+	0xca, 0x2b, 0x0c, 0xd7, 0x91, 0x35, 0x50, 0x6b, 0xfc, 0x98, 0x9c, 0x68, 0xa0, 0x67, 0xf4, 0x19,
+	0x7e, 0x36, 0xdb, 0xe7, 0xf1, 0x96, 0x3c, 0x32, 0x27, 0x3b, 0x93, 0xf1, 0x85, 0x94, 0xb9, 0x45,
+	0x1e, 0x8d, 0xca, 0x54, 0xe5, 0xf2, 0xe8, 0x25, 0x3f, 0x69, 0xb0, 0xa0, 0xdc, 0x84, 0x6c, 0x4e,
+	0x26, 0x95, 0xf0, 0x2a, 0xbd, 0xfc, 0x7f, 0x20, 0xc8, 0xba, 0x24, 0x59, 0x6f, 0x90, 0xbb, 0xa9,
+	0x97, 0x13, 0xf9, 0x98, 0x35, 0xc0, 0x69, 0x3b, 0x26, 0xbf, 0x69, 0x70, 0x35, 0x69, 0x3c, 0x24,
+	0xc7, 0xf6, 0xc9, 0x70, 0x3c, 0x7d, 0x6b, 0x1a, 0x28, 0xb2, 0xff, 0x5c, 0xb2, 0x7f, 0x4c, 0x1e,
+	0xa6, 0xb2, 0x0f, 0x8d, 0x74, 0xd4, 0x08, 0xad, 0x41, 0xcc, 0x5c, 0x8f, 0x77, 0x9e, 0xbe, 0x3a,
+	0x29, 0x6a, 0xaf, 0x4f, 0x8a, 0xda, 0xdf, 0x27, 0x45, 0xed, 0xe5, 0x69, 0x71, 0xe6, 0xf5, 0x69,
+	0x71, 0xe6, 0xcd, 0x69, 0x71, 0xe6, 0x99, 0xe5, 0xb8, 0x41, 0xab, 0xd7, 0x30, 0x6d, 0xde, 0x4e,
+	0xbd, 0xd0, 0xe7, 0x67, 0x7d, 0x82, 0x7e, 0x87, 0x89, 0xc6, 0x9c, 0xb4, 0xcd, 0x07, 0xff, 0x05,
+	0x00, 0x00, 0xff, 0xff, 0x8e, 0x1e, 0xc6, 0xd0, 0x28, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -896,6 +1003,8 @@ type QueryClient interface {
 	GasStabilityPoolBalanceAll(ctx context.Context, in *QueryAllGasStabilityPoolBalance, opts ...grpc.CallOption) (*QueryAllGasStabilityPoolBalanceResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(ctx context.Context, in *QueryCodeHashRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
+	// Queries the total supply of a ZRC20 contract.
+	ZRC20TotalSupply(ctx context.Context, in *QueryZRC20TotalSupplyRequest, opts ...grpc.CallOption) (*QueryZRC20TotalSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -978,6 +1087,15 @@ func (c *queryClient) CodeHash(ctx context.Context, in *QueryCodeHashRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ZRC20TotalSupply(ctx context.Context, in *QueryZRC20TotalSupplyRequest, opts ...grpc.CallOption) (*QueryZRC20TotalSupplyResponse, error) {
+	out := new(QueryZRC20TotalSupplyResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Query/ZRC20TotalSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -996,6 +1114,8 @@ type QueryServer interface {
 	GasStabilityPoolBalanceAll(context.Context, *QueryAllGasStabilityPoolBalance) (*QueryAllGasStabilityPoolBalanceResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(context.Context, *QueryCodeHashRequest) (*QueryCodeHashResponse, error)
+	// Queries the total supply of a ZRC20 contract.
+	ZRC20TotalSupply(context.Context, *QueryZRC20TotalSupplyRequest) (*QueryZRC20TotalSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1026,6 +1146,9 @@ func (*UnimplementedQueryServer) GasStabilityPoolBalanceAll(ctx context.Context,
 func (*UnimplementedQueryServer) CodeHash(ctx context.Context, req *QueryCodeHashRequest) (*QueryCodeHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CodeHash not implemented")
 }
+func (*UnimplementedQueryServer) ZRC20TotalSupply(ctx context.Context, req *QueryZRC20TotalSupplyRequest) (*QueryZRC20TotalSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRC20TotalSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1175,6 +1298,24 @@ func _Query_CodeHash_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ZRC20TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZRC20TotalSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ZRC20TotalSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Query/ZRC20TotalSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZRC20TotalSupply(ctx, req.(*QueryZRC20TotalSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.fungible.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1211,6 +1352,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CodeHash",
 			Handler:    _Query_CodeHash_Handler,
 		},
+		{
+			MethodName: "ZRC20TotalSupply",
+			Handler:    _Query_ZRC20TotalSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fungible/query.proto",
@@ -1748,6 +1893,73 @@ func (m *QueryCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryZRC20TotalSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZRC20TotalSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZRC20TotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryZRC20TotalSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZRC20TotalSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZRC20TotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolBalance) > 0 {
+		i -= len(m.ProtocolBalance)
+		copy(dAtA[i:], m.ProtocolBalance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolBalance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TotalSupply) > 0 {
+		i -= len(m.TotalSupply)
+		copy(dAtA[i:], m.TotalSupply)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalSupply)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1972,6 +2184,36 @@ func (m *QueryCodeHashResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryZRC20TotalSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZRC20TotalSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TotalSupply)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProtocolBalance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3329,6 +3571,202 @@ func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryZRC20TotalSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZRC20TotalSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZRC20TotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZRC20TotalSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZRC20TotalSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZRC20TotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,9 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneBallotBacklog(ctx, types.BallotBacklogPruneLimit)
+	k.ReEnableCrosschainFlags(ctx)
+
 	lastBlockObserverCount, found := k.GetLastObserverCount(ctx)
 	if !found {
 		ctx.Logger().Error("LastBlockObserverCount not found at height", ctx.BlockHeight())
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +12,13 @@ import (
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
+const (
+	FlagDisableInboundChains  = "disable-inbound-chains"
+	FlagDisableOutboundChains = "disable-outbound-chains"
+	FlagEnableChains          = "enable-chains"
+	FlagReEnableHeight        = "re-enable-height"
+)
+
 func CmdUpdateCrosschainFlags() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-crosschain-flags [is-inbound-enabled] [is-outbound-enabled]",
@@ -30,6 +39,25 @@ func CmdUpdateCrosschainFlags() *cobra.Command {
 				return err
 			}
 			msg := types.NewMsgUpdateCrosschainFlags(clientCtx.GetFromAddress().String(), argIsInboundEnabled, arsIsOutboundEnabled)
+
+			// the current chain overrides are read so the direction not updated by the command keeps its value
+			var currentFlags types.CrosschainFlags
+			if cmd.Flags().Changed(FlagDisableInboundChains) || cmd.Flags().Changed(FlagDisableOutboundChains) {
+				res, err := types.NewQueryClient(clientCtx).CrosschainFlags(cmd.Context(), &types.QueryGetCrosschainFlagsRequest{})
+				if err != nil {
+					return err
+				}
+				currentFlags = res.CrosschainFlags
+			}
+			msg.ChainFlags, err = parseChainFlags(cmd, currentFlags)
+			if err != nil {
+				return err
+			}
+			msg.ReEnableHeight, err = cmd.Flags().GetInt64(FlagReEnableHeight)
+			if err != nil {
+				return err
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -37,7 +65,63 @@ func CmdUpdateCrosschainFlags() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64Slice(FlagDisableInboundChains, []int64{}, "chain ids to disable inbound for")
+	cmd.Flags().Int64Slice(FlagDisableOutboundChains, []int64{}, "chain ids to disable outbound for")
+	cmd.Flags().Int64Slice(FlagEnableChains, []int64{}, "chain ids to remove the inbound and outbound overrides for")
+	cmd.Flags().Int64(FlagReEnableHeight, 0, "block height at which the inbound and outbound disabled by the transaction are enabled again")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseChainFlags returns the chain overrides defined by the command flags
+// the direction of a chain not disabled by the command keeps its value in the current flags
+func parseChainFlags(cmd *cobra.Command, currentFlags types.CrosschainFlags) ([]types.ChainCrosschainFlags, error) {
+	disableInbound, err := cmd.Flags().GetInt64Slice(FlagDisableInboundChains)
+	if err != nil {
+		return nil, err
+	}
+	disableOutbound, err := cmd.Flags().GetInt64Slice(FlagDisableOutboundChains)
+	if err != nil {
+		return nil, err
+	}
+	enable, err := cmd.Flags().GetInt64Slice(FlagEnableChains)
+	if err != nil {
+		return nil, err
+	}
+
+	chainFlags := make(map[int64]*types.ChainCrosschainFlags)
+	get := func(chainID int64) *types.ChainCrosschainFlags {
+		if _, ok := chainFlags[chainID]; !ok {
+			current, found := currentFlags.ChainFlagsByChainID(chainID)
+			if !found {
+				current = types.ChainCrosschainFlags{IsInboundEnabled: true, IsOutboundEnabled: true}
+			}
+			chainFlags[chainID] = &types.ChainCrosschainFlags{
+				ChainId:           chainID,
+				IsInboundEnabled:  current.IsInboundEnabled,
+				IsOutboundEnabled: current.IsOutboundEnabled,
+			}
+		}
+		return chainFlags[chainID]
+	}
+	for _, chainID := range disableInbound {
+		get(chainID).IsInboundEnabled = false
+	}
+	for _, chainID := range disableOutbound {
+		get(chainID).IsOutboundEnabled = false
+	}
+	for _, chainID := range enable {
+		if _, ok := chainFlags[chainID]; ok {
+			return nil, fmt.Errorf("chain %d can't be both enabled and disabled", chainID)
+		}
+		chainFlags[chainID] = &types.ChainCrosschainFlags{ChainId: chainID, IsInboundEnabled: true, IsOutboundEnabled: true}
+	}
+
+	res := make([]types.ChainCrosschainFlags, 0, len(chainFlags))
+	for _, flags := range chainFlags {
+		res = append(res, *flags)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ChainId < res[j].ChainId })
+	return res, nil
+}
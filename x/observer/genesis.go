@@ -51,6 +51,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if genState.CrosschainFlags.GasPriceIncreaseFlags != nil {
 			crosschainFlags.GasPriceIncreaseFlags = genState.CrosschainFlags.GasPriceIncreaseFlags
 		}
+		crosschainFlags.ChainFlags = genState.CrosschainFlags.ChainFlags
+		crosschainFlags.InboundReEnableHeight = genState.CrosschainFlags.InboundReEnableHeight
+		crosschainFlags.OutboundReEnableHeight = genState.CrosschainFlags.OutboundReEnableHeight
 		k.SetCrosschainFlags(ctx, *crosschainFlags)
 	} else {
 		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
@@ -107,6 +110,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetFundMigrator(ctx, elem)
 	}
 
+	for _, elem := range genState.TssBalances {
+		k.SetTssBalance(ctx, elem)
+	}
+
 	for _, elem := range genState.BlameList {
 		k.SetBlame(ctx, elem)
 	}
@@ -185,6 +192,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		PendingNonces:     pendingNonces,
 		TssHistory:        k.GetAllTSS(ctx),
 		TssFundMigrators:  k.GetAllTssFundMigrators(ctx),
+		TssBalances:       k.GetAllTssBalances(ctx),
 		BlameList:         k.GetAllBlame(ctx),
 		ChainNonces:       k.GetAllChainNonces(ctx),
 		NonceToCctx:       k.GetAllNonceToCctx(ctx),
@@ -38,6 +38,7 @@ func TestGenesis(t *testing.T) {
 		LastObserverCount: sample.LastObserverCount(1000),
 		CoreParamsList:    sample.CoreParamsList(),
 		TssFundMigrators:  []types.TssFundMigratorInfo{sample.TssFundsMigrator(1), sample.TssFundsMigrator(2)},
+		TssBalances:       []types.TssBalance{sample.TssBalance(1), sample.TssBalance(2)},
 		ChainNonces: []types.ChainNonces{
 			sample.ChainNonces(t, "0"),
 			sample.ChainNonces(t, "1"),
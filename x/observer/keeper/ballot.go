@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/x/observer/types"
@@ -70,3 +72,127 @@ func (k Keeper) GetMaturedBallotList(ctx sdk.Context) []string {
 	}
 	return list.BallotsIndexList
 }
+
+// DeleteBallot removes a ballot from the store
+func (k Keeper) DeleteBallot(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoterKey))
+	store.Delete(types.KeyPrefix(index))
+}
+
+// DeleteBallotList removes the list of ballots for a given height
+func (k Keeper) DeleteBallotList(ctx sdk.Context, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
+	store.Delete(types.BallotListKeyPrefix(height))
+}
+
+// ClearMaturedBallots deletes the ballots matured at current height and their list once emissions have been distributed
+// The final votes of each ballot are emitted in an archival event if enabled in the params
+func (k Keeper) ClearMaturedBallots(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight() - params.BallotMaturityBlocks
+	list, found := k.GetBallotList(ctx, height)
+	if !found {
+		return
+	}
+	for _, index := range list.BallotsIndexList {
+		ballot, found := k.GetBallot(ctx, index)
+		if !found {
+			continue
+		}
+		k.deleteMaturedBallot(ctx, ballot, params.ArchiveMaturedBallots)
+	}
+	k.DeleteBallotList(ctx, height)
+}
+
+func (k Keeper) deleteMaturedBallot(ctx sdk.Context, ballot types.Ballot, archive bool) {
+	if archive {
+		EmitEventBallotArchived(ctx, ballot)
+	}
+	k.DeleteBallot(ctx, ballot.Index)
+}
+
+// SetBallotBacklogHeight sets the height below which the ballots and ballot lists are deleted by PruneBallotBacklog
+func (k Keeper) SetBallotBacklogHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	// #nosec G701 always positive
+	store.Set(types.KeyPrefix(types.BallotBacklogKey), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetBallotBacklogHeight returns the height below which the ballots are deleted, not found if there is no backlog to delete
+func (k Keeper) GetBallotBacklogHeight(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.BallotBacklogKey))
+	if b == nil {
+		return 0, false
+	}
+	// #nosec G701 always in range
+	return int64(sdk.BigEndianToUint64(b)), true
+}
+
+// PruneBallotBacklog visits at most limit ballots or ballot lists and deletes the ones created below the backlog height
+// The deletion resumes in the next block from a saved cursor, the ballots are deleted first and the ballot lists after,
+// the backlog height is removed once both have been visited entirely
+func (k Keeper) PruneBallotBacklog(ctx sdk.Context, limit int) {
+	backlogHeight, found := k.GetBallotBacklogHeight(ctx)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.KeyPrefix(types.BallotBacklogCursorKey))
+	if cursor == nil {
+		cursor = types.KeyPrefix(types.VoterKey)
+	}
+
+	if bytes.HasPrefix(cursor, types.KeyPrefix(types.VoterKey)) {
+		archive := k.GetParams(ctx).ArchiveMaturedBallots
+		var matured []types.Ballot
+		next := visitBacklog(store, cursor, types.KeyPrefix(types.VoterKey), limit, func(value []byte) {
+			var ballot types.Ballot
+			k.cdc.MustUnmarshal(value, &ballot)
+			if ballot.BallotCreationHeight < backlogHeight {
+				matured = append(matured, ballot)
+			}
+		})
+		for _, ballot := range matured {
+			k.deleteMaturedBallot(ctx, ballot, archive)
+		}
+		if next == nil {
+			next = types.KeyPrefix(types.BallotListKey)
+		}
+		store.Set(types.KeyPrefix(types.BallotBacklogCursorKey), next)
+		return
+	}
+
+	var maturedHeights []int64
+	next := visitBacklog(store, cursor, types.KeyPrefix(types.BallotListKey), limit, func(value []byte) {
+		var list types.BallotListForHeight
+		k.cdc.MustUnmarshal(value, &list)
+		if list.Height < backlogHeight {
+			maturedHeights = append(maturedHeights, list.Height)
+		}
+	})
+	for _, height := range maturedHeights {
+		k.DeleteBallotList(ctx, height)
+	}
+	if next == nil {
+		store.Delete(types.KeyPrefix(types.BallotBacklogKey))
+		store.Delete(types.KeyPrefix(types.BallotBacklogCursorKey))
+		return
+	}
+	store.Set(types.KeyPrefix(types.BallotBacklogCursorKey), next)
+}
+
+// visitBacklog calls visit on at most limit values of the store from the start key until the end of the prefix
+// It returns the key to resume from, or nil if the end of the prefix has been reached
+func visitBacklog(store sdk.KVStore, start []byte, prefixKey []byte, limit int, visit func(value []byte)) []byte {
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefixKey))
+	defer iterator.Close()
+	for visited := 0; iterator.Valid(); iterator.Next() {
+		if visited == limit {
+			return append([]byte{}, iterator.Key()...)
+		}
+		visit(iterator.Value())
+		visited++
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -21,3 +24,76 @@ func TestKeeper_GetBallot(t *testing.T) {
 
 	k.GetBallot(ctx, identifier)
 }
+
+func TestKeeper_ClearMaturedBallots(t *testing.T) {
+	k, ctx := SetupKeeper(t)
+	params := types.DefaultParams()
+	params.ArchiveMaturedBallots = true
+	k.SetParams(ctx, params)
+
+	maturedHeight := int64(10)
+	ctx = ctx.WithBlockHeight(maturedHeight + params.BallotMaturityBlocks)
+	for _, identifier := range []string{"matured-1", "matured-2"} {
+		ballot := types.Ballot{BallotIdentifier: identifier, BallotCreationHeight: maturedHeight}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+	}
+	pending := types.Ballot{BallotIdentifier: "pending", BallotCreationHeight: maturedHeight + 1}
+	k.SetBallot(ctx, &pending)
+	k.AddBallotToList(ctx, pending)
+
+	k.ClearMaturedBallots(ctx)
+
+	_, found := k.GetBallot(ctx, "matured-1")
+	require.False(t, found)
+	_, found = k.GetBallot(ctx, "matured-2")
+	require.False(t, found)
+	_, found = k.GetBallotList(ctx, maturedHeight)
+	require.False(t, found)
+	_, found = k.GetBallot(ctx, "pending")
+	require.True(t, found)
+	_, found = k.GetBallotList(ctx, maturedHeight+1)
+	require.True(t, found)
+
+	archived := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventBallotArchived{}) {
+			archived++
+		}
+	}
+	require.Equal(t, 2, archived)
+}
+
+func TestKeeper_PruneBallotBacklog(t *testing.T) {
+	k, ctx := SetupKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+
+	backlogHeight := int64(20)
+	for height := int64(0); height < 30; height++ {
+		ballot := types.Ballot{BallotIdentifier: fmt.Sprintf("ballot-%d", height), BallotCreationHeight: height}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+	}
+	k.SetBallotBacklogHeight(ctx, backlogHeight)
+
+	// 30 ballots and 30 ballot lists are visited by chunks of 7
+	for i := 0; i < 10; i++ {
+		_, found := k.GetBallotBacklogHeight(ctx)
+		require.True(t, found)
+		k.PruneBallotBacklog(ctx, 7)
+	}
+	_, found := k.GetBallotBacklogHeight(ctx)
+	require.False(t, found)
+
+	for height := int64(0); height < 30; height++ {
+		_, found := k.GetBallot(ctx, fmt.Sprintf("ballot-%d", height))
+		require.Equal(t, height >= backlogHeight, found)
+		_, found = k.GetBallotList(ctx, height)
+		require.Equal(t, height >= backlogHeight, found)
+	}
+	require.Len(t, k.GetAllBallots(ctx), 10)
+
+	// nothing happens without backlog
+	k.PruneBallotBacklog(ctx, 7)
+	require.Len(t, k.GetAllBallots(ctx), 10)
+}
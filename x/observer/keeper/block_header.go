@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+	"math/big"
 	"strconv"
 
+	cosmoserrors "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/common"
@@ -29,6 +35,22 @@ func (k Keeper) GetBlockHeader(ctx sdk.Context, hash []byte) (val common.BlockHe
 	return val, true
 }
 
+// GetAllBlockHeader returns all block headers
+func (k Keeper) GetAllBlockHeader(ctx sdk.Context) (list []common.BlockHeader) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val common.BlockHeader
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 // RemoveBlockHeader removes a block header from the store
 func (k Keeper) RemoveBlockHeader(ctx sdk.Context, hash []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderKey))
@@ -53,3 +75,224 @@ func (k Keeper) GetBlockHeaderState(ctx sdk.Context, chainID int64) (val types.B
 	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
+
+// SetBlockHeaderWork indexes a block header by chain and height with the cumulative work of its fork
+func (k Keeper) SetBlockHeaderWork(ctx sdk.Context, chainID int64, height int64, hash []byte, work *big.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderHeightKey))
+	store.Set(append(types.GetBlockHeaderHeightPrefix(chainID, height), hash...), work.Bytes())
+}
+
+// GetBlockHeaderWork returns the cumulative work of the fork ending with the block header
+func (k Keeper) GetBlockHeaderWork(ctx sdk.Context, chainID int64, height int64, hash []byte) (*big.Int, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderHeightKey))
+	b := store.Get(append(types.GetBlockHeaderHeightPrefix(chainID, height), hash...))
+	if b == nil {
+		return nil, false
+	}
+	return new(big.Int).SetBytes(b), true
+}
+
+// SetCanonicalBlockHash sets the hash of the canonical block header at a height
+func (k Keeper) SetCanonicalBlockHash(ctx sdk.Context, chainID int64, height int64, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderCanonicalKey))
+	store.Set(types.GetBlockHeaderHeightPrefix(chainID, height), hash)
+}
+
+// GetCanonicalBlockHash returns the hash of the canonical block header at a height
+func (k Keeper) GetCanonicalBlockHash(ctx sdk.Context, chainID int64, height int64) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderCanonicalKey))
+	b := store.Get(types.GetBlockHeaderHeightPrefix(chainID, height))
+	return b, b != nil
+}
+
+// RemoveCanonicalBlockHash removes the canonical block header at a height
+func (k Keeper) RemoveCanonicalBlockHash(ctx sdk.Context, chainID int64, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderCanonicalKey))
+	store.Delete(types.GetBlockHeaderHeightPrefix(chainID, height))
+}
+
+// CheckBlockHeaderParent checks a new block header can be attached to the headers of the store
+// the parent must be known and, on EVM chains, the header must not fork the chain below its confirmation count
+func (k Keeper) CheckBlockHeaderParent(ctx sdk.Context, chainID int64, height int64, parentHash []byte) error {
+	// if no BlockHeaderState is found, the header initializes the store after voting
+	bhs, found := k.GetBlockHeaderState(ctx, chainID)
+	if !found || bhs.EarliestHeight == 0 {
+		return nil
+	}
+
+	parent, found := k.GetBlockHeader(ctx, parentHash)
+	if !found {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, "parent block header not found")
+	}
+	if height != parent.Height+1 {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, fmt.Sprintf("invalid block height: wanted %d, got %d", parent.Height+1, height))
+	}
+
+	// EVM chains are considered final at the confirmation count, forks below it are rejected
+	if common.IsEVMChain(chainID) {
+		coreParams, found := k.GetCoreParamsByChainID(ctx, chainID)
+		if !found {
+			return types.ErrCoreParamsNotSet
+		}
+		if height+int64(coreParams.ConfirmationCount) <= bhs.LatestHeight {
+			return cosmoserrors.Wrapf(types.ErrBlockHeaderFinalized, "height %d, latest height %d", height, bhs.LatestHeight)
+		}
+	}
+	return nil
+}
+
+// AddBlockHeaderToChain stores a voted block header, updates the tips and the canonical chain and prunes old headers
+// the canonical chain is the fork with the most work on Bitcoin and the highest fork on EVM chains
+func (k Keeper) AddBlockHeaderToChain(ctx sdk.Context, header common.BlockHeader) error {
+	work, err := types.BlockHeaderWork(header)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrUnrecognizedBlockHeader, err.Error())
+	}
+
+	bhs, found := k.GetBlockHeaderState(ctx, header.ChainId)
+	if found && bhs.EarliestHeight > 0 {
+		parentWork, found := k.GetBlockHeaderWork(ctx, header.ChainId, header.Height-1, header.ParentHash)
+		if !found {
+			return cosmoserrors.Wrap(types.ErrNoParentHash, "parent block header not found")
+		}
+		work.Add(work, parentWork)
+	} else {
+		bhs = types.BlockHeaderState{
+			ChainId:        header.ChainId,
+			EarliestHeight: header.Height,
+		}
+	}
+
+	k.SetBlockHeader(ctx, header)
+	k.SetBlockHeaderWork(ctx, header.ChainId, header.Height, header.Hash, work)
+	bhs.AddTip(header.Hash, header.ParentHash)
+
+	// the header becomes the canonical tip if its fork has more work than the canonical chain
+	canonicalWork := big.NewInt(0)
+	if len(bhs.LatestBlockHash) > 0 {
+		if latestWork, found := k.GetBlockHeaderWork(ctx, header.ChainId, bhs.LatestHeight, bhs.LatestBlockHash); found {
+			canonicalWork = latestWork
+		}
+	}
+	if work.Cmp(canonicalWork) > 0 {
+		k.setCanonicalTip(ctx, &bhs, header)
+	}
+
+	k.pruneBlockHeaders(ctx, &bhs)
+	k.SetBlockHeaderState(ctx, bhs)
+	return nil
+}
+
+// GetProvableBlockHeader returns a block header proofs can be verified against
+// the header must be on the canonical chain and at least the confirmation count of the chain deep
+func (k Keeper) GetProvableBlockHeader(ctx sdk.Context, hash []byte) (common.BlockHeader, error) {
+	header, found := k.GetBlockHeader(ctx, hash)
+	if !found {
+		return header, cosmoserrors.Wrapf(types.ErrBlockHeaderNotFound, "block hash: %x", hash)
+	}
+	canonicalHash, found := k.GetCanonicalBlockHash(ctx, header.ChainId, header.Height)
+	if !found || !bytes.Equal(canonicalHash, hash) {
+		return header, cosmoserrors.Wrapf(types.ErrBlockHeaderNotCanonical, "block hash: %x", hash)
+	}
+	bhs, found := k.GetBlockHeaderState(ctx, header.ChainId)
+	if !found {
+		return header, cosmoserrors.Wrapf(types.ErrBlockHeaderNotFound, "block header state not found for chain %d", header.ChainId)
+	}
+	coreParams, found := k.GetCoreParamsByChainID(ctx, header.ChainId)
+	if !found {
+		return header, types.ErrCoreParamsNotSet
+	}
+	if header.Height+int64(coreParams.ConfirmationCount) > bhs.LatestHeight {
+		return header, cosmoserrors.Wrapf(
+			types.ErrBlockHeaderNotConfirmed,
+			"height %d, latest height %d, confirmation count %d",
+			header.Height,
+			bhs.LatestHeight,
+			coreParams.ConfirmationCount,
+		)
+	}
+	return header, nil
+}
+
+// setCanonicalTip makes the header the canonical tip, rewriting the canonical chain down to the fork point
+func (k Keeper) setCanonicalTip(ctx sdk.Context, bhs *types.BlockHeaderState, header common.BlockHeader) {
+	// a fork with more work can be shorter than the previous canonical chain
+	for height := bhs.LatestHeight; height > header.Height; height-- {
+		k.RemoveCanonicalBlockHash(ctx, header.ChainId, height)
+	}
+
+	current := header
+	for {
+		canonicalHash, found := k.GetCanonicalBlockHash(ctx, current.ChainId, current.Height)
+		if found && bytes.Equal(canonicalHash, current.Hash) {
+			break
+		}
+		k.SetCanonicalBlockHash(ctx, current.ChainId, current.Height, current.Hash)
+
+		parent, found := k.GetBlockHeader(ctx, current.ParentHash)
+		if !found {
+			break
+		}
+		current = parent
+	}
+
+	bhs.LatestHeight = header.Height
+	bhs.LatestBlockHash = header.Hash
+}
+
+// pruneBlockHeaders removes up to BlockHeaderPruneLimit headers older than the prune window of the chain
+func (k Keeper) pruneBlockHeaders(ctx sdk.Context, bhs *types.BlockHeaderState) {
+	coreParams, found := k.GetCoreParamsByChainID(ctx, bhs.ChainId)
+	if !found || coreParams.BlockHeaderPruneWindow == 0 {
+		return
+	}
+	pruneBelow := bhs.LatestHeight - int64(coreParams.BlockHeaderPruneWindow)
+	if pruneBelow <= bhs.EarliestHeight {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderHeightKey))
+	chainPrefix := types.GetBlockHeaderChainPrefix(bhs.ChainId)
+
+	var keys [][]byte
+	iterator := store.Iterator(chainPrefix, types.GetBlockHeaderHeightPrefix(bhs.ChainId, pruneBelow))
+	for ; iterator.Valid() && len(keys) < types.BlockHeaderPruneLimit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		height := int64(sdk.BigEndianToUint64(key[8:16]))
+		hash := key[16:]
+		k.RemoveBlockHeader(ctx, hash)
+		k.RemoveCanonicalBlockHash(ctx, bhs.ChainId, height)
+		bhs.RemoveTip(hash)
+		store.Delete(key)
+	}
+
+	// the earliest height is the lowest height still indexed
+	iterator = sdk.KVStorePrefixIterator(store, chainPrefix)
+	defer iterator.Close()
+	if iterator.Valid() {
+		bhs.EarliestHeight = int64(sdk.BigEndianToUint64(iterator.Key()[8:16]))
+	}
+}
+
+// bitcoinHeaderGetter returns a getter of the stored Bitcoin headers for the ancestry checks of a new header
+func (k Keeper) bitcoinHeaderGetter(ctx sdk.Context) common.BitcoinHeaderGetter {
+	return func(hash chainhash.Hash) (*wire.BlockHeader, bool) {
+		bh, found := k.GetBlockHeader(ctx, hash[:])
+		if !found {
+			return nil, false
+		}
+		headerBytes := bh.Header.GetBitcoinHeader()
+		if headerBytes == nil {
+			return nil, false
+		}
+		var header wire.BlockHeader
+		if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+			return nil, false
+		}
+		return &header, true
+	}
+}
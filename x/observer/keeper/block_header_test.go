@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func sampleEthBlockHeader(height int64, hash string, parentHash string) common.BlockHeader {
+	return common.BlockHeader{
+		Height:     height,
+		Hash:       []byte(hash),
+		ParentHash: []byte(parentHash),
+		ChainId:    common.GoerliLocalnetChain().ChainId,
+		Header:     common.NewEthereumHeader([]byte(hash)),
+	}
+}
+
+func sampleBtcBlockHeader(t *testing.T, height int64, hash string, parentHash string, bits uint32) common.BlockHeader {
+	header := wire.BlockHeader{
+		Version:   1,
+		Timestamp: time.Unix(1700000000+height, 0),
+		Bits:      bits,
+	}
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+
+	return common.BlockHeader{
+		Height:     height,
+		Hash:       []byte(hash),
+		ParentHash: []byte(parentHash),
+		ChainId:    common.BtcRegtestChain().ChainId,
+		Header:     common.NewBitcoinHeader(buf.Bytes()),
+	}
+}
+
+func setBlockHeaderCoreParams(k *Keeper, ctx sdk.Context, chainID int64, confirmationCount uint64, pruneWindow uint64) {
+	k.SetCoreParams(ctx, types.CoreParamsList{
+		CoreParams: []*types.CoreParams{
+			{
+				ChainId:                chainID,
+				ConfirmationCount:      confirmationCount,
+				BlockHeaderPruneWindow: pruneWindow,
+			},
+		},
+	})
+}
+
+func requireCanonicalChain(t *testing.T, k *Keeper, ctx sdk.Context, chainID int64, hashes map[int64]string) {
+	for height, hash := range hashes {
+		canonicalHash, found := k.GetCanonicalBlockHash(ctx, chainID, height)
+		require.True(t, found, "height %d", height)
+		require.Equal(t, hash, string(canonicalHash), "height %d", height)
+	}
+}
+
+func TestKeeper_AddBlockHeaderToChain(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+
+	t.Run("should extend the canonical chain and reorg to a higher fork", func(t *testing.T) {
+		k, ctx := SetupKeeper(t)
+		setBlockHeaderCoreParams(k, ctx, chainID, 2, 0)
+
+		for _, header := range []common.BlockHeader{
+			sampleEthBlockHeader(1, "1", "0"),
+			sampleEthBlockHeader(2, "2", "1"),
+			sampleEthBlockHeader(3, "3", "2"),
+			sampleEthBlockHeader(4, "4", "3"),
+		} {
+			require.NoError(t, k.AddBlockHeaderToChain(ctx, header))
+		}
+
+		// a fork at the same height doesn't change the canonical chain
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, sampleEthBlockHeader(4, "4b", "3")))
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 1, bhs.EarliestHeight)
+		require.EqualValues(t, 4, bhs.LatestHeight)
+		require.Equal(t, "4", string(bhs.LatestBlockHash))
+		require.ElementsMatch(t, [][]byte{[]byte("4"), []byte("4b")}, bhs.Tips)
+
+		// the fork becomes canonical once higher
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, sampleEthBlockHeader(5, "5b", "4b")))
+		bhs, _ = k.GetBlockHeaderState(ctx, chainID)
+		require.EqualValues(t, 5, bhs.LatestHeight)
+		require.Equal(t, "5b", string(bhs.LatestBlockHash))
+		require.ElementsMatch(t, [][]byte{[]byte("4"), []byte("5b")}, bhs.Tips)
+		requireCanonicalChain(t, k, ctx, chainID, map[int64]string{1: "1", 2: "2", 3: "3", 4: "4b", 5: "5b"})
+	})
+
+	t.Run("should fail if the parent is not found", func(t *testing.T) {
+		k, ctx := SetupKeeper(t)
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, sampleEthBlockHeader(1, "1", "0")))
+
+		err := k.AddBlockHeaderToChain(ctx, sampleEthBlockHeader(3, "3", "2"))
+		require.ErrorIs(t, err, types.ErrNoParentHash)
+	})
+
+	t.Run("should reorg to a shorter bitcoin fork with more work", func(t *testing.T) {
+		k, ctx := SetupKeeper(t)
+		btcChainID := common.BtcRegtestChain().ChainId
+		setBlockHeaderCoreParams(k, ctx, btcChainID, 1, 0)
+		lowWork, highWork := uint32(0x207fffff), uint32(0x1d00ffff)
+
+		for _, header := range []common.BlockHeader{
+			sampleBtcBlockHeader(t, 1, "1", "0", lowWork),
+			sampleBtcBlockHeader(t, 2, "2", "1", lowWork),
+			sampleBtcBlockHeader(t, 3, "3", "2", lowWork),
+			sampleBtcBlockHeader(t, 2, "2b", "1", highWork),
+		} {
+			require.NoError(t, k.AddBlockHeaderToChain(ctx, header))
+		}
+
+		bhs, found := k.GetBlockHeaderState(ctx, btcChainID)
+		require.True(t, found)
+		require.EqualValues(t, 2, bhs.LatestHeight)
+		require.Equal(t, "2b", string(bhs.LatestBlockHash))
+		requireCanonicalChain(t, k, ctx, btcChainID, map[int64]string{1: "1", 2: "2b"})
+		_, found = k.GetCanonicalBlockHash(ctx, btcChainID, 3)
+		require.False(t, found)
+	})
+
+	t.Run("should prune block headers older than the prune window", func(t *testing.T) {
+		k, ctx := SetupKeeper(t)
+		setBlockHeaderCoreParams(k, ctx, chainID, 1, 2)
+
+		for _, header := range []common.BlockHeader{
+			sampleEthBlockHeader(1, "1", "0"),
+			sampleEthBlockHeader(2, "2", "1"),
+			sampleEthBlockHeader(2, "2b", "1"),
+			sampleEthBlockHeader(3, "3", "2"),
+			sampleEthBlockHeader(4, "4", "3"),
+			sampleEthBlockHeader(5, "5", "4"),
+		} {
+			require.NoError(t, k.AddBlockHeaderToChain(ctx, header))
+		}
+
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 3, bhs.EarliestHeight)
+		require.EqualValues(t, 5, bhs.LatestHeight)
+		require.Equal(t, [][]byte{[]byte("5")}, bhs.Tips)
+		for _, hash := range []string{"1", "2", "2b"} {
+			_, found = k.GetBlockHeader(ctx, []byte(hash))
+			require.False(t, found, hash)
+		}
+		_, found = k.GetCanonicalBlockHash(ctx, chainID, 2)
+		require.False(t, found)
+		requireCanonicalChain(t, k, ctx, chainID, map[int64]string{3: "3", 4: "4", 5: "5"})
+	})
+}
+
+func TestKeeper_CheckBlockHeaderParent(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+
+	setup := func(t *testing.T) (*Keeper, sdk.Context) {
+		k, ctx := SetupKeeper(t)
+		setBlockHeaderCoreParams(k, ctx, chainID, 2, 0)
+		for _, header := range []common.BlockHeader{
+			sampleEthBlockHeader(1, "1", "0"),
+			sampleEthBlockHeader(2, "2", "1"),
+			sampleEthBlockHeader(3, "3", "2"),
+			sampleEthBlockHeader(4, "4", "3"),
+		} {
+			require.NoError(t, k.AddBlockHeaderToChain(ctx, header))
+		}
+		return k, ctx
+	}
+
+	t.Run("should allow any header if the store is not initialized", func(t *testing.T) {
+		k, ctx := SetupKeeper(t)
+		require.NoError(t, k.CheckBlockHeaderParent(ctx, chainID, 100, []byte("99")))
+	})
+
+	t.Run("should allow extending the tip or forking above the confirmation count", func(t *testing.T) {
+		k, ctx := setup(t)
+		require.NoError(t, k.CheckBlockHeaderParent(ctx, chainID, 5, []byte("4")))
+		require.NoError(t, k.CheckBlockHeaderParent(ctx, chainID, 3, []byte("2")))
+	})
+
+	t.Run("should fail if the parent is not found", func(t *testing.T) {
+		k, ctx := setup(t)
+		err := k.CheckBlockHeaderParent(ctx, chainID, 6, []byte("5"))
+		require.ErrorIs(t, err, types.ErrNoParentHash)
+	})
+
+	t.Run("should fail if the height doesn't follow the parent", func(t *testing.T) {
+		k, ctx := setup(t)
+		err := k.CheckBlockHeaderParent(ctx, chainID, 6, []byte("4"))
+		require.ErrorIs(t, err, types.ErrNoParentHash)
+	})
+
+	t.Run("should fail if the fork is below the confirmation count", func(t *testing.T) {
+		k, ctx := setup(t)
+		err := k.CheckBlockHeaderParent(ctx, chainID, 2, []byte("1"))
+		require.ErrorIs(t, err, types.ErrBlockHeaderFinalized)
+	})
+}
+
+func TestKeeper_GetProvableBlockHeader(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+	k, ctx := SetupKeeper(t)
+	setBlockHeaderCoreParams(k, ctx, chainID, 2, 0)
+	for _, header := range []common.BlockHeader{
+		sampleEthBlockHeader(1, "1", "0"),
+		sampleEthBlockHeader(2, "2", "1"),
+		sampleEthBlockHeader(2, "2b", "1"),
+		sampleEthBlockHeader(3, "3", "2"),
+		sampleEthBlockHeader(4, "4", "3"),
+	} {
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, header))
+	}
+
+	header, err := k.GetProvableBlockHeader(ctx, []byte("2"))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, header.Height)
+
+	_, err = k.GetProvableBlockHeader(ctx, []byte("5"))
+	require.ErrorIs(t, err, types.ErrBlockHeaderNotFound)
+
+	_, err = k.GetProvableBlockHeader(ctx, []byte("2b"))
+	require.ErrorIs(t, err, types.ErrBlockHeaderNotCanonical)
+
+	_, err = k.GetProvableBlockHeader(ctx, []byte("3"))
+	require.ErrorIs(t, err, types.ErrBlockHeaderNotConfirmed)
+}
+
+func TestKeeper_BitcoinHeaderGetter(t *testing.T) {
+	k, ctx := SetupKeeper(t)
+	genesis := common.BitcoinMainnetParams.GenesisBlock.Header
+	var buf bytes.Buffer
+	require.NoError(t, genesis.Serialize(&buf))
+	genesisHash := genesis.BlockHash()
+	k.SetBlockHeader(ctx, common.BlockHeader{
+		Height:  0,
+		Hash:    genesisHash[:],
+		ChainId: common.BtcMainnetChain().ChainId,
+		Header:  common.NewBitcoinHeader(buf.Bytes()),
+	})
+
+	getHeader := k.bitcoinHeaderGetter(ctx)
+	header, found := getHeader(genesisHash)
+	require.True(t, found)
+	require.Equal(t, genesisHash, header.BlockHash())
+
+	_, found = getHeader(genesis.PrevBlock)
+	require.False(t, found)
+}
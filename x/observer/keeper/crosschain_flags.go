@@ -42,6 +42,36 @@ func (k Keeper) IsOutboundEnabled(ctx sdk.Context) (found bool) {
 	return flags.IsOutboundEnabled
 }
 
+// IsInboundEnabledForChain returns true if inbound is enabled globally and for the chain
+func (k Keeper) IsInboundEnabledForChain(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		return false
+	}
+	return flags.IsChainInboundEnabled(chainID)
+}
+
+// IsOutboundEnabledForChain returns true if outbound is enabled globally and for the chain
+func (k Keeper) IsOutboundEnabledForChain(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		return false
+	}
+	return flags.IsChainOutboundEnabled(chainID)
+}
+
+// ReEnableCrosschainFlags enables again the inbound and outbound flags whose re-enable height is reached
+func (k Keeper) ReEnableCrosschainFlags(ctx sdk.Context) {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		return
+	}
+	if flags.ReEnable(ctx.BlockHeight()) {
+		ctx.Logger().Info("crosschain flags re-enabled", "height", ctx.BlockHeight())
+		k.SetCrosschainFlags(ctx, flags)
+	}
+}
+
 // RemoveCrosschainFlags removes crosschain flags from the store
 func (k Keeper) RemoveCrosschainFlags(ctx sdk.Context) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CrosschainFlagsKey))
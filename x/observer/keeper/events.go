@@ -21,6 +21,20 @@ func EmitEventBallotCreated(ctx sdk.Context, ballot types.Ballot, observationHas
 	}
 }
 
+func EmitEventBallotArchived(ctx sdk.Context, ballot types.Ballot) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBallotArchived{
+		BallotIdentifier:     ballot.BallotIdentifier,
+		BallotType:           ballot.ObservationType.String(),
+		BallotStatus:         ballot.BallotStatus,
+		BallotCreationHeight: ballot.BallotCreationHeight,
+		VoterList:            ballot.VoterList,
+		Votes:                ballot.Votes,
+	})
+	if err != nil {
+		ctx.Logger().Error("failed to emit EventBallotArchived : %s", err.Error())
+	}
+}
+
 func EmitEventKeyGenBlockUpdated(ctx sdk.Context, keygen *types.Keygen) {
 	err := ctx.EventManager().EmitTypedEvents(&types.EventKeygenBlockUpdated{
 		MsgTypeUrl:    sdk.MsgTypeURL(&types.MsgUpdateKeygen{}),
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/btcsuite/btcutil"
@@ -15,7 +16,7 @@ import (
 )
 
 // Prove simply checks two things:
-// 1. the block header is available
+// 1. the block header is available, on the canonical chain and confirmed
 // 2. the proof is valid
 func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.QueryProveResponse, error) {
 	if req == nil {
@@ -27,9 +28,12 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	res, found := k.GetBlockHeader(ctx, blockHash)
-	if !found {
-		return nil, status.Error(codes.NotFound, "block header not found")
+	res, err := k.GetProvableBlockHeader(ctx, blockHash)
+	if err != nil {
+		if errors.Is(err, types.ErrBlockHeaderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	proven := false
@@ -5,6 +5,9 @@ import (
 	v2 "github.com/zeta-chain/zetacore/x/observer/migrations/v2"
 	v3 "github.com/zeta-chain/zetacore/x/observer/migrations/v3"
 	v4 "github.com/zeta-chain/zetacore/x/observer/migrations/v4"
+	v5 "github.com/zeta-chain/zetacore/x/observer/migrations/v5"
+	v6 "github.com/zeta-chain/zetacore/x/observer/migrations/v6"
+	v7 "github.com/zeta-chain/zetacore/x/observer/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -32,3 +35,18 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.observerKeeper.storeKey, m.observerKeeper.cdc)
 }
+
+// Migrate4to5 migrates the store from consensus version 4 to 5
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.observerKeeper)
+}
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.observerKeeper)
+}
+
+// Migrate6to7 migrates the store from consensus version 6 to 7
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.observerKeeper)
+}
@@ -39,36 +39,50 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 		return nil, cosmoserrors.Wrap(types.ErrBlockAlreadyExist, fmt.Sprintf("block hash: %x", msg.BlockHash))
 	}
 
-	// if BlockHeaderState exists and parent block header is not found, this tx is rejected
-	// if no BlockHeaderState is found, allow this vote to pass through to create and initialize
-	// the Earliest/Latest height with this block header (after voting, not here)
-	// if BlockHeaderState is found, check if the block height is valid
-	// validate block height as it's not part of the header itself
-	bhs, found := k.Keeper.GetBlockHeaderState(ctx, msg.ChainId)
-	if found && bhs.EarliestHeight > 0 && bhs.EarliestHeight < msg.Height {
-		pHash, err := msg.Header.ParentHash()
-		if err != nil {
-			return nil, cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
-		}
-		_, found = k.GetBlockHeader(ctx, pHash)
-		if !found {
-			return nil, cosmoserrors.Wrap(types.ErrNoParentHash, "parent block header not found")
-		}
-		if msg.Height != bhs.LatestHeight+1 {
-			return nil, cosmoserrors.Wrap(types.ErrNoParentHash, fmt.Sprintf("invalid block height: wanted %d, got %d", bhs.LatestHeight+1, msg.Height))
-		}
+	// if BlockHeaderState exists the parent block header must be in the store
+	// block height is validated against the parent as it's not part of the header itself
+	pHash, err := msg.Header.ParentHash()
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
+	}
+	if err := k.CheckBlockHeaderParent(ctx, msg.ChainId, msg.Height, pHash); err != nil {
+		return nil, err
 	}
 
 	// Check timestamp
-	err := msg.Header.ValidateTimestamp(ctx.BlockTime())
+	err = msg.Header.ValidateTimestamp(ctx.BlockTime())
 	if err != nil {
 		return nil, cosmoserrors.Wrap(types.ErrInvalidTimestamp, err.Error())
 	}
 
-	// NOTE: error is checked in BasicValidation in msg; check again for extra caution
-	pHash, err := msg.Header.ParentHash()
-	if err != nil {
-		return nil, cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
+	// Bitcoin headers must follow the difficulty and timestamp rules of their stored ancestors
+	// the first header of the store has no stored ancestor, it must start a retarget period so the difficulty
+	// of every following header can be validated
+	if btcHeader := msg.Header.GetBitcoinHeader(); btcHeader != nil {
+		getHeader := k.bitcoinHeaderGetter(ctx)
+		if bhs, found := k.GetBlockHeaderState(ctx, msg.ChainId); found && bhs.EarliestHeight > 0 {
+			err = common.ValidateBitcoinHeaderDifficulty(btcHeader, msg.Height, msg.ChainId, getHeader)
+			if err != nil {
+				return nil, cosmoserrors.Wrap(types.ErrInvalidDifficulty, err.Error())
+			}
+		} else if msg.ChainId != common.BtcRegtestChain().ChainId {
+			blocksPerRetarget, err := common.GetBTCBlocksPerRetarget(msg.ChainId)
+			if err != nil {
+				return nil, cosmoserrors.Wrap(types.ErrInvalidDifficulty, err.Error())
+			}
+			if msg.Height%blocksPerRetarget != 0 {
+				return nil, cosmoserrors.Wrapf(
+					types.ErrInvalidDifficulty,
+					"first header height %d must be a multiple of the retarget period %d",
+					msg.Height,
+					blocksPerRetarget,
+				)
+			}
+		}
+		err = common.ValidateBitcoinHeaderMedianTime(btcHeader, getHeader)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrInvalidTimestamp, err.Error())
+		}
 	}
 
 	// add vote to ballot
@@ -88,25 +102,6 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	/**
 	 * Vote finalized, add block header to store
 	 */
-	bhs, found = k.Keeper.GetBlockHeaderState(ctx, msg.ChainId)
-	if !found {
-		bhs = types.BlockHeaderState{
-			ChainId:         msg.ChainId,
-			LatestHeight:    msg.Height,
-			EarliestHeight:  msg.Height,
-			LatestBlockHash: msg.BlockHash,
-		}
-	} else {
-		if msg.Height > bhs.LatestHeight {
-			bhs.LatestHeight = msg.Height
-			bhs.LatestBlockHash = msg.BlockHash
-		}
-		if bhs.EarliestHeight == 0 {
-			bhs.EarliestHeight = msg.Height
-		}
-	}
-	k.Keeper.SetBlockHeaderState(ctx, bhs)
-
 	bh := common.BlockHeader{
 		Header:     msg.Header,
 		Height:     msg.Height,
@@ -114,7 +109,9 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 		ParentHash: pHash,
 		ChainId:    msg.ChainId,
 	}
-	k.SetBlockHeader(ctx, bh)
+	if err := k.AddBlockHeaderToChain(ctx, bh); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgAddBlockHeaderResponse{}, nil
 }
@@ -3,35 +3,60 @@ package keeper
 import (
 	"context"
 
+	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 // UpdateCrosschainFlags updates the crosschain related flags.
-// Only the admin policy account is authorized to broadcast this message.
+// The emergency policy account can disable inbound and outbound globally or for specific chains,
+// enabling a disabled flag or updating the gas price increase flags requires the admin policy account.
+// If a re-enable height is provided, the flags disabled by the message are automatically enabled again at this height.
 func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUpdateCrosschainFlags) (*types.MsgUpdateCrosschainFlagsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// check if the value exists
+	flags, isFound := k.GetCrosschainFlags(ctx)
+	if !isFound {
+		flags = *types.DefaultCrosschainFlags()
+	}
+
 	requiredGroup := types.Policy_Type_group1
-	if msg.IsInboundEnabled || msg.IsOutboundEnabled || msg.GasPriceIncreaseFlags != nil {
+	if enablesCrosschainFlags(flags, msg) || msg.GasPriceIncreaseFlags != nil {
 		requiredGroup = types.Policy_Type_group2
 	}
 
-	// check permission
-	if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(requiredGroup) {
+	// check permission, the admin policy account can also perform the actions of the emergency policy account
+	params := k.GetParams(ctx)
+	if msg.Creator != params.GetAdminPolicyAccount(requiredGroup) && msg.Creator != params.GetAdminPolicyAccount(types.Policy_Type_group2) {
 		return &types.MsgUpdateCrosschainFlagsResponse{}, types.ErrNotAuthorizedPolicy
 	}
 
-	// check if the value exists
-	flags, isFound := k.GetCrosschainFlags(ctx)
-	if !isFound {
-		flags = *types.DefaultCrosschainFlags()
+	if msg.ReEnableHeight != 0 && msg.ReEnableHeight <= ctx.BlockHeight() {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInvalidReEnableHeight,
+			"re-enable height %d must be after current height %d",
+			msg.ReEnableHeight,
+			ctx.BlockHeight(),
+		)
 	}
 
 	// update values
+	flags.InboundReEnableHeight = types.ReEnableHeightAfterUpdate(flags.IsInboundEnabled, msg.IsInboundEnabled, flags.InboundReEnableHeight, msg.ReEnableHeight)
+	flags.OutboundReEnableHeight = types.ReEnableHeightAfterUpdate(flags.IsOutboundEnabled, msg.IsOutboundEnabled, flags.OutboundReEnableHeight, msg.ReEnableHeight)
 	flags.IsInboundEnabled = msg.IsInboundEnabled
 	flags.IsOutboundEnabled = msg.IsOutboundEnabled
 
+	for _, chainFlags := range msg.ChainFlags {
+		current, found := flags.ChainFlagsByChainID(chainFlags.ChainId)
+		if !found {
+			current = types.ChainCrosschainFlags{IsInboundEnabled: true, IsOutboundEnabled: true}
+		}
+		chainFlags.InboundReEnableHeight = types.ReEnableHeightAfterUpdate(current.IsInboundEnabled, chainFlags.IsInboundEnabled, current.InboundReEnableHeight, msg.ReEnableHeight)
+		chainFlags.OutboundReEnableHeight = types.ReEnableHeightAfterUpdate(current.IsOutboundEnabled, chainFlags.IsOutboundEnabled, current.OutboundReEnableHeight, msg.ReEnableHeight)
+		flags.SetChainFlags(chainFlags)
+	}
+
 	if msg.GasPriceIncreaseFlags != nil {
 		flags.GasPriceIncreaseFlags = msg.GasPriceIncreaseFlags
 	}
@@ -49,6 +74,8 @@ func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUp
 		GasPriceIncreaseFlags:        msg.GasPriceIncreaseFlags,
 		BlockHeaderVerificationFlags: msg.BlockHeaderVerificationFlags,
 		Signer:                       msg.Creator,
+		ChainFlags:                   msg.ChainFlags,
+		ReEnableHeight:               msg.ReEnableHeight,
 	})
 	if err != nil {
 		ctx.Logger().Error("Error emitting EventCrosschainFlagsUpdated :", err)
@@ -56,3 +83,20 @@ func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUp
 
 	return &types.MsgUpdateCrosschainFlagsResponse{}, nil
 }
+
+// enablesCrosschainFlags returns true if the message enables an inbound or outbound flag currently disabled, globally or for a chain
+func enablesCrosschainFlags(flags types.CrosschainFlags, msg *types.MsgUpdateCrosschainFlags) bool {
+	if (msg.IsInboundEnabled && !flags.IsInboundEnabled) || (msg.IsOutboundEnabled && !flags.IsOutboundEnabled) {
+		return true
+	}
+	for _, chainFlags := range msg.ChainFlags {
+		current, found := flags.ChainFlagsByChainID(chainFlags.ChainId)
+		if !found {
+			continue
+		}
+		if (chainFlags.IsInboundEnabled && !current.IsInboundEnabled) || (chainFlags.IsOutboundEnabled && !current.IsOutboundEnabled) {
+			return true
+		}
+	}
+	return false
+}
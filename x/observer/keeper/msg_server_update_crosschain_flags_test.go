@@ -141,6 +141,7 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 
 		admin := sample.AccAddress()
 		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group1)
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{IsInboundEnabled: false, IsOutboundEnabled: false})
 
 		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
 			Creator:           admin,
@@ -150,4 +151,154 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		require.Error(t, err)
 		require.Equal(t, types.ErrNotAuthorizedPolicy, err)
 	})
+
+	t.Run("emergency group can disable a chain and schedule its re-enable", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group1)
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+		ctx = ctx.WithBlockHeight(100)
+
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			ChainFlags: []types.ChainCrosschainFlags{
+				{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true},
+				{ChainId: 2, IsInboundEnabled: false, IsOutboundEnabled: false},
+			},
+			ReEnableHeight: 200,
+		})
+		require.NoError(t, err)
+
+		require.True(t, k.IsInboundEnabled(ctx))
+		require.False(t, k.IsInboundEnabledForChain(ctx, 1))
+		require.True(t, k.IsOutboundEnabledForChain(ctx, 1))
+		require.False(t, k.IsInboundEnabledForChain(ctx, 2))
+		require.False(t, k.IsOutboundEnabledForChain(ctx, 2))
+		require.True(t, k.IsInboundEnabledForChain(ctx, 3))
+		flags, _ := k.GetCrosschainFlags(ctx)
+		require.EqualValues(t, 0, flags.InboundReEnableHeight)
+		require.EqualValues(t, 0, flags.OutboundReEnableHeight)
+		require.Equal(t, []types.ChainCrosschainFlags{
+			{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true, InboundReEnableHeight: 200},
+			{ChainId: 2, IsInboundEnabled: false, IsOutboundEnabled: false, InboundReEnableHeight: 200, OutboundReEnableHeight: 200},
+		}, flags.ChainFlags)
+
+		// the emergency group can't enable a disabled chain
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			ChainFlags:        []types.ChainCrosschainFlags{{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: true}},
+		})
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+
+		// flags are enabled again at the re-enable height
+		k.ReEnableCrosschainFlags(ctx.WithBlockHeight(199))
+		require.False(t, k.IsInboundEnabledForChain(ctx, 1))
+		k.ReEnableCrosschainFlags(ctx.WithBlockHeight(200))
+		require.True(t, k.IsInboundEnabledForChain(ctx, 1))
+		require.True(t, k.IsOutboundEnabledForChain(ctx, 2))
+		flags, _ = k.GetCrosschainFlags(ctx)
+		require.Empty(t, flags.ChainFlags)
+	})
+
+	t.Run("admin group can enable a disabled chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group2)
+		flags := *types.DefaultCrosschainFlags()
+		flags.ChainFlags = []types.ChainCrosschainFlags{{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: false}}
+		k.SetCrosschainFlags(ctx, flags)
+
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			ChainFlags:        []types.ChainCrosschainFlags{{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: true}},
+		})
+		require.NoError(t, err)
+		require.True(t, k.IsInboundEnabledForChain(ctx, 1))
+		require.True(t, k.IsOutboundEnabledForChain(ctx, 1))
+		flags, _ = k.GetCrosschainFlags(ctx)
+		require.Empty(t, flags.ChainFlags)
+	})
+
+	t.Run("emergency group can disable globally until a re-enable height", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group1)
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+		ctx = ctx.WithBlockHeight(100)
+
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  false,
+			IsOutboundEnabled: false,
+			ReEnableHeight:    100,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidReEnableHeight)
+
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  false,
+			IsOutboundEnabled: false,
+			ReEnableHeight:    150,
+		})
+		require.NoError(t, err)
+		require.False(t, k.IsInboundEnabled(ctx))
+
+		k.ReEnableCrosschainFlags(ctx.WithBlockHeight(150))
+		require.True(t, k.IsInboundEnabled(ctx))
+		require.True(t, k.IsOutboundEnabledForChain(ctx, 1))
+	})
+
+	t.Run("timed disable of a direction doesn't lift the indefinite disable of the other direction", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group1)
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+		ctx = ctx.WithBlockHeight(100)
+
+		// outbound disabled indefinitely globally and for a chain
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: false,
+			ChainFlags:        []types.ChainCrosschainFlags{{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: false}},
+		})
+		require.NoError(t, err)
+
+		// inbound disabled later until a re-enable height
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  false,
+			IsOutboundEnabled: false,
+			ChainFlags:        []types.ChainCrosschainFlags{{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: false}},
+			ReEnableHeight:    150,
+		})
+		require.NoError(t, err)
+		flags, _ := k.GetCrosschainFlags(ctx)
+		require.EqualValues(t, 150, flags.InboundReEnableHeight)
+		require.EqualValues(t, 0, flags.OutboundReEnableHeight)
+		require.Equal(t, []types.ChainCrosschainFlags{
+			{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: false, InboundReEnableHeight: 150},
+		}, flags.ChainFlags)
+
+		// only the inbound is enabled again
+		k.ReEnableCrosschainFlags(ctx.WithBlockHeight(150))
+		require.True(t, k.IsInboundEnabled(ctx))
+		require.True(t, k.IsInboundEnabledForChain(ctx, 1))
+		require.False(t, k.IsOutboundEnabledForChain(ctx, 1))
+		flags, _ = k.GetCrosschainFlags(ctx)
+		require.False(t, flags.IsOutboundEnabled)
+		require.Equal(t, []types.ChainCrosschainFlags{
+			{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: false},
+		}, flags.ChainFlags)
+	})
 }
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// VoteInboundHalt adds the vote of an observer to halt the inbound of a chain
+// whose ZRC20 supply is not backed by the assets held on the chain.
+// Once the ballot is finalized, the inbound of the chain is disabled until it's enabled again by the admin policy account.
+func (k msgServer) VoteInboundHalt(goCtx context.Context, msg *types.MsgVoteInboundHalt) (*types.MsgVoteInboundHaltResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// GetChainFromChainID makes sure we are getting only supported chains
+	chain := k.GetParams(ctx).GetChainFromChainID(msg.ChainId)
+	if chain == nil {
+		return nil, cosmoserrors.Wrap(types.ErrSupportedChains, fmt.Sprintf("ChainID %d, inbound halt vote", msg.ChainId))
+	}
+	if ok := k.IsAuthorized(ctx, msg.Creator, chain); !ok {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+	if msg.CheckHeight > ctx.BlockHeight() {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInvalidCheckHeight,
+			"check height %d is after current height %d",
+			msg.CheckHeight,
+			ctx.BlockHeight(),
+		)
+	}
+
+	// add vote to ballot
+	ballot, isNew, err := k.FindBallot(ctx, msg.Digest(), chain, types.ObservationType_InBoundTx)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(err, "failed to find ballot")
+	}
+	if isNew {
+		EmitEventBallotCreated(ctx, ballot, msg.Zrc20, chain.String())
+	}
+	ballot, err = k.AddVoteToBallot(ctx, ballot, msg.Creator, types.VoteType_SuccessObservation)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(err, "failed to add vote to ballot")
+	}
+	_, isFinalized := k.CheckIfFinalizingVote(ctx, ballot)
+	if !isFinalized {
+		return &types.MsgVoteInboundHaltResponse{}, nil
+	}
+
+	/**
+	 * Vote finalized, disable the inbound of the chain
+	 */
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		flags = *types.DefaultCrosschainFlags()
+	}
+	chainFlags, found := flags.ChainFlagsByChainID(msg.ChainId)
+	if !found {
+		chainFlags = types.ChainCrosschainFlags{
+			ChainId:           msg.ChainId,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+		}
+	}
+	chainFlags.IsInboundEnabled = false
+	chainFlags.InboundReEnableHeight = 0
+	flags.SetChainFlags(chainFlags)
+	k.SetCrosschainFlags(ctx, flags)
+
+	err = ctx.EventManager().EmitTypedEvents(&types.EventCrosschainFlagsUpdated{
+		MsgTypeUrl:        sdk.MsgTypeURL(&types.MsgVoteInboundHalt{}),
+		IsInboundEnabled:  flags.IsInboundEnabled,
+		IsOutboundEnabled: flags.IsOutboundEnabled,
+		Signer:            msg.Creator,
+		ChainFlags:        []types.ChainCrosschainFlags{chainFlags},
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventCrosschainFlagsUpdated :", err)
+	}
+
+	return &types.MsgVoteInboundHaltResponse{}, nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_VoteInboundHalt(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+	zrc20 := sample.EthAddress().Hex()
+
+	setupObservers := func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, n int) []string {
+		r := rand.New(rand.NewSource(9))
+		observers := make([]string, n)
+		for i := range observers {
+			validator := sample.Validator(t, r)
+			k.GetStakingKeeper().SetValidator(ctx, validator)
+			observer, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+			require.NoError(t, err)
+			observers[i] = observer.String()
+		}
+		k.SetObserverMapper(ctx, &types.ObserverMapper{
+			ObserverChain: &chain,
+			ObserverList:  observers,
+		})
+		return observers
+	}
+
+	t.Run("should halt the inbound of the chain once the ballot is finalized", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		srv := keeper.NewMsgServerImpl(*k)
+		observers := setupObservers(t, k, ctx, 2)
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+
+		_, err := srv.VoteInboundHalt(ctx, types.NewMsgVoteInboundHalt(observers[0], chain.ChainId, zrc20, 100, sdkmath.NewInt(42)))
+		require.NoError(t, err)
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsChainInboundEnabled(chain.ChainId))
+
+		_, err = srv.VoteInboundHalt(ctx, types.NewMsgVoteInboundHalt(observers[1], chain.ChainId, zrc20, 100, sdkmath.NewInt(43)))
+		require.NoError(t, err)
+		flags, found = k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.False(t, flags.IsChainInboundEnabled(chain.ChainId))
+		require.True(t, flags.IsChainOutboundEnabled(chain.ChainId))
+		require.True(t, flags.IsInboundEnabled)
+	})
+
+	t.Run("should keep the outbound override of the chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		srv := keeper.NewMsgServerImpl(*k)
+		observers := setupObservers(t, k, ctx, 1)
+		flags := *types.DefaultCrosschainFlags()
+		flags.SetChainFlags(types.ChainCrosschainFlags{ChainId: chain.ChainId, IsInboundEnabled: true, IsOutboundEnabled: false})
+		k.SetCrosschainFlags(ctx, flags)
+
+		_, err := srv.VoteInboundHalt(ctx, types.NewMsgVoteInboundHalt(observers[0], chain.ChainId, zrc20, 100, sdkmath.NewInt(42)))
+		require.NoError(t, err)
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.False(t, flags.IsChainInboundEnabled(chain.ChainId))
+		require.False(t, flags.IsChainOutboundEnabled(chain.ChainId))
+	})
+
+	t.Run("should fail if the voter is not an observer of the chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		srv := keeper.NewMsgServerImpl(*k)
+		setupObservers(t, k, ctx, 1)
+
+		_, err := srv.VoteInboundHalt(ctx, types.NewMsgVoteInboundHalt(sample.AccAddress(), chain.ChainId, zrc20, 100, sdkmath.NewInt(42)))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+
+	t.Run("should fail if the check height is in the future", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		srv := keeper.NewMsgServerImpl(*k)
+		observers := setupObservers(t, k, ctx, 1)
+
+		_, err := srv.VoteInboundHalt(ctx, types.NewMsgVoteInboundHalt(observers[0], chain.ChainId, zrc20, 101, sdkmath.NewInt(42)))
+		require.ErrorIs(t, err, types.ErrInvalidCheckHeight)
+	})
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// VoteTssBalance adds the vote of an observer on the balance of the current TSS on a chain.
+// Once the ballot is finalized, the balance is recorded and used to compute the amount of the funds migrated to a new TSS.
+func (k msgServer) VoteTssBalance(goCtx context.Context, msg *types.MsgVoteTssBalance) (*types.MsgVoteTssBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// GetChainFromChainID makes sure we are getting only supported chains
+	chain := k.GetParams(ctx).GetChainFromChainID(msg.ChainId)
+	if chain == nil {
+		return nil, cosmoserrors.Wrap(types.ErrSupportedChains, fmt.Sprintf("ChainID %d, tss balance vote", msg.ChainId))
+	}
+	if ok := k.IsAuthorized(ctx, msg.Creator, chain); !ok {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+	tss, found := k.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrTssNotFound
+	}
+	if msg.TssPubkey != tss.TssPubkey {
+		return nil, cosmoserrors.Wrapf(types.ErrTssPubkeyMismatch, "current tss pubkey %s, voted pubkey %s", tss.TssPubkey, msg.TssPubkey)
+	}
+
+	// add vote to ballot
+	ballot, isNew, err := k.FindBallot(ctx, msg.Digest(), chain, types.ObservationType_InBoundTx)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(err, "failed to find ballot")
+	}
+	if isNew {
+		EmitEventBallotCreated(ctx, ballot, msg.TssPubkey, chain.String())
+	}
+	ballot, err = k.AddVoteToBallot(ctx, ballot, msg.Creator, types.VoteType_SuccessObservation)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(err, "failed to add vote to ballot")
+	}
+	_, isFinalized := k.CheckIfFinalizingVote(ctx, ballot)
+	if !isFinalized {
+		return &types.MsgVoteTssBalanceResponse{}, nil
+	}
+
+	k.SetTssBalance(ctx, types.TssBalance{
+		ChainId:             msg.ChainId,
+		TssPubkey:           msg.TssPubkey,
+		Balance:             msg.Balance,
+		FinalizedZetaHeight: ctx.BlockHeight(),
+	})
+	return &types.MsgVoteTssBalanceResponse{}, nil
+}
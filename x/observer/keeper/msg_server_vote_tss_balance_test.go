@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_VoteTssBalance(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+
+	setup := func(t *testing.T) (*keeper.Keeper, sdk.Context, types.MsgServer, []string, types.TSS) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		r := rand.New(rand.NewSource(9))
+		observers := make([]string, 2)
+		for i := range observers {
+			validator := sample.Validator(t, r)
+			k.GetStakingKeeper().SetValidator(ctx, validator)
+			observer, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+			require.NoError(t, err)
+			observers[i] = observer.String()
+		}
+		k.SetObserverMapper(ctx, &types.ObserverMapper{
+			ObserverChain: &chain,
+			ObserverList:  observers,
+		})
+		tss := sample.Tss()
+		k.SetTSS(ctx, tss)
+		return k, ctx, keeper.NewMsgServerImpl(*k), observers, tss
+	}
+
+	t.Run("should set the tss balance once the ballot is finalized", func(t *testing.T) {
+		k, ctx, srv, observers, tss := setup(t)
+
+		_, err := srv.VoteTssBalance(ctx, types.NewMsgVoteTssBalance(observers[0], chain.ChainId, tss.TssPubkey, sdk.NewUint(42)))
+		require.NoError(t, err)
+		_, found := k.GetTssBalance(ctx, chain.ChainId)
+		require.False(t, found)
+
+		_, err = srv.VoteTssBalance(ctx, types.NewMsgVoteTssBalance(observers[1], chain.ChainId, tss.TssPubkey, sdk.NewUint(42)))
+		require.NoError(t, err)
+		balance, found := k.GetTssBalance(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, tss.TssPubkey, balance.TssPubkey)
+		require.True(t, balance.Balance.Equal(sdk.NewUint(42)))
+		require.EqualValues(t, 100, balance.FinalizedZetaHeight)
+	})
+
+	t.Run("should not set the tss balance if the observers disagree", func(t *testing.T) {
+		k, ctx, srv, observers, tss := setup(t)
+
+		_, err := srv.VoteTssBalance(ctx, types.NewMsgVoteTssBalance(observers[0], chain.ChainId, tss.TssPubkey, sdk.NewUint(42)))
+		require.NoError(t, err)
+		_, err = srv.VoteTssBalance(ctx, types.NewMsgVoteTssBalance(observers[1], chain.ChainId, tss.TssPubkey, sdk.NewUint(43)))
+		require.NoError(t, err)
+		_, found := k.GetTssBalance(ctx, chain.ChainId)
+		require.False(t, found)
+	})
+
+	t.Run("should fail if the pubkey is not the current tss", func(t *testing.T) {
+		_, ctx, srv, observers, _ := setup(t)
+
+		_, err := srv.VoteTssBalance(ctx, types.NewMsgVoteTssBalance(observers[0], chain.ChainId, sample.Tss().TssPubkey, sdk.NewUint(42)))
+		require.ErrorIs(t, err, types.ErrTssPubkeyMismatch)
+	})
+
+	t.Run("should fail if not an observer", func(t *testing.T) {
+		_, ctx, srv, _, tss := setup(t)
+
+		_, err := srv.VoteTssBalance(ctx, types.NewMsgVoteTssBalance(sample.AccAddress(), chain.ChainId, tss.TssPubkey, sdk.NewUint(42)))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+}
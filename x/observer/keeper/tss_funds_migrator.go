@@ -44,3 +44,33 @@ func (k Keeper) RemoveAllExistingMigrators(ctx sdk.Context) {
 		store.Delete(iterator.Key())
 	}
 }
+
+// SetTssBalance sets the balance of the TSS on a chain voted by the observers
+func (k Keeper) SetTssBalance(ctx sdk.Context, balance types.TssBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssBalanceKey))
+	b := k.cdc.MustMarshal(&balance)
+	store.Set([]byte(fmt.Sprintf("%d", balance.ChainId)), b)
+}
+
+// GetTssBalance returns the last balance of the TSS on a chain voted by the observers
+func (k Keeper) GetTssBalance(ctx sdk.Context, chainID int64) (val types.TssBalance, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssBalanceKey))
+	b := store.Get([]byte(fmt.Sprintf("%d", chainID)))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) GetAllTssBalances(ctx sdk.Context) (balances []types.TssBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssBalanceKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TssBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		balances = append(balances, val)
+	}
+	return
+}
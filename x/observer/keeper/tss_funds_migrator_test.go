@@ -30,3 +30,28 @@ func TestKeeper_GetTssFundMigrator(t *testing.T) {
 	})
 
 }
+
+func TestKeeper_GetTssBalance(t *testing.T) {
+	t.Run("Successfully set tss balance for chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		balance := sample.TssBalance(1)
+		k.SetTssBalance(ctx, balance)
+		got, found := k.GetTssBalance(ctx, balance.ChainId)
+		assert.True(t, found)
+		assert.Equal(t, balance, got)
+		_, found = k.GetTssBalance(ctx, 2)
+		assert.False(t, found)
+	})
+	t.Run("Verify the last voted balance is kept for a chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		balance1 := sample.TssBalance(1)
+		k.SetTssBalance(ctx, balance1)
+		balance2 := balance1
+		balance2.FinalizedZetaHeight = 2000
+		k.SetTssBalance(ctx, balance2)
+		k.SetTssBalance(ctx, sample.TssBalance(2))
+		balances := k.GetAllTssBalances(ctx)
+		assert.Equal(t, 2, len(balances))
+		assert.Equal(t, balance2, balances[0])
+	})
+}
@@ -78,12 +78,34 @@ func (k Keeper) FindBallot(ctx sdk.Context, index string, chain *common.Chain, o
 			BallotStatus:         types.BallotStatus_BallotInProgress,
 			BallotCreationHeight: ctx.BlockHeight(),
 		}
+		if obsParams.BallotWeighting == types.BallotWeighting_BondedTokens {
+			ballot.VoterWeights = k.GetVoterWeights(ctx, observerMapper.ObserverList)
+		}
 		isNew = true
 		k.AddBallotToList(ctx, ballot)
 	}
 	return
 }
 
+// GetVoterWeights returns the bonded tokens of the validator of each voter in the list
+// A voter without a bonded validator has a weight of zero
+func (k Keeper) GetVoterWeights(ctx sdk.Context, voterList []string) []sdk.Int {
+	weights := make([]sdk.Int, len(voterList))
+	for i, voter := range voterList {
+		weights[i] = sdk.ZeroInt()
+		valAddress, err := types.GetOperatorAddressFromAccAddress(voter)
+		if err != nil {
+			continue
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddress)
+		if !found {
+			continue
+		}
+		weights[i] = validator.BondedTokens()
+	}
+	return weights
+}
+
 func (k Keeper) IsValidator(ctx sdk.Context, creator string) error {
 	valAddress, err := types.GetOperatorAddressFromAccAddress(creator)
 	if err != nil {
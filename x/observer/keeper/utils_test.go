@@ -5,10 +5,15 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -104,3 +109,121 @@ func TestKeeper_IsAuthorized(t *testing.T) {
 		}
 	})
 }
+
+func TestKeeper_FindBallot(t *testing.T) {
+	setObservers := func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, chain *common.Chain, tokens []int64) []string {
+		r := rand.New(rand.NewSource(42))
+		observers := make([]string, len(tokens))
+		for i, amount := range tokens {
+			validator := sample.Validator(t, r)
+			validator.Tokens = sdk.NewInt(amount)
+			validator.Status = stakingtypes.Bonded
+			k.GetStakingKeeper().SetValidator(ctx, validator)
+			accAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+			require.NoError(t, err)
+			observers[i] = accAddress.String()
+		}
+		k.SetObserverMapper(ctx, &types.ObserverMapper{
+			ObserverChain: chain,
+			ObserverList:  observers,
+		})
+		return observers
+	}
+	setWeighting := func(k *keeper.Keeper, ctx sdk.Context, chain *common.Chain, weighting types.BallotWeighting) {
+		params := k.GetParams(ctx)
+		for _, observerParams := range params.ObserverParams {
+			if observerParams.Chain.IsEqual(*chain) {
+				observerParams.BallotWeighting = weighting
+			}
+		}
+		k.SetParams(ctx, params)
+	}
+
+	t.Run("unweighted ballot has no voter weights", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		chain := k.GetParams(ctx).GetSupportedChains()[0]
+		observers := setObservers(t, k, ctx, chain, []int64{100, 200, 300})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, observers, ballot.VoterList)
+		require.False(t, ballot.IsWeighted())
+	})
+
+	t.Run("weighted ballot snapshots the bonded tokens of the voters", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		chain := k.GetParams(ctx).GetSupportedChains()[0]
+		setWeighting(k, ctx, chain, types.BallotWeighting_BondedTokens)
+		setObservers(t, k, ctx, chain, []int64{100, 200, 700})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, []sdk.Int{sdk.NewInt(100), sdk.NewInt(200), sdk.NewInt(700)}, ballot.VoterWeights)
+	})
+
+	t.Run("voter without a bonded validator has no weight", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		chain := k.GetParams(ctx).GetSupportedChains()[0]
+		setWeighting(k, ctx, chain, types.BallotWeighting_BondedTokens)
+		observers := setObservers(t, k, ctx, chain, []int64{100, 200})
+
+		valAddress, err := types.GetOperatorAddressFromAccAddress(observers[1])
+		require.NoError(t, err)
+		validator, found := k.GetStakingKeeper().GetValidator(ctx, valAddress)
+		require.True(t, found)
+		validator.Status = stakingtypes.Unbonding
+		k.GetStakingKeeper().SetValidator(ctx, validator)
+
+		ballot, _, err := k.FindBallot(ctx, "index", chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.Equal(t, []sdk.Int{sdk.NewInt(100), sdk.ZeroInt()}, ballot.VoterWeights)
+	})
+
+	t.Run("weights changing while the ballot is open do not affect finalization", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		chain := k.GetParams(ctx).GetSupportedChains()[0]
+		setWeighting(k, ctx, chain, types.BallotWeighting_BondedTokens)
+		observers := setObservers(t, k, ctx, chain, []int64{100, 100, 800})
+
+		ballot, _, err := k.FindBallot(ctx, "index", chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		k.SetBallot(ctx, &ballot)
+
+		// the heaviest voter loses most of its stake after the ballot is created
+		valAddress, err := types.GetOperatorAddressFromAccAddress(observers[2])
+		require.NoError(t, err)
+		validator, found := k.GetStakingKeeper().GetValidator(ctx, valAddress)
+		require.True(t, found)
+		validator.Tokens = sdk.NewInt(1)
+		k.GetStakingKeeper().SetValidator(ctx, validator)
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.False(t, isNew)
+		require.Equal(t, sdk.NewInt(800), ballot.VoterWeights[2])
+
+		// the two light voters are not enough to finalize with the snapshotted weights
+		for _, observer := range observers[:2] {
+			ballot, err = k.AddVoteToBallot(ctx, ballot, observer, types.VoteType_SuccessObservation)
+			require.NoError(t, err)
+			var finalized bool
+			ballot, finalized = k.CheckIfFinalizingVote(ctx, ballot)
+			require.False(t, finalized)
+		}
+
+		// the heavy voter finalizes it
+		ballot, err = k.AddVoteToBallot(ctx, ballot, observers[2], types.VoteType_SuccessObservation)
+		require.NoError(t, err)
+		ballot, finalized := k.CheckIfFinalizingVote(ctx, ballot)
+		require.True(t, finalized)
+		require.Equal(t, types.BallotStatus_BallotFinalized_SuccessObservation, ballot.BallotStatus)
+
+		// a new ballot uses the updated weights
+		newBallot, isNew, err := k.FindBallot(ctx, "index2", chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, sdk.NewInt(1), newBallot.VoterWeights[2])
+	})
+}
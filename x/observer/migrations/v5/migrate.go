@@ -0,0 +1,27 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	GetParamsIfExists(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 4 to 5
+// This migration sets the ballot weighting of the existing observer params to one observer one vote,
+// the ballots of every chain are therefore finalized as before until the weighting is updated
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	p := k.GetParamsIfExists(ctx)
+	for _, observerParams := range p.ObserverParams {
+		if observerParams == nil {
+			continue
+		}
+		observerParams.BallotWeighting = types.BallotWeighting_OneObserverOneVote
+	}
+	k.SetParams(ctx, p)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v5 "github.com/zeta-chain/zetacore/x/observer/migrations/v5"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+
+	params := types.DefaultParams()
+	require.NotEmpty(t, params.ObserverParams)
+	for _, observerParams := range params.ObserverParams {
+		observerParams.BallotWeighting = types.BallotWeighting_BondedTokens
+	}
+	k.SetParams(ctx, params)
+
+	err := v5.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	params = k.GetParams(ctx)
+	for _, observerParams := range params.ObserverParams {
+		require.Equal(t, types.BallotWeighting_OneObserverOneVote, observerParams.BallotWeighting)
+	}
+	require.Equal(t, types.DefaultParams().BallotMaturityBlocks, params.BallotMaturityBlocks)
+	require.Equal(t, types.DefaultParams().AdminPolicy, params.AdminPolicy)
+}
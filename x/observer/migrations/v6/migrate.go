@@ -0,0 +1,29 @@
+package v6
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	GetParamsIfExists(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+	SetBallotBacklogHeight(ctx sdk.Context, height int64)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 5 to 6
+// This migration disables the archival of matured ballots and marks the ballots matured before the upgrade as backlog,
+// the backlog is deleted in bounded chunks by the begin blocker so the upgrade doesn't time out
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	p := k.GetParamsIfExists(ctx)
+	p.ArchiveMaturedBallots = false
+	k.SetParams(ctx, p)
+
+	// the ballots matured at the upgrade height are cleared by emissions, the ones below have already been processed
+	backlogHeight := ctx.BlockHeight() - p.BallotMaturityBlocks
+	if backlogHeight > 0 {
+		k.SetBallotBacklogHeight(ctx, backlogHeight)
+	}
+
+	return nil
+}
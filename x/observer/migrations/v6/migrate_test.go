@@ -0,0 +1,39 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v6 "github.com/zeta-chain/zetacore/x/observer/migrations/v6"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("should set backlog height of matured ballots", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		params := types.DefaultParams()
+		k.SetParams(ctx, params)
+		ctx = ctx.WithBlockHeight(1000)
+
+		err := v6.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		require.False(t, k.GetParams(ctx).ArchiveMaturedBallots)
+		backlogHeight, found := k.GetBallotBacklogHeight(ctx)
+		require.True(t, found)
+		require.Equal(t, 1000-params.BallotMaturityBlocks, backlogHeight)
+	})
+
+	t.Run("should not set backlog height if no ballot is matured", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		k.SetParams(ctx, types.DefaultParams())
+		ctx = ctx.WithBlockHeight(10)
+
+		err := v6.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		_, found := k.GetBallotBacklogHeight(ctx)
+		require.False(t, found)
+	})
+}
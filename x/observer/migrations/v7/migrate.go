@@ -0,0 +1,96 @@
+package v7
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	GetAllBlockHeader(ctx sdk.Context) []common.BlockHeader
+	GetBlockHeader(ctx sdk.Context, hash []byte) (common.BlockHeader, bool)
+	GetBlockHeaderState(ctx sdk.Context, chainID int64) (types.BlockHeaderState, bool)
+	SetBlockHeaderState(ctx sdk.Context, blockHeaderState types.BlockHeaderState)
+	GetBlockHeaderWork(ctx sdk.Context, chainID int64, height int64, hash []byte) (*big.Int, bool)
+	SetBlockHeaderWork(ctx sdk.Context, chainID int64, height int64, hash []byte, work *big.Int)
+	SetCanonicalBlockHash(ctx sdk.Context, chainID int64, height int64, hash []byte)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 6 to 7
+// This migration indexes the existing block headers by height with their cumulative work,
+// and computes the tips and the canonical chain of each chain from them
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	headers := k.GetAllBlockHeader(ctx)
+
+	// parents are indexed before their children
+	sort.SliceStable(headers, func(i, j int) bool {
+		return headers[i].Height < headers[j].Height
+	})
+
+	states := make(map[int64]*types.BlockHeaderState)
+	var chainIDs []int64
+	for _, header := range headers {
+		work, err := types.BlockHeaderWork(header)
+		if err != nil {
+			return err
+		}
+		if parentWork, found := k.GetBlockHeaderWork(ctx, header.ChainId, header.Height-1, header.ParentHash); found {
+			work.Add(work, parentWork)
+		}
+		k.SetBlockHeaderWork(ctx, header.ChainId, header.Height, header.Hash, work)
+
+		bhs, found := states[header.ChainId]
+		if !found {
+			state, found := k.GetBlockHeaderState(ctx, header.ChainId)
+			if !found {
+				state = types.BlockHeaderState{
+					ChainId:        header.ChainId,
+					EarliestHeight: header.Height,
+				}
+			}
+			state.Tips = nil
+			bhs = &state
+			states[header.ChainId] = bhs
+			chainIDs = append(chainIDs, header.ChainId)
+		}
+		bhs.AddTip(header.Hash, header.ParentHash)
+	}
+
+	for _, chainID := range chainIDs {
+		bhs := states[chainID]
+
+		// the canonical tip is the tip with the most work, the previous latest block wins ties
+		var tip common.BlockHeader
+		tipWork := big.NewInt(0)
+		for _, hash := range bhs.Tips {
+			header, found := k.GetBlockHeader(ctx, hash)
+			if !found {
+				continue
+			}
+			work, _ := k.GetBlockHeaderWork(ctx, chainID, header.Height, hash)
+			cmp := work.Cmp(tipWork)
+			if cmp > 0 || (cmp == 0 && bytes.Equal(hash, bhs.LatestBlockHash)) {
+				tip = header
+				tipWork = work
+			}
+		}
+
+		current, found := tip, tipWork.Sign() > 0
+		if found {
+			bhs.LatestHeight = tip.Height
+			bhs.LatestBlockHash = tip.Hash
+		}
+		for found {
+			k.SetCanonicalBlockHash(ctx, chainID, current.Height, current.Hash)
+			current, found = k.GetBlockHeader(ctx, current.ParentHash)
+		}
+
+		k.SetBlockHeaderState(ctx, *bhs)
+	}
+
+	return nil
+}
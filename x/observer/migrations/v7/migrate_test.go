@@ -0,0 +1,61 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v7 "github.com/zeta-chain/zetacore/x/observer/migrations/v7"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	chainID := common.GoerliLocalnetChain().ChainId
+
+	for _, header := range []struct {
+		height     int64
+		hash       string
+		parentHash string
+	}{
+		{1, "1", "0"},
+		{2, "2", "1"},
+		{3, "3", "2"},
+		{3, "3b", "2"},
+		{4, "4b", "3b"},
+	} {
+		k.SetBlockHeader(ctx, common.BlockHeader{
+			Height:     header.height,
+			Hash:       []byte(header.hash),
+			ParentHash: []byte(header.parentHash),
+			ChainId:    chainID,
+			Header:     common.NewEthereumHeader([]byte(header.hash)),
+		})
+	}
+	k.SetBlockHeaderState(ctx, types.BlockHeaderState{
+		ChainId:         chainID,
+		EarliestHeight:  1,
+		LatestHeight:    4,
+		LatestBlockHash: []byte("4b"),
+	})
+
+	err := v7.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	bhs, found := k.GetBlockHeaderState(ctx, chainID)
+	require.True(t, found)
+	require.EqualValues(t, 1, bhs.EarliestHeight)
+	require.EqualValues(t, 4, bhs.LatestHeight)
+	require.Equal(t, "4b", string(bhs.LatestBlockHash))
+	require.ElementsMatch(t, [][]byte{[]byte("3"), []byte("4b")}, bhs.Tips)
+
+	for height, hash := range map[int64]string{1: "1", 2: "2", 3: "3b", 4: "4b"} {
+		canonicalHash, found := k.GetCanonicalBlockHash(ctx, chainID, height)
+		require.True(t, found)
+		require.Equal(t, hash, string(canonicalHash))
+	}
+	work, found := k.GetBlockHeaderWork(ctx, chainID, 4, []byte("4b"))
+	require.True(t, found)
+	require.EqualValues(t, 4, work.Int64())
+}
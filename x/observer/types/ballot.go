@@ -7,6 +7,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// BallotBacklogPruneLimit is the maximum number of ballots or ballot lists visited per block when deleting the ballot backlog
+const BallotBacklogPruneLimit = 500
+
 func (m Ballot) AddVote(address string, vote VoteType) (Ballot, error) {
 	if m.HasVoted(address) {
 		return m, errors.Wrap(ErrUnableToAddVote, fmt.Sprintf(" Voter : %s | Ballot :%s | Already Voted", address, m.String()))
@@ -37,21 +40,22 @@ func (m Ballot) GetVoterIndex(address string) int {
 // Is finalzing vote checks sets the ballot to a final status if enough votes have been added
 // If it has already been finalized it returns false
 // It enough votes have not been added it returns false
+// Votes are weighted with the voter weights if the ballot is weighted, otherwise each vote counts as one
 func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 	if m.BallotStatus != BallotStatus_BallotInProgress {
 		return m, false
 	}
 	success, failure := sdk.ZeroDec(), sdk.ZeroDec()
-	total := sdk.NewDec(int64(len(m.VoterList)))
+	total := m.GetTotalWeight()
 	if total.IsZero() {
 		return m, false
 	}
-	for _, vote := range m.Votes {
+	for i, vote := range m.Votes {
 		if vote == VoteType_SuccessObservation {
-			success = success.Add(sdk.OneDec())
+			success = success.Add(m.GetVoterWeight(i))
 		}
 		if vote == VoteType_FailureObservation {
-			failure = failure.Add(sdk.OneDec())
+			failure = failure.Add(m.GetVoterWeight(i))
 		}
 
 	}
@@ -71,6 +75,35 @@ func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 	return m, false
 }
 
+// IsWeighted returns true if the ballot carries a weight snapshot for its voters
+func (m Ballot) IsWeighted() bool {
+	return len(m.VoterWeights) > 0
+}
+
+// GetVoterWeight returns the weight of the voter at `index` in the `VoterList`
+// Voters of an unweighted ballot all have a weight of one
+func (m Ballot) GetVoterWeight(index int) sdk.Dec {
+	if !m.IsWeighted() {
+		return sdk.OneDec()
+	}
+	if index < 0 || index >= len(m.VoterWeights) {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(m.VoterWeights[index])
+}
+
+// GetTotalWeight returns the sum of the weights of all the voters in the `VoterList`
+func (m Ballot) GetTotalWeight() sdk.Dec {
+	if !m.IsWeighted() {
+		return sdk.NewDec(int64(len(m.VoterList)))
+	}
+	total := sdk.ZeroDec()
+	for i := range m.VoterList {
+		total = total.Add(m.GetVoterWeight(i))
+	}
+	return total
+}
+
 func CreateVotes(len int) []VoteType {
 	voterList := make([]VoteType, len)
 	for i := range voterList {
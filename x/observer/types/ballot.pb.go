@@ -81,15 +81,42 @@ func (BallotStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eac86b249c97b5b, []int{1}
 }
 
+// BallotWeighting defines how the votes of the observers are weighted when finalizing a ballot.
+type BallotWeighting int32
+
+const (
+	BallotWeighting_OneObserverOneVote BallotWeighting = 0
+	BallotWeighting_BondedTokens       BallotWeighting = 1
+)
+
+var BallotWeighting_name = map[int32]string{
+	0: "OneObserverOneVote",
+	1: "BondedTokens",
+}
+
+var BallotWeighting_value = map[string]int32{
+	"OneObserverOneVote": 0,
+	"BondedTokens":       1,
+}
+
+func (x BallotWeighting) String() string {
+	return proto.EnumName(BallotWeighting_name, int32(x))
+}
+
+func (BallotWeighting) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eac86b249c97b5b, []int{2}
+}
+
 type Ballot struct {
-	Index                string                                 `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	BallotIdentifier     string                                 `protobuf:"bytes,2,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
-	VoterList            []string                               `protobuf:"bytes,3,rep,name=voter_list,json=voterList,proto3" json:"voter_list,omitempty"`
-	Votes                []VoteType                             `protobuf:"varint,4,rep,packed,name=votes,proto3,enum=zetachain.zetacore.observer.VoteType" json:"votes,omitempty"`
-	ObservationType      ObservationType                        `protobuf:"varint,5,opt,name=observation_type,json=observationType,proto3,enum=zetachain.zetacore.observer.ObservationType" json:"observation_type,omitempty"`
-	BallotThreshold      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
-	BallotStatus         BallotStatus                           `protobuf:"varint,7,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
-	BallotCreationHeight int64                                  `protobuf:"varint,8,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	Index                string                                   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	BallotIdentifier     string                                   `protobuf:"bytes,2,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
+	VoterList            []string                                 `protobuf:"bytes,3,rep,name=voter_list,json=voterList,proto3" json:"voter_list,omitempty"`
+	Votes                []VoteType                               `protobuf:"varint,4,rep,packed,name=votes,proto3,enum=zetachain.zetacore.observer.VoteType" json:"votes,omitempty"`
+	ObservationType      ObservationType                          `protobuf:"varint,5,opt,name=observation_type,json=observationType,proto3,enum=zetachain.zetacore.observer.ObservationType" json:"observation_type,omitempty"`
+	BallotThreshold      github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,6,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
+	BallotStatus         BallotStatus                             `protobuf:"varint,7,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
+	BallotCreationHeight int64                                    `protobuf:"varint,8,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	VoterWeights         []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,rep,name=voter_weights,json=voterWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voter_weights"`
 }
 
 func (m *Ballot) Reset()         { *m = Ballot{} }
@@ -229,6 +256,7 @@ func (m *BallotListForHeight) GetBallotsIndexList() []string {
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.VoteType", VoteType_name, VoteType_value)
 	proto.RegisterEnum("zetachain.zetacore.observer.BallotStatus", BallotStatus_name, BallotStatus_value)
+	proto.RegisterEnum("zetachain.zetacore.observer.BallotWeighting", BallotWeighting_name, BallotWeighting_value)
 	proto.RegisterType((*Ballot)(nil), "zetachain.zetacore.observer.Ballot")
 	proto.RegisterType((*BallotListForHeight)(nil), "zetachain.zetacore.observer.BallotListForHeight")
 }
@@ -236,41 +264,45 @@ func init() {
 func init() { proto.RegisterFile("observer/ballot.proto", fileDescriptor_9eac86b249c97b5b) }
 
 var fileDescriptor_9eac86b249c97b5b = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x93, 0x26, 0x34, 0x43, 0x69, 0xc2, 0x12, 0x82, 0x15, 0x84, 0x1b, 0x45, 0xa2, 0x0a,
-	0xa5, 0xb5, 0xa5, 0xc2, 0x8d, 0x5b, 0x40, 0x11, 0x91, 0x50, 0x01, 0xb7, 0x02, 0x15, 0x0e, 0x96,
-	0x63, 0x0f, 0xf1, 0x0a, 0xd7, 0x1b, 0xed, 0x6e, 0xaa, 0x36, 0x5f, 0xc1, 0x47, 0x70, 0xe0, 0x53,
-	0x7a, 0xec, 0x11, 0x71, 0xa8, 0x20, 0xf9, 0x11, 0xe4, 0x5d, 0x3b, 0x04, 0x29, 0xca, 0xc9, 0x3b,
-	0xf3, 0xe6, 0xbd, 0xd9, 0x9d, 0x37, 0x86, 0xfb, 0x6c, 0x28, 0x90, 0x9f, 0x23, 0x77, 0x86, 0x7e,
-	0x1c, 0x33, 0x69, 0x8f, 0x39, 0x93, 0x8c, 0x3c, 0x9c, 0xa2, 0xf4, 0x83, 0xc8, 0xa7, 0x89, 0xad,
-	0x4e, 0x8c, 0xa3, 0x9d, 0x57, 0xb6, 0x1a, 0x23, 0x36, 0x62, 0xaa, 0xce, 0x49, 0x4f, 0x9a, 0xd2,
-	0x7a, 0xb0, 0x50, 0xca, 0x0f, 0x1a, 0xe8, 0xfc, 0x29, 0x41, 0xa5, 0xa7, 0xc4, 0x49, 0x03, 0xca,
-	0x34, 0x09, 0xf1, 0xc2, 0x34, 0xda, 0x46, 0xb7, 0xea, 0xea, 0x80, 0x3c, 0x85, 0xbb, 0xba, 0xb9,
-	0x47, 0x43, 0x4c, 0x24, 0xfd, 0x42, 0x91, 0x9b, 0x45, 0x55, 0x51, 0xd7, 0xc0, 0x60, 0x91, 0x27,
-	0x8f, 0x00, 0xce, 0x99, 0x44, 0xee, 0xc5, 0x54, 0x48, 0xb3, 0xd4, 0x2e, 0x75, 0xab, 0x6e, 0x55,
-	0x65, 0xde, 0x50, 0x21, 0xc9, 0x0b, 0x28, 0xa7, 0x81, 0x30, 0x37, 0xda, 0xa5, 0xee, 0xf6, 0xe1,
-	0x63, 0x7b, 0xcd, 0x43, 0xec, 0x0f, 0x4c, 0xe2, 0xc9, 0xe5, 0x18, 0x5d, 0xcd, 0x21, 0x1f, 0xa1,
-	0xae, 0x31, 0x5f, 0x52, 0x96, 0x78, 0xf2, 0x72, 0x8c, 0x66, 0xb9, 0x6d, 0x74, 0xb7, 0x0f, 0xf7,
-	0xd7, 0xea, 0xbc, 0xfd, 0x47, 0x52, 0x72, 0x35, 0xf6, 0x7f, 0x82, 0x9c, 0x42, 0xf6, 0x10, 0x4f,
-	0x46, 0x1c, 0x45, 0xc4, 0xe2, 0xd0, 0xac, 0xa4, 0x0f, 0xec, 0xd9, 0x57, 0x37, 0x3b, 0x85, 0x5f,
-	0x37, 0x3b, 0xbb, 0x23, 0x2a, 0xa3, 0xc9, 0xd0, 0x0e, 0xd8, 0x99, 0x13, 0x30, 0x71, 0xc6, 0x44,
-	0xf6, 0x39, 0x10, 0xe1, 0x57, 0x27, 0xbd, 0x89, 0xb0, 0x5f, 0x61, 0xe0, 0xd6, 0xb4, 0xce, 0x49,
-	0x2e, 0x43, 0x8e, 0xe0, 0x4e, 0x26, 0x2d, 0xa4, 0x2f, 0x27, 0xc2, 0xbc, 0xa5, 0x2e, 0xfc, 0x64,
-	0xed, 0x85, 0xb5, 0x1d, 0xc7, 0x8a, 0xe0, 0x6e, 0x0d, 0x97, 0x22, 0xf2, 0x1c, 0x9a, 0x99, 0x5e,
-	0xc0, 0x51, 0xcf, 0x21, 0x42, 0x3a, 0x8a, 0xa4, 0xb9, 0xd9, 0x36, 0xba, 0x25, 0xb7, 0xa1, 0xd1,
-	0x97, 0x19, 0xf8, 0x5a, 0x61, 0x9d, 0xcf, 0x70, 0x4f, 0x6b, 0xa6, 0x26, 0xf4, 0x19, 0xd7, 0x69,
-	0xd2, 0x84, 0x4a, 0x46, 0x36, 0x14, 0x39, 0x8b, 0xc8, 0x3e, 0x10, 0x2d, 0x23, 0x3c, 0xb5, 0x02,
-	0xda, 0xcc, 0xa2, 0x32, 0x33, 0x9b, 0x94, 0x18, 0xa4, 0x40, 0x2a, 0xb7, 0xf7, 0x1e, 0x36, 0x73,
-	0xa7, 0x48, 0x13, 0xc8, 0xf1, 0x24, 0x08, 0x50, 0x88, 0xa5, 0xa1, 0xd7, 0x0b, 0x69, 0xbe, 0xef,
-	0xd3, 0x78, 0xc2, 0x71, 0x39, 0x6f, 0x90, 0x1a, 0xdc, 0x3e, 0x62, 0xf2, 0x14, 0x65, 0xaa, 0x10,
-	0xd6, 0x8b, 0xad, 0x8d, 0x1f, 0xdf, 0x2d, 0x63, 0x6f, 0x0a, 0x5b, 0xcb, 0x33, 0x20, 0xbb, 0xd0,
-	0xd1, 0x71, 0x9f, 0x26, 0x7e, 0x4c, 0xa7, 0x18, 0x7a, 0x2b, 0xdb, 0xac, 0xa8, 0x5b, 0xd9, 0xb6,
-	0x01, 0x75, 0x5d, 0x37, 0x48, 0xde, 0x71, 0x36, 0xe2, 0x28, 0x44, 0xde, 0xbb, 0x37, 0xb8, 0x9a,
-	0x59, 0xc6, 0xf5, 0xcc, 0x32, 0x7e, 0xcf, 0x2c, 0xe3, 0xdb, 0xdc, 0x2a, 0x5c, 0xcf, 0xad, 0xc2,
-	0xcf, 0xb9, 0x55, 0xf8, 0xe4, 0x2c, 0x2d, 0x41, 0x6a, 0xda, 0x81, 0xf2, 0xcf, 0xc9, 0xfd, 0x73,
-	0x2e, 0x16, 0xbf, 0x96, 0xde, 0x88, 0x61, 0x45, 0xfd, 0x61, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xd6, 0xe7, 0x98, 0x67, 0xc6, 0x03, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xb6, 0x9b, 0x36, 0x7f, 0xb3, 0x7f, 0xda, 0x98, 0x25, 0x14, 0xab, 0x08, 0x37, 0xaa, 0x44,
+	0x15, 0x4a, 0x6b, 0x4b, 0x85, 0x1b, 0x27, 0x02, 0xaa, 0x88, 0x84, 0x5a, 0x70, 0x2b, 0xaa, 0xc2,
+	0xc1, 0x72, 0xec, 0xc1, 0x5e, 0xd5, 0xdd, 0xad, 0x76, 0x37, 0xa5, 0xed, 0x53, 0xf0, 0x10, 0x1c,
+	0x78, 0x02, 0x9e, 0xa1, 0xc7, 0x1e, 0x11, 0x87, 0x0a, 0x25, 0x2f, 0x82, 0x76, 0xd7, 0x09, 0x41,
+	0x8a, 0x22, 0x71, 0xf2, 0xce, 0x7c, 0xb3, 0xdf, 0x7c, 0x3b, 0xdf, 0x18, 0xdd, 0x63, 0x3d, 0x01,
+	0xfc, 0x1c, 0x78, 0xd0, 0x8b, 0x8b, 0x82, 0x49, 0xff, 0x8c, 0x33, 0xc9, 0xf0, 0x83, 0x2b, 0x90,
+	0x71, 0x92, 0xc7, 0x84, 0xfa, 0xfa, 0xc4, 0x38, 0xf8, 0xa3, 0xca, 0xd5, 0x66, 0xc6, 0x32, 0xa6,
+	0xeb, 0x02, 0x75, 0x32, 0x57, 0x56, 0xef, 0x8f, 0x99, 0x46, 0x07, 0x03, 0xac, 0x7f, 0x9f, 0x47,
+	0xd5, 0x8e, 0x26, 0xc7, 0x4d, 0xb4, 0x40, 0x68, 0x0a, 0x17, 0xae, 0xdd, 0xb2, 0xdb, 0xb5, 0xd0,
+	0x04, 0xf8, 0x09, 0xba, 0x63, 0x9a, 0x47, 0x24, 0x05, 0x2a, 0xc9, 0x27, 0x02, 0xdc, 0x9d, 0xd3,
+	0x15, 0x8e, 0x01, 0xba, 0xe3, 0x3c, 0x7e, 0x88, 0xd0, 0x39, 0x93, 0xc0, 0xa3, 0x82, 0x08, 0xe9,
+	0x56, 0x5a, 0x95, 0x76, 0x2d, 0xac, 0xe9, 0xcc, 0x1b, 0x22, 0x24, 0x7e, 0x8e, 0x16, 0x54, 0x20,
+	0xdc, 0xf9, 0x56, 0xa5, 0xbd, 0xbc, 0xf3, 0xc8, 0x9f, 0xf1, 0x10, 0xff, 0x3d, 0x93, 0x70, 0x78,
+	0x79, 0x06, 0xa1, 0xb9, 0x83, 0x8f, 0x90, 0x63, 0xb0, 0x58, 0x12, 0x46, 0x23, 0x79, 0x79, 0x06,
+	0xee, 0x42, 0xcb, 0x6e, 0x2f, 0xef, 0x6c, 0xcd, 0xe4, 0xd9, 0xff, 0x73, 0x49, 0xd3, 0x35, 0xd8,
+	0xdf, 0x09, 0x7c, 0x8c, 0xca, 0x87, 0x44, 0x32, 0xe7, 0x20, 0x72, 0x56, 0xa4, 0x6e, 0x55, 0x3d,
+	0xb0, 0xe3, 0x5f, 0xdf, 0xae, 0x59, 0x3f, 0x6f, 0xd7, 0x36, 0x32, 0x22, 0xf3, 0x7e, 0xcf, 0x4f,
+	0xd8, 0x69, 0x90, 0x30, 0x71, 0xca, 0x44, 0xf9, 0xd9, 0x16, 0xe9, 0x49, 0xa0, 0x94, 0x08, 0xff,
+	0x15, 0x24, 0x61, 0xc3, 0xf0, 0x1c, 0x8e, 0x68, 0xf0, 0x1e, 0x5a, 0x2a, 0xa9, 0x85, 0x8c, 0x65,
+	0x5f, 0xb8, 0xff, 0x69, 0xc1, 0x8f, 0x67, 0x0a, 0x36, 0x76, 0x1c, 0xe8, 0x0b, 0x61, 0xbd, 0x37,
+	0x11, 0xe1, 0x67, 0x68, 0xa5, 0xe4, 0x4b, 0x38, 0x98, 0x39, 0xe4, 0x40, 0xb2, 0x5c, 0xba, 0x8b,
+	0x2d, 0xbb, 0x5d, 0x09, 0x9b, 0x06, 0x7d, 0x59, 0x82, 0xaf, 0x35, 0x86, 0x0f, 0xd0, 0x92, 0x71,
+	0xe5, 0xb3, 0x8e, 0x85, 0x5b, 0x53, 0xc6, 0xfc, 0xd3, 0xeb, 0xba, 0x54, 0x86, 0x75, 0x4d, 0x72,
+	0x64, 0x38, 0xd6, 0x3f, 0xa2, 0xbb, 0x46, 0xa8, 0x72, 0x76, 0x97, 0xf1, 0xb2, 0xd7, 0x0a, 0xaa,
+	0x96, 0x8a, 0x6c, 0xad, 0xa8, 0x8c, 0xf0, 0x16, 0xc2, 0x46, 0x9b, 0x88, 0xf4, 0x5e, 0x99, 0x0d,
+	0x99, 0xd3, 0x1b, 0x52, 0x8e, 0x5f, 0x74, 0x15, 0xa0, 0xe8, 0x36, 0xdf, 0xa1, 0xc5, 0x91, 0xfd,
+	0x78, 0x05, 0xe1, 0x83, 0x7e, 0x92, 0x80, 0x10, 0x13, 0x4e, 0x3a, 0x96, 0xca, 0xef, 0xc6, 0xa4,
+	0xe8, 0x73, 0x98, 0xcc, 0xdb, 0xb8, 0x81, 0xfe, 0xdf, 0x63, 0xf2, 0x18, 0xa4, 0x62, 0x48, 0x9d,
+	0xb9, 0xd5, 0xf9, 0x6f, 0x5f, 0x3d, 0x7b, 0xf3, 0x0a, 0xd5, 0x27, 0x07, 0x8b, 0x37, 0xd0, 0xba,
+	0x89, 0x77, 0x09, 0x8d, 0x0b, 0x72, 0x05, 0x69, 0x34, 0xb5, 0xcd, 0x94, 0xba, 0xa9, 0x6d, 0x9b,
+	0xc8, 0x31, 0x75, 0x5d, 0xfa, 0x96, 0xb3, 0x8c, 0x83, 0x10, 0xe3, 0xde, 0x2f, 0x50, 0xc3, 0x60,
+	0x66, 0x78, 0x84, 0x66, 0x4a, 0xfd, 0x3e, 0x2d, 0x29, 0x80, 0xef, 0x53, 0x50, 0x6a, 0x1d, 0x0b,
+	0x3b, 0xa8, 0xde, 0x61, 0x34, 0x85, 0xf4, 0x90, 0x9d, 0x00, 0x15, 0x8e, 0x6d, 0x28, 0x3a, 0xdd,
+	0xeb, 0x81, 0x67, 0xdf, 0x0c, 0x3c, 0xfb, 0xd7, 0xc0, 0xb3, 0xbf, 0x0c, 0x3d, 0xeb, 0x66, 0xe8,
+	0x59, 0x3f, 0x86, 0x9e, 0xf5, 0x21, 0x98, 0xb0, 0x4f, 0x2d, 0xd3, 0xb6, 0xde, 0xab, 0x60, 0xb4,
+	0x57, 0xc1, 0xc5, 0xf8, 0x97, 0x37, 0x5e, 0xf6, 0xaa, 0xfa, 0xcf, 0x7f, 0xfa, 0x3b, 0x00, 0x00,
+	0xff, 0xff, 0x74, 0x52, 0x6e, 0x32, 0x5e, 0x04, 0x00, 0x00,
 }
 
 func (m *Ballot) Marshal() (dAtA []byte, err error) {
@@ -293,6 +325,20 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoterWeights) > 0 {
+		for iNdEx := len(m.VoterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.VoterWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.VoterWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBallot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.BallotCreationHeight != 0 {
 		i = encodeVarintBallot(dAtA, i, uint64(m.BallotCreationHeight))
 		i--
@@ -448,6 +494,12 @@ func (m *Ballot) Size() (n int) {
 	if m.BallotCreationHeight != 0 {
 		n += 1 + sovBallot(uint64(m.BallotCreationHeight))
 	}
+	if len(m.VoterWeights) > 0 {
+		for _, e := range m.VoterWeights {
+			l = e.Size()
+			n += 1 + l + sovBallot(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -760,6 +812,42 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.VoterWeights = append(m.VoterWeights, v)
+			if err := m.VoterWeights[len(m.VoterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBallot(dAtA[iNdEx:])
@@ -344,6 +344,102 @@ func TestBallot_IsFinalizingVote(t *testing.T) {
 	}
 }
 
+func TestBallot_IsFinalizingWeightedVote(t *testing.T) {
+	tt := []struct {
+		name            string
+		BallotThreshold sdk.Dec
+		weights         []int64
+		Votes           []VoteType
+		finalizingVote  int
+		finalStatus     BallotStatus
+	}{
+		{
+			name:            "heavy voter alone finalizes the ballot",
+			BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+			weights:         []int64{700, 100, 100, 100},
+			Votes:           []VoteType{VoteType_SuccessObservation, VoteType_NotYetVoted, VoteType_NotYetVoted, VoteType_NotYetVoted},
+			finalizingVote:  0,
+			finalStatus:     BallotStatus_BallotFinalized_SuccessObservation,
+		},
+		{
+			name:            "majority of light voters cannot finalize the ballot",
+			BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+			weights:         []int64{700, 100, 100, 100},
+			Votes:           []VoteType{VoteType_NotYetVoted, VoteType_SuccessObservation, VoteType_SuccessObservation, VoteType_SuccessObservation},
+			finalizingVote:  0,
+			finalStatus:     BallotStatus_BallotInProgress,
+		},
+		{
+			name:            "failure finalized at threshold",
+			BallotThreshold: sdk.MustNewDecFromStr("0.5"),
+			weights:         []int64{300, 200, 100, 400},
+			Votes:           []VoteType{VoteType_FailureObservation, VoteType_SuccessObservation, VoteType_NotYetVoted, VoteType_FailureObservation},
+			finalizingVote:  3,
+			finalStatus:     BallotStatus_BallotFinalized_FailureObservation,
+		},
+		{
+			name:            "voters without weight do not count",
+			BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+			weights:         []int64{0, 0, 100, 0},
+			Votes:           []VoteType{VoteType_FailureObservation, VoteType_FailureObservation, VoteType_NotYetVoted, VoteType_FailureObservation},
+			finalizingVote:  0,
+			finalStatus:     BallotStatus_BallotInProgress,
+		},
+		{
+			name:            "ballot with zero total weight cannot be finalized",
+			BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+			weights:         []int64{0, 0},
+			Votes:           []VoteType{VoteType_SuccessObservation, VoteType_SuccessObservation},
+			finalizingVote:  0,
+			finalStatus:     BallotStatus_BallotInProgress,
+		},
+	}
+	for _, test := range tt {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			weights := make([]sdk.Int, len(test.weights))
+			for i, w := range test.weights {
+				weights[i] = sdk.NewInt(w)
+			}
+			ballot := Ballot{
+				BallotStatus:    BallotStatus_BallotInProgress,
+				BallotThreshold: test.BallotThreshold,
+				VoterList:       make([]string, len(test.Votes)),
+				VoterWeights:    weights,
+			}
+			assert.True(t, ballot.IsWeighted())
+			isFinalizingVote := false
+			for index, vote := range test.Votes {
+				ballot.Votes = append(ballot.Votes, vote)
+				ballot, isFinalizingVote = ballot.IsFinalizingVote()
+				if isFinalizingVote {
+					assert.Equal(t, test.finalizingVote, index)
+				}
+			}
+			assert.Equal(t, test.finalStatus, ballot.BallotStatus)
+		})
+	}
+}
+
+func TestBallot_GetVoterWeight(t *testing.T) {
+	t.Run("unweighted ballot", func(t *testing.T) {
+		ballot := Ballot{VoterList: []string{"Observer1", "Observer2", "Observer3"}}
+		assert.False(t, ballot.IsWeighted())
+		assert.Equal(t, sdk.OneDec(), ballot.GetVoterWeight(0))
+		assert.Equal(t, sdk.NewDec(3), ballot.GetTotalWeight())
+	})
+	t.Run("weighted ballot", func(t *testing.T) {
+		ballot := Ballot{
+			VoterList:    []string{"Observer1", "Observer2", "Observer3"},
+			VoterWeights: []sdk.Int{sdk.NewInt(10), sdk.NewInt(20), sdk.NewInt(30)},
+		}
+		assert.Equal(t, sdk.NewDec(20), ballot.GetVoterWeight(1))
+		assert.Equal(t, sdk.ZeroDec(), ballot.GetVoterWeight(-1))
+		assert.Equal(t, sdk.ZeroDec(), ballot.GetVoterWeight(3))
+		assert.Equal(t, sdk.NewDec(60), ballot.GetTotalWeight())
+	})
+}
+
 func Test_BuildRewardsDistribution(t *testing.T) {
 	tt := []struct {
 		name         string
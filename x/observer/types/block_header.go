@@ -0,0 +1,49 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/zeta-chain/zetacore/common"
+)
+
+// BlockHeaderPruneLimit is the maximum number of block headers pruned each time a block header is added
+const BlockHeaderPruneLimit = 100
+
+// HasTip returns true if the hash is the tip of one of the forks
+func (m BlockHeaderState) HasTip(hash []byte) bool {
+	for _, tip := range m.Tips {
+		if bytes.Equal(tip, hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTip makes the block header the tip of its fork, replacing its parent if the parent was a tip
+func (m *BlockHeaderState) AddTip(hash []byte, parentHash []byte) {
+	m.RemoveTip(parentHash)
+	if !m.HasTip(hash) {
+		m.Tips = append(m.Tips, hash)
+	}
+}
+
+// RemoveTip removes the hash from the tips
+func (m *BlockHeaderState) RemoveTip(hash []byte) {
+	tips := m.Tips[:0]
+	for _, tip := range m.Tips {
+		if !bytes.Equal(tip, hash) {
+			tips = append(tips, tip)
+		}
+	}
+	m.Tips = tips
+}
+
+// BlockHeaderWork returns the work of a single block header
+// EVM chains follow the highest fork so each header weighs one
+func BlockHeaderWork(header common.BlockHeader) (*big.Int, error) {
+	if common.IsBitcoinChain(header.ChainId) {
+		return header.Header.Work()
+	}
+	return big.NewInt(1), nil
+}
@@ -29,6 +29,8 @@ type BlockHeaderState struct {
 	LatestHeight    int64  `protobuf:"varint,2,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
 	EarliestHeight  int64  `protobuf:"varint,3,opt,name=earliest_height,json=earliestHeight,proto3" json:"earliest_height,omitempty"`
 	LatestBlockHash []byte `protobuf:"bytes,4,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+	// hashes of the headers that have no child yet, one per competing fork
+	Tips [][]byte `protobuf:"bytes,5,rep,name=tips,proto3" json:"tips,omitempty"`
 }
 
 func (m *BlockHeaderState) Reset()         { *m = BlockHeaderState{} }
@@ -92,6 +94,13 @@ func (m *BlockHeaderState) GetLatestBlockHash() []byte {
 	return nil
 }
 
+func (m *BlockHeaderState) GetTips() [][]byte {
+	if m != nil {
+		return m.Tips
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlockHeaderState)(nil), "zetachain.zetacore.observer.BlockHeaderState")
 }
@@ -99,24 +108,25 @@ func init() {
 func init() { proto.RegisterFile("observer/block_header.proto", fileDescriptor_9fad6da3aeeeaa45) }
 
 var fileDescriptor_9fad6da3aeeeaa45 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4f, 0x2a, 0x4e,
-	0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0x8e, 0xcf, 0x48, 0x4d, 0x4c, 0x49,
-	0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xae, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48,
-	0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x60, 0xea, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xea, 0xf4, 0x41, 0x2c, 0x88, 0x16, 0x29, 0x71, 0xb8, 0x79, 0x30, 0x06, 0x44, 0x42,
-	0x69, 0x39, 0x23, 0x97, 0x80, 0x13, 0xc8, 0x0a, 0x0f, 0xb0, 0x0d, 0xc1, 0x25, 0x89, 0x25, 0xa9,
-	0x42, 0x92, 0x5c, 0x1c, 0x60, 0xe3, 0xe3, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98, 0x83,
-	0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0x21, 0x65, 0x2e, 0xde, 0x9c, 0xc4, 0x92, 0xd4, 0xe2, 0x92, 0xf8,
-	0x8c, 0xd4, 0xcc, 0xf4, 0x8c, 0x12, 0x09, 0x26, 0xb0, 0x3c, 0x0f, 0x44, 0xd0, 0x03, 0x2c, 0x26,
-	0xa4, 0xce, 0xc5, 0x9f, 0x9a, 0x58, 0x94, 0x93, 0x89, 0xa4, 0x8c, 0x19, 0xac, 0x8c, 0x0f, 0x26,
-	0x0c, 0x55, 0xa8, 0xc5, 0x25, 0x08, 0x35, 0x0d, 0xea, 0xcd, 0xc4, 0xe2, 0x0c, 0x09, 0x16, 0x05,
-	0x46, 0x0d, 0x9e, 0x20, 0x7e, 0x88, 0x04, 0xc4, 0x6d, 0x89, 0xc5, 0x19, 0x4e, 0x9e, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
-	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9f, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x0a, 0x10, 0x5d, 0xb0, 0x63, 0xf5, 0x61, 0x61, 0xa3, 0x5f, 0x01,
-	0xf7, 0xb4, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa7, 0xf5, 0x45, 0x4d, 0x66, 0x01, 0x00, 0x00,
+	// 281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x50, 0xc1, 0x4e, 0xb4, 0x30,
+	0x18, 0xa4, 0x3f, 0xfb, 0xab, 0x69, 0xd0, 0xd5, 0xc6, 0x44, 0xdc, 0x4d, 0x1a, 0xa2, 0x07, 0x89,
+	0x89, 0xf4, 0xe0, 0x1b, 0xec, 0x69, 0xf7, 0x8a, 0x37, 0x2f, 0xa4, 0x40, 0x43, 0x1b, 0xd1, 0x92,
+	0xb6, 0x1a, 0xf5, 0x29, 0x7c, 0x1f, 0x5f, 0xc0, 0xe3, 0x1e, 0x3d, 0x1a, 0x78, 0x11, 0xc3, 0x07,
+	0x6c, 0xbc, 0x4d, 0x67, 0xa6, 0xf3, 0x7d, 0xdf, 0xe0, 0xa5, 0xce, 0xad, 0x30, 0x2f, 0xc2, 0xb0,
+	0xbc, 0xd6, 0xc5, 0x43, 0x26, 0x05, 0x2f, 0x85, 0x49, 0x1a, 0xa3, 0x9d, 0x26, 0xcb, 0x77, 0xe1,
+	0x78, 0x21, 0xb9, 0x7a, 0x4a, 0x00, 0x69, 0x23, 0x92, 0xc9, 0xbf, 0x38, 0xad, 0x74, 0xa5, 0xc1,
+	0xc7, 0x7a, 0x34, 0x7c, 0x59, 0x9c, 0xed, 0xf2, 0x26, 0x30, 0x08, 0x17, 0x9f, 0x08, 0x1f, 0xaf,
+	0xfa, 0x11, 0x6b, 0x98, 0x70, 0xe7, 0xb8, 0x13, 0xe4, 0x1c, 0x1f, 0x40, 0x7c, 0xa6, 0xca, 0x10,
+	0x45, 0x28, 0xf6, 0xd3, 0x7d, 0x78, 0x6f, 0x4a, 0x72, 0x89, 0x0f, 0x6b, 0xee, 0x84, 0x75, 0x99,
+	0x14, 0xaa, 0x92, 0x2e, 0xfc, 0x07, 0x7a, 0x30, 0x90, 0x6b, 0xe0, 0xc8, 0x15, 0x9e, 0x0b, 0x6e,
+	0x6a, 0xf5, 0xc7, 0xe6, 0x83, 0xed, 0x68, 0xa2, 0x47, 0xe3, 0x35, 0x3e, 0x19, 0xd3, 0xc6, 0x33,
+	0xb9, 0x95, 0xe1, 0x2c, 0x42, 0x71, 0x90, 0xce, 0x07, 0x61, 0xd8, 0x8d, 0x5b, 0x49, 0x08, 0x9e,
+	0x39, 0xd5, 0xd8, 0xf0, 0x7f, 0xe4, 0xc7, 0x41, 0x0a, 0x78, 0xb5, 0xf9, 0x6a, 0x29, 0xda, 0xb6,
+	0x14, 0xfd, 0xb4, 0x14, 0x7d, 0x74, 0xd4, 0xdb, 0x76, 0xd4, 0xfb, 0xee, 0xa8, 0x77, 0xcf, 0x2a,
+	0xe5, 0xe4, 0x73, 0x9e, 0x14, 0xfa, 0x91, 0xf5, 0x25, 0xdd, 0xc0, 0x01, 0x6c, 0xea, 0x8b, 0xbd,
+	0xee, 0x8a, 0x60, 0xee, 0xad, 0x11, 0x36, 0xdf, 0x83, 0x3e, 0x6e, 0x7f, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0x4d, 0xcf, 0x9d, 0x37, 0x7a, 0x01, 0x00, 0x00,
 }
 
 func (m *BlockHeaderState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +149,15 @@ func (m *BlockHeaderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tips) > 0 {
+		for iNdEx := len(m.Tips) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tips[iNdEx])
+			copy(dAtA[i:], m.Tips[iNdEx])
+			i = encodeVarintBlockHeader(dAtA, i, uint64(len(m.Tips[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.LatestBlockHash) > 0 {
 		i -= len(m.LatestBlockHash)
 		copy(dAtA[i:], m.LatestBlockHash)
@@ -194,6 +213,12 @@ func (m *BlockHeaderState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlockHeader(uint64(l))
 	}
+	if len(m.Tips) > 0 {
+		for _, b := range m.Tips {
+			l = len(b)
+			n += 1 + l + sovBlockHeader(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -323,6 +348,38 @@ func (m *BlockHeaderState) Unmarshal(dAtA []byte) error {
 				m.LatestBlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tips", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tips = append(m.Tips, make([]byte, postIndex-iNdEx))
+			copy(m.Tips[len(m.Tips)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlockHeader(dAtA[iNdEx:])
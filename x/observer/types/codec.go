@@ -15,6 +15,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateKeygen{}, "crosschain/UpdateKeygen", nil)
 	cdc.RegisterConcrete(&MsgAddBlockHeader{}, "crosschain/AddBlockHeader", nil)
 	cdc.RegisterConcrete(&MsgUpdateObserver{}, "observer/UpdateObserver", nil)
+	cdc.RegisterConcrete(&MsgVoteInboundHalt{}, "observer/VoteInboundHalt", nil)
+	cdc.RegisterConcrete(&MsgVoteTssBalance{}, "observer/VoteTssBalance", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +28,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateKeygen{},
 		&MsgAddBlockHeader{},
 		&MsgUpdateObserver{},
+		&MsgVoteInboundHalt{},
+		&MsgVoteTssBalance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
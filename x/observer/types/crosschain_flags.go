@@ -35,3 +35,94 @@ func DefaultCrosschainFlags() *CrosschainFlags {
 		BlockHeaderVerificationFlags: &DefaultBlockHeaderVerificationFlags,
 	}
 }
+
+// ChainFlagsByChainID returns the override of the inbound and outbound flags for a chain
+func (m CrosschainFlags) ChainFlagsByChainID(chainID int64) (ChainCrosschainFlags, bool) {
+	for _, chainFlags := range m.ChainFlags {
+		if chainFlags.ChainId == chainID {
+			return chainFlags, true
+		}
+	}
+	return ChainCrosschainFlags{}, false
+}
+
+// IsChainInboundEnabled returns true if inbound is enabled globally and not disabled for the chain
+func (m CrosschainFlags) IsChainInboundEnabled(chainID int64) bool {
+	chainFlags, found := m.ChainFlagsByChainID(chainID)
+	return m.IsInboundEnabled && (!found || chainFlags.IsInboundEnabled)
+}
+
+// IsChainOutboundEnabled returns true if outbound is enabled globally and not disabled for the chain
+func (m CrosschainFlags) IsChainOutboundEnabled(chainID int64) bool {
+	chainFlags, found := m.ChainFlagsByChainID(chainID)
+	return m.IsOutboundEnabled && (!found || chainFlags.IsOutboundEnabled)
+}
+
+// SetChainFlags sets the override of a chain, the override is removed if it enables both inbound and outbound
+func (m *CrosschainFlags) SetChainFlags(chainFlags ChainCrosschainFlags) {
+	overrides := make([]ChainCrosschainFlags, 0, len(m.ChainFlags)+1)
+	for _, override := range m.ChainFlags {
+		if override.ChainId != chainFlags.ChainId {
+			overrides = append(overrides, override)
+		}
+	}
+	if !chainFlags.IsInboundEnabled || !chainFlags.IsOutboundEnabled {
+		overrides = append(overrides, chainFlags)
+	}
+	m.ChainFlags = overrides
+}
+
+// ReEnable enables the global flags and the chain flags whose re-enable height is reached, only the direction that has
+// been disabled with a re-enable height is enabled again. It returns true if the flags have been updated
+func (m *CrosschainFlags) ReEnable(height int64) bool {
+	updated := false
+	if reached(m.InboundReEnableHeight, height) {
+		m.IsInboundEnabled = true
+		m.InboundReEnableHeight = 0
+		updated = true
+	}
+	if reached(m.OutboundReEnableHeight, height) {
+		m.IsOutboundEnabled = true
+		m.OutboundReEnableHeight = 0
+		updated = true
+	}
+
+	overrides := make([]ChainCrosschainFlags, 0, len(m.ChainFlags))
+	for _, override := range m.ChainFlags {
+		if reached(override.InboundReEnableHeight, height) {
+			override.IsInboundEnabled = true
+			override.InboundReEnableHeight = 0
+			updated = true
+		}
+		if reached(override.OutboundReEnableHeight, height) {
+			override.IsOutboundEnabled = true
+			override.OutboundReEnableHeight = 0
+			updated = true
+		}
+		if override.IsInboundEnabled && override.IsOutboundEnabled {
+			continue
+		}
+		overrides = append(overrides, override)
+	}
+	m.ChainFlags = overrides
+	return updated
+}
+
+// reached returns true if a re-enable height is set and reached
+func reached(reEnableHeight, height int64) bool {
+	return reEnableHeight > 0 && reEnableHeight <= height
+}
+
+// ReEnableHeightAfterUpdate returns the re-enable height of a flag after its update
+// A flag disabled by the update is enabled again at the re-enable height of the update, a flag already disabled keeps
+// its re-enable height so an indefinite disable is not lifted by a later timed disable, and an enabled flag has none
+func ReEnableHeightAfterUpdate(wasEnabled, isEnabled bool, currentReEnableHeight, reEnableHeight int64) int64 {
+	switch {
+	case isEnabled:
+		return 0
+	case wasEnabled:
+		return reEnableHeight
+	default:
+		return currentReEnableHeight
+	}
+}
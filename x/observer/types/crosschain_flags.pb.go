@@ -159,18 +159,103 @@ func (m *BlockHeaderVerificationFlags) GetIsBtcTypeChainEnabled() bool {
 	return false
 }
 
+// ChainCrosschainFlags overrides the global inbound and outbound flags for a single chain
+// A chain can only be disabled by its override, it is enabled only if the global flag is enabled
+type ChainCrosschainFlags struct {
+	ChainId           int64 `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	IsInboundEnabled  bool  `protobuf:"varint,2,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
+	IsOutboundEnabled bool  `protobuf:"varint,3,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
+	// Block height at which the disabled inbound of the chain is enabled again, 0 if never enabled automatically
+	InboundReEnableHeight int64 `protobuf:"varint,4,opt,name=inboundReEnableHeight,proto3" json:"inboundReEnableHeight,omitempty"`
+	// Block height at which the disabled outbound of the chain is enabled again, 0 if never enabled automatically
+	OutboundReEnableHeight int64 `protobuf:"varint,5,opt,name=outboundReEnableHeight,proto3" json:"outboundReEnableHeight,omitempty"`
+}
+
+func (m *ChainCrosschainFlags) Reset()         { *m = ChainCrosschainFlags{} }
+func (m *ChainCrosschainFlags) String() string { return proto.CompactTextString(m) }
+func (*ChainCrosschainFlags) ProtoMessage()    {}
+func (*ChainCrosschainFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b948b59e4d986f49, []int{2}
+}
+func (m *ChainCrosschainFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainCrosschainFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainCrosschainFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainCrosschainFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainCrosschainFlags.Merge(m, src)
+}
+func (m *ChainCrosschainFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainCrosschainFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainCrosschainFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainCrosschainFlags proto.InternalMessageInfo
+
+func (m *ChainCrosschainFlags) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainCrosschainFlags) GetIsInboundEnabled() bool {
+	if m != nil {
+		return m.IsInboundEnabled
+	}
+	return false
+}
+
+func (m *ChainCrosschainFlags) GetIsOutboundEnabled() bool {
+	if m != nil {
+		return m.IsOutboundEnabled
+	}
+	return false
+}
+
+func (m *ChainCrosschainFlags) GetInboundReEnableHeight() int64 {
+	if m != nil {
+		return m.InboundReEnableHeight
+	}
+	return 0
+}
+
+func (m *ChainCrosschainFlags) GetOutboundReEnableHeight() int64 {
+	if m != nil {
+		return m.OutboundReEnableHeight
+	}
+	return 0
+}
+
 type CrosschainFlags struct {
 	IsInboundEnabled             bool                          `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled            bool                          `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags        *GasPriceIncreaseFlags        `protobuf:"bytes,3,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,4,opt,name=blockHeaderVerificationFlags,proto3" json:"blockHeaderVerificationFlags,omitempty"`
+	ChainFlags                   []ChainCrosschainFlags        `protobuf:"bytes,5,rep,name=chainFlags,proto3" json:"chainFlags"`
+	// Block height at which the global inbound is enabled again, 0 if never enabled automatically
+	InboundReEnableHeight int64 `protobuf:"varint,6,opt,name=inboundReEnableHeight,proto3" json:"inboundReEnableHeight,omitempty"`
+	// Block height at which the global outbound is enabled again, 0 if never enabled automatically
+	OutboundReEnableHeight int64 `protobuf:"varint,7,opt,name=outboundReEnableHeight,proto3" json:"outboundReEnableHeight,omitempty"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
 func (m *CrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*CrosschainFlags) ProtoMessage()    {}
 func (*CrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b948b59e4d986f49, []int{2}
+	return fileDescriptor_b948b59e4d986f49, []int{3}
 }
 func (m *CrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +312,27 @@ func (m *CrosschainFlags) GetBlockHeaderVerificationFlags() *BlockHeaderVerifica
 	return nil
 }
 
+func (m *CrosschainFlags) GetChainFlags() []ChainCrosschainFlags {
+	if m != nil {
+		return m.ChainFlags
+	}
+	return nil
+}
+
+func (m *CrosschainFlags) GetInboundReEnableHeight() int64 {
+	if m != nil {
+		return m.InboundReEnableHeight
+	}
+	return 0
+}
+
+func (m *CrosschainFlags) GetOutboundReEnableHeight() int64 {
+	if m != nil {
+		return m.OutboundReEnableHeight
+	}
+	return 0
+}
+
 type LegacyCrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
@@ -237,7 +343,7 @@ func (m *LegacyCrosschainFlags) Reset()         { *m = LegacyCrosschainFlags{} }
 func (m *LegacyCrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*LegacyCrosschainFlags) ProtoMessage()    {}
 func (*LegacyCrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b948b59e4d986f49, []int{3}
+	return fileDescriptor_b948b59e4d986f49, []int{4}
 }
 func (m *LegacyCrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +396,7 @@ func (m *LegacyCrosschainFlags) GetGasPriceIncreaseFlags() *GasPriceIncreaseFlag
 func init() {
 	proto.RegisterType((*GasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.GasPriceIncreaseFlags")
 	proto.RegisterType((*BlockHeaderVerificationFlags)(nil), "zetachain.zetacore.observer.BlockHeaderVerificationFlags")
+	proto.RegisterType((*ChainCrosschainFlags)(nil), "zetachain.zetacore.observer.ChainCrosschainFlags")
 	proto.RegisterType((*CrosschainFlags)(nil), "zetachain.zetacore.observer.CrosschainFlags")
 	proto.RegisterType((*LegacyCrosschainFlags)(nil), "zetachain.zetacore.observer.LegacyCrosschainFlags")
 }
@@ -297,38 +404,44 @@ func init() {
 func init() { proto.RegisterFile("observer/crosschain_flags.proto", fileDescriptor_b948b59e4d986f49) }
 
 var fileDescriptor_b948b59e4d986f49 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0x5d, 0x95, 0x65, 0xca, 0xb2, 0x1a, 0x2d, 0xd6, 0x75, 0x49, 0x4b, 0x4f, 0x45,
-	0x34, 0x23, 0xd5, 0x83, 0x5e, 0x5b, 0x57, 0x0d, 0xac, 0x58, 0x82, 0x78, 0xf0, 0x22, 0x93, 0xc9,
-	0xeb, 0x64, 0x30, 0x3b, 0x53, 0x66, 0x26, 0x4b, 0x2b, 0xf8, 0x05, 0x3c, 0x79, 0x14, 0x3f, 0xd1,
-	0x1e, 0xf7, 0xe0, 0x41, 0x10, 0x54, 0xda, 0x2f, 0x22, 0x9d, 0xd8, 0xc5, 0xb6, 0x31, 0x1f, 0xc0,
-	0xdb, 0xe4, 0xfd, 0xdf, 0xff, 0xfd, 0x92, 0xf7, 0x5e, 0x06, 0xb7, 0x55, 0x6c, 0x40, 0x9f, 0x82,
-	0x26, 0x4c, 0x2b, 0x63, 0x58, 0x4a, 0x85, 0x7c, 0x3b, 0xce, 0x28, 0x37, 0xc1, 0x44, 0x2b, 0xab,
-	0xbc, 0xdb, 0xef, 0xc1, 0x52, 0x17, 0x0e, 0xdc, 0x49, 0x69, 0x08, 0x56, 0x9e, 0x83, 0x1b, 0x5c,
-	0x71, 0xe5, 0xf2, 0xc8, 0xf2, 0x54, 0x58, 0x0e, 0x7c, 0xae, 0x14, 0xcf, 0x80, 0xb8, 0xa7, 0x38,
-	0x1f, 0x93, 0x24, 0xd7, 0xd4, 0x0a, 0x25, 0x0b, 0xbd, 0xfb, 0xa5, 0x8e, 0x9b, 0xcf, 0xa8, 0x19,
-	0x69, 0xc1, 0x20, 0x94, 0x4c, 0x03, 0x35, 0xf0, 0x74, 0x89, 0xf4, 0x3a, 0xb8, 0x01, 0x13, 0xc5,
-	0xd2, 0x63, 0x90, 0xdc, 0xa6, 0x2d, 0xd4, 0x41, 0xbd, 0x9d, 0xe8, 0xef, 0x90, 0x17, 0xe2, 0x3d,
-	0x0d, 0x56, 0xcf, 0x42, 0x69, 0x41, 0x9f, 0xd2, 0xac, 0x55, 0xef, 0xa0, 0x5e, 0xa3, 0x7f, 0x2b,
-	0x28, 0x98, 0xc1, 0x8a, 0x19, 0x3c, 0xf9, 0xc3, 0x1c, 0xec, 0x9e, 0xfd, 0x68, 0xd7, 0x3e, 0xff,
-	0x6c, 0xa3, 0x68, 0xdd, 0xe9, 0x3d, 0xc2, 0x37, 0xf9, 0xc6, 0x5b, 0x8c, 0x40, 0x33, 0x90, 0xb6,
-	0xb5, 0xd3, 0x41, 0xbd, 0xbd, 0xe8, 0x5f, 0xb2, 0x77, 0x1f, 0x5f, 0xdf, 0x94, 0x5e, 0xd0, 0x69,
-	0xeb, 0x92, 0x73, 0x95, 0x49, 0x5e, 0x0f, 0xef, 0x9f, 0xd0, 0xe9, 0x08, 0x64, 0x22, 0x24, 0x1f,
-	0x32, 0x3b, 0x35, 0xad, 0xcb, 0x2e, 0x7b, 0x33, 0xdc, 0xfd, 0x88, 0xf0, 0xe1, 0x20, 0x53, 0xec,
-	0xdd, 0x73, 0xa0, 0x09, 0xe8, 0xd7, 0xa0, 0xc5, 0x58, 0x30, 0xf7, 0x29, 0x45, 0x8f, 0x1e, 0xe2,
-	0xa6, 0x30, 0x47, 0x36, 0x7d, 0x35, 0x9b, 0xc0, 0x70, 0x39, 0x97, 0x23, 0x49, 0xe3, 0x0c, 0x12,
-	0xd7, 0xad, 0xdd, 0xa8, 0x5c, 0x2c, 0x5c, 0x03, 0xcb, 0xb6, 0x5c, 0xf5, 0x95, 0xab, 0x44, 0xec,
-	0x7e, 0xad, 0xe3, 0xfd, 0xe1, 0xc5, 0x5e, 0x14, 0xfc, 0x3b, 0xf8, 0xaa, 0x30, 0xa1, 0x8c, 0x55,
-	0x2e, 0x93, 0x75, 0xf4, 0x56, 0xdc, 0xbb, 0x8b, 0xaf, 0x09, 0xf3, 0x32, 0xb7, 0x6b, 0xc9, 0x05,
-	0x71, 0x5b, 0xf0, 0x52, 0xdc, 0xe4, 0x65, 0x6b, 0xe1, 0xc6, 0xd1, 0xe8, 0xf7, 0x83, 0x8a, 0x55,
-	0x0c, 0x4a, 0x17, 0x2a, 0x2a, 0x2f, 0xe8, 0x7d, 0xc0, 0x87, 0x71, 0x45, 0x8f, 0xdd, 0x24, 0x1b,
-	0xfd, 0xc7, 0x95, 0xc0, 0xaa, 0x21, 0x45, 0x95, 0xe5, 0xbb, 0xdf, 0x11, 0x6e, 0x1e, 0x03, 0xa7,
-	0x6c, 0xf6, 0x1f, 0x36, 0x77, 0x10, 0x9e, 0xcd, 0x7d, 0x74, 0x3e, 0xf7, 0xd1, 0xaf, 0xb9, 0x8f,
-	0x3e, 0x2d, 0xfc, 0xda, 0xf9, 0xc2, 0xaf, 0x7d, 0x5b, 0xf8, 0xb5, 0x37, 0x84, 0x0b, 0x9b, 0xe6,
-	0x71, 0xc0, 0xd4, 0x09, 0x59, 0x42, 0xee, 0x39, 0x1e, 0x59, 0xf1, 0xc8, 0x94, 0x5c, 0xdc, 0x46,
-	0x76, 0x36, 0x01, 0x13, 0x5f, 0x71, 0xbf, 0xf3, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xde,
-	0xd8, 0x41, 0xc9, 0xa6, 0x04, 0x00, 0x00,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xee, 0x34, 0xfb, 0x8b, 0x29, 0xcb, 0x6a, 0xdc, 0x6a, 0x5c, 0x97, 0x6c, 0xe9, 0xa9, 0x88,
+	0x26, 0x5a, 0x45, 0xf4, 0xda, 0xba, 0xba, 0x81, 0x15, 0x4b, 0x10, 0x05, 0x2f, 0x32, 0x99, 0xbc,
+	0x4e, 0x82, 0xdd, 0x4c, 0x99, 0x99, 0x2c, 0xad, 0xe0, 0x3f, 0xe0, 0xc9, 0xa3, 0xe8, 0x3f, 0xb4,
+	0xc7, 0x3d, 0x0a, 0x82, 0x4a, 0xfb, 0x4f, 0x78, 0x94, 0x4e, 0xb6, 0xeb, 0xb6, 0x4d, 0x03, 0x7a,
+	0xf4, 0x36, 0xf3, 0xbe, 0xf7, 0xcd, 0x37, 0xef, 0xcd, 0xf7, 0x12, 0xbc, 0xc7, 0x03, 0x09, 0xe2,
+	0x18, 0x84, 0x4b, 0x05, 0x97, 0x92, 0x46, 0x24, 0x4e, 0xde, 0x74, 0x7b, 0x84, 0x49, 0xa7, 0x2f,
+	0xb8, 0xe2, 0xe6, 0x8d, 0x77, 0xa0, 0x88, 0x0e, 0x3b, 0x7a, 0xc5, 0x05, 0x38, 0x53, 0xce, 0xce,
+	0x36, 0xe3, 0x8c, 0xeb, 0x3c, 0x77, 0xb2, 0xca, 0x28, 0x3b, 0x36, 0xe3, 0x9c, 0xf5, 0xc0, 0xd5,
+	0xbb, 0x20, 0xed, 0xba, 0x61, 0x2a, 0x88, 0x8a, 0x79, 0x92, 0xe1, 0xf5, 0xcf, 0x65, 0x5c, 0x7d,
+	0x4a, 0x64, 0x47, 0xc4, 0x14, 0xbc, 0x84, 0x0a, 0x20, 0x12, 0x9e, 0x4c, 0x24, 0xcd, 0x1a, 0xae,
+	0x40, 0x9f, 0xd3, 0xe8, 0x10, 0x12, 0xa6, 0x22, 0x0b, 0xd5, 0x50, 0xc3, 0xf0, 0x2f, 0x86, 0x4c,
+	0x0f, 0x6f, 0x0a, 0x50, 0x62, 0xe8, 0x25, 0x0a, 0xc4, 0x31, 0xe9, 0x59, 0xe5, 0x1a, 0x6a, 0x54,
+	0x9a, 0xd7, 0x9d, 0x4c, 0xd3, 0x99, 0x6a, 0x3a, 0x8f, 0xcf, 0x34, 0x5b, 0x1b, 0x27, 0xdf, 0xf7,
+	0x4a, 0x9f, 0x7e, 0xec, 0x21, 0x7f, 0x96, 0x69, 0x3e, 0xc4, 0xd7, 0xd8, 0xdc, 0x2d, 0x3a, 0x20,
+	0x28, 0x24, 0xca, 0x32, 0x6a, 0xa8, 0xb1, 0xe9, 0x2f, 0x83, 0xcd, 0x3b, 0xf8, 0xca, 0x3c, 0xf4,
+	0x8c, 0x0c, 0xac, 0x15, 0xcd, 0xca, 0x83, 0xcc, 0x06, 0xde, 0x3a, 0x22, 0x83, 0x0e, 0x24, 0x61,
+	0x9c, 0xb0, 0x36, 0x55, 0x03, 0x69, 0xad, 0xea, 0xec, 0xf9, 0x70, 0xfd, 0x03, 0xc2, 0xbb, 0xad,
+	0x1e, 0xa7, 0x6f, 0x0f, 0x80, 0x84, 0x20, 0x5e, 0x82, 0x88, 0xbb, 0x31, 0xd5, 0xa5, 0x64, 0x3d,
+	0xba, 0x8f, 0xab, 0xb1, 0xdc, 0x57, 0xd1, 0x8b, 0x61, 0x1f, 0xda, 0x93, 0x77, 0xd9, 0x4f, 0x48,
+	0xd0, 0x83, 0x50, 0x77, 0x6b, 0xc3, 0xcf, 0x07, 0x33, 0x56, 0x4b, 0xd1, 0x05, 0x56, 0x79, 0xca,
+	0xca, 0x01, 0xeb, 0xbf, 0x10, 0xde, 0xd6, 0x81, 0xf6, 0xb9, 0x39, 0xb2, 0x4b, 0x58, 0x78, 0x5d,
+	0xef, 0xbc, 0xf0, 0xec, 0x91, 0xa6, 0x5b, 0xf3, 0x26, 0xbe, 0x14, 0x4b, 0x2f, 0x09, 0x78, 0x9a,
+	0x84, 0xb3, 0x1a, 0x0b, 0x71, 0xf3, 0x16, 0xbe, 0x1c, 0xcb, 0xe7, 0xa9, 0x9a, 0x49, 0x36, 0x74,
+	0xf2, 0x22, 0xa0, 0x4b, 0xc8, 0xf8, 0x3e, 0x64, 0xb1, 0x03, 0x88, 0x59, 0xa4, 0x74, 0xdf, 0x0d,
+	0x3f, 0x1f, 0x34, 0x1f, 0xe0, 0xab, 0x3c, 0x55, 0x39, 0x88, 0x7e, 0x00, 0xc3, 0x5f, 0x82, 0xd6,
+	0xbf, 0xac, 0xe0, 0xad, 0xf9, 0xaa, 0xf3, 0x6a, 0x43, 0x7f, 0x53, 0x5b, 0x79, 0x59, 0x6d, 0x11,
+	0xae, 0xb2, 0xbc, 0x89, 0xd0, 0xdd, 0xa8, 0x34, 0x9b, 0x4e, 0xc1, 0x14, 0x3a, 0xb9, 0xb3, 0xe4,
+	0xe7, 0x1f, 0x68, 0xbe, 0xc7, 0xbb, 0x41, 0x81, 0xbd, 0x74, 0x33, 0x2b, 0xcd, 0x47, 0x85, 0x82,
+	0x45, 0xfe, 0xf4, 0x0b, 0x8f, 0x37, 0x5f, 0x61, 0xfc, 0xa7, 0xa1, 0xd6, 0x6a, 0xcd, 0x68, 0x54,
+	0x9a, 0x77, 0x0b, 0xc5, 0xf2, 0xfc, 0xd7, 0x5a, 0x99, 0x0c, 0xb5, 0x7f, 0xe1, 0xa8, 0xe5, 0xee,
+	0x58, 0xfb, 0x37, 0x77, 0xac, 0x17, 0xba, 0xe3, 0x1b, 0xc2, 0xd5, 0x43, 0x60, 0x84, 0x0e, 0xff,
+	0x43, 0x8f, 0xb4, 0xbc, 0x93, 0x91, 0x8d, 0x4e, 0x47, 0x36, 0xfa, 0x39, 0xb2, 0xd1, 0xc7, 0xb1,
+	0x5d, 0x3a, 0x1d, 0xdb, 0xa5, 0xaf, 0x63, 0xbb, 0xf4, 0xda, 0x65, 0xb1, 0x8a, 0xd2, 0xc0, 0xa1,
+	0xfc, 0xc8, 0x9d, 0x88, 0xdc, 0xd6, 0x7a, 0xee, 0x54, 0xcf, 0x1d, 0xb8, 0xe7, 0xff, 0x13, 0x35,
+	0xec, 0x83, 0x0c, 0xd6, 0xf4, 0x07, 0xf9, 0xde, 0xef, 0x01, 0x00, 0xe1, 0xb5, 0xcf, 0x6b, 0x68,
+	0x06, 0x00, 0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -425,6 +538,64 @@ func (m *BlockHeaderVerificationFlags) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainCrosschainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainCrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutboundReEnableHeight != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.OutboundReEnableHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InboundReEnableHeight != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.InboundReEnableHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CrosschainFlags) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -445,6 +616,30 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OutboundReEnableHeight != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.OutboundReEnableHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InboundReEnableHeight != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.InboundReEnableHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChainFlags) > 0 {
+		for iNdEx := len(m.ChainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.BlockHeaderVerificationFlags != nil {
 		{
 			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -596,6 +791,30 @@ func (m *BlockHeaderVerificationFlags) Size() (n int) {
 	return n
 }
 
+func (m *ChainCrosschainFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ChainId))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
+	if m.InboundReEnableHeight != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.InboundReEnableHeight))
+	}
+	if m.OutboundReEnableHeight != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.OutboundReEnableHeight))
+	}
+	return n
+}
+
 func (m *CrosschainFlags) Size() (n int) {
 	if m == nil {
 		return 0
@@ -616,6 +835,18 @@ func (m *CrosschainFlags) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
+	if len(m.ChainFlags) > 0 {
+		for _, e := range m.ChainFlags {
+			l = e.Size()
+			n += 1 + l + sovCrosschainFlags(uint64(l))
+		}
+	}
+	if m.InboundReEnableHeight != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.InboundReEnableHeight))
+	}
+	if m.OutboundReEnableHeight != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.OutboundReEnableHeight))
+	}
 	return n
 }
 
@@ -893,6 +1124,153 @@ func (m *BlockHeaderVerificationFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChainCrosschainFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainCrosschainFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainCrosschainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundReEnableHeight", wireType)
+			}
+			m.InboundReEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundReEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundReEnableHeight", wireType)
+			}
+			m.OutboundReEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundReEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1034,6 +1412,78 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainFlags = append(m.ChainFlags, ChainCrosschainFlags{})
+			if err := m.ChainFlags[len(m.ChainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundReEnableHeight", wireType)
+			}
+			m.InboundReEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundReEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundReEnableHeight", wireType)
+			}
+			m.OutboundReEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundReEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -34,4 +34,13 @@ var (
 	ErrLastObserverCountNotFound       = errorsmod.Register(ModuleName, 1123, "last observer count not found")
 	ErrUpdateObserver                  = errorsmod.Register(ModuleName, 1124, "unable to update observer")
 	ErrNodeAccountNotFound             = errorsmod.Register(ModuleName, 1125, "node account not found")
+	ErrParamsBallotWeighting           = errorsmod.Register(ModuleName, 1126, "invalid ballot weighting")
+	ErrBlockHeaderFinalized            = errorsmod.Register(ModuleName, 1127, "block header height already finalized")
+	ErrBlockHeaderNotCanonical         = errorsmod.Register(ModuleName, 1128, "block header not on the canonical chain")
+	ErrBlockHeaderNotConfirmed         = errorsmod.Register(ModuleName, 1129, "block header not confirmed")
+	ErrInvalidDifficulty               = errorsmod.Register(ModuleName, 1130, "invalid difficulty")
+	ErrInvalidReEnableHeight           = errorsmod.Register(ModuleName, 1131, "invalid re-enable height")
+	ErrInvalidCheckHeight              = errorsmod.Register(ModuleName, 1132, "invalid solvency check height")
+	ErrTssNotFound                     = errorsmod.Register(ModuleName, 1133, "tss not found")
+	ErrTssPubkeyMismatch               = errorsmod.Register(ModuleName, 1136, "tss pubkey does not match the current tss pubkey")
 )
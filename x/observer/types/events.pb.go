@@ -100,6 +100,90 @@ func (m *EventBallotCreated) GetBallotType() string {
 	return ""
 }
 
+type EventBallotArchived struct {
+	BallotIdentifier     string       `protobuf:"bytes,1,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
+	BallotType           string       `protobuf:"bytes,2,opt,name=ballot_type,json=ballotType,proto3" json:"ballot_type,omitempty"`
+	BallotStatus         BallotStatus `protobuf:"varint,3,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
+	BallotCreationHeight int64        `protobuf:"varint,4,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	VoterList            []string     `protobuf:"bytes,5,rep,name=voter_list,json=voterList,proto3" json:"voter_list,omitempty"`
+	Votes                []VoteType   `protobuf:"varint,6,rep,packed,name=votes,proto3,enum=zetachain.zetacore.observer.VoteType" json:"votes,omitempty"`
+}
+
+func (m *EventBallotArchived) Reset()         { *m = EventBallotArchived{} }
+func (m *EventBallotArchived) String() string { return proto.CompactTextString(m) }
+func (*EventBallotArchived) ProtoMessage()    {}
+func (*EventBallotArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{1}
+}
+func (m *EventBallotArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBallotArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBallotArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBallotArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBallotArchived.Merge(m, src)
+}
+func (m *EventBallotArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBallotArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBallotArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBallotArchived proto.InternalMessageInfo
+
+func (m *EventBallotArchived) GetBallotIdentifier() string {
+	if m != nil {
+		return m.BallotIdentifier
+	}
+	return ""
+}
+
+func (m *EventBallotArchived) GetBallotType() string {
+	if m != nil {
+		return m.BallotType
+	}
+	return ""
+}
+
+func (m *EventBallotArchived) GetBallotStatus() BallotStatus {
+	if m != nil {
+		return m.BallotStatus
+	}
+	return BallotStatus_BallotFinalized_SuccessObservation
+}
+
+func (m *EventBallotArchived) GetBallotCreationHeight() int64 {
+	if m != nil {
+		return m.BallotCreationHeight
+	}
+	return 0
+}
+
+func (m *EventBallotArchived) GetVoterList() []string {
+	if m != nil {
+		return m.VoterList
+	}
+	return nil
+}
+
+func (m *EventBallotArchived) GetVotes() []VoteType {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 type EventKeygenBlockUpdated struct {
 	MsgTypeUrl    string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	KeygenBlock   string `protobuf:"bytes,2,opt,name=keygen_block,json=keygenBlock,proto3" json:"keygen_block,omitempty"`
@@ -110,7 +194,7 @@ func (m *EventKeygenBlockUpdated) Reset()         { *m = EventKeygenBlockUpdated
 func (m *EventKeygenBlockUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventKeygenBlockUpdated) ProtoMessage()    {}
 func (*EventKeygenBlockUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f1ca57368474456, []int{1}
+	return fileDescriptor_1f1ca57368474456, []int{2}
 }
 func (m *EventKeygenBlockUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,7 +256,7 @@ func (m *EventNewObserverAdded) Reset()         { *m = EventNewObserverAdded{} }
 func (m *EventNewObserverAdded) String() string { return proto.CompactTextString(m) }
 func (*EventNewObserverAdded) ProtoMessage()    {}
 func (*EventNewObserverAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f1ca57368474456, []int{2}
+	return fileDescriptor_1f1ca57368474456, []int{3}
 }
 func (m *EventNewObserverAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,13 +327,15 @@ type EventCrosschainFlagsUpdated struct {
 	GasPriceIncreaseFlags        *GasPriceIncreaseFlags        `protobuf:"bytes,4,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	Signer                       string                        `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,6,opt,name=blockHeaderVerificationFlags,proto3" json:"blockHeaderVerificationFlags,omitempty"`
+	ChainFlags                   []ChainCrosschainFlags        `protobuf:"bytes,7,rep,name=chainFlags,proto3" json:"chainFlags"`
+	ReEnableHeight               int64                         `protobuf:"varint,8,opt,name=reEnableHeight,proto3" json:"reEnableHeight,omitempty"`
 }
 
 func (m *EventCrosschainFlagsUpdated) Reset()         { *m = EventCrosschainFlagsUpdated{} }
 func (m *EventCrosschainFlagsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventCrosschainFlagsUpdated) ProtoMessage()    {}
 func (*EventCrosschainFlagsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f1ca57368474456, []int{3}
+	return fileDescriptor_1f1ca57368474456, []int{4}
 }
 func (m *EventCrosschainFlagsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,8 +406,23 @@ func (m *EventCrosschainFlagsUpdated) GetBlockHeaderVerificationFlags() *BlockHe
 	return nil
 }
 
+func (m *EventCrosschainFlagsUpdated) GetChainFlags() []ChainCrosschainFlags {
+	if m != nil {
+		return m.ChainFlags
+	}
+	return nil
+}
+
+func (m *EventCrosschainFlagsUpdated) GetReEnableHeight() int64 {
+	if m != nil {
+		return m.ReEnableHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
+	proto.RegisterType((*EventBallotArchived)(nil), "zetachain.zetacore.observer.EventBallotArchived")
 	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
 	proto.RegisterType((*EventNewObserverAdded)(nil), "zetachain.zetacore.observer.EventNewObserverAdded")
 	proto.RegisterType((*EventCrosschainFlagsUpdated)(nil), "zetachain.zetacore.observer.EventCrosschainFlagsUpdated")
@@ -330,45 +431,56 @@ func init() {
 func init() { proto.RegisterFile("observer/events.proto", fileDescriptor_1f1ca57368474456) }
 
 var fileDescriptor_1f1ca57368474456 = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x5e, 0xb6, 0x31, 0x81, 0x37, 0xd8, 0x66, 0xb1, 0x2d, 0xeb, 0x50, 0x36, 0x2a, 0x21, 0xf1,
-	0x33, 0x91, 0xc6, 0x69, 0x88, 0x0b, 0xad, 0xc6, 0x56, 0x81, 0xd8, 0x54, 0x31, 0x0e, 0x5c, 0x22,
-	0x27, 0x79, 0x4b, 0xac, 0x66, 0x76, 0x65, 0x3b, 0x83, 0x22, 0x71, 0xe4, 0xce, 0x15, 0xfe, 0x22,
-	0x8e, 0x3b, 0x72, 0xe0, 0x80, 0xd6, 0x7f, 0x04, 0xd9, 0x4e, 0xd3, 0xa2, 0x56, 0x55, 0x6f, 0xce,
-	0x7b, 0xdf, 0xf7, 0xbd, 0xef, 0xbd, 0xe7, 0x18, 0x6d, 0xf0, 0x48, 0x82, 0xb8, 0x04, 0x11, 0xc0,
-	0x25, 0x30, 0x25, 0xfd, 0xae, 0xe0, 0x8a, 0xe3, 0x9d, 0x2f, 0xa0, 0x48, 0x9c, 0x11, 0xca, 0x7c,
-	0x73, 0xe2, 0x02, 0xfc, 0x01, 0xb2, 0x76, 0x37, 0xe5, 0x29, 0x37, 0xb8, 0x40, 0x9f, 0x2c, 0xa5,
-	0xb6, 0x5b, 0x29, 0xc5, 0x82, 0x4b, 0x69, 0xc8, 0xe1, 0x79, 0x4e, 0xd2, 0x52, 0xb3, 0xb6, 0x55,
-	0x01, 0x06, 0x07, 0x9b, 0xa8, 0xff, 0x71, 0x10, 0x3e, 0xd4, 0xd5, 0x1b, 0x24, 0xcf, 0xb9, 0x6a,
-	0x0a, 0x20, 0x0a, 0x12, 0xbc, 0x87, 0x56, 0x2e, 0x64, 0x1a, 0xaa, 0x5e, 0x17, 0xc2, 0x42, 0xe4,
-	0xae, 0xb3, 0xe7, 0x3c, 0xbc, 0xd5, 0x46, 0x17, 0x32, 0x7d, 0xdf, 0xeb, 0xc2, 0x99, 0xc8, 0xf1,
-	0x13, 0xb4, 0x1e, 0x19, 0x4a, 0x48, 0x13, 0x60, 0x8a, 0x9e, 0x53, 0x10, 0xee, 0xbc, 0x81, 0xad,
-	0xd9, 0x44, 0xab, 0x8a, 0xe3, 0x47, 0x68, 0xcd, 0xd6, 0x25, 0x8a, 0x72, 0x16, 0x66, 0x44, 0x66,
-	0xee, 0x82, 0xc1, 0xae, 0x8e, 0xc4, 0x8f, 0x89, 0xcc, 0xb4, 0xee, 0x28, 0xd4, 0xb4, 0xe2, 0x2e,
-	0x5a, 0xdd, 0x91, 0x44, 0x53, 0xc7, 0xf1, 0x2e, 0x5a, 0x2e, 0x4d, 0x68, 0xa7, 0xee, 0x0d, 0xeb,
-	0xd2, 0x86, 0xb4, 0xd1, 0xfa, 0x37, 0x07, 0x6d, 0x99, 0xf6, 0xde, 0x40, 0x2f, 0x05, 0xd6, 0xc8,
-	0x79, 0xdc, 0x39, 0xeb, 0x26, 0x33, 0xf6, 0x78, 0x1f, 0xad, 0x74, 0x0c, 0x2f, 0x8c, 0x34, 0xb1,
-	0x6c, 0x6f, 0xb9, 0x33, 0xd4, 0xc2, 0x0f, 0xd0, 0x9d, 0x12, 0xd2, 0x2d, 0xa2, 0x0e, 0xf4, 0x64,
-	0xd9, 0xd7, 0x6d, 0x1b, 0x3d, 0xb5, 0xc1, 0xfa, 0x8f, 0x79, 0xb4, 0x61, 0x7c, 0xbc, 0x83, 0x4f,
-	0x27, 0xe5, 0x06, 0x5e, 0x25, 0xc9, 0x4c, 0x2e, 0xaa, 0xe1, 0x81, 0x08, 0x49, 0x92, 0x08, 0x90,
-	0xb2, 0x74, 0xb2, 0xca, 0x87, 0x52, 0x3a, 0x8c, 0x5f, 0xa2, 0x9a, 0xb9, 0x32, 0x39, 0x05, 0xa6,
-	0xc2, 0x54, 0x10, 0xa6, 0x00, 0x2a, 0x92, 0x75, 0xe6, 0x0e, 0x11, 0x47, 0x16, 0x30, 0x60, 0xbf,
-	0x40, 0xdb, 0x13, 0xd8, 0xb6, 0xaf, 0x72, 0x05, 0x5b, 0x63, 0x64, 0xdb, 0x21, 0x3e, 0x40, 0xdb,
-	0x95, 0xc9, 0x9c, 0x48, 0x65, 0x27, 0x16, 0xc6, 0xbc, 0x60, 0xca, 0xec, 0x65, 0xb1, 0xbd, 0x39,
-	0x00, 0xbc, 0x25, 0x52, 0x99, 0xe9, 0x35, 0x75, 0xb6, 0xfe, 0x73, 0x01, 0xed, 0x98, 0xd9, 0x34,
-	0xab, 0xbb, 0xfb, 0x5a, 0x5f, 0xdd, 0xd9, 0xf7, 0xf4, 0x18, 0xad, 0x51, 0xd9, 0x62, 0x11, 0x2f,
-	0x58, 0x72, 0xc8, 0x48, 0x94, 0x43, 0x62, 0x26, 0x74, 0xb3, 0x3d, 0x16, 0xc7, 0x4f, 0xd1, 0x3a,
-	0x95, 0x27, 0x85, 0xfa, 0x0f, 0xbc, 0x60, 0xc0, 0xe3, 0x09, 0x9c, 0xa1, 0x8d, 0x94, 0xc8, 0x53,
-	0x41, 0x63, 0x68, 0xb1, 0x58, 0x00, 0x91, 0x60, 0xbc, 0x99, 0x71, 0x2c, 0xef, 0xef, 0xfb, 0x53,
-	0xfe, 0x55, 0xff, 0x68, 0x12, 0xb3, 0x3d, 0x59, 0x10, 0x6f, 0xa2, 0x25, 0x49, 0x53, 0x06, 0xa2,
-	0xbc, 0xc5, 0xe5, 0x17, 0xfe, 0x8a, 0xee, 0x99, 0x51, 0x1e, 0x03, 0x49, 0x40, 0x7c, 0x00, 0x41,
-	0xcf, 0x69, 0x6c, 0x7e, 0x01, 0x6b, 0x64, 0xc9, 0x18, 0x39, 0x98, 0x6a, 0xa4, 0x31, 0x45, 0xa0,
-	0x3d, 0x55, 0xbe, 0xd1, 0xfa, 0x75, 0xed, 0x39, 0x57, 0xd7, 0x9e, 0xf3, 0xf7, 0xda, 0x73, 0xbe,
-	0xf7, 0xbd, 0xb9, 0xab, 0xbe, 0x37, 0xf7, 0xbb, 0xef, 0xcd, 0x7d, 0x0c, 0x52, 0xaa, 0xb2, 0x22,
-	0xf2, 0x63, 0x7e, 0x11, 0xe8, 0x92, 0xcf, 0x4c, 0xf5, 0x60, 0x50, 0x3d, 0xf8, 0x5c, 0x3d, 0x35,
-	0x81, 0x5e, 0x9d, 0x8c, 0x96, 0xcc, 0x8b, 0xf3, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea,
-	0x9e, 0x8b, 0x9e, 0xf7, 0x04, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0x93, 0x34, 0x6c, 0x27, 0xdd, 0x6c, 0x77, 0xd8, 0xb6, 0xde, 0x2c, 0xa4, 0x21, 0x52,
+	0x51, 0xca, 0x8f, 0x44, 0x04, 0x2e, 0x05, 0x2e, 0x4d, 0x54, 0xda, 0x88, 0xaa, 0xad, 0x0c, 0x2d,
+	0x12, 0x17, 0x6b, 0x6c, 0x7f, 0xb5, 0x47, 0x71, 0x3d, 0xd1, 0xcc, 0x24, 0x10, 0x24, 0x8e, 0xdc,
+	0xb9, 0x22, 0xfe, 0x1e, 0xa4, 0x1e, 0x7b, 0xe4, 0x80, 0x10, 0x6a, 0xff, 0x11, 0x34, 0x33, 0x8e,
+	0x13, 0xd2, 0x10, 0xf5, 0x66, 0xbf, 0x79, 0xdf, 0x9b, 0x37, 0x6f, 0x3e, 0x7f, 0x46, 0x5b, 0xcc,
+	0x13, 0xc0, 0xc7, 0xc0, 0xdb, 0x30, 0x86, 0x44, 0x8a, 0xd6, 0x90, 0x33, 0xc9, 0xf0, 0x9b, 0x9f,
+	0x40, 0x12, 0x3f, 0x22, 0x34, 0x69, 0xe9, 0x27, 0xc6, 0xa1, 0x35, 0x65, 0x56, 0x5f, 0x85, 0x2c,
+	0x64, 0x9a, 0xd7, 0x56, 0x4f, 0xa6, 0xa4, 0x3a, 0x53, 0xf2, 0x48, 0x1c, 0x33, 0x99, 0xc2, 0xbb,
+	0x19, 0xec, 0x73, 0x26, 0x84, 0xd6, 0x74, 0xaf, 0x63, 0x12, 0xa6, 0x5b, 0x55, 0x77, 0x32, 0xc2,
+	0xf4, 0xc1, 0x2c, 0x34, 0xfe, 0xb2, 0x10, 0x3e, 0x52, 0xa6, 0xba, 0x5a, 0xaf, 0xc7, 0x81, 0x48,
+	0x08, 0x70, 0x1d, 0x6d, 0xdc, 0x88, 0xd0, 0x95, 0x93, 0x21, 0xb8, 0x23, 0x1e, 0xdb, 0x56, 0xdd,
+	0x6a, 0xae, 0x3b, 0xe8, 0x46, 0x84, 0xdf, 0x4e, 0x86, 0x70, 0xc9, 0x63, 0xfc, 0x21, 0x7a, 0x69,
+	0x2c, 0xb8, 0x34, 0x80, 0x44, 0xd2, 0x6b, 0x0a, 0xdc, 0xce, 0x6b, 0xda, 0xa6, 0x59, 0xe8, 0x67,
+	0x38, 0xde, 0x47, 0x9b, 0x66, 0x5f, 0x22, 0x29, 0x4b, 0xdc, 0x88, 0x88, 0xc8, 0x2e, 0x68, 0xee,
+	0x8b, 0x39, 0xfc, 0x84, 0x88, 0x48, 0xe9, 0xce, 0x53, 0xf5, 0x51, 0xec, 0xa2, 0xd1, 0x9d, 0x5b,
+	0xe8, 0x29, 0x1c, 0xef, 0xa2, 0x72, 0x6a, 0x42, 0x39, 0xb5, 0xd7, 0x8c, 0x4b, 0x03, 0x29, 0xa3,
+	0x8d, 0x3f, 0xf2, 0xe8, 0xed, 0xb9, 0xe3, 0x1d, 0x72, 0x3f, 0xa2, 0x63, 0x08, 0x96, 0xbb, 0xb7,
+	0xfe, 0xc7, 0xfd, 0xc2, 0x2e, 0xf9, 0xc5, 0x5d, 0xf0, 0x19, 0x7a, 0x9e, 0x12, 0x84, 0x24, 0x72,
+	0x24, 0xf4, 0xd9, 0x2a, 0x9d, 0xfd, 0xd6, 0x8a, 0x0b, 0x6e, 0x19, 0x47, 0xdf, 0xe8, 0x02, 0x67,
+	0xc3, 0x9b, 0x7b, 0xc3, 0x9f, 0xa1, 0xed, 0x54, 0xcf, 0x57, 0xf7, 0xa1, 0x23, 0x03, 0x1a, 0x46,
+	0x52, 0x07, 0x51, 0x70, 0x5e, 0x79, 0xb3, 0xcb, 0x52, 0xb9, 0xe9, 0x35, 0xfc, 0x2e, 0x42, 0x63,
+	0x26, 0x81, 0xbb, 0x31, 0x15, 0xd2, 0x5e, 0xab, 0x17, 0x9a, 0xeb, 0xce, 0xba, 0x46, 0x4e, 0xa9,
+	0x90, 0xf8, 0x0b, 0xb4, 0xa6, 0x5e, 0x84, 0x5d, 0xaa, 0x17, 0x9a, 0x95, 0xce, 0xde, 0x4a, 0x73,
+	0x57, 0x4c, 0x82, 0x3a, 0x9a, 0x63, 0x6a, 0x1a, 0xbf, 0x58, 0x68, 0x47, 0xe7, 0xf8, 0x35, 0x4c,
+	0x42, 0x48, 0xba, 0x31, 0xf3, 0x07, 0x97, 0xc3, 0xe0, 0x89, 0xbd, 0xf2, 0x1e, 0xda, 0x18, 0xe8,
+	0x3a, 0xd7, 0x53, 0x85, 0x69, 0x82, 0xe5, 0xc1, 0x4c, 0x0b, 0xef, 0xa1, 0x4a, 0x4a, 0x19, 0x8e,
+	0xbc, 0x01, 0x4c, 0x44, 0xda, 0x1f, 0xcf, 0x0d, 0x7a, 0x61, 0xc0, 0xc6, 0x6f, 0x79, 0xb4, 0xa5,
+	0x7d, 0x9c, 0xc1, 0x0f, 0xe7, 0xa9, 0xd9, 0xc3, 0x20, 0x78, 0x92, 0x8b, 0xac, 0x09, 0x81, 0xbb,
+	0x24, 0x08, 0x38, 0x08, 0x91, 0x3a, 0x79, 0xc1, 0x66, 0x52, 0x0a, 0xc6, 0x5f, 0xa2, 0xaa, 0xce,
+	0x24, 0xa6, 0x90, 0x48, 0x37, 0xe4, 0x24, 0x91, 0x00, 0x59, 0x91, 0x71, 0x66, 0xcf, 0x18, 0xc7,
+	0x86, 0x30, 0xad, 0xfe, 0x1c, 0xbd, 0x5e, 0x52, 0x6d, 0xce, 0x95, 0xb6, 0xf2, 0xce, 0xa3, 0x62,
+	0x73, 0x42, 0x7c, 0x80, 0x5e, 0x67, 0x26, 0x63, 0x22, 0xa4, 0x49, 0xcc, 0xf5, 0xd9, 0x28, 0x91,
+	0xba, 0xbf, 0x8b, 0xce, 0xf6, 0x94, 0x70, 0x4a, 0x84, 0xd4, 0xe9, 0xf5, 0xd4, 0x6a, 0xe3, 0xf7,
+	0x22, 0x7a, 0xa3, 0xb3, 0xe9, 0x65, 0x33, 0xe0, 0x2b, 0x35, 0x02, 0x9e, 0x7e, 0x4f, 0x1f, 0xa0,
+	0x4d, 0x2a, 0xfa, 0x89, 0xc7, 0x46, 0x49, 0x70, 0x94, 0x10, 0x2f, 0x86, 0x40, 0x27, 0xf4, 0xcc,
+	0x79, 0x84, 0xe3, 0x8f, 0xd0, 0x4b, 0x2a, 0xce, 0x47, 0xf2, 0x3f, 0xe4, 0x82, 0x26, 0x3f, 0x5e,
+	0xc0, 0x11, 0xda, 0x0a, 0x89, 0xb8, 0xe0, 0xd4, 0x87, 0x7e, 0xa2, 0x9a, 0x5a, 0x80, 0xf6, 0xa6,
+	0xe3, 0x28, 0x77, 0x3a, 0x2b, 0x9b, 0xf1, 0x78, 0x59, 0xa5, 0xb3, 0x5c, 0x10, 0x6f, 0xa3, 0x92,
+	0xa0, 0x61, 0x02, 0x3c, 0x9d, 0x06, 0xe9, 0x1b, 0xfe, 0x19, 0xbd, 0xa3, 0xa3, 0x3c, 0x01, 0x12,
+	0x00, 0xbf, 0x02, 0x4e, 0xaf, 0xa9, 0xaf, 0x3f, 0x1f, 0x63, 0xa4, 0xa4, 0x8d, 0x1c, 0xac, 0xfe,
+	0x64, 0x57, 0x08, 0x38, 0x2b, 0xe5, 0xf1, 0x77, 0x08, 0xcd, 0x6e, 0xc4, 0x7e, 0xab, 0x5e, 0x68,
+	0x96, 0x3b, 0x9f, 0xac, 0xdc, 0x4c, 0x4f, 0xb8, 0x85, 0xab, 0xec, 0x16, 0x6f, 0xff, 0xde, 0xcd,
+	0x39, 0x73, 0x52, 0xf8, 0x7d, 0x54, 0xe1, 0x60, 0x62, 0x36, 0x73, 0xc0, 0x7e, 0xa6, 0x67, 0xc4,
+	0x02, 0xda, 0xed, 0xdf, 0xde, 0xd7, 0xac, 0xbb, 0xfb, 0x9a, 0xf5, 0xcf, 0x7d, 0xcd, 0xfa, 0xf5,
+	0xa1, 0x96, 0xbb, 0x7b, 0xa8, 0xe5, 0xfe, 0x7c, 0xa8, 0xe5, 0xbe, 0x6f, 0x87, 0x54, 0x46, 0x23,
+	0xaf, 0xe5, 0xb3, 0x9b, 0xb6, 0xb2, 0xf1, 0xb1, 0x56, 0x6f, 0x4f, 0x1d, 0xb5, 0x7f, 0xcc, 0xfe,
+	0x19, 0x6d, 0xd5, 0x3b, 0xc2, 0x2b, 0xe9, 0x5f, 0xc7, 0xa7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
+	0x9e, 0x4e, 0xce, 0x81, 0xd7, 0x06, 0x00, 0x00,
 }
 
 func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
@@ -429,6 +541,80 @@ func (m *EventBallotCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBallotArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBallotArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBallotArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		dAtA2 := make([]byte, len(m.Votes)*10)
+		var j1 int
+		for _, num := range m.Votes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VoterList) > 0 {
+		for iNdEx := len(m.VoterList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VoterList[iNdEx])
+			copy(dAtA[i:], m.VoterList[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.VoterList[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BallotCreationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BallotCreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BallotStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BallotStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BallotType) > 0 {
+		i -= len(m.BallotType)
+		copy(dAtA[i:], m.BallotType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventKeygenBlockUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -549,6 +735,25 @@ func (m *EventCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.ReEnableHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReEnableHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChainFlags) > 0 {
+		for iNdEx := len(m.ChainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.BlockHeaderVerificationFlags != nil {
 		{
 			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -650,6 +855,42 @@ func (m *EventBallotCreated) Size() (n int) {
 	return n
 }
 
+func (m *EventBallotArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovEvents(uint64(m.BallotStatus))
+	}
+	if m.BallotCreationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BallotCreationHeight))
+	}
+	if len(m.VoterList) > 0 {
+		for _, s := range m.VoterList {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		l = 0
+		for _, e := range m.Votes {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *EventKeygenBlockUpdated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -727,6 +968,15 @@ func (m *EventCrosschainFlagsUpdated) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if len(m.ChainFlags) > 0 {
+		for _, e := range m.ChainFlags {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.ReEnableHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ReEnableHeight))
+	}
 	return n
 }
 
@@ -946,6 +1196,259 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBallotArchived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBallotArchived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBallotArchived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
+			}
+			m.BallotStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreationHeight", wireType)
+			}
+			m.BallotCreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotCreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterList = append(m.VoterList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v VoteType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VoteType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Votes = append(m.Votes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Votes) == 0 {
+					m.Votes = make([]VoteType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VoteType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VoteType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Votes = append(m.Votes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1494,6 +1997,59 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainFlags = append(m.ChainFlags, ChainCrosschainFlags{})
+			if err := m.ChainFlags[len(m.ChainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReEnableHeight", wireType)
+			}
+			m.ReEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -40,6 +40,7 @@ type GenesisState struct {
 	PendingNonces     []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
 	ChainNonces       []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
 	NonceToCctx       []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
+	TssBalances       []TssBalance          `protobuf:"bytes,16,rep,name=tss_balances,json=tssBalances,proto3" json:"tss_balances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -180,6 +181,13 @@ func (m *GenesisState) GetNonceToCctx() []NonceToCctx {
 	return nil
 }
 
+func (m *GenesisState) GetTssBalances() []TssBalance {
+	if m != nil {
+		return m.TssBalances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.observer.GenesisState")
 }
@@ -187,48 +195,49 @@ func init() {
 func init() { proto.RegisterFile("observer/genesis.proto", fileDescriptor_15ea8c9d44da7399) }
 
 var fileDescriptor_15ea8c9d44da7399 = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x4e, 0x14, 0x31,
-	0x14, 0xc7, 0x77, 0x05, 0xc1, 0xed, 0xf2, 0x65, 0x45, 0x6d, 0x40, 0x07, 0xc4, 0x1b, 0xe2, 0xc7,
-	0x8c, 0xc1, 0x4b, 0xe3, 0x85, 0x6c, 0x02, 0x12, 0x01, 0x75, 0x20, 0x31, 0xd1, 0xc4, 0x49, 0xb7,
-	0x5b, 0x86, 0x89, 0xb3, 0xed, 0x64, 0xda, 0x35, 0xe0, 0x53, 0xf8, 0x18, 0x3e, 0x0a, 0x97, 0x5c,
-	0x7a, 0x65, 0x0c, 0xbc, 0x88, 0xe9, 0xd7, 0xcc, 0xce, 0x6e, 0x32, 0xee, 0x5d, 0xf3, 0x3f, 0xe7,
-	0xff, 0x3b, 0xed, 0x69, 0x4f, 0xc1, 0x3d, 0xde, 0x15, 0x34, 0xff, 0x4e, 0xf3, 0x20, 0xa6, 0x8c,
-	0x8a, 0x44, 0xf8, 0x59, 0xce, 0x25, 0x87, 0xab, 0x3f, 0xa8, 0xc4, 0xe4, 0x14, 0x27, 0xcc, 0xd7,
-	0x2b, 0x9e, 0x53, 0xdf, 0xa5, 0xae, 0x2c, 0xc7, 0x3c, 0xe6, 0x3a, 0x2f, 0x50, 0x2b, 0x63, 0x59,
-	0xb9, 0x5b, 0xa0, 0xba, 0x38, 0x4d, 0xb9, 0xb4, 0xf2, 0x72, 0x29, 0xa7, 0xb8, 0x4f, 0xad, 0xba,
-	0x5a, 0xa8, 0xba, 0x48, 0xc4, 0x38, 0x23, 0xd4, 0x16, 0x5f, 0x59, 0x2b, 0x83, 0x39, 0x17, 0xc2,
-	0x64, 0x9c, 0xa4, 0x38, 0x16, 0x63, 0xa5, 0xbe, 0xd1, 0xf3, 0x98, 0xb2, 0x31, 0x28, 0xe3, 0x3d,
-	0x1a, 0x61, 0x42, 0xf8, 0x80, 0xb9, 0x7d, 0x3c, 0x18, 0x0a, 0x32, 0x42, 0x23, 0xc9, 0x23, 0x42,
-	0xe4, 0x99, 0x8d, 0xde, 0x2f, 0xa2, 0x6e, 0x31, 0x56, 0x2a, 0xc3, 0x39, 0xee, 0xbb, 0x1d, 0x3c,
-	0x2c, 0x65, 0xca, 0x7a, 0x09, 0x8b, 0xab, 0x27, 0x80, 0x45, 0x58, 0x0a, 0xa7, 0x3d, 0x1a, 0xd6,
-	0xa2, 0x93, 0x01, 0xeb, 0x89, 0xa8, 0x9f, 0xc4, 0x39, 0x96, 0xdc, 0x16, 0xdb, 0xf8, 0xd5, 0x02,
-	0x73, 0xbb, 0xe6, 0x1e, 0x8e, 0x24, 0x96, 0x14, 0xbe, 0x06, 0xb3, 0xa6, 0x99, 0x02, 0x35, 0xd7,
-	0xa7, 0x36, 0xdb, 0x5b, 0x8f, 0xfd, 0x9a, 0x8b, 0xf1, 0xb7, 0x75, 0x6e, 0xe8, 0x3c, 0x70, 0x0f,
-	0xb4, 0x5c, 0x4c, 0xa0, 0x1b, 0x1a, 0xf0, 0xb4, 0x16, 0xf0, 0xde, 0x2e, 0x0e, 0x70, 0x96, 0xd1,
-	0x3c, 0x2c, 0xdd, 0x30, 0x04, 0x8b, 0xaa, 0xa9, 0x6f, 0x4c, 0x4f, 0xf7, 0x13, 0x21, 0xd1, 0x94,
-	0x06, 0x6e, 0xd6, 0x02, 0x0f, 0x4b, 0x4f, 0x38, 0x0a, 0x80, 0x9f, 0xc0, 0xd2, 0xe8, 0x05, 0xa3,
-	0xe9, 0xf5, 0xe6, 0x66, 0x7b, 0xeb, 0x59, 0x2d, 0xb4, 0x53, 0x98, 0x76, 0x94, 0x27, 0x5c, 0x24,
-	0x55, 0x01, 0xbe, 0x02, 0x33, 0xe6, 0xb6, 0xd0, 0x4d, 0x8d, 0xab, 0xef, 0xda, 0x07, 0x9d, 0x1a,
-	0x5a, 0x8b, 0x32, 0x9b, 0x57, 0x85, 0x66, 0x26, 0x30, 0xbf, 0xd3, 0xa9, 0xa1, 0xb5, 0xc0, 0xaf,
-	0xe0, 0x4e, 0x8a, 0x85, 0x8c, 0x5c, 0x3c, 0xd2, 0xa7, 0x45, 0xb3, 0x9a, 0xe4, 0xd7, 0x92, 0xf6,
-	0xb1, 0x90, 0xae, 0xff, 0x1d, 0xdd, 0xb0, 0xdb, 0xe9, 0xa8, 0x04, 0xbf, 0x80, 0x25, 0xe5, 0x8a,
-	0xcc, 0x5e, 0xa3, 0x54, 0xdd, 0xc3, 0x2d, 0x0d, 0xaf, 0xbf, 0xd8, 0x0e, 0xcf, 0xa9, 0x39, 0xa7,
-	0xea, 0xfc, 0xf6, 0xf4, 0xc5, 0x9f, 0xb5, 0x46, 0xb8, 0x40, 0x2a, 0x2a, 0xdc, 0x02, 0x53, 0x52,
-	0x08, 0xd4, 0xd2, 0xbc, 0xf5, 0x5a, 0xde, 0xf1, 0xd1, 0x51, 0xa8, 0x92, 0xe1, 0x2e, 0x68, 0xab,
-	0xe7, 0x7c, 0x9a, 0x08, 0xc9, 0xf3, 0x73, 0x04, 0xf4, 0x9b, 0xf8, 0xaf, 0xd7, 0x6e, 0x00, 0x48,
-	0x21, 0xde, 0x1a, 0x27, 0xec, 0x01, 0xe8, 0xe6, 0xa2, 0x18, 0x0b, 0x81, 0xda, 0x9a, 0xf7, 0xa2,
-	0x9e, 0x27, 0xc4, 0xce, 0x80, 0xf5, 0x0e, 0xac, 0x69, 0x8f, 0x9d, 0x70, 0xcb, 0x5f, 0x92, 0xd5,
-	0x90, 0xda, 0x2e, 0xd0, 0xdf, 0x90, 0xe9, 0xdc, 0x9c, 0xa6, 0x6f, 0xd4, 0xcf, 0x94, 0x4a, 0xb7,
-	0xbc, 0x96, 0xf6, 0xda, 0xb7, 0xbb, 0x50, 0x9d, 0x7c, 0x34, 0xaf, 0x61, 0x4f, 0xea, 0x9f, 0x9a,
-	0xb1, 0x1c, 0x6a, 0x87, 0x85, 0xce, 0x67, 0xc3, 0x22, 0xfc, 0x08, 0xe6, 0x86, 0xbf, 0x44, 0xb4,
-	0x30, 0xc1, 0x94, 0x75, 0x94, 0x5e, 0x81, 0xb6, 0x49, 0x29, 0xc1, 0x10, 0xcc, 0x57, 0xfe, 0x3c,
-	0xb4, 0x38, 0xd1, 0xe4, 0x32, 0x42, 0x8f, 0x79, 0x87, 0xc8, 0x33, 0xc7, 0x64, 0x43, 0xd2, 0xde,
-	0xc5, 0x95, 0xd7, 0xbc, 0xbc, 0xf2, 0x9a, 0x7f, 0xaf, 0xbc, 0xe6, 0xcf, 0x6b, 0xaf, 0x71, 0x79,
-	0xed, 0x35, 0x7e, 0x5f, 0x7b, 0x8d, 0xcf, 0x41, 0x9c, 0xc8, 0xd3, 0x41, 0xd7, 0x27, 0xbc, 0x1f,
-	0x28, 0xec, 0x73, 0x5d, 0x21, 0x70, 0x15, 0x82, 0xb3, 0xa0, 0xfc, 0x08, 0xcf, 0x33, 0x2a, 0xba,
-	0x33, 0xfa, 0xf3, 0x7b, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xee, 0x86, 0x37, 0x94, 0x8c, 0x06,
-	0x00, 0x00,
+	// 666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5d, 0x4f, 0x13, 0x4d,
+	0x14, 0xc7, 0xdb, 0xa7, 0x3c, 0x60, 0xa7, 0x05, 0xea, 0x88, 0x3a, 0x01, 0x2d, 0x15, 0x2f, 0x24,
+	0xbe, 0xec, 0x1a, 0xbc, 0x34, 0x5e, 0xd8, 0x26, 0x20, 0x11, 0x10, 0x17, 0x12, 0x13, 0x4d, 0xdc,
+	0x4c, 0xa7, 0xc3, 0xb2, 0x71, 0x3b, 0xd3, 0xec, 0x4c, 0x0d, 0xf8, 0x29, 0xfc, 0x58, 0x5c, 0x72,
+	0xe9, 0x95, 0x31, 0xf0, 0x2d, 0xbc, 0x32, 0xf3, 0xb6, 0xdb, 0x85, 0x64, 0xe9, 0xdd, 0xe4, 0x7f,
+	0xce, 0xff, 0x77, 0x66, 0xce, 0x99, 0x19, 0x70, 0x8f, 0xf7, 0x05, 0x4d, 0xbf, 0xd3, 0xd4, 0x8f,
+	0x28, 0xa3, 0x22, 0x16, 0xde, 0x28, 0xe5, 0x92, 0xc3, 0x95, 0x1f, 0x54, 0x62, 0x72, 0x8c, 0x63,
+	0xe6, 0xe9, 0x15, 0x4f, 0xa9, 0xe7, 0x52, 0x97, 0x97, 0x22, 0x1e, 0x71, 0x9d, 0xe7, 0xab, 0x95,
+	0xb1, 0x2c, 0xdf, 0xcd, 0x50, 0x7d, 0x9c, 0x24, 0x5c, 0x5a, 0x79, 0x29, 0x97, 0x13, 0x3c, 0xa4,
+	0x56, 0x5d, 0xc9, 0x54, 0x5d, 0x24, 0x64, 0x9c, 0x11, 0x6a, 0x8b, 0x2f, 0xaf, 0xe6, 0xc1, 0x94,
+	0x0b, 0x61, 0x32, 0x8e, 0x12, 0x1c, 0x89, 0x6b, 0xa5, 0xbe, 0xd1, 0xd3, 0x88, 0xb2, 0x6b, 0x50,
+	0xc6, 0x07, 0x34, 0xc4, 0x84, 0xf0, 0x31, 0x73, 0xfb, 0x78, 0x30, 0x11, 0x64, 0x84, 0x86, 0x92,
+	0x87, 0x84, 0xc8, 0x13, 0x1b, 0xbd, 0x9f, 0x45, 0xdd, 0xe2, 0x5a, 0xa9, 0x11, 0x4e, 0xf1, 0xd0,
+	0xed, 0xe0, 0x61, 0x2e, 0x53, 0x36, 0x88, 0x59, 0x54, 0x3c, 0x01, 0xcc, 0xc2, 0x52, 0x38, 0xed,
+	0xd1, 0xa4, 0x16, 0x1e, 0x8d, 0xd9, 0x40, 0x84, 0xc3, 0x38, 0x4a, 0xb1, 0xe4, 0xb6, 0xd8, 0xda,
+	0xdf, 0x3a, 0x68, 0x6e, 0x99, 0x39, 0x1c, 0x48, 0x2c, 0x29, 0x7c, 0x03, 0xe6, 0x4c, 0x33, 0x05,
+	0xaa, 0x76, 0x6a, 0xeb, 0x8d, 0x8d, 0xc7, 0x5e, 0xc9, 0x60, 0xbc, 0xae, 0xce, 0x0d, 0x9c, 0x07,
+	0x6e, 0x83, 0xba, 0x8b, 0x09, 0xf4, 0x9f, 0x06, 0x3c, 0x2b, 0x05, 0x7c, 0xb0, 0x8b, 0x5d, 0x3c,
+	0x1a, 0xd1, 0x34, 0xc8, 0xdd, 0x30, 0x00, 0x8b, 0xaa, 0xa9, 0x6f, 0x4d, 0x4f, 0x77, 0x62, 0x21,
+	0x51, 0x4d, 0x03, 0xd7, 0x4b, 0x81, 0x7b, 0xb9, 0x27, 0xb8, 0x0a, 0x80, 0x9f, 0x40, 0xeb, 0xea,
+	0x80, 0xd1, 0x4c, 0xa7, 0xba, 0xde, 0xd8, 0x78, 0x5e, 0x0a, 0xed, 0x65, 0xa6, 0x4d, 0xe5, 0x09,
+	0x16, 0x49, 0x51, 0x80, 0xaf, 0xc1, 0xac, 0x99, 0x16, 0xfa, 0xbf, 0x53, 0xbd, 0xb1, 0x6b, 0xfb,
+	0x3a, 0x35, 0xb0, 0x16, 0x65, 0x36, 0xb7, 0x0a, 0xcd, 0x4e, 0x61, 0x7e, 0xaf, 0x53, 0x03, 0x6b,
+	0x81, 0x5f, 0xc1, 0x9d, 0x04, 0x0b, 0x19, 0xba, 0x78, 0xa8, 0x4f, 0x8b, 0xe6, 0x34, 0xc9, 0x2b,
+	0x25, 0xed, 0x60, 0x21, 0x5d, 0xff, 0x7b, 0xba, 0x61, 0xb7, 0x93, 0xab, 0x12, 0xfc, 0x02, 0x5a,
+	0xca, 0x15, 0x9a, 0xbd, 0x86, 0x89, 0x9a, 0xc3, 0xad, 0x4e, 0xf5, 0xc6, 0xc1, 0xf6, 0x78, 0x4a,
+	0xcd, 0x39, 0x55, 0xe7, 0xbb, 0x33, 0x67, 0xbf, 0x57, 0x2b, 0xc1, 0x02, 0x29, 0xa8, 0x70, 0x03,
+	0xd4, 0xa4, 0x10, 0xa8, 0xae, 0x79, 0x9d, 0x52, 0xde, 0xe1, 0xc1, 0x41, 0xa0, 0x92, 0xe1, 0x16,
+	0x68, 0xa8, 0xeb, 0x7c, 0x1c, 0x0b, 0xc9, 0xd3, 0x53, 0x04, 0x3a, 0xb5, 0x69, 0xbc, 0x76, 0x03,
+	0x40, 0x0a, 0xf1, 0xce, 0x38, 0xe1, 0x00, 0x40, 0xf7, 0x2e, 0xb2, 0x67, 0x21, 0x50, 0x43, 0xf3,
+	0x5e, 0x96, 0xf3, 0x84, 0xd8, 0x1c, 0xb3, 0xc1, 0xae, 0x35, 0x6d, 0xb3, 0x23, 0x6e, 0xf9, 0x2d,
+	0x59, 0x0c, 0xa9, 0xed, 0x02, 0xfd, 0x0d, 0x99, 0xce, 0x35, 0x35, 0x7d, 0xad, 0xfc, 0x4d, 0xa9,
+	0x74, 0xcb, 0xab, 0x6b, 0xaf, 0xbd, 0xbb, 0x0b, 0xc5, 0x97, 0x8f, 0xe6, 0x35, 0xec, 0x69, 0xf9,
+	0x55, 0x33, 0x96, 0x3d, 0xed, 0xb0, 0xd0, 0xf9, 0xd1, 0xa4, 0x08, 0x3f, 0x82, 0xe6, 0xe4, 0x97,
+	0x88, 0x16, 0xa6, 0x78, 0x65, 0x3d, 0xa5, 0x17, 0xa0, 0x0d, 0x92, 0x4b, 0x30, 0x00, 0xf3, 0x85,
+	0x3f, 0x0f, 0x2d, 0x4e, 0xf5, 0x72, 0x19, 0xa1, 0x87, 0xbc, 0x47, 0xe4, 0x89, 0x63, 0xb2, 0x5c,
+	0x82, 0xfb, 0xa0, 0xa9, 0xc6, 0xd5, 0xc7, 0x09, 0xd6, 0xdb, 0x6c, 0x69, 0xe4, 0x93, 0x9b, 0x06,
+	0xd5, 0x35, 0xf9, 0x8e, 0x28, 0x33, 0x45, 0x74, 0xb7, 0xcf, 0x2e, 0xda, 0xd5, 0xf3, 0x8b, 0x76,
+	0xf5, 0xcf, 0x45, 0xbb, 0xfa, 0xf3, 0xb2, 0x5d, 0x39, 0xbf, 0x6c, 0x57, 0x7e, 0x5d, 0xb6, 0x2b,
+	0x9f, 0xfd, 0x28, 0x96, 0xc7, 0xe3, 0xbe, 0x47, 0xf8, 0xd0, 0x57, 0xd4, 0x17, 0xba, 0x80, 0xef,
+	0x0a, 0xf8, 0x27, 0x7e, 0xfe, 0xb5, 0x9e, 0x8e, 0xa8, 0xe8, 0xcf, 0xea, 0xef, 0xf4, 0xd5, 0xbf,
+	0x01, 0x00, 0xb3, 0x01, 0xca, 0xe6, 0xde, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -251,6 +260,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TssBalances) > 0 {
+		for iNdEx := len(m.TssBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TssBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.NonceToCctx) > 0 {
 		for iNdEx := len(m.NonceToCctx) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -543,6 +568,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TssBalances) > 0 {
+		for _, e := range m.TssBalances {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1100,6 +1131,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssBalances = append(m.TssBalances, TssBalance{})
+			if err := m.TssBalances[len(m.TssBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -32,12 +36,13 @@ func BallotListKeyPrefix(p int64) []byte {
 const (
 	BlameKey = "Blame-"
 	// TODO change identifier for VoterKey to something more descriptive
-	VoterKey                      = "Voter-value-"
-	AllCoreParams                 = "CoreParams"
-	ObserverMapperKey             = "Observer-value-"
-	ObserverParamsKey             = "ObserverParams"
-	AdminPolicyParamsKey          = "AdminParams"
-	BallotMaturityBlocksParamsKey = "BallotMaturityBlocksParams"
+	VoterKey                       = "Voter-value-"
+	AllCoreParams                  = "CoreParams"
+	ObserverMapperKey              = "Observer-value-"
+	ObserverParamsKey              = "ObserverParams"
+	AdminPolicyParamsKey           = "AdminParams"
+	BallotMaturityBlocksParamsKey  = "BallotMaturityBlocksParams"
+	ArchiveMaturedBallotsParamsKey = "ArchiveMaturedBallotsParams"
 
 	// CrosschainFlagsKey is the key for the crosschain flags
 	// NOTE: PermissionFlags is old name for CrosschainFlags we keep it as key value for backward compatibility
@@ -49,10 +54,21 @@ const (
 	BlockHeaderKey            = "BlockHeader-value-"
 	BlockHeaderStateKey       = "BlockHeaderState-value-"
 
-	BallotListKey      = "BallotList-value-"
-	TSSKey             = "TSS-value-"
-	TSSHistoryKey      = "TSS-History-value-"
-	TssFundMigratorKey = "FundsMigrator-value-"
+	// BlockHeaderHeightKey indexes the block headers by chain and height, the value is the cumulative work of the header
+	// BlockHeaderCanonicalKey maps a chain and height to the hash of the header on the canonical chain
+	BlockHeaderHeightKey    = "BlockHeaderHeight-value-"
+	BlockHeaderCanonicalKey = "BlockHeaderCanonical-value-"
+
+	BallotListKey = "BallotList-value-"
+
+	// BallotBacklogKey is the key for the height below which the ballots are deleted in chunks by the begin blocker
+	// BallotBacklogCursorKey is the key for the store key the deletion resumes from in the next block
+	BallotBacklogKey       = "BallotBacklog-value-"
+	BallotBacklogCursorKey = "BallotBacklogCursor-value-"
+	TSSKey                 = "TSS-value-"
+	TSSHistoryKey          = "TSS-History-value-"
+	TssFundMigratorKey     = "FundsMigrator-value-"
+	TssBalanceKey          = "TssBalance-value-"
 
 	PendingNoncesKeyPrefix = "PendingNonces-value-"
 	ChainNoncesKey         = "ChainNonces-value-"
@@ -66,3 +82,13 @@ func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) st
 func GetBlamePrefix(chainID int64, nonce int64) string {
 	return fmt.Sprintf("%d-%d", chainID, nonce)
 }
+
+// GetBlockHeaderChainPrefix returns the prefix of the block header indexes of a chain
+func GetBlockHeaderChainPrefix(chainID int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(chainID))
+}
+
+// GetBlockHeaderHeightPrefix returns the prefix of the block header indexes of a chain at a height
+func GetBlockHeaderHeightPrefix(chainID int64, height int64) []byte {
+	return append(GetBlockHeaderChainPrefix(chainID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
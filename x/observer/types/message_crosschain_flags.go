@@ -55,6 +55,21 @@ func (msg *MsgUpdateCrosschainFlags) ValidateBasic() error {
 		}
 	}
 
+	if msg.ReEnableHeight < 0 {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "re-enable height must not be negative")
+	}
+
+	chainIDs := make(map[int64]bool)
+	for _, chainFlags := range msg.ChainFlags {
+		if chainFlags.ChainId <= 0 {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain id %d", chainFlags.ChainId)
+		}
+		if chainIDs[chainFlags.ChainId] {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate flags for chain id %d", chainFlags.ChainId)
+		}
+		chainIDs[chainFlags.ChainId] = true
+	}
+
 	return nil
 }
 
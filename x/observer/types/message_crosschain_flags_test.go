@@ -51,6 +51,38 @@ func TestMsgUpdateCrosschainFlags_ValidateBasic(t *testing.T) {
 				Creator: sample.AccAddress(),
 			},
 		},
+		{
+			name: "negative re-enable height",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:        sample.AccAddress(),
+				ReEnableHeight: -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid chain id in chain flags",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:    sample.AccAddress(),
+				ChainFlags: []types.ChainCrosschainFlags{{ChainId: 0}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "duplicate chain flags",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:    sample.AccAddress(),
+				ChainFlags: []types.ChainCrosschainFlags{{ChainId: 1}, {ChainId: 1, IsInboundEnabled: true}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid chain flags",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:        sample.AccAddress(),
+				ChainFlags:     []types.ChainCrosschainFlags{{ChainId: 1}, {ChainId: 2, IsInboundEnabled: true}},
+				ReEnableHeight: 100,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -116,3 +148,22 @@ func TestGasPriceIncreaseFlags_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCrosschainFlags_ChainFlags(t *testing.T) {
+	flags := types.CrosschainFlags{IsInboundEnabled: true, IsOutboundEnabled: true}
+	flags.SetChainFlags(types.ChainCrosschainFlags{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true})
+
+	require.False(t, flags.IsChainInboundEnabled(1))
+	require.True(t, flags.IsChainOutboundEnabled(1))
+	require.True(t, flags.IsChainInboundEnabled(2))
+
+	// the global flags take precedence
+	flags.IsOutboundEnabled = false
+	require.False(t, flags.IsChainOutboundEnabled(1))
+	require.False(t, flags.IsChainOutboundEnabled(2))
+
+	// an override enabling both inbound and outbound is removed
+	flags.SetChainFlags(types.ChainCrosschainFlags{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: true})
+	require.Empty(t, flags.ChainFlags)
+	require.True(t, flags.IsChainInboundEnabled(1))
+}
@@ -89,6 +89,14 @@ func ValidateCoreParams(params *CoreParams) error {
 		if params.WatchUtxoTicker == 0 || params.WatchUtxoTicker > 300 {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "WatchUtxoTicker %d out of range", params.WatchUtxoTicker)
 		}
+		// the headers of the previous retarget period are required to validate the difficulty of a new header
+		blocksPerRetarget, err := common.GetBTCBlocksPerRetarget(params.ChainId)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if params.BlockHeaderPruneWindow != 0 && int64(params.BlockHeaderPruneWindow) < blocksPerRetarget {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "BlockHeaderPruneWindow %d must be at least %d", params.BlockHeaderPruneWindow, blocksPerRetarget)
+		}
 	}
 	if common.IsEVMChain(params.ChainId) {
 		if !validCoreContractAddress(params.ZetaTokenContractAddress) {
@@ -66,6 +66,15 @@ func (s *UpdateCoreParamsSuite) TestBTCParams() {
 	copy.WatchUtxoTicker = 0
 	err := ValidateCoreParams(&copy)
 	require.NotNil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.BlockHeaderPruneWindow = 2015
+	err = ValidateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy.BlockHeaderPruneWindow = 2016
+	err = ValidateCoreParams(&copy)
+	require.Nil(s.T(), err)
 }
 
 func (s *UpdateCoreParamsSuite) TestCoreContractAddresses() {
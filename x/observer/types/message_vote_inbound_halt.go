@@ -0,0 +1,78 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgVoteInboundHalt = "vote_inbound_halt"
+
+var _ sdk.Msg = &MsgVoteInboundHalt{}
+
+func NewMsgVoteInboundHalt(creator string, chainID int64, zrc20 string, checkHeight int64, deficit sdkmath.Int) *MsgVoteInboundHalt {
+	return &MsgVoteInboundHalt{
+		Creator:     creator,
+		ChainId:     chainID,
+		Zrc20:       zrc20,
+		CheckHeight: checkHeight,
+		Deficit:     deficit.String(),
+	}
+}
+
+func (msg *MsgVoteInboundHalt) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgVoteInboundHalt) Type() string {
+	return TypeMsgVoteInboundHalt
+}
+
+func (msg *MsgVoteInboundHalt) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgVoteInboundHalt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgVoteInboundHalt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if common.GetChainFromChainID(msg.ChainId) == nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "chain id (%d)", msg.ChainId)
+	}
+	if !ethcommon.IsHexAddress(msg.Zrc20) {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid zrc20 address (%s)", msg.Zrc20)
+	}
+	if msg.CheckHeight <= 0 {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid check height (%d)", msg.CheckHeight)
+	}
+	deficit, ok := sdkmath.NewIntFromString(msg.Deficit)
+	if !ok || !deficit.IsPositive() {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid deficit (%s)", msg.Deficit)
+	}
+	return nil
+}
+
+// Digest returns the identifier of the ballot of the vote
+// The deficit observed by each voter is not part of the ballot, the voters only agree on the check confirming a deficit
+func (msg *MsgVoteInboundHalt) Digest() string {
+	m := *msg
+	m.Creator = ""
+	m.Deficit = ""
+	m.Zrc20 = ethcommon.HexToAddress(m.Zrc20).Hex()
+	hash := crypto.Keccak256Hash([]byte(m.String()))
+	return hash.Hex()
+}
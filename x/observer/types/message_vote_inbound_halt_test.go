@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgVoteInboundHalt_ValidateBasic(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+	zrc20 := sample.EthAddress().Hex()
+
+	tests := []struct {
+		name string
+		msg  *types.MsgVoteInboundHalt
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgVoteInboundHalt(sample.AccAddress(), chainID, zrc20, 100, sdkmath.NewInt(42)),
+		},
+		{
+			name: "invalid creator",
+			msg:  types.NewMsgVoteInboundHalt("invalid_address", chainID, zrc20, 100, sdkmath.NewInt(42)),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgVoteInboundHalt(sample.AccAddress(), 999, zrc20, 100, sdkmath.NewInt(42)),
+			err:  sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "invalid zrc20",
+			msg:  types.NewMsgVoteInboundHalt(sample.AccAddress(), chainID, "invalid", 100, sdkmath.NewInt(42)),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid check height",
+			msg:  types.NewMsgVoteInboundHalt(sample.AccAddress(), chainID, zrc20, 0, sdkmath.NewInt(42)),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "no deficit",
+			msg:  types.NewMsgVoteInboundHalt(sample.AccAddress(), chainID, zrc20, 100, sdkmath.ZeroInt()),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgVoteInboundHalt_Digest(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+	zrc20 := sample.EthAddress().Hex()
+	msg := types.NewMsgVoteInboundHalt(sample.AccAddress(), chainID, zrc20, 100, sdkmath.NewInt(42))
+
+	// the voters agree on the check, not on the deficit they observe
+	other := types.NewMsgVoteInboundHalt(sample.AccAddress(), chainID, zrc20, 100, sdkmath.NewInt(43))
+	require.Equal(t, msg.Digest(), other.Digest())
+
+	other = types.NewMsgVoteInboundHalt(sample.AccAddress(), chainID, zrc20, 200, sdkmath.NewInt(42))
+	require.NotEqual(t, msg.Digest(), other.Digest())
+}
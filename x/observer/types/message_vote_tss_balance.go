@@ -0,0 +1,68 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgVoteTssBalance = "vote_tss_balance"
+
+var _ sdk.Msg = &MsgVoteTssBalance{}
+
+func NewMsgVoteTssBalance(creator string, chainID int64, tssPubkey string, balance sdk.Uint) *MsgVoteTssBalance {
+	return &MsgVoteTssBalance{
+		Creator:   creator,
+		ChainId:   chainID,
+		TssPubkey: tssPubkey,
+		Balance:   balance,
+	}
+}
+
+func (msg *MsgVoteTssBalance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgVoteTssBalance) Type() string {
+	return TypeMsgVoteTssBalance
+}
+
+func (msg *MsgVoteTssBalance) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgVoteTssBalance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgVoteTssBalance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if common.GetChainFromChainID(msg.ChainId) == nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "chain id (%d)", msg.ChainId)
+	}
+	if msg.TssPubkey == "" {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidPubKey, "tss pubkey is empty")
+	}
+	if msg.Balance.IsNil() {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "balance is nil")
+	}
+	return nil
+}
+
+// Digest returns the identifier of the ballot of the vote, the voters agree on the balance of the TSS on the chain
+func (msg *MsgVoteTssBalance) Digest() string {
+	m := *msg
+	m.Creator = ""
+	hash := crypto.Keccak256Hash([]byte(m.String()))
+	return hash.Hex()
+}
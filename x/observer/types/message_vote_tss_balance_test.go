@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgVoteTssBalance_ValidateBasic(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+	tssPubkey := sample.Tss().TssPubkey
+
+	tests := []struct {
+		name string
+		msg  *types.MsgVoteTssBalance
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgVoteTssBalance(sample.AccAddress(), chainID, tssPubkey, sdk.NewUint(42)),
+		},
+		{
+			name: "invalid creator",
+			msg:  types.NewMsgVoteTssBalance("invalid_address", chainID, tssPubkey, sdk.NewUint(42)),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgVoteTssBalance(sample.AccAddress(), 999, tssPubkey, sdk.NewUint(42)),
+			err:  sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "empty tss pubkey",
+			msg:  types.NewMsgVoteTssBalance(sample.AccAddress(), chainID, "", sdk.NewUint(42)),
+			err:  sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name: "nil balance",
+			msg:  types.NewMsgVoteTssBalance(sample.AccAddress(), chainID, tssPubkey, sdk.Uint{}),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgVoteTssBalance_Digest(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+	tssPubkey := sample.Tss().TssPubkey
+	msg := types.NewMsgVoteTssBalance(sample.AccAddress(), chainID, tssPubkey, sdk.NewUint(42))
+
+	// the voters agree on the balance
+	other := types.NewMsgVoteTssBalance(sample.AccAddress(), chainID, tssPubkey, sdk.NewUint(42))
+	require.Equal(t, msg.Digest(), other.Digest())
+
+	other = types.NewMsgVoteTssBalance(sample.AccAddress(), chainID, tssPubkey, sdk.NewUint(43))
+	require.NotEqual(t, msg.Digest(), other.Digest())
+}
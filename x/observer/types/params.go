@@ -60,6 +60,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPrefix(ObserverParamsKey), &p.ObserverParams, validateVotingThresholds),
 		paramtypes.NewParamSetPair(KeyPrefix(AdminPolicyParamsKey), &p.AdminPolicy, validateAdminPolicy),
 		paramtypes.NewParamSetPair(KeyPrefix(BallotMaturityBlocksParamsKey), &p.BallotMaturityBlocks, validateBallotMaturityBlocks),
+		paramtypes.NewParamSetPair(KeyPrefix(ArchiveMaturedBallotsParamsKey), &p.ArchiveMaturedBallots, validateArchiveMaturedBallots),
 	}
 }
 
@@ -86,6 +87,9 @@ func validateVotingThresholds(i interface{}) error {
 		if threshold.BallotThreshold.GT(sdk.OneDec()) {
 			return ErrParamsThreshold
 		}
+		if _, ok := BallotWeighting_name[int32(threshold.BallotWeighting)]; !ok {
+			return ErrParamsBallotWeighting
+		}
 	}
 	return nil
 }
@@ -106,6 +110,15 @@ func validateBallotMaturityBlocks(i interface{}) error {
 	return nil
 }
 
+func validateArchiveMaturedBallots(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func (p Params) GetAdminPolicyAccount(policyType Policy_Type) string {
 	for _, admin := range p.AdminPolicy {
 		if admin.PolicyType == policyType {
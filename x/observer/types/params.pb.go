@@ -107,6 +107,14 @@ type CoreParams struct {
 	ChainId                     int64  `protobuf:"varint,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	OutboundTxScheduleInterval  int64  `protobuf:"varint,12,opt,name=outbound_tx_schedule_interval,json=outboundTxScheduleInterval,proto3" json:"outbound_tx_schedule_interval,omitempty"`
 	OutboundTxScheduleLookahead int64  `protobuf:"varint,13,opt,name=outbound_tx_schedule_lookahead,json=outboundTxScheduleLookahead,proto3" json:"outbound_tx_schedule_lookahead,omitempty"`
+	// number of blocks below the latest header after which block headers are pruned, 0 disables pruning
+	BlockHeaderPruneWindow uint64 `protobuf:"varint,14,opt,name=block_header_prune_window,json=blockHeaderPruneWindow,proto3" json:"block_header_prune_window,omitempty"`
+	// gas price votes more than this number of blocks behind the most recent vote are ignored, 0 disables the check
+	GasPriceStaleBlocks uint64 `protobuf:"varint,15,opt,name=gas_price_stale_blocks,json=gasPriceStaleBlocks,proto3" json:"gas_price_stale_blocks,omitempty"`
+	// gas price votes cast more than this number of seconds ago are ignored, 0 disables the check
+	GasPriceStaleSeconds uint64 `protobuf:"varint,16,opt,name=gas_price_stale_seconds,json=gasPriceStaleSeconds,proto3" json:"gas_price_stale_seconds,omitempty"`
+	// gas price votes deviating from the median by more than this percentage are ignored, 0 disables the check
+	GasPriceMaxDeviationPercent uint64 `protobuf:"varint,17,opt,name=gas_price_max_deviation_percent,json=gasPriceMaxDeviationPercent,proto3" json:"gas_price_max_deviation_percent,omitempty"`
 }
 
 func (m *CoreParams) Reset()         { *m = CoreParams{} }
@@ -219,11 +227,40 @@ func (m *CoreParams) GetOutboundTxScheduleLookahead() int64 {
 	return 0
 }
 
+func (m *CoreParams) GetBlockHeaderPruneWindow() uint64 {
+	if m != nil {
+		return m.BlockHeaderPruneWindow
+	}
+	return 0
+}
+
+func (m *CoreParams) GetGasPriceStaleBlocks() uint64 {
+	if m != nil {
+		return m.GasPriceStaleBlocks
+	}
+	return 0
+}
+
+func (m *CoreParams) GetGasPriceStaleSeconds() uint64 {
+	if m != nil {
+		return m.GasPriceStaleSeconds
+	}
+	return 0
+}
+
+func (m *CoreParams) GetGasPriceMaxDeviationPercent() uint64 {
+	if m != nil {
+		return m.GasPriceMaxDeviationPercent
+	}
+	return 0
+}
+
 type ObserverParams struct {
 	Chain                 *common.Chain                          `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
 	BallotThreshold       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
 	MinObserverDelegation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_observer_delegation,json=minObserverDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_observer_delegation"`
 	IsSupported           bool                                   `protobuf:"varint,5,opt,name=is_supported,json=isSupported,proto3" json:"is_supported,omitempty"`
+	BallotWeighting       BallotWeighting                        `protobuf:"varint,6,opt,name=ballot_weighting,json=ballotWeighting,proto3,enum=zetachain.zetacore.observer.BallotWeighting" json:"ballot_weighting,omitempty"`
 }
 
 func (m *ObserverParams) Reset()         { *m = ObserverParams{} }
@@ -273,6 +310,13 @@ func (m *ObserverParams) GetIsSupported() bool {
 	return false
 }
 
+func (m *ObserverParams) GetBallotWeighting() BallotWeighting {
+	if m != nil {
+		return m.BallotWeighting
+	}
+	return BallotWeighting_OneObserverOneVote
+}
+
 type Admin_Policy struct {
 	PolicyType Policy_Type `protobuf:"varint,1,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.observer.Policy_Type" json:"policy_type,omitempty"`
 	Address    string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -330,6 +374,8 @@ type Params struct {
 	ObserverParams       []*ObserverParams `protobuf:"bytes,1,rep,name=observer_params,json=observerParams,proto3" json:"observer_params,omitempty"`
 	AdminPolicy          []*Admin_Policy   `protobuf:"bytes,2,rep,name=admin_policy,json=adminPolicy,proto3" json:"admin_policy,omitempty"`
 	BallotMaturityBlocks int64             `protobuf:"varint,3,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
+	// emit an EventBallotArchived with the final votes of each matured ballot before it is deleted
+	ArchiveMaturedBallots bool `protobuf:"varint,4,opt,name=archive_matured_ballots,json=archiveMaturedBallots,proto3" json:"archive_matured_ballots,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -385,6 +431,13 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetArchiveMaturedBallots() bool {
+	if m != nil {
+		return m.ArchiveMaturedBallots
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.Policy_Type", Policy_Type_name, Policy_Type_value)
 	proto.RegisterType((*CoreParamsList)(nil), "zetachain.zetacore.observer.CoreParamsList")
@@ -397,57 +450,67 @@ func init() {
 func init() { proto.RegisterFile("observer/params.proto", fileDescriptor_4542fa62877488a1) }
 
 var fileDescriptor_4542fa62877488a1 = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0x89, 0x9b, 0x26, 0x6f, 0x1d, 0x27, 0x5d, 0x5a, 0xba, 0x38, 0xc2, 0x31, 0x46,
-	0x02, 0xd3, 0x2a, 0x36, 0x18, 0x4e, 0x08, 0x0e, 0x89, 0x7b, 0x20, 0x52, 0x10, 0xd1, 0xd6, 0x1c,
-	0xe8, 0x65, 0x34, 0x9e, 0x9d, 0xda, 0x23, 0xaf, 0xf7, 0xad, 0x66, 0x66, 0x8b, 0xcd, 0x5f, 0xc1,
-	0x11, 0x89, 0x0b, 0x07, 0x0e, 0xfc, 0x11, 0xfc, 0x01, 0x3d, 0xf6, 0x88, 0x38, 0x54, 0x28, 0xb9,
-	0xf0, 0x67, 0xa0, 0x7d, 0xfb, 0xa3, 0x4e, 0x82, 0x22, 0xf5, 0xe4, 0xb7, 0xf3, 0x3e, 0xef, 0x3b,
-	0x6f, 0xde, 0x7c, 0x3d, 0xf0, 0x00, 0x27, 0x46, 0xea, 0x17, 0x52, 0x0f, 0x12, 0xae, 0xf9, 0xc2,
-	0xf4, 0x13, 0x8d, 0x16, 0xbd, 0x83, 0x9f, 0xa4, 0xe5, 0x62, 0xc6, 0x55, 0xdc, 0xa7, 0x08, 0xb5,
-	0xec, 0x97, 0x64, 0xeb, 0x1d, 0x81, 0x8b, 0x05, 0xc6, 0x83, 0xfc, 0x27, 0xaf, 0x68, 0xdd, 0x9f,
-	0xe2, 0x14, 0x29, 0x1c, 0x64, 0x51, 0xb1, 0xfa, 0xb0, 0x92, 0x2f, 0x83, 0x3c, 0xd1, 0x7d, 0x06,
-	0xcd, 0x11, 0x6a, 0x79, 0x4e, 0x9b, 0x9e, 0x29, 0x63, 0xbd, 0x6f, 0xc0, 0xcd, 0xb6, 0x61, 0x79,
-	0x1f, 0xbe, 0xd3, 0xd9, 0xec, 0xb9, 0xc3, 0x8f, 0xfb, 0xb7, 0x34, 0xd2, 0x7f, 0xa3, 0x10, 0x80,
-	0xa8, 0xe2, 0xee, 0x9f, 0x75, 0x80, 0x37, 0x29, 0xef, 0x08, 0x3c, 0x81, 0xf1, 0x73, 0xa5, 0x17,
-	0xdc, 0x2a, 0x8c, 0x99, 0xc0, 0x34, 0xb6, 0xbe, 0xd3, 0x71, 0x7a, 0xf5, 0xe0, 0xde, 0x7a, 0x66,
-	0x94, 0x25, 0xbc, 0x1e, 0xec, 0x4f, 0xb9, 0x61, 0x89, 0x56, 0x42, 0x32, 0xab, 0xc4, 0x5c, 0x6a,
-	0x7f, 0x83, 0xe0, 0xe6, 0x94, 0x9b, 0xf3, 0x6c, 0x79, 0x4c, 0xab, 0x5e, 0x07, 0x1a, 0x2a, 0x66,
-	0x76, 0x59, 0x52, 0x9b, 0x44, 0x81, 0x8a, 0xc7, 0xcb, 0x82, 0xe8, 0xc2, 0x2e, 0xa6, 0x76, 0x0d,
-	0xa9, 0x13, 0xe2, 0x62, 0x6a, 0x2b, 0xe6, 0x11, 0xdc, 0xfb, 0x91, 0x5b, 0x31, 0x63, 0xa9, 0x5d,
-	0x62, 0xc9, 0xdd, 0x21, 0x6e, 0x8f, 0x12, 0xdf, 0xdb, 0x25, 0x16, 0xec, 0xd7, 0x40, 0x17, 0xc3,
-	0x2c, 0xce, 0x65, 0x76, 0x90, 0xd8, 0x6a, 0x2e, 0x2c, 0xe3, 0x61, 0xa8, 0xa5, 0x31, 0xfe, 0x76,
-	0xc7, 0xe9, 0xed, 0x04, 0x7e, 0x86, 0x8c, 0x33, 0x62, 0x54, 0x00, 0xc7, 0x79, 0xde, 0xfb, 0x0a,
-	0x5a, 0x02, 0xe3, 0x58, 0x0a, 0x8b, 0xfa, 0x66, 0xf5, 0x4e, 0x5e, 0x5d, 0x11, 0xd7, 0xab, 0x47,
-	0xd0, 0x96, 0x5a, 0x0c, 0x3f, 0x65, 0x22, 0x35, 0x16, 0xc3, 0xd5, 0x4d, 0x05, 0x20, 0x85, 0x03,
-	0xa2, 0x46, 0x39, 0x74, 0x5d, 0xe4, 0x3d, 0xd8, 0xa6, 0xdb, 0x64, 0x2a, 0xf4, 0xdd, 0x8e, 0xd3,
-	0xdb, 0x0c, 0xee, 0xd2, 0xf7, 0x69, 0xe8, 0x1d, 0xc3, 0xfb, 0x98, 0xda, 0x09, 0xa6, 0x71, 0x98,
-	0x4d, 0xcc, 0x88, 0x99, 0x0c, 0xd3, 0x48, 0x32, 0x15, 0x5b, 0xa9, 0x5f, 0xf0, 0xc8, 0x6f, 0x10,
-	0xdf, 0x2a, 0xa1, 0xf1, 0xf2, 0x69, 0x81, 0x9c, 0x16, 0x44, 0xd6, 0xe2, 0xff, 0x4a, 0x44, 0x88,
-	0x73, 0x3e, 0x93, 0x3c, 0xf4, 0x77, 0x49, 0xe3, 0xe0, 0xa6, 0xc6, 0x59, 0x89, 0x74, 0x7f, 0xdd,
-	0x80, 0xe6, 0x77, 0x85, 0xc5, 0x0a, 0x0b, 0x7d, 0x08, 0x77, 0xa8, 0x4b, 0x72, 0x8d, 0x3b, 0xdc,
-	0xed, 0x17, 0xd6, 0x1f, 0x65, 0x8b, 0x41, 0x9e, 0xf3, 0x7e, 0x80, 0xfd, 0x09, 0x8f, 0x22, 0xb4,
-	0xcc, 0xce, 0xb4, 0x34, 0x33, 0x8c, 0x42, 0xb2, 0xc4, 0xce, 0x49, 0xff, 0xe5, 0xeb, 0xc3, 0xda,
-	0xdf, 0xaf, 0x0f, 0x3f, 0x9a, 0x2a, 0x3b, 0x4b, 0x27, 0x59, 0xf5, 0x40, 0xa0, 0x59, 0xa0, 0x29,
-	0x7e, 0x8e, 0x4c, 0x38, 0x1f, 0xd8, 0x55, 0x22, 0x4d, 0xff, 0x89, 0x14, 0xc1, 0x5e, 0xae, 0x33,
-	0x2e, 0x65, 0xbc, 0xe7, 0xf0, 0x70, 0xa1, 0x62, 0x56, 0x1a, 0x9f, 0x85, 0x32, 0x92, 0x53, 0xf2,
-	0x2c, 0x39, 0xea, 0xed, 0x77, 0x78, 0xb0, 0x50, 0x71, 0x79, 0xc6, 0x27, 0x95, 0x98, 0xf7, 0x01,
-	0x34, 0x94, 0x61, 0x26, 0x4d, 0x12, 0xd4, 0x56, 0x86, 0x64, 0xc3, 0xed, 0xc0, 0x55, 0xe6, 0x69,
-	0xb9, 0xd4, 0x35, 0xd0, 0x38, 0x0e, 0xb3, 0x66, 0xce, 0x31, 0x52, 0x62, 0xe5, 0x9d, 0x82, 0x9b,
-	0x50, 0xc4, 0x32, 0x75, 0x1a, 0x50, 0x73, 0xd8, 0xbb, 0xf5, 0x6f, 0x9b, 0x57, 0xb2, 0xf1, 0x2a,
-	0x91, 0x01, 0xe4, 0xc5, 0x59, 0xec, 0xf9, 0x70, 0xb7, 0x74, 0xd2, 0x06, 0x39, 0xa9, 0xfc, 0xec,
-	0xfe, 0xeb, 0xc0, 0x56, 0x71, 0x15, 0x63, 0xd8, 0xab, 0xc6, 0x70, 0xe5, 0xa9, 0x78, 0x7c, 0xeb,
-	0x9e, 0x57, 0x2f, 0x34, 0x68, 0xe2, 0xd5, 0x0b, 0x3e, 0x83, 0x06, 0xa7, 0x53, 0xe5, 0xed, 0xf8,
-	0x1b, 0x24, 0xf9, 0xc9, 0xad, 0x92, 0xeb, 0x63, 0x08, 0x5c, 0x2a, 0x2f, 0x66, 0xf2, 0x05, 0xbc,
-	0x5b, 0x38, 0x61, 0xc1, 0x6d, 0xaa, 0x95, 0x5d, 0xb1, 0x49, 0x84, 0x62, 0x6e, 0xc8, 0x0f, 0x9b,
-	0xc1, 0xfd, 0x3c, 0xfb, 0x6d, 0x91, 0x3c, 0xa1, 0xdc, 0x97, 0xf5, 0x5f, 0x7e, 0x3b, 0xac, 0x3d,
-	0x7a, 0x0c, 0xee, 0xda, 0x7c, 0x3c, 0x80, 0xad, 0xa9, 0xc6, 0x34, 0xf9, 0x6c, 0xbf, 0x56, 0xc5,
-	0xc3, 0x7d, 0xa7, 0x55, 0xff, 0xe3, 0xf7, 0xb6, 0x73, 0x72, 0xfa, 0xf2, 0xa2, 0xed, 0xbc, 0xba,
-	0x68, 0x3b, 0xff, 0x5c, 0xb4, 0x9d, 0x9f, 0x2f, 0xdb, 0xb5, 0x57, 0x97, 0xed, 0xda, 0x5f, 0x97,
-	0xed, 0xda, 0xb3, 0xc1, 0x9a, 0x11, 0xb2, 0xd6, 0x8f, 0xe8, 0x14, 0x83, 0xf2, 0x14, 0x83, 0x65,
-	0xf5, 0x20, 0xe7, 0xae, 0x98, 0x6c, 0xd1, 0xbb, 0xfc, 0xf9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x7f, 0x57, 0xd8, 0xba, 0x11, 0x06, 0x00, 0x00,
+	// 959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xd3, 0x6e, 0xb6, 0x9d, 0xa4, 0x69, 0xea, 0xed, 0x1f, 0x6f, 0x2a, 0xd2, 0x10, 0x24,
+	0x08, 0xbb, 0x34, 0x81, 0x2c, 0x20, 0x81, 0xe0, 0xd0, 0xa6, 0x87, 0xad, 0xd4, 0x8a, 0xc8, 0x0d,
+	0xaa, 0xd8, 0xcb, 0x68, 0x32, 0x9e, 0x4d, 0x46, 0x71, 0x3c, 0xd6, 0xcc, 0xb8, 0x4d, 0xf8, 0x14,
+	0x1c, 0x39, 0xee, 0x81, 0x03, 0x7c, 0x93, 0x3d, 0xee, 0x11, 0x71, 0x58, 0xa1, 0xf6, 0x2b, 0xf0,
+	0x01, 0x90, 0xdf, 0xd8, 0x4e, 0xda, 0xa2, 0x48, 0x9c, 0xfc, 0xfc, 0x7e, 0xbf, 0xdf, 0x9b, 0xf7,
+	0xde, 0xbc, 0x67, 0xa3, 0x1d, 0x31, 0x50, 0x4c, 0x5e, 0x31, 0xd9, 0x0e, 0x89, 0x24, 0x13, 0xd5,
+	0x0a, 0xa5, 0xd0, 0xc2, 0xde, 0xff, 0x99, 0x69, 0x42, 0x47, 0x84, 0x07, 0x2d, 0xb0, 0x84, 0x64,
+	0xad, 0x94, 0x59, 0x7d, 0x42, 0xc5, 0x64, 0x22, 0x82, 0xb6, 0x79, 0x18, 0x45, 0x75, 0x7b, 0x28,
+	0x86, 0x02, 0xcc, 0x76, 0x6c, 0x25, 0xde, 0x79, 0xf8, 0x01, 0xf1, 0x7d, 0xa1, 0x13, 0xf7, 0x5e,
+	0xe6, 0x4e, 0x0d, 0x03, 0x34, 0x5e, 0xa1, 0x72, 0x57, 0x48, 0xd6, 0x83, 0x5c, 0xce, 0xb8, 0xd2,
+	0xf6, 0x4b, 0x54, 0x8c, 0x4f, 0xc7, 0x26, 0x3d, 0xc7, 0xaa, 0xaf, 0x34, 0x8b, 0x9d, 0x4f, 0x5a,
+	0x4b, 0xf2, 0x6b, 0xcd, 0x23, 0xb8, 0x88, 0x66, 0x76, 0xe3, 0x8f, 0x02, 0x42, 0x73, 0xc8, 0x3e,
+	0x44, 0x36, 0x15, 0xc1, 0x6b, 0x2e, 0x27, 0x44, 0x73, 0x11, 0x60, 0x2a, 0xa2, 0x40, 0x3b, 0x56,
+	0xdd, 0x6a, 0xae, 0xba, 0x5b, 0x8b, 0x48, 0x37, 0x06, 0xec, 0x26, 0xaa, 0x0c, 0x89, 0xc2, 0xa1,
+	0xe4, 0x94, 0x61, 0xcd, 0xe9, 0x98, 0x49, 0x27, 0x0f, 0xe4, 0xf2, 0x90, 0xa8, 0x5e, 0xec, 0xee,
+	0x83, 0xd7, 0xae, 0xa3, 0x12, 0x0f, 0xb0, 0x9e, 0xa6, 0xac, 0x15, 0x60, 0x21, 0x1e, 0xf4, 0xa7,
+	0x09, 0xa3, 0x81, 0x36, 0x44, 0xa4, 0x17, 0x28, 0xab, 0x40, 0x29, 0x8a, 0x48, 0x67, 0x9c, 0x67,
+	0x68, 0xeb, 0x9a, 0x68, 0x3a, 0xc2, 0x91, 0x9e, 0x8a, 0x94, 0xf7, 0x08, 0x78, 0x9b, 0x00, 0xfc,
+	0xa8, 0xa7, 0x22, 0xe1, 0x7e, 0x8f, 0xe0, 0xbe, 0xb0, 0x16, 0x63, 0x16, 0x17, 0x12, 0x68, 0x49,
+	0xa8, 0xc6, 0xc4, 0xf3, 0x24, 0x53, 0xca, 0x59, 0xab, 0x5b, 0xcd, 0x75, 0xd7, 0x89, 0x29, 0xfd,
+	0x98, 0xd1, 0x4d, 0x08, 0x47, 0x06, 0xb7, 0xbf, 0x43, 0x55, 0x2a, 0x82, 0x80, 0x51, 0x2d, 0xe4,
+	0x43, 0xf5, 0xba, 0x51, 0x67, 0x8c, 0xfb, 0xea, 0x2e, 0xaa, 0x31, 0x49, 0x3b, 0x9f, 0x63, 0x1a,
+	0x29, 0x2d, 0xbc, 0xd9, 0xc3, 0x08, 0x08, 0x22, 0xec, 0x03, 0xab, 0x6b, 0x48, 0xf7, 0x83, 0x3c,
+	0x45, 0x6b, 0x70, 0x9b, 0x98, 0x7b, 0x4e, 0xb1, 0x6e, 0x35, 0x57, 0xdc, 0xc7, 0xf0, 0x7e, 0xea,
+	0xd9, 0x47, 0xe8, 0x03, 0x11, 0xe9, 0x81, 0x88, 0x02, 0x2f, 0xee, 0x98, 0xa2, 0x23, 0xe6, 0x45,
+	0x3e, 0xc3, 0x3c, 0xd0, 0x4c, 0x5e, 0x11, 0xdf, 0x29, 0x01, 0xbf, 0x9a, 0x92, 0xfa, 0xd3, 0x8b,
+	0x84, 0x72, 0x9a, 0x30, 0xe2, 0x14, 0xff, 0x33, 0x84, 0x2f, 0xc4, 0x98, 0x8c, 0x18, 0xf1, 0x9c,
+	0x0d, 0x88, 0xb1, 0xff, 0x30, 0xc6, 0x59, 0x4a, 0xb1, 0xbf, 0x41, 0x4f, 0x07, 0xbe, 0xa0, 0x63,
+	0x1c, 0xbf, 0x31, 0x89, 0x43, 0x19, 0x05, 0x0c, 0x5f, 0xf3, 0xc0, 0x13, 0xd7, 0x4e, 0x19, 0x2e,
+	0x66, 0x17, 0x08, 0x2f, 0x01, 0xef, 0xc5, 0xf0, 0x25, 0xa0, 0xf6, 0x0b, 0xb4, 0x3b, 0x9f, 0x1d,
+	0xa5, 0x89, 0xcf, 0x30, 0x30, 0x95, 0xb3, 0x09, 0xba, 0x27, 0xe9, 0x04, 0x5d, 0xc4, 0xd8, 0x31,
+	0x40, 0xf6, 0x57, 0x68, 0xef, 0xbe, 0x48, 0x31, 0x2a, 0x02, 0x4f, 0x39, 0x15, 0x50, 0x6d, 0xdf,
+	0x51, 0x5d, 0x18, 0xcc, 0x3e, 0x41, 0x07, 0x73, 0xd9, 0x84, 0x4c, 0xb1, 0xc7, 0xae, 0xb8, 0x99,
+	0xf0, 0x90, 0x49, 0xca, 0x02, 0xed, 0x6c, 0x81, 0x7c, 0x3f, 0x95, 0x9f, 0x93, 0xe9, 0x49, 0xca,
+	0xe9, 0x19, 0x4a, 0xe3, 0x9f, 0x3c, 0x2a, 0xff, 0x90, 0xec, 0x53, 0xb2, 0x2f, 0x1f, 0xa1, 0x47,
+	0x70, 0x25, 0xb0, 0x22, 0xc5, 0xce, 0x46, 0x2b, 0x59, 0xff, 0x6e, 0xec, 0x74, 0x0d, 0x66, 0xff,
+	0x84, 0x2a, 0x66, 0xd1, 0xb1, 0x1e, 0x49, 0xa6, 0x46, 0xc2, 0xf7, 0x60, 0xfe, 0xd7, 0x8f, 0x5b,
+	0x6f, 0xdf, 0x1f, 0xe4, 0xfe, 0x7a, 0x7f, 0xf0, 0xf1, 0x90, 0xeb, 0x51, 0x34, 0x88, 0xd5, 0x6d,
+	0x2a, 0xd4, 0x44, 0xa8, 0xe4, 0x71, 0xa8, 0xbc, 0x71, 0x5b, 0xcf, 0x42, 0xa6, 0x5a, 0x27, 0x8c,
+	0xba, 0x9b, 0x26, 0x4e, 0x3f, 0x0d, 0x63, 0xbf, 0x46, 0x7b, 0x13, 0x1e, 0xe0, 0x74, 0xcb, 0xb1,
+	0xc7, 0x7c, 0x36, 0x84, 0xa4, 0x61, 0x7d, 0xfe, 0xff, 0x09, 0x3b, 0x13, 0x1e, 0xa4, 0x35, 0x9e,
+	0x64, 0xc1, 0xec, 0x0f, 0x51, 0x89, 0x2b, 0xac, 0xa2, 0x30, 0x14, 0x52, 0x33, 0x0f, 0x76, 0x6e,
+	0xcd, 0x2d, 0x72, 0x75, 0x91, 0xba, 0xec, 0xcb, 0xac, 0xca, 0x6b, 0xc6, 0x87, 0x23, 0xcd, 0x83,
+	0xa1, 0x53, 0xa8, 0x5b, 0xcd, 0x72, 0xe7, 0xb3, 0xa5, 0x1f, 0xa6, 0x63, 0x10, 0x5d, 0xa6, 0x9a,
+	0xb4, 0xc6, 0xcc, 0xd1, 0x50, 0xa8, 0x74, 0xe4, 0xc5, 0x55, 0xf6, 0x84, 0xcf, 0xe9, 0xcc, 0x3e,
+	0x45, 0xc5, 0x10, 0x2c, 0x1c, 0xa7, 0x0d, 0x9d, 0x2f, 0x77, 0x9a, 0x4b, 0xcf, 0x30, 0x4a, 0xdc,
+	0x9f, 0x85, 0xcc, 0x45, 0x46, 0x1c, 0xdb, 0xb6, 0x83, 0x1e, 0xa7, 0xfb, 0x98, 0x87, 0x7d, 0x4c,
+	0x5f, 0x1b, 0x6f, 0xf2, 0xa8, 0x90, 0xdc, 0x71, 0x1f, 0x6d, 0x66, 0xfd, 0xbd, 0xf3, 0xc1, 0x7d,
+	0xbe, 0xf4, 0xcc, 0xbb, 0x93, 0xe2, 0x96, 0xc5, 0xdd, 0xc9, 0x39, 0x43, 0x25, 0x02, 0x55, 0x99,
+	0x74, 0x9c, 0x3c, 0x84, 0xfc, 0x74, 0x69, 0xc8, 0xc5, 0x36, 0xb8, 0x45, 0x90, 0x27, 0x3d, 0xf9,
+	0x12, 0xed, 0x26, 0xcd, 0x9f, 0x10, 0x1d, 0x49, 0xae, 0x67, 0xe9, 0x32, 0xad, 0xc0, 0x12, 0x6f,
+	0x1b, 0xf4, 0x3c, 0x01, 0x93, 0x6d, 0xfa, 0x1a, 0xed, 0x11, 0x49, 0x47, 0xfc, 0x8a, 0x19, 0x19,
+	0xf3, 0xb0, 0xe1, 0x29, 0x98, 0x9e, 0x35, 0x77, 0x27, 0x81, 0xcf, 0x0d, 0x6a, 0xae, 0x4a, 0x7d,
+	0xbb, 0xfa, 0xeb, 0x9b, 0x83, 0xdc, 0xb3, 0xe7, 0xa8, 0xb8, 0xd0, 0x57, 0x1b, 0xa1, 0xc2, 0x50,
+	0x8a, 0x28, 0xfc, 0xa2, 0x92, 0xcb, 0xec, 0x4e, 0xc5, 0xaa, 0xae, 0xfe, 0xfe, 0x5b, 0xcd, 0x3a,
+	0x3e, 0x7d, 0x7b, 0x53, 0xb3, 0xde, 0xdd, 0xd4, 0xac, 0xbf, 0x6f, 0x6a, 0xd6, 0x2f, 0xb7, 0xb5,
+	0xdc, 0xbb, 0xdb, 0x5a, 0xee, 0xcf, 0xdb, 0x5a, 0xee, 0x55, 0x7b, 0x61, 0x32, 0xe3, 0x92, 0x0f,
+	0xa1, 0xfa, 0x76, 0x5a, 0x7d, 0x7b, 0x9a, 0xfd, 0x0e, 0xcd, 0x98, 0x0e, 0x0a, 0xf0, 0x57, 0x7c,
+	0xf1, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x05, 0xce, 0xa9, 0xa6, 0x07, 0x00, 0x00,
 }
 
 func (m *CoreParamsList) Marshal() (dAtA []byte, err error) {
@@ -507,6 +570,30 @@ func (m *CoreParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasPriceMaxDeviationPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPriceMaxDeviationPercent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.GasPriceStaleSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPriceStaleSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GasPriceStaleBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPriceStaleBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BlockHeaderPruneWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockHeaderPruneWindow))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.OutboundTxScheduleLookahead != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.OutboundTxScheduleLookahead))
 		i--
@@ -591,6 +678,11 @@ func (m *ObserverParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BallotWeighting != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BallotWeighting))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.IsSupported {
 		i--
 		if m.IsSupported {
@@ -691,6 +783,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArchiveMaturedBallots {
+		i--
+		if m.ArchiveMaturedBallots {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BallotMaturityBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BallotMaturityBlocks))
 		i--
@@ -795,6 +897,18 @@ func (m *CoreParams) Size() (n int) {
 	if m.OutboundTxScheduleLookahead != 0 {
 		n += 1 + sovParams(uint64(m.OutboundTxScheduleLookahead))
 	}
+	if m.BlockHeaderPruneWindow != 0 {
+		n += 1 + sovParams(uint64(m.BlockHeaderPruneWindow))
+	}
+	if m.GasPriceStaleBlocks != 0 {
+		n += 1 + sovParams(uint64(m.GasPriceStaleBlocks))
+	}
+	if m.GasPriceStaleSeconds != 0 {
+		n += 2 + sovParams(uint64(m.GasPriceStaleSeconds))
+	}
+	if m.GasPriceMaxDeviationPercent != 0 {
+		n += 2 + sovParams(uint64(m.GasPriceMaxDeviationPercent))
+	}
 	return n
 }
 
@@ -815,6 +929,9 @@ func (m *ObserverParams) Size() (n int) {
 	if m.IsSupported {
 		n += 2
 	}
+	if m.BallotWeighting != 0 {
+		n += 1 + sovParams(uint64(m.BallotWeighting))
+	}
 	return n
 }
 
@@ -855,6 +972,9 @@ func (m *Params) Size() (n int) {
 	if m.BallotMaturityBlocks != 0 {
 		n += 1 + sovParams(uint64(m.BallotMaturityBlocks))
 	}
+	if m.ArchiveMaturedBallots {
+		n += 2
+	}
 	return n
 }
 
@@ -1225,6 +1345,82 @@ func (m *CoreParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderPruneWindow", wireType)
+			}
+			m.BlockHeaderPruneWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeaderPruneWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceStaleBlocks", wireType)
+			}
+			m.GasPriceStaleBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceStaleBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceStaleSeconds", wireType)
+			}
+			m.GasPriceStaleSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceStaleSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMaxDeviationPercent", wireType)
+			}
+			m.GasPriceMaxDeviationPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceMaxDeviationPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1399,6 +1595,25 @@ func (m *ObserverParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsSupported = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotWeighting", wireType)
+			}
+			m.BallotWeighting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotWeighting |= BallotWeighting(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1637,6 +1852,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveMaturedBallots", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArchiveMaturedBallots = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
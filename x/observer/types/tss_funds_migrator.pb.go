@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
@@ -27,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TssFundMigratorInfo struct {
 	ChainId            int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	MigrationCctxIndex string `protobuf:"bytes,2,opt,name=migration_cctx_index,json=migrationCctxIndex,proto3" json:"migration_cctx_index,omitempty"`
+	// indexes of the cmd cctxs updating the TSS address in the connector contract of the chain
+	ContractUpdateCctxIndexes []string `protobuf:"bytes,3,rep,name=contract_update_cctx_indexes,json=contractUpdateCctxIndexes,proto3" json:"contract_update_cctx_indexes,omitempty"`
 }
 
 func (m *TssFundMigratorInfo) Reset()         { *m = TssFundMigratorInfo{} }
@@ -76,29 +79,109 @@ func (m *TssFundMigratorInfo) GetMigrationCctxIndex() string {
 	return ""
 }
 
+func (m *TssFundMigratorInfo) GetContractUpdateCctxIndexes() []string {
+	if m != nil {
+		return m.ContractUpdateCctxIndexes
+	}
+	return nil
+}
+
+// TssBalance is the balance of the TSS on a chain voted by the observers, used to migrate the funds to a new TSS
+// For Bitcoin it's the value of the UTXOs spendable by one sweep transaction
+type TssBalance struct {
+	ChainId             int64                                   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TssPubkey           string                                  `protobuf:"bytes,2,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
+	Balance             github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"balance"`
+	FinalizedZetaHeight int64                                   `protobuf:"varint,4,opt,name=finalized_zeta_height,json=finalizedZetaHeight,proto3" json:"finalized_zeta_height,omitempty"`
+}
+
+func (m *TssBalance) Reset()         { *m = TssBalance{} }
+func (m *TssBalance) String() string { return proto.CompactTextString(m) }
+func (*TssBalance) ProtoMessage()    {}
+func (*TssBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb561ac1748a50be, []int{1}
+}
+func (m *TssBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TssBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TssBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TssBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TssBalance.Merge(m, src)
+}
+func (m *TssBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *TssBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TssBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TssBalance proto.InternalMessageInfo
+
+func (m *TssBalance) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TssBalance) GetTssPubkey() string {
+	if m != nil {
+		return m.TssPubkey
+	}
+	return ""
+}
+
+func (m *TssBalance) GetFinalizedZetaHeight() int64 {
+	if m != nil {
+		return m.FinalizedZetaHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TssFundMigratorInfo)(nil), "zetachain.zetacore.observer.TssFundMigratorInfo")
+	proto.RegisterType((*TssBalance)(nil), "zetachain.zetacore.observer.TssBalance")
 }
 
 func init() { proto.RegisterFile("observer/tss_funds_migrator.proto", fileDescriptor_bb561ac1748a50be) }
 
 var fileDescriptor_bb561ac1748a50be = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x4f, 0x2a, 0x4e,
-	0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x2f, 0x29, 0x2e, 0x8e, 0x4f, 0x2b, 0xcd, 0x4b, 0x29, 0x8e, 0xcf,
-	0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0x2f, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xae,
-	0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x60,
-	0xba, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xea, 0xf4, 0x41, 0x2c, 0x88, 0x16, 0xa5, 0x24,
-	0x2e, 0xe1, 0x90, 0xe2, 0x62, 0xb7, 0xd2, 0xbc, 0x14, 0x5f, 0xa8, 0x59, 0x9e, 0x79, 0x69, 0xf9,
-	0x42, 0x92, 0x5c, 0x1c, 0x60, 0x73, 0xe2, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98, 0x83,
-	0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0x21, 0x03, 0x2e, 0x11, 0x88, 0xb5, 0x99, 0xf9, 0x79, 0xf1, 0xc9,
-	0xc9, 0x25, 0x15, 0xf1, 0x99, 0x79, 0x29, 0xa9, 0x15, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41,
-	0x42, 0x70, 0x39, 0xe7, 0xe4, 0x92, 0x0a, 0x4f, 0x90, 0x8c, 0x93, 0xe7, 0x89, 0x47, 0x72, 0x8c,
-	0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72,
-	0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x83, 0x5c, 0xac, 0x0b, 0xb6, 0x44, 0x1f, 0xe6, 0x78, 0xfd, 0x0a, 0x7d, 0x84, 0xa7,
-	0x2b, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xae, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb7,
-	0x95, 0x68, 0x68, 0x0d, 0x01, 0x00, 0x00,
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xbd, 0xae, 0xd3, 0x30,
+	0x14, 0x8e, 0x09, 0xe2, 0x52, 0x8f, 0xb9, 0x17, 0x29, 0x97, 0x9f, 0xdc, 0xd2, 0x85, 0x2e, 0x8d,
+	0x11, 0x3c, 0x00, 0x52, 0x91, 0x10, 0x19, 0x90, 0x50, 0xd4, 0x2e, 0x5d, 0x2c, 0xc7, 0x76, 0x13,
+	0xab, 0xad, 0x1d, 0xe5, 0x38, 0x28, 0xed, 0x53, 0xf0, 0x0e, 0x3c, 0x0b, 0x52, 0xc7, 0x8e, 0x88,
+	0xa1, 0x42, 0xed, 0x8b, 0xa0, 0xb8, 0x4d, 0xe9, 0xc4, 0xe4, 0x63, 0x7f, 0xe7, 0xfb, 0xce, 0xe7,
+	0xf3, 0xe1, 0xd7, 0x26, 0x03, 0x59, 0x7d, 0x93, 0x15, 0xb1, 0x00, 0x74, 0x5e, 0x6b, 0x01, 0x74,
+	0xa5, 0xf2, 0x8a, 0x59, 0x53, 0xc5, 0x65, 0x65, 0xac, 0x09, 0x5e, 0x6c, 0xa4, 0x65, 0xbc, 0x60,
+	0x4a, 0xc7, 0xae, 0x32, 0x95, 0x8c, 0x3b, 0xd6, 0xf3, 0xbb, 0xdc, 0xe4, 0xc6, 0xf5, 0x91, 0xb6,
+	0x3a, 0x51, 0x06, 0x3f, 0x10, 0xbe, 0x9d, 0x00, 0x7c, 0xaa, 0xb5, 0xf8, 0x72, 0x16, 0x4b, 0xf4,
+	0xdc, 0x04, 0xf7, 0xf8, 0xa9, 0x13, 0xa2, 0x4a, 0x84, 0xa8, 0x8f, 0x86, 0x7e, 0x7a, 0xe3, 0xee,
+	0x89, 0x08, 0xde, 0xe2, 0xbb, 0xd3, 0x5c, 0x65, 0x34, 0xe5, 0xdc, 0x36, 0x54, 0x69, 0x21, 0x9b,
+	0xf0, 0x51, 0x1f, 0x0d, 0x7b, 0x69, 0x70, 0xc1, 0x3e, 0x72, 0xdb, 0x24, 0x2d, 0x12, 0x7c, 0xc0,
+	0x2f, 0xb9, 0xd1, 0xb6, 0x62, 0xdc, 0xd2, 0xba, 0x14, 0xcc, 0xca, 0x2b, 0x9e, 0x84, 0xd0, 0xef,
+	0xfb, 0xc3, 0x5e, 0x7a, 0xdf, 0xf5, 0x4c, 0x5d, 0xcb, 0x85, 0x2e, 0x61, 0xf0, 0x13, 0x61, 0x3c,
+	0x01, 0x18, 0xb3, 0x25, 0xd3, 0x5c, 0xfe, 0xcf, 0xdc, 0x2b, 0x8c, 0xdb, 0xf5, 0x94, 0x75, 0xb6,
+	0x90, 0xeb, 0xb3, 0xa5, 0x9e, 0x05, 0xf8, 0xea, 0x1e, 0x82, 0x04, 0xdf, 0x64, 0x27, 0x91, 0xd0,
+	0x6f, 0xb1, 0x31, 0xd9, 0xee, 0x1f, 0xbc, 0xdf, 0xfb, 0x87, 0x37, 0xb9, 0xb2, 0x45, 0x9d, 0xc5,
+	0xdc, 0xac, 0x08, 0x37, 0xb0, 0x32, 0x70, 0x3e, 0x46, 0x20, 0x16, 0xc4, 0xae, 0x4b, 0x09, 0xf1,
+	0x54, 0x69, 0x9b, 0x76, 0xfc, 0xe0, 0x1d, 0x7e, 0x36, 0x57, 0x9a, 0x2d, 0xd5, 0x46, 0x0a, 0xda,
+	0xae, 0x9b, 0x16, 0x52, 0xe5, 0x85, 0x0d, 0x1f, 0x3b, 0x47, 0xb7, 0x17, 0x70, 0x26, 0x2d, 0xfb,
+	0xec, 0xa0, 0x71, 0xb2, 0x3d, 0x44, 0x68, 0x77, 0x88, 0xd0, 0x9f, 0x43, 0x84, 0xbe, 0x1f, 0x23,
+	0x6f, 0x77, 0x8c, 0xbc, 0x5f, 0xc7, 0xc8, 0x9b, 0x91, 0xab, 0xf9, 0xad, 0xd8, 0xc8, 0x7d, 0x88,
+	0x74, 0x31, 0x92, 0x86, 0xfc, 0x8b, 0xbf, 0x35, 0x93, 0x3d, 0x71, 0xf9, 0xbd, 0xff, 0x3b, 0x00,
+	0x89, 0x01, 0x6e, 0x57, 0x17, 0x02, 0x00, 0x00,
 }
 
 func (m *TssFundMigratorInfo) Marshal() (dAtA []byte, err error) {
@@ -121,6 +204,15 @@ func (m *TssFundMigratorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractUpdateCctxIndexes) > 0 {
+		for iNdEx := len(m.ContractUpdateCctxIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractUpdateCctxIndexes[iNdEx])
+			copy(dAtA[i:], m.ContractUpdateCctxIndexes[iNdEx])
+			i = encodeVarintTssFundsMigrator(dAtA, i, uint64(len(m.ContractUpdateCctxIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MigrationCctxIndex) > 0 {
 		i -= len(m.MigrationCctxIndex)
 		copy(dAtA[i:], m.MigrationCctxIndex)
@@ -136,6 +228,56 @@ func (m *TssFundMigratorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TssBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TssBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TssBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizedZetaHeight != 0 {
+		i = encodeVarintTssFundsMigrator(dAtA, i, uint64(m.FinalizedZetaHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTssFundsMigrator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintTssFundsMigrator(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTssFundsMigrator(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTssFundsMigrator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTssFundsMigrator(v)
 	base := offset
@@ -160,6 +302,33 @@ func (m *TssFundMigratorInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTssFundsMigrator(uint64(l))
 	}
+	if len(m.ContractUpdateCctxIndexes) > 0 {
+		for _, s := range m.ContractUpdateCctxIndexes {
+			l = len(s)
+			n += 1 + l + sovTssFundsMigrator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TssBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovTssFundsMigrator(uint64(m.ChainId))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTssFundsMigrator(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovTssFundsMigrator(uint64(l))
+	if m.FinalizedZetaHeight != 0 {
+		n += 1 + sovTssFundsMigrator(uint64(m.FinalizedZetaHeight))
+	}
 	return n
 }
 
@@ -249,6 +418,192 @@ func (m *TssFundMigratorInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.MigrationCctxIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractUpdateCctxIndexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssFundsMigrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssFundsMigrator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssFundsMigrator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractUpdateCctxIndexes = append(m.ContractUpdateCctxIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTssFundsMigrator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTssFundsMigrator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TssBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTssFundsMigrator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TssBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TssBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssFundsMigrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssFundsMigrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssFundsMigrator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssFundsMigrator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssFundsMigrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssFundsMigrator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssFundsMigrator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedZetaHeight", wireType)
+			}
+			m.FinalizedZetaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssFundsMigrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedZetaHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTssFundsMigrator(dAtA[iNdEx:])
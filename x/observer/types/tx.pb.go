@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -540,6 +541,10 @@ type MsgUpdateCrosschainFlags struct {
 	IsOutboundEnabled            bool                          `protobuf:"varint,4,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags        *GasPriceIncreaseFlags        `protobuf:"bytes,5,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,6,opt,name=blockHeaderVerificationFlags,proto3" json:"blockHeaderVerificationFlags,omitempty"`
+	// Per chain overrides, an override with inbound and outbound enabled removes the override of the chain
+	ChainFlags []ChainCrosschainFlags `protobuf:"bytes,7,rep,name=chainFlags,proto3" json:"chainFlags"`
+	// Block height at which the inbound and outbound disabled by the message are enabled again, 0 to disable until updated
+	ReEnableHeight int64 `protobuf:"varint,8,opt,name=reEnableHeight,proto3" json:"reEnableHeight,omitempty"`
 }
 
 func (m *MsgUpdateCrosschainFlags) Reset()         { *m = MsgUpdateCrosschainFlags{} }
@@ -610,6 +615,20 @@ func (m *MsgUpdateCrosschainFlags) GetBlockHeaderVerificationFlags() *BlockHeade
 	return nil
 }
 
+func (m *MsgUpdateCrosschainFlags) GetChainFlags() []ChainCrosschainFlags {
+	if m != nil {
+		return m.ChainFlags
+	}
+	return nil
+}
+
+func (m *MsgUpdateCrosschainFlags) GetReEnableHeight() int64 {
+	if m != nil {
+		return m.ReEnableHeight
+	}
+	return 0
+}
+
 type MsgUpdateCrosschainFlagsResponse struct {
 }
 
@@ -734,6 +753,220 @@ func (m *MsgUpdateKeygenResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateKeygenResponse proto.InternalMessageInfo
 
+// MsgVoteInboundHalt is the vote of an observer to halt the inbound of a chain
+// when the supply of a ZRC20 of the chain is not backed by the assets held on the chain
+type MsgVoteInboundHalt struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Zrc20   string `protobuf:"bytes,3,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	// ZetaChain height of the solvency check confirming the deficit, the observers vote for the same check height
+	CheckHeight int64 `protobuf:"varint,4,opt,name=check_height,json=checkHeight,proto3" json:"check_height,omitempty"`
+	// ZRC20 supply not backed by the assets held on the chain, as observed by the voter
+	Deficit string `protobuf:"bytes,5,opt,name=deficit,proto3" json:"deficit,omitempty"`
+}
+
+func (m *MsgVoteInboundHalt) Reset()         { *m = MsgVoteInboundHalt{} }
+func (m *MsgVoteInboundHalt) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteInboundHalt) ProtoMessage()    {}
+func (*MsgVoteInboundHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{14}
+}
+func (m *MsgVoteInboundHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteInboundHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteInboundHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteInboundHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteInboundHalt.Merge(m, src)
+}
+func (m *MsgVoteInboundHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteInboundHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteInboundHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteInboundHalt proto.InternalMessageInfo
+
+func (m *MsgVoteInboundHalt) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgVoteInboundHalt) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgVoteInboundHalt) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
+func (m *MsgVoteInboundHalt) GetCheckHeight() int64 {
+	if m != nil {
+		return m.CheckHeight
+	}
+	return 0
+}
+
+func (m *MsgVoteInboundHalt) GetDeficit() string {
+	if m != nil {
+		return m.Deficit
+	}
+	return ""
+}
+
+type MsgVoteInboundHaltResponse struct {
+}
+
+func (m *MsgVoteInboundHaltResponse) Reset()         { *m = MsgVoteInboundHaltResponse{} }
+func (m *MsgVoteInboundHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteInboundHaltResponse) ProtoMessage()    {}
+func (*MsgVoteInboundHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{15}
+}
+func (m *MsgVoteInboundHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteInboundHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteInboundHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteInboundHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteInboundHaltResponse.Merge(m, src)
+}
+func (m *MsgVoteInboundHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteInboundHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteInboundHaltResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteInboundHaltResponse proto.InternalMessageInfo
+
+// MsgVoteTssBalance is the vote of an observer on the balance of the current TSS on a chain
+type MsgVoteTssBalance struct {
+	Creator   string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId   int64                                   `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TssPubkey string                                  `protobuf:"bytes,3,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
+	Balance   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"balance"`
+}
+
+func (m *MsgVoteTssBalance) Reset()         { *m = MsgVoteTssBalance{} }
+func (m *MsgVoteTssBalance) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteTssBalance) ProtoMessage()    {}
+func (*MsgVoteTssBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{16}
+}
+func (m *MsgVoteTssBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteTssBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteTssBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteTssBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteTssBalance.Merge(m, src)
+}
+func (m *MsgVoteTssBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteTssBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteTssBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteTssBalance proto.InternalMessageInfo
+
+func (m *MsgVoteTssBalance) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgVoteTssBalance) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgVoteTssBalance) GetTssPubkey() string {
+	if m != nil {
+		return m.TssPubkey
+	}
+	return ""
+}
+
+type MsgVoteTssBalanceResponse struct {
+}
+
+func (m *MsgVoteTssBalanceResponse) Reset()         { *m = MsgVoteTssBalanceResponse{} }
+func (m *MsgVoteTssBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteTssBalanceResponse) ProtoMessage()    {}
+func (*MsgVoteTssBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{17}
+}
+func (m *MsgVoteTssBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteTssBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteTssBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteTssBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteTssBalanceResponse.Merge(m, src)
+}
+func (m *MsgVoteTssBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteTssBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteTssBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteTssBalanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateObserver)(nil), "zetachain.zetacore.observer.MsgUpdateObserver")
 	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverResponse")
@@ -749,69 +982,86 @@ func init() {
 	proto.RegisterType((*MsgUpdateCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateCrosschainFlagsResponse")
 	proto.RegisterType((*MsgUpdateKeygen)(nil), "zetachain.zetacore.observer.MsgUpdateKeygen")
 	proto.RegisterType((*MsgUpdateKeygenResponse)(nil), "zetachain.zetacore.observer.MsgUpdateKeygenResponse")
+	proto.RegisterType((*MsgVoteInboundHalt)(nil), "zetachain.zetacore.observer.MsgVoteInboundHalt")
+	proto.RegisterType((*MsgVoteInboundHaltResponse)(nil), "zetachain.zetacore.observer.MsgVoteInboundHaltResponse")
+	proto.RegisterType((*MsgVoteTssBalance)(nil), "zetachain.zetacore.observer.MsgVoteTssBalance")
+	proto.RegisterType((*MsgVoteTssBalanceResponse)(nil), "zetachain.zetacore.observer.MsgVoteTssBalanceResponse")
 }
 
 func init() { proto.RegisterFile("observer/tx.proto", fileDescriptor_1bcd40fa296a2b1d) }
 
 var fileDescriptor_1bcd40fa296a2b1d = []byte{
-	// 903 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xe3, 0xc4, 0xb1, 0xc7, 0xae, 0x63, 0x6f, 0xec, 0x58, 0x96, 0x6b, 0xc5, 0xe0, 0xa5,
-	0x6e, 0xeb, 0x4a, 0x8e, 0xd2, 0x16, 0x6d, 0x81, 0x1e, 0xec, 0xfe, 0x38, 0x42, 0x91, 0xda, 0x20,
-	0x50, 0x1f, 0x7a, 0x21, 0x96, 0xdc, 0x31, 0x45, 0x84, 0xda, 0x15, 0xb8, 0x54, 0x63, 0x15, 0x68,
-	0xdf, 0xa0, 0x68, 0x5f, 0xa5, 0xef, 0xd0, 0x43, 0x8e, 0x39, 0xf6, 0x54, 0x14, 0xf6, 0xa9, 0x4f,
-	0xd0, 0x6b, 0xc0, 0x5d, 0x72, 0x25, 0x4a, 0x32, 0x2d, 0xe5, 0x24, 0xee, 0xcc, 0x37, 0xdf, 0xcc,
-	0x37, 0x33, 0xe4, 0x0a, 0xd6, 0x85, 0x27, 0x31, 0xfe, 0x09, 0xe3, 0x46, 0x72, 0x59, 0xef, 0xc6,
-	0x22, 0x11, 0x64, 0xe7, 0x67, 0x4c, 0xa8, 0xdf, 0xa6, 0x21, 0xaf, 0xab, 0x27, 0x11, 0x63, 0x3d,
-	0x47, 0x55, 0x1f, 0xfa, 0xa2, 0xd3, 0x11, 0xbc, 0xa1, 0x7f, 0x74, 0x44, 0x75, 0x23, 0x10, 0x81,
-	0x50, 0x8f, 0x8d, 0xf4, 0x29, 0xb7, 0x1a, 0x6a, 0x2f, 0xa2, 0x1d, 0xcc, 0xac, 0x8f, 0x8d, 0xd5,
-	0x8f, 0x85, 0x94, 0x2a, 0x8f, 0x7b, 0x11, 0xd1, 0x40, 0x66, 0x80, 0x2d, 0x03, 0xc8, 0x1f, 0x32,
-	0xc7, 0xa6, 0x71, 0x74, 0x69, 0x4c, 0x3b, 0x39, 0x7e, 0x77, 0x60, 0x46, 0xce, 0x42, 0x1e, 0xb8,
-	0x5c, 0x70, 0x1f, 0x73, 0x37, 0x19, 0x08, 0x94, 0x99, 0xcd, 0xfe, 0xcf, 0x82, 0xf5, 0xe7, 0x32,
-	0xf8, 0xa1, 0xcb, 0x68, 0x82, 0xa7, 0x99, 0x9f, 0x54, 0xe0, 0xbe, 0x1f, 0x23, 0x4d, 0x44, 0x5c,
-	0xb1, 0xf6, 0xac, 0xfd, 0x25, 0x27, 0x3f, 0x92, 0x43, 0xd8, 0x10, 0x11, 0x73, 0x73, 0x26, 0x97,
-	0x32, 0x16, 0xa3, 0x94, 0x95, 0x3b, 0x0a, 0x46, 0x44, 0xc4, 0x72, 0x92, 0x23, 0xed, 0x49, 0x23,
-	0x38, 0xbe, 0x1c, 0x8f, 0x98, 0xd7, 0x11, 0x1c, 0x5f, 0x8e, 0x46, 0x9c, 0xc3, 0x3b, 0x3d, 0x55,
-	0x8f, 0x1b, 0x23, 0x95, 0x82, 0x57, 0xee, 0xee, 0x59, 0xfb, 0xab, 0xcd, 0x27, 0xf5, 0x92, 0x69,
-	0xd4, 0x73, 0x12, 0xad, 0xc4, 0x51, 0x81, 0xce, 0x4a, 0x6f, 0xe8, 0x64, 0xef, 0xc0, 0xf6, 0x98,
-	0x54, 0x07, 0x65, 0x57, 0x70, 0x89, 0xf6, 0x9f, 0xba, 0x11, 0x47, 0x8c, 0x1d, 0x47, 0xc2, 0x7f,
-	0xf1, 0x0c, 0x29, 0x2b, 0x6d, 0xc4, 0x36, 0x2c, 0xea, 0x81, 0x85, 0x4c, 0x89, 0x9f, 0x77, 0xee,
-	0xab, 0x73, 0x8b, 0x91, 0x5d, 0x00, 0x2f, 0xe5, 0x70, 0xdb, 0x54, 0xb6, 0x95, 0xce, 0x15, 0x67,
-	0x49, 0x59, 0x9e, 0x51, 0xd9, 0x26, 0x8f, 0x60, 0xa1, 0x8d, 0x61, 0xd0, 0x4e, 0x94, 0xae, 0x79,
-	0x27, 0x3b, 0x91, 0xc3, 0xd4, 0x9e, 0x66, 0xad, 0xdc, 0xdb, 0xb3, 0xf6, 0x97, 0x9b, 0xa4, 0x9e,
-	0x6d, 0x96, 0xae, 0xe5, 0x6b, 0x9a, 0xd0, 0xe3, 0xbb, 0xaf, 0xfe, 0x79, 0x3c, 0xe7, 0x64, 0xb8,
-	0x4c, 0x50, 0xb1, 0x64, 0x23, 0xe8, 0x12, 0x1e, 0x1a, 0xb5, 0x5f, 0x89, 0x18, 0xcf, 0xd4, 0xa6,
-	0x94, 0x28, 0x3a, 0x01, 0xf0, 0x0d, 0x4e, 0x69, 0x5a, 0x6e, 0xbe, 0x57, 0xda, 0xf3, 0x01, 0xad,
-	0x33, 0x14, 0x6a, 0xef, 0xc2, 0xce, 0x84, 0xcc, 0xa6, 0xb0, 0xbf, 0x2c, 0x58, 0xd5, 0x65, 0x4f,
-	0xb1, 0x6f, 0xef, 0xc3, 0xda, 0x0d, 0xbb, 0xf6, 0x40, 0x8c, 0xac, 0xcd, 0x17, 0xb0, 0xad, 0x4a,
-	0x8c, 0x42, 0xe4, 0x89, 0x1b, 0xc4, 0x94, 0x27, 0x88, 0x6e, 0xb7, 0xe7, 0xbd, 0xc0, 0x7e, 0xb6,
-	0x6d, 0x5b, 0x03, 0xc0, 0x89, 0xf6, 0x9f, 0x29, 0x37, 0x79, 0x02, 0x9b, 0x94, 0x31, 0x97, 0x0b,
-	0x86, 0x2e, 0xf5, 0x7d, 0xd1, 0xe3, 0x89, 0x2b, 0x78, 0xd4, 0x57, 0x23, 0x5a, 0x74, 0x08, 0x65,
-	0xec, 0x7b, 0xc1, 0xf0, 0x48, 0xbb, 0x4e, 0x79, 0xd4, 0xb7, 0x2b, 0xf0, 0xa8, 0xa8, 0xc2, 0x08,
-	0xfc, 0xdd, 0x82, 0x07, 0xf9, 0x5c, 0x68, 0x07, 0xcf, 0x45, 0x82, 0x6f, 0xb7, 0x48, 0x27, 0xe9,
-	0x22, 0xd1, 0x0e, 0xba, 0x21, 0xbf, 0x10, 0x4a, 0xc2, 0x72, 0xd3, 0x2e, 0x9d, 0x88, 0x4a, 0x98,
-	0x6d, 0xc9, 0x92, 0x8a, 0x6d, 0xf1, 0x0b, 0x61, 0x6f, 0xc3, 0xd6, 0x48, 0x41, 0xa6, 0xd8, 0xff,
-	0xef, 0x40, 0x65, 0x30, 0x2d, 0xf3, 0x1d, 0xfa, 0x36, 0xfd, 0x0c, 0x95, 0x54, 0xfd, 0x01, 0xac,
-	0x85, 0xb2, 0xc5, 0x3d, 0xd1, 0xe3, 0xec, 0x1b, 0x4e, 0xbd, 0x08, 0x99, 0x2a, 0x70, 0xd1, 0x19,
-	0xb3, 0x93, 0x03, 0x58, 0x0f, 0xe5, 0x69, 0x2f, 0x29, 0x80, 0x75, 0x63, 0xc7, 0x1d, 0xa4, 0x0d,
-	0x9b, 0x01, 0x95, 0x67, 0x71, 0xe8, 0x63, 0x8b, 0xa7, 0xe9, 0x24, 0xaa, 0x62, 0xb2, 0xb7, 0xa2,
-	0x59, 0xaa, 0xff, 0x64, 0x52, 0xa4, 0x33, 0x99, 0x90, 0xfc, 0x02, 0xef, 0x7a, 0x83, 0x17, 0xe7,
-	0x1c, 0xe3, 0xf0, 0x22, 0xf4, 0x69, 0x12, 0x0a, 0xad, 0xbe, 0xb2, 0xa0, 0x12, 0x7e, 0x7e, 0x4b,
-	0xc3, 0x6f, 0x26, 0x70, 0x4a, 0xe9, 0x6d, 0x1b, 0xf6, 0x6e, 0x6a, 0xbc, 0x99, 0xce, 0x91, 0xda,
-	0x24, 0x8d, 0xf9, 0x0e, 0xfb, 0x01, 0xf2, 0x92, 0x99, 0x6c, 0xc0, 0x3d, 0x95, 0x30, 0x5b, 0x23,
-	0x7d, 0xc8, 0x66, 0x3f, 0x4c, 0x91, 0xb3, 0x37, 0xaf, 0x16, 0x60, 0xfe, 0xb9, 0x0c, 0x88, 0x80,
-	0xe5, 0xe1, 0xb7, 0xf1, 0xc3, 0x52, 0xc5, 0xc5, 0xa5, 0xaf, 0x3e, 0x9d, 0x01, 0x9c, 0x27, 0x26,
-	0x97, 0xb0, 0x3a, 0x72, 0xe3, 0xd4, 0x6f, 0xa3, 0x29, 0xe2, 0xab, 0x9f, 0xce, 0x86, 0x37, 0x99,
-	0x7f, 0x85, 0xb5, 0xb1, 0x4f, 0xe2, 0xe1, 0x74, 0x5c, 0x83, 0x88, 0xea, 0x67, 0xb3, 0x46, 0x98,
-	0xfc, 0x31, 0xac, 0x14, 0xbe, 0x0b, 0x07, 0x53, 0xb4, 0xcf, 0xa0, 0xab, 0x1f, 0xcf, 0x82, 0x36,
-	0x39, 0x7f, 0xb3, 0x60, 0x73, 0xf2, 0xfb, 0xfd, 0xc9, 0x94, 0x3a, 0x8a, 0x61, 0xd5, 0x2f, 0xdf,
-	0x2a, 0x6c, 0xb8, 0x07, 0x85, 0x8d, 0x3e, 0x98, 0x8e, 0x4e, 0xa3, 0x6f, 0xef, 0xc1, 0xa4, 0x55,
-	0x4f, 0x37, 0x6e, 0xe4, 0x6a, 0xaf, 0x4f, 0xd5, 0x4b, 0x83, 0xbf, 0x7d, 0xe3, 0x26, 0xdf, 0xc3,
-	0xc7, 0xad, 0x57, 0x57, 0x35, 0xeb, 0xf5, 0x55, 0xcd, 0xfa, 0xf7, 0xaa, 0x66, 0xfd, 0x71, 0x5d,
-	0x9b, 0x7b, 0x7d, 0x5d, 0x9b, 0xfb, 0xfb, 0xba, 0x36, 0xf7, 0x63, 0x23, 0x08, 0x93, 0x76, 0xcf,
-	0x4b, 0xaf, 0xf9, 0x46, 0xca, 0xf8, 0x91, 0x22, 0x6f, 0xe4, 0xe4, 0x8d, 0xcb, 0xc6, 0xe0, 0x0f,
-	0x5b, 0xbf, 0x8b, 0xd2, 0x5b, 0x50, 0xff, 0xd9, 0x9e, 0xbe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xb7,
-	0xd1, 0x06, 0xc3, 0xaa, 0x0a, 0x00, 0x00,
+	// 1110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x72, 0x1b, 0x45,
+	0x10, 0xf6, 0xa2, 0xf8, 0x47, 0x2d, 0xe3, 0x9f, 0x89, 0x1d, 0xcb, 0x72, 0x2c, 0x1b, 0x1d, 0x88,
+	0x01, 0x47, 0xeb, 0x28, 0xfc, 0x57, 0x71, 0xb0, 0x03, 0xd8, 0x2a, 0xca, 0xd8, 0xb5, 0x45, 0x4c,
+	0x15, 0x97, 0xad, 0xd1, 0xce, 0x78, 0xb5, 0xe5, 0xd5, 0x8c, 0x6a, 0x67, 0x44, 0xec, 0x50, 0xf0,
+	0x06, 0x14, 0x3c, 0x00, 0x2f, 0x01, 0xcf, 0xc0, 0x21, 0xc7, 0x1c, 0x81, 0x43, 0x8a, 0xb2, 0x4f,
+	0xbc, 0x01, 0xc7, 0xd4, 0xce, 0xcc, 0xae, 0x7e, 0x2d, 0xc9, 0x3e, 0x69, 0xa7, 0xa7, 0xfb, 0xeb,
+	0xfe, 0x7a, 0xbe, 0xed, 0x59, 0xc1, 0x22, 0xaf, 0x09, 0x1a, 0x7d, 0x4f, 0x23, 0x5b, 0x9e, 0x97,
+	0x9b, 0x11, 0x97, 0x1c, 0xad, 0x3d, 0xa7, 0x12, 0x7b, 0x75, 0x1c, 0xb0, 0xb2, 0x7a, 0xe2, 0x11,
+	0x2d, 0x27, 0x5e, 0x85, 0xbb, 0x1e, 0x6f, 0x34, 0x38, 0xb3, 0xf5, 0x8f, 0x8e, 0x28, 0x2c, 0xf9,
+	0xdc, 0xe7, 0xea, 0xd1, 0x8e, 0x9f, 0x12, 0x6b, 0x0a, 0x5d, 0x0b, 0x71, 0x83, 0x1a, 0xeb, 0x46,
+	0x6a, 0xf5, 0x22, 0x2e, 0x84, 0xca, 0xe3, 0x9e, 0x86, 0xd8, 0x17, 0xc6, 0x61, 0x25, 0x75, 0x48,
+	0x1e, 0xcc, 0xc6, 0x72, 0xba, 0xd1, 0xc4, 0x11, 0x6e, 0x24, 0xfe, 0xeb, 0x6d, 0x33, 0x65, 0x24,
+	0x60, 0xbe, 0xcb, 0x38, 0xf3, 0x68, 0xb2, 0x8d, 0xda, 0x04, 0x85, 0xb1, 0x95, 0xfe, 0xb3, 0x60,
+	0xf1, 0x50, 0xf8, 0x4f, 0x9b, 0x04, 0x4b, 0x7a, 0x64, 0xf6, 0x51, 0x1e, 0xa6, 0xbd, 0x88, 0x62,
+	0xc9, 0xa3, 0xbc, 0xb5, 0x69, 0x6d, 0x65, 0x9d, 0x64, 0x89, 0x76, 0x60, 0x89, 0x87, 0xc4, 0x4d,
+	0x90, 0x5c, 0x4c, 0x48, 0x44, 0x85, 0xc8, 0xbf, 0xa1, 0xdc, 0x10, 0x0f, 0x49, 0x02, 0xb2, 0xab,
+	0x77, 0xe2, 0x08, 0x46, 0x9f, 0xf5, 0x47, 0x64, 0x74, 0x04, 0xa3, 0xcf, 0x7a, 0x23, 0x4e, 0xe0,
+	0xcd, 0x96, 0xaa, 0xc7, 0x8d, 0x28, 0x16, 0x9c, 0xe5, 0xef, 0x6c, 0x5a, 0x5b, 0x73, 0x95, 0x47,
+	0xe5, 0x21, 0xa7, 0x51, 0x4e, 0x40, 0x34, 0x13, 0x47, 0x05, 0x3a, 0xb3, 0xad, 0x8e, 0x55, 0x69,
+	0x0d, 0x56, 0xfb, 0xa8, 0x3a, 0x54, 0x34, 0x39, 0x13, 0xb4, 0xf4, 0xbb, 0x6e, 0xc4, 0x2e, 0x21,
+	0x7b, 0x21, 0xf7, 0xce, 0x0e, 0x28, 0x26, 0x43, 0x1b, 0xb1, 0x0a, 0x33, 0xfa, 0xc0, 0x02, 0xa2,
+	0xc8, 0x67, 0x9c, 0x69, 0xb5, 0xae, 0x12, 0xb4, 0x0e, 0x50, 0x8b, 0x31, 0xdc, 0x3a, 0x16, 0x75,
+	0xc5, 0x73, 0xd6, 0xc9, 0x2a, 0xcb, 0x01, 0x16, 0x75, 0x74, 0x0f, 0xa6, 0xea, 0x34, 0xf0, 0xeb,
+	0x52, 0xf1, 0xca, 0x38, 0x66, 0x85, 0x76, 0x62, 0x7b, 0x9c, 0x35, 0x3f, 0xb9, 0x69, 0x6d, 0xe5,
+	0x2a, 0xa8, 0x6c, 0x94, 0xa5, 0x6b, 0xf9, 0x1c, 0x4b, 0xbc, 0x77, 0xe7, 0xc5, 0xab, 0x8d, 0x09,
+	0xc7, 0xf8, 0x19, 0x42, 0xdd, 0x25, 0xa7, 0x84, 0xce, 0xe1, 0x6e, 0xca, 0xf6, 0x09, 0x8f, 0xe8,
+	0xb1, 0x52, 0xca, 0x10, 0x46, 0xfb, 0x00, 0x5e, 0xea, 0xa7, 0x38, 0xe5, 0x2a, 0x0f, 0x86, 0xf6,
+	0xbc, 0x0d, 0xeb, 0x74, 0x84, 0x96, 0xd6, 0x61, 0x6d, 0x40, 0xe6, 0xb4, 0xb0, 0x3f, 0x2d, 0x98,
+	0xd3, 0x65, 0x8f, 0xa1, 0xb7, 0x77, 0x60, 0xe1, 0x1a, 0xad, 0xcd, 0xf3, 0x1e, 0xd9, 0x7c, 0x0a,
+	0xab, 0xaa, 0xc4, 0x30, 0xa0, 0x4c, 0xba, 0x7e, 0x84, 0x99, 0xa4, 0xd4, 0x6d, 0xb6, 0x6a, 0x67,
+	0xf4, 0xc2, 0xa8, 0x6d, 0xa5, 0xed, 0xb0, 0xaf, 0xf7, 0x8f, 0xd5, 0x36, 0x7a, 0x04, 0xcb, 0x98,
+	0x10, 0x97, 0x71, 0x42, 0x5d, 0xec, 0x79, 0xbc, 0xc5, 0xa4, 0xcb, 0x59, 0x78, 0xa1, 0x8e, 0x68,
+	0xc6, 0x41, 0x98, 0x90, 0xaf, 0x39, 0xa1, 0xbb, 0x7a, 0xeb, 0x88, 0x85, 0x17, 0xa5, 0x3c, 0xdc,
+	0xeb, 0x66, 0x91, 0x12, 0xfc, 0xc5, 0x82, 0xf9, 0xe4, 0x5c, 0x70, 0x83, 0x9e, 0x70, 0x49, 0x6f,
+	0x27, 0xa4, 0xfd, 0x58, 0x48, 0xb8, 0x41, 0xdd, 0x80, 0x9d, 0x72, 0x45, 0x21, 0x57, 0x29, 0x0d,
+	0x3d, 0x11, 0x95, 0xd0, 0xa8, 0x24, 0xab, 0x62, 0xab, 0xec, 0x94, 0x97, 0x56, 0x61, 0xa5, 0xa7,
+	0xa0, 0xb4, 0xd8, 0xff, 0x33, 0x90, 0x6f, 0x9f, 0x56, 0x3a, 0x87, 0xbe, 0x8c, 0xc7, 0xd0, 0x90,
+	0xaa, 0xdf, 0x85, 0x85, 0x40, 0x54, 0x59, 0x8d, 0xb7, 0x18, 0xf9, 0x82, 0xe1, 0x5a, 0x48, 0x89,
+	0x2a, 0x70, 0xc6, 0xe9, 0xb3, 0xa3, 0x6d, 0x58, 0x0c, 0xc4, 0x51, 0x4b, 0x76, 0x39, 0xeb, 0xc6,
+	0xf6, 0x6f, 0xa0, 0x3a, 0x2c, 0xfb, 0x58, 0x1c, 0x47, 0x81, 0x47, 0xab, 0x2c, 0x4e, 0x27, 0xa8,
+	0x2a, 0xc6, 0xbc, 0x15, 0x95, 0xa1, 0xfc, 0xf7, 0x07, 0x45, 0x3a, 0x83, 0x01, 0xd1, 0x8f, 0x70,
+	0xbf, 0xd6, 0x7e, 0x71, 0x4e, 0x68, 0x14, 0x9c, 0x06, 0x1e, 0x96, 0x01, 0xd7, 0xec, 0xf3, 0x53,
+	0x2a, 0xe1, 0x27, 0x23, 0x1a, 0x7e, 0x3d, 0x80, 0x33, 0x14, 0x1e, 0x7d, 0x0b, 0xd0, 0x6e, 0x75,
+	0x7e, 0x7a, 0x33, 0xb3, 0x95, 0x1b, 0x31, 0xe3, 0x9e, 0xc4, 0xf6, 0x9e, 0x33, 0x32, 0x87, 0xdd,
+	0x01, 0x85, 0xde, 0x86, 0xb9, 0x88, 0xea, 0x76, 0x1e, 0xe8, 0x41, 0x33, 0xa3, 0x74, 0xd5, 0x63,
+	0x2d, 0x95, 0x60, 0xf3, 0xba, 0x93, 0x4f, 0xe5, 0xb1, 0xab, 0xa4, 0xac, 0x7d, 0xbe, 0xa2, 0x17,
+	0x3e, 0x65, 0x43, 0x44, 0xb1, 0x04, 0x93, 0x8a, 0xb1, 0xd1, 0xb1, 0x5e, 0x18, 0xf1, 0x75, 0x42,
+	0xa4, 0xe8, 0xbf, 0x59, 0x80, 0x0e, 0x85, 0x1f, 0x0b, 0xd2, 0x68, 0xe6, 0x00, 0x87, 0xf2, 0x76,
+	0x2f, 0xcb, 0x12, 0x4c, 0x3e, 0x8f, 0xbc, 0xca, 0x8e, 0x79, 0xd5, 0xf5, 0x02, 0xbd, 0x05, 0xb3,
+	0x5e, 0x9d, 0xc6, 0xb3, 0xb8, 0x73, 0xe4, 0xe6, 0x94, 0x4d, 0xb7, 0x21, 0xce, 0x46, 0xe8, 0x69,
+	0xe0, 0x05, 0x52, 0x49, 0x2c, 0xeb, 0x24, 0xcb, 0xd2, 0x7d, 0x28, 0xf4, 0x57, 0x97, 0x16, 0xff,
+	0x87, 0xbe, 0x31, 0xe2, 0xed, 0x6f, 0x84, 0xd8, 0xc3, 0x21, 0x66, 0x1e, 0xbd, 0xf5, 0x8d, 0x21,
+	0x85, 0xe8, 0x9e, 0x55, 0x59, 0x29, 0x84, 0x99, 0x4e, 0x55, 0x98, 0xae, 0x69, 0x78, 0x55, 0x7f,
+	0x76, 0xcf, 0x8e, 0xcf, 0xfc, 0x9f, 0x57, 0x1b, 0x0f, 0xfc, 0x40, 0xd6, 0x5b, 0xb5, 0xf8, 0xa2,
+	0xb0, 0x3d, 0x2e, 0x1a, 0x5c, 0x98, 0x9f, 0x87, 0x82, 0x9c, 0xd9, 0xf2, 0xa2, 0x49, 0x45, 0xf9,
+	0x69, 0xc0, 0xa4, 0x93, 0xc4, 0x9b, 0x2b, 0xa3, 0xbb, 0xe6, 0x84, 0x51, 0xe5, 0xef, 0x19, 0xc8,
+	0x1c, 0x0a, 0x1f, 0x71, 0xc8, 0x75, 0x4e, 0xe7, 0xf7, 0x86, 0x8a, 0xb2, 0x7b, 0x08, 0x16, 0x1e,
+	0xdf, 0xc0, 0x39, 0x49, 0x8c, 0xce, 0x61, 0xae, 0xe7, 0x0b, 0xa4, 0x3c, 0x0a, 0xa6, 0xdb, 0xbf,
+	0xf0, 0xe1, 0xcd, 0xfc, 0xd3, 0xcc, 0x3f, 0xc1, 0x42, 0xdf, 0x15, 0xb9, 0x33, 0x1e, 0x56, 0x3b,
+	0xa2, 0xf0, 0xf1, 0x4d, 0x23, 0xd2, 0xfc, 0x11, 0xcc, 0x76, 0xdd, 0x13, 0xdb, 0x63, 0xb4, 0x2f,
+	0xf5, 0x2e, 0xbc, 0x7f, 0x13, 0xef, 0x34, 0xe7, 0xcf, 0x16, 0x2c, 0x0f, 0x9e, 0xf7, 0x1f, 0x8c,
+	0xc9, 0xa3, 0x3b, 0xac, 0xf0, 0xd9, 0xad, 0xc2, 0x3a, 0x7b, 0xd0, 0x35, 0x60, 0xb6, 0xc7, 0x83,
+	0xd3, 0xde, 0xa3, 0x7b, 0x30, 0x68, 0xf2, 0xc4, 0x8a, 0xeb, 0xf9, 0xd4, 0x2b, 0x8f, 0xd5, 0xcb,
+	0xd4, 0x7f, 0xb4, 0xe2, 0x06, 0x7f, 0x97, 0xa1, 0x1f, 0x60, 0xbe, 0x77, 0xde, 0xd9, 0xa3, 0xa0,
+	0x7a, 0x02, 0x0a, 0x1f, 0xdd, 0x30, 0xa0, 0x93, 0x76, 0xcf, 0xbc, 0x2a, 0x8f, 0x03, 0xd5, 0xf6,
+	0x1f, 0x4d, 0x7b, 0xf0, 0x6c, 0xd9, 0xab, 0xbe, 0xb8, 0x2c, 0x5a, 0x2f, 0x2f, 0x8b, 0xd6, 0xbf,
+	0x97, 0x45, 0xeb, 0xd7, 0xab, 0xe2, 0xc4, 0xcb, 0xab, 0xe2, 0xc4, 0x5f, 0x57, 0xc5, 0x89, 0xef,
+	0xec, 0x8e, 0x21, 0x16, 0x23, 0x3e, 0x54, 0xe0, 0x76, 0x02, 0x6e, 0x9f, 0xdb, 0xed, 0xff, 0x2d,
+	0xf1, 0x44, 0xab, 0x4d, 0xa9, 0xbf, 0x2e, 0x8f, 0x5f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xeb,
+	0xbf, 0x87, 0xb1, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -833,6 +1083,8 @@ type MsgClient interface {
 	UpdateCrosschainFlags(ctx context.Context, in *MsgUpdateCrosschainFlags, opts ...grpc.CallOption) (*MsgUpdateCrosschainFlagsResponse, error)
 	UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error)
 	AddBlockHeader(ctx context.Context, in *MsgAddBlockHeader, opts ...grpc.CallOption) (*MsgAddBlockHeaderResponse, error)
+	VoteInboundHalt(ctx context.Context, in *MsgVoteInboundHalt, opts ...grpc.CallOption) (*MsgVoteInboundHaltResponse, error)
+	VoteTssBalance(ctx context.Context, in *MsgVoteTssBalance, opts ...grpc.CallOption) (*MsgVoteTssBalanceResponse, error)
 }
 
 type msgClient struct {
@@ -906,6 +1158,24 @@ func (c *msgClient) AddBlockHeader(ctx context.Context, in *MsgAddBlockHeader, o
 	return out, nil
 }
 
+func (c *msgClient) VoteInboundHalt(ctx context.Context, in *MsgVoteInboundHalt, opts ...grpc.CallOption) (*MsgVoteInboundHaltResponse, error) {
+	out := new(MsgVoteInboundHaltResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteInboundHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteTssBalance(ctx context.Context, in *MsgVoteTssBalance, opts ...grpc.CallOption) (*MsgVoteTssBalanceResponse, error) {
+	out := new(MsgVoteTssBalanceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteTssBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
@@ -915,6 +1185,8 @@ type MsgServer interface {
 	UpdateCrosschainFlags(context.Context, *MsgUpdateCrosschainFlags) (*MsgUpdateCrosschainFlagsResponse, error)
 	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
 	AddBlockHeader(context.Context, *MsgAddBlockHeader) (*MsgAddBlockHeaderResponse, error)
+	VoteInboundHalt(context.Context, *MsgVoteInboundHalt) (*MsgVoteInboundHaltResponse, error)
+	VoteTssBalance(context.Context, *MsgVoteTssBalance) (*MsgVoteTssBalanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -942,6 +1214,12 @@ func (*UnimplementedMsgServer) UpdateKeygen(ctx context.Context, req *MsgUpdateK
 func (*UnimplementedMsgServer) AddBlockHeader(ctx context.Context, req *MsgAddBlockHeader) (*MsgAddBlockHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddBlockHeader not implemented")
 }
+func (*UnimplementedMsgServer) VoteInboundHalt(ctx context.Context, req *MsgVoteInboundHalt) (*MsgVoteInboundHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteInboundHalt not implemented")
+}
+func (*UnimplementedMsgServer) VoteTssBalance(ctx context.Context, req *MsgVoteTssBalance) (*MsgVoteTssBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTssBalance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1073,6 +1351,42 @@ func _Msg_AddBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteInboundHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteInboundHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteInboundHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/VoteInboundHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteInboundHalt(ctx, req.(*MsgVoteInboundHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteTssBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteTssBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteTssBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/VoteTssBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteTssBalance(ctx, req.(*MsgVoteTssBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.observer.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1105,6 +1419,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddBlockHeader",
 			Handler:    _Msg_AddBlockHeader_Handler,
 		},
+		{
+			MethodName: "VoteInboundHalt",
+			Handler:    _Msg_VoteInboundHalt_Handler,
+		},
+		{
+			MethodName: "VoteTssBalance",
+			Handler:    _Msg_VoteTssBalance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "observer/tx.proto",
@@ -1492,6 +1814,25 @@ func (m *MsgUpdateCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.ReEnableHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReEnableHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChainFlags) > 0 {
+		for iNdEx := len(m.ChainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.BlockHeaderVerificationFlags != nil {
 		{
 			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -1627,48 +1968,200 @@ func (m *MsgUpdateKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgVoteInboundHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateObserver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgVoteInboundHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteInboundHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Deficit) > 0 {
+		i -= len(m.Deficit)
+		copy(dAtA[i:], m.Deficit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Deficit)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.OldObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CheckHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CheckHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.NewObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UpdateReason != 0 {
-		n += 1 + sovTx(uint64(m.UpdateReason))
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteInboundHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteInboundHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteInboundHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteTssBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTssBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTssBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteTssBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTssBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTssBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpdateReason != 0 {
+		n += 1 + sovTx(uint64(m.UpdateReason))
+	}
+	return n
+}
+
+func (m *MsgUpdateObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgAddBlockHeader) Size() (n int) {
@@ -1815,6 +2308,15 @@ func (m *MsgUpdateCrosschainFlags) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ChainFlags) > 0 {
+		for _, e := range m.ChainFlags {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ReEnableHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReEnableHeight))
+	}
 	return n
 }
 
@@ -1852,6 +2354,73 @@ func (m *MsgUpdateKeygenResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteInboundHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CheckHeight != 0 {
+		n += 1 + sovTx(uint64(m.CheckHeight))
+	}
+	l = len(m.Deficit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteInboundHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteTssBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVoteTssBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3051,6 +3620,59 @@ func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainFlags = append(m.ChainFlags, ChainCrosschainFlags{})
+			if err := m.ChainFlags[len(m.ChainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReEnableHeight", wireType)
+			}
+			m.ReEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3273,6 +3895,457 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVoteInboundHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteInboundHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteInboundHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckHeight", wireType)
+			}
+			m.CheckHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deficit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deficit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteInboundHaltResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteInboundHaltResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteInboundHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteTssBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteTssBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteTssBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteTssBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteTssBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteTssBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -57,9 +57,10 @@ type BitcoinChainClient struct {
 
 	Mu                *sync.Mutex // lock for all the maps, utxos and core params
 	pendingNonce      uint64
-	includedTxHashes  map[string]uint64                       // key: tx hash
+	includedTxHashes  map[string]uint64                       // key: tx hash, value: highest nonce paid
 	includedTxResults map[string]btcjson.GetTransactionResult // key: chain-tss-nonce
 	broadcastedTx     map[string]string                       // key: chain-tss-nonce, value: outTx hash
+	rbfBlocks         int64                                   // blocks a pending outTx waits before being replaced by fee
 	utxos             []btcjson.ListUnspentResult
 	params            observertypes.CoreParams
 
@@ -75,6 +76,7 @@ const (
 	minConfirmations = 0
 	maxHeightDiff    = 10000
 	btcBlocksPerDay  = 144
+	defaultRBFBlocks = 3 // replace a pending outTx if it's not mined within 3 blocks
 )
 
 func (ob *BitcoinChainClient) WithZetaClient(bridge *ZetaCoreBridge) {
@@ -151,23 +153,19 @@ func NewBitcoinClient(
 	ob.includedTxResults = make(map[string]btcjson.GetTransactionResult)
 	ob.broadcastedTx = make(map[string]string)
 	ob.params = btcCfg.CoreParams
+	ob.rbfBlocks = btcCfg.RBFBlocks
+	if ob.rbfBlocks <= 0 {
+		ob.rbfBlocks = defaultRBFBlocks
+	}
 
 	// initialize the Client
-	ob.logger.ChainLogger.Info().Msgf("Chain %s endpoint %s", ob.chain.String(), btcCfg.RPCHost)
-	connCfg := &rpcclient.ConnConfig{
-		Host:         btcCfg.RPCHost,
-		User:         btcCfg.RPCUsername,
-		Pass:         btcCfg.RPCPassword,
-		HTTPPostMode: true,
-		DisableTLS:   true,
-		Params:       btcCfg.RPCParams,
-	}
-	client, err := rpcclient.New(connCfg, nil)
+	ob.logger.ChainLogger.Info().Msgf("Chain %s endpoint %s, %d fallback endpoints", ob.chain.String(), btcCfg.RPCHost, len(btcCfg.GetRPCHosts())-1)
+	client, err := NewBTCFailoverClient(ob.chain.ChainId, btcCfg, ob.params.ConfirmationCount, chainLogger, ts)
 	if err != nil {
-		return nil, fmt.Errorf("error creating rpc client: %s", err)
+		return nil, err
 	}
 	ob.rpcClient = client
-	err = client.Ping()
+	_, err = client.GetBlockCount()
 	if err != nil {
 		return nil, fmt.Errorf("error ping the bitcoin server: %s", err)
 	}
@@ -199,11 +197,17 @@ func (ob *BitcoinChainClient) Start() {
 	go ob.WatchUTXOS()
 	go ob.WatchGasPrice()
 	go ob.ExternalChainWatcherForNewInboundTrackerSuggestions()
+	if client, ok := ob.rpcClient.(*BTCFailoverClient); ok {
+		client.Start() // watches the health of bitcoin nodes
+	}
 }
 
 func (ob *BitcoinChainClient) Stop() {
 	ob.logger.ChainLogger.Info().Msgf("ob %s is stopping", ob.chain.String())
 	close(ob.stop) // this notifies all goroutines to stop
+	if client, ok := ob.rpcClient.(*BTCFailoverClient); ok {
+		client.Stop()
+	}
 	ob.logger.ChainLogger.Info().Msgf("%s observer stopped", ob.chain.String())
 }
 
@@ -281,6 +285,13 @@ func (ob *BitcoinChainClient) postBlockHeader(tip int64) error {
 	res, err := ob.zetaClient.GetBlockHeaderStateByChain(ob.chain.ChainId)
 	if err == nil && res.BlockHeaderState != nil && res.BlockHeaderState.EarliestHeight > 0 {
 		bn = res.BlockHeaderState.LatestHeight + 1
+	} else if ob.chain.ChainId != common.BtcRegtestChain().ChainId {
+		// the first header must start a retarget period for the difficulty of the next headers to be validated
+		blocksPerRetarget, err := common.GetBTCBlocksPerRetarget(ob.chain.ChainId)
+		if err != nil {
+			return err
+		}
+		bn = tip - tip%blocksPerRetarget
 	}
 	if bn > tip {
 		return fmt.Errorf("postBlockHeader: must post block confirmed block header: %d > %d", bn, tip)
@@ -331,7 +342,7 @@ func (ob *BitcoinChainClient) observeInTx() error {
 	if err != nil {
 		return err
 	}
-	if !flags.IsInboundEnabled {
+	if !flags.IsChainInboundEnabled(ob.chain.ChainId) {
 		return errors.New("inbound TXS / Send has been disabled by the protocol")
 	}
 
@@ -373,14 +384,23 @@ func (ob *BitcoinChainClient) observeInTx() error {
 			ob.chain.ChainId,
 		)
 
-		for _, inTx := range inTxs {
+		// post the votes of the block concurrently to broadcast them in the same batch tx
+		posts := make([]func() error, len(inTxs))
+		for i, inTx := range inTxs {
 			msg := ob.GetInboundVoteMessageFromBtcEvent(inTx)
-			zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
+			posts[i] = func() error {
+				zetaHash, err := ob.zetaClient.PostSend(msg)
+				if err != nil {
+					return err
+				}
+				ob.logger.WatchInTx.Info().Msgf("ZetaSent event detected and reported: PostSend zeta tx: %s", zetaHash)
+				return nil
+			}
+		}
+		for _, err := range postVotesConcurrently(posts) {
 			if err != nil {
 				ob.logger.WatchInTx.Error().Err(err).Msg("error posting to zeta core")
-				continue
 			}
-			ob.logger.WatchInTx.Info().Msgf("ZetaSent event detected and reported: PostSend zeta tx: %s", zetaHash)
 		}
 
 		// Save LastBlockHeight
@@ -512,7 +532,7 @@ func (ob *BitcoinChainClient) PostGasPrice() error {
 			return err
 		}
 		// #nosec G701 always in range
-		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1, "100", uint64(bn))
+		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1, 0, "100", uint64(bn))
 		if err != nil {
 			ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 			return err
@@ -538,7 +558,7 @@ func (ob *BitcoinChainClient) PostGasPrice() error {
 		return err
 	}
 	// #nosec G701 always positive
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), "100", uint64(bn))
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), 0, "100", uint64(bn))
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 		return err
@@ -791,6 +811,25 @@ func (ob *BitcoinChainClient) FetchUTXOS() error {
 	return nil
 }
 
+// GetTssUTXOsAmount fetches the UTXOs of the TSS address and returns their total amount in satoshis
+func (ob *BitcoinChainClient) GetTssUTXOsAmount() (int64, error) {
+	err := ob.FetchUTXOS()
+	if err != nil {
+		return 0, err
+	}
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	total := int64(0)
+	for _, utxo := range ob.utxos {
+		amount, err := GetSatoshis(utxo.Amount)
+		if err != nil {
+			return 0, err
+		}
+		total += amount
+	}
+	return total, nil
+}
+
 // refreshPendingNonce tries increasing the artificial pending nonce of outTx (if lagged behind).
 // There could be many (unpredictable) reasons for a pending nonce lagging behind, for example:
 // 1. The zetaclient gets restarted.
@@ -959,6 +998,43 @@ func (ob *BitcoinChainClient) SelectUTXOs(amount float64, utxosToSpend uint16, n
 	return results, total, consolidatedUtxo, consolidatedValue, nil
 }
 
+// SelectSweepUTXOs selects the utxos spent by the sweep of the TSS funds to a new TSS: the nonce-mark utxo
+// of the previous nonce followed by the 'maxNoOfInputsPerTx' biggest utxos, it returns the utxos and their total value.
+// The utxos left by a sweep are swept by the next migration, the TSS address is not updated while they cover its fees.
+func (ob *BitcoinChainClient) SelectSweepUTXOs(nonce uint64, test bool) ([]btcjson.ListUnspentResult, float64, error) {
+	idx := -1
+	if nonce == 0 {
+		ob.Mu.Lock()
+		defer ob.Mu.Unlock()
+	} else {
+		preTxid, err := ob.getOutTxidByNonce(nonce-1, test)
+		if err != nil {
+			return nil, 0, err
+		}
+		ob.Mu.Lock()
+		defer ob.Mu.Unlock()
+		idx, err = ob.findNonceMarkUTXO(nonce-1, preTxid)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	results := make([]btcjson.ListUnspentResult, 0, maxNoOfInputsPerTx+1)
+	total := 0.0
+	if idx >= 0 {
+		results = append(results, ob.utxos[idx])
+		total += ob.utxos[idx].Amount
+	}
+	numInputs := len(results) + maxNoOfInputsPerTx
+	for i := len(ob.utxos) - 1; i >= 0 && len(results) < numInputs; i-- { // iterate over UTXOs big-to-small
+		if i != idx {
+			results = append(results, ob.utxos[i])
+			total += ob.utxos[i].Amount
+		}
+	}
+	return results, total, nil
+}
+
 // SaveBroadcastedTx saves successfully broadcasted transaction
 func (ob *BitcoinChainClient) SaveBroadcastedTx(txHash string, nonce uint64) {
 	outTxID := ob.GetTxID(nonce)
@@ -973,6 +1049,91 @@ func (ob *BitcoinChainClient) SaveBroadcastedTx(txHash string, nonce uint64) {
 	ob.logger.ObserveOutTx.Info().Msgf("SaveBroadcastedTx: saved broadcasted txHash %s for outTx %s", txHash, outTxID)
 }
 
+// GetStuckOutTx returns the outTx of given nonce if it has been pending in the mempool for at least 'rbfBlocks' blocks,
+// along with its mempool entry. Returns nil if the outTx is not broadcasted by this observer or not stuck yet.
+// An outTx spent by a pending outTx (the UTXOs are selected with minconf 0) is not returned: replacing it would
+// evict its descendants, so the newest descendant is replaced instead and pays for its ancestors (CPFP).
+func (ob *BitcoinChainClient) GetStuckOutTx(nonce uint64) (*wire.MsgTx, *btcjson.GetMempoolEntryResult, error) {
+	outTxID := ob.GetTxID(nonce)
+	ob.Mu.Lock()
+	txHash, broadcasted := ob.broadcastedTx[outTxID] // the latest replacement if any
+	res, included := ob.includedTxResults[outTxID]
+	ob.Mu.Unlock()
+	if !broadcasted || (included && res.Confirmations > 0) {
+		return nil, nil, nil
+	}
+
+	entry, err := ob.rpcClient.GetMempoolEntry(txHash)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "GetStuckOutTx: error GetMempoolEntry %s", txHash)
+	}
+	// the descendant count includes the outTx itself
+	if entry.DescendantCount > 1 {
+		ob.logger.ObserveOutTx.Info().Msgf("GetStuckOutTx: outTx %s outTxID %s has %d pending descendants, the newest one is replaced",
+			txHash, outTxID, entry.DescendantCount-1)
+		return nil, nil, nil
+	}
+	blockNumber, err := ob.rpcClient.GetBlockCount()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "GetStuckOutTx: error GetBlockCount")
+	}
+	if blockNumber-entry.Height < ob.rbfBlocks {
+		return nil, nil, nil
+	}
+
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "GetStuckOutTx: error NewHashFromStr: %s", txHash)
+	}
+	rawResult, err := ob.rpcClient.GetRawTransactionVerbose(hash)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "GetStuckOutTx: error GetRawTransactionVerbose %s", txHash)
+	}
+	tx, err := DeserializeMsgTx(rawResult.Hex)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "GetStuckOutTx: error deserializing outTx %s", txHash)
+	}
+	ob.logger.ObserveOutTx.Info().Msgf("GetStuckOutTx: outTx %s outTxID %s pending since block %d with %d pending ancestors",
+		txHash, outTxID, entry.Height, entry.AncestorCount-1)
+	return tx, entry, nil
+}
+
+// GetMempoolAncestors returns the vsize and the fees (in satoshis) of the pending ancestors of a mempool entry
+func GetMempoolAncestors(entry *btcjson.GetMempoolEntryResult) (int64, int64, error) {
+	// the ancestor size and fees include the entry itself
+	ancestorFees, err := btcutil.NewAmount(entry.Fees.Ancestor)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "GetMempoolAncestors: invalid ancestor fees %f", entry.Fees.Ancestor)
+	}
+	baseFee, err := btcutil.NewAmount(entry.Fees.Base)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "GetMempoolAncestors: invalid base fee %f", entry.Fees.Base)
+	}
+	return entry.AncestorSize - int64(entry.VSize), int64(ancestorFees - baseFee), nil
+}
+
+// GetTxInPrevOuts returns the previous outputs spent by the inputs of given tx.
+// The Bitcoin node has to be configured to watch TSS address
+func (ob *BitcoinChainClient) GetTxInPrevOuts(tx *wire.MsgTx) ([]*wire.TxOut, error) {
+	prevOuts := make([]*wire.TxOut, 0, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		prevHash := txIn.PreviousOutPoint.Hash
+		txResult, err := ob.rpcClient.GetTransaction(&prevHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "GetTxInPrevOuts: error GetTransaction %s", prevHash)
+		}
+		prevTx, err := DeserializeMsgTx(txResult.Hex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "GetTxInPrevOuts: error deserializing tx %s", prevHash)
+		}
+		if txIn.PreviousOutPoint.Index >= uint32(len(prevTx.TxOut)) {
+			return nil, fmt.Errorf("GetTxInPrevOuts: invalid outpoint %s", txIn.PreviousOutPoint)
+		}
+		prevOuts = append(prevOuts, prevTx.TxOut[txIn.PreviousOutPoint.Index])
+	}
+	return prevOuts, nil
+}
+
 func (ob *BitcoinChainClient) GetCctxParams(nonce uint64) (types.OutboundTxParams, error) {
 	send, err := ob.zetaClient.GetCctxByNonce(ob.chain.ChainId, nonce)
 	if err != nil {
@@ -1032,6 +1193,7 @@ func (ob *BitcoinChainClient) observeOutTx() {
 }
 
 // checkNSaveIncludedTx either includes a new outTx or update an existing outTx result.
+// A batched outTx is included for every cctx it pays so that one observation confirms all of them.
 // Returns inMempool, error
 func (ob *BitcoinChainClient) checkNSaveIncludedTx(txHash string, params types.OutboundTxParams) (bool, error) {
 	outTxID := ob.GetTxID(params.OutboundTxTssNonce)
@@ -1040,62 +1202,106 @@ func (ob *BitcoinChainClient) checkNSaveIncludedTx(txHash string, params types.O
 		return false, errors.Wrapf(err, "checkNSaveIncludedTx: error GetTxResultByHash: %s", txHash)
 	}
 	if getTxResult.Confirmations >= 0 { // check included tx only
-		err = ob.checkTssOutTxResult(hash, getTxResult, params, params.OutboundTxTssNonce)
+		nonce, lastNonce, err := ob.checkTssOutTxResult(hash, getTxResult, params, params.OutboundTxTssNonce)
 		if err != nil {
 			return false, errors.Wrapf(err, "checkNSaveIncludedTx: error verify bitcoin outTx %s outTxID %s", txHash, outTxID)
 		}
 
-		ob.Mu.Lock()
-		defer ob.Mu.Unlock()
-		nonce, foundHash := ob.includedTxHashes[txHash]
-		res, foundRes := ob.includedTxResults[outTxID]
-
-		// include new outTx and enforce rigid 1-to-1 mapping: outTxID(nonce) <===> txHash
-		if !foundHash && !foundRes {
-			ob.includedTxHashes[txHash] = params.OutboundTxTssNonce
-			ob.includedTxResults[outTxID] = *getTxResult
-			if params.OutboundTxTssNonce >= ob.pendingNonce { // try increasing pending nonce on every newly included outTx
-				ob.pendingNonce = params.OutboundTxTssNonce + 1
-			}
-			ob.logger.ObserveOutTx.Info().Msgf("checkNSaveIncludedTx: included new bitcoin outTx %s outTxID %s pending nonce %d", txHash, outTxID, ob.pendingNonce)
+		for n := nonce; n <= lastNonce; n++ {
+			ob.setIncludedTx(txHash, getTxResult, n)
 		}
-		// update saved tx result as confirmations may increase
-		if foundHash && foundRes {
-			ob.includedTxResults[outTxID] = *getTxResult
-			if getTxResult.Confirmations > res.Confirmations {
-				ob.logger.ObserveOutTx.Info().Msgf("checkNSaveIncludedTx: bitcoin outTx %s got confirmations %d", txHash, getTxResult.Confirmations)
-			}
+		return false, nil
+	}
+	return true, nil // in mempool
+}
+
+// setIncludedTx includes a new outTx or updates an existing outTx result, enforcing rigid mapping: outTxID(nonce) ===> txHash
+// A batched outTx pays several nonces, the tx hash maps to the highest nonce included so far.
+// A pending outTx (0 confirmations) can be replaced by fee with another outTx of the same nonce. Both spend the same nonce-mark
+// so at most one of them can be mined and the replacement is accepted once it's mined.
+func (ob *BitcoinChainClient) setIncludedTx(txHash string, getTxResult *btcjson.GetTransactionResult, nonce uint64) {
+	outTxID := ob.GetTxID(nonce)
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	lastNonce, foundHash := ob.includedTxHashes[txHash]
+	res, foundRes := ob.includedTxResults[outTxID]
+
+	// include new outTx
+	if !foundRes {
+		if !foundHash || nonce > lastNonce {
+			ob.includedTxHashes[txHash] = nonce
 		}
-		if !foundHash && foundRes { // be alert for duplicate payment!!! As we got a new hash paying same cctx. It might happen (e.g. majority of signers get crupted)
-			ob.logger.ObserveOutTx.Error().Msgf("checkNSaveIncludedTx: duplicate payment by bitcoin outTx %s outTxID %s, prior result %v, current result %v", txHash, outTxID, res, *getTxResult)
+		ob.includedTxResults[outTxID] = *getTxResult
+		if nonce >= ob.pendingNonce { // try increasing pending nonce on every newly included outTx
+			ob.pendingNonce = nonce + 1
 		}
-		if foundHash && !foundRes {
-			ob.logger.ObserveOutTx.Error().Msgf("checkNSaveIncludedTx: unreachable code path! outTx %s outTxID %s, prior nonce %d, current nonce %d", txHash, outTxID, nonce, params.OutboundTxTssNonce)
+		ob.logger.ObserveOutTx.Info().Msgf("setIncludedTx: included new bitcoin outTx %s outTxID %s pending nonce %d", txHash, outTxID, ob.pendingNonce)
+		return
+	}
+	// update saved tx result as confirmations may increase
+	if res.TxID == txHash {
+		ob.includedTxResults[outTxID] = *getTxResult
+		if getTxResult.Confirmations > res.Confirmations {
+			ob.logger.ObserveOutTx.Info().Msgf("setIncludedTx: bitcoin outTx %s got confirmations %d", txHash, getTxResult.Confirmations)
 		}
-		return false, nil
+		return
+	}
+	if res.Confirmations == 0 && getTxResult.Confirmations > 0 { // the pending outTx was replaced by a mined one
+		delete(ob.includedTxHashes, res.TxID)
+		if !foundHash || nonce > lastNonce {
+			ob.includedTxHashes[txHash] = nonce
+		}
+		ob.includedTxResults[outTxID] = *getTxResult
+		ob.logger.ObserveOutTx.Info().Msgf("setIncludedTx: pending outTx %s replaced by mined outTx %s outTxID %s", res.TxID, txHash, outTxID)
+	} else if getTxResult.Confirmations > 0 { // be alert for duplicate payment!!! As we got a new hash paying same cctx. It might happen (e.g. majority of signers get crupted)
+		ob.logger.ObserveOutTx.Error().Msgf("setIncludedTx: duplicate payment by bitcoin outTx %s outTxID %s, prior result %v, current result %v", txHash, outTxID, res, *getTxResult)
+	} else { // wait for either of them to be mined
+		ob.logger.ObserveOutTx.Info().Msgf("setIncludedTx: pending outTx %s outTxID %s competes with pending outTx %s", txHash, outTxID, res.TxID)
 	}
-	return true, nil // in mempool
 }
 
 // Basic TSS outTX checks:
 //   - should be able to query the raw tx
 //   - check if all inputs are segwit && TSS inputs
+//   - check if the outputs pay the cctxs of all the nonces batched into the outTx
 //
-// Returns: true if outTx passes basic checks.
-func (ob *BitcoinChainClient) checkTssOutTxResult(hash *chainhash.Hash, res *btcjson.GetTransactionResult, params types.OutboundTxParams, nonce uint64) error {
+// Returns: the first and the last nonces paid by the outTx if it passes basic checks.
+func (ob *BitcoinChainClient) checkTssOutTxResult(hash *chainhash.Hash, res *btcjson.GetTransactionResult, params types.OutboundTxParams, nonce uint64) (uint64, uint64, error) {
 	rawResult, err := ob.getRawTxResult(hash, res)
 	if err != nil {
-		return errors.Wrapf(err, "checkTssOutTxResult: error GetRawTxResultByHash %s", hash.String())
+		return 0, 0, errors.Wrapf(err, "checkTssOutTxResult: error GetRawTxResultByHash %s", hash.String())
 	}
-	err = ob.checkTSSVin(rawResult.Vin, nonce)
+	firstNonce, lastNonce, err := getVoutsNonceRange(rawResult.Vout)
 	if err != nil {
-		return errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vin in outTx %s nonce %d", hash, nonce)
+		return 0, 0, errors.Wrapf(err, "checkTssOutTxResult: invalid nonce-mark in outTx %s nonce %d", hash, nonce)
+	}
+	if nonce < firstNonce || nonce > lastNonce {
+		return 0, 0, fmt.Errorf("checkTssOutTxResult: outTx %s pays nonces %d-%d, not nonce %d", hash, firstNonce, lastNonce, nonce)
 	}
-	err = ob.checkTSSVout(rawResult.Vout, params, nonce)
+
+	// the other cctxs batched into the outTx are verified along
+	batchParams := make([]types.OutboundTxParams, 0, lastNonce-firstNonce+1)
+	for n := firstNonce; n <= lastNonce; n++ {
+		if n == nonce {
+			batchParams = append(batchParams, params)
+			continue
+		}
+		p, err := ob.GetCctxParams(n)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "checkTssOutTxResult: error getting cctx of nonce %d batched in outTx %s", n, hash)
+		}
+		batchParams = append(batchParams, p)
+	}
+
+	err = ob.checkTSSVin(rawResult.Vin, firstNonce)
 	if err != nil {
-		return errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vout in outTx %s nonce %d", hash, nonce)
+		return 0, 0, errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vin in outTx %s nonce %d", hash, nonce)
 	}
-	return nil
+	err = ob.checkTSSVout(rawResult.Vout, batchParams, firstNonce)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vout in outTx %s nonce %d", hash, nonce)
+	}
+	return firstNonce, lastNonce, nil
 }
 
 func (ob *BitcoinChainClient) GetTxResultByHash(txID string) (*chainhash.Hash, *btcjson.GetTransactionResult, error) {
@@ -1171,13 +1377,25 @@ func (ob *BitcoinChainClient) checkTSSVin(vins []btcjson.Vin, nonce uint64) erro
 }
 
 // checkTSSVout vout is valid if:
-//   - The first output is the nonce-mark
-//   - The second output is the correct payment to recipient
-//   - The third output is the change to TSS (optional)
-func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.OutboundTxParams, nonce uint64) error {
-	// vouts: [nonce-mark, payment to recipient, change to TSS (optional)]
-	if !(len(vouts) == 2 || len(vouts) == 3) {
-		return fmt.Errorf("checkTSSVout: invalid number of vouts: %d", len(vouts))
+//   - The first output is the nonce-mark of the last nonce
+//   - The next outputs are the correct payments to the recipients, one per nonce from the first nonce 'nonce'
+//   - The last output is the change to TSS (optional if there is only one payment)
+//
+// The payment to recipient can be any supported output type (P2TR, P2WSH, P2WPKH, P2SH or P2PKH)
+func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params []types.OutboundTxParams, nonce uint64) error {
+	// vouts: [nonce-mark, payment to recipient 1, ..., payment to recipient N, change to TSS (optional if N = 1)]
+	numPayments := len(params)
+	if numPayments == 0 {
+		return fmt.Errorf("checkTSSVout: no payment to check")
+	}
+	if !(len(vouts) == numPayments+2 || (numPayments == 1 && len(vouts) == 2)) {
+		return fmt.Errorf("checkTSSVout: invalid number of vouts: %d for %d payments", len(vouts), numPayments)
+	}
+	// #nosec G701 always positive
+	lastNonce := nonce + uint64(numPayments) - 1
+	bitcoinNetParams, err := common.GetBTCChainParams(ob.chain.ChainId)
+	if err != nil {
+		return errors.Wrapf(err, "checkTSSVout: error getting bitcoin net params for chain %d", ob.chain.ChainId)
 	}
 
 	tssAddress := ob.Tss.BTCAddress()
@@ -1186,46 +1404,44 @@ func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.Ou
 		if err != nil {
 			return errors.Wrap(err, "checkTSSVout: error getting satoshis")
 		}
-		// decode P2WPKH scriptPubKey
+		// decode receiver address from scriptPubKey
 		scriptPubKey := vout.ScriptPubKey.Hex
 		decodedScriptPubKey, err := hex.DecodeString(scriptPubKey)
 		if err != nil {
 			return errors.Wrapf(err, "checkTSSVout: error decoding scriptPubKey %s", scriptPubKey)
 		}
-		if len(decodedScriptPubKey) != 22 { // P2WPKH script
-			return fmt.Errorf("checkTSSVout: unsupported scriptPubKey: %s", scriptPubKey)
-		}
-		witnessVersion := decodedScriptPubKey[0]
-		witnessProgram := decodedScriptPubKey[2:]
-		if witnessVersion != 0 {
-			return fmt.Errorf("checkTSSVout: unsupported witness in scriptPubKey %s", scriptPubKey)
-		}
-		recvAddress, err := ob.chain.BTCAddressFromWitnessProgram(witnessProgram)
+		recvAddr, err := DecodeScriptPubKey(decodedScriptPubKey, bitcoinNetParams)
 		if err != nil {
-			return errors.Wrapf(err, "checkTSSVout: error getting receiver from witness program %s", witnessProgram)
+			return errors.Wrapf(err, "checkTSSVout: unsupported scriptPubKey %s", scriptPubKey)
 		}
+		recvAddress := recvAddr.EncodeAddress()
 
+		switch {
 		// 1st vout: nonce-mark
-		if vout.N == 0 {
+		case vout.N == 0:
 			if recvAddress != tssAddress {
 				return fmt.Errorf("checkTSSVout: nonce-mark address %s not match TSS address %s", recvAddress, tssAddress)
 			}
-			if amount != common.NonceMarkAmount(nonce) {
-				return fmt.Errorf("checkTSSVout: nonce-mark amount %d not match nonce-mark amount %d", amount, common.NonceMarkAmount(nonce))
+			if amount != common.NonceMarkAmount(lastNonce) {
+				return fmt.Errorf("checkTSSVout: nonce-mark amount %d not match nonce-mark amount %d", amount, common.NonceMarkAmount(lastNonce))
 			}
-		}
-		// 2nd vout: payment to recipient
-		if vout.N == 1 {
-			if recvAddress != params.Receiver {
-				return fmt.Errorf("checkTSSVout: output address %s not match params receiver %s", recvAddress, params.Receiver)
+		// 2nd to (N+1)th vouts: payments to recipients
+		case int(vout.N) <= numPayments:
+			p := params[vout.N-1]
+			// the receiver is re-encoded so that its format matches the address decoded from the scriptPubKey
+			receiver, err := common.DecodeBtcAddress(p.Receiver, ob.chain.ChainId)
+			if err != nil {
+				return errors.Wrapf(err, "checkTSSVout: error decoding receiver %s", p.Receiver)
+			}
+			if recvAddress != receiver.EncodeAddress() {
+				return fmt.Errorf("checkTSSVout: output address %s not match params receiver %s", recvAddress, p.Receiver)
 			}
 			// #nosec G701 always positive
-			if uint64(amount) != params.Amount.Uint64() {
-				return fmt.Errorf("checkTSSVout: output amount %d not match params amount %d", amount, params.Amount)
+			if uint64(amount) != p.Amount.Uint64() {
+				return fmt.Errorf("checkTSSVout: output amount %d not match params amount %d", amount, p.Amount)
 			}
-		}
-		// 3rd vout: change to TSS (optional)
-		if vout.N == 2 {
+		// last vout: change to TSS
+		default:
 			if recvAddress != tssAddress {
 				return fmt.Errorf("checkTSSVout: change address %s not match TSS address %s", recvAddress, tssAddress)
 			}
@@ -1234,6 +1450,18 @@ func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.Ou
 	return nil
 }
 
+// getVoutsNonceRange returns the first and the last nonces paid by an outTx given its vouts, see GetOutTxNonceRange
+func getVoutsNonceRange(vouts []btcjson.Vout) (uint64, uint64, error) {
+	if len(vouts) < 2 {
+		return 0, 0, fmt.Errorf("getVoutsNonceRange: invalid number of vouts: %d", len(vouts))
+	}
+	nonceMark, err := GetSatoshis(vouts[0].Value)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "getVoutsNonceRange: error getting satoshis")
+	}
+	return getNonceRange(nonceMark, len(vouts))
+}
+
 func (ob *BitcoinChainClient) BuildBroadcastedTxMap() error {
 	var broadcastedTransactions []clienttypes.OutTxHashSQLType
 	if err := ob.db.Find(&broadcastedTransactions).Error; err != nil {
@@ -16,6 +16,7 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/pkg/errors"
 	flag "github.com/spf13/pflag"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 )
@@ -27,6 +28,11 @@ const (
 
 // Broadcast Broadcasts tx to metachain. Returns txHash and error
 func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg, authzSigner AuthZSigner) (string, error) {
+	return b.BroadcastMsgs(gaslimit, []sdktypes.Msg{authzWrappedMsg}, authzSigner)
+}
+
+// BroadcastMsgs Broadcasts one tx with several messages to metachain, the tx only succeeds if every message succeeds. Returns txHash and error
+func (b *ZetaCoreBridge) BroadcastMsgs(gaslimit uint64, authzWrappedMsgs []sdktypes.Msg, authzSigner AuthZSigner) (string, error) {
 	b.broadcastLock.Lock()
 	defer b.broadcastLock.Unlock()
 	var err error
@@ -66,7 +72,7 @@ func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg
 	factory = factory.WithAccountNumber(b.accountNumber[authzSigner.KeyType])
 	factory = factory.WithSequence(b.seqNumber[authzSigner.KeyType])
 	factory = factory.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
-	builder, err := factory.BuildUnsignedTx(authzWrappedMsg)
+	builder, err := factory.BuildUnsignedTx(authzWrappedMsgs...)
 	if err != nil {
 		return "", err
 	}
@@ -112,6 +118,7 @@ func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg
 			}
 			b.seqNumber[authzSigner.KeyType] = expectedSeq
 			b.logger.Warn().Msgf("Reset seq number to %d (from err msg) from %d", b.seqNumber[authzSigner.KeyType], gotSeq)
+			return commit.TxHash, errors.Wrapf(ErrSequenceMismatch, "fail to broadcast to zetachain,code:%d, log:%s", commit.Code, commit.RawLog)
 		}
 		return commit.TxHash, fmt.Errorf("fail to broadcast to zetachain,code:%d, log:%s", commit.Code, commit.RawLog)
 	}
@@ -170,6 +177,8 @@ func (b *ZetaCoreBridge) GetContext() (client.Context, error) {
 	return ctx, nil
 }
 
+// SignTx signs the tx with the hotkey, through the HSM in hsm mode.
+// With the remote keyring backend the keybase of the keys is a remotesigner.Keyring, which delegates the signature to the remote signer.
 func (b *ZetaCoreBridge) SignTx(
 	txf clienttx.Factory,
 	name string,
@@ -0,0 +1,142 @@
+package zetaclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+// BTCFailoverClient is a BTCRPCClient that spreads requests over multiple bitcoin nodes.
+// Requests go to the active node and fail over to the next healthy one on connection errors.
+type BTCFailoverClient struct {
+	clients []BTCRPCClient
+	pool    *EndpointPool
+}
+
+var _ BTCRPCClient = (*BTCFailoverClient)(nil)
+
+// NewBTCFailoverClient creates a rpc client for each of the configured hosts
+func NewBTCFailoverClient(
+	chainID int64,
+	cfg config.BTCConfig,
+	maxBlockLag uint64,
+	logger zerolog.Logger,
+	ts *TelemetryServer,
+) (*BTCFailoverClient, error) {
+	hosts := cfg.GetRPCHosts()
+	clients := make([]BTCRPCClient, len(hosts))
+	for i, host := range hosts {
+		connCfg := &rpcclient.ConnConfig{
+			Host:         host,
+			User:         cfg.RPCUsername,
+			Pass:         cfg.RPCPassword,
+			HTTPPostMode: true,
+			DisableTLS:   true,
+			Params:       cfg.RPCParams,
+		}
+		client, err := rpcclient.New(connCfg, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating rpc client for host %s: %s", endpointName(host), err)
+		}
+		clients[i] = client
+	}
+	return &BTCFailoverClient{
+		clients: clients,
+		pool:    NewEndpointPool(chainID, hosts, maxBlockLag, logger, ts),
+	}, nil
+}
+
+// Start watches the health of bitcoin nodes in the background
+func (c *BTCFailoverClient) Start() {
+	go c.pool.WatchHealth(func(_ context.Context, i int) (uint64, error) {
+		height, err := c.clients[i].GetBlockCount()
+		if err != nil {
+			return 0, err
+		}
+		return uint64(height), nil
+	})
+}
+
+// Stop stops watching the health of bitcoin nodes
+func (c *BTCFailoverClient) Stop() {
+	c.pool.Stop()
+}
+
+func (c *BTCFailoverClient) GetNetworkInfo() (*btcjson.GetNetworkInfoResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*btcjson.GetNetworkInfoResult, error) {
+		return client.GetNetworkInfo()
+	})
+}
+
+func (c *BTCFailoverClient) SendRawTransaction(tx *wire.MsgTx, allowHighFees bool) (*chainhash.Hash, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*chainhash.Hash, error) {
+		return client.SendRawTransaction(tx, allowHighFees)
+	})
+}
+
+func (c *BTCFailoverClient) ListUnspentMinMaxAddresses(minConf int, maxConf int, addrs []btcutil.Address) ([]btcjson.ListUnspentResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) ([]btcjson.ListUnspentResult, error) {
+		return client.ListUnspentMinMaxAddresses(minConf, maxConf, addrs)
+	})
+}
+
+func (c *BTCFailoverClient) EstimateSmartFee(confTarget int64, mode *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*btcjson.EstimateSmartFeeResult, error) {
+		return client.EstimateSmartFee(confTarget, mode)
+	})
+}
+
+func (c *BTCFailoverClient) GetTransaction(txHash *chainhash.Hash) (*btcjson.GetTransactionResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*btcjson.GetTransactionResult, error) {
+		return client.GetTransaction(txHash)
+	})
+}
+
+func (c *BTCFailoverClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*btcjson.TxRawResult, error) {
+		return client.GetRawTransactionVerbose(txHash)
+	})
+}
+
+func (c *BTCFailoverClient) GetMempoolEntry(txHash string) (*btcjson.GetMempoolEntryResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*btcjson.GetMempoolEntryResult, error) {
+		return client.GetMempoolEntry(txHash)
+	})
+}
+
+func (c *BTCFailoverClient) GetBlockCount() (int64, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (int64, error) {
+		return client.GetBlockCount()
+	})
+}
+
+func (c *BTCFailoverClient) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*chainhash.Hash, error) {
+		return client.GetBlockHash(blockHeight)
+	})
+}
+
+func (c *BTCFailoverClient) GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*btcjson.GetBlockVerboseResult, error) {
+		return client.GetBlockVerbose(blockHash)
+	})
+}
+
+func (c *BTCFailoverClient) GetBlockVerboseTx(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*btcjson.GetBlockVerboseTxResult, error) {
+		return client.GetBlockVerboseTx(blockHash)
+	})
+}
+
+func (c *BTCFailoverClient) GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+	return failoverCall(c.pool, c.clients, func(client BTCRPCClient) (*wire.BlockHeader, error) {
+		return client.GetBlockHeader(blockHash)
+	})
+}
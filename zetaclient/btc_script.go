@@ -0,0 +1,62 @@
+package zetaclient
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const (
+	// lengths of the standard scriptPubKeys
+	lengthScriptP2TR   = 34 // OP_1 OP_DATA_32 <32-byte witness program>
+	lengthScriptP2WSH  = 34 // OP_0 OP_DATA_32 <32-byte script hash>
+	lengthScriptP2WPKH = 22 // OP_0 OP_DATA_20 <20-byte pubkey hash>
+	lengthScriptP2SH   = 23 // OP_HASH160 OP_DATA_20 <20-byte script hash> OP_EQUAL
+	lengthScriptP2PKH  = 25 // OP_DUP OP_HASH160 OP_DATA_20 <20-byte pubkey hash> OP_EQUALVERIFY OP_CHECKSIG
+)
+
+// PayToAddrScript creates the scriptPubKey paying to the given address
+// Only the standard output types supported for withdrawals are accepted
+func PayToAddrScript(addr btcutil.Address) ([]byte, error) {
+	if !common.IsBtcAddressSupported(addr) {
+		return nil, fmt.Errorf("unsupported address type %T for address %s", addr, addr.EncodeAddress())
+	}
+	if taproot, ok := addr.(*common.AddressTaproot); ok {
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_1).AddData(taproot.WitnessProgram()).Script()
+	}
+	return txscript.PayToAddrScript(addr)
+}
+
+// DecodeScriptPubKey decodes the receiver address from a standard scriptPubKey (P2TR, P2WSH, P2WPKH, P2SH or P2PKH)
+func DecodeScriptPubKey(script []byte, net *chaincfg.Params) (btcutil.Address, error) {
+	switch {
+	case len(script) == lengthScriptP2TR &&
+		script[0] == txscript.OP_1 &&
+		script[1] == txscript.OP_DATA_32:
+		return common.NewAddressTaproot(script[2:], net)
+	case len(script) == lengthScriptP2WSH &&
+		script[0] == txscript.OP_0 &&
+		script[1] == txscript.OP_DATA_32:
+		return btcutil.NewAddressWitnessScriptHash(script[2:], net)
+	case len(script) == lengthScriptP2WPKH &&
+		script[0] == txscript.OP_0 &&
+		script[1] == txscript.OP_DATA_20:
+		return btcutil.NewAddressWitnessPubKeyHash(script[2:], net)
+	case len(script) == lengthScriptP2SH &&
+		script[0] == txscript.OP_HASH160 &&
+		script[1] == txscript.OP_DATA_20 &&
+		script[22] == txscript.OP_EQUAL:
+		return btcutil.NewAddressScriptHashFromHash(script[2:22], net)
+	case len(script) == lengthScriptP2PKH &&
+		script[0] == txscript.OP_DUP &&
+		script[1] == txscript.OP_HASH160 &&
+		script[2] == txscript.OP_DATA_20 &&
+		script[23] == txscript.OP_EQUALVERIFY &&
+		script[24] == txscript.OP_CHECKSIG:
+		return btcutil.NewAddressPubKeyHash(script[3:23], net)
+	}
+	return nil, fmt.Errorf("unsupported scriptPubKey %x", script)
+}
@@ -0,0 +1,263 @@
+package zetaclient
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// regtest vectors of every supported output type, paying to the same 20-byte or 32-byte hash
+var regtestPayeeVectors = []struct {
+	name       string
+	address    string
+	script     string
+	outputSize uint64
+}{
+	{
+		name:       "P2TR",
+		address:    "bcrt1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qg74mmc",
+		script:     "51201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+		outputSize: bytesPerOutputP2TR,
+	},
+	{
+		name:       "P2WSH",
+		address:    "bcrt1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qzf4jry",
+		script:     "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+		outputSize: bytesPerOutputP2WSH,
+	},
+	{
+		name:       "P2WPKH",
+		address:    "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080",
+		script:     "0014751e76e8199196d454941c45d1b3a323f1433bd6",
+		outputSize: bytesPerOutputP2WPKH,
+	},
+	{
+		name:       "P2SH",
+		address:    "2N3vVYSK5XRgVSGWy21PnsRmBUywSQNdCsf",
+		script:     "a914751e76e8199196d454941c45d1b3a323f1433bd687",
+		outputSize: bytesPerOutputP2SH,
+	},
+	{
+		name:       "P2PKH",
+		address:    "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r",
+		script:     "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac",
+		outputSize: bytesPerOutputP2PKH,
+	},
+}
+
+func TestPayToAddrScriptRoundTrip(t *testing.T) {
+	chainID := common.BtcRegtestChain().ChainId
+	for _, tc := range regtestPayeeVectors {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := common.DecodeBtcAddress(tc.address, chainID)
+			require.NoError(t, err)
+
+			// address -> script
+			script, err := PayToAddrScript(addr)
+			require.NoError(t, err)
+			require.Equal(t, tc.script, hex.EncodeToString(script))
+
+			// script -> address
+			decoded, err := DecodeScriptPubKey(script, &chaincfg.RegressionNetParams)
+			require.NoError(t, err)
+			require.Equal(t, tc.address, decoded.EncodeAddress())
+
+			// output size matches the serialized output
+			size, err := GetOutputSizeByAddress(addr)
+			require.NoError(t, err)
+			require.Equal(t, tc.outputSize, size)
+			// #nosec G701 always positive
+			require.Equal(t, size, uint64(wire.NewTxOut(1, script).SerializeSize()))
+		})
+	}
+}
+
+func TestPayToAddrScriptUnsupported(t *testing.T) {
+	pubKey, err := hex.DecodeString("02192d74d0cb94344c9569c2e77901573d8d7903c3ebec3a957724895dca52c6b4")
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressPubKey(pubKey, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	_, err = PayToAddrScript(addr)
+	require.Error(t, err)
+	_, err = GetOutputSizeByAddress(addr)
+	require.Error(t, err)
+}
+
+func TestDecodeScriptPubKeyUnsupported(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+	}{
+		{
+			name:   "P2PK",
+			script: "2102192d74d0cb94344c9569c2e77901573d8d7903c3ebec3a957724895dca52c6b4ac",
+		},
+		{
+			name:   "witness v2",
+			script: "52201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+		},
+		{
+			name:   "P2WPKH with wrong push length",
+			script: "0015751e76e8199196d454941c45d1b3a323f1433bd6",
+		},
+		{
+			name:   "P2SH without OP_EQUAL",
+			script: "a914751e76e8199196d454941c45d1b3a323f1433bd688",
+		},
+		{
+			name:   "OP_RETURN",
+			script: "6a0b68656c6c6f20776f726c64",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			script, err := hex.DecodeString(tc.script)
+			require.NoError(t, err)
+			_, err = DecodeScriptPubKey(script, &chaincfg.RegressionNetParams)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEstimateOutTxSize(t *testing.T) {
+	chainID := common.BtcRegtestChain().ChainId
+	privateKey, payerScript := generateKeyPair(t, &chaincfg.RegressionNetParams)
+	utxosTxids := []string{
+		"c1729638e1c9b6bfca57d11bf93047d98b65594b0bf75d7ee68bf7dc80dc164e",
+		"54f9ebbd9e3ad39a297da54bf34a609b6831acbea0361cb5b7b5c8374f5046aa",
+	}
+
+	for _, tc := range regtestPayeeVectors {
+		t.Run(tc.name, func(t *testing.T) {
+			payee, err := common.DecodeBtcAddress(tc.address, chainID)
+			require.NoError(t, err)
+			payeeScript, err := PayToAddrScript(payee)
+			require.NoError(t, err)
+
+			// build and sign a 2-input withdrawal tx: [nonce-mark, payment, change]
+			tx := wire.NewMsgTx(wire.TxVersion)
+			addTxInputs(t, tx, utxosTxids)
+			tx.AddTxOut(wire.NewTxOut(1000, payerScript))
+			tx.AddTxOut(wire.NewTxOut(100000, payeeScript))
+			tx.AddTxOut(wire.NewTxOut(200000, payerScript))
+			signTx(t, tx, payerScript, privateKey)
+
+			// the estimated size covers the actual size
+			sizeEstimated, err := EstimateOutTxSize(uint64(len(utxosTxids)), payee)
+			require.NoError(t, err)
+			// #nosec G701 always positive
+			txSize := uint64(tx.SerializeSize())
+			require.True(t, sizeEstimated >= txSize)
+			require.True(t, sizeEstimated-txSize <= 2) // 2 witness may vary
+
+			// the estimated size only differs from the P2WPKH one by the payee output size
+			require.Equal(t, EstimateSegWitTxSize(uint64(len(utxosTxids)), 3)+tc.outputSize-bytesPerOutputP2WPKH, sizeEstimated)
+		})
+	}
+}
+
+func TestCheckTSSVout(t *testing.T) {
+	// the test signer's TSS address is a P2PKH testnet address
+	ob := createTestClient(t)
+	ob.chain = common.BtcTestNetChain()
+	tssAddress, err := common.DecodeBtcAddress(ob.Tss.BTCAddress(), ob.chain.ChainId)
+	require.NoError(t, err)
+	tssScript, err := PayToAddrScript(tssAddress)
+	require.NoError(t, err)
+	nonce := uint64(1)
+
+	testnetPayees := map[string]string{
+		"P2TR":   "tb1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q98lawz",
+		"P2WSH":  "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7",
+		"P2WPKH": "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		"P2SH":   "2N3vVYSK5XRgVSGWy21PnsRmBUywSQNdCsf",
+		"P2PKH":  "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r",
+	}
+	for _, tc := range regtestPayeeVectors {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.OutboundTxParams{
+				Receiver: testnetPayees[tc.name],
+				Amount:   sdkmath.NewUint(100000),
+			}
+			vouts := []btcjson.Vout{
+				{
+					N:            0,
+					Value:        float64(common.NonceMarkAmount(nonce)) / 1e8,
+					ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(tssScript)},
+				},
+				{
+					N:            1,
+					Value:        0.001,
+					ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: tc.script},
+				},
+				{
+					N:            2,
+					Value:        0.01,
+					ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(tssScript)},
+				},
+			}
+			require.NoError(t, ob.checkTSSVout(vouts, []types.OutboundTxParams{params}, nonce))
+
+			// wrong amount to the receiver
+			params.Amount = sdkmath.NewUint(100001)
+			require.Error(t, ob.checkTSSVout(vouts, []types.OutboundTxParams{params}, nonce))
+
+			// wrong receiver
+			params.Amount = sdkmath.NewUint(100000)
+			params.Receiver = testnetPayees["P2WPKH"]
+			if tc.name == "P2WPKH" {
+				params.Receiver = testnetPayees["P2PKH"]
+			}
+			require.Error(t, ob.checkTSSVout(vouts, []types.OutboundTxParams{params}, nonce))
+		})
+	}
+}
+
+func TestCheckTSSVoutBatch(t *testing.T) {
+	ob := createTestClient(t)
+	ob.chain = common.BtcTestNetChain()
+	tssAddress, err := common.DecodeBtcAddress(ob.Tss.BTCAddress(), ob.chain.ChainId)
+	require.NoError(t, err)
+	tssScript, err := PayToAddrScript(tssAddress)
+	require.NoError(t, err)
+	nonce := uint64(10)
+
+	// the batch pays nonce 10, 11 and 12
+	params := []types.OutboundTxParams{
+		{Receiver: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", Amount: sdkmath.NewUint(100000)},
+		{Receiver: "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", Amount: sdkmath.NewUint(200000)},
+		{Receiver: "tb1prp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q98lawz", Amount: sdkmath.NewUint(300000)},
+	}
+	vouts := []btcjson.Vout{
+		{N: 0, Value: float64(common.NonceMarkAmount(12)) / 1e8, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(tssScript)}},
+		{N: 1, Value: 0.001, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: "0014751e76e8199196d454941c45d1b3a323f1433bd6"}},
+		{N: 2, Value: 0.002, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac"}},
+		{N: 3, Value: 0.003, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: "51201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"}},
+		{N: 4, Value: 0.01, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(tssScript)}},
+	}
+	require.NoError(t, ob.checkTSSVout(vouts, params, nonce))
+
+	first, last, err := getVoutsNonceRange(vouts)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), first)
+	require.Equal(t, uint64(12), last)
+
+	// the nonce-mark must encode the last nonce
+	require.Error(t, ob.checkTSSVout(vouts, params, nonce+1))
+
+	// a batched outTx must have a change output
+	require.Error(t, ob.checkTSSVout(vouts[:4], params, nonce))
+
+	// the payments must be in nonce order
+	params[0], params[1] = params[1], params[0]
+	require.Error(t, ob.checkTSSVout(vouts, params, nonce))
+}
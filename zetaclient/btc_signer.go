@@ -6,11 +6,12 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -22,8 +23,12 @@ import (
 )
 
 const (
-	maxNoOfInputsPerTx = 20
-	consolidationRank  = 10 // the rank below (or equal to) which we consolidate UTXOs
+	maxNoOfInputsPerTx   = 20
+	maxNoOfPaymentsPerTx = 10 // the maximum number of cctxs batched into one outTx
+	consolidationRank    = 10 // the rank below (or equal to) which we consolidate UTXOs
+
+	// rbfTxInSequenceNum is the input sequence number that signals opt-in replace-by-fee (BIP 125)
+	rbfTxInSequenceNum = wire.MaxTxInSequenceNum - 2
 )
 
 var (
@@ -46,17 +51,10 @@ type BTCSigner struct {
 var _ ChainSigner = &BTCSigner{}
 
 func NewBTCSigner(cfg config.BTCConfig, tssSigner TSSSigner, logger zerolog.Logger, ts *TelemetryServer) (*BTCSigner, error) {
-	connCfg := &rpcclient.ConnConfig{
-		Host:         cfg.RPCHost,
-		User:         cfg.RPCUsername,
-		Pass:         cfg.RPCPassword,
-		HTTPPostMode: true,
-		DisableTLS:   true,
-		Params:       cfg.RPCParams,
-	}
-	client, err := rpcclient.New(connCfg, nil)
+	// the signer fails over to other nodes on error, their health is watched and reported by the observer
+	client, err := NewBTCFailoverClient(0, cfg, 0, logger.With().Str("chain", "BTC").Logger(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating bitcoin rpc client: %s", err)
+		return nil, err
 	}
 
 	return &BTCSigner{
@@ -69,19 +67,40 @@ func NewBTCSigner(cfg config.BTCConfig, tssSigner TSSSigner, logger zerolog.Logg
 	}, nil
 }
 
-// SignWithdrawTx receives utxos sorted by value, amount in BTC, feeRate in BTC per Kb
+// BTCPayment is the payment of an outTx to the receiver of the cctx of given nonce
+type BTCPayment struct {
+	To     btcutil.Address
+	Amount float64 // in BTC
+	Nonce  uint64
+}
+
+// SignWithdrawTx receives utxos sorted by value, payments of consecutive nonces, feeRate in BTC per Kb
+// The payments are batched into one outTx: [nonce-mark of the last nonce, payment 1, ..., payment N, change to TSS]
 func (signer *BTCSigner) SignWithdrawTx(
-	to *btcutil.AddressWitnessPubKeyHash,
-	amount float64,
+	payments []BTCPayment,
 	gasPrice *big.Int,
 	sizeLimit uint64,
 	btcClient *BitcoinChainClient,
 	height uint64,
-	nonce uint64,
 	chain *common.Chain,
 ) (*wire.MsgTx, error) {
-	estimateFee := float64(gasPrice.Uint64()*outTxBytesMax) / 1e8
-	nonceMark := common.NonceMarkAmount(nonce)
+	if len(payments) == 0 {
+		return nil, fmt.Errorf("SignWithdrawTx: no payment to sign")
+	}
+	nonce := payments[0].Nonce
+	lastNonce := payments[len(payments)-1].Nonce
+	amount := 0.0
+	payees := make([]btcutil.Address, len(payments))
+	for i, payment := range payments {
+		// #nosec G701 always in range
+		if payment.Nonce != nonce+uint64(i) {
+			return nil, fmt.Errorf("SignWithdrawTx: nonce %d of payment %d is not consecutive to nonce %d", payment.Nonce, i, nonce)
+		}
+		amount += payment.Amount
+		payees[i] = payment.To
+	}
+	estimateFee := float64(gasPrice.Uint64()*getOutTxBytesMax(len(payments))) / 1e8
+	nonceMark := common.NonceMarkAmount(lastNonce)
 
 	// refresh unspent UTXOs and continue with keysign regardless of error
 	err := btcClient.FetchUTXOS()
@@ -104,34 +123,21 @@ func (signer *BTCSigner) SignWithdrawTx(
 		}
 		outpoint := wire.NewOutPoint(hash, prevOut.Vout)
 		txIn := wire.NewTxIn(outpoint, nil, nil)
+		txIn.Sequence = rbfTxInSequenceNum
 		tx.AddTxIn(txIn)
 	}
 
-	amountSatoshis, err := GetSatoshis(amount)
+	// #nosec G701 always positive
+	txSize, err := signer.getOutTxSize(uint64(len(prevOuts)), payees, sizeLimit, nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	// size checking
-	// #nosec G701 always positive
-	txSize := uint64(tx.SerializeSize())
-	if txSize > sizeLimit { // ZRC20 'withdraw' charged less fee from end user
-		signer.logger.Info().Msgf("sizeLimit %d is less than txSize %d for nonce %d", sizeLimit, txSize, nonce)
-	}
-	if txSize < outTxBytesMin { // outbound shouldn't be blocked a low sizeLimit
-		signer.logger.Warn().Msgf("sizeLimit %d is less than outTxBytesMin %d; use outTxBytesMin", sizeLimit, outTxBytesMin)
-		txSize = outTxBytesMin
-	}
-	if txSize > outTxBytesMax { // in case of accident
-		signer.logger.Warn().Msgf("sizeLimit %d is greater than outTxBytesMax %d; use outTxBytesMax", sizeLimit, outTxBytesMax)
-		txSize = outTxBytesMax
-	}
-
 	// fee calculation
 	// #nosec G701 always in range (checked above)
 	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice)
-	signer.logger.Info().Msgf("bitcoin outTx nonce %d gasPrice %s size %d fees %s consolidated %d utxos of value %v",
-		nonce, gasPrice.String(), txSize, fees.String(), consolidatedUtxo, consolidatedValue)
+	signer.logger.Info().Msgf("bitcoin outTx nonce %d-%d gasPrice %s size %d fees %s consolidated %d utxos of value %v",
+		nonce, lastNonce, gasPrice.String(), txSize, fees.String(), consolidatedUtxo, consolidatedValue)
 
 	// calculate remaining btc to TSS self
 	tssAddrWPKH := signer.tssSigner.BTCAddressWitnessPubkeyHash()
@@ -152,49 +158,385 @@ func (signer *BTCSigner) SignWithdrawTx(
 		signer.logger.Info().Msgf("SignWithdrawTx: adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
 		remainingSats--
 	}
+	// the change tells a batched outTx from a single payment outTx, see GetOutTxNonceRange
+	if len(payments) > 1 && remainingSats == 0 {
+		return nil, fmt.Errorf("SignWithdrawTx: no change output for batched outTx of nonce %d-%d", nonce, lastNonce)
+	}
 
 	// 1st output: the nonce-mark btc to TSS self
 	txOut1 := wire.NewTxOut(nonceMark, payToSelf)
 	tx.AddTxOut(txOut1)
 
-	// 2nd output: the payment to the recipient
-	pkScript, err := payToWitnessPubKeyHashScript(to.WitnessProgram())
+	// 2nd to (N+1)th outputs: the payments to the recipients
+	for _, payment := range payments {
+		pkScript, err := PayToAddrScript(payment.To)
+		if err != nil {
+			return nil, err
+		}
+		amountSatoshis, err := GetSatoshis(payment.Amount)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(wire.NewTxOut(amountSatoshis, pkScript))
+	}
+
+	// last output: the remaining btc to TSS self
+	if remainingSats > 0 {
+		txOutChange := wire.NewTxOut(remainingSats, payToSelf)
+		tx.AddTxOut(txOutChange)
+	}
+
+	// sign the tx
+	prevTxOuts := make([]*wire.TxOut, len(prevOuts))
+	for ix, prevOut := range prevOuts {
+		amt, err := GetSatoshis(prevOut.Amount)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := hex.DecodeString(prevOut.ScriptPubKey)
+		if err != nil {
+			return nil, err
+		}
+		prevTxOuts[ix] = wire.NewTxOut(amt, pkScript)
+	}
+	err = signer.signTx(tx, prevTxOuts, height, nonce, chain)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// SignSweepTx sweeps the biggest TSS utxos to the new TSS of a funds migration, in one outTx:
+// [nonce-mark of the nonce, payment to the new TSS, change to TSS]. The voted amount of the migration
+// is paid exactly, the fee is capped to what is left once the payment and the nonce-mark are paid.
+func (signer *BTCSigner) SignSweepTx(
+	payment BTCPayment,
+	gasPrice *big.Int,
+	btcClient *BitcoinChainClient,
+	height uint64,
+	chain *common.Chain,
+) (*wire.MsgTx, error) {
+	nonce := payment.Nonce
+	nonceMark := common.NonceMarkAmount(nonce)
+
+	// refresh unspent UTXOs and continue with keysign regardless of error
+	err := btcClient.FetchUTXOS()
+	if err != nil {
+		signer.logger.Error().Err(err).Msgf("SignSweepTx: FetchUTXOS error: nonce %d chain %d", nonce, chain.ChainId)
+	}
+	prevOuts, total, err := btcClient.SelectSweepUTXOs(nonce, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// build tx with selected unspents
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, prevOut := range prevOuts {
+		hash, err := chainhash.NewHashFromStr(prevOut.TxID)
+		if err != nil {
+			return nil, err
+		}
+		outpoint := wire.NewOutPoint(hash, prevOut.Vout)
+		txIn := wire.NewTxIn(outpoint, nil, nil)
+		txIn.Sequence = rbfTxInSequenceNum
+		tx.AddTxIn(txIn)
+	}
+
+	// #nosec G701 always positive
+	txSize, err := EstimateBatchOutTxSize(uint64(len(prevOuts)), []btcutil.Address{payment.To})
+	if err != nil {
+		return nil, err
+	}
+	// #nosec G701 always in range
+	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice).Int64()
+
+	totalSats, err := GetSatoshis(total)
+	if err != nil {
+		return nil, err
+	}
+	amountSats, err := GetSatoshis(payment.Amount)
 	if err != nil {
 		return nil, err
 	}
-	txOut2 := wire.NewTxOut(amountSatoshis, pkScript)
-	tx.AddTxOut(txOut2)
+	remainingSats := totalSats - amountSats - nonceMark - fees
+	if remainingSats < 0 {
+		fees += remainingSats
+		remainingSats = 0
+		signer.logger.Warn().Msgf("SignSweepTx: fees capped to %d for nonce %d", fees, nonce)
+	} else if remainingSats == nonceMark {
+		signer.logger.Info().Msgf("SignSweepTx: adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
+		remainingSats--
+	}
+	// #nosec G701 always in range
+	if fees < int64(txSize) {
+		return nil, fmt.Errorf("SignSweepTx: %d utxos of value %v cannot pay %d sats and the fees of nonce %d", len(prevOuts), total, amountSats, nonce)
+	}
+	signer.logger.Info().Msgf("bitcoin sweep outTx nonce %d gasPrice %s size %d fees %d utxos %d of value %v",
+		nonce, gasPrice.String(), txSize, fees, len(prevOuts), total)
 
-	// 3rd output: the remaining btc to TSS self
+	tssAddrWPKH := signer.tssSigner.BTCAddressWitnessPubkeyHash()
+	payToSelf, err := payToWitnessPubKeyHashScript(tssAddrWPKH.WitnessProgram())
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := PayToAddrScript(payment.To)
+	if err != nil {
+		return nil, err
+	}
+	tx.AddTxOut(wire.NewTxOut(nonceMark, payToSelf))
+	tx.AddTxOut(wire.NewTxOut(amountSats, pkScript))
 	if remainingSats > 0 {
-		txOut3 := wire.NewTxOut(remainingSats, payToSelf)
-		tx.AddTxOut(txOut3)
+		tx.AddTxOut(wire.NewTxOut(remainingSats, payToSelf))
 	}
 
 	// sign the tx
-	sigHashes := txscript.NewTxSigHashes(tx)
-	witnessHashes := make([][]byte, len(tx.TxIn))
-	for ix := range tx.TxIn {
-		amt, err := GetSatoshis(prevOuts[ix].Amount)
+	prevTxOuts := make([]*wire.TxOut, len(prevOuts))
+	for ix, prevOut := range prevOuts {
+		amt, err := GetSatoshis(prevOut.Amount)
 		if err != nil {
 			return nil, err
 		}
-		pkScript, err := hex.DecodeString(prevOuts[ix].ScriptPubKey)
+		pkScript, err := hex.DecodeString(prevOut.ScriptPubKey)
 		if err != nil {
 			return nil, err
 		}
-		witnessHashes[ix], err = txscript.CalcWitnessSigHash(pkScript, sigHashes, txscript.SigHashAll, tx, ix, amt)
+		prevTxOuts[ix] = wire.NewTxOut(amt, pkScript)
+	}
+	err = signer.signTx(tx, prevTxOuts, height, nonce, chain)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// IsTssFundsMigration returns true if the cctx migrates the TSS funds to a new TSS
+func IsTssFundsMigration(cctx *types.CrossChainTx) bool {
+	return cctx.GetCurrentOutTxParam().CoinType == common.CoinType_Cmd &&
+		strings.HasPrefix(cctx.RelayedMessage, common.CmdMigrateTssFunds+":")
+}
+
+// SignRBFTx re-signs a stuck outTx with a higher gasPrice (in sat/byte). The replacement spends the same inputs
+// so that only one of them can be mined. The fee bump is funded by the gas price increase of the cctx.
+// The replacement also pays the gasPrice for the pending ancestors of the stuck outTx (CPFP), given the vsize
+// and the fees (in satoshis) of the ancestors.
+func (signer *BTCSigner) SignRBFTx(
+	stuckTx *wire.MsgTx,
+	ancestorSize int64,
+	ancestorFees int64,
+	gasPrice *big.Int,
+	relayFeeRate *big.Int,
+	sizeLimit uint64,
+	btcClient *BitcoinChainClient,
+	height uint64,
+	chain *common.Chain,
+) (*wire.MsgTx, error) {
+	nonce, lastNonce, err := GetOutTxNonceRange(stuckTx.TxOut)
+	if err != nil {
+		return nil, err
+	}
+	payees, err := getOutTxPayees(stuckTx, chain.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	prevOuts, err := btcClient.GetTxInPrevOuts(stuckTx)
+	if err != nil {
+		return nil, err
+	}
+	oldFees := int64(0)
+	for _, prevOut := range prevOuts {
+		oldFees += prevOut.Value
+	}
+	for _, txOut := range stuckTx.TxOut {
+		oldFees -= txOut.Value
+	}
+
+	// the replacement has the same inputs and outputs as the stuck tx, so is the size
+	// #nosec G701 always positive
+	txSize, err := signer.getOutTxSize(uint64(len(stuckTx.TxIn)), payees, sizeLimit, nonce)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec G701 always in range (checked in getOutTxSize)
+	fees := GetRBFTxFees(int64(txSize), ancestorSize, ancestorFees, gasPrice)
+	feeBump := fees.Int64() - oldFees
+
+	// BIP 125 requires the replacement to pay for its own bandwidth at the minimum relay fee rate
+	// #nosec G701 always in range (checked in getOutTxSize)
+	minFeeBump := new(big.Int).Mul(big.NewInt(int64(txSize)), relayFeeRate)
+	if feeBump < minFeeBump.Int64() {
+		return nil, fmt.Errorf("SignRBFTx: fee bump %d is less than minimum %d; wait for gas price increase", feeBump, minFeeBump.Int64())
+	}
+	signer.logger.Info().Msgf("bitcoin RBF outTx nonce %d-%d gasPrice %s size %d ancestor size %d fees %s fee bump %d",
+		nonce, lastNonce, gasPrice.String(), txSize, ancestorSize, fees.String(), feeBump)
+
+	tx, err := NewRBFTx(stuckTx, feeBump, lastNonce)
+	if err != nil {
+		return nil, err
+	}
+	err = signer.signTx(tx, prevOuts, height, nonce, chain)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// GetRBFTxFees returns the fees of the replacement of a stuck outTx of size 'txSize' at 'gasPrice'.
+// The fees of the pending ancestors are topped up so that the package of the ancestors and the replacement
+// pays 'gasPrice' as a whole.
+func GetRBFTxFees(txSize int64, ancestorSize int64, ancestorFees int64, gasPrice *big.Int) *big.Int {
+	fees := new(big.Int).Mul(big.NewInt(txSize+ancestorSize), gasPrice)
+	fees.Sub(fees, big.NewInt(ancestorFees))
+	// the ancestors might already pay more than 'gasPrice'
+	minFees := new(big.Int).Mul(big.NewInt(txSize), gasPrice)
+	if fees.Cmp(minFees) < 0 {
+		return minFees
+	}
+	return fees
+}
+
+// NewRBFTx builds an unsigned replacement of the stuck outTx that pays 'feeBump' more satoshis in fees.
+// It spends the same inputs (nonce-mark included) and the fee bump is deducted from the change to TSS.
+// 'nonce' is the last nonce paid by the stuck outTx, the one encoded by its nonce-mark.
+func NewRBFTx(stuckTx *wire.MsgTx, feeBump int64, nonce uint64) (*wire.MsgTx, error) {
+	// outputs: [nonce-mark, payment to recipient 1, ..., payment to recipient N, change to TSS]
+	if len(stuckTx.TxOut) < 3 {
+		return nil, fmt.Errorf("NewRBFTx: no change output to pay the fee bump")
+	}
+	if feeBump <= 0 {
+		return nil, fmt.Errorf("NewRBFTx: invalid fee bump %d", feeBump)
+	}
+	// keep the change above nonce-mark so it's neither dust nor mistaken as a nonce-mark
+	nonceMark := common.NonceMarkAmount(nonce)
+	change := stuckTx.TxOut[len(stuckTx.TxOut)-1]
+	remainingSats := change.Value - feeBump
+	if remainingSats <= nonceMark {
+		return nil, fmt.Errorf("NewRBFTx: change value %d is not enough to pay fee bump %d", change.Value, feeBump)
+	}
+
+	tx := wire.NewMsgTx(stuckTx.Version)
+	for _, stuckTxIn := range stuckTx.TxIn {
+		outpoint := stuckTxIn.PreviousOutPoint
+		txIn := wire.NewTxIn(&outpoint, nil, nil)
+		txIn.Sequence = rbfTxInSequenceNum
+		tx.AddTxIn(txIn)
+	}
+	for _, txOut := range stuckTx.TxOut[:len(stuckTx.TxOut)-1] {
+		tx.AddTxOut(wire.NewTxOut(txOut.Value, txOut.PkScript))
+	}
+	tx.AddTxOut(wire.NewTxOut(remainingSats, change.PkScript))
+	tx.LockTime = stuckTx.LockTime
+	return tx, nil
+}
+
+// GetOutTxNonceRange returns the first and the last nonces paid by an outTx.
+// outputs: [nonce-mark of the last nonce, payment of the first nonce, ..., payment of the last nonce, change to TSS]
+// The change is optional only for an outTx paying a single nonce, a batched outTx always has one.
+func GetOutTxNonceRange(txOuts []*wire.TxOut) (uint64, uint64, error) {
+	if len(txOuts) < 2 {
+		return 0, 0, fmt.Errorf("GetOutTxNonceRange: invalid number of outputs: %d", len(txOuts))
+	}
+	return getNonceRange(txOuts[0].Value, len(txOuts))
+}
+
+// getNonceRange returns the nonce range of an outTx given its nonce-mark amount and number of outputs
+func getNonceRange(nonceMark int64, numOutputs int) (uint64, uint64, error) {
+	if nonceMark < common.NonceMarkAmount(0) {
+		return 0, 0, fmt.Errorf("getNonceRange: invalid nonce-mark amount %d", nonceMark)
+	}
+	// #nosec G701 always positive
+	lastNonce := uint64(nonceMark - common.NonceMarkAmount(0))
+
+	numPayments := 1
+	if numOutputs > 3 {
+		numPayments = numOutputs - 2
+	}
+	// #nosec G701 always positive
+	if uint64(numPayments) > lastNonce+1 {
+		return 0, 0, fmt.Errorf("getNonceRange: %d payments exceed last nonce %d", numPayments, lastNonce)
+	}
+	// #nosec G701 always positive
+	return lastNonce + 1 - uint64(numPayments), lastNonce, nil
+}
+
+// getOutTxPayees decodes the recipients of the payments of an outTx
+func getOutTxPayees(tx *wire.MsgTx, chainID int64) ([]btcutil.Address, error) {
+	nonce, lastNonce, err := GetOutTxNonceRange(tx.TxOut)
+	if err != nil {
+		return nil, err
+	}
+	bitcoinNetParams, err := common.GetBTCChainParams(chainID)
+	if err != nil {
+		return nil, err
+	}
+	payees := make([]btcutil.Address, 0, lastNonce-nonce+1)
+	for _, txOut := range tx.TxOut[1 : lastNonce-nonce+2] {
+		payee, err := DecodeScriptPubKey(txOut.PkScript, bitcoinNetParams)
 		if err != nil {
 			return nil, err
 		}
+		payees = append(payees, payee)
+	}
+	return payees, nil
+}
+
+// IsRBFSignaled returns true if the tx signals opt-in replace-by-fee (BIP 125)
+func IsRBFSignaled(tx *wire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.Sequence < wire.MaxTxInSequenceNum-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// getOutTxSize estimates the outTx size, the size varies with the number and the type of the payee outputs
+func (signer *BTCSigner) getOutTxSize(numInputs uint64, payees []btcutil.Address, sizeLimit uint64, nonce uint64) (uint64, error) {
+	txSize, err := EstimateBatchOutTxSize(numInputs, payees)
+	if err != nil {
+		return 0, err
+	}
+	bytesMax := getOutTxBytesMax(len(payees))
+	if txSize > sizeLimit { // ZRC20 'withdraw' charged less fee from end user
+		signer.logger.Info().Msgf("sizeLimit %d is less than txSize %d for nonce %d", sizeLimit, txSize, nonce)
+	}
+	if txSize < outTxBytesMin { // outbound shouldn't be blocked a low sizeLimit
+		signer.logger.Warn().Msgf("sizeLimit %d is less than outTxBytesMin %d; use outTxBytesMin", sizeLimit, outTxBytesMin)
+		txSize = outTxBytesMin
+	}
+	if txSize > bytesMax { // in case of accident
+		signer.logger.Warn().Msgf("sizeLimit %d is greater than outTxBytesMax %d; use outTxBytesMax", sizeLimit, bytesMax)
+		txSize = bytesMax
+	}
+	return txSize, nil
+}
+
+// getOutTxBytesMax returns the maximum outTx size for the number of payments, each payment adds an output
+func getOutTxBytesMax(numPayments int) uint64 {
+	if numPayments <= 1 {
+		return outTxBytesMax
+	}
+	// #nosec G701 always positive
+	return outTxBytesMax + uint64(numPayments-1)*bytesPerOutput
+}
+
+// signTx signs all the TSS SegWit inputs of the tx with TSS key
+func (signer *BTCSigner) signTx(tx *wire.MsgTx, prevOuts []*wire.TxOut, height uint64, nonce uint64, chain *common.Chain) error {
+	sigHashes := txscript.NewTxSigHashes(tx)
+	witnessHashes := make([][]byte, len(tx.TxIn))
+	for ix := range tx.TxIn {
+		var err error
+		witnessHashes[ix], err = txscript.CalcWitnessSigHash(prevOuts[ix].PkScript, sigHashes, txscript.SigHashAll, tx, ix, prevOuts[ix].Value)
+		if err != nil {
+			return err
+		}
 	}
 	tss, ok := signer.tssSigner.(*TSS)
 	if !ok {
-		return nil, fmt.Errorf("tssSigner is not a TSS")
+		return fmt.Errorf("tssSigner is not a TSS")
 	}
 	sig65Bs, err := tss.SignBatch(witnessHashes, height, nonce, chain)
 	if err != nil {
-		return nil, fmt.Errorf("SignBatch error: %v", err)
+		return fmt.Errorf("SignBatch error: %v", err)
 	}
 
 	for ix := range tx.TxIn {
@@ -211,7 +553,7 @@ func (signer *BTCSigner) SignWithdrawTx(
 		txWitness := wire.TxWitness{append(sig.Serialize(), byte(hashType)), pkCompressed}
 		tx.TxIn[ix].Witness = txWitness
 	}
-	return tx, nil
+	return nil
 }
 
 func (signer *BTCSigner) Broadcast(signedTx *wire.MsgTx) error {
@@ -240,26 +582,37 @@ func (signer *BTCSigner) TryProcessOutTx(
 	chainclient ChainClient,
 	zetaBridge ZetaCoreBridger,
 	height uint64,
+) {
+	signer.TryProcessOutTxBatch([]*types.CrossChainTx{cctx}, outTxMan, []string{outTxID}, chainclient, zetaBridge, height)
+}
+
+// TryProcessOutTxBatch processes the cctxs of consecutive nonces in one outTx with a single keysign.
+// The 1st cctx leads the batch: the batch is signed only if the outTx of the 1st cctx is not processed yet.
+func (signer *BTCSigner) TryProcessOutTxBatch(
+	cctxs []*types.CrossChainTx,
+	outTxMan *OutTxProcessorManager,
+	outTxIDs []string,
+	chainclient ChainClient,
+	zetaBridge ZetaCoreBridger,
+	height uint64,
 ) {
 	defer func() {
-		outTxMan.EndTryProcess(outTxID)
+		for _, outTxID := range outTxIDs {
+			outTxMan.EndTryProcess(outTxID)
+		}
 		if err := recover(); err != nil {
-			signer.logger.Error().Msgf("BTC TryProcessOutTx: %s, caught panic error: %v", cctx.Index, err)
+			signer.logger.Error().Msgf("BTC TryProcessOutTx: %s, caught panic error: %v", cctxs[0].Index, err)
 		}
 	}()
 
+	cctx := cctxs[0]
 	logger := signer.logger.With().
-		Str("OutTxID", outTxID).
+		Str("OutTxID", outTxIDs[0]).
 		Str("SendHash", cctx.Index).
+		Int("BatchSize", len(cctxs)).
 		Logger()
 
 	params := cctx.GetCurrentOutTxParam()
-	if params.CoinType == common.CoinType_Zeta || params.CoinType == common.CoinType_ERC20 {
-		logger.Error().Msgf("BTC TryProcessOutTx: can only send BTC to a BTC network")
-		return
-	}
-
-	logger.Info().Msgf("BTC TryProcessOutTx: %s, value %d to %s", cctx.Index, params.Amount.BigInt(), params.Receiver)
 	btcClient, ok := chainclient.(*BitcoinChainClient)
 	if !ok {
 		logger.Error().Msgf("chain client is not a bitcoin client")
@@ -270,7 +623,7 @@ func (signer *BTCSigner) TryProcessOutTx(
 		logger.Error().Err(err).Msgf("cannot get crosschain flags")
 		return
 	}
-	if !flags.IsOutboundEnabled {
+	if !flags.IsChainOutboundEnabled(params.ReceiverChainId) {
 		logger.Info().Msgf("outbound is disabled")
 		return
 	}
@@ -283,42 +636,75 @@ func (signer *BTCSigner) TryProcessOutTx(
 		logger.Error().Err(err).Msgf("cannot check if send %s is processed", cctx.Index)
 		return
 	}
-	if included || confirmed {
-		logger.Info().Msgf("CCTX %s already processed; exit signer", outTxID)
+	if confirmed {
+		logger.Info().Msgf("CCTX %s already processed; exit signer", outTxIDs[0])
 		return
 	}
-
-	sizelimit := params.OutboundTxGasLimit
-	gasprice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
-	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
-		logger.Error().Msgf("cannot convert gas price  %s ", params.OutboundTxGasPrice)
-		return
+	// replace the outTx by fee if it's been stuck in mempool for too long
+	var stuckTx *wire.MsgTx
+	var stuckEntry *btcjson.GetMempoolEntryResult
+	if included {
+		stuckTx, stuckEntry, err = btcClient.GetStuckOutTx(outboundTxTssNonce)
+		if err != nil {
+			logger.Info().Err(err).Msgf("cannot check if outTx of nonce %d is stuck", outboundTxTssNonce)
+		}
+		if stuckTx == nil {
+			logger.Info().Msgf("CCTX %s already processed; exit signer", outTxIDs[0])
+			return
+		}
+		if !IsRBFSignaled(stuckTx) {
+			logger.Warn().Msgf("stuck outTx %s of nonce %d is not replaceable", stuckTx.TxHash(), outboundTxTssNonce)
+			return
+		}
+		// a batched outTx is replaced only once, by the cctx of its first nonce
+		firstNonce, _, err := GetOutTxNonceRange(stuckTx.TxOut)
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot get nonces of stuck outTx %s", stuckTx.TxHash())
+			return
+		}
+		if firstNonce != outboundTxTssNonce {
+			logger.Info().Msgf("stuck outTx %s of nonce %d is replaced by nonce %d", stuckTx.TxHash(), outboundTxTssNonce, firstNonce)
+			return
+		}
 	}
 
-	// Check receiver P2WPKH address
-	bitcoinNetParams, err := common.BitcoinNetParamsFromChainID(params.ReceiverChainId)
-	if err != nil {
-		logger.Error().Err(err).Msgf("cannot get bitcoin net params%v", err)
-		return
-	}
+	// the highest gas price pays the fee of the batch, the size limits add up
+	sizelimit := uint64(0)
+	gasprice := big.NewInt(0)
+	payments := make([]BTCPayment, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		params := cctx.GetCurrentOutTxParam()
+		if params.CoinType == common.CoinType_Zeta || params.CoinType == common.CoinType_ERC20 {
+			logger.Error().Msgf("BTC TryProcessOutTx: can only send BTC to a BTC network")
+			return
+		}
+		logger.Info().Msgf("BTC TryProcessOutTx: %s, value %d to %s", cctx.Index, params.Amount.BigInt(), params.Receiver)
 
-	addr, err := btcutil.DecodeAddress(params.Receiver, bitcoinNetParams)
-	if err != nil {
-		logger.Error().Err(err).Msgf("cannot decode address %s ", params.Receiver)
-		return
-	}
-	if !addr.IsForNet(bitcoinNetParams) {
-		logger.Error().Msgf(
-			"address %s is not for network %s",
-			params.Receiver,
-			bitcoinNetParams.Name,
-		)
-		return
-	}
-	to, ok := addr.(*btcutil.AddressWitnessPubKeyHash)
-	if err != nil || !ok {
-		logger.Error().Err(err).Msgf("cannot convert address %s to P2WPKH address", params.Receiver)
-		return
+		sizelimit += params.OutboundTxGasLimit
+		cctxGasPrice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
+		if !ok || cctxGasPrice.Cmp(big.NewInt(0)) < 0 {
+			logger.Error().Msgf("cannot convert gas price  %s ", params.OutboundTxGasPrice)
+			return
+		}
+		if cctxGasPrice.Cmp(gasprice) > 0 {
+			gasprice = cctxGasPrice
+		}
+
+		// Check receiver address
+		to, err := common.DecodeBtcAddress(params.Receiver, params.ReceiverChainId)
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot decode address %s ", params.Receiver)
+			return
+		}
+		if !common.IsBtcAddressSupported(to) {
+			logger.Error().Msgf("unsupported address %s", params.Receiver)
+			return
+		}
+		payments = append(payments, BTCPayment{
+			To:     to,
+			Amount: float64(params.Amount.Uint64()) / 1e8,
+			Nonce:  params.OutboundTxTssNonce,
+		})
 	}
 
 	// Add 1 satoshi/byte to gasPrice to avoid minRelayTxFee issue
@@ -330,24 +716,68 @@ func (signer *BTCSigner) TryProcessOutTx(
 	satPerByte := FeeRateToSatPerByte(networkInfo.RelayFee)
 	gasprice.Add(gasprice, satPerByte)
 
-	logger.Info().Msgf("SignWithdrawTx: to %s, value %d sats", addr.EncodeAddress(), params.Amount.Uint64())
-	logger.Info().Msgf("using utxos: %v", btcClient.utxos)
-
-	tx, err := signer.SignWithdrawTx(
-		to,
-		float64(params.Amount.Uint64())/1e8,
-		gasprice,
-		sizelimit,
-		btcClient,
-		height,
-		outboundTxTssNonce,
-		&btcClient.chain,
-	)
+	// re-broadcast the journaled outTx of the nonce, if any, instead of requesting a new keysign
+	var tx *wire.MsgTx
+	journaled := false
+	if stuckTx == nil {
+		tx = signer.getJournaledTx(outTxMan.Journal(), cctx, btcClient.chain.ChainId, logger)
+		journaled = tx != nil
+	}
+	if journaled {
+		logger.Info().Msgf("re-broadcasting journaled outTx %s of nonce %d", tx.TxHash(), outboundTxTssNonce)
+	} else if stuckTx != nil {
+		logger.Info().Msgf("SignRBFTx: replace stuck outTx %s with gasPrice %s, nonce %d", stuckTx.TxHash(), gasprice, outboundTxTssNonce)
+		var ancestorSize, ancestorFees int64
+		ancestorSize, ancestorFees, err = GetMempoolAncestors(stuckEntry)
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot get pending ancestors of stuck outTx %s", stuckTx.TxHash())
+			return
+		}
+		tx, err = signer.SignRBFTx(
+			stuckTx,
+			ancestorSize,
+			ancestorFees,
+			gasprice,
+			satPerByte,
+			sizelimit,
+			btcClient,
+			height,
+			&btcClient.chain,
+		)
+	} else if len(cctxs) == 1 && IsTssFundsMigration(cctx) {
+		logger.Info().Msgf("SignSweepTx: to %s, value %v BTC, nonce %d", payments[0].To.EncodeAddress(), payments[0].Amount, payments[0].Nonce)
+		tx, err = signer.SignSweepTx(
+			payments[0],
+			gasprice,
+			btcClient,
+			height,
+			&btcClient.chain,
+		)
+	} else {
+		for _, payment := range payments {
+			logger.Info().Msgf("SignWithdrawTx: to %s, value %v BTC, nonce %d", payment.To.EncodeAddress(), payment.Amount, payment.Nonce)
+		}
+		logger.Info().Msgf("using utxos: %v", btcClient.utxos)
+
+		tx, err = signer.SignWithdrawTx(
+			payments,
+			gasprice,
+			sizelimit,
+			btcClient,
+			height,
+			&btcClient.chain,
+		)
+	}
 	if err != nil {
 		logger.Warn().Err(err).Msgf("SignOutboundTx error: nonce %d chain %d", outboundTxTssNonce, params.ReceiverChainId)
 		return
 	}
-	logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", cctx.InboundTxParams.SenderChainId, btcClient.chain.ChainName, outboundTxTssNonce)
+	if !journaled && tx != nil {
+		logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", cctx.InboundTxParams.SenderChainId, btcClient.chain.ChainName, outboundTxTssNonce)
+
+		// journal the signed outTx before broadcasting it, so it's never signed again
+		signer.journalSignedTx(outTxMan.Journal(), tx, cctxs, gasprice, btcClient.chain.ChainId, logger)
+	}
 
 	// FIXME: add prometheus metrics
 	_, err = zetaBridge.GetObserverList(btcClient.chain)
@@ -356,7 +786,13 @@ func (signer *BTCSigner) TryProcessOutTx(
 	}
 	if tx != nil {
 		outTxHash := tx.TxHash().String()
-		logger.Info().Msgf("on chain %s nonce %d, outTxHash %s signer %s", btcClient.chain.ChainName, outboundTxTssNonce, outTxHash, myid)
+		// the replacement pays the same nonces as the stuck outTx
+		nonce, lastNonce, err := GetOutTxNonceRange(tx.TxOut)
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot get nonces of outTx %s", outTxHash)
+			return
+		}
+		logger.Info().Msgf("on chain %s nonce %d-%d, outTxHash %s signer %s", btcClient.chain.ChainName, nonce, lastNonce, outTxHash, myid)
 		// TODO: pick a few broadcasters.
 		//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
 		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
@@ -364,21 +800,85 @@ func (signer *BTCSigner) TryProcessOutTx(
 			// #nosec G404 randomness is not a security issue here
 			time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) //random delay to avoid sychronized broadcast
 			err := signer.Broadcast(tx)
+			if jErr := outTxMan.Journal().RecordBroadcastAttempt(btcClient.chain.ChainId, outTxHash); jErr != nil {
+				logger.Error().Err(jErr).Msgf("cannot journal broadcast attempt of outTx %s", outTxHash)
+			}
 			if err != nil {
-				logger.Warn().Err(err).Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outTxHash, btcClient.chain.ChainName, outboundTxTssNonce, i)
+				logger.Warn().Err(err).Msgf("broadcasting tx %s to chain %s: nonce %d-%d, retry %d", outTxHash, btcClient.chain.ChainName, nonce, lastNonce, i)
 				continue
 			}
-			logger.Info().Msgf("Broadcast success: nonce %d to chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.String(), outTxHash)
-			zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(btcClient.chain.ChainId, outboundTxTssNonce, outTxHash, nil, "", -1)
-			if err != nil {
-				logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.ChainName, outTxHash)
+			logger.Info().Msgf("Broadcast success: nonce %d-%d to chain %s outTxHash %s", nonce, lastNonce, btcClient.chain.String(), outTxHash)
+
+			// every cctx paid by the outTx is tracked with the same outTx hash
+			for n := nonce; n <= lastNonce; n++ {
+				zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(btcClient.chain.ChainId, n, outTxHash, nil, "", -1)
+				if err != nil {
+					logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", n, btcClient.chain.ChainName, outTxHash)
+				}
+				logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
+
+				// Save successfully broadcasted transaction to btc chain client
+				btcClient.SaveBroadcastedTx(outTxHash, n)
 			}
-			logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
-
-			// Save successfully broadcasted transaction to btc chain client
-			btcClient.SaveBroadcastedTx(outTxHash, outboundTxTssNonce)
 
 			break // successful broadcast; no need to retry
 		}
 	}
 }
+
+// getJournaledTx returns the journaled outTx paying the nonce of the cctx, so it's re-broadcasted instead of signed again
+func (signer *BTCSigner) getJournaledTx(journal *SigningJournal, cctx *types.CrossChainTx, chainID int64, logger zerolog.Logger) *wire.MsgTx {
+	nonce := cctx.GetCurrentOutTxParam().OutboundTxTssNonce
+	entry, err := journal.GetLatestSignedTx(chainID, nonce)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot get journaled outTx of nonce %d", nonce)
+		return nil
+	}
+	if entry == nil || entry.CctxIndex != cctx.Index {
+		return nil
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	err = tx.Deserialize(bytes.NewReader(entry.SignedTx))
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot deserialize journaled outTx %s", entry.TxHash)
+		return nil
+	}
+	firstNonce, lastNonce, err := GetOutTxNonceRange(tx.TxOut)
+	if err != nil || nonce < firstNonce || nonce > lastNonce {
+		logger.Error().Msgf("journaled outTx %s doesn't pay nonce %d", entry.TxHash, nonce)
+		return nil
+	}
+	return tx
+}
+
+// journalSignedTx records the signed outTx for every nonce it pays
+func (signer *BTCSigner) journalSignedTx(
+	journal *SigningJournal,
+	tx *wire.MsgTx,
+	cctxs []*types.CrossChainTx,
+	gasPrice *big.Int,
+	chainID int64,
+	logger zerolog.Logger,
+) {
+	var buf bytes.Buffer
+	err := tx.Serialize(&buf)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot serialize signed outTx %s", tx.TxHash())
+		return
+	}
+	firstNonce, lastNonce, err := GetOutTxNonceRange(tx.TxOut)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot get nonces of outTx %s", tx.TxHash())
+		return
+	}
+	cctxIndexes := make(map[uint64]string, len(cctxs))
+	for _, cctx := range cctxs {
+		cctxIndexes[cctx.GetCurrentOutTxParam().OutboundTxTssNonce] = cctx.Index
+	}
+	for n := firstNonce; n <= lastNonce; n++ {
+		err = journal.RecordSignedTx(chainID, n, cctxIndexes[n], tx.TxHash().String(), gasPrice.String(), buf.Bytes())
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot journal signed outTx %s of nonce %d", tx.TxHash(), n)
+		}
+	}
+}
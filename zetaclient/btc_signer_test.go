@@ -1,9 +1,11 @@
 package zetaclient
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math"
+	"math/big"
 	"math/rand"
 	"sort"
 	"sync"
@@ -21,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/common"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	. "gopkg.in/check.v1"
 )
@@ -398,6 +401,7 @@ func createTestClient(t *testing.T) *BitcoinChainClient {
 	client := &BitcoinChainClient{
 		Tss:               tss,
 		Mu:                &sync.Mutex{},
+		includedTxHashes:  make(map[string]uint64),
 		includedTxResults: make(map[string]btcjson.GetTransactionResult),
 	}
 
@@ -625,3 +629,421 @@ func TestUTXOConsolidation(t *testing.T) {
 		require.Equal(t, 22.31, clsdtValue)
 	})
 }
+
+// helper function to create a stuck outTx of given nonce: [nonce-mark, payment, change]
+func TestSelectSweepUTXOs(t *testing.T) {
+	dummyTxID := "6e6f71d281146c1fc5c755b35908ee449f26786c84e2ae18f98b268de40b7ec4"
+
+	t.Run("should wait for the nonce-mark of the previous nonce", func(t *testing.T) {
+		ob := createTestClient(t)
+		result, total, err := ob.SelectSweepUTXOs(1, true)
+		require.ErrorContains(t, err, "cannot find outTx txid for nonce 0")
+		require.Nil(t, result)
+		require.Zero(t, total)
+	})
+
+	t.Run("should sweep the nonce-mark and all the utxos", func(t *testing.T) {
+		ob := createTestClient(t)
+		mineTxNSetNonceMark(ob, 0, dummyTxID, -1) // mine a transaction and set nonce-mark utxo for nonce 0
+
+		// output: [0.00002, 8.72, 5.16, ..., 0.01], 22.44002
+		result, total, err := ob.SelectSweepUTXOs(1, true)
+		require.NoError(t, err)
+		assert.InEpsilon(t, 22.44002, total, 1e-8)
+		require.Len(t, result, 11)
+		require.Equal(t, ob.utxos[0], result[0])
+		require.Equal(t, ob.utxos[10], result[1])
+	})
+
+	t.Run("should sweep the biggest utxos", func(t *testing.T) {
+		ob := createTestClient(t)
+		for i := 0; i < maxNoOfInputsPerTx; i++ {
+			ob.utxos = append(ob.utxos, btcjson.ListUnspentResult{Amount: 0.001})
+		}
+		sort.SliceStable(ob.utxos, func(i, j int) bool {
+			return ob.utxos[i].Amount < ob.utxos[j].Amount
+		})
+
+		// output: the 10 dummy UTXOs and 10 of the 0.001 UTXOs, 22.45
+		result, total, err := ob.SelectSweepUTXOs(0, true)
+		require.NoError(t, err)
+		assert.InEpsilon(t, 22.45, total, 1e-8)
+		require.Len(t, result, maxNoOfInputsPerTx)
+		require.Equal(t, 8.72, result[0].Amount)
+	})
+
+	t.Run("should sweep more than maxNoOfInputsPerTx utxos in several sweeps", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.utxos = nil
+		for i := 0; i < 2*maxNoOfInputsPerTx+5; i++ {
+			ob.utxos = append(ob.utxos, btcjson.ListUnspentResult{TxID: fmt.Sprintf("%064x", i), Amount: 0.001})
+		}
+
+		// each migration sweeps the biggest utxos left by the previous sweep
+		sweeps, swept := 0, 0.0
+		for len(ob.utxos) > 0 {
+			result, total, err := ob.SelectSweepUTXOs(0, true)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(result), maxNoOfInputsPerTx)
+			ob.utxos = ob.utxos[:len(ob.utxos)-len(result)]
+			sweeps++
+			swept += total
+		}
+		require.Equal(t, 3, sweeps)
+		assert.InEpsilon(t, 0.045, swept, 1e-8)
+	})
+}
+
+func createStuckOutTx(t *testing.T, nonce uint64, change int64) *wire.MsgTx {
+	_, payerScript := generateKeyPair(t, &chaincfg.TestNet3Params)
+	_, payeeScript := generateKeyPair(t, &chaincfg.TestNet3Params)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	txids := []string{
+		"e1a3d1b8e5c8e8e6c7ff4bd3b4e8f0e55a0a7b03db62ab6cc1e9f1e4f80a9c7a",
+		"3f3d6e2ab8a7ee4a7e5f3a2c3a1e5d6a9ef0c4bd5f1a2c3e4d5f6a7b8c9d0e1f",
+	}
+	for i, txid := range txids {
+		hash, err := chainhash.NewHashFromStr(txid)
+		require.Nil(t, err)
+		txIn := wire.NewTxIn(wire.NewOutPoint(hash, uint32(i)), nil, wire.TxWitness{[]byte{0x01}, []byte{0x02}})
+		txIn.Sequence = rbfTxInSequenceNum
+		tx.AddTxIn(txIn)
+	}
+	tx.AddTxOut(wire.NewTxOut(common.NonceMarkAmount(nonce), payerScript))
+	tx.AddTxOut(wire.NewTxOut(1000000, payeeScript))
+	if change > 0 {
+		tx.AddTxOut(wire.NewTxOut(change, payerScript))
+	}
+	return tx
+}
+
+func TestNewRBFTx(t *testing.T) {
+	nonce := uint64(100)
+
+	t.Run("should replace stuck outTx with higher fee", func(t *testing.T) {
+		stuckTx := createStuckOutTx(t, nonce, 100000)
+		tx, err := NewRBFTx(stuckTx, 5000, nonce)
+		require.Nil(t, err)
+
+		// same inputs (nonce-mark included) and signals replaceability
+		require.Len(t, tx.TxIn, len(stuckTx.TxIn))
+		for i, txIn := range tx.TxIn {
+			require.Equal(t, stuckTx.TxIn[i].PreviousOutPoint, txIn.PreviousOutPoint)
+			require.Equal(t, uint32(rbfTxInSequenceNum), txIn.Sequence)
+			require.Nil(t, txIn.Witness)
+		}
+		require.True(t, IsRBFSignaled(tx))
+
+		// fee bump is deducted from the change only
+		require.Len(t, tx.TxOut, 3)
+		require.Equal(t, stuckTx.TxOut[0], tx.TxOut[0])
+		require.Equal(t, stuckTx.TxOut[1], tx.TxOut[1])
+		require.Equal(t, int64(95000), tx.TxOut[2].Value)
+		require.Equal(t, stuckTx.TxOut[2].PkScript, tx.TxOut[2].PkScript)
+		require.NotEqual(t, stuckTx.TxHash(), tx.TxHash())
+	})
+
+	t.Run("should fail if there is no change output", func(t *testing.T) {
+		stuckTx := createStuckOutTx(t, nonce, 0)
+		_, err := NewRBFTx(stuckTx, 5000, nonce)
+		require.ErrorContains(t, err, "no change output")
+	})
+
+	t.Run("should fail if change is not enough to pay fee bump", func(t *testing.T) {
+		stuckTx := createStuckOutTx(t, nonce, 10000)
+		_, err := NewRBFTx(stuckTx, 10000-common.NonceMarkAmount(nonce), nonce)
+		require.ErrorContains(t, err, "not enough to pay fee bump")
+	})
+
+	t.Run("should fail if fee bump is not positive", func(t *testing.T) {
+		stuckTx := createStuckOutTx(t, nonce, 100000)
+		_, err := NewRBFTx(stuckTx, 0, nonce)
+		require.ErrorContains(t, err, "invalid fee bump")
+	})
+}
+
+func TestNewRBFTxBatch(t *testing.T) {
+	// a batched outTx of nonce 98-100: [nonce-mark, payment 1, payment 2, payment 3, change]
+	stuckTx := createStuckOutTx(t, 100, 100000)
+	change := stuckTx.TxOut[2]
+	stuckTx.TxOut = append(stuckTx.TxOut[:2], wire.NewTxOut(2000000, stuckTx.TxOut[1].PkScript), wire.NewTxOut(3000000, stuckTx.TxOut[1].PkScript), change)
+
+	nonce, lastNonce, err := GetOutTxNonceRange(stuckTx.TxOut)
+	require.Nil(t, err)
+	require.Equal(t, uint64(98), nonce)
+	require.Equal(t, uint64(100), lastNonce)
+
+	// the payments are kept and the fee bump is deducted from the change
+	tx, err := NewRBFTx(stuckTx, 5000, lastNonce)
+	require.Nil(t, err)
+	require.Len(t, tx.TxOut, 5)
+	for i := 0; i < 4; i++ {
+		require.Equal(t, stuckTx.TxOut[i], tx.TxOut[i])
+	}
+	require.Equal(t, int64(95000), tx.TxOut[4].Value)
+}
+
+// mockBTCMempool serves the mempool entries and the raw txs of pending outTxs at a fixed block count
+type mockBTCMempool struct {
+	BTCRPCClient
+	blockCount int64
+	entries    map[string]*btcjson.GetMempoolEntryResult
+	txs        map[string]*wire.MsgTx
+}
+
+func (m *mockBTCMempool) GetMempoolEntry(txHash string) (*btcjson.GetMempoolEntryResult, error) {
+	entry, found := m.entries[txHash]
+	if !found {
+		return nil, fmt.Errorf("transaction %s not in mempool", txHash)
+	}
+	return entry, nil
+}
+
+func (m *mockBTCMempool) GetBlockCount() (int64, error) {
+	return m.blockCount, nil
+}
+
+func (m *mockBTCMempool) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	var buf bytes.Buffer
+	if err := m.txs[txHash.String()].Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return &btcjson.TxRawResult{Hex: hex.EncodeToString(buf.Bytes())}, nil
+}
+
+func TestGetStuckOutTx(t *testing.T) {
+	// outTx of nonce 101 spends the unconfirmed nonce-mark of the outTx of nonce 100, both pending since block 100
+	parentTx := createStuckOutTx(t, 100, 100000)
+	childTx := createStuckOutTx(t, 101, 90000)
+	parentTxHash := parentTx.TxHash()
+	childTx.TxIn[0].PreviousOutPoint = *wire.NewOutPoint(&parentTxHash, 0)
+	parentHash, childHash := parentTx.TxHash().String(), childTx.TxHash().String()
+
+	ob := createTestClient(t)
+	ob.rbfBlocks = defaultRBFBlocks
+	ob.broadcastedTx = map[string]string{ob.GetTxID(100): parentHash, ob.GetTxID(101): childHash}
+	ob.logger.ObserveOutTx = zerolog.Nop()
+	ob.rpcClient = &mockBTCMempool{
+		blockCount: 110,
+		entries: map[string]*btcjson.GetMempoolEntryResult{
+			parentHash: {
+				VSize:           250,
+				Height:          100,
+				DescendantCount: 2,
+				DescendantSize:  500,
+				AncestorCount:   1,
+				AncestorSize:    250,
+				Fees:            btcjson.MempoolFees{Base: 0.000025, Ancestor: 0.000025, Descendant: 0.00005},
+			},
+			childHash: {
+				VSize:           250,
+				Height:          100,
+				DescendantCount: 1,
+				DescendantSize:  250,
+				AncestorCount:   2,
+				AncestorSize:    500,
+				Fees:            btcjson.MempoolFees{Base: 0.000025, Ancestor: 0.00005, Descendant: 0.000025},
+			},
+		},
+		txs: map[string]*wire.MsgTx{parentHash: parentTx, childHash: childTx},
+	}
+
+	t.Run("should not replace an outTx with a pending child", func(t *testing.T) {
+		tx, entry, err := ob.GetStuckOutTx(100)
+		require.NoError(t, err)
+		require.Nil(t, tx)
+		require.Nil(t, entry)
+	})
+
+	t.Run("should replace the pending child paying for its parent", func(t *testing.T) {
+		tx, entry, err := ob.GetStuckOutTx(101)
+		require.NoError(t, err)
+		require.Equal(t, childHash, tx.TxHash().String())
+
+		ancestorSize, ancestorFees, err := GetMempoolAncestors(entry)
+		require.NoError(t, err)
+		require.Equal(t, int64(250), ancestorSize)
+		require.Equal(t, int64(2500), ancestorFees)
+
+		// the parent and the replacement of the child pay 20 sat/vB as a package
+		fees := GetRBFTxFees(250, ancestorSize, ancestorFees, big.NewInt(20))
+		require.Equal(t, int64(7500), fees.Int64())
+	})
+
+	t.Run("should not replace an outTx pending for less than rbfBlocks", func(t *testing.T) {
+		ob.rpcClient.(*mockBTCMempool).blockCount = 102
+		tx, entry, err := ob.GetStuckOutTx(101)
+		require.NoError(t, err)
+		require.Nil(t, tx)
+		require.Nil(t, entry)
+	})
+}
+
+func TestGetRBFTxFees(t *testing.T) {
+	t.Run("should pay gas price for the replacement without ancestors", func(t *testing.T) {
+		require.Equal(t, int64(5000), GetRBFTxFees(250, 0, 0, big.NewInt(20)).Int64())
+	})
+
+	t.Run("should top up the fees of the ancestors", func(t *testing.T) {
+		require.Equal(t, int64(12000), GetRBFTxFees(250, 400, 1000, big.NewInt(20)).Int64())
+	})
+
+	t.Run("should pay gas price for the replacement if the ancestors pay more", func(t *testing.T) {
+		require.Equal(t, int64(5000), GetRBFTxFees(250, 250, 10000, big.NewInt(20)).Int64())
+	})
+}
+
+func TestGetOutTxNonceRange(t *testing.T) {
+	txOut := func(n int, nonce uint64) []*wire.TxOut {
+		txOuts := []*wire.TxOut{wire.NewTxOut(common.NonceMarkAmount(nonce), nil)}
+		for i := 1; i < n; i++ {
+			txOuts = append(txOuts, wire.NewTxOut(10000, nil))
+		}
+		return txOuts
+	}
+
+	tests := []struct {
+		name      string
+		txOuts    []*wire.TxOut
+		nonce     uint64
+		lastNonce uint64
+		fail      bool
+	}{
+		{name: "single payment without change", txOuts: txOut(2, 5), nonce: 5, lastNonce: 5},
+		{name: "single payment with change", txOuts: txOut(3, 5), nonce: 5, lastNonce: 5},
+		{name: "batch of 2 payments", txOuts: txOut(4, 5), nonce: 4, lastNonce: 5},
+		{name: "batch of 10 payments", txOuts: txOut(12, 9), nonce: 0, lastNonce: 9},
+		{name: "more payments than nonces", txOuts: txOut(12, 8), fail: true},
+		{name: "no payment", txOuts: txOut(1, 5), fail: true},
+		{name: "invalid nonce-mark", txOuts: []*wire.TxOut{wire.NewTxOut(1000, nil), wire.NewTxOut(1000, nil)}, fail: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			nonce, lastNonce, err := GetOutTxNonceRange(tc.txOuts)
+			if tc.fail {
+				require.Error(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tc.nonce, nonce)
+			require.Equal(t, tc.lastNonce, lastNonce)
+		})
+	}
+}
+
+func TestJournaledOutTx(t *testing.T) {
+	journal, err := NewSigningJournal(t.TempDir())
+	require.NoError(t, err)
+	signer := &BTCSigner{}
+	chainID := common.BtcTestNetChain().ChainId
+	cctx := func(index string, nonce uint64) *crosschaintypes.CrossChainTx {
+		return &crosschaintypes.CrossChainTx{
+			Index:            index,
+			OutboundTxParams: []*crosschaintypes.OutboundTxParams{{OutboundTxTssNonce: nonce}},
+		}
+	}
+
+	// a batched outTx of nonce 99-100: [nonce-mark, payment 1, payment 2, change]
+	tx := createStuckOutTx(t, 100, 100000)
+	change := tx.TxOut[2]
+	tx.TxOut = append(tx.TxOut[:2], wire.NewTxOut(2000000, tx.TxOut[1].PkScript), change)
+	cctxs := []*crosschaintypes.CrossChainTx{cctx("0x99", 99), cctx("0x100", 100)}
+
+	require.Nil(t, signer.getJournaledTx(journal, cctxs[0], chainID, zerolog.Nop()))
+	signer.journalSignedTx(journal, tx, cctxs, big.NewInt(10), chainID, zerolog.Nop())
+
+	t.Run("should return the journaled outTx for every nonce it pays", func(t *testing.T) {
+		for _, c := range cctxs {
+			journaledTx := signer.getJournaledTx(journal, c, chainID, zerolog.Nop())
+			require.NotNil(t, journaledTx)
+			require.Equal(t, tx.TxHash(), journaledTx.TxHash())
+		}
+	})
+
+	t.Run("should not return the journaled outTx of another cctx", func(t *testing.T) {
+		require.Nil(t, signer.getJournaledTx(journal, cctx("0x98", 99), chainID, zerolog.Nop()))
+		require.Nil(t, signer.getJournaledTx(journal, cctx("0x101", 101), chainID, zerolog.Nop()))
+	})
+}
+
+func TestIsRBFSignaled(t *testing.T) {
+	tx := createStuckOutTx(t, 1, 100000)
+	require.True(t, IsRBFSignaled(tx))
+
+	for _, txIn := range tx.TxIn {
+		txIn.Sequence = wire.MaxTxInSequenceNum
+	}
+	require.False(t, IsRBFSignaled(tx))
+
+	// round trip of raw tx hex
+	var buf bytes.Buffer
+	require.Nil(t, tx.Serialize(&buf))
+	decoded, err := DeserializeMsgTx(hex.EncodeToString(buf.Bytes()))
+	require.Nil(t, err)
+	require.Equal(t, tx.TxHash(), decoded.TxHash())
+	require.False(t, IsRBFSignaled(decoded))
+}
+
+func TestSetIncludedTx(t *testing.T) {
+	nonce := uint64(100)
+	pendingTxID := "6e6f71d281146c1fc5c755b35908ee449f26786c84e2ae18f98b268de40b7ec4"
+	replacementTxID := "1a0e6f5bd2f31de6b8f4de9bd4a3f9c4b1f1cde3ff1f3a8e5a4dd1d68f3d7b12"
+
+	t.Run("should include new outTx", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.setIncludedTx(pendingTxID, &btcjson.GetTransactionResult{TxID: pendingTxID}, nonce)
+		require.Equal(t, pendingTxID, ob.includedTxResults[ob.GetTxID(nonce)].TxID)
+		require.Equal(t, nonce, ob.includedTxHashes[pendingTxID])
+		require.Equal(t, nonce+1, ob.pendingNonce)
+	})
+
+	t.Run("should accept mined replacement of pending outTx", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.setIncludedTx(pendingTxID, &btcjson.GetTransactionResult{TxID: pendingTxID}, nonce)
+		ob.setIncludedTx(replacementTxID, &btcjson.GetTransactionResult{TxID: replacementTxID, Confirmations: 1}, nonce)
+
+		res := ob.includedTxResults[ob.GetTxID(nonce)]
+		require.Equal(t, replacementTxID, res.TxID)
+		require.Equal(t, int64(1), res.Confirmations)
+		require.Equal(t, nonce, ob.includedTxHashes[replacementTxID])
+		require.NotContains(t, ob.includedTxHashes, pendingTxID)
+	})
+
+	t.Run("should keep pending outTx until replacement is mined", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.setIncludedTx(pendingTxID, &btcjson.GetTransactionResult{TxID: pendingTxID}, nonce)
+		ob.setIncludedTx(replacementTxID, &btcjson.GetTransactionResult{TxID: replacementTxID}, nonce)
+
+		require.Equal(t, pendingTxID, ob.includedTxResults[ob.GetTxID(nonce)].TxID)
+		require.NotContains(t, ob.includedTxHashes, replacementTxID)
+	})
+
+	t.Run("should not replace mined outTx", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.setIncludedTx(pendingTxID, &btcjson.GetTransactionResult{TxID: pendingTxID, Confirmations: 1}, nonce)
+		ob.setIncludedTx(replacementTxID, &btcjson.GetTransactionResult{TxID: replacementTxID, Confirmations: 1}, nonce)
+
+		require.Equal(t, pendingTxID, ob.includedTxResults[ob.GetTxID(nonce)].TxID)
+		require.NotContains(t, ob.includedTxHashes, replacementTxID)
+	})
+
+	t.Run("should update confirmations of included outTx", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.setIncludedTx(pendingTxID, &btcjson.GetTransactionResult{TxID: pendingTxID}, nonce)
+		ob.setIncludedTx(pendingTxID, &btcjson.GetTransactionResult{TxID: pendingTxID, Confirmations: 3}, nonce)
+
+		require.Equal(t, int64(3), ob.includedTxResults[ob.GetTxID(nonce)].Confirmations)
+	})
+
+	t.Run("should include batched outTx for every nonce", func(t *testing.T) {
+		ob := createTestClient(t)
+		for n := nonce; n <= nonce+2; n++ {
+			ob.setIncludedTx(pendingTxID, &btcjson.GetTransactionResult{TxID: pendingTxID}, n)
+		}
+		for n := nonce; n <= nonce+2; n++ {
+			require.Equal(t, pendingTxID, ob.includedTxResults[ob.GetTxID(n)].TxID)
+		}
+		require.Equal(t, nonce+2, ob.includedTxHashes[pendingTxID])
+		require.Equal(t, nonce+3, ob.pendingNonce)
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 const filename string = "zetaclient_config.json"
 const folder string = "config"
+const remoteSignerKeyFilename string = "remote_signer_key.json"
 
 // Save saves ZetaClient config
 func Save(config *Config, path string) error {
@@ -57,9 +58,15 @@ func Load(path string) (*Config, error) {
 	if cfg.KeyringBackend == KeyringBackendUndefined {
 		cfg.KeyringBackend = KeyringBackendTest
 	}
-	if cfg.KeyringBackend != KeyringBackendFile && cfg.KeyringBackend != KeyringBackendTest {
+	if cfg.KeyringBackend != KeyringBackendFile && cfg.KeyringBackend != KeyringBackendTest && cfg.KeyringBackend != KeyringBackendRemote {
 		return nil, fmt.Errorf("invalid keyring backend %s", cfg.KeyringBackend)
 	}
+	if cfg.KeyringBackend == KeyringBackendRemote {
+		err = validateRemoteSigner(&cfg.RemoteSigner, path)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// fields sanitization
 	cfg.TssPath = GetPath(cfg.TssPath)
@@ -83,3 +90,25 @@ func GetPath(inputPath string) string {
 	}
 	return filepath.Join(path...)
 }
+
+// validateRemoteSigner validates the remote signer config and sets its defaults
+func validateRemoteSigner(cfg *RemoteSignerConfig, path string) error {
+	if cfg.Address == "" {
+		return fmt.Errorf("remote signer address is empty")
+	}
+	if cfg.SignerID == "" {
+		return fmt.Errorf("remote signer ID is empty")
+	}
+	if cfg.TssKeyringBackend == KeyringBackendUndefined {
+		cfg.TssKeyringBackend = KeyringBackendTest
+	}
+	if cfg.TssKeyringBackend != KeyringBackendFile && cfg.TssKeyringBackend != KeyringBackendTest {
+		return fmt.Errorf("invalid tss keyring backend %s", cfg.TssKeyringBackend)
+	}
+	if cfg.ClientKeyPath == "" {
+		cfg.ClientKeyPath = filepath.Join(path, folder, remoteSignerKeyFilename)
+	} else if strings.HasPrefix(cfg.ClientKeyPath, "~") {
+		cfg.ClientKeyPath = GetPath(cfg.ClientKeyPath)
+	}
+	return nil
+}
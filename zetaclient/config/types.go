@@ -20,8 +20,29 @@ const (
 	KeyringBackendUndefined KeyringBackend = ""
 	KeyringBackendTest      KeyringBackend = "test"
 	KeyringBackendFile      KeyringBackend = "file"
+
+	// KeyringBackendRemote delegates the signing of the hotkey to a remote signer, see package remotesigner
+	KeyringBackendRemote KeyringBackend = "remote"
 )
 
+// RemoteSignerConfig is the config of the remote signer holding the hotkey with the remote keyring backend
+type RemoteSignerConfig struct {
+	// Address is the address of the remote signer, tcp://host:port or unix:///path/to/socket
+	Address string
+
+	// SignerID is the hex ID of the connection key of the remote signer, other signers are rejected
+	SignerID string
+
+	// ClientKeyPath is the path of the ed25519 connection key authenticating zetaclient to the remote signer, it's generated if missing
+	ClientKeyPath string
+
+	// TssKeyringBackend is the backend of the local keyring holding the TSS p2p key, under the name of the hotkey
+	TssKeyringBackend KeyringBackend
+
+	// TimeoutSeconds is the timeout of the requests to the remote signer, 0 uses the default timeout
+	TimeoutSeconds uint64
+}
+
 type ClientConfiguration struct {
 	ChainHost       string `json:"chain_host" mapstructure:"chain_host"`
 	ChainRPC        string `json:"chain_rpc" mapstructure:"chain_rpc"`
@@ -35,6 +56,15 @@ type EVMConfig struct {
 	observertypes.CoreParams
 	Chain    common.Chain
 	Endpoint string
+
+	// Endpoints are the fallback endpoints used when 'Endpoint' is unhealthy
+	Endpoints []string
+
+	// EndpointQuorum is the number of endpoints that must agree on block number and tx receipt before voting inbound txs, 0 disables the cross-check
+	EndpointQuorum int
+
+	// ForceLegacyTx makes the signer sign legacy txs even if the chain supports EIP-1559 dynamic fee txs
+	ForceLegacyTx bool
 }
 
 type BTCConfig struct {
@@ -45,6 +75,34 @@ type BTCConfig struct {
 	RPCPassword string
 	RPCHost     string
 	RPCParams   string // "regtest", "mainnet", "testnet3"
+
+	// RPCHosts are the fallback hosts used when 'RPCHost' is unhealthy, they share the same credentials and params
+	RPCHosts []string
+
+	// RBFBlocks is the number of blocks an outTx can sit in the mempool before it's replaced with a higher fee
+	RBFBlocks int64
+}
+
+// GetEndpoints returns all the endpoints of the chain, the primary endpoint comes first
+func (c EVMConfig) GetEndpoints() []string {
+	return dedupEndpoints(c.Endpoint, c.Endpoints)
+}
+
+// GetRPCHosts returns all the RPC hosts of the chain, the primary host comes first
+func (c BTCConfig) GetRPCHosts() []string {
+	return dedupEndpoints(c.RPCHost, c.RPCHosts)
+}
+
+func dedupEndpoints(primary string, fallbacks []string) []string {
+	endpoints := make([]string, 0, len(fallbacks)+1)
+	seen := make(map[string]bool)
+	for _, endpoint := range append([]string{primary}, fallbacks...) {
+		if endpoint != "" && !seen[endpoint] {
+			seen[endpoint] = true
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
 }
 
 // Config is the config for ZetaClient
@@ -72,6 +130,15 @@ type Config struct {
 	HsmMode             bool           `json:"HsmMode"`
 	HsmHotKey           string         `json:"HsmHotKey"`
 
+	// RemoteSigner is only used with the remote keyring backend
+	RemoteSigner RemoteSignerConfig `json:"RemoteSigner"`
+
+	// VoteBatchWindow is the window in milliseconds over which the votes are collected to be broadcasted in one tx, 0 disables the batching
+	VoteBatchWindow uint64 `json:"VoteBatchWindow"`
+
+	// VoteBatchMaxMsgs is the maximum number of votes broadcasted in one tx, 0 uses the default
+	VoteBatchMaxMsgs int `json:"VoteBatchMaxMsgs"`
+
 	// chain specific fields are updatable at runtime and shared across threads
 	cfgLock         *sync.RWMutex        `json:"-"`
 	Keygen          observertypes.Keygen `json:"Keygen"`
@@ -157,6 +224,15 @@ func (c *Config) GetKeyringBackend() KeyringBackend {
 	return c.KeyringBackend
 }
 
+// GetTssKeyringBackend returns the backend of the local keyring holding the TSS p2p key,
+// the TSS p2p key is the hotkey unless the hotkey is held by a remote signer
+func (c *Config) GetTssKeyringBackend() KeyringBackend {
+	if c.KeyringBackend == KeyringBackendRemote {
+		return c.RemoteSigner.TssKeyringBackend
+	}
+	return c.KeyringBackend
+}
+
 // UpdateCoreParams updates core params for all chains
 // this must be the ONLY function that writes to core params
 func (c *Config) UpdateCoreParams(
@@ -225,6 +301,9 @@ func (c *Config) Clone() *Config {
 		TssPath:             c.TssPath,
 		TestTssKeysign:      c.TestTssKeysign,
 		KeyringBackend:      c.KeyringBackend,
+		RemoteSigner:        c.RemoteSigner,
+		VoteBatchWindow:     c.VoteBatchWindow,
+		VoteBatchMaxMsgs:    c.VoteBatchMaxMsgs,
 
 		cfgLock:         &sync.RWMutex{},
 		Keygen:          c.GetKeygen(),
@@ -7,4 +7,7 @@ import (
 var (
 	ErrBech32ifyPubKey = errors.New("Bech32ifyPubKey fail in main")
 	ErrNewPubKey       = errors.New("NewPubKey error from string")
+
+	// ErrSequenceMismatch is returned when a tx is rejected for its account sequence, the sequence is reset from the error
+	ErrSequenceMismatch = errors.New("account sequence mismatch")
 )
@@ -27,6 +27,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+	"github.com/zeta-chain/protocol-contracts/pkg/openzeppelin/contracts/token/erc20/ierc20.sol"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -132,8 +133,9 @@ func NewEVMChainClient(
 	fileLogger := zerolog.New(logFile).With().Logger()
 	ob.fileLogger = &fileLogger
 
-	ob.logger.ChainLogger.Info().Msgf("Chain %s endpoint %s", ob.chain.ChainName.String(), evmCfg.Endpoint)
-	client, err := ethclient.Dial(evmCfg.Endpoint)
+	endpoints := evmCfg.GetEndpoints()
+	ob.logger.ChainLogger.Info().Msgf("Chain %s endpoint %s, %d fallback endpoints", ob.chain.ChainName.String(), evmCfg.Endpoint, len(endpoints)-1)
+	client, err := NewEVMFailoverClient(ob.chain.ChainId, endpoints, evmCfg.EndpointQuorum, evmCfg.ConfirmationCount, chainLogger, ts)
 	if err != nil {
 		ob.logger.ChainLogger.Error().Err(err).Msg("eth Client Dial")
 		return nil, err
@@ -147,7 +149,7 @@ func NewEVMChainClient(
 	}
 
 	if ob.chain.IsKlaytnChain() {
-		client, err := Dial(evmCfg.Endpoint)
+		client, err := Dial(endpoints[0])
 		if err != nil {
 			ob.logger.ChainLogger.Err(err).Msg("klaytn Client Dial")
 			return nil, err
@@ -168,6 +170,10 @@ func NewEVMChainClient(
 	if err != nil {
 		return nil, err
 	}
+	err = ob.RegisterPromCounter(metricsPkg.ReorgCount, "Number of chain reorganizations detected")
+	if err != nil {
+		return nil, err
+	}
 
 	err = ob.LoadDB(dbpath, ob.chain)
 	if err != nil {
@@ -250,6 +256,21 @@ func (ob *EVMChainClient) GetERC20CustodyContract() (*erc20custody.ERC20Custody,
 	return FetchERC20CustodyContract(addr, ob.evmClient)
 }
 
+// GetTssBalance returns the gas token balance of the TSS address on the chain
+func (ob *EVMChainClient) GetTssBalance() (*big.Int, error) {
+	return ob.evmClient.BalanceAt(context.Background(), ob.Tss.EVMAddress(), nil)
+}
+
+// GetERC20CustodyBalance returns the balance of the ERC20 asset held by the ERC20Custody contract of the chain
+func (ob *EVMChainClient) GetERC20CustodyBalance(asset ethcommon.Address) (*big.Int, error) {
+	token, err := ierc20.NewIERC20(asset, ob.evmClient)
+	if err != nil {
+		return nil, err
+	}
+	custody := ethcommon.HexToAddress(ob.GetCoreParams().Erc20CustodyContractAddress)
+	return token.BalanceOf(&bind.CallOpts{}, custody)
+}
+
 func FetchConnectorContract(addr ethcommon.Address, client EVMRPCClient) (*zetaconnector.ZetaConnectorNonEth, error) {
 	return zetaconnector.NewZetaConnectorNonEth(addr, client)
 }
@@ -271,11 +292,17 @@ func (ob *EVMChainClient) Start() {
 	go ob.ExternalChainWatcher() // Observes external Chains for incoming trasnactions
 	go ob.WatchGasPrice()        // Observes external Chains for Gas prices and posts to core
 	go ob.observeOutTx()         // Populates receipts and confirmed outbound transactions
+	if client, ok := ob.evmClient.(*EVMFailoverClient); ok {
+		client.Start() // Watches the health of rpc endpoints
+	}
 }
 
 func (ob *EVMChainClient) Stop() {
 	ob.logger.ChainLogger.Info().Msgf("ob %s is stopping", ob.chain.String())
 	close(ob.stop) // this notifies all goroutines to stop
+	if client, ok := ob.evmClient.(*EVMFailoverClient); ok {
+		client.Stop()
+	}
 
 	ob.logger.ChainLogger.Info().Msg("closing ob.db")
 	dbInst, err := ob.db.DB()
@@ -302,9 +329,38 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 	if !found {
 		return false, false, nil
 	}
+	effectiveGasPrice, err := ob.getEffectiveGasPrice(receipt, transaction)
+	if err != nil {
+		return false, false, err
+	}
 
 	sendID := fmt.Sprintf("%s-%d", ob.chain.String(), nonce)
 	logger = logger.With().Str("sendID", sendID).Logger()
+
+	// the nonce of an expired outtx is used by a cancel tx, the outtx is failed and the cctx is reverted
+	if isCancelTx(transaction, ob.Tss.EVMAddress()) {
+		logger.Info().Msgf("Found (cancel tx) sendHash %s on chain %s txhash %s", sendHash, ob.chain.String(), receipt.TxHash.Hex())
+		zetaTxHash, ballot, err := ob.zetaClient.PostReceiveConfirmation(
+			sendHash,
+			receipt.TxHash.Hex(),
+			receipt.BlockNumber.Uint64(),
+			receipt.GasUsed,
+			effectiveGasPrice,
+			transaction.Gas(),
+			big.NewInt(0),
+			common.ReceiveStatus_Failed,
+			ob.chain,
+			nonce,
+			cointype,
+		)
+		if err != nil {
+			logger.Error().Err(err).Msgf("error posting confirmation to meta core for cctx %s nonce %d", sendHash, nonce)
+		} else if zetaTxHash != "" {
+			logger.Info().Msgf("Zeta tx hash: %s cctx %s nonce %d ballot %s", zetaTxHash, sendHash, nonce, ballot)
+		}
+		return true, true, nil
+	}
+
 	if cointype == common.CoinType_Cmd {
 		recvStatus := common.ReceiveStatus_Failed
 		if receipt.Status == 1 {
@@ -315,7 +371,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 			receipt.TxHash.Hex(),
 			receipt.BlockNumber.Uint64(),
 			receipt.GasUsed,
-			transaction.GasPrice(),
+			effectiveGasPrice,
 			transaction.Gas(),
 			transaction.Value(),
 			recvStatus,
@@ -337,7 +393,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 				receipt.TxHash.Hex(),
 				receipt.BlockNumber.Uint64(),
 				receipt.GasUsed,
-				transaction.GasPrice(),
+				effectiveGasPrice,
 				transaction.Gas(),
 				transaction.Value(),
 				common.ReceiveStatus_Success,
@@ -358,7 +414,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 				receipt.TxHash.Hex(),
 				receipt.BlockNumber.Uint64(),
 				receipt.GasUsed,
-				transaction.GasPrice(),
+				effectiveGasPrice,
 				transaction.Gas(),
 				big.NewInt(0),
 				common.ReceiveStatus_Failed,
@@ -400,7 +456,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 							vLog.TxHash.Hex(),
 							vLog.BlockNumber,
 							receipt.GasUsed,
-							transaction.GasPrice(),
+							effectiveGasPrice,
 							transaction.Gas(),
 							mMint,
 							common.ReceiveStatus_Success,
@@ -435,7 +491,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 							vLog.TxHash.Hex(),
 							vLog.BlockNumber,
 							receipt.GasUsed,
-							transaction.GasPrice(),
+							effectiveGasPrice,
 							transaction.Gas(),
 							mMint,
 							common.ReceiveStatus_Success,
@@ -463,7 +519,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 				receipt.TxHash.Hex(),
 				receipt.BlockNumber.Uint64(),
 				receipt.GasUsed,
-				transaction.GasPrice(),
+				effectiveGasPrice,
 				transaction.Gas(),
 				big.NewInt(0),
 				common.ReceiveStatus_Failed,
@@ -497,7 +553,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 							vLog.TxHash.Hex(),
 							vLog.BlockNumber,
 							receipt.GasUsed,
-							transaction.GasPrice(),
+							effectiveGasPrice,
 							transaction.Gas(),
 							event.Amount,
 							common.ReceiveStatus_Success,
@@ -524,7 +580,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 				receipt.TxHash.Hex(),
 				receipt.BlockNumber.Uint64(),
 				receipt.GasUsed,
-				transaction.GasPrice(),
+				effectiveGasPrice,
 				transaction.Gas(),
 				big.NewInt(0),
 				common.ReceiveStatus_Failed,
@@ -764,15 +820,26 @@ func (ob *EVMChainClient) observeInTX() error {
 	if err != nil {
 		return err
 	}
+	latestBlockNum := header.Number.Uint64()
+	// only scan the blocks a quorum of endpoints have reached
+	if client, ok := ob.quorumClient(); ok {
+		quorumBlockNum, err := client.QuorumBlockNumber(context.Background())
+		if err != nil {
+			return err
+		}
+		if quorumBlockNum < latestBlockNum {
+			latestBlockNum = quorumBlockNum
+		}
+	}
 	// update last block height
-	ob.SetLastBlockHeight(header.Number.Uint64())
-	confirmedBlockNum := header.Number.Uint64() - ob.GetCoreParams().ConfirmationCount
+	ob.SetLastBlockHeight(latestBlockNum)
+	confirmedBlockNum := latestBlockNum - ob.GetCoreParams().ConfirmationCount
 
 	crosschainFlags, err := ob.zetaClient.GetCrosschainFlags()
 	if err != nil {
 		return err
 	}
-	if !crosschainFlags.IsInboundEnabled {
+	if !crosschainFlags.IsChainInboundEnabled(ob.chain.ChainId) {
 		return errors.New("inbound TXS / Send has been disabled by the protocol")
 	}
 	counter, err := ob.GetPromCounter("rpc_getBlockByNumber_count")
@@ -787,6 +854,10 @@ func (ob *EVMChainClient) observeInTX() error {
 		sampledLogger.Debug().Msg("Skipping observer , No new block is produced")
 		return nil
 	}
+	// rewind the scan cursor to the fork point if the chain got reorganized
+	if err := ob.checkReorg(); err != nil {
+		return err
+	}
 	lastBlock := ob.GetLastBlockHeightScanned()
 	startBlock := lastBlock + 1
 	toBlock := lastBlock + config.MaxBlocksPerPeriod // read at most 100 blocks in one go
@@ -794,6 +865,8 @@ func (ob *EVMChainClient) observeInTX() error {
 		toBlock = confirmedBlockNum
 	}
 	ob.logger.ExternalChainWatcher.Info().Msgf("Checking for all inTX : startBlock %d, toBlock %d", startBlock, toBlock)
+	// the blocks are re-scanned later if the endpoints don't agree on an inbound tx
+	var quorumErr error
 	//task 1:  Query evm chain for zeta sent logs
 	func() {
 		toB := toBlock
@@ -818,19 +891,20 @@ func (ob *EVMChainClient) observeInTX() error {
 			return
 		}
 		// Pull out arguments from logs
+		var msgs []*types.MsgVoteOnObservedInboundTx
+		defer func() { ob.postInboundVotes(msgs, "ZetaSent event") }()
 		for logs.Next() {
 			msg, err := ob.GetInboundVoteMsgForZetaSentEvent(logs.Event)
 			if err != nil {
 				ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error getting inbound vote msg")
 				continue
 			}
-
-			zetaHash, err := ob.zetaClient.PostSend(PostSendNonEVMGasLimit, &msg)
-			if err != nil {
-				ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
+			if err := ob.checkInTxQuorum(logs.Event.Raw.TxHash, logs.Event.Raw.BlockHash); err != nil {
+				ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error checking inbound tx quorum")
+				quorumErr = err
 				return
 			}
-			ob.logger.ExternalChainWatcher.Info().Msgf("ZetaSent event detected and reported: PostSend zeta tx: %s", zetaHash)
+			msgs = append(msgs, &msg)
 		}
 	}()
 
@@ -860,17 +934,19 @@ func (ob *EVMChainClient) observeInTX() error {
 		}
 
 		// Pull out arguments from logs
+		var msgs []*types.MsgVoteOnObservedInboundTx
+		defer func() { ob.postInboundVotes(msgs, "ZRC20Custody Deposited event") }()
 		for depositedLogs.Next() {
 			msg, err := ob.GetInboundVoteMsgForDepositedEvent(depositedLogs.Event)
 			if err != nil {
 				continue
 			}
-			zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, &msg)
-			if err != nil {
-				ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
+			if err := ob.checkInTxQuorum(depositedLogs.Event.Raw.TxHash, depositedLogs.Event.Raw.BlockHash); err != nil {
+				ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error checking inbound tx quorum")
+				quorumErr = err
 				return
 			}
-			ob.logger.ExternalChainWatcher.Info().Msgf("ZRC20Custody Deposited event detected and reported: PostSend zeta tx: %s", zetaHash)
+			msgs = append(msgs, &msg)
 		}
 	}()
 
@@ -883,6 +959,8 @@ func (ob *EVMChainClient) observeInTX() error {
 		}
 
 		// query incoming gas asset
+		var msgs []*types.MsgVoteOnObservedInboundTx
+		defer func() { ob.postInboundVotes(msgs, "Gas Deposit") }()
 		for bn := startBlock; bn <= toBlock; bn++ {
 			err = ob.postBlockHeader(toBlock)
 			if err != nil {
@@ -929,6 +1007,11 @@ func (ob *EVMChainClient) observeInTX() error {
 						ob.logger.ExternalChainWatcher.Info().Msgf("tx %s failed; don't act", tx.Hash())
 						continue
 					}
+					if err := ob.checkInTxQuorum(tx.Hash(), block.Hash()); err != nil {
+						ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error checking inbound tx quorum")
+						quorumErr = err
+						return
+					}
 
 					from, err := ob.evmClient.TransactionSender(context.Background(), tx, block.Hash(), receipt.TransactionIndex)
 					if err != nil {
@@ -944,21 +1027,82 @@ func (ob *EVMChainClient) observeInTX() error {
 					if msg == nil {
 						continue
 					}
-					zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
-					if err != nil {
-						ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
-						continue
-					}
-					ob.logger.ExternalChainWatcher.Info().Msgf("Gas Deposit detected and reported: PostSend zeta tx: %s", zetaHash)
+					msgs = append(msgs, msg)
 				}
 			}
 		}
 	}()
 	// ============= end of query the incoming tx to TSS address ==============
+	if quorumErr != nil {
+		return quorumErr
+	}
 	ob.SetLastBlockHeightScanned(toBlock)
 	if err := ob.db.Save(clienttypes.ToLastBlockSQLType(ob.GetLastBlockHeightScanned())).Error; err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error writing toBlock to db")
 	}
+	if err := ob.saveBlockHashes(startBlock, toBlock); err != nil {
+		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error saving block hashes")
+	}
+	return nil
+}
+
+// postInboundVotes posts the inbound votes of the observed events concurrently to broadcast them in the same batch tx
+func (ob *EVMChainClient) postInboundVotes(msgs []*types.MsgVoteOnObservedInboundTx, event string) {
+	posts := make([]func() error, len(msgs))
+	for i, msg := range msgs {
+		msg := msg
+		posts[i] = func() error {
+			zetaHash, err := ob.zetaClient.PostSend(msg)
+			if err != nil {
+				return err
+			}
+			ob.logger.ExternalChainWatcher.Info().Msgf("%s detected and reported: PostSend zeta tx: %s", event, zetaHash)
+			return nil
+		}
+	}
+	for _, err := range postVotesConcurrently(posts) {
+		if err != nil {
+			ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
+		}
+	}
+}
+
+// getEffectiveGasPrice returns the gas price paid by a mined outbound tx
+func (ob *EVMChainClient) getEffectiveGasPrice(receipt *ethtypes.Receipt, transaction *ethtypes.Transaction) (*big.Int, error) {
+	if transaction.Type() != ethtypes.DynamicFeeTxType {
+		return transaction.GasPrice(), nil
+	}
+	// the price paid by a dynamic fee tx depends on the base fee of the block including it
+	header, err := ob.evmClient.HeaderByNumber(context.Background(), receipt.BlockNumber)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getEffectiveGasPrice: error getting header %d", receipt.BlockNumber)
+	}
+	return EffectiveGasPrice(transaction, header.BaseFee), nil
+}
+
+// quorumClient returns the rpc client if inbound txs are cross-checked across a quorum of endpoints
+func (ob *EVMChainClient) quorumClient() (*EVMFailoverClient, bool) {
+	client, ok := ob.evmClient.(*EVMFailoverClient)
+	if !ok || !client.QuorumEnabled() {
+		return nil, false
+	}
+	return client, true
+}
+
+// checkInTxQuorum checks that a quorum of endpoints agree the inbound tx succeeded in the observed block
+func (ob *EVMChainClient) checkInTxQuorum(txHash ethcommon.Hash, blockHash ethcommon.Hash) error {
+	client, ok := ob.quorumClient()
+	if !ok {
+		return nil
+	}
+	receipt, err := client.QuorumTransactionReceipt(context.Background(), txHash)
+	if err != nil {
+		return err
+	}
+	if receipt.BlockHash != blockHash || receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("checkInTxQuorum: quorum receipt of inbound tx %s has block %s status %d, observed in block %s",
+			txHash.Hex(), receipt.BlockHash.Hex(), receipt.Status, blockHash.Hex())
+	}
 	return nil
 }
 
@@ -1008,16 +1152,27 @@ func (ob *EVMChainClient) PostGasPrice() error {
 		ob.logger.WatchGasPrice.Err(err).Msg("Err SuggestGasPrice:")
 		return err
 	}
-	blockNum, err := ob.evmClient.BlockNumber(context.TODO())
+	header, err := ob.evmClient.HeaderByNumber(context.TODO(), nil)
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("Err Fetching Most recent Block : ")
 		return err
 	}
+	blockNum := header.Number.Uint64()
+
+	// PRIORITY FEE, only EIP-1559 chains have a base fee
+	priorityFee := big.NewInt(0)
+	if header.BaseFee != nil {
+		priorityFee, err = ob.evmClient.SuggestGasTipCap(context.TODO())
+		if err != nil {
+			ob.logger.WatchGasPrice.Err(err).Msg("Err SuggestGasTipCap:")
+			return err
+		}
+	}
 
 	// SUPPLY
 	supply := "100" // lockedAmount on ETH, totalSupply on other chains
 
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), supply, blockNum)
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), priorityFee.Uint64(), supply, blockNum)
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice to zetacore failed")
 		return err
@@ -1136,7 +1291,8 @@ func (ob *EVMChainClient) LoadDB(dbPath string, chain common.Chain) error {
 
 		err = db.AutoMigrate(&clienttypes.ReceiptSQLType{},
 			&clienttypes.TransactionSQLType{},
-			&clienttypes.LastBlockSQLType{})
+			&clienttypes.LastBlockSQLType{},
+			&clienttypes.BlockHashSQLType{})
 		if err != nil {
 			ob.logger.ChainLogger.Error().Err(err).Msg("error migrating db")
 			return err
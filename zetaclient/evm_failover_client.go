@@ -0,0 +1,232 @@
+package zetaclient
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// EVMFailoverClient is an EVMRPCClient that spreads requests over multiple endpoints of the same chain.
+// Requests go to the active endpoint and fail over to the next healthy one on connection errors.
+// If a quorum is configured, block numbers and inbound tx receipts can be cross-checked across endpoints.
+type EVMFailoverClient struct {
+	clients []EVMRPCClient
+	pool    *EndpointPool
+	quorum  int
+}
+
+var _ EVMRPCClient = (*EVMFailoverClient)(nil)
+
+// NewEVMFailoverClient dials all the given endpoints
+func NewEVMFailoverClient(
+	chainID int64,
+	endpoints []string,
+	quorum int,
+	maxBlockLag uint64,
+	logger zerolog.Logger,
+	ts *TelemetryServer,
+) (*EVMFailoverClient, error) {
+	clients := make([]EVMRPCClient, len(endpoints))
+	for i, endpoint := range endpoints {
+		client, err := ethclient.Dial(endpoint)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error dialing endpoint %s", endpointName(endpoint))
+		}
+		clients[i] = client
+	}
+	return newEVMFailoverClient(clients, NewEndpointPool(chainID, endpoints, maxBlockLag, logger, ts), quorum)
+}
+
+func newEVMFailoverClient(clients []EVMRPCClient, pool *EndpointPool, quorum int) (*EVMFailoverClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("no endpoint configured")
+	}
+	if quorum > len(clients) {
+		return nil, fmt.Errorf("endpoint quorum %d exceeds number of endpoints %d", quorum, len(clients))
+	}
+	return &EVMFailoverClient{
+		clients: clients,
+		pool:    pool,
+		quorum:  quorum,
+	}, nil
+}
+
+// Start watches the health of endpoints in the background
+func (c *EVMFailoverClient) Start() {
+	go c.pool.WatchHealth(func(ctx context.Context, i int) (uint64, error) {
+		return c.clients[i].BlockNumber(ctx)
+	})
+}
+
+// Stop stops watching the health of endpoints
+func (c *EVMFailoverClient) Stop() {
+	c.pool.Stop()
+}
+
+// QuorumEnabled returns true if block numbers and receipts are cross-checked across endpoints
+func (c *EVMFailoverClient) QuorumEnabled() bool {
+	return c.quorum > 1
+}
+
+// QuorumBlockNumber returns the highest block number reached by at least a quorum of endpoints
+func (c *EVMFailoverClient) QuorumBlockNumber(ctx context.Context) (uint64, error) {
+	heights := make([]uint64, 0, len(c.clients))
+	for i, client := range c.clients {
+		height, err := client.BlockNumber(ctx)
+		c.pool.RecordResult(i, err)
+		if err != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	if len(heights) < c.quorum {
+		return 0, fmt.Errorf("QuorumBlockNumber: only %d endpoints responded, quorum is %d", len(heights), c.quorum)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+	return heights[c.quorum-1], nil
+}
+
+// QuorumTransactionReceipt returns the receipt of a tx once a quorum of endpoints agree on its block and status
+func (c *EVMFailoverClient) QuorumTransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	type receiptKey struct {
+		blockHash ethcommon.Hash
+		status    uint64
+	}
+	votes := make(map[receiptKey]int)
+	for i, client := range c.clients {
+		receipt, err := client.TransactionReceipt(ctx, txHash)
+		c.pool.RecordResult(i, err)
+		if err != nil {
+			continue
+		}
+		key := receiptKey{blockHash: receipt.BlockHash, status: receipt.Status}
+		votes[key]++
+		if votes[key] >= c.quorum {
+			return receipt, nil
+		}
+	}
+	return nil, fmt.Errorf("QuorumTransactionReceipt: no quorum of %d endpoints agree on receipt of tx %s", c.quorum, txHash.Hex())
+}
+
+func (c *EVMFailoverClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (*big.Int, error) {
+		return client.ChainID(ctx)
+	})
+}
+
+func (c *EVMFailoverClient) BalanceAt(ctx context.Context, account ethcommon.Address, blockNumber *big.Int) (*big.Int, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (*big.Int, error) {
+		return client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+func (c *EVMFailoverClient) CodeAt(ctx context.Context, contract ethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) ([]byte, error) {
+		return client.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (c *EVMFailoverClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) ([]byte, error) {
+		return client.CallContract(ctx, call, blockNumber)
+	})
+}
+
+func (c *EVMFailoverClient) PendingCodeAt(ctx context.Context, account ethcommon.Address) ([]byte, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) ([]byte, error) {
+		return client.PendingCodeAt(ctx, account)
+	})
+}
+
+func (c *EVMFailoverClient) PendingNonceAt(ctx context.Context, account ethcommon.Address) (uint64, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (uint64, error) {
+		return client.PendingNonceAt(ctx, account)
+	})
+}
+
+func (c *EVMFailoverClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (*big.Int, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+}
+
+func (c *EVMFailoverClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (*big.Int, error) {
+		return client.SuggestGasTipCap(ctx)
+	})
+}
+
+func (c *EVMFailoverClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (uint64, error) {
+		return client.EstimateGas(ctx, call)
+	})
+}
+
+func (c *EVMFailoverClient) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	_, err := failoverCall(c.pool, c.clients, func(client EVMRPCClient) (struct{}, error) {
+		return struct{}{}, client.SendTransaction(ctx, tx)
+	})
+	return err
+}
+
+func (c *EVMFailoverClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) ([]ethtypes.Log, error) {
+		return client.FilterLogs(ctx, query)
+	})
+}
+
+func (c *EVMFailoverClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- ethtypes.Log) (ethereum.Subscription, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (ethereum.Subscription, error) {
+		return client.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+func (c *EVMFailoverClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (uint64, error) {
+		return client.BlockNumber(ctx)
+	})
+}
+
+func (c *EVMFailoverClient) BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (*ethtypes.Block, error) {
+		return client.BlockByNumber(ctx, number)
+	})
+}
+
+func (c *EVMFailoverClient) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (*ethtypes.Header, error) {
+		return client.HeaderByNumber(ctx, number)
+	})
+}
+
+func (c *EVMFailoverClient) TransactionByHash(ctx context.Context, hash ethcommon.Hash) (*ethtypes.Transaction, bool, error) {
+	type txResult struct {
+		tx        *ethtypes.Transaction
+		isPending bool
+	}
+	result, err := failoverCall(c.pool, c.clients, func(client EVMRPCClient) (txResult, error) {
+		tx, isPending, err := client.TransactionByHash(ctx, hash)
+		return txResult{tx: tx, isPending: isPending}, err
+	})
+	return result.tx, result.isPending, err
+}
+
+func (c *EVMFailoverClient) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (*ethtypes.Receipt, error) {
+		return client.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (c *EVMFailoverClient) TransactionSender(ctx context.Context, tx *ethtypes.Transaction, block ethcommon.Hash, index uint) (ethcommon.Address, error) {
+	return failoverCall(c.pool, c.clients, func(client EVMRPCClient) (ethcommon.Address, error) {
+		return client.TransactionSender(ctx, tx, block, index)
+	})
+}
@@ -0,0 +1,111 @@
+package zetaclient
+
+import (
+	"context"
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	metricsPkg "github.com/zeta-chain/zetacore/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+)
+
+const (
+	blockHashHistorySize = 1000 // number of scanned block hashes kept in db for reorg detection
+)
+
+// checkReorg compares the parent hash of the next block to scan with the saved hash of the last scanned block.
+// If they mismatch, the scan cursor is rewound to the fork point so that the reorganized blocks get re-observed.
+func (ob *EVMChainClient) checkReorg() error {
+	lastScanned := ob.GetLastBlockHeightScanned()
+	savedHash, found := ob.getBlockHash(lastScanned)
+	if !found { // nothing to compare against
+		return nil
+	}
+	header, err := ob.evmClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(lastScanned+1))
+	if err != nil {
+		return errors.Wrapf(err, "checkReorg: error getting header %d", lastScanned+1)
+	}
+	if header.ParentHash == savedHash {
+		return nil
+	}
+
+	// a reorg happened, find the fork point and re-observe from there
+	forkPoint, err := ob.findForkPoint(lastScanned)
+	if err != nil {
+		return err
+	}
+	ob.logger.ExternalChainWatcher.Warn().Msgf("checkReorg: reorg detected at block %d, parent hash %s, saved hash %s; rewind to fork point %d",
+		lastScanned+1, header.ParentHash.Hex(), savedHash.Hex(), forkPoint)
+	ob.rewindToForkPoint(lastScanned, forkPoint)
+
+	counter, err := ob.GetPromCounter(metricsPkg.ReorgCount)
+	if err != nil {
+		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("GetPromCounter:")
+	} else {
+		counter.Inc()
+	}
+	return nil
+}
+
+// findForkPoint walks back from the given height and returns the highest block whose saved hash still matches the chain
+func (ob *EVMChainClient) findForkPoint(height uint64) (uint64, error) {
+	for bn := height; bn > 0; bn-- {
+		savedHash, found := ob.getBlockHash(bn)
+		if !found { // reorg is deeper than saved history, re-observe all the saved blocks
+			ob.logger.ExternalChainWatcher.Error().Msgf("findForkPoint: no saved hash for block %d, reorg is deeper than history", bn)
+			return bn, nil
+		}
+		header, err := ob.evmClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(bn))
+		if err != nil {
+			return 0, errors.Wrapf(err, "findForkPoint: error getting header %d", bn)
+		}
+		if header.Hash() == savedHash {
+			return bn, nil
+		}
+	}
+	return 0, nil
+}
+
+// rewindToForkPoint resets the scan cursor to the fork point and discards everything saved for the reorganized blocks
+func (ob *EVMChainClient) rewindToForkPoint(lastScanned uint64, forkPoint uint64) {
+	for bn := forkPoint + 1; bn <= lastScanned; bn++ {
+		ob.BlockCache.Remove(bn)
+	}
+	if err := ob.db.Unscoped().Where("num > ?", forkPoint).Delete(&clienttypes.BlockHashSQLType{}).Error; err != nil {
+		ob.logger.ExternalChainWatcher.Error().Err(err).Msgf("rewindToForkPoint: error deleting block hashes above %d", forkPoint)
+	}
+	ob.SetLastBlockHeightScanned(forkPoint)
+	if err := ob.db.Save(clienttypes.ToLastBlockSQLType(forkPoint)).Error; err != nil {
+		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error writing fork point to db")
+	}
+}
+
+// saveBlockHashes saves the hashes of scanned blocks [startBlock, toBlock] and prunes the ones out of history
+func (ob *EVMChainClient) saveBlockHashes(startBlock uint64, toBlock uint64) error {
+	for bn := startBlock; bn <= toBlock; bn++ {
+		block, err := ob.GetBlockByNumberCached(bn)
+		if err != nil {
+			return errors.Wrapf(err, "saveBlockHashes: error getting block %d", bn)
+		}
+		if err := ob.db.Save(clienttypes.ToBlockHashSQLType(bn, block.Hash())).Error; err != nil {
+			return errors.Wrapf(err, "saveBlockHashes: error saving hash of block %d", bn)
+		}
+	}
+	if toBlock > blockHashHistorySize {
+		err := ob.db.Unscoped().Where("num <= ?", toBlock-blockHashHistorySize).Delete(&clienttypes.BlockHashSQLType{}).Error
+		if err != nil {
+			return errors.Wrap(err, "saveBlockHashes: error pruning block hashes")
+		}
+	}
+	return nil
+}
+
+// getBlockHash returns the saved hash of a scanned block
+func (ob *EVMChainClient) getBlockHash(bn uint64) (ethcommon.Hash, bool) {
+	var blockHash clienttypes.BlockHashSQLType
+	if err := ob.db.First(&blockHash, bn).Error; err != nil {
+		return ethcommon.Hash{}, false
+	}
+	return ethcommon.HexToHash(blockHash.Hash), true
+}
@@ -0,0 +1,173 @@
+package zetaclient
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metricsPkg "github.com/zeta-chain/zetacore/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// simulatedChain serves block headers of a chain that can be forked at any height
+type simulatedChain struct {
+	EVMRPCClient
+	headers []*ethtypes.Header
+}
+
+func newSimulatedChain(length uint64) *simulatedChain {
+	chain := &simulatedChain{}
+	chain.extend(length, "main")
+	return chain
+}
+
+// extend appends blocks to the chain, the tag makes the blocks different from any other branch
+func (c *simulatedChain) extend(length uint64, tag string) {
+	for i := uint64(0); i < length; i++ {
+		header := &ethtypes.Header{
+			Number:     new(big.Int).SetUint64(uint64(len(c.headers))),
+			Difficulty: big.NewInt(1),
+			Extra:      []byte(tag),
+		}
+		if len(c.headers) > 0 {
+			header.ParentHash = c.headers[len(c.headers)-1].Hash()
+		}
+		c.headers = append(c.headers, header)
+	}
+}
+
+// fork returns a new chain that shares blocks [0, forkPoint] with this chain
+func (c *simulatedChain) fork(forkPoint uint64, length uint64) *simulatedChain {
+	forked := &simulatedChain{headers: append([]*ethtypes.Header{}, c.headers[:forkPoint+1]...)}
+	forked.extend(length, "fork")
+	return forked
+}
+
+func (c *simulatedChain) HeaderByNumber(_ context.Context, number *big.Int) (*ethtypes.Header, error) {
+	if number.Uint64() >= uint64(len(c.headers)) {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	return c.headers[number.Uint64()], nil
+}
+
+func (c *simulatedChain) BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	header, err := c.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return ethtypes.NewBlockWithHeader(header), nil
+}
+
+// helper function to create an EVMChainClient that has scanned the chain up to 'lastScanned'
+func createReorgTestClient(t *testing.T, chainName string, chain *simulatedChain, lastScanned uint64) *EVMChainClient {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "evm.db")), &gorm.Config{})
+	require.NoError(t, err)
+	err = db.AutoMigrate(&clienttypes.LastBlockSQLType{}, &clienttypes.BlockHashSQLType{})
+	require.NoError(t, err)
+	blockCache, err := lru.New(1000)
+	require.NoError(t, err)
+
+	ob := &EVMChainClient{
+		ChainMetrics: NewChainMetrics(chainName, &metricsPkg.Metrics{}),
+		evmClient:    chain,
+		Mu:           &sync.Mutex{},
+		db:           db,
+		BlockCache:   blockCache,
+		ts:           NewTelemetryServer(),
+	}
+	require.NoError(t, ob.RegisterPromCounter(metricsPkg.ReorgCount, "Number of chain reorganizations detected"))
+	require.NoError(t, ob.saveBlockHashes(1, lastScanned))
+	ob.SetLastBlockHeightScanned(lastScanned)
+	return ob
+}
+
+func getReorgCount(t *testing.T, ob *EVMChainClient) float64 {
+	counter, err := ob.GetPromCounter(metricsPkg.ReorgCount)
+	require.NoError(t, err)
+	return testutil.ToFloat64(counter)
+}
+
+func TestEVMChainClient_CheckReorg(t *testing.T) {
+	t.Run("should not rewind if chain is not reorganized", func(t *testing.T) {
+		chain := newSimulatedChain(30)
+		ob := createReorgTestClient(t, "reorg_test_none", chain, 20)
+
+		require.NoError(t, ob.checkReorg())
+		require.Equal(t, uint64(20), ob.GetLastBlockHeightScanned())
+		require.Equal(t, float64(0), getReorgCount(t, ob))
+	})
+
+	t.Run("should rewind to fork point and re-observe the forked blocks", func(t *testing.T) {
+		chain := newSimulatedChain(30)
+		ob := createReorgTestClient(t, "reorg_test_fork", chain, 20)
+
+		// the chain forks at block 15 and the blocks 16~20 scanned earlier are gone
+		forked := chain.fork(15, 15)
+		ob.evmClient = forked
+		require.NoError(t, ob.checkReorg())
+		require.Equal(t, uint64(15), ob.GetLastBlockHeightScanned())
+		require.Equal(t, float64(1), getReorgCount(t, ob))
+
+		// the fork point is persisted and the stale hashes are discarded
+		var lastBlock clienttypes.LastBlockSQLType
+		require.NoError(t, ob.db.First(&lastBlock, clienttypes.LastBlockNumID).Error)
+		require.Equal(t, uint64(15), lastBlock.Num)
+		hash, found := ob.getBlockHash(15)
+		require.True(t, found)
+		require.Equal(t, forked.headers[15].Hash(), hash)
+		_, found = ob.getBlockHash(16)
+		require.False(t, found)
+
+		// the forked blocks are re-observed from the new chain rather than the block cache
+		block, err := ob.GetBlockByNumberCached(16)
+		require.NoError(t, err)
+		require.Equal(t, forked.headers[16].Hash(), block.Hash())
+		require.NoError(t, ob.saveBlockHashes(16, 20))
+		ob.SetLastBlockHeightScanned(20)
+		require.NoError(t, ob.checkReorg())
+		require.Equal(t, uint64(20), ob.GetLastBlockHeightScanned())
+		require.Equal(t, float64(1), getReorgCount(t, ob))
+	})
+
+	t.Run("should detect reorg of the last scanned block only", func(t *testing.T) {
+		chain := newSimulatedChain(30)
+		ob := createReorgTestClient(t, "reorg_test_tip", chain, 20)
+
+		ob.evmClient = chain.fork(19, 5)
+		require.NoError(t, ob.checkReorg())
+		require.Equal(t, uint64(19), ob.GetLastBlockHeightScanned())
+		require.Equal(t, float64(1), getReorgCount(t, ob))
+	})
+
+	t.Run("should rewind to the earliest saved block if reorg is deeper than history", func(t *testing.T) {
+		chain := newSimulatedChain(30)
+		ob := createReorgTestClient(t, "reorg_test_deep", chain, 20)
+		require.NoError(t, ob.db.Unscoped().Where("num < ?", 10).Delete(&clienttypes.BlockHashSQLType{}).Error)
+
+		ob.evmClient = chain.fork(5, 20)
+		require.NoError(t, ob.checkReorg())
+		require.Equal(t, uint64(9), ob.GetLastBlockHeightScanned())
+	})
+}
+
+func TestEVMChainClient_SaveBlockHashes(t *testing.T) {
+	chain := newSimulatedChain(blockHashHistorySize + 20)
+	ob := createReorgTestClient(t, "reorg_test_prune", chain, 10)
+
+	// hashes out of history are pruned
+	require.NoError(t, ob.saveBlockHashes(11, blockHashHistorySize+10))
+	_, found := ob.getBlockHash(10)
+	require.False(t, found)
+	hash, found := ob.getBlockHash(11)
+	require.True(t, found)
+	require.Equal(t, chain.headers[11].Hash(), hash)
+}
@@ -15,13 +15,14 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	zetaconnectorbase "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.base.sol"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
 )
 
 type EVMSigner struct {
@@ -34,6 +35,7 @@ type EVMSigner struct {
 	erc20CustodyABI             abi.ABI
 	metaContractAddress         ethcommon.Address
 	erc20CustodyContractAddress ethcommon.Address
+	forceLegacyTx               bool
 	logger                      zerolog.Logger
 	ts                          *TelemetryServer
 }
@@ -41,8 +43,7 @@ type EVMSigner struct {
 var _ ChainSigner = &EVMSigner{}
 
 func NewEVMSigner(
-	chain common.Chain,
-	endpoint string,
+	evmCfg config.EVMConfig,
 	tssSigner TSSSigner,
 	abiString string,
 	erc20CustodyABIString string,
@@ -51,7 +52,9 @@ func NewEVMSigner(
 	logger zerolog.Logger,
 	ts *TelemetryServer,
 ) (*EVMSigner, error) {
-	client, err := ethclient.Dial(endpoint)
+	// the signer fails over to other endpoints on error, their health is watched and reported by the observer
+	chain := evmCfg.Chain
+	client, err := NewEVMFailoverClient(chain.ChainId, evmCfg.GetEndpoints(), 0, 0, logger.With().Str("chain", chain.ChainName.String()).Logger(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +83,7 @@ func NewEVMSigner(
 		erc20CustodyABI:             erc20CustodyABI,
 		metaContractAddress:         metaContract,
 		erc20CustodyContractAddress: erc20CustodyContract,
+		forceLegacyTx:               evmCfg.ForceLegacyTx,
 		logger: logger.With().
 			Str("chain", chain.ChainName.String()).
 			Str("module", "EVMSigner").Logger(),
@@ -87,6 +91,31 @@ func NewEVMSigner(
 	}, nil
 }
 
+// newTx builds a dynamic fee tx that uses 'gasPrice' as fee cap if 'gasTipCap' is given, a legacy tx otherwise
+func (signer *EVMSigner) newTx(
+	nonce uint64,
+	to ethcommon.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	gasTipCap *big.Int,
+	data []byte,
+) *ethtypes.Transaction {
+	if gasTipCap == nil {
+		return ethtypes.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
+	}
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   signer.chainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasPrice,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     amount,
+		Data:      data,
+	})
+}
+
 // Sign given data, and metadata (gas, nonce, etc)
 // returns a signed transaction, sig bytes, hash bytes, and error
 func (signer *EVMSigner) Sign(
@@ -94,11 +123,12 @@ func (signer *EVMSigner) Sign(
 	to ethcommon.Address,
 	gasLimit uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	nonce uint64,
 	height uint64,
 ) (*ethtypes.Transaction, []byte, []byte, error) {
 	log.Debug().Msgf("TSS SIGNER: %s", signer.tssSigner.Pubkey())
-	tx := ethtypes.NewTransaction(nonce, to, big.NewInt(0), gasLimit, gasPrice, data)
+	tx := signer.newTx(nonce, to, big.NewInt(0), gasLimit, gasPrice, gasTipCap, data)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
@@ -126,6 +156,36 @@ func (signer *EVMSigner) Broadcast(tx *ethtypes.Transaction) error {
 	return signer.client.SendTransaction(ctxt, tx)
 }
 
+// getJournaledTx returns the journaled tx of the cctx if it can be re-broadcasted instead of signing a new one
+// the journaled tx must be signed by the current TSS and pay at least the given gas price, otherwise a new keysign is needed
+func (signer *EVMSigner) getJournaledTx(journal *SigningJournal, send *types.CrossChainTx, gasPrice *big.Int, logger zerolog.Logger) *ethtypes.Transaction {
+	nonce := send.GetCurrentOutTxParam().OutboundTxTssNonce
+	entry, err := journal.GetLatestSignedTx(signer.chain.ChainId, nonce)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot get journaled tx of nonce %d", nonce)
+		return nil
+	}
+	if entry == nil || entry.CctxIndex != send.Index {
+		return nil
+	}
+	tx := new(ethtypes.Transaction)
+	err = tx.UnmarshalBinary(entry.SignedTx)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot deserialize journaled tx %s", entry.TxHash)
+		return nil
+	}
+	sender, err := ethtypes.Sender(signer.ethSigner, tx)
+	if err != nil || sender != signer.tssSigner.EVMAddress() {
+		logger.Info().Msgf("journaled tx %s is not signed by current TSS; sign a new one", entry.TxHash)
+		return nil
+	}
+	if tx.Nonce() != nonce || tx.GasFeeCap().Cmp(gasPrice) < 0 {
+		logger.Info().Msgf("journaled tx %s pays gasprice %d lower than %d; sign a new one", entry.TxHash, tx.GasFeeCap(), gasPrice)
+		return nil
+	}
+	return tx
+}
+
 // SignOutboundTx
 // function onReceive(
 //
@@ -146,6 +206,7 @@ func (signer *EVMSigner) SignOutboundTx(sender ethcommon.Address,
 	sendHash [32]byte,
 	nonce uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	height uint64) (*ethtypes.Transaction, error) {
 
 	if len(sendHash) < 32 {
@@ -159,7 +220,7 @@ func (signer *EVMSigner) SignOutboundTx(sender ethcommon.Address,
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, gasTipCap, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -188,6 +249,7 @@ func (signer *EVMSigner) SignRevertTx(
 	sendHash [32]byte,
 	nonce uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
 	var data []byte
@@ -198,7 +260,7 @@ func (signer *EVMSigner) SignRevertTx(
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, gasTipCap, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -206,8 +268,8 @@ func (signer *EVMSigner) SignRevertTx(
 	return tx, nil
 }
 
-func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, nil)
+func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, gasTipCap *big.Int, height uint64) (*ethtypes.Transaction, error) {
+	tx := signer.newTx(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, gasTipCap, nil)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
 	if err != nil {
@@ -227,14 +289,20 @@ func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, height ui
 	return signedTX, nil
 }
 
+// isCancelTx returns true if the tx is a cancel tx of the TSS: a transfer of 0 to itself without data
+func isCancelTx(tx *ethtypes.Transaction, tssAddress ethcommon.Address) bool {
+	return tx.To() != nil && *tx.To() == tssAddress && tx.Value().Sign() == 0 && len(tx.Data()) == 0
+}
+
 func (signer *EVMSigner) SignWithdrawTx(
 	to ethcommon.Address,
 	amount *big.Int,
 	nonce uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(nonce, to, amount, 21000, gasPrice, nil)
+	tx := signer.newTx(nonce, to, amount, 21000, gasPrice, gasTipCap, nil)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
 	if err != nil {
@@ -261,6 +329,7 @@ func (signer *EVMSigner) SignCommandTx(
 	outboundParams *types.OutboundTxParams,
 	gasLimit uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
 	if cmd == common.CmdWhitelistERC20 {
@@ -276,14 +345,33 @@ func (signer *EVMSigner) SignCommandTx(
 		if err != nil {
 			return nil, err
 		}
-		tx, _, _, err := signer.Sign(data, to, gasLimit, gasPrice, outboundParams.OutboundTxTssNonce, height)
+		tx, _, _, err := signer.Sign(data, to, gasLimit, gasPrice, gasTipCap, outboundParams.OutboundTxTssNonce, height)
+		if err != nil {
+			return nil, fmt.Errorf("sign error: %w", err)
+		}
+		return tx, nil
+	}
+	if cmd == common.CmdUpdateConnectorTssAddress {
+		newTss := ethcommon.HexToAddress(params)
+		if newTss == (ethcommon.Address{}) {
+			return nil, fmt.Errorf("SignCommandTx: invalid tss address %s", params)
+		}
+		connectorAbi, err := zetaconnectorbase.ZetaConnectorBaseMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err := connectorAbi.Pack("updateTssAddress", newTss)
+		if err != nil {
+			return nil, err
+		}
+		tx, _, _, err := signer.Sign(data, to, gasLimit, gasPrice, gasTipCap, outboundParams.OutboundTxTssNonce, height)
 		if err != nil {
 			return nil, fmt.Errorf("sign error: %w", err)
 		}
 		return tx, nil
 	}
 	if cmd == common.CmdMigrateTssFunds {
-		tx := ethtypes.NewTransaction(outboundParams.OutboundTxTssNonce, to, outboundParams.Amount.BigInt(), 21000, gasPrice, nil)
+		tx := signer.newTx(outboundParams.OutboundTxTssNonce, to, outboundParams.Amount.BigInt(), 21000, gasPrice, gasTipCap, nil)
 		hashBytes := signer.ethSigner.Hash(tx).Bytes()
 		sig, err := signer.tssSigner.Sign(hashBytes, height, outboundParams.OutboundTxTssNonce, signer.chain, "")
 		if err != nil {
@@ -412,6 +500,13 @@ func (signer *EVMSigner) TryProcessOutTx(
 	} else {
 		gasprice = specified
 	}
+
+	// sign EIP-1559 dynamic fee tx if the chain supports it
+	gasTipCap, err := signer.getGasTipCap(send.GetCurrentOutTxParam(), gasprice)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot get gas tip cap for chain %s", toChain)
+		return
+	}
 	//if common.IsEthereumChain(toChain.ChainId) {
 	//	suggested, err := signer.client.SuggestGasPrice(context.Background())
 	//	if err != nil {
@@ -434,9 +529,28 @@ func (signer *EVMSigner) TryProcessOutTx(
 		return
 	}
 
-	var tx *ethtypes.Transaction
+	// re-broadcast the journaled tx of the nonce, if any, instead of requesting a new keysign
+	tx := signer.getJournaledTx(outTxMan.Journal(), send, gasprice, logger)
+	journaled := tx != nil
 
-	if send.GetCurrentOutTxParam().CoinType == common.CoinType_Cmd { // admin command
+	// the nonce of an expired outtx is cancelled, the cancel tx must pay more than the journaled tx to replace it in the mempool
+	expired := send.GetCurrentOutTxParam().OutboundTxExpired
+	if expired && journaled && !isCancelTx(tx, signer.tssSigner.EVMAddress()) {
+		if minGasPrice := new(big.Int).Div(new(big.Int).Mul(tx.GasFeeCap(), big.NewInt(11)), big.NewInt(10)); gasprice.Cmp(minGasPrice) < 0 {
+			gasprice = minGasPrice
+		}
+		if minGasTipCap := new(big.Int).Div(new(big.Int).Mul(tx.GasTipCap(), big.NewInt(11)), big.NewInt(10)); gasTipCap != nil && gasTipCap.Cmp(minGasTipCap) < 0 {
+			gasTipCap = minGasTipCap
+		}
+		tx, journaled = nil, false
+	}
+
+	if journaled {
+		logger.Info().Msgf("re-broadcasting journaled tx %s: nonce %d, gasprice %d", tx.Hash().Hex(), tx.Nonce(), tx.GasFeeCap())
+	} else if expired {
+		logger.Info().Msgf("SignCancelTx: outtx expired, cancel nonce %d, gasprice %d", send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
+		tx, err = signer.SignCancelTx(send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice, gasTipCap, height)
+	} else if send.GetCurrentOutTxParam().CoinType == common.CoinType_Cmd { // admin command
 		to := ethcommon.HexToAddress(send.GetCurrentOutTxParam().Receiver)
 		if to == (ethcommon.Address{}) {
 			logger.Error().Msgf("invalid receiver %s", send.GetCurrentOutTxParam().Receiver)
@@ -448,7 +562,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 			logger.Error().Msgf("invalid message %s", msg)
 			return
 		}
-		tx, err = signer.SignCommandTx(msg[0], msg[1], to, send.GetCurrentOutTxParam(), gasLimit, gasprice, height)
+		tx, err = signer.SignCommandTx(msg[0], msg[1], to, send.GetCurrentOutTxParam(), gasLimit, gasprice, gasTipCap, height)
 	} else if send.InboundTxParams.SenderChainId == zetaBridge.ZetaChain().ChainId && send.CctxStatus.Status == types.CctxStatus_PendingOutbound && flags.IsOutboundEnabled {
 		if send.GetCurrentOutTxParam().CoinType == common.CoinType_Gas {
 			logger.Info().Msgf("SignWithdrawTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
@@ -457,6 +571,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 				send.GetCurrentOutTxParam().Amount.BigInt(),
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				gasTipCap,
 				height,
 			)
 		}
@@ -470,6 +585,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 				gasLimit,
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				gasTipCap,
 				height,
 			)
 		}
@@ -485,6 +601,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 				sendhash,
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				gasTipCap,
 				height,
 			)
 		}
@@ -496,6 +613,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 				send.GetCurrentOutTxParam().Amount.BigInt(),
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				gasTipCap,
 				height,
 			)
 		}
@@ -509,6 +627,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 				gasLimit,
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				gasTipCap,
 				height,
 			)
 		}
@@ -525,6 +644,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 			sendhash,
 			send.GetCurrentOutTxParam().OutboundTxTssNonce,
 			gasprice,
+			gasTipCap,
 			height,
 		)
 	} else if send.CctxStatus.Status == types.CctxStatus_PendingOutbound {
@@ -539,6 +659,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 			sendhash,
 			send.GetCurrentOutTxParam().OutboundTxTssNonce,
 			gasprice,
+			gasTipCap,
 			height,
 		)
 	}
@@ -547,7 +668,20 @@ func (signer *EVMSigner) TryProcessOutTx(
 		logger.Warn().Err(err).Msgf("signer SignOutbound error: nonce %d chain %d", send.GetCurrentOutTxParam().OutboundTxTssNonce, send.GetCurrentOutTxParam().ReceiverChainId)
 		return
 	}
-	logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce)
+	if !journaled && tx != nil {
+		logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce)
+
+		// journal the signed tx before broadcasting it, so it's never signed again for the same gas price
+		signedTx, err := tx.MarshalBinary()
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot serialize signed tx %s", tx.Hash().Hex())
+			return
+		}
+		err = outTxMan.Journal().RecordSignedTx(signer.chain.ChainId, tx.Nonce(), send.Index, tx.Hash().Hex(), tx.GasFeeCap().String(), signedTx)
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot journal signed tx %s", tx.Hash().Hex())
+		}
+	}
 
 	_, err = zetaBridge.GetObserverList(*toChain)
 	if err != nil {
@@ -565,6 +699,9 @@ func (signer *EVMSigner) TryProcessOutTx(
 			// #nosec G404 randomness is not a security issue here
 			time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) // FIXME: use backoff
 			err := signer.Broadcast(tx)
+			if jErr := outTxMan.Journal().RecordBroadcastAttempt(signer.chain.ChainId, outTxHash); jErr != nil {
+				logger.Error().Err(jErr).Msgf("cannot journal broadcast attempt of tx %s", outTxHash)
+			}
 			if err != nil {
 				log.Warn().Err(err).Msgf("OutTx Broadcast error")
 				retry, report := HandleBroadcastError(err, strconv.FormatUint(send.GetCurrentOutTxParam().OutboundTxTssNonce, 10), toChain.String(), outTxHash)
@@ -606,6 +743,7 @@ func (signer *EVMSigner) SignERC20WithdrawTx(
 	gasLimit uint64,
 	nonce uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
 	var data []byte
@@ -615,7 +753,7 @@ func (signer *EVMSigner) SignERC20WithdrawTx(
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, gasTipCap, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("sign error: %w", err)
 	}
@@ -637,6 +775,7 @@ func (signer *EVMSigner) SignWhitelistTx(
 	gasLimit uint64,
 	nonce uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
 	var data []byte
@@ -648,7 +787,7 @@ func (signer *EVMSigner) SignWhitelistTx(
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, gasTipCap, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -656,6 +795,29 @@ func (signer *EVMSigner) SignWhitelistTx(
 	return tx, nil
 }
 
+// getGasTipCap returns the tip of a dynamic fee outbound tx, or nil if a legacy tx should be signed
+func (signer *EVMSigner) getGasTipCap(params *types.OutboundTxParams, gasPrice *big.Int) (*big.Int, error) {
+	if signer.forceLegacyTx || params.OutboundTxGasPriorityFee == "" {
+		return nil, nil
+	}
+	header, err := signer.client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if header.BaseFee == nil { // chain doesn't support EIP-1559
+		return nil, nil
+	}
+	priorityFee, err := params.GetGasPriorityFee()
+	if err != nil {
+		return nil, err
+	}
+	gasTipCap := new(big.Int).SetUint64(priorityFee)
+	if gasTipCap.Cmp(gasPrice) > 0 { // the tip can't exceed the fee cap
+		gasTipCap = new(big.Int).Set(gasPrice)
+	}
+	return gasTipCap, nil
+}
+
 func roundUpToNearestGwei(gasPrice *big.Int) *big.Int {
 	oneGwei := big.NewInt(1_000_000_000) // 1 Gwei
 	mod := new(big.Int)
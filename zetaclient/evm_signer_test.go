@@ -0,0 +1,108 @@
+package zetaclient
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// mockHeaderClient serves a latest header with the given base fee
+type mockHeaderClient struct {
+	EVMRPCClient
+	baseFee *big.Int
+}
+
+func (m *mockHeaderClient) HeaderByNumber(_ context.Context, _ *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(100), BaseFee: m.baseFee}, nil
+}
+
+func TestEVMSigner_NewTx(t *testing.T) {
+	signer := &EVMSigner{chainID: big.NewInt(1)}
+	to := ethcommon.HexToAddress("0x1")
+
+	t.Run("should build legacy tx without gas tip cap", func(t *testing.T) {
+		tx := signer.newTx(1, to, big.NewInt(10), 21000, big.NewInt(100), nil, nil)
+		require.Equal(t, uint8(ethtypes.LegacyTxType), tx.Type())
+		require.Equal(t, big.NewInt(100), tx.GasPrice())
+	})
+
+	t.Run("should build dynamic fee tx with gas tip cap", func(t *testing.T) {
+		tx := signer.newTx(1, to, big.NewInt(10), 21000, big.NewInt(100), big.NewInt(2), nil)
+		require.Equal(t, uint8(ethtypes.DynamicFeeTxType), tx.Type())
+		require.Equal(t, big.NewInt(100), tx.GasFeeCap())
+		require.Equal(t, big.NewInt(2), tx.GasTipCap())
+		require.Equal(t, big.NewInt(1), tx.ChainId())
+		require.Equal(t, to, *tx.To())
+	})
+}
+
+func TestIsCancelTx(t *testing.T) {
+	signer := &EVMSigner{chainID: big.NewInt(1)}
+	tss := ethcommon.HexToAddress("0x1")
+
+	require.True(t, isCancelTx(signer.newTx(1, tss, big.NewInt(0), 21000, big.NewInt(100), nil, nil), tss))
+	require.False(t, isCancelTx(signer.newTx(1, tss, big.NewInt(10), 21000, big.NewInt(100), nil, nil), tss))
+	require.False(t, isCancelTx(signer.newTx(1, tss, big.NewInt(0), 21000, big.NewInt(100), nil, []byte{1}), tss))
+	require.False(t, isCancelTx(signer.newTx(1, ethcommon.HexToAddress("0x2"), big.NewInt(0), 21000, big.NewInt(100), nil, nil), tss))
+}
+
+func TestEVMSigner_GetGasTipCap(t *testing.T) {
+	gasPrice := big.NewInt(100)
+	params := &types.OutboundTxParams{OutboundTxGasPrice: "100", OutboundTxGasPriorityFee: "2"}
+
+	t.Run("should return priority fee on EIP-1559 chain", func(t *testing.T) {
+		signer := &EVMSigner{client: &mockHeaderClient{baseFee: big.NewInt(50)}}
+		gasTipCap, err := signer.getGasTipCap(params, gasPrice)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(2), gasTipCap)
+	})
+
+	t.Run("should cap priority fee to gas price", func(t *testing.T) {
+		signer := &EVMSigner{client: &mockHeaderClient{baseFee: big.NewInt(50)}}
+		gasTipCap, err := signer.getGasTipCap(&types.OutboundTxParams{OutboundTxGasPriorityFee: "200"}, gasPrice)
+		require.NoError(t, err)
+		require.Equal(t, gasPrice, gasTipCap)
+	})
+
+	t.Run("should sign legacy tx on chain without base fee", func(t *testing.T) {
+		signer := &EVMSigner{client: &mockHeaderClient{}}
+		gasTipCap, err := signer.getGasTipCap(params, gasPrice)
+		require.NoError(t, err)
+		require.Nil(t, gasTipCap)
+	})
+
+	t.Run("should sign legacy tx if forced or no priority fee is voted", func(t *testing.T) {
+		signer := &EVMSigner{client: &mockHeaderClient{baseFee: big.NewInt(50)}, forceLegacyTx: true}
+		gasTipCap, err := signer.getGasTipCap(params, gasPrice)
+		require.NoError(t, err)
+		require.Nil(t, gasTipCap)
+
+		signer.forceLegacyTx = false
+		gasTipCap, err = signer.getGasTipCap(&types.OutboundTxParams{OutboundTxGasPrice: "100"}, gasPrice)
+		require.NoError(t, err)
+		require.Nil(t, gasTipCap)
+	})
+}
+
+func TestEffectiveGasPrice(t *testing.T) {
+	to := ethcommon.HexToAddress("0x1")
+	legacyTx := ethtypes.NewTransaction(1, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.Equal(t, big.NewInt(100), EffectiveGasPrice(legacyTx, big.NewInt(50)))
+
+	dynamicFeeTx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		GasTipCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &to,
+	})
+	// base fee + tip
+	require.Equal(t, big.NewInt(52), EffectiveGasPrice(dynamicFeeTx, big.NewInt(50)))
+	// the tip is reduced to stay under the fee cap
+	require.Equal(t, big.NewInt(100), EffectiveGasPrice(dynamicFeeTx, big.NewInt(99)))
+}
@@ -67,15 +67,21 @@ func (ob *BitcoinChainClient) ObserveTrackerSuggestions() error {
 	if err != nil {
 		return err
 	}
-	for _, tracker := range trackers {
-		ob.logger.WatchInTx.Info().Msgf("checking tracker with hash :%s and coin-type :%s ", tracker.TxHash, tracker.CoinType)
-		ballotIdentifier, err := ob.CheckReceiptForBtcTxHash(tracker.TxHash, true)
-		if err != nil {
-			return err
+	// the trackers are checked concurrently to broadcast their votes in the same batch tx
+	posts := make([]func() error, len(trackers))
+	for i, tracker := range trackers {
+		tracker := tracker
+		posts[i] = func() error {
+			ob.logger.WatchInTx.Info().Msgf("checking tracker with hash :%s and coin-type :%s ", tracker.TxHash, tracker.CoinType)
+			ballotIdentifier, err := ob.CheckReceiptForBtcTxHash(tracker.TxHash, true)
+			if err != nil {
+				return err
+			}
+			ob.logger.WatchInTx.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_Gas.String())
+			return nil
 		}
-		ob.logger.WatchInTx.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_Gas.String())
 	}
-	return nil
+	return firstError(postVotesConcurrently(posts))
 }
 
 func (ob *BitcoinChainClient) CheckReceiptForBtcTxHash(txHash string, vote bool) (string, error) {
@@ -111,7 +117,7 @@ func (ob *BitcoinChainClient) CheckReceiptForBtcTxHash(txHash string, vote bool)
 	if !vote {
 		return msg.Digest(), nil
 	}
-	zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
+	zetaHash, err := ob.zetaClient.PostSend(msg)
 	if err != nil {
 		ob.logger.WatchInTx.Error().Err(err).Msg("error posting to zeta core")
 		return "", err
@@ -125,28 +131,37 @@ func (ob *EVMChainClient) ObserveTrackerSuggestions() error {
 	if err != nil {
 		return err
 	}
-	for _, tracker := range trackers {
-		ob.logger.ExternalChainWatcher.Info().Msgf("checking tracker with hash :%s and coin-type :%s ", tracker.TxHash, tracker.CoinType)
-		switch tracker.CoinType {
-		case common.CoinType_Zeta:
-			ballotIdentifier, err := ob.CheckReceiptForCoinTypeZeta(tracker.TxHash, true)
-			if err != nil {
-				return err
-			}
-			ob.logger.ExternalChainWatcher.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_Zeta.String())
-		case common.CoinType_ERC20:
-			ballotIdentifier, err := ob.CheckReceiptForCoinTypeERC20(tracker.TxHash, true)
-			if err != nil {
-				return err
-			}
-			ob.logger.ExternalChainWatcher.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_ERC20.String())
-		case common.CoinType_Gas:
-			ballotIdentifier, err := ob.CheckReceiptForCoinTypeGas(tracker.TxHash, true)
-			if err != nil {
-				return err
-			}
-			ob.logger.ExternalChainWatcher.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_Gas.String())
+	// the trackers are checked concurrently to broadcast their votes in the same batch tx
+	posts := make([]func() error, len(trackers))
+	for i, tracker := range trackers {
+		tracker := tracker
+		posts[i] = func() error { return ob.observeTrackerSuggestion(tracker) }
+	}
+	return firstError(postVotesConcurrently(posts))
+}
+
+// observeTrackerSuggestion checks the inbound tx of the tracker and votes for it
+func (ob *EVMChainClient) observeTrackerSuggestion(tracker types.InTxTracker) error {
+	ob.logger.ExternalChainWatcher.Info().Msgf("checking tracker with hash :%s and coin-type :%s ", tracker.TxHash, tracker.CoinType)
+	switch tracker.CoinType {
+	case common.CoinType_Zeta:
+		ballotIdentifier, err := ob.CheckReceiptForCoinTypeZeta(tracker.TxHash, true)
+		if err != nil {
+			return err
+		}
+		ob.logger.ExternalChainWatcher.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_Zeta.String())
+	case common.CoinType_ERC20:
+		ballotIdentifier, err := ob.CheckReceiptForCoinTypeERC20(tracker.TxHash, true)
+		if err != nil {
+			return err
+		}
+		ob.logger.ExternalChainWatcher.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_ERC20.String())
+	case common.CoinType_Gas:
+		ballotIdentifier, err := ob.CheckReceiptForCoinTypeGas(tracker.TxHash, true)
+		if err != nil {
+			return err
 		}
+		ob.logger.ExternalChainWatcher.Info().Msgf("Vote submitted for inbound Tracker,Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, common.CoinType_Gas.String())
 	}
 	return nil
 }
@@ -176,7 +191,7 @@ func (ob *EVMChainClient) CheckReceiptForCoinTypeZeta(txHash string, vote bool)
 		return msg.Digest(), nil
 	}
 
-	zetaHash, err := ob.zetaClient.PostSend(PostSendNonEVMGasLimit, &msg)
+	zetaHash, err := ob.zetaClient.PostSend(&msg)
 	if err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
 		return "", err
@@ -210,7 +225,7 @@ func (ob *EVMChainClient) CheckReceiptForCoinTypeERC20(txHash string, vote bool)
 		return msg.Digest(), nil
 	}
 
-	zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, &msg)
+	zetaHash, err := ob.zetaClient.PostSend(&msg)
 	if err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
 		return "", err
@@ -259,7 +274,7 @@ func (ob *EVMChainClient) CheckReceiptForCoinTypeGas(txHash string, vote bool) (
 		return msg.Digest(), nil
 	}
 
-	zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
+	zetaHash, err := ob.zetaClient.PostSend(msg)
 	if err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
 		return "", err
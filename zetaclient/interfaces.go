@@ -48,7 +48,7 @@ type ChainSigner interface {
 
 // ZetaCoreBridger is the interface to interact with ZetaCore
 type ZetaCoreBridger interface {
-	PostSend(zetaGasLimit uint64, msg *crosschaintypes.MsgVoteOnObservedInboundTx) (string, error)
+	PostSend(msg *crosschaintypes.MsgVoteOnObservedInboundTx) (string, error)
 	PostReceiveConfirmation(
 		sendHash string,
 		outTxHash string,
@@ -62,7 +62,7 @@ type ZetaCoreBridger interface {
 		nonce uint64,
 		coinType common.CoinType,
 	) (string, string, error)
-	PostGasPrice(chain common.Chain, gasPrice uint64, supply string, blockNum uint64) (string, error)
+	PostGasPrice(chain common.Chain, gasPrice uint64, priorityFee uint64, supply string, blockNum uint64) (string, error)
 	PostAddBlockHeader(chainID int64, txhash []byte, height int64, header common.HeaderData) (string, error)
 	GetBlockHeaderStateByChain(chainID int64) (observertypes.QueryGetBlockHeaderStateResponse, error)
 
@@ -84,6 +84,7 @@ type ZetaCoreBridger interface {
 	GetCctxByNonce(chainID int64, nonce uint64) (*crosschaintypes.CrossChainTx, error)
 	GetAllOutTxTrackerByChain(chainID int64, order Order) ([]crosschaintypes.OutTxTracker, error)
 	GetCrosschainFlags() (observertypes.CrosschainFlags, error)
+	GetCrosschainParams() (crosschaintypes.Params, error)
 	GetObserverList(chain common.Chain) ([]string, error)
 	GetKeyGen() (*observertypes.Keygen, error)
 	GetBtcTssAddress() (string, error)
@@ -103,6 +104,7 @@ type BTCRPCClient interface {
 	EstimateSmartFee(confTarget int64, mode *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error)
 	GetTransaction(txHash *chainhash.Hash) (*btcjson.GetTransactionResult, error)
 	GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error)
+	GetMempoolEntry(txHash string) (*btcjson.GetMempoolEntryResult, error)
 	GetBlockCount() (int64, error)
 	GetBlockHash(blockHeight int64) (*chainhash.Hash, error)
 	GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error)
@@ -121,6 +123,8 @@ type EVMRPCClient interface {
 	TransactionByHash(ctx context.Context, hash ethcommon.Hash) (tx *ethtypes.Transaction, isPending bool, err error)
 	TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error)
 	TransactionSender(ctx context.Context, tx *ethtypes.Transaction, block ethcommon.Hash, index uint) (ethcommon.Address, error)
+	ChainID(ctx context.Context) (*big.Int, error)
+	BalanceAt(ctx context.Context, account ethcommon.Address, blockNumber *big.Int) (*big.Int, error)
 }
 
 // KlaytnRPCClient is the interface for Klaytn RPC client
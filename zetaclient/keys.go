@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -15,9 +16,11 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog/log"
+	"github.com/tendermint/tendermint/p2p"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/common/cosmos"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
 )
 
 // HotkeyPasswordEnvVar is the environment variable used to retrieve the password for the hotkey
@@ -28,6 +31,9 @@ type Keys struct {
 	signerName      string
 	kb              ckeys.Keyring
 	OperatorAddress sdk.AccAddress
+
+	// tssKb holds the private key of the TSS p2p key, the hotkey itself unless it's held by a remote signer
+	tssKb ckeys.Keyring
 }
 
 // NewKeysWithKeybase create a new instance of Keys
@@ -36,6 +42,18 @@ func NewKeysWithKeybase(kb ckeys.Keyring, granterAddress sdk.AccAddress, grantee
 		signerName:      granteeName,
 		kb:              kb,
 		OperatorAddress: granterAddress,
+		tssKb:           kb,
+	}
+}
+
+// NewKeysWithRemoteSigner create a new instance of Keys signing with the hotkey of a remote signer,
+// the local keybase holds the TSS p2p key under the name of the hotkey
+func NewKeysWithRemoteSigner(remoteKb ckeys.Keyring, tssKb ckeys.Keyring, granterAddress sdk.AccAddress, granteeName string) *Keys {
+	return &Keys{
+		signerName:      granteeName,
+		kb:              remoteKb,
+		OperatorAddress: granterAddress,
+		tssKb:           tssKb,
 	}
 }
 
@@ -43,7 +61,8 @@ func GetGranteeKeyName(signerName string) string {
 	return signerName
 }
 
-// GetKeyringKeybase return keyring and key info
+// GetKeyringKeybase return the local keyring and the bech32 pubkey of the hotkey,
+// with the remote keyring backend the local keyring holds the TSS p2p key under the name of the hotkey
 func GetKeyringKeybase(cfg *config.Config) (ckeys.Keyring, string, error) {
 	granteeName := cfg.AuthzHotkey
 	chainHomeFolder := cfg.ZetaCoreHome
@@ -53,8 +72,9 @@ func GetKeyringKeybase(cfg *config.Config) (ckeys.Keyring, string, error) {
 	}
 
 	// read password from env if using keyring backend file
+	keyringBackend := cfg.GetTssKeyringBackend()
 	buf := bytes.NewBufferString("")
-	if cfg.KeyringBackend == config.KeyringBackendFile {
+	if keyringBackend == config.KeyringBackendFile {
 		password, err := getHotkeyPassword()
 		if err != nil {
 			return nil, "", err
@@ -65,7 +85,7 @@ func GetKeyringKeybase(cfg *config.Config) (ckeys.Keyring, string, error) {
 		buf.WriteByte('\n')
 	}
 
-	kb, err := getKeybase(chainHomeFolder, buf, cfg.KeyringBackend)
+	kb, err := getKeybase(chainHomeFolder, buf, keyringBackend)
 	if err != nil {
 		return nil, "", fmt.Errorf("fail to get keybase,err:%w", err)
 	}
@@ -90,23 +110,48 @@ func GetKeyringKeybase(cfg *config.Config) (ckeys.Keyring, string, error) {
 	return kb, pubkeyBech32, nil
 }
 
+// GetRemoteSignerKeybase return the keyring signing with the hotkey held by the remote signer
+func GetRemoteSignerKeybase(cfg *config.Config) (ckeys.Keyring, error) {
+	logger := log.Logger.With().Str("module", "GetRemoteSignerKeybase").Logger()
+	signerID, err := remotesigner.ParseID(cfg.RemoteSigner.SignerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer ID: %w", err)
+	}
+	clientKey, err := p2p.LoadOrGenNodeKey(cfg.RemoteSigner.ClientKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load remote signer client key,err:%w", err)
+	}
+	// #nosec G701 always in range
+	timeout := time.Duration(cfg.RemoteSigner.TimeoutSeconds) * time.Second
+	client := remotesigner.NewClient(cfg.RemoteSigner.Address, signerID, clientKey.PrivKey, timeout)
+	logger.Info().Msgf("Connecting to remote signer %s with client ID %s", cfg.RemoteSigner.Address, client.ID())
+
+	kb, err := remotesigner.NewKeyring(client, newKeybaseCodec(), cfg.AuthzHotkey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get remote signer keybase,err:%w", err)
+	}
+	return kb, nil
+}
+
 // getKeybase will create an instance of Keybase
 func getKeybase(zetaCoreHome string, reader io.Reader, keyringBackend config.KeyringBackend) (ckeys.Keyring, error) {
 	cliDir := zetaCoreHome
 	if len(zetaCoreHome) == 0 {
 		return nil, fmt.Errorf("zetaCoreHome is empty")
 	}
-	registry := codectypes.NewInterfaceRegistry()
-	cryptocodec.RegisterInterfaces(registry)
-	cdc := codec.NewProtoCodec(registry)
-
 	// create a new keybase based on the selected backend
 	backend := ckeys.BackendTest
 	if keyringBackend == config.KeyringBackendFile {
 		backend = ckeys.BackendFile
 	}
 
-	return ckeys.New(sdk.KeyringServiceName(), backend, cliDir, reader, cdc)
+	return ckeys.New(sdk.KeyringServiceName(), backend, cliDir, reader, newKeybaseCodec())
+}
+
+func newKeybaseCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
 }
 
 // GetSignerInfo return signer info
@@ -136,15 +181,19 @@ func (k *Keys) GetAddress() sdk.AccAddress {
 	return addr
 }
 
-// GetPrivateKey return the private key
+// GetPrivateKey return the private key of the TSS p2p key, which is the hotkey unless it's held by a remote signer
 func (k *Keys) GetPrivateKey() (cryptotypes.PrivKey, error) {
-	password, err := k.GetHotkeyPassword()
-	if err != nil {
-		return nil, err
+	password := ""
+	if k.tssKb.Backend() == ckeys.BackendFile {
+		var err error
+		password, err = getHotkeyPassword()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	signer := GetGranteeKeyName(k.signerName)
-	privKeyArmor, err := k.kb.ExportPrivKeyArmor(signer, password)
+	privKeyArmor, err := k.tssKb.ExportPrivKeyArmor(signer, password)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package zetaclient
 
 import (
 	"bytes"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -14,9 +15,15 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	hd "github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
 	. "gopkg.in/check.v1"
 
 	"github.com/zeta-chain/zetacore/cmd"
@@ -123,3 +130,61 @@ func (ks *KeysSuite) TestNewKeys(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(pubKey.VerifySignature([]byte(msg), signedMsg), Equals, true)
 }
+
+func (ks *KeysSuite) TestNewKeysWithRemoteSigner(c *C) {
+	oldStdIn := os.Stdin
+	defer func() {
+		os.Stdin = oldStdIn
+	}()
+	os.Stdin = nil
+	folder := ks.setupKeysForTest(c)
+	defer func() {
+		err := os.RemoveAll(folder)
+		c.Assert(err, IsNil)
+	}()
+
+	// the remote signer holds the hotkey and allows the client key generated in the folder
+	clientKeyPath := filepath.Join(folder, "remote_signer_key.json")
+	clientKey, err := p2p.LoadOrGenNodeKey(clientKeyPath)
+	c.Assert(err, IsNil)
+	hotkey := secp256k1.GenPrivKey()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	server := remotesigner.NewServer(listener, ed25519.GenPrivKey(), []p2p.ID{clientKey.ID()},
+		map[string]cryptotypes.PrivKey{signerNameForTest: hotkey}, zerolog.Nop())
+	server.Start()
+	defer server.Stop()
+
+	cfg := &config.Config{
+		AuthzHotkey:    signerNameForTest,
+		ZetaCoreHome:   folder,
+		KeyringBackend: config.KeyringBackendRemote,
+		RemoteSigner: config.RemoteSignerConfig{
+			Address:           "tcp://" + listener.Addr().String(),
+			SignerID:          string(server.ID()),
+			ClientKeyPath:     clientKeyPath,
+			TssKeyringBackend: config.KeyringBackendTest,
+		},
+	}
+	tssKb, tssPubkey, err := GetKeyringKeybase(cfg)
+	c.Assert(err, IsNil)
+	remoteKb, err := GetRemoteSignerKeybase(cfg)
+	c.Assert(err, IsNil)
+	granter := cosmos.AccAddress(crypto.AddressHash([]byte("granter")))
+	ki := NewKeysWithRemoteSigner(remoteKb, tssKb, granter, signerNameForTest)
+
+	// the txs are signed by the remote hotkey
+	c.Assert(ki.GetAddress(), DeepEquals, types.AccAddress(hotkey.PubKey().Address()))
+	msg := []byte("hello")
+	signedMsg, pubKey, err := ki.GetKeybase().Sign(signerNameForTest, msg)
+	c.Assert(err, IsNil)
+	c.Assert(pubKey.Equals(hotkey.PubKey()), Equals, true)
+	c.Assert(pubKey.VerifySignature(msg, signedMsg), Equals, true)
+
+	// the private key is the local TSS p2p key
+	priKey, err := ki.GetPrivateKey()
+	c.Assert(err, IsNil)
+	priKeyBech32, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, priKey.PubKey())
+	c.Assert(err, IsNil)
+	c.Assert(priKeyBech32, Equals, tssPubkey)
+}
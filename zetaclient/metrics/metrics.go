@@ -22,12 +22,15 @@ const (
 	//
 	//COUNTER_NUM_RPCS
 	PendingTxs = "pending_txs"
+	ReorgCount = "reorg_count"
 )
 
 var (
 	Counters = map[string]prometheus.Counter{}
 
 	Gauges = map[string]prometheus.Gauge{}
+
+	GaugeVecs = map[string]*prometheus.GaugeVec{}
 )
 
 func NewMetrics() (*Metrics, error) {
@@ -81,6 +84,20 @@ func (m *Metrics) RegisterGauge(name string, help string) error {
 	return nil
 }
 
+func (m *Metrics) RegisterGaugeVec(name string, help string, labels []string) error {
+	if _, found := GaugeVecs[name]; found {
+		return fmt.Errorf("gauge vec %s already registered", name)
+	}
+
+	var gaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: name,
+		Help: help,
+	}, labels)
+	prometheus.MustRegister(gaugeVec)
+	GaugeVecs[name] = gaugeVec
+	return nil
+}
+
 func (m *Metrics) Start() {
 	log.Info().Msg("metrics server starting")
 	go func() {
@@ -15,9 +15,12 @@ type OutTxProcessorManager struct {
 	mu                 sync.Mutex
 	Logger             zerolog.Logger
 	numActiveProcessor int64
+
+	// journal persists the signed outtxs across restarts
+	journal *SigningJournal
 }
 
-func NewOutTxProcessorManager(logger zerolog.Logger) *OutTxProcessorManager {
+func NewOutTxProcessorManager(logger zerolog.Logger, journal *SigningJournal) *OutTxProcessorManager {
 	return &OutTxProcessorManager{
 		outTxStartTime:     make(map[string]time.Time),
 		outTxEndTime:       make(map[string]time.Time),
@@ -25,6 +28,7 @@ func NewOutTxProcessorManager(logger zerolog.Logger) *OutTxProcessorManager {
 		mu:                 sync.Mutex{},
 		Logger:             logger.With().Str("module", "OutTxProcessorManager").Logger(),
 		numActiveProcessor: 0,
+		journal:            journal,
 	}
 }
 
@@ -62,6 +66,11 @@ func (outTxMan *OutTxProcessorManager) TimeInTryProcess(outTxID string) time.Dur
 	return 0
 }
 
+// Journal returns the signing journal of the signed outtxs, nil if the signed outtxs are not journaled
+func (outTxMan *OutTxProcessorManager) Journal() *SigningJournal {
+	return outTxMan.journal
+}
+
 // ToOutTxID returns the outTxID for OutTxProcessorManager to track
 func ToOutTxID(index string, receiverChainID int64, nonce uint64) string {
 	return fmt.Sprintf("%s-%d-%d", index, receiverChainID, nonce)
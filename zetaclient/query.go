@@ -11,13 +11,16 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	tmhttp "github.com/tendermint/tendermint/rpc/client/http"
 	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"google.golang.org/grpc"
 )
@@ -39,6 +42,15 @@ func (b *ZetaCoreBridge) GetCrosschainFlags() (observertypes.CrosschainFlags, er
 	return resp.CrosschainFlags, nil
 }
 
+func (b *ZetaCoreBridge) GetCrosschainParams() (types.Params, error) {
+	client := types.NewQueryClient(b.grpcConn)
+	resp, err := client.Params(context.Background(), &types.QueryParamsRequest{})
+	if err != nil {
+		return types.Params{}, err
+	}
+	return resp.Params, nil
+}
+
 func (b *ZetaCoreBridge) GetCoreParamsForChainID(externalChainID int64) (*observertypes.CoreParams, error) {
 	client := observertypes.NewQueryClient(b.grpcConn)
 	resp, err := client.GetCoreParamsForChain(context.Background(), &observertypes.QueryGetCoreParamsForChainRequest{ChainId: externalChainID})
@@ -194,6 +206,16 @@ func (b *ZetaCoreBridge) GetLatestZetaBlock() (*tmservice.Block, error) {
 	return res.SdkBlock, nil
 }
 
+// GetTxResult returns the result of a tx included in a block, fails if the tx is not found
+func (b *ZetaCoreBridge) GetTxResult(txHash string) (*sdk.TxResponse, error) {
+	client := txtypes.NewServiceClient(b.grpcConn)
+	res, err := client.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: txHash})
+	if err != nil {
+		return nil, err
+	}
+	return res.TxResponse, nil
+}
+
 func (b *ZetaCoreBridge) GetNodeInfo() (*tmservice.GetNodeInfoResponse, error) {
 	var err error
 
@@ -458,3 +480,33 @@ func (b *ZetaCoreBridge) GetZetaHotKeyBalance() (sdkmath.Int, error) {
 	}
 	return resp.Balance.Amount, nil
 }
+
+func (b *ZetaCoreBridge) GetForeignCoins() ([]fungibletypes.ForeignCoins, error) {
+	client := fungibletypes.NewQueryClient(b.grpcConn)
+	resp, err := client.ForeignCoinsAll(context.Background(), &fungibletypes.QueryAllForeignCoinsRequest{
+		Pagination: &query.PageRequest{
+			Limit: 2000,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.ForeignCoins, nil
+}
+
+func (b *ZetaCoreBridge) GetZRC20TotalSupply(zrc20Address string) (sdkmath.Int, sdkmath.Int, error) {
+	client := fungibletypes.NewQueryClient(b.grpcConn)
+	resp, err := client.ZRC20TotalSupply(context.Background(), &fungibletypes.QueryZRC20TotalSupplyRequest{Zrc20Address: zrc20Address})
+	if err != nil {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), err
+	}
+	totalSupply, ok := sdkmath.NewIntFromString(resp.TotalSupply)
+	if !ok {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), fmt.Errorf("invalid total supply %s for zrc20 %s", resp.TotalSupply, zrc20Address)
+	}
+	protocolBalance, ok := sdkmath.NewIntFromString(resp.ProtocolBalance)
+	if !ok {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), fmt.Errorf("invalid protocol balance %s for zrc20 %s", resp.ProtocolBalance, zrc20Address)
+	}
+	return totalSupply, protocolBalance, nil
+}
@@ -0,0 +1,145 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// DefaultTimeout is the default timeout of the connection and of each request to the remote signer
+const DefaultTimeout = 5 * time.Second
+
+// Client requests public keys and signatures to a remote signer, it redials the signer when the connection is lost
+type Client struct {
+	address   string
+	signerID  p2p.ID
+	clientKey tmcrypto.PrivKey
+	timeout   time.Duration
+
+	mu      sync.Mutex
+	conn    net.Conn
+	encoder *json.Encoder
+	decoder *json.Decoder
+}
+
+// NewClient creates a client of the remote signer at the address, authenticated with the client key.
+// The connection is only established by the first request.
+func NewClient(address string, signerID p2p.ID, clientKey tmcrypto.PrivKey, timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Client{
+		address:   address,
+		signerID:  signerID,
+		clientKey: clientKey,
+		timeout:   timeout,
+	}
+}
+
+// ID returns the ID of the client key, it must be allowed by the remote signer
+func (c *Client) ID() p2p.ID {
+	return p2p.PubKeyToID(c.clientKey.PubKey())
+}
+
+// PubKey returns the public key of a key of the remote signer
+func (c *Client) PubKey(keyName string) (cryptotypes.PubKey, error) {
+	res, err := c.request(Request{Method: MethodPubKey, KeyName: keyName})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.PubKey) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key of %d bytes for key %s", len(res.PubKey), keyName)
+	}
+	return &secp256k1.PubKey{Key: res.PubKey}, nil
+}
+
+// Sign signs the bytes with a key of the remote signer, the signature is verified against the public key
+func (c *Client) Sign(keyName string, pubKey cryptotypes.PubKey, signBytes []byte) ([]byte, error) {
+	res, err := c.request(Request{Method: MethodSign, KeyName: keyName, SignBytes: signBytes})
+	if err != nil {
+		return nil, err
+	}
+	if !pubKey.VerifySignature(signBytes, res.Signature) {
+		return nil, fmt.Errorf("invalid signature from the remote signer for key %s", keyName)
+	}
+	return res.Signature, nil
+}
+
+// Close closes the connection to the remote signer
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closeConn()
+}
+
+// request sends the request and reads its response, the connection is closed on transport errors to be redialed
+func (c *Client) request(req Request) (Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var res Response
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return res, fmt.Errorf("unable to connect to the remote signer %s: %w", c.address, err)
+		}
+	}
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		_ = c.closeConn()
+		return res, err
+	}
+	if err := c.encoder.Encode(req); err != nil {
+		_ = c.closeConn()
+		return res, fmt.Errorf("unable to send %s request to the remote signer: %w", req.Method, err)
+	}
+	if err := c.decoder.Decode(&res); err != nil {
+		_ = c.closeConn()
+		return res, fmt.Errorf("unable to read %s response from the remote signer: %w", req.Method, err)
+	}
+	if res.Error != "" {
+		return res, fmt.Errorf("remote signer %s error for key %s: %s", req.Method, req.KeyName, res.Error)
+	}
+	return res, nil
+}
+
+// connect dials the remote signer and authenticates it
+func (c *Client) connect() error {
+	rawConn, err := tmnet.Connect(c.address)
+	if err != nil {
+		return err
+	}
+	if err := rawConn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		_ = rawConn.Close()
+		return err
+	}
+	secretConn, err := conn.MakeSecretConnection(rawConn, c.clientKey)
+	if err != nil {
+		_ = rawConn.Close()
+		return fmt.Errorf("handshake failed: %w", err)
+	}
+	if id := p2p.PubKeyToID(secretConn.RemotePubKey()); id != c.signerID {
+		_ = secretConn.Close()
+		return fmt.Errorf("unexpected signer ID %s, expected %s", id, c.signerID)
+	}
+	c.conn = secretConn
+	c.encoder = json.NewEncoder(secretConn)
+	c.decoder = json.NewDecoder(secretConn)
+	return nil
+}
+
+func (c *Client) closeConn() error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn, c.encoder, c.decoder = nil, nil, nil
+	return err
+}
@@ -0,0 +1,68 @@
+package remotesigner
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	ckeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ckeys.Keyring = &Keyring{}
+
+// Keyring is a keyring whose keys are held by a remote signer.
+// It only stores the public keys of the remote keys in memory and signs through the remote signer,
+// so the txs signed with the keyring by the Cosmos SDK are signed by the remote signer.
+type Keyring struct {
+	ckeys.Keyring
+	client *Client
+}
+
+// NewKeyring creates a keyring with the remote keys of the names, their public keys are fetched from the remote signer
+func NewKeyring(client *Client, cdc codec.Codec, keyNames ...string) (*Keyring, error) {
+	kb := ckeys.NewInMemory(cdc)
+	for _, name := range keyNames {
+		pubKey, err := client.PubKey(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := kb.SaveOfflineKey(name, pubKey); err != nil {
+			return nil, fmt.Errorf("unable to save the public key of remote key %s: %w", name, err)
+		}
+	}
+	return &Keyring{
+		Keyring: kb,
+		client:  client,
+	}, nil
+}
+
+// Sign signs the message with the remote key of the name
+func (k *Keyring) Sign(uid string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	record, err := k.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k.sign(record, msg)
+}
+
+// SignByAddress signs the message with the remote key of the address
+func (k *Keyring) SignByAddress(address sdk.Address, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	record, err := k.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k.sign(record, msg)
+}
+
+func (k *Keyring) sign(record *ckeys.Record, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	signature, err := k.client.Sign(record.Name, pubKey, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signature, pubKey, nil
+}
@@ -0,0 +1,65 @@
+// Package remotesigner signs the ZetaChain txs of zetaclient with a hotkey held by an external signing service,
+// in the spirit of tmkms for Tendermint validators.
+//
+// # Protocol
+//
+// zetaclient dials the signer over TCP (tcp://host:port) or a unix socket (unix:///path/to/socket).
+//
+// Both ends are mutually authenticated by the handshake of the Tendermint secret connection, which is used by
+// validators and tmkms. Each end owns an ed25519 connection key whose ID is the hex encoded address of its
+// public key, as for Tendermint node IDs:
+//   - zetaclient closes the connection if the ID of the signer is not the signer ID of its config
+//   - the signer closes the connection if the ID of zetaclient is not in its allowlist
+//
+// Once authenticated, zetaclient sends requests and the signer answers each request in order. Every message is
+// a JSON object on its own line and binary fields are base64 encoded:
+//
+//	-> {"method":"pubkey","key_name":"hotkey"}
+//	<- {"pub_key":"<33-byte compressed secp256k1 public key>"}
+//
+//	-> {"method":"sign","key_name":"hotkey","sign_bytes":"<bytes to sign>"}
+//	<- {"signature":"<64-byte R || S secp256k1 signature of the SHA-256 of the bytes, with a low S>"}
+//
+// A failed request is answered with {"error":"<reason>"} and the connection stays open.
+package remotesigner
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+const (
+	// MethodPubKey requests the secp256k1 public key of a key
+	MethodPubKey = "pubkey"
+
+	// MethodSign requests the signature of bytes with a key
+	MethodSign = "sign"
+)
+
+// Request is a request of zetaclient to the remote signer
+type Request struct {
+	Method    string `json:"method"`
+	KeyName   string `json:"key_name"`
+	SignBytes []byte `json:"sign_bytes,omitempty"`
+}
+
+// Response is the answer of the remote signer to a request
+type Response struct {
+	PubKey    []byte `json:"pub_key,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ParseID parses the hex ID of a connection key
+func ParseID(id string) (p2p.ID, error) {
+	bytes, err := hex.DecodeString(id)
+	if err != nil {
+		return "", fmt.Errorf("invalid ID %s: %w", id, err)
+	}
+	if len(bytes) != p2p.IDByteLength {
+		return "", fmt.Errorf("invalid ID %s: %d bytes instead of %d", id, len(bytes), p2p.IDByteLength)
+	}
+	return p2p.ID(hex.EncodeToString(bytes)), nil
+}
@@ -0,0 +1,143 @@
+package remotesigner
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+func startTestServer(t *testing.T, network, address string, allowedClients []p2p.ID, keys map[string]cryptotypes.PrivKey) *Server {
+	listener, err := net.Listen(network, address)
+	require.NoError(t, err)
+	server := NewServer(listener, ed25519.GenPrivKey(), allowedClients, keys, zerolog.Nop())
+	server.Start()
+	t.Cleanup(server.Stop)
+	return server
+}
+
+func testCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	hotkey := secp256k1.GenPrivKey()
+	keys := map[string]cryptotypes.PrivKey{"hotkey": hotkey}
+	clientKey := ed25519.GenPrivKey()
+	clientID := p2p.PubKeyToID(clientKey.PubKey())
+	msg := []byte("sign bytes")
+
+	t.Run("should sign with the remote key over tcp", func(t *testing.T) {
+		server := startTestServer(t, "tcp", "127.0.0.1:0", []p2p.ID{clientID}, keys)
+		client := NewClient("tcp://"+server.listener.Addr().String(), server.ID(), clientKey, time.Second)
+		defer client.Close()
+
+		pubKey, err := client.PubKey("hotkey")
+		require.NoError(t, err)
+		require.True(t, hotkey.PubKey().Equals(pubKey))
+
+		signature, err := client.Sign("hotkey", pubKey, msg)
+		require.NoError(t, err)
+		require.True(t, hotkey.PubKey().VerifySignature(msg, signature))
+
+		_, err = client.PubKey("unknown")
+		require.ErrorContains(t, err, "key unknown not found")
+
+		// the connection stays open after a failed request
+		_, err = client.Sign("hotkey", pubKey, msg)
+		require.NoError(t, err)
+	})
+
+	t.Run("should sign with the keyring over a unix socket", func(t *testing.T) {
+		socket := filepath.Join(t.TempDir(), "signer.sock")
+		server := startTestServer(t, "unix", socket, []p2p.ID{clientID}, keys)
+		client := NewClient("unix://"+socket, server.ID(), clientKey, time.Second)
+		defer client.Close()
+
+		kb, err := NewKeyring(client, testCodec(), "hotkey")
+		require.NoError(t, err)
+		address := sdk.AccAddress(hotkey.PubKey().Address())
+		record, err := kb.Key("hotkey")
+		require.NoError(t, err)
+		recordAddress, err := record.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, address, recordAddress)
+
+		signature, pubKey, err := kb.Sign("hotkey", msg)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(msg, signature))
+		signature, _, err = kb.SignByAddress(address, msg)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(msg, signature))
+	})
+
+	t.Run("should redial the remote signer", func(t *testing.T) {
+		server := startTestServer(t, "tcp", "127.0.0.1:0", []p2p.ID{clientID}, keys)
+		client := NewClient("tcp://"+server.listener.Addr().String(), server.ID(), clientKey, time.Second)
+		defer client.Close()
+
+		pubKey, err := client.PubKey("hotkey")
+		require.NoError(t, err)
+		require.NoError(t, client.conn.Close())
+
+		// the request on the closed connection fails and the next one redials
+		_, err = client.Sign("hotkey", pubKey, msg)
+		require.Error(t, err)
+		_, err = client.Sign("hotkey", pubKey, msg)
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a client not allowed by the signer", func(t *testing.T) {
+		server := startTestServer(t, "tcp", "127.0.0.1:0", []p2p.ID{clientID}, keys)
+		client := NewClient("tcp://"+server.listener.Addr().String(), server.ID(), ed25519.GenPrivKey(), time.Second)
+		defer client.Close()
+
+		_, err := client.PubKey("hotkey")
+		require.ErrorContains(t, err, "is not allowed")
+	})
+
+	t.Run("should reject a signer with an unexpected ID", func(t *testing.T) {
+		server := startTestServer(t, "tcp", "127.0.0.1:0", []p2p.ID{clientID}, keys)
+		otherID := p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())
+		client := NewClient("tcp://"+server.listener.Addr().String(), otherID, clientKey, time.Second)
+		defer client.Close()
+
+		_, err := client.PubKey("hotkey")
+		require.ErrorContains(t, err, "unexpected signer ID")
+	})
+
+	t.Run("should reject a signature not matching the public key", func(t *testing.T) {
+		otherKeys := map[string]cryptotypes.PrivKey{"hotkey": secp256k1.GenPrivKey()}
+		server := startTestServer(t, "tcp", "127.0.0.1:0", []p2p.ID{clientID}, otherKeys)
+		client := NewClient("tcp://"+server.listener.Addr().String(), server.ID(), clientKey, time.Second)
+		defer client.Close()
+
+		_, err := client.Sign("hotkey", hotkey.PubKey(), msg)
+		require.ErrorContains(t, err, "invalid signature")
+	})
+}
+
+func TestParseID(t *testing.T) {
+	id := p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())
+	parsed, err := ParseID(string(id))
+	require.NoError(t, err)
+	require.Equal(t, id, parsed)
+
+	_, err = ParseID("not hex")
+	require.Error(t, err)
+	_, err = ParseID("abcd")
+	require.ErrorContains(t, err, "2 bytes instead of 20")
+}
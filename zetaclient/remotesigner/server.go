@@ -0,0 +1,174 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/rs/zerolog"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// Server is a reference remote signer holding its keys in memory.
+// It is used in tests and documents the protocol for the implementations of signing services.
+type Server struct {
+	listener       net.Listener
+	serverKey      tmcrypto.PrivKey
+	allowedClients map[p2p.ID]bool
+	keys           map[string]cryptotypes.PrivKey
+	logger         zerolog.Logger
+
+	wg   sync.WaitGroup
+	mu   sync.Mutex
+	conn map[net.Conn]bool
+	stop chan struct{}
+}
+
+// NewServer creates a remote signer serving the keys on the listener to the allowed clients
+func NewServer(
+	listener net.Listener,
+	serverKey tmcrypto.PrivKey,
+	allowedClients []p2p.ID,
+	keys map[string]cryptotypes.PrivKey,
+	logger zerolog.Logger,
+) *Server {
+	allowed := make(map[p2p.ID]bool)
+	for _, id := range allowedClients {
+		allowed[id] = true
+	}
+	return &Server{
+		listener:       listener,
+		serverKey:      serverKey,
+		allowedClients: allowed,
+		keys:           keys,
+		logger:         logger.With().Str("module", "RemoteSigner").Logger(),
+		conn:           make(map[net.Conn]bool),
+		stop:           make(chan struct{}),
+	}
+}
+
+// ID returns the ID of the server key, it must be the signer ID configured in zetaclient
+func (s *Server) ID() p2p.ID {
+	return p2p.PubKeyToID(s.serverKey.PubKey())
+}
+
+// Start accepts the connections of the clients in the background
+func (s *Server) Start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			rawConn, err := s.listener.Accept()
+			if err != nil {
+				select {
+				case <-s.stop:
+					return
+				default:
+				}
+				if errors.Is(err, net.ErrClosed) {
+					return
+				}
+				s.logger.Error().Err(err).Msg("accept error")
+				continue
+			}
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				s.serve(rawConn)
+			}()
+		}
+	}()
+}
+
+// Stop closes the listener and the connections of the clients
+func (s *Server) Stop() {
+	close(s.stop)
+	_ = s.listener.Close()
+	s.mu.Lock()
+	for c := range s.conn {
+		_ = c.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// serve authenticates the client and answers its requests until the connection is closed
+func (s *Server) serve(rawConn net.Conn) {
+	if !s.track(rawConn) {
+		_ = rawConn.Close()
+		return
+	}
+	defer s.untrack(rawConn)
+	defer rawConn.Close()
+
+	secretConn, err := conn.MakeSecretConnection(rawConn, s.serverKey)
+	if err != nil {
+		s.logger.Warn().Err(err).Msgf("handshake failed with %s", rawConn.RemoteAddr())
+		return
+	}
+	encoder := json.NewEncoder(secretConn)
+	clientID := p2p.PubKeyToID(secretConn.RemotePubKey())
+	if !s.allowedClients[clientID] {
+		s.logger.Warn().Msgf("rejected client %s from %s", clientID, rawConn.RemoteAddr())
+		_ = encoder.Encode(Response{Error: fmt.Sprintf("client %s is not allowed", clientID)})
+		return
+	}
+
+	decoder := json.NewDecoder(secretConn)
+	for {
+		var req Request
+		if err := decoder.Decode(&req); err != nil {
+			return
+		}
+		res := s.handle(req)
+		if res.Error != "" {
+			s.logger.Warn().Msgf("%s request of client %s failed: %s", req.Method, clientID, res.Error)
+		}
+		if err := encoder.Encode(res); err != nil {
+			return
+		}
+	}
+}
+
+// handle answers a request
+func (s *Server) handle(req Request) Response {
+	key, found := s.keys[req.KeyName]
+	if !found {
+		return Response{Error: fmt.Sprintf("key %s not found", req.KeyName)}
+	}
+	switch req.Method {
+	case MethodPubKey:
+		return Response{PubKey: key.PubKey().Bytes()}
+	case MethodSign:
+		signature, err := key.Sign(req.SignBytes)
+		if err != nil {
+			return Response{Error: err.Error()}
+		}
+		return Response{Signature: signature}
+	default:
+		return Response{Error: fmt.Sprintf("unknown method %s", req.Method)}
+	}
+}
+
+func (s *Server) track(c net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.stop:
+		return false
+	default:
+	}
+	s.conn[c] = true
+	return true
+}
+
+func (s *Server) untrack(c net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.conn, c)
+}
@@ -0,0 +1,259 @@
+package zetaclient
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+)
+
+const (
+	endpointHealthCheckInterval = 30 * time.Second
+	endpointProbeTimeout        = 10 * time.Second
+	endpointMaxErrorRate        = 0.5             // an endpoint failing more than half of the requests is unhealthy
+	endpointMaxLatency          = 5 * time.Second // an endpoint responding slower than this is unhealthy
+)
+
+// rpcEndpoint tracks the health of an external chain RPC endpoint
+type rpcEndpoint struct {
+	name        string // endpoint host, credentials and api keys are stripped
+	healthy     bool
+	blockHeight uint64
+	blockLag    uint64
+	latency     time.Duration
+	requests    uint64 // number of requests since last health check
+	failures    uint64 // number of failed requests since last health check
+	errorRate   float64
+	lastErr     error
+}
+
+// EndpointPool keeps track of the health of multiple RPC endpoints of a chain and selects the one to use.
+// The endpoints are preferred in the configured order, the active endpoint rotates away on failure and
+// the preferred healthy endpoint is re-selected on every health check.
+type EndpointPool struct {
+	mu          sync.Mutex
+	chainID     int64
+	endpoints   []*rpcEndpoint
+	active      int
+	maxBlockLag uint64
+	logger      zerolog.Logger
+	ts          *TelemetryServer
+	stop        chan struct{}
+}
+
+// NewEndpointPool creates a pool for the given endpoints; an endpoint lagging behind the highest one by more than
+// 'maxBlockLag' blocks is considered unhealthy. The health is reported to telemetry under 'chainID' if 'ts' is not nil
+func NewEndpointPool(chainID int64, endpoints []string, maxBlockLag uint64, logger zerolog.Logger, ts *TelemetryServer) *EndpointPool {
+	pool := &EndpointPool{
+		chainID:     chainID,
+		endpoints:   make([]*rpcEndpoint, len(endpoints)),
+		maxBlockLag: maxBlockLag,
+		logger:      logger.With().Str("module", "EndpointPool").Logger(),
+		ts:          ts,
+		stop:        make(chan struct{}),
+	}
+	for i, endpoint := range endpoints {
+		pool.endpoints[i] = &rpcEndpoint{name: endpointName(endpoint), healthy: true}
+	}
+	return pool
+}
+
+// Size returns the number of endpoints in the pool
+func (p *EndpointPool) Size() int {
+	return len(p.endpoints)
+}
+
+// Order returns the indexes of endpoints to try: the active endpoint, then the other healthy ones, then the unhealthy ones
+func (p *EndpointPool) Order() []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	order := make([]int, 0, len(p.endpoints))
+	if len(p.endpoints) == 0 {
+		return order
+	}
+	order = append(order, p.active)
+	for _, wantHealthy := range []bool{true, false} {
+		for i, endpoint := range p.endpoints {
+			if i != p.active && endpoint.healthy == wantHealthy {
+				order = append(order, i)
+			}
+		}
+	}
+	return order
+}
+
+// RecordResult records the result of a request to an endpoint and rotates away from the active endpoint if it failed
+func (p *EndpointPool) RecordResult(i int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	endpoint := p.endpoints[i]
+	endpoint.requests++
+	if !isEndpointFailure(err) {
+		return
+	}
+	endpoint.failures++
+	endpoint.lastErr = err
+	if i == p.active {
+		for j := 1; j < len(p.endpoints); j++ {
+			next := (i + j) % len(p.endpoints)
+			if p.endpoints[next].healthy {
+				p.active = next
+				p.logger.Warn().Err(err).Msgf("endpoint %s failed, rotate to endpoint %s", endpoint.name, p.endpoints[next].name)
+				break
+			}
+		}
+	}
+}
+
+// UpdateHealth updates the health of all endpoints with the probed block heights and latencies,
+// then selects the preferred healthy endpoint as the active one
+func (p *EndpointPool) UpdateHealth(heights []uint64, latencies []time.Duration, errs []error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	maxHeight := uint64(0)
+	for i := range p.endpoints {
+		if errs[i] == nil && heights[i] > maxHeight {
+			maxHeight = heights[i]
+		}
+	}
+	for i, endpoint := range p.endpoints {
+		endpoint.latency = latencies[i]
+		endpoint.errorRate = 0
+		if endpoint.requests > 0 {
+			endpoint.errorRate = float64(endpoint.failures) / float64(endpoint.requests)
+		}
+		endpoint.requests, endpoint.failures = 0, 0
+		if errs[i] != nil {
+			endpoint.lastErr = errs[i]
+			endpoint.healthy = false
+			continue
+		}
+		endpoint.blockHeight = heights[i]
+		endpoint.blockLag = maxHeight - heights[i]
+		endpoint.healthy = endpoint.blockLag <= p.maxBlockLag &&
+			endpoint.errorRate <= endpointMaxErrorRate &&
+			endpoint.latency <= endpointMaxLatency
+	}
+	for i, endpoint := range p.endpoints {
+		if endpoint.healthy {
+			if i != p.active {
+				p.logger.Info().Msgf("switch active endpoint from %s to %s", p.endpoints[p.active].name, endpoint.name)
+				p.active = i
+			}
+			break
+		}
+	}
+}
+
+// Health returns a snapshot of the health of all endpoints
+func (p *EndpointPool) Health() []clienttypes.EndpointHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	health := make([]clienttypes.EndpointHealth, len(p.endpoints))
+	for i, endpoint := range p.endpoints {
+		health[i] = clienttypes.EndpointHealth{
+			Endpoint:    endpoint.name,
+			Active:      i == p.active,
+			Healthy:     endpoint.healthy,
+			BlockHeight: endpoint.blockHeight,
+			BlockLag:    endpoint.blockLag,
+			LatencyMs:   endpoint.latency.Milliseconds(),
+			ErrorRate:   endpoint.errorRate,
+		}
+		if endpoint.lastErr != nil {
+			health[i].LastError = endpoint.lastErr.Error()
+		}
+	}
+	return health
+}
+
+// CheckHealth probes the block height of every endpoint, updates their health and reports it to telemetry
+func (p *EndpointPool) CheckHealth(probe func(ctx context.Context, i int) (uint64, error)) {
+	heights := make([]uint64, len(p.endpoints))
+	latencies := make([]time.Duration, len(p.endpoints))
+	errs := make([]error, len(p.endpoints))
+
+	var wg sync.WaitGroup
+	for i := range p.endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), endpointProbeTimeout)
+			defer cancel()
+			start := time.Now()
+			heights[i], errs[i] = probe(ctx, i)
+			latencies[i] = time.Since(start)
+		}(i)
+	}
+	wg.Wait()
+
+	p.UpdateHealth(heights, latencies, errs)
+	if p.ts != nil {
+		p.ts.SetEndpointHealth(p.chainID, p.Health())
+	}
+}
+
+// WatchHealth checks the health of endpoints periodically until the pool is stopped
+func (p *EndpointPool) WatchHealth(probe func(ctx context.Context, i int) (uint64, error)) {
+	ticker := time.NewTicker(endpointHealthCheckInterval)
+	defer ticker.Stop()
+	p.CheckHealth(probe)
+	for {
+		select {
+		case <-ticker.C:
+			p.CheckHealth(probe)
+		case <-p.stop:
+			p.logger.Info().Msg("WatchHealth stopped")
+			return
+		}
+	}
+}
+
+// Stop stops watching the health of endpoints
+func (p *EndpointPool) Stop() {
+	close(p.stop)
+}
+
+// failoverCall calls the endpoints in the order given by the pool until one of them doesn't fail
+func failoverCall[C any, T any](pool *EndpointPool, clients []C, call func(client C) (T, error)) (T, error) {
+	var result T
+	err := errors.New("no rpc endpoint configured")
+	for _, i := range pool.Order() {
+		result, err = call(clients[i])
+		pool.RecordResult(i, err)
+		if !isEndpointFailure(err) {
+			return result, err
+		}
+	}
+	return result, err
+}
+
+// isEndpointFailure returns true if the error is caused by the endpoint rather than the request itself.
+// Errors returned by the RPC server (e.g. a tx not found or a rejected tx) would be the same on any other endpoint.
+func isEndpointFailure(err error) bool {
+	if err == nil || errors.Is(err, ethereum.NotFound) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	var btcErr *btcjson.RPCError
+	return !errors.As(err, &btcErr)
+}
+
+// endpointName strips the credentials and api keys from the endpoint url
+func endpointName(endpoint string) string {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Host == "" {
+		return endpoint
+	}
+	return endpointURL.Host
+}
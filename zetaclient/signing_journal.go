@@ -0,0 +1,116 @@
+package zetaclient
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// SigningJournalDBName is the name of the sqlite db of the signing journal in the chain observer db directory
+const SigningJournalDBName = "signing_journal"
+
+// SigningJournal is a durable journal of the outbound txs signed with TSS, stored next to the chain client dbs
+// The journaled tx of a nonce is re-broadcasted instead of requesting a new keysign when it's still valid,
+// so a restarted client doesn't sign the nonces it has already signed and broadcasted
+// A nil journal records nothing and never returns a journaled tx
+type SigningJournal struct {
+	db *gorm.DB
+	mu sync.Mutex
+}
+
+// NewSigningJournal opens (or creates) the signing journal in the given db directory
+func NewSigningJournal(dbpath string) (*SigningJournal, error) {
+	if _, err := os.Stat(dbpath); os.IsNotExist(err) {
+		err := os.MkdirAll(dbpath, os.ModePerm)
+		if err != nil {
+			return nil, err
+		}
+	}
+	path := fmt.Sprintf("%s/%s", dbpath, SigningJournalDBName)
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, err
+	}
+	err = db.AutoMigrate(&clienttypes.SignedOutTxSQLType{})
+	if err != nil {
+		return nil, err
+	}
+	return &SigningJournal{db: db}, nil
+}
+
+// RecordSignedTx journals a signed outbound tx of the nonce, a tx already journaled for the nonce is not recorded twice
+func (j *SigningJournal) RecordSignedTx(chainID int64, nonce uint64, cctxIndex string, txHash string, gasPrice string, signedTx []byte) error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entry := clienttypes.ToSignedOutTxSQLType(chainID, nonce, cctxIndex, txHash, gasPrice, signedTx)
+	return j.db.Where("chain_id = ? AND nonce = ? AND tx_hash = ?", chainID, nonce, txHash).FirstOrCreate(&entry).Error
+}
+
+// GetLatestSignedTx returns the last tx journaled for the nonce, or nil if no tx has been journaled
+func (j *SigningJournal) GetLatestSignedTx(chainID int64, nonce uint64) (*clienttypes.SignedOutTxSQLType, error) {
+	if j == nil {
+		return nil, nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var entry clienttypes.SignedOutTxSQLType
+	err := j.db.Where("chain_id = ? AND nonce = ?", chainID, nonce).Order("id desc").First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// RecordBroadcastAttempt counts a broadcast attempt of a journaled tx
+func (j *SigningJournal) RecordBroadcastAttempt(chainID int64, txHash string) error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.db.Model(&clienttypes.SignedOutTxSQLType{}).
+		Where("chain_id = ? AND tx_hash = ?", chainID, txHash).
+		Updates(map[string]interface{}{
+			"broadcast_attempts":  gorm.Expr("broadcast_attempts + ?", 1),
+			"last_broadcast_time": time.Now().Unix(),
+		}).Error
+}
+
+// PruneSignedTxs removes the journaled txs of the nonces lower than the given nonce, their outtxs are confirmed
+func (j *SigningJournal) PruneSignedTxs(chainID int64, nonceLow uint64) error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.db.Unscoped().Where("chain_id = ? AND nonce < ?", chainID, nonceLow).Delete(&clienttypes.SignedOutTxSQLType{}).Error
+}
+
+// ListSignedTxs returns the journaled txs of the chain ordered by nonce, or the journaled txs of all chains if chainID is 0
+func (j *SigningJournal) ListSignedTxs(chainID int64) ([]clienttypes.SignedOutTxSQLType, error) {
+	if j == nil {
+		return nil, nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	query := j.db.Order("chain_id, nonce, id")
+	if chainID != 0 {
+		query = query.Where("chain_id = ?", chainID)
+	}
+	var entries []clienttypes.SignedOutTxSQLType
+	err := query.Find(&entries).Error
+	return entries, err
+}
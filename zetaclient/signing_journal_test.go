@@ -0,0 +1,71 @@
+package zetaclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningJournal(t *testing.T) {
+	journal, err := NewSigningJournal(t.TempDir())
+	require.NoError(t, err)
+
+	t.Run("should return nil if no tx is journaled", func(t *testing.T) {
+		entry, err := journal.GetLatestSignedTx(5, 0)
+		require.NoError(t, err)
+		require.Nil(t, entry)
+	})
+
+	t.Run("should record signed txs once and return the latest", func(t *testing.T) {
+		require.NoError(t, journal.RecordSignedTx(5, 0, "0x01", "0xaa", "100", []byte{1}))
+		require.NoError(t, journal.RecordSignedTx(5, 0, "0x01", "0xaa", "100", []byte{1}))
+		require.NoError(t, journal.RecordSignedTx(5, 0, "0x01", "0xbb", "200", []byte{2}))
+		require.NoError(t, journal.RecordSignedTx(5, 1, "0x02", "0xcc", "100", []byte{3}))
+		require.NoError(t, journal.RecordSignedTx(97, 0, "0x03", "0xdd", "100", []byte{4}))
+
+		entry, err := journal.GetLatestSignedTx(5, 0)
+		require.NoError(t, err)
+		require.Equal(t, "0xbb", entry.TxHash)
+		require.Equal(t, "200", entry.GasPrice)
+		require.Equal(t, []byte{2}, entry.SignedTx)
+
+		entries, err := journal.ListSignedTxs(5)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		entries, err = journal.ListSignedTxs(0)
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+	})
+
+	t.Run("should count broadcast attempts", func(t *testing.T) {
+		require.NoError(t, journal.RecordBroadcastAttempt(5, "0xcc"))
+		require.NoError(t, journal.RecordBroadcastAttempt(5, "0xcc"))
+
+		entry, err := journal.GetLatestSignedTx(5, 1)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, entry.BroadcastAttempts)
+		require.Positive(t, entry.LastBroadcastTime)
+	})
+
+	t.Run("should prune the txs of confirmed nonces", func(t *testing.T) {
+		require.NoError(t, journal.PruneSignedTxs(5, 1))
+
+		entry, err := journal.GetLatestSignedTx(5, 0)
+		require.NoError(t, err)
+		require.Nil(t, entry)
+		entry, err = journal.GetLatestSignedTx(5, 1)
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		entry, err = journal.GetLatestSignedTx(97, 0)
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+	})
+
+	t.Run("nil journal should be a no-op", func(t *testing.T) {
+		var nilJournal *SigningJournal
+		require.NoError(t, nilJournal.RecordSignedTx(5, 0, "0x01", "0xaa", "100", []byte{1}))
+		entry, err := nilJournal.GetLatestSignedTx(5, 0)
+		require.NoError(t, err)
+		require.Nil(t, entry)
+	})
+}
@@ -29,6 +29,7 @@ type TelemetryServer struct {
 	status                 types.Status
 	ipAddress              string
 	hotKeyBurnRate         *metrics.BurnRate
+	endpointHealth         map[int64][]types.EndpointHealth // chainid => health of rpc endpoints
 }
 
 // NewTelemetryServer should only listen to the loopback
@@ -38,6 +39,7 @@ func NewTelemetryServer() *TelemetryServer {
 		lastScannedBlockNumber: make(map[int64]uint64),
 		lastStartTimestamp:     time.Now(),
 		hotKeyBurnRate:         metrics.NewBurnRate(100),
+		endpointHealth:         make(map[int64][]types.EndpointHealth),
 	}
 	s := &http.Server{
 		Addr:              ":8123",
@@ -112,6 +114,13 @@ func (t *TelemetryServer) SetNumberOfUTXOs(numberOfUTXOs int) {
 	t.mu.Unlock()
 }
 
+// setter for health of rpc endpoints of a chain
+func (t *TelemetryServer) SetEndpointHealth(chainID int64, health []types.EndpointHealth) {
+	t.mu.Lock()
+	t.endpointHealth[chainID] = health
+	t.mu.Unlock()
+}
+
 func (t *TelemetryServer) AddFeeEntry(block int64, amount int64) {
 	t.mu.Lock()
 	err := t.hotKeyBurnRate.AddFee(amount, block)
@@ -133,6 +142,7 @@ func (t *TelemetryServer) Handlers() http.Handler {
 	router.Handle("/status", http.HandlerFunc(t.statusHandler)).Methods(http.MethodGet)
 	router.Handle("/ip", http.HandlerFunc(t.ipHandler)).Methods(http.MethodGet)
 	router.Handle("/hotkeyburnrate", http.HandlerFunc(t.hotKeyFeeBurnRate)).Methods(http.MethodGet)
+	router.Handle("/endpoints", http.HandlerFunc(t.endpointHealthHandler)).Methods(http.MethodGet)
 	// router.Handle("/debug/pprof/goroutine", pprof.Handler("goroutine"))
 	// router.Handle("/debug/pprof/heap", pprof.Handler("heap"))
 	// router.HandleFunc("/debug/pprof/", pprof.Index)
@@ -252,3 +262,19 @@ func (t *TelemetryServer) hotKeyFeeBurnRate(w http.ResponseWriter, _ *http.Reque
 	defer t.mu.Unlock()
 	fmt.Fprintf(w, "%v", t.hotKeyBurnRate.GetBurnRate())
 }
+
+func (t *TelemetryServer) endpointHealthHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	jsonBytes, err := json.Marshal(t.endpointHealth)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, err = w.Write(jsonBytes)
+	if err != nil {
+		t.logger.Error().Err(err).Msg("Failed to write response")
+	}
+}
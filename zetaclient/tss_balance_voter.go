@@ -0,0 +1,150 @@
+package zetaclient
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/zetacore/common"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// TssBalanceVoter votes the balance of the current TSS on every observed chain once a new TSS is finalized,
+// the voted balances are the amounts migrated to the new TSS by MsgMigrateAllTssFunds
+type TssBalanceVoter struct {
+	zetaClient   *ZetaCoreBridge
+	chainClients map[common.Chain]ChainClient
+	ticker       *DynamicTicker
+	stop         chan struct{}
+	logger       zerolog.Logger
+	lastVoted    map[int64]string // key: chain id, value: tss pubkey and balance of the last vote
+}
+
+func NewTssBalanceVoter(
+	zetaClient *ZetaCoreBridge,
+	chainClients map[common.Chain]ChainClient,
+	logger zerolog.Logger,
+) (*TssBalanceVoter, error) {
+	dynamicTicker, err := NewDynamicTicker("TssBalanceTicker", 60)
+	if err != nil {
+		return nil, err
+	}
+	return &TssBalanceVoter{
+		zetaClient:   zetaClient,
+		chainClients: chainClients,
+		ticker:       dynamicTicker,
+		stop:         make(chan struct{}),
+		logger: logger.With().
+			Str("module", "TssBalanceVoter").
+			Logger(),
+		lastVoted: make(map[int64]string),
+	}, nil
+}
+
+func (tv *TssBalanceVoter) Start() {
+	defer tv.ticker.Stop()
+	for {
+		select {
+		case <-tv.ticker.C():
+			err := tv.VoteTssBalances()
+			if err != nil {
+				tv.logger.Error().Err(err).Msg("TssBalanceVoter error")
+			}
+		case <-tv.stop:
+			return
+		}
+	}
+}
+
+func (tv *TssBalanceVoter) Stop() {
+	tv.logger.Info().Msg("TssBalanceVoter is stopping")
+	close(tv.stop)
+}
+
+// VoteTssBalances votes the balance of the current TSS on the chains without pending nonces,
+// only while a TSS funds migration is possible: inbound is disabled and a newer TSS is finalized
+func (tv *TssBalanceVoter) VoteTssBalances() error {
+	flags, err := tv.zetaClient.GetCrosschainFlags()
+	if err != nil {
+		return err
+	}
+	if flags.IsInboundEnabled {
+		return nil
+	}
+	tss, err := tv.zetaClient.GetCurrentTss()
+	if err != nil {
+		return err
+	}
+	tssHistory, err := tv.zetaClient.GetTssHistory()
+	if err != nil {
+		return err
+	}
+	if !IsTssMigrationPending(tss, tssHistory) {
+		return nil
+	}
+
+	for chain, client := range tv.chainClients {
+		pendingNonces, err := tv.zetaClient.GetPendingNoncesByChain(chain.ChainId)
+		if err != nil {
+			tv.logger.Error().Err(err).Msgf("error getting pending nonces of chain %d", chain.ChainId)
+			continue
+		}
+		if pendingNonces.NonceLow != pendingNonces.NonceHigh {
+			continue
+		}
+		// #nosec G701 always positive
+		balance, err := GetTssMigrationBalance(client, uint64(pendingNonces.NonceLow))
+		if err != nil {
+			tv.logger.Error().Err(err).Msgf("error getting tss balance of chain %d", chain.ChainId)
+			continue
+		}
+		vote := fmt.Sprintf("%s-%s", tss.TssPubkey, balance)
+		if tv.lastVoted[chain.ChainId] == vote {
+			continue
+		}
+		zetaHash, err := tv.zetaClient.PostVoteTssBalance(chain.ChainId, tss.TssPubkey, sdkmath.NewUintFromBigInt(balance))
+		if err != nil {
+			tv.logger.Error().Err(err).Msgf("error voting tss balance of chain %d", chain.ChainId)
+			continue
+		}
+		tv.lastVoted[chain.ChainId] = vote
+		tv.logger.Info().Msgf("voted tss balance %s of chain %d, zeta tx %s", balance, chain.ChainId, zetaHash)
+	}
+	return nil
+}
+
+// IsTssMigrationPending returns true if a TSS finalized after the current TSS is in the TSS history
+func IsTssMigrationPending(tss observertypes.TSS, tssHistory []observertypes.TSS) bool {
+	for _, t := range tssHistory {
+		if t.TssPubkey != tss.TssPubkey && t.FinalizedZetaHeight > tss.FinalizedZetaHeight {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTssMigrationBalance returns the TSS funds a migration can move to the new TSS in one outTx:
+// the TSS balance of gas tokens for EVM chains and the value of the utxos swept by SignSweepTx for Bitcoin
+func GetTssMigrationBalance(client ChainClient, nonce uint64) (*big.Int, error) {
+	switch ob := client.(type) {
+	case *EVMChainClient:
+		return ob.GetTssBalance()
+	case *BitcoinChainClient:
+		err := ob.FetchUTXOS()
+		if err != nil {
+			return nil, err
+		}
+		_, total, err := ob.SelectSweepUTXOs(nonce, false)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := GetSatoshis(total)
+		if err != nil {
+			return nil, err
+		}
+		return big.NewInt(amount), nil
+	default:
+		return nil, fmt.Errorf("unsupported chain client")
+	}
+}
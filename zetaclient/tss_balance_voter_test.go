@@ -0,0 +1,22 @@
+package zetaclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestIsTssMigrationPending(t *testing.T) {
+	tss := observertypes.TSS{TssPubkey: "tss1", FinalizedZetaHeight: 100}
+
+	require.False(t, IsTssMigrationPending(tss, []observertypes.TSS{tss}))
+	require.False(t, IsTssMigrationPending(tss, []observertypes.TSS{
+		{TssPubkey: "tss0", FinalizedZetaHeight: 50},
+		tss,
+	}))
+	require.True(t, IsTssMigrationPending(tss, []observertypes.TSS{
+		tss,
+		{TssPubkey: "tss2", FinalizedZetaHeight: 200},
+	}))
+}
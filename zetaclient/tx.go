@@ -18,20 +18,61 @@ import (
 )
 
 const (
-	PostGasPriceGasLimit            = 1_500_000
-	AddTxHashToOutTxTrackerGasLimit = 200_000
-	PostNonceGasLimit               = 200_000
-	PostSendEVMGasLimit             = 4_000_000 // likely emit a lot of logs, so costly
-	PostSendNonEVMGasLimit          = 1_000_000
-	PostReceiveConfirmationGasLimit = 400_000
-	PostBlameDataGasLimit           = 200_000
-	DefaultGasLimit                 = 200_000
-	PostProveOutboundTxGasLimit     = 400_000
-	DefaultRetryCount               = 5
-	ExtendedRetryCount              = 15
-	DefaultRetryInterval            = 5
+	// TxBaseGasLimit is the gas of a tx paid once whatever its number of messages: the ante handler, the signature and the tx size
+	TxBaseGasLimit = 100_000
+
+	// the gas limits of the messages, the gas limit of a tx is the sum of the gas limits of its messages and TxBaseGasLimit
+	GasPriceVoteGasLimit       = 1_400_000
+	InboundVoteGasLimit        = 3_900_000 // calls the zEVM and likely emit a lot of logs, so costly
+	InboundVoteZetaGasLimit    = 900_000
+	OutboundVoteGasLimit       = 300_000
+	OutboundVoteFailedGasLimit = 3_900_000 // the revert of the cctx calls the zEVM
+	DefaultMsgGasLimit         = 100_000
+
+	DefaultRetryCount    = 5
+	ExtendedRetryCount   = 15
+	DefaultRetryInterval = 5
 )
 
+// GetMsgGasLimit returns the gas limit of a message of zetaclient, the messages wrapped by authz.MsgExec add up
+func GetMsgGasLimit(msg sdk.Msg) uint64 {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		if err != nil {
+			return DefaultMsgGasLimit
+		}
+		var gasLimit uint64
+		for _, m := range msgs {
+			gasLimit += GetMsgGasLimit(m)
+		}
+		return gasLimit
+	case *types.MsgGasPriceVoter:
+		return GasPriceVoteGasLimit
+	case *types.MsgVoteOnObservedInboundTx:
+		if msg.CoinType == common.CoinType_Zeta {
+			return InboundVoteZetaGasLimit
+		}
+		return InboundVoteGasLimit
+	case *types.MsgVoteOnObservedOutboundTx:
+		if msg.Status == common.ReceiveStatus_Failed {
+			return OutboundVoteFailedGasLimit
+		}
+		return OutboundVoteGasLimit
+	default:
+		return DefaultMsgGasLimit
+	}
+}
+
+// GetTxGasLimit returns the gas limit of a tx with the messages
+func GetTxGasLimit(msgs ...sdk.Msg) uint64 {
+	gasLimit := uint64(TxBaseGasLimit)
+	for _, msg := range msgs {
+		gasLimit += GetMsgGasLimit(msg)
+	}
+	return gasLimit
+}
+
 // GetInBoundVoteMessage returns a new MsgVoteOnObservedInboundTx
 func GetInBoundVoteMessage(
 	sender string,
@@ -81,25 +122,39 @@ func (b *ZetaCoreBridge) WrapMessageWithAuthz(msg sdk.Msg) (sdk.Msg, AuthZSigner
 	return &authzMessage, authzSigner, nil
 }
 
-func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, supply string, blockNum uint64) (string, error) {
-	signerAddress := b.keys.GetOperatorAddress().String()
-	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, supply, blockNum)
-
-	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
-	if err != nil {
-		return "", err
+// broadcastVote broadcasts the authz wrapped vote, in a batch of votes if the vote aggregator is enabled
+// the vote aggregator retries the broadcast of its batches, a vote broadcasted alone is retried DefaultRetryCount times
+func (b *ZetaCoreBridge) broadcastVote(authzMsg sdk.Msg, authzSigner AuthZSigner) (string, error) {
+	if b.voteAggregator != nil {
+		return b.voteAggregator.Submit(authzMsg, authzSigner)
 	}
-
+	var err error
 	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(PostGasPriceGasLimit, authzMsg, authzSigner)
+		var zetaTxHash string
+		zetaTxHash, err = b.Broadcast(GetTxGasLimit(authzMsg), authzMsg, authzSigner)
 		if err == nil {
 			return zetaTxHash, nil
 		}
-		b.logger.Debug().Err(err).Msgf("PostGasPrice broadcast fail | Retry count : %d", i+1)
+		b.logger.Debug().Err(err).Msgf("%s broadcast fail | Retry count : %d", sdk.MsgTypeURL(authzMsg), i+1)
 		time.Sleep(DefaultRetryInterval * time.Second)
 	}
+	return "", fmt.Errorf("broadcast failed after %d retries: %w", DefaultRetryCount, err)
+}
 
-	return "", fmt.Errorf("post gasprice failed after %d retries", DefaultRetryInterval)
+func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, priorityFee uint64, supply string, blockNum uint64) (string, error) {
+	signerAddress := b.keys.GetOperatorAddress().String()
+	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, priorityFee, supply, blockNum)
+
+	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
+	if err != nil {
+		return "", err
+	}
+
+	zetaTxHash, err := b.broadcastVote(authzMsg, authzSigner)
+	if err != nil {
+		return "", errors.Wrap(err, "post gasprice failed")
+	}
+	return zetaTxHash, nil
 }
 
 func (b *ZetaCoreBridge) AddTxHashToOutTxTracker(
@@ -118,28 +173,24 @@ func (b *ZetaCoreBridge) AddTxHashToOutTxTracker(
 		return "", err
 	}
 
-	zetaTxHash, err := b.Broadcast(AddTxHashToOutTxTrackerGasLimit, authzMsg, authzSigner)
+	zetaTxHash, err := b.Broadcast(GetTxGasLimit(authzMsg), authzMsg, authzSigner)
 	if err != nil {
 		return "", err
 	}
 	return zetaTxHash, nil
 }
 
-func (b *ZetaCoreBridge) PostSend(zetaGasLimit uint64, msg *types.MsgVoteOnObservedInboundTx) (string, error) {
+func (b *ZetaCoreBridge) PostSend(msg *types.MsgVoteOnObservedInboundTx) (string, error) {
 	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
 	if err != nil {
 		return "", err
 	}
 
-	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(zetaGasLimit, authzMsg, authzSigner)
-		if err == nil {
-			return zetaTxHash, nil
-		}
-		b.logger.Debug().Err(err).Msgf("PostSend broadcast fail | Retry count : %d", i+1)
-		time.Sleep(DefaultRetryInterval * time.Second)
+	zetaTxHash, err := b.broadcastVote(authzMsg, authzSigner)
+	if err != nil {
+		return "", errors.Wrap(err, "post send failed")
 	}
-	return "", fmt.Errorf("post send failed after %d retries", DefaultRetryInterval)
+	return zetaTxHash, nil
 }
 
 func (b *ZetaCoreBridge) PostReceiveConfirmation(
@@ -186,21 +237,11 @@ func (b *ZetaCoreBridge) PostReceiveConfirmation(
 		return "", ballotIndex, nil
 	}
 
-	// FIXME: remove this gas limit stuff; in the special ante handler with no gas limit, add
-	// NewMsgReceiveConfirmation to it.
-	var gasLimit uint64 = PostReceiveConfirmationGasLimit
-	if status == common.ReceiveStatus_Failed {
-		gasLimit = PostSendEVMGasLimit
-	}
-	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(gasLimit, authzMsg, authzSigner)
-		if err == nil {
-			return zetaTxHash, ballotIndex, nil
-		}
-		b.logger.Debug().Err(err).Msgf("PostReceive broadcast fail | Retry count : %d", i+1)
-		time.Sleep(DefaultRetryInterval * time.Second)
+	zetaTxHash, err := b.broadcastVote(authzMsg, authzSigner)
+	if err != nil {
+		return "", ballotIndex, errors.Wrap(err, "post receive failed")
 	}
-	return "", ballotIndex, fmt.Errorf("post receive failed after %d retries", DefaultRetryCount)
+	return zetaTxHash, ballotIndex, nil
 }
 
 func (b *ZetaCoreBridge) SetTSS(tssPubkey string, keyGenZetaHeight int64, status common.ReceiveStatus) (string, error) {
@@ -214,7 +255,7 @@ func (b *ZetaCoreBridge) SetTSS(tssPubkey string, keyGenZetaHeight int64, status
 
 	zetaTxHash := ""
 	for i := 0; i <= DefaultRetryCount; i++ {
-		zetaTxHash, err = b.Broadcast(DefaultGasLimit, authzMsg, authzSigner)
+		zetaTxHash, err = b.Broadcast(GetTxGasLimit(authzMsg), authzMsg, authzSigner)
 		if err == nil {
 			return zetaTxHash, nil
 		}
@@ -257,10 +298,8 @@ func (b *ZetaCoreBridge) PostBlameData(blame *blame.Blame, chainID int64, index
 		return "", err
 	}
 
-	var gasLimit uint64 = PostBlameDataGasLimit
-
 	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(gasLimit, authzMsg, authzSigner)
+		zetaTxHash, err := b.Broadcast(GetTxGasLimit(authzMsg), authzMsg, authzSigner)
 		if err == nil {
 			return zetaTxHash, nil
 		}
@@ -280,14 +319,53 @@ func (b *ZetaCoreBridge) PostAddBlockHeader(chainID int64, blockHash []byte, hei
 		return "", err
 	}
 
-	var gasLimit uint64 = DefaultGasLimit
+	zetaTxHash, err := b.broadcastVote(authzMsg, authzSigner)
+	if err != nil {
+		return "", errors.Wrap(err, "post add block header failed")
+	}
+	return zetaTxHash, nil
+}
+
+// PostVoteInboundHalt votes to halt the inbound of a chain whose zrc20 supply is not backed by the assets held on the chain
+func (b *ZetaCoreBridge) PostVoteInboundHalt(chainID int64, zrc20 string, checkHeight int64, deficit math.Int) (string, error) {
+	signerAddress := b.keys.GetOperatorAddress().String()
+
+	msg := observerTypes.NewMsgVoteInboundHalt(signerAddress, chainID, zrc20, checkHeight, deficit)
+
+	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < DefaultRetryCount; i++ {
+		zetaTxHash, err := b.Broadcast(GetTxGasLimit(authzMsg), authzMsg, authzSigner)
+		if err == nil {
+			return zetaTxHash, nil
+		}
+		b.logger.Error().Err(err).Msgf("PostVoteInboundHalt broadcast fail | Retry count : %d", i+1)
+		time.Sleep(DefaultRetryInterval * time.Second)
+	}
+	return "", fmt.Errorf("post vote inbound halt failed after %d retries", DefaultRetryCount)
+}
+
+// PostVoteTssBalance votes the balance of the current TSS on a chain, migrated to the new TSS by MsgMigrateAllTssFunds
+func (b *ZetaCoreBridge) PostVoteTssBalance(chainID int64, tssPubkey string, balance math.Uint) (string, error) {
+	signerAddress := b.keys.GetOperatorAddress().String()
+
+	msg := observerTypes.NewMsgVoteTssBalance(signerAddress, chainID, tssPubkey, balance)
+
+	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
+	if err != nil {
+		return "", err
+	}
+
 	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(gasLimit, authzMsg, authzSigner)
+		zetaTxHash, err := b.Broadcast(GetTxGasLimit(authzMsg), authzMsg, authzSigner)
 		if err == nil {
 			return zetaTxHash, nil
 		}
-		b.logger.Error().Err(err).Msgf("PostAddBlockHeader broadcast fail | Retry count : %d", i+1)
+		b.logger.Error().Err(err).Msgf("PostVoteTssBalance broadcast fail | Retry count : %d", i+1)
 		time.Sleep(DefaultRetryInterval * time.Second)
 	}
-	return "", fmt.Errorf("post add block header failed after %d retries", DefaultRetryCount)
+	return "", fmt.Errorf("post vote tss balance failed after %d retries", DefaultRetryCount)
 }
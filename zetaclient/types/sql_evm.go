@@ -69,6 +69,13 @@ type LastBlockSQLType struct {
 	Num uint64
 }
 
+// BlockHashSQLType stores the hash of a scanned block, keyed by block number, for reorg detection
+type BlockHashSQLType struct {
+	gorm.Model
+	Num  uint64
+	Hash string
+}
+
 // Type translation functions:
 
 func ToReceiptDBType(receipt *ethtypes.Receipt) (ReceiptDB, error) {
@@ -160,3 +167,12 @@ func ToLastBlockSQLType(lastBlock uint64) *LastBlockSQLType {
 		Num:   lastBlock,
 	}
 }
+
+func ToBlockHashSQLType(num uint64, hash common.Hash) *BlockHashSQLType {
+	return &BlockHashSQLType{
+		// #nosec G701 always in range
+		Model: gorm.Model{ID: uint(num)},
+		Num:   num,
+		Hash:  hash.Hex(),
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"gorm.io/gorm"
+)
+
+// SignedOutTxSQLType is an entry of the outbound signing journal
+// It records a signed outbound tx so it can be re-broadcasted after a restart instead of being signed again
+type SignedOutTxSQLType struct {
+	gorm.Model
+	ChainID           int64  `gorm:"index:idx_signed_outtx_chain_nonce"`
+	Nonce             uint64 `gorm:"index:idx_signed_outtx_chain_nonce"`
+	CctxIndex         string
+	TxHash            string
+	GasPrice          string
+	SignedTx          []byte // serialized signed tx, as broadcasted to the external chain
+	BroadcastAttempts uint64
+	LastBroadcastTime int64
+}
+
+func ToSignedOutTxSQLType(chainID int64, nonce uint64, cctxIndex string, txHash string, gasPrice string, signedTx []byte) SignedOutTxSQLType {
+	return SignedOutTxSQLType{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		CctxIndex: cctxIndex,
+		TxHash:    txHash,
+		GasPrice:  gasPrice,
+		SignedTx:  signedTx,
+	}
+}
@@ -4,3 +4,15 @@ package types
 type Status struct {
 	BTCNumberOfUTXOs int `json:"btc_number_of_utxos"`
 }
+
+// EndpointHealth is the health of an external chain RPC endpoint
+type EndpointHealth struct {
+	Endpoint    string  `json:"endpoint"`
+	Active      bool    `json:"active"`
+	Healthy     bool    `json:"healthy"`
+	BlockHeight uint64  `json:"block_height"`
+	BlockLag    uint64  `json:"block_lag"`
+	LatencyMs   int64   `json:"latency_ms"`
+	ErrorRate   float64 `json:"error_rate"`
+	LastError   string  `json:"last_error,omitempty"`
+}
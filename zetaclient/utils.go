@@ -14,6 +14,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
@@ -33,6 +35,13 @@ const (
 	bytesPerOutput    = 31  // each output is about 31 bytes
 	bytes1stWitness   = 110 // the 1st witness incurs about 110 bytes and it may vary
 	bytesPerWitness   = 108 // each additional witness incurs about 108 bytes and it may vary
+
+	// output sizes by type: 8-byte value + 1-byte script length + scriptPubKey
+	bytesPerOutputP2TR   = 43 // each P2TR output is 43 bytes
+	bytesPerOutputP2WSH  = 43 // each P2WSH output is 43 bytes
+	bytesPerOutputP2WPKH = 31 // each P2WPKH output is 31 bytes
+	bytesPerOutputP2SH   = 32 // each P2SH output is 32 bytes
+	bytesPerOutputP2PKH  = 34 // each P2PKH output is 34 bytes
 )
 
 var (
@@ -84,6 +93,49 @@ func EstimateSegWitTxSize(numInputs uint64, numOutputs uint64) uint64 {
 	return bytesEmptyTx + bytesInput + bytesOutput + bytesWitness
 }
 
+// GetOutputSizeByAddress returns the size of a tx output paying to the given address
+func GetOutputSizeByAddress(to btcutil.Address) (uint64, error) {
+	switch to.(type) {
+	case *common.AddressTaproot:
+		return bytesPerOutputP2TR, nil
+	case *btcutil.AddressWitnessScriptHash:
+		return bytesPerOutputP2WSH, nil
+	case *btcutil.AddressWitnessPubKeyHash:
+		return bytesPerOutputP2WPKH, nil
+	case *btcutil.AddressScriptHash:
+		return bytesPerOutputP2SH, nil
+	case *btcutil.AddressPubKeyHash:
+		return bytesPerOutputP2PKH, nil
+	default:
+		return 0, fmt.Errorf("cannot get output size for unsupported address type %T", to)
+	}
+}
+
+// EstimateOutTxSize estimates the size of a withdrawal tx spending numInputs TSS UTXOs to the payee
+// The tx has 3 outputs: the nonce-mark and the change to TSS (P2WPKH) and the payment to the payee
+func EstimateOutTxSize(numInputs uint64, payee btcutil.Address) (uint64, error) {
+	return EstimateBatchOutTxSize(numInputs, []btcutil.Address{payee})
+}
+
+// EstimateBatchOutTxSize estimates the size of a batched withdrawal tx spending numInputs TSS UTXOs to the payees
+// The tx has the nonce-mark and the change to TSS (P2WPKH) and one payment output per payee
+func EstimateBatchOutTxSize(numInputs uint64, payees []btcutil.Address) (uint64, error) {
+	if numInputs == 0 {
+		return 0, nil
+	}
+	bytesOutput := uint64(2 * bytesPerOutputP2WPKH)
+	for _, payee := range payees {
+		bytesPayee, err := GetOutputSizeByAddress(payee)
+		if err != nil {
+			return 0, err
+		}
+		bytesOutput += bytesPayee
+	}
+	bytesInput := numInputs * bytesPerInput
+	bytesWitness := bytes1stWitness + (numInputs-1)*bytesPerWitness
+	return bytesEmptyTx + bytesInput + bytesOutput + bytesWitness, nil
+}
+
 // SegWitTxSizeDepositor returns SegWit tx size (149B) incurred by the depositor
 func SegWitTxSizeDepositor() uint64 {
 	return bytesPerInput + bytesPerWitness
@@ -134,6 +186,27 @@ func payToWitnessPubKeyHashScript(pubKeyHash []byte) ([]byte, error) {
 	return txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(pubKeyHash).Script()
 }
 
+// DeserializeMsgTx decodes a hex-encoded raw Bitcoin transaction
+func DeserializeMsgTx(txHex string) (*wire.MsgTx, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, err
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// EffectiveGasPrice returns the gas price paid by a tx included in a block with the given base fee
+func EffectiveGasPrice(tx *ethtypes.Transaction, baseFee *big.Int) *big.Int {
+	if tx.Type() != ethtypes.DynamicFeeTxType || baseFee == nil {
+		return tx.GasPrice()
+	}
+	return new(big.Int).Add(baseFee, tx.EffectiveGasTipValue(baseFee))
+}
+
 type DynamicTicker struct {
 	name     string
 	interval uint64
@@ -0,0 +1,322 @@
+package zetaclient
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// DefaultVoteBatchMaxMsgs is the default maximum number of votes in a batch
+	DefaultVoteBatchMaxMsgs = 20
+
+	// MaxVoteBatchGasLimit is the maximum gas limit of a batch, well below the max gas of a ZetaChain block
+	MaxVoteBatchGasLimit = 50_000_000
+
+	// voteBatchConfirmInterval is the interval between the queries of the result of a batch tx
+	voteBatchConfirmInterval = 2 * time.Second
+
+	// voteBatchConfirmTimeout is the time after which the result of a batch tx is no longer queried
+	voteBatchConfirmTimeout = 60 * time.Second
+)
+
+var (
+	errVoteAggregatorStopped = errors.New("vote aggregator stopped")
+
+	// failedMsgIndexRegex matches the index of the failed message in the log of a failed tx
+	failedMsgIndexRegex = regexp.MustCompile(`message index: ([0-9]+)`)
+)
+
+// VoteBroadcaster broadcasts the batches of votes of the vote aggregator
+type VoteBroadcaster interface {
+	BroadcastMsgs(gasLimit uint64, authzWrappedMsgs []sdk.Msg, authzSigner AuthZSigner) (string, error)
+	GetTxResult(txHash string) (*sdk.TxResponse, error)
+}
+
+// voteEntry is a vote waiting for the broadcast of its batch
+type voteEntry struct {
+	msg    sdk.Msg
+	signer AuthZSigner
+
+	// result receives the result of the first broadcast of the vote, it's nil once the result is sent
+	result chan voteResult
+}
+
+type voteResult struct {
+	txHash string
+	err    error
+}
+
+// VoteAggregator collects the authz wrapped votes of zetaclient over a short window and broadcasts them in one tx
+// holding a MsgExec per vote, the votes of a batch share the tx base gas and a single account sequence.
+//
+// A tx fails as a whole when one of its messages fails. A batch rejected before its execution is broadcasted again
+// one vote per tx, and a batch failing in its execution is broadcasted again without the failed vote,
+// so one bad vote doesn't drop the other votes of its batch. The result of a vote is returned once the tx
+// holding it passes CheckTx, as for the votes broadcasted one per tx, the execution of a batch is confirmed
+// in the background and the votes broadcasted again after a failed execution are not returned to their callers.
+type VoteAggregator struct {
+	broadcaster     VoteBroadcaster
+	window          time.Duration
+	maxMsgs         int
+	confirmInterval time.Duration
+	confirmTimeout  time.Duration
+	entries         chan *voteEntry
+	stop            chan struct{}
+	wg              sync.WaitGroup
+	logger          zerolog.Logger
+}
+
+// NewVoteAggregator creates a vote aggregator broadcasting the votes collected over the window in batches of maxMsgs votes at most
+func NewVoteAggregator(broadcaster VoteBroadcaster, window time.Duration, maxMsgs int, logger zerolog.Logger) *VoteAggregator {
+	if maxMsgs <= 0 {
+		maxMsgs = DefaultVoteBatchMaxMsgs
+	}
+	return &VoteAggregator{
+		broadcaster:     broadcaster,
+		window:          window,
+		maxMsgs:         maxMsgs,
+		confirmInterval: voteBatchConfirmInterval,
+		confirmTimeout:  voteBatchConfirmTimeout,
+		entries:         make(chan *voteEntry),
+		stop:            make(chan struct{}),
+		logger:          logger.With().Str("module", "VoteAggregator").Logger(),
+	}
+}
+
+// Start starts collecting the votes
+func (a *VoteAggregator) Start() {
+	a.logger.Info().Msgf("VoteAggregator started with a window of %s and %d votes per batch", a.window, a.maxMsgs)
+	a.wg.Add(1)
+	go a.run()
+}
+
+// Stop stops the vote aggregator, the votes waiting for their batch fail
+func (a *VoteAggregator) Stop() {
+	a.logger.Info().Msg("VoteAggregator is stopping")
+	close(a.stop)
+	a.wg.Wait()
+}
+
+// Submit adds the authz wrapped vote to the next batch and waits for the broadcast of the batch, returns the hash of the tx holding the vote
+// the votes are collected from concurrent callers, a caller voting in a loop should submit its votes concurrently to batch them
+func (a *VoteAggregator) Submit(authzMsg sdk.Msg, authzSigner AuthZSigner) (string, error) {
+	entry := &voteEntry{
+		msg:    authzMsg,
+		signer: authzSigner,
+		result: make(chan voteResult, 1),
+	}
+	select {
+	case a.entries <- entry:
+	case <-a.stop:
+		return "", errVoteAggregatorStopped
+	}
+	select {
+	case res := <-entry.result:
+		return res.txHash, res.err
+	case <-a.stop:
+		return "", errVoteAggregatorStopped
+	}
+}
+
+// run collects the votes from the first vote of a batch until the end of the window or until the batch is full
+func (a *VoteAggregator) run() {
+	defer a.wg.Done()
+	for {
+		var batch []*voteEntry
+		select {
+		case entry := <-a.entries:
+			batch = append(batch, entry)
+		case <-a.stop:
+			return
+		}
+
+		timer := time.NewTimer(a.window)
+	collect:
+		for len(batch) < a.maxMsgs {
+			select {
+			case entry := <-a.entries:
+				batch = append(batch, entry)
+			case <-timer.C:
+				break collect
+			case <-a.stop:
+				timer.Stop()
+				return
+			}
+		}
+		timer.Stop()
+
+		for _, votes := range splitVoteBatch(batch) {
+			a.broadcast(votes)
+		}
+	}
+}
+
+// splitVoteBatch splits the batch in txs of votes of the same signer within the gas limit of a batch, the order of the votes is kept
+func splitVoteBatch(batch []*voteEntry) [][]*voteEntry {
+	var txs [][]*voteEntry
+	var gasLimits []uint64
+	open := make(map[string]int)
+	for _, entry := range batch {
+		key := entry.signer.String()
+		gasLimit := GetMsgGasLimit(entry.msg)
+		i, found := open[key]
+		if !found || gasLimits[i]+gasLimit > MaxVoteBatchGasLimit {
+			i = len(txs)
+			open[key] = i
+			txs = append(txs, nil)
+			gasLimits = append(gasLimits, TxBaseGasLimit)
+		}
+		txs[i] = append(txs[i], entry)
+		gasLimits[i] += gasLimit
+	}
+	return txs
+}
+
+// broadcast broadcasts the votes in one tx, the tx is broadcasted again on account sequence mismatch
+// and the votes are broadcasted one by one if the tx is rejected
+func (a *VoteAggregator) broadcast(entries []*voteEntry) {
+	msgs := make([]sdk.Msg, len(entries))
+	for i, entry := range entries {
+		msgs[i] = entry.msg
+	}
+	gasLimit := GetTxGasLimit(msgs...)
+
+	var err error
+	for i := 0; i < DefaultRetryCount; i++ {
+		var txHash string
+		txHash, err = a.broadcaster.BroadcastMsgs(gasLimit, msgs, entries[0].signer)
+		if err == nil {
+			a.logger.Debug().Msgf("broadcasted %d votes with gas limit %d in tx %s", len(entries), gasLimit, txHash)
+			a.respond(entries, txHash, nil)
+			if len(entries) > 1 {
+				a.wg.Add(1)
+				go a.confirm(txHash, entries)
+			}
+			return
+		}
+		if !errors.Is(err, ErrSequenceMismatch) {
+			break
+		}
+		a.logger.Debug().Err(err).Msgf("broadcast of %d votes fail | Retry count : %d", len(entries), i+1)
+	}
+
+	if len(entries) > 1 {
+		a.logger.Warn().Err(err).Msgf("batch of %d votes rejected, broadcasting the votes one by one", len(entries))
+		for _, entry := range entries {
+			a.broadcast([]*voteEntry{entry})
+		}
+		return
+	}
+	a.respond(entries, "", err)
+}
+
+// confirm waits for the execution of the batch tx, if it failed the votes of the batch but the failed one are broadcasted again
+// the votes of a batch that can't be confirmed are not broadcasted again, they might be executed already
+func (a *VoteAggregator) confirm(txHash string, entries []*voteEntry) {
+	defer a.wg.Done()
+	res, err := a.waitTxResult(txHash)
+	if err != nil {
+		a.logger.Warn().Err(err).Msgf("unable to confirm the batch of %d votes in tx %s", len(entries), txHash)
+		return
+	}
+	if res.Code == 0 {
+		return
+	}
+
+	failedIndex := -1
+	if matches := failedMsgIndexRegex.FindStringSubmatch(res.RawLog); len(matches) == 2 {
+		failedIndex, err = strconv.Atoi(matches[1])
+		if err != nil {
+			failedIndex = -1
+		}
+	}
+	retries := make([]*voteEntry, 0, len(entries))
+	for i, entry := range entries {
+		if i == failedIndex {
+			a.logger.Error().Msgf("vote %d of the batch tx %s failed: %s", i, txHash, res.RawLog)
+			continue
+		}
+		retries = append(retries, entry)
+	}
+
+	if failedIndex < 0 || failedIndex >= len(entries) {
+		a.logger.Warn().Msgf("batch tx %s failed: %s, broadcasting the votes one by one", txHash, res.RawLog)
+		for _, entry := range retries {
+			a.broadcast([]*voteEntry{entry})
+		}
+		return
+	}
+	a.logger.Warn().Msgf("batch tx %s failed, broadcasting the other %d votes again", txHash, len(retries))
+	if len(retries) > 0 {
+		a.broadcast(retries)
+	}
+}
+
+// waitTxResult queries the result of the tx until it's included in a block
+func (a *VoteAggregator) waitTxResult(txHash string) (*sdk.TxResponse, error) {
+	ticker := time.NewTicker(a.confirmInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(a.confirmTimeout)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			res, err := a.broadcaster.GetTxResult(txHash)
+			if err == nil && res != nil {
+				return res, nil
+			}
+		case <-timeout.C:
+			return nil, fmt.Errorf("tx not found after %s", a.confirmTimeout)
+		case <-a.stop:
+			return nil, errVoteAggregatorStopped
+		}
+	}
+}
+
+// respond sends the result of the broadcast to the callers of the votes, the votes broadcasted again have no caller
+func (a *VoteAggregator) respond(entries []*voteEntry, txHash string, err error) {
+	for _, entry := range entries {
+		if entry.result == nil {
+			if err != nil {
+				a.logger.Error().Err(err).Msg("broadcast of a vote of a failed batch tx failed")
+			}
+			continue
+		}
+		entry.result <- voteResult{txHash: txHash, err: err}
+		entry.result = nil
+	}
+}
+
+// postVotesConcurrently calls the vote posts concurrently so that the vote aggregator broadcasts their votes
+// in the same batch tx, the votes posted one after the other would be broadcasted one batch window apart
+// returns the error of each post
+func postVotesConcurrently(posts []func() error) []error {
+	errs := make([]error, len(posts))
+	var wg sync.WaitGroup
+	for i, post := range posts {
+		wg.Add(1)
+		go func(i int, post func() error) {
+			defer wg.Done()
+			errs[i] = post()
+		}(i, post)
+	}
+	wg.Wait()
+	return errs
+}
+
+// firstError returns the first non nil error of the errors
+func firstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package zetaclient
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// broadcastedTx is a tx broadcasted to the mock broadcaster
+type broadcastedTx struct {
+	gasLimit uint64
+	msgs     []sdk.Msg
+}
+
+// mockVoteBroadcaster records the broadcasted txs, rejects the txs holding a rejected message
+// and fails the execution of the txs holding a failing message, the results of the txs are not found if unconfirmed
+type mockVoteBroadcaster struct {
+	mu                sync.Mutex
+	txs               []broadcastedTx
+	results           map[string]*sdk.TxResponse
+	sequenceMismatchs int
+	rejected          sdk.Msg
+	failing           sdk.Msg
+	unconfirmed       bool
+}
+
+func (m *mockVoteBroadcaster) BroadcastMsgs(gasLimit uint64, msgs []sdk.Msg, _ AuthZSigner) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.sequenceMismatchs > 0 {
+		m.sequenceMismatchs--
+		return "", ErrSequenceMismatch
+	}
+	res := &sdk.TxResponse{}
+	for i, msg := range msgs {
+		if msg == m.rejected {
+			return "", errors.New("rejected")
+		}
+		if msg == m.failing {
+			res.Code = 1
+			res.RawLog = fmt.Sprintf("failed to execute message; message index: %d: already voted", i)
+		}
+	}
+	m.txs = append(m.txs, broadcastedTx{gasLimit: gasLimit, msgs: msgs})
+	txHash := fmt.Sprintf("tx%d", len(m.txs))
+	m.results[txHash] = res
+	return txHash, nil
+}
+
+func (m *mockVoteBroadcaster) GetTxResult(txHash string) (*sdk.TxResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res, found := m.results[txHash]
+	if !found || m.unconfirmed {
+		return nil, errors.New("not found")
+	}
+	return res, nil
+}
+
+func (m *mockVoteBroadcaster) getTxs() []broadcastedTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]broadcastedTx{}, m.txs...)
+}
+
+func newTestVoteAggregator(t *testing.T, maxMsgs int) (*VoteAggregator, *mockVoteBroadcaster) {
+	broadcaster := &mockVoteBroadcaster{results: make(map[string]*sdk.TxResponse)}
+	aggregator := NewVoteAggregator(broadcaster, 100*time.Millisecond, maxMsgs, zerolog.Nop())
+	aggregator.confirmInterval = 10 * time.Millisecond
+	aggregator.confirmTimeout = 200 * time.Millisecond
+	aggregator.Start()
+	t.Cleanup(aggregator.Stop)
+	return aggregator, broadcaster
+}
+
+func sampleGasPriceVote(chainID int64) sdk.Msg {
+	msg := authz.NewMsgExec(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), []sdk.Msg{
+		crosschaintypes.NewMsgGasPriceVoter(sample.AccAddress(), chainID, 1, 0, "100", 1),
+	})
+	return &msg
+}
+
+// submitVotes submits the votes concurrently and returns their tx hashes and errors
+func submitVotes(aggregator *VoteAggregator, msgs []sdk.Msg) ([]string, []error) {
+	hashes := make([]string, len(msgs))
+	errs := make([]error, len(msgs))
+	var wg sync.WaitGroup
+	for i, msg := range msgs {
+		wg.Add(1)
+		go func(i int, msg sdk.Msg) {
+			defer wg.Done()
+			hashes[i], errs[i] = aggregator.Submit(msg, AuthZSigner{KeyType: common.ZetaClientGranteeKey})
+		}(i, msg)
+	}
+	wg.Wait()
+	return hashes, errs
+}
+
+func TestVoteAggregator(t *testing.T) {
+	t.Run("should broadcast the votes of the window in one tx", func(t *testing.T) {
+		aggregator, broadcaster := newTestVoteAggregator(t, 10)
+		msgs := []sdk.Msg{sampleGasPriceVote(1), sampleGasPriceVote(2), sampleGasPriceVote(3)}
+
+		hashes, errs := submitVotes(aggregator, msgs)
+		for i := range msgs {
+			require.NoError(t, errs[i])
+			require.Equal(t, "tx1", hashes[i])
+		}
+		txs := broadcaster.getTxs()
+		require.Len(t, txs, 1)
+		require.Len(t, txs[0].msgs, 3)
+		require.EqualValues(t, TxBaseGasLimit+3*GasPriceVoteGasLimit, txs[0].gasLimit)
+	})
+
+	t.Run("should split the votes in batches of max msgs", func(t *testing.T) {
+		aggregator, broadcaster := newTestVoteAggregator(t, 2)
+		msgs := []sdk.Msg{sampleGasPriceVote(1), sampleGasPriceVote(2), sampleGasPriceVote(3)}
+
+		_, errs := submitVotes(aggregator, msgs)
+		for i := range msgs {
+			require.NoError(t, errs[i])
+		}
+		txs := broadcaster.getTxs()
+		require.Len(t, txs, 2)
+		require.Len(t, txs[0].msgs, 2)
+		require.Len(t, txs[1].msgs, 1)
+	})
+
+	t.Run("should broadcast again on sequence mismatch", func(t *testing.T) {
+		aggregator, broadcaster := newTestVoteAggregator(t, 10)
+		broadcaster.sequenceMismatchs = 2
+
+		txHash, err := aggregator.Submit(sampleGasPriceVote(1), AuthZSigner{})
+		require.NoError(t, err)
+		require.Equal(t, "tx1", txHash)
+		require.Len(t, broadcaster.getTxs(), 1)
+	})
+
+	t.Run("should broadcast the votes one by one if the batch is rejected", func(t *testing.T) {
+		aggregator, broadcaster := newTestVoteAggregator(t, 10)
+		msgs := []sdk.Msg{sampleGasPriceVote(1), sampleGasPriceVote(2), sampleGasPriceVote(3)}
+		broadcaster.rejected = msgs[1]
+
+		hashes, errs := submitVotes(aggregator, msgs)
+		require.ErrorContains(t, errs[1], "rejected")
+		require.NoError(t, errs[0])
+		require.NoError(t, errs[2])
+		require.NotEqual(t, hashes[0], hashes[2])
+		txs := broadcaster.getTxs()
+		require.Len(t, txs, 2)
+		require.EqualValues(t, TxBaseGasLimit+GasPriceVoteGasLimit, txs[0].gasLimit)
+	})
+
+	t.Run("should broadcast again the votes of a failed batch but the failed vote", func(t *testing.T) {
+		aggregator, broadcaster := newTestVoteAggregator(t, 10)
+		msgs := []sdk.Msg{sampleGasPriceVote(1), sampleGasPriceVote(2), sampleGasPriceVote(3)}
+		broadcaster.failing = msgs[1]
+
+		// the votes are returned once the batch passes CheckTx, before its execution fails
+		hashes, errs := submitVotes(aggregator, msgs)
+		for i := range msgs {
+			require.NoError(t, errs[i])
+			require.Equal(t, "tx1", hashes[i])
+		}
+		require.Eventually(t, func() bool { return len(broadcaster.getTxs()) == 2 }, time.Second, 10*time.Millisecond)
+		txs := broadcaster.getTxs()
+		require.Len(t, txs[1].msgs, 2)
+		require.NotContains(t, txs[1].msgs, msgs[1])
+	})
+
+	t.Run("should not broadcast again the votes of a batch that can't be confirmed", func(t *testing.T) {
+		aggregator, broadcaster := newTestVoteAggregator(t, 10)
+		msgs := []sdk.Msg{sampleGasPriceVote(1), sampleGasPriceVote(2)}
+		broadcaster.unconfirmed = true
+
+		hashes, errs := submitVotes(aggregator, msgs)
+		for i := range msgs {
+			require.NoError(t, errs[i])
+			require.Equal(t, "tx1", hashes[i])
+		}
+		time.Sleep(2 * aggregator.confirmTimeout)
+		require.Len(t, broadcaster.getTxs(), 1)
+	})
+}
+
+func TestGetTxGasLimit(t *testing.T) {
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	wrap := func(msg sdk.Msg) sdk.Msg {
+		exec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		return &exec
+	}
+	inboundVote := func(coinType common.CoinType) sdk.Msg {
+		return wrap(crosschaintypes.NewMsgVoteOnObservedInboundTx(sample.AccAddress(), "sender", 1, "origin", "receiver", 2,
+			sdkmath.NewUint(1), "", "hash", 1, 100, coinType, "", 0))
+	}
+	outboundVote := func(status common.ReceiveStatus) sdk.Msg {
+		return wrap(crosschaintypes.NewMsgVoteOnObservedOutboundTx(sample.AccAddress(), "index", "hash", 1, 1,
+			sdkmath.NewInt(1), 1, sdkmath.NewUint(1), status, 1, 1, common.CoinType_Gas))
+	}
+
+	require.EqualValues(t, TxBaseGasLimit+GasPriceVoteGasLimit, GetTxGasLimit(sampleGasPriceVote(1)))
+	require.EqualValues(t, TxBaseGasLimit+InboundVoteGasLimit, GetTxGasLimit(inboundVote(common.CoinType_ERC20)))
+	require.EqualValues(t, TxBaseGasLimit+InboundVoteZetaGasLimit, GetTxGasLimit(inboundVote(common.CoinType_Zeta)))
+	require.EqualValues(t, TxBaseGasLimit+OutboundVoteGasLimit, GetTxGasLimit(outboundVote(common.ReceiveStatus_Success)))
+	require.EqualValues(t, TxBaseGasLimit+OutboundVoteFailedGasLimit, GetTxGasLimit(outboundVote(common.ReceiveStatus_Failed)))
+	require.EqualValues(t, TxBaseGasLimit+DefaultMsgGasLimit, GetTxGasLimit(wrap(crosschaintypes.NewMsgAddToOutTxTracker(
+		sample.AccAddress(), 1, 1, "hash", nil, "", 0))))
+
+	// the base gas is paid once per tx
+	require.EqualValues(t, TxBaseGasLimit+GasPriceVoteGasLimit+OutboundVoteGasLimit,
+		GetTxGasLimit(sampleGasPriceVote(1), outboundVote(common.ReceiveStatus_Success)))
+}
+
+func TestPostVotesConcurrently(t *testing.T) {
+	aggregator, broadcaster := newTestVoteAggregator(t, 10)
+	msgs := []sdk.Msg{sampleGasPriceVote(1), sampleGasPriceVote(2), sampleGasPriceVote(3)}
+
+	posts := make([]func() error, len(msgs)+1)
+	for i, msg := range msgs {
+		msg := msg
+		posts[i] = func() error {
+			_, err := aggregator.Submit(msg, AuthZSigner{})
+			return err
+		}
+	}
+	posts[len(msgs)] = func() error { return errors.New("post failed") }
+
+	errs := postVotesConcurrently(posts)
+	for i := range msgs {
+		require.NoError(t, errs[i])
+	}
+	require.ErrorContains(t, firstError(errs), "post failed")
+	txs := broadcaster.getTxs()
+	require.Len(t, txs, 1)
+	require.Len(t, txs[0].msgs, 3)
+}
@@ -40,6 +40,9 @@ type ZetaCoreBridge struct {
 	stop          chan struct{}
 	pause         chan struct{}
 	Telemetry     *TelemetryServer
+
+	// voteAggregator batches the votes, nil if the votes are broadcasted one per tx
+	voteAggregator *VoteAggregator
 }
 
 // NewZetaCoreBridge create a new instance of ZetaCoreBridge
@@ -136,6 +139,15 @@ func (b *ZetaCoreBridge) ZetaChain() common.Chain {
 func (b *ZetaCoreBridge) Stop() {
 	b.logger.Info().Msgf("ZetaBridge is stopping")
 	close(b.stop) // this notifies all configupdater to stop
+	if b.voteAggregator != nil {
+		b.voteAggregator.Stop()
+	}
+}
+
+// EnableVoteAggregator broadcasts the votes collected over the window in batches of maxMsgs votes at most, see VoteAggregator
+func (b *ZetaCoreBridge) EnableVoteAggregator(window time.Duration, maxMsgs int) {
+	b.voteAggregator = NewVoteAggregator(b, window, maxMsgs, b.logger)
+	b.voteAggregator.Start()
 }
 
 // GetAccountNumberAndSequenceNumber We do not use multiple KeyType for now , but this can be optionally used in the future to seprate TSS signer from Zetaclient GRantee
@@ -39,6 +39,7 @@ type CoreObserver struct {
 	ts                  *TelemetryServer
 	stop                chan struct{}
 	lastOperatorBalance sdkmath.Int
+	journal             *SigningJournal
 }
 
 // NewCoreObserver creates a new CoreObserver
@@ -50,6 +51,7 @@ func NewCoreObserver(
 	logger zerolog.Logger,
 	cfg *config.Config,
 	ts *TelemetryServer,
+	journal *SigningJournal,
 ) *CoreObserver {
 	co := CoreObserver{
 		ts:   ts,
@@ -69,6 +71,7 @@ func NewCoreObserver(
 
 	co.clientMap = clientMap
 	co.metrics = metrics
+	co.journal = journal
 	co.logger.ChainLogger.Info().Msg("starting core observer")
 	err := metrics.RegisterCounter(OutboundTxSignCount, "number of Outbound tx signed")
 	if err != nil {
@@ -125,7 +128,7 @@ func (co *CoreObserver) MonitorCore() {
 
 // startCctxScheduler schedules keysigns for cctxs on each ZetaChain block (the ticker)
 func (co *CoreObserver) startCctxScheduler() {
-	outTxMan := NewOutTxProcessorManager(co.logger.ChainLogger)
+	outTxMan := NewOutTxProcessorManager(co.logger.ChainLogger, co.journal)
 	observeTicker := time.NewTicker(3 * time.Second)
 	var lastBlockNum int64
 	for {
@@ -172,6 +175,17 @@ func (co *CoreObserver) startCctxScheduler() {
 					} // Gauge only takes float values
 					gauge.Set(float64(co.ts.hotKeyBurnRate.GetBurnRate().Int64()))
 
+					flags, err := co.bridge.GetCrosschainFlags()
+					if err != nil {
+						co.logger.ZetaChainWatcher.Error().Err(err).Msg("startCctxScheduler: GetCrosschainFlags fail")
+						continue
+					}
+					crosschainParams, err := co.bridge.GetCrosschainParams()
+					if err != nil {
+						co.logger.ZetaChainWatcher.Error().Err(err).Msg("startCctxScheduler: GetCrosschainParams fail")
+						continue
+					}
+
 					// schedule keysign for pending cctxs on each chain
 					supportedChains := co.Config().GetEnabledChains()
 					for _, c := range supportedChains {
@@ -198,10 +212,23 @@ func (co *CoreObserver) startCctxScheduler() {
 						}
 						gauge.Set(float64(totalPending))
 
+						// the outtxs of the nonces lower than the earliest pending nonce are confirmed, their journaled txs are no longer needed
+						if len(cctxList) > 0 {
+							err = co.journal.PruneSignedTxs(c.ChainId, cctxList[0].GetCurrentOutTxParam().OutboundTxTssNonce)
+							if err != nil {
+								co.logger.ZetaChainWatcher.Error().Err(err).Msgf("startCctxScheduler: PruneSignedTxs failed for chain %d", c.ChainId)
+							}
+						}
+
+						if !flags.IsChainOutboundEnabled(c.ChainId) {
+							co.logger.ZetaChainWatcher.Info().Msgf("startCctxScheduler: outbound disabled for chain %d", c.ChainId)
+							continue
+						}
+
 						// #nosec G701 range is verified
 						zetaHeight := uint64(bn)
 						if common.IsEVMChain(c.ChainId) {
-							co.scheduleCctxEVM(outTxMan, zetaHeight, c.ChainId, cctxList, ob, signer)
+							co.scheduleCctxEVM(outTxMan, zetaHeight, c.ChainId, cctxList, ob, signer, crosschainParams)
 						} else if common.IsBitcoinChain(c.ChainId) {
 							co.scheduleCctxBTC(outTxMan, zetaHeight, c.ChainId, cctxList, ob, signer)
 						} else {
@@ -225,7 +252,8 @@ func (co *CoreObserver) scheduleCctxEVM(
 	chainID int64,
 	cctxList []*types.CrossChainTx,
 	ob ChainClient,
-	signer ChainSigner) {
+	signer ChainSigner,
+	crosschainParams types.Params) {
 	res, err := co.bridge.GetAllOutTxTrackerByChain(chainID, Ascending)
 	if err != nil {
 		co.logger.ZetaChainWatcher.Warn().Err(err).Msgf("scheduleCctxEVM: GetAllOutTxTrackerByChain failed for chain %d", chainID)
@@ -236,6 +264,30 @@ func (co *CoreObserver) scheduleCctxEVM(
 		trackerMap[v.Nonce] = true
 	}
 
+	// the outtxs are confirmed concurrently to broadcast their votes in the same batch tx
+	lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
+	included := make([]bool, len(cctxList))
+	includedErrs := make([]error, len(cctxList))
+	posts := make([]func() error, 0, len(cctxList))
+	for idx, cctx := range cctxList {
+		// #nosec G701 always in range
+		if int64(idx) >= lookahead {
+			break
+		}
+		idx, cctx, params := idx, cctx, cctx.GetCurrentOutTxParam()
+		if params.ReceiverChainId != chainID {
+			continue
+		}
+		if params.OutboundTxTssNonce > cctxList[0].GetCurrentOutTxParam().OutboundTxTssNonce+MaxLookaheadNonce {
+			break
+		}
+		posts = append(posts, func() error {
+			included[idx], _, includedErrs[idx] = ob.IsSendOutTxProcessed(cctx.Index, params.OutboundTxTssNonce, params.CoinType, co.logger.ZetaChainWatcher)
+			return includedErrs[idx]
+		})
+	}
+	postVotesConcurrently(posts)
+
 	for idx, cctx := range cctxList {
 		params := cctx.GetCurrentOutTxParam()
 		nonce := params.OutboundTxTssNonce
@@ -250,20 +302,25 @@ func (co *CoreObserver) scheduleCctxEVM(
 			break
 		}
 
-		// try confirming the outtx
-		included, _, err := ob.IsSendOutTxProcessed(cctx.Index, params.OutboundTxTssNonce, params.CoinType, co.logger.ZetaChainWatcher)
-		if err != nil {
+		// the outtx was confirmed above
+		if err := includedErrs[idx]; err != nil {
 			co.logger.ZetaChainWatcher.Error().Err(err).Msgf("scheduleCctxEVM: IsSendOutTxProcessed faild for chain %d", chainID)
 			continue
 		}
-		if included {
+		if included[idx] {
 			co.logger.ZetaChainWatcher.Info().Msgf("scheduleCctxEVM: outtx %s already included; do not schedule keysign", outTxID)
 			continue
 		}
 
+		// the outtx is expired by zetacore once timed out if no tx is in tracker, so it must not be signed anymore
+		// a timed out outtx in tracker is still scheduled to be re-broadcasted and observed, an expired one to sign its cancel tx
+		if _, tracked := trackerMap[nonce]; !tracked && !params.OutboundTxExpired && crosschainParams.IsOutboundTimedOut(*params, zetaHeight) {
+			co.logger.ZetaChainWatcher.Warn().Msgf("scheduleCctxEVM: outtx %s timed out; do not schedule keysign", outTxID)
+			continue
+		}
+
 		// #nosec G701 positive
 		interval := uint64(ob.GetCoreParams().OutboundTxScheduleInterval)
-		lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
 
 		// determining critical outtx; if it satisfies following criteria
 		// 1. it's the first pending outtx for this chain
@@ -304,6 +361,7 @@ func (co *CoreObserver) scheduleCctxEVM(
 // 1. schedule at most one keysign per ticker
 // 2. schedule keysign only when nonce-mark UTXO is available
 // 3. stop keysign when lookahead is reached
+// 4. batch the consecutive pending nonces into one keysign (up to 'maxNoOfPaymentsPerTx' cctxs)
 func (co *CoreObserver) scheduleCctxBTC(
 	outTxMan *OutTxProcessorManager,
 	zetaHeight uint64,
@@ -316,7 +374,13 @@ func (co *CoreObserver) scheduleCctxBTC(
 		co.logger.ZetaChainWatcher.Error().Msgf("scheduleCctxBTC: chain client is not a bitcoin client")
 		return
 	}
+	btcSigner, ok := signer.(*BTCSigner)
+	if !ok { // should never happen
+		co.logger.ZetaChainWatcher.Error().Msgf("scheduleCctxBTC: chain signer is not a bitcoin signer")
+		return
+	}
 	lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
+	pendingNonce := btcClient.GetPendingNonce()
 
 	// schedule at most one keysign per ticker
 	for idx, cctx := range cctxList {
@@ -329,7 +393,7 @@ func (co *CoreObserver) scheduleCctxBTC(
 			continue
 		}
 		// stop if the nonce being processed is higher than the pending nonce
-		if nonce > btcClient.GetPendingNonce() {
+		if nonce > pendingNonce {
 			break
 		}
 		// stop if lookahead is reached
@@ -337,13 +401,56 @@ func (co *CoreObserver) scheduleCctxBTC(
 			co.logger.ZetaChainWatcher.Warn().Msgf("scheduleCctxBTC: lookahead reached, signing %d, earliest pending %d", nonce, cctxList[0].GetCurrentOutTxParam().OutboundTxTssNonce)
 			break
 		}
-		// try confirming the outtx or scheduling a keysign
-		if !outTxMan.IsOutTxActive(outTxID) {
-			outTxMan.StartTryProcess(outTxID)
-			co.logger.ZetaChainWatcher.Debug().Msgf("scheduleCctxBTC: sign outtx %s with value %d\n", outTxID, params.Amount)
-			go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, co.bridge, zetaHeight)
+		// try confirming the outtx of an included nonce
+		if nonce < pendingNonce {
+			if !outTxMan.IsOutTxActive(outTxID) {
+				outTxMan.StartTryProcess(outTxID)
+				co.logger.ZetaChainWatcher.Debug().Msgf("scheduleCctxBTC: sign outtx %s with value %d\n", outTxID, params.Amount)
+				go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, co.bridge, zetaHeight)
+			}
+			continue
+		}
+
+		// pack the consecutive pending nonces into one outtx and schedule a single keysign
+		batch, batchIDs := co.getBatchCctxBTC(cctxList[idx:], chainID, lookahead-int64(idx))
+		for _, id := range batchIDs {
+			if outTxMan.IsOutTxActive(id) {
+				return
+			}
+		}
+		for _, id := range batchIDs {
+			outTxMan.StartTryProcess(id)
+		}
+		co.logger.ZetaChainWatcher.Debug().Msgf("scheduleCctxBTC: sign outtx of nonce %d-%d", nonce, nonce+uint64(len(batch))-1)
+		go btcSigner.TryProcessOutTxBatch(batch, outTxMan, batchIDs, ob, co.bridge, zetaHeight)
+		break
+	}
+}
+
+// getBatchCctxBTC returns the leading cctxs of consecutive nonces to be paid by one bitcoin outtx, and their outtx IDs
+// The batch is limited to 'maxNoOfPaymentsPerTx' cctxs and to the remaining lookahead, a TSS funds migration is never batched
+func (co *CoreObserver) getBatchCctxBTC(cctxList []*types.CrossChainTx, chainID int64, lookahead int64) ([]*types.CrossChainTx, []string) {
+	batch := make([]*types.CrossChainTx, 0, maxNoOfPaymentsPerTx)
+	batchIDs := make([]string, 0, maxNoOfPaymentsPerTx)
+	for idx, cctx := range cctxList {
+		if idx >= maxNoOfPaymentsPerTx || int64(idx) >= lookahead {
+			break
+		}
+		params := cctx.GetCurrentOutTxParam()
+		// #nosec G701 always positive
+		if params.ReceiverChainId != chainID || (idx > 0 && params.OutboundTxTssNonce != cctxList[0].GetCurrentOutTxParam().OutboundTxTssNonce+uint64(idx)) {
+			break
+		}
+		if idx > 0 && IsTssFundsMigration(cctx) {
+			break
+		}
+		batch = append(batch, cctx)
+		batchIDs = append(batchIDs, ToOutTxID(cctx.Index, params.ReceiverChainId, params.OutboundTxTssNonce))
+		if IsTssFundsMigration(cctx) {
+			break
 		}
 	}
+	return batch, batchIDs
 }
 
 func (co *CoreObserver) getUpdatedChainOb(chainID int64) (ChainClient, error) {
@@ -0,0 +1,283 @@
+package zetaclient
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	metricsPkg "github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+const (
+	// ZRC20SupplyMismatch is the gauge of the zrc20 supply (plus in-flight withdrawals) not matching the assets held on the chain
+	// A positive value is a deficit, the gauge is reset to 0 when the mismatch is within the tolerance
+	ZRC20SupplyMismatch = "zrc20_supply_mismatch"
+
+	// ZRC20SupplyToleranceBps is the mismatch tolerated between the zrc20 supply and the assets held on the chain, in basis points of the supply
+	// The withdraw fees of a gas zrc20 are held by the protocol while the TSS pays the outTx fees on the chain,
+	// they are excluded from the supply so only the difference between the fees charged and paid remains
+	ZRC20SupplyToleranceBps = 100
+
+	// ZRC20DeficitConfirmations is the number of consecutive checks with a deficit before voting an inbound halt
+	ZRC20DeficitConfirmations = 3
+
+	// ZRC20CheckHeightInterval is the ZetaChain height interval the check heights of the inbound halt votes are rounded to,
+	// so the votes of observers confirming the same deficit end up in the same ballot
+	ZRC20CheckHeightInterval = 100
+)
+
+// ZRC20SupplyChecker reconciles the supply of every zrc20 with the assets held on its foreign chain
+// and votes to halt the inbound of a chain when the deficit of one of its zrc20 is confirmed
+type ZRC20SupplyChecker struct {
+	zetaClient      *ZetaCoreBridge
+	chainClients    map[common.Chain]ChainClient
+	ticker          *DynamicTicker
+	stop            chan struct{}
+	logger          zerolog.Logger
+	deficitCount    map[string]uint64 // key: zrc20 address, value: consecutive checks with a deficit
+	lastCheckHeight map[string]int64  // key: zrc20 address, value: check height of the last inbound halt vote
+}
+
+func NewZRC20SupplyChecker(
+	zetaClient *ZetaCoreBridge,
+	chainClients map[common.Chain]ChainClient,
+	metrics *metricsPkg.Metrics,
+	logger zerolog.Logger,
+) (*ZRC20SupplyChecker, error) {
+	dynamicTicker, err := NewDynamicTicker("ZRC20SupplyTicker", 60)
+	if err != nil {
+		return nil, err
+	}
+	err = metrics.RegisterGaugeVec(ZRC20SupplyMismatch, "zrc20 supply not matching the assets held on the chain", []string{"chain_id", "zrc20", "symbol"})
+	if err != nil {
+		return nil, err
+	}
+	return &ZRC20SupplyChecker{
+		zetaClient:   zetaClient,
+		chainClients: chainClients,
+		ticker:       dynamicTicker,
+		stop:         make(chan struct{}),
+		logger: logger.With().
+			Str("module", "ZRC20SupplyChecker").
+			Logger(),
+		deficitCount:    make(map[string]uint64),
+		lastCheckHeight: make(map[string]int64),
+	}, nil
+}
+
+func (zs *ZRC20SupplyChecker) Start() {
+	defer zs.ticker.Stop()
+	for {
+		select {
+		case <-zs.ticker.C():
+			err := zs.CheckZRC20Supplies()
+			if err != nil {
+				zs.logger.Error().Err(err).Msg("ZRC20SupplyChecker error")
+			}
+		case <-zs.stop:
+			return
+		}
+	}
+}
+
+func (zs *ZRC20SupplyChecker) Stop() {
+	zs.logger.Info().Msg("ZRC20SupplyChecker is stopping")
+	close(zs.stop)
+}
+
+// CheckZRC20Supplies reconciles the supply of the zrc20s of the observed chains, ZETA is reconciled by the ZetaSupplyChecker
+func (zs *ZRC20SupplyChecker) CheckZRC20Supplies() error {
+	coins, err := zs.zetaClient.GetForeignCoins()
+	if err != nil {
+		return err
+	}
+	flags, err := zs.zetaClient.GetCrosschainFlags()
+	if err != nil {
+		return err
+	}
+	zetaHeight, err := zs.zetaClient.GetZetaBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		if coin.CoinType == common.CoinType_Zeta {
+			continue
+		}
+		chain := common.GetChainFromChainID(coin.ForeignChainId)
+		if chain == nil {
+			continue
+		}
+		client, found := zs.chainClients[*chain]
+		if !found {
+			continue
+		}
+
+		mismatch, ok, err := zs.checkZRC20Supply(client, coin)
+		if err != nil {
+			zs.logger.Error().Err(err).Msgf("error checking supply of zrc20 %s on chain %d", coin.Zrc20ContractAddress, coin.ForeignChainId)
+			continue
+		}
+		gauge := metricsPkg.GaugeVecs[ZRC20SupplyMismatch].WithLabelValues(fmt.Sprint(coin.ForeignChainId), coin.Zrc20ContractAddress, coin.Symbol)
+		if ok {
+			gauge.Set(0)
+		} else {
+			gauge.Set(ToDecimalUnits(mismatch, coin.Decimals))
+		}
+
+		// vote the inbound halt of the chain once the deficit is confirmed
+		if ok || !mismatch.IsPositive() {
+			zs.deficitCount[coin.Zrc20ContractAddress] = 0
+			continue
+		}
+		zs.deficitCount[coin.Zrc20ContractAddress]++
+		if zs.deficitCount[coin.Zrc20ContractAddress] < ZRC20DeficitConfirmations || !flags.IsChainInboundEnabled(coin.ForeignChainId) {
+			continue
+		}
+		checkHeight := zetaHeight - zetaHeight%ZRC20CheckHeightInterval
+		if checkHeight <= 0 || zs.lastCheckHeight[coin.Zrc20ContractAddress] == checkHeight {
+			continue
+		}
+		zetaHash, err := zs.zetaClient.PostVoteInboundHalt(coin.ForeignChainId, coin.Zrc20ContractAddress, checkHeight, mismatch)
+		if err != nil {
+			zs.logger.Error().Err(err).Msgf("error voting inbound halt of chain %d for zrc20 %s", coin.ForeignChainId, coin.Zrc20ContractAddress)
+			continue
+		}
+		zs.lastCheckHeight[coin.Zrc20ContractAddress] = checkHeight
+		zs.logger.Warn().Msgf("voted inbound halt of chain %d, zrc20 %s deficit %s, zeta tx %s", coin.ForeignChainId, coin.Zrc20ContractAddress, mismatch, zetaHash)
+	}
+	return nil
+}
+
+// checkZRC20Supply returns the mismatch between the supply of the zrc20 (plus in-flight withdrawals) and the assets held on the chain
+func (zs *ZRC20SupplyChecker) checkZRC20Supply(client ChainClient, coin fungibletypes.ForeignCoins) (sdkmath.Int, bool, error) {
+	totalSupply, protocolBalance, err := zs.zetaClient.GetZRC20TotalSupply(coin.Zrc20ContractAddress)
+	if err != nil {
+		return sdkmath.ZeroInt(), false, err
+	}
+	cctxs, totalPending, err := zs.zetaClient.ListPendingCctx(coin.ForeignChainId)
+	if err != nil {
+		return sdkmath.ZeroInt(), false, err
+	}
+	// the pending cctxs are capped by the query, the in-flight amount would be underestimated
+	if totalPending > uint64(len(cctxs)) {
+		return sdkmath.ZeroInt(), false, fmt.Errorf("check skipped, only %d of the %d pending cctxs are listed", len(cctxs), totalPending)
+	}
+	trackers, err := zs.zetaClient.GetAllOutTxTrackerByChain(coin.ForeignChainId, Ascending)
+	if err != nil {
+		return sdkmath.ZeroInt(), false, err
+	}
+	inFlight := GetZRC20AmountInFlight(coin, cctxs, trackers)
+	externalBalance, err := GetExternalBalance(client, coin)
+	if err != nil {
+		return sdkmath.ZeroInt(), false, err
+	}
+	mismatch, ok := ValidateZRC20Supply(zs.logger, coin, totalSupply, protocolBalance, inFlight, externalBalance)
+	return mismatch, ok, nil
+}
+
+// GetExternalBalance returns the assets backing the zrc20 held on the chain:
+// the ERC20Custody balance for ERC20s, the TSS balance for EVM gas tokens and the TSS UTXOs for BTC
+func GetExternalBalance(client ChainClient, coin fungibletypes.ForeignCoins) (sdkmath.Int, error) {
+	var balance *big.Int
+	switch ob := client.(type) {
+	case *EVMChainClient:
+		var err error
+		switch coin.CoinType {
+		case common.CoinType_Gas:
+			balance, err = ob.GetTssBalance()
+		case common.CoinType_ERC20:
+			balance, err = ob.GetERC20CustodyBalance(ethcommon.HexToAddress(coin.Asset))
+		default:
+			return sdkmath.ZeroInt(), fmt.Errorf("unsupported coin type %s on evm chain", coin.CoinType)
+		}
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+	case *BitcoinChainClient:
+		if coin.CoinType != common.CoinType_Gas {
+			return sdkmath.ZeroInt(), fmt.Errorf("unsupported coin type %s on bitcoin chain", coin.CoinType)
+		}
+		amount, err := ob.GetTssUTXOsAmount()
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		balance = big.NewInt(amount)
+	default:
+		return sdkmath.ZeroInt(), fmt.Errorf("unsupported chain client for chain %d", coin.ForeignChainId)
+	}
+	return sdkmath.NewIntFromBigInt(balance), nil
+}
+
+// GetZRC20AmountInFlight returns the amount of the zrc20 in the pending cctxs to the chain
+// The zrc20 of these cctxs is already burned while the assets are still held on the chain
+// The cctxs with an outTx tracker are ignored, their outTx may already be mined
+func GetZRC20AmountInFlight(coin fungibletypes.ForeignCoins, cctxs []*types.CrossChainTx, trackers []types.OutTxTracker) sdkmath.Int {
+	tracked := make(map[uint64]bool)
+	for _, tracker := range trackers {
+		tracked[tracker.Nonce] = true
+	}
+	amount := sdkmath.ZeroUint()
+	for _, cctx := range cctxs {
+		if cctx == nil || cctx.GetInboundTxParams().CoinType != coin.CoinType {
+			continue
+		}
+		if coin.CoinType == common.CoinType_ERC20 && !strings.EqualFold(cctx.GetInboundTxParams().Asset, coin.Asset) {
+			continue
+		}
+		if tracked[cctx.GetCurrentOutTxParam().OutboundTxTssNonce] {
+			continue
+		}
+		amount = amount.Add(cctx.GetCurrentOutTxParam().Amount)
+	}
+	return sdkmath.NewIntFromBigInt(amount.BigInt())
+}
+
+// ValidateZRC20Supply returns the mismatch between the supply of the zrc20 (plus in-flight withdrawals) and the assets held on the chain,
+// and whether the mismatch is within the tolerance. A positive mismatch is a deficit
+// The balance held by the protocol is excluded from the supply of a gas zrc20, it is made of the withdraw fees paying the outTx fees
+func ValidateZRC20Supply(
+	logger zerolog.Logger,
+	coin fungibletypes.ForeignCoins,
+	totalSupply, protocolBalance, inFlight, externalBalance sdkmath.Int,
+) (sdkmath.Int, bool) {
+	supply := totalSupply
+	if coin.CoinType == common.CoinType_Gas {
+		supply = totalSupply.Sub(protocolBalance)
+	}
+	mismatch := supply.Add(inFlight).Sub(externalBalance)
+	tolerance := supply.MulRaw(ZRC20SupplyToleranceBps).QuoRaw(10_000)
+	ok := mismatch.Abs().LTE(tolerance)
+
+	event := logger.Info()
+	if !ok {
+		event = logger.Error()
+	}
+	event.
+		Int64("chain_id", coin.ForeignChainId).
+		Str("zrc20", coin.Zrc20ContractAddress).
+		Str("symbol", coin.Symbol).
+		Str("total_supply", totalSupply.String()).
+		Str("protocol_balance", protocolBalance.String()).
+		Str("in_flight", inFlight.String()).
+		Str("external_balance", externalBalance.String()).
+		Str("mismatch", mismatch.String()).
+		Bool("supply_check_success", ok).
+		Msg("zrc20 supply check")
+	return mismatch, ok
+}
+
+// ToDecimalUnits converts an amount in the smallest unit of a token to a float in the token unit
+func ToDecimalUnits(amount sdkmath.Int, decimals uint32) float64 {
+	value, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(amount.BigInt()),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)),
+	).Float64()
+	return value
+}
@@ -0,0 +1,148 @@
+package zetaclient_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	"github.com/zeta-chain/zetacore/zetaclient"
+)
+
+func pendingCctx(coinType common.CoinType, asset string, nonce uint64, amount uint64) *types.CrossChainTx {
+	return &types.CrossChainTx{
+		InboundTxParams: &types.InboundTxParams{
+			CoinType: coinType,
+			Asset:    asset,
+		},
+		OutboundTxParams: []*types.OutboundTxParams{
+			{
+				OutboundTxTssNonce: nonce,
+				Amount:             sdkmath.NewUint(amount),
+			},
+		},
+	}
+}
+
+func TestZRC20SupplyChecker_GetZRC20AmountInFlight(t *testing.T) {
+	asset := "0x7c8dDa80bbBE1254a7aACf3219EBe1481c6E01d7"
+	cctxs := []*types.CrossChainTx{
+		pendingCctx(common.CoinType_Gas, "", 0, 100),
+		pendingCctx(common.CoinType_Gas, "", 1, 200),
+		pendingCctx(common.CoinType_ERC20, asset, 2, 1000),
+		pendingCctx(common.CoinType_ERC20, "0x0000000000000000000000000000000000000001", 3, 2000),
+		pendingCctx(common.CoinType_Zeta, "", 4, 5000),
+	}
+	trackers := []types.OutTxTracker{{Nonce: 1}}
+
+	t.Run("should sum the untracked pending gas withdrawals", func(t *testing.T) {
+		coin := fungibletypes.ForeignCoins{CoinType: common.CoinType_Gas}
+		require.True(t, sdkmath.NewInt(100).Equal(zetaclient.GetZRC20AmountInFlight(coin, cctxs, trackers)))
+	})
+
+	t.Run("should sum the pending withdrawals of the erc20 asset", func(t *testing.T) {
+		coin := fungibletypes.ForeignCoins{CoinType: common.CoinType_ERC20, Asset: "0x7c8dda80bbbe1254a7aacf3219ebe1481c6e01d7"}
+		require.True(t, sdkmath.NewInt(1000).Equal(zetaclient.GetZRC20AmountInFlight(coin, cctxs, trackers)))
+	})
+
+	t.Run("should return zero without pending cctxs", func(t *testing.T) {
+		coin := fungibletypes.ForeignCoins{CoinType: common.CoinType_Gas}
+		require.True(t, zetaclient.GetZRC20AmountInFlight(coin, nil, nil).IsZero())
+	})
+}
+
+func TestZRC20SupplyChecker_ValidateZRC20Supply(t *testing.T) {
+	coin := fungibletypes.ForeignCoins{ForeignChainId: 5, Symbol: "gETH"}
+	tt := []struct {
+		name            string
+		coinType        common.CoinType
+		totalSupply     int64
+		protocolBalance int64
+		inFlight        int64
+		externalBalance int64
+		mismatch        int64
+		ok              bool
+	}{
+		{
+			name:            "supply and in-flight withdrawals backed by the chain balance",
+			coinType:        common.CoinType_Gas,
+			totalSupply:     10_000,
+			inFlight:        500,
+			externalBalance: 10_500,
+			mismatch:        0,
+			ok:              true,
+		},
+		{
+			name:            "deficit within the tolerance",
+			coinType:        common.CoinType_Gas,
+			totalSupply:     10_000,
+			inFlight:        500,
+			externalBalance: 10_400,
+			mismatch:        100,
+			ok:              true,
+		},
+		{
+			name:            "deficit beyond the tolerance",
+			coinType:        common.CoinType_Gas,
+			totalSupply:     10_000,
+			inFlight:        500,
+			externalBalance: 10_399,
+			mismatch:        101,
+			ok:              false,
+		},
+		{
+			name:            "surplus beyond the tolerance",
+			coinType:        common.CoinType_Gas,
+			totalSupply:     10_000,
+			inFlight:        0,
+			externalBalance: 20_000,
+			mismatch:        -10_000,
+			ok:              false,
+		},
+		{
+			name:            "withdraw fees held by the protocol excluded from the gas zrc20 supply",
+			coinType:        common.CoinType_Gas,
+			totalSupply:     11_000,
+			protocolBalance: 1_000,
+			inFlight:        500,
+			externalBalance: 10_500,
+			mismatch:        0,
+			ok:              true,
+		},
+		{
+			name:            "balance held by the protocol not excluded from the erc20 zrc20 supply",
+			coinType:        common.CoinType_ERC20,
+			totalSupply:     11_000,
+			protocolBalance: 1_000,
+			inFlight:        500,
+			externalBalance: 10_500,
+			mismatch:        1_000,
+			ok:              false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			coin := coin
+			coin.CoinType = tc.coinType
+			mismatch, ok := zetaclient.ValidateZRC20Supply(
+				zerolog.Nop(),
+				coin,
+				sdkmath.NewInt(tc.totalSupply),
+				sdkmath.NewInt(tc.protocolBalance),
+				sdkmath.NewInt(tc.inFlight),
+				sdkmath.NewInt(tc.externalBalance),
+			)
+			require.True(t, sdkmath.NewInt(tc.mismatch).Equal(mismatch), mismatch.String())
+			require.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
+func TestZRC20SupplyChecker_ToDecimalUnits(t *testing.T) {
+	require.Equal(t, 1.5, zetaclient.ToDecimalUnits(sdkmath.NewInt(150_000_000), 8))
+	require.Equal(t, -0.25, zetaclient.ToDecimalUnits(sdkmath.NewInt(-250_000_000_000_000_000), 18))
+}